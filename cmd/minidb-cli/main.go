@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/yyun543/minidb/internal/cli"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+var (
+	host        = flag.String("host", "localhost", "Server host")
+	port        = flag.String("port", "7205", "Server port")
+	user        = flag.String("user", "", "User name (when the server requires authentication)")
+	password    = flag.String("password", "", "Password (default $MINIDB_PASSWORD, prompted on a terminal when -user is set)")
+	database    = flag.String("database", "", "Database to use after connecting")
+	dataDir     = flag.String("data-dir", "", "Open a local data directory in-process instead of connecting to a server")
+	configPath  = flag.String("config", "", "Configuration file for -data-dir")
+	execute     = flag.String("e", "", "Execute the statements and exit")
+	file        = flag.String("f", "", "Execute the statements in the file and exit")
+	format      = flag.String("format", cli.FormatTable, "Output format: table, csv, json or vertical")
+	timing      = flag.Bool("timing", false, "Show the execution time of each statement")
+	historyPath = flag.String("history", cli.DefaultHistoryFile(), "History file (empty to disable)")
+	help        = flag.Bool("h", false, "Show help")
+)
+
+func main() {
+	flag.Parse()
+
+	if *help {
+		printUsage()
+		return
+	}
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", strings.TrimPrefix(err.Error(), "minidb: "))
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if !cli.ValidFormat(*format) {
+		return fmt.Errorf("unknown output format %q (must be %s)", *format, strings.Join(cli.Formats, ", "))
+	}
+
+	// 日志默认不输出，避免混入查询结果；LOG_CONSOLE、LOG_DIR 可以打开
+	logConfig := logger.ConfigFromEnv()
+	if os.Getenv("LOG_CONSOLE") == "" {
+		logConfig.EnableConsole = false
+	}
+	if os.Getenv("LOG_DIR") == "" {
+		logConfig.LogDir = ""
+	}
+	if err := logger.InitLogger(logConfig); err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+	defer logger.Sync()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+
+	interactive := *execute == "" && *file == "" && cli.IsTerminal(int(os.Stdin.Fd()))
+
+	db, err := open(ctx, interactive)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if *dataDir != "" && *database != "" {
+		if err := conn.Exec(ctx, "USE "+*database); err != nil {
+			return err
+		}
+	}
+
+	shell := cli.NewShell(conn, os.Stdout, os.Stderr)
+	shell.Format = *format
+	shell.Timing = *timing
+
+	switch {
+	case *execute != "":
+		return shell.Run(ctx, strings.NewReader(*execute), "-e")
+	case *file != "":
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return shell.Run(ctx, f, *file)
+	case !interactive:
+		return shell.Run(ctx, os.Stdin, "stdin")
+	}
+
+	completer := cli.NewCompleter()
+	completer.Refresh(ctx, conn)
+	shell.Completer = completer
+
+	history := cli.LoadHistory(*historyPath, cli.DefaultHistorySize)
+	defer history.Save()
+
+	fmt.Printf("Connected to %s. Type \\h for help, \\q to quit.\n", target())
+	return shell.RunInteractive(ctx, cli.NewLineEditor(os.Stdout, history, completer), history)
+}
+
+// open 打开本地数据目录，或者连接到服务器
+func open(ctx context.Context, interactive bool) (*minidb.DB, error) {
+	pass := *password
+	if pass == "" {
+		pass = os.Getenv("MINIDB_PASSWORD")
+	}
+	if pass == "" && *user != "" && interactive {
+		fmt.Print("Password: ")
+		var err error
+		pass, err = cli.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	if *dataDir != "" {
+		return minidb.OpenWithOptions(minidb.Options{
+			DataDir:    *dataDir,
+			ConfigFile: *configPath,
+			User:       *user,
+			Password:   pass,
+		})
+	}
+	return minidb.Dial(ctx, net.JoinHostPort(*host, *port), minidb.DialOptions{
+		User:     *user,
+		Password: pass,
+		Database: *database,
+	})
+}
+
+// target 连接的描述
+func target() string {
+	if *dataDir != "" {
+		return *dataDir
+	}
+	return net.JoinHostPort(*host, *port)
+}
+
+func printUsage() {
+	fmt.Printf("minidb-cli - MiniDB interactive client\n\n")
+	fmt.Printf("Usage: %s [options]\n\n", os.Args[0])
+	fmt.Printf("Options:\n")
+	flag.PrintDefaults()
+	fmt.Printf("\nExamples:\n")
+	fmt.Printf("  %s                              # Connect to localhost:7205\n", os.Args[0])
+	fmt.Printf("  %s -user admin -database shop   # Log in and switch to a database\n", os.Args[0])
+	fmt.Printf("  %s -data-dir ./minidb_data      # Open a data directory without a server\n", os.Args[0])
+	fmt.Printf("  %s -e 'SHOW DATABASES' -format csv  # Run statements and exit (for scripts and cron jobs)\n", os.Args[0])
+	fmt.Printf("  %s -f nightly.sql               # Run a file, stop at the first error with exit code 1\n", os.Args[0])
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/metrics"
+	"github.com/yyun543/minidb/internal/storage"
+	"go.uber.org/zap"
+)
+
+// newAdminHandler 创建管理端口的 HTTP 处理器
+// /metrics 输出进程级指标 (metrics.Default) 和本服务的在线会话、Delta Log 版本；/healthz 检查存储引擎
+func newAdminHandler(queries *executor.QueryRegistry, engine *storage.ParquetEngine) http.Handler {
+	instance := metrics.NewRegistry()
+	instance.NewGaugeFunc("minidb_active_sessions", "Client sessions currently connected.", func() float64 {
+		return float64(queries.SessionCount())
+	})
+	instance.NewGaugeFunc("minidb_running_queries", "Statements currently executing.", func() float64 {
+		return float64(len(queries.Running()))
+	})
+	instance.NewGaugeFunc("minidb_delta_log_version", "Latest committed Delta Log version.", func() float64 {
+		return float64(engine.GetDeltaLog().GetLatestVersion())
+	})
+
+	return metrics.NewAdminMux([]*metrics.Registry{metrics.Default, instance},
+		metrics.HealthCheck{Name: "storage", Check: engine.Health})
+}
+
+// startAdminServer 在 address 上启动管理端口，返回的 http.Server 用于关闭
+func startAdminServer(address string, handler http.Handler) (*http.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Admin server stopped", zap.String("address", address), zap.Error(err))
+		}
+	}()
+	return server, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"time"
+
+	"github.com/yyun543/minidb/internal/executor"
+)
+
+// watchConnection 在语句执行期间检测客户端是否断开连接，断开时以 ErrClientDisconnected 取消语句
+// 执行期间连接上不会有其他读取，检测通过 Peek 等待下一个字节：上一条语句 ';' 之后的换行等空白直接丢弃，
+// 读到其他数据 (客户端提前发送了下一条语句) 时停止检测，数据保留在 reader 中；
+// 返回的 stop 通过读超时结束检测，并报告连接是否已断开
+func watchConnection(conn net.Conn, reader *bufio.Reader, cancel context.CancelCauseFunc) (stop func() bool) {
+	done := make(chan bool, 1)
+	go func() {
+		closed := false
+		for {
+			next, err := reader.Peek(1)
+			if err != nil {
+				closed = !errors.Is(err, os.ErrDeadlineExceeded)
+				break
+			}
+			if !isSpace(next[0]) {
+				break
+			}
+			reader.Discard(1)
+		}
+		if closed {
+			cancel(executor.ErrClientDisconnected)
+		}
+		done <- closed
+	}()
+
+	return func() bool {
+		conn.SetReadDeadline(time.Now())
+		closed := <-done
+		conn.SetReadDeadline(time.Time{})
+		return closed
+	}
+}
+
+// isSpace 语句之间的空白字符
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+// closeOnSessionEnd 会话的 context 结束时关闭连接 (KILL SESSION)，被终止时先通知客户端
+func closeOnSessionEnd(ctx context.Context, conn net.Conn) {
+	<-ctx.Done()
+	if errors.Is(context.Cause(ctx), executor.ErrSessionTerminated) {
+		conn.Write([]byte("Error: " + executor.ErrSessionTerminated.Error() + "\n"))
+	}
+	conn.Close()
+}
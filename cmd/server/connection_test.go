@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+)
+
+// readStatement 像 handleConnection 一样读取一条语句，换行留在 reader 中
+func readStatement(t *testing.T, client net.Conn, reader *bufio.Reader, statement string) {
+	go client.Write([]byte(statement + "\n"))
+	input, err := reader.ReadString(';')
+	require.NoError(t, err)
+	require.Equal(t, statement, input)
+}
+
+// TestWatchConnectionCancelsOnDisconnect 语句之后的换行不会结束检测，客户端在执行期间断开时取消语句
+func TestWatchConnectionCancelsOnDisconnect(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	reader := bufio.NewReader(server)
+	readStatement(t, client, reader, "SELECT * FROM big;")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	stop := watchConnection(server, reader, cancel)
+
+	// 执行中的语句仍在运行
+	select {
+	case <-ctx.Done():
+		t.Fatal("query canceled before the client disconnected")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, client.Close())
+	select {
+	case <-ctx.Done():
+		assert.ErrorIs(t, context.Cause(ctx), executor.ErrClientDisconnected)
+	case <-time.After(5 * time.Second):
+		t.Fatal("query was not canceled after the client disconnected")
+	}
+	assert.True(t, stop())
+}
+
+// TestWatchConnectionKeepsNextStatement 客户端提前发送的下一条语句保留在 reader 中，语句正常结束时不取消
+func TestWatchConnectionKeepsNextStatement(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	reader := bufio.NewReader(server)
+	readStatement(t, client, reader, "SELECT 1;")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	stop := watchConnection(server, reader, cancel)
+
+	// net.Pipe 的写入在对端读完后才返回
+	client.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	_, err := client.Write([]byte("SELECT 2;"))
+	require.NoError(t, err)
+	assert.False(t, stop())
+	assert.NoError(t, ctx.Err())
+
+	input, err := reader.ReadString(';')
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 2;", input)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/yyun543/minidb/internal/auth"
+)
+
+// 文本协议的登录握手 (启用认证时，在欢迎消息之前进行)
+//
+//	server: Username:
+//	client: alice
+//	server: Password:
+//	client: secret
+//
+// 支持 SCRAM 的客户端在第一行发送 "SCRAM-SHA-256 <client-first>"，之后每条 SCRAM 消息各占一行，
+// 并带同样的机制名前缀，密码不经过网络：
+//
+//	server: Username:
+//	client: SCRAM-SHA-256 n,,n=alice,r=...
+//	server: SCRAM-SHA-256 r=...,s=...,i=4096
+//	client: SCRAM-SHA-256 c=biws,r=...,p=...
+//	server: SCRAM-SHA-256 v=...
+//
+// 认证失败时服务端发送 "Authentication failed" 并关闭连接
+
+// scramPrefix SCRAM 消息行前缀
+const scramPrefix = auth.ScramMechanism + " "
+
+// authenticateConnection 执行登录握手，返回认证的用户名
+func authenticateConnection(conn net.Conn, reader *bufio.Reader, manager *auth.Manager) (string, error) {
+	conn.Write([]byte("Username: "))
+	first, err := readLoginLine(reader)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(first, scramPrefix) {
+		return authenticateScram(conn, reader, manager, strings.TrimPrefix(first, scramPrefix))
+	}
+
+	conn.Write([]byte("Password: "))
+	password, err := readLoginLine(reader)
+	if err != nil {
+		return "", err
+	}
+	if err := manager.Authenticate(first, password); err != nil {
+		return "", err
+	}
+	return first, nil
+}
+
+// authenticateScram 完成 SCRAM-SHA-256 质询-应答
+func authenticateScram(conn net.Conn, reader *bufio.Reader, manager *auth.Manager, clientFirst string) (string, error) {
+	server := manager.NewScramServer()
+	serverFirst, err := server.First(clientFirst)
+	if err != nil {
+		return "", err
+	}
+	conn.Write([]byte(scramPrefix + serverFirst + "\n"))
+
+	line, err := readLoginLine(reader)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(line, scramPrefix) {
+		return "", fmt.Errorf("scram: expected client-final message")
+	}
+	serverFinal, err := server.Final(strings.TrimPrefix(line, scramPrefix))
+	if err != nil {
+		return "", err
+	}
+	conn.Write([]byte(scramPrefix + serverFinal + "\n"))
+	return server.User(), nil
+}
+
+// readLoginLine 读取一行登录输入，去掉行尾换行
+func readLoginLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"net"
@@ -10,14 +12,19 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/yyun543/minidb/internal/config"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/parser"
 	"go.uber.org/zap"
 )
 
 var (
-	host = flag.String("host", "localhost", "Host to bind to")
-	port = flag.String("port", "7205", "Port to bind to")
-	help = flag.Bool("h", false, "Show help")
+	configPath = flag.String("config", "", "Path to the YAML configuration file")
+	host       = flag.String("host", "localhost", "Host to bind to (overrides server.listeners)")
+	port       = flag.String("port", "7205", "Port to bind to (overrides server.listeners)")
+	dataDir    = flag.String("data-dir", "", "Data directory (overrides data_dir)")
+	adminAddr  = flag.String("admin", "", "HTTP admin address for /metrics and /healthz, e.g. localhost:9205 (overrides server.admin_listener)")
+	help       = flag.Bool("h", false, "Show help")
 )
 
 func main() {
@@ -36,31 +43,58 @@ func main() {
 	}
 	defer logger.Sync()
 
+	// 加载配置，命令行参数覆盖配置文件
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
 	// 创建全局查询处理器
-	handler, err := NewQueryHandler()
+	handler, err := NewQueryHandler(cfg)
 	if err != nil {
 		logger.Fatal("Failed to create query handler", zap.Error(err))
 	}
 	defer handler.Close()
 
-	// 启动TCP服务器
-	address := *host + ":" + *port
-	listener, err := net.Listen("tcp", address)
-	if err != nil {
-		logger.Fatal("Unable to start server",
-			zap.String("address", address),
-			zap.Error(err))
+	// 启动TCP服务器，每个监听地址一个 listener
+	listeners := make([]net.Listener, 0, len(cfg.Server.Listeners))
+	for _, address := range cfg.Server.Listeners {
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			logger.Fatal("Unable to start server",
+				zap.String("address", address),
+				zap.Error(err))
+		}
+		defer listener.Close()
+		listeners = append(listeners, listener)
+	}
+
+	// 启动 HTTP 管理端口 (/metrics、/healthz)
+	if cfg.Server.AdminListener != "" {
+		adminServer, err := startAdminServer(cfg.Server.AdminListener, handler.adminHandler)
+		if err != nil {
+			logger.Fatal("Unable to start admin server",
+				zap.String("address", cfg.Server.AdminListener),
+				zap.Error(err))
+		}
+		defer adminServer.Close()
 	}
-	defer listener.Close()
 
 	logger.LogServerEvent("server_starting",
 		zap.String("version", "2.0 (Lakehouse architecture)"),
-		zap.String("address", address),
+		zap.Strings("addresses", cfg.Server.Listeners),
+		zap.String("admin_address", cfg.Server.AdminListener),
+		zap.String("data_dir", cfg.DataDir),
 		zap.Strings("features", []string{"Vectorized Execution", "Cost-based Optimization", "Statistics Collection"}))
 
 	fmt.Printf("=== MiniDB Server ===\n")
 	fmt.Printf("Version: 2.0 (Lakehouse architecture)\n")
-	fmt.Printf("Listening on: %s\n", address)
+	fmt.Printf("Listening on: %s\n", strings.Join(cfg.Server.Listeners, ", "))
+	if cfg.Server.AdminListener != "" {
+		fmt.Printf("Metrics and health: http://%s/metrics, http://%s/healthz\n", cfg.Server.AdminListener, cfg.Server.AdminListener)
+	}
+	fmt.Printf("Data directory: %s\n", cfg.DataDir)
 	fmt.Printf("Features: Vectorized Execution, Cost-based Optimization, Statistics Collection\n")
 	fmt.Printf("Ready for connections...\n\n")
 
@@ -69,7 +103,9 @@ func main() {
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGTERM)
 
 	// 在新协程中处理连接
-	go acceptConnections(listener, handler)
+	for _, listener := range listeners {
+		go acceptConnections(listener, handler)
+	}
 
 	// 等待停止信号
 	<-signalChan
@@ -77,11 +113,39 @@ func main() {
 	fmt.Println("\nShutting down server...")
 }
 
+// loadConfig 读取 -config 指定的配置文件 (未指定时使用默认配置)，再应用显式给出的命令行参数
+func loadConfig() (*config.Config, error) {
+	cfg := config.Default()
+	if *configPath != "" {
+		loaded, err := config.Load(*configPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg = loaded
+	}
+
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	if explicit["host"] || explicit["port"] {
+		cfg.Server.Listeners = []string{net.JoinHostPort(*host, *port)}
+	}
+	if explicit["data-dir"] {
+		cfg.DataDir = *dataDir
+	}
+	if explicit["admin"] {
+		cfg.Server.AdminListener = *adminAddr
+	}
+	return cfg, cfg.Validate()
+}
+
 // acceptConnections 接受客户端连接
 func acceptConnections(listener net.Listener, handler *QueryHandler) {
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
 			logger.Error("Failed to accept connection", zap.Error(err))
 			continue
 		}
@@ -101,6 +165,8 @@ func printUsage() {
 	fmt.Printf("  %s                    # Start on default host:port (localhost:7205)\n", os.Args[0])
 	fmt.Printf("  %s -port 8080         # Start on port 8080\n", os.Args[0])
 	fmt.Printf("  %s -host 0.0.0.0      # Bind to all interfaces\n", os.Args[0])
+	fmt.Printf("  %s -config minidb.yaml # Load data dir, listeners and maintenance policies from a file\n", os.Args[0])
+	fmt.Printf("  %s -admin localhost:9205 # Serve Prometheus /metrics and /healthz over HTTP\n", os.Args[0])
 }
 
 func handleConnection(conn net.Conn, handler *QueryHandler) {
@@ -108,9 +174,23 @@ func handleConnection(conn net.Conn, handler *QueryHandler) {
 
 	// 获取客户端地址
 	clientAddr := conn.RemoteAddr().String()
+	reader := bufio.NewReader(conn)
+
+	// 启用认证时先完成登录握手
+	user := ""
+	if handler.accessControl.Enforced() {
+		var err error
+		user, err = authenticateConnection(conn, reader, handler.accessControl)
+		if err != nil {
+			logger.WithClient(clientAddr).Warn("Authentication failed", zap.Error(err))
+			conn.Write([]byte("Authentication failed\n"))
+			return
+		}
+	}
 
 	// 创建新会话
 	session := handler.sessionManager.CreateSession()
+	session.User = user
 	sessionID := session.ID
 
 	// 记录连接事件
@@ -122,6 +202,12 @@ func handleConnection(conn net.Conn, handler *QueryHandler) {
 		logger.LogConnectionEvent("client_disconnected", clientAddr, sessionID)
 	}()
 
+	// 登记会话，KILL SESSION 取消会话的 context 并关闭连接
+	sessCtx, cancelSession := context.WithCancelCause(context.Background())
+	defer cancelSession(nil)
+	defer handler.queries.RegisterSession(session, cancelSession)()
+	go closeOnSessionEnd(sessCtx, conn)
+
 	// 发送欢迎消息
 	welcomeMsg := fmt.Sprintf("Welcome to MiniDB v2.0!\n")
 	welcomeMsg += fmt.Sprintf("Session ID: %d\n", sessionID)
@@ -129,8 +215,6 @@ func handleConnection(conn net.Conn, handler *QueryHandler) {
 	welcomeMsg += fmt.Sprintf("------------------------------------\n")
 	conn.Write([]byte(welcomeMsg))
 
-	reader := bufio.NewReader(conn)
-
 	// 读取客户端命令
 	for {
 		conn.Write([]byte("minidb> "))
@@ -159,10 +243,19 @@ func handleConnection(conn net.Conn, handler *QueryHandler) {
 		// 记录查询（调试用）
 		logger.WithClient(clientAddr).Debug("Query received",
 			zap.Int64("session_id", sessionID),
-			zap.String("query", query))
+			zap.String("query", parser.RedactPasswords(query)))
 
-		// 使用会话ID处理查询
-		result, err := handler.HandleQuery(sessionID, query)
+		// 使用会话ID处理查询，执行期间客户端断开连接时取消查询
+		queryCtx, cancelQuery := context.WithCancelCause(sessCtx)
+		stopWatching := watchConnection(conn, reader, cancelQuery)
+		result, err := handler.HandleQueryContext(queryCtx, sessionID, query)
+		disconnected := stopWatching()
+		cancelQuery(nil)
+		if disconnected {
+			logger.WithClient(clientAddr).Info("Client disconnected during query",
+				zap.Int64("session_id", sessionID))
+			return
+		}
 		if err != nil {
 			errorMsg := fmt.Sprintf("Error: %v\n", err)
 			conn.Write([]byte(errorMsg))
@@ -0,0 +1,558 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// 表级权限
+const (
+	PrivilegeSelect = "SELECT"
+	PrivilegeInsert = "INSERT"
+	PrivilegeUpdate = "UPDATE"
+	PrivilegeDelete = "DELETE"
+	PrivilegeAll    = "ALL" // 授予时展开为以上全部权限；检查 ALL 时要求同时具备全部权限
+)
+
+// Wildcard GRANT ... ON db.* / *.* 中匹配任意数据库或表的通配符
+const Wildcard = "*"
+
+// tablePrivileges ALL 展开后的权限
+var tablePrivileges = []string{PrivilegeSelect, PrivilegeInsert, PrivilegeUpdate, PrivilegeDelete}
+
+// ErrAuthenticationFailed 用户不存在或密码错误 (不区分两者，避免暴露用户是否存在)
+var ErrAuthenticationFailed = errors.New("authentication failed: invalid user name or password")
+
+// User 可以登录的用户
+type User struct {
+	Name       string
+	Superuser  bool
+	Credential *ScramCredential
+	CreatedAt  time.Time
+}
+
+// Role 权限的集合，通过 GRANT role TO user 授予用户
+type Role struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+// Membership 角色成员关系
+type Membership struct {
+	Role   string
+	Member string
+}
+
+// Grant 授予用户或角色的一项表级权限
+type Grant struct {
+	Grantee   string
+	Database  string // 数据库名或 "*"
+	Table     string // 表名或 "*"
+	Privilege string
+}
+
+// matches 权限是否覆盖 db.table 上的 privilege
+func (g Grant) matches(privilege, db, table string) bool {
+	return g.Privilege == privilege &&
+		(g.Database == Wildcard || g.Database == db) &&
+		(g.Table == Wildcard || g.Table == table)
+}
+
+// catalogState 用户和权限目录，保存在 sys.users / sys.roles / sys.role_members / sys.privileges 中
+type catalogState struct {
+	Users   map[string]*User
+	Roles   map[string]*Role
+	Members []Membership
+	Grants  []Grant
+}
+
+// Manager 用户、角色和权限管理
+// 所有修改先在副本上进行，持久化成功后才替换内存中的目录
+type Manager struct {
+	mu       sync.RWMutex
+	tables   map[string]Table // 保存目录的系统表 (表名 -> 表)
+	state    *catalogState
+	enforced bool // 是否要求认证并检查权限
+}
+
+// NewManager 创建权限管理器并从 sys 库的系统表加载用户和权限目录
+func NewManager(open TableOpener) (*Manager, error) {
+	tables := make(map[string]Table, len(saveOrder))
+	for _, name := range saveOrder {
+		tables[name] = open(name, TableSchemas[name])
+	}
+	state, err := loadState(tables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load access control catalog: %w", err)
+	}
+	return &Manager{tables: tables, state: state}, nil
+}
+
+// newCatalogState 创建空目录
+func newCatalogState() *catalogState {
+	return &catalogState{
+		Users: make(map[string]*User),
+		Roles: make(map[string]*Role),
+	}
+}
+
+// SetEnforced 设置是否要求认证并在执行前检查权限
+func (m *Manager) SetEnforced(enforced bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.enforced = enforced
+}
+
+// Enforced 是否要求认证并检查权限
+func (m *Manager) Enforced() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.enforced
+}
+
+// clone 复制目录 (用户和角色的字段不会被原地修改，凭据可以共享)
+func (st *catalogState) clone() *catalogState {
+	next := newCatalogState()
+	for name, user := range st.Users {
+		copied := *user
+		next.Users[name] = &copied
+	}
+	for name, role := range st.Roles {
+		copied := *role
+		next.Roles[name] = &copied
+	}
+	next.Members = append([]Membership(nil), st.Members...)
+	next.Grants = append([]Grant(nil), st.Grants...)
+	return next
+}
+
+// update 在目录副本上执行 fn，成功后只重写有变化的系统表并替换当前目录
+// 写入某张表失败时从系统表重新加载目录，使内存中的目录与已提交的数据一致
+func (m *Manager) update(ctx context.Context, fn func(st *catalogState) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := m.state.clone()
+	if err := fn(next); err != nil {
+		return err
+	}
+	for _, name := range saveOrder {
+		if reflect.DeepEqual(m.state.section(name), next.section(name)) {
+			continue
+		}
+		record := next.record(name)
+		err := m.tables[name].Replace(ctx, record)
+		record.Release()
+		if err != nil {
+			if reloaded, loadErr := loadState(m.tables); loadErr == nil {
+				m.state = reloaded
+			}
+			return fmt.Errorf("failed to save access control catalog: %w", err)
+		}
+	}
+	m.state = next
+	return nil
+}
+
+// Bootstrap 目录中没有任何用户时创建初始超级用户，返回是否创建
+func (m *Manager) Bootstrap(name, password string) (bool, error) {
+	m.mu.RLock()
+	empty := len(m.state.Users) == 0
+	m.mu.RUnlock()
+	if !empty {
+		return false, nil
+	}
+	if name == "" || password == "" {
+		return false, errors.New("no users exist: an initial superuser name and password are required")
+	}
+	if err := m.CreateUser(context.Background(), name, password, true); err != nil {
+		return false, err
+	}
+	logger.WithComponent("auth").Info("Created initial superuser", zap.String("user", name))
+	return true, nil
+}
+
+// CreateUser 创建用户
+func (m *Manager) CreateUser(ctx context.Context, name, password string, superuser bool) error {
+	if name == "" {
+		return errors.New("user name must not be empty")
+	}
+	if password == "" {
+		return fmt.Errorf("user %s requires a non-empty password", name)
+	}
+	credential, err := NewScramCredential(password)
+	if err != nil {
+		return err
+	}
+	return m.update(ctx, func(st *catalogState) error {
+		if err := st.checkNameFree(name); err != nil {
+			return err
+		}
+		st.Users[name] = &User{
+			Name:       name,
+			Superuser:  superuser,
+			Credential: credential,
+			CreatedAt:  time.Now(),
+		}
+		return nil
+	})
+}
+
+// DropUser 删除用户及其角色成员关系和权限
+func (m *Manager) DropUser(ctx context.Context, name string, ifExists bool) error {
+	return m.update(ctx, func(st *catalogState) error {
+		user, ok := st.Users[name]
+		if !ok {
+			if ifExists {
+				return nil
+			}
+			return fmt.Errorf("user %s does not exist", name)
+		}
+		if user.Superuser && st.superuserCount() == 1 {
+			return fmt.Errorf("cannot drop user %s: it is the last superuser", name)
+		}
+		delete(st.Users, name)
+		st.removeGrantee(name)
+		return nil
+	})
+}
+
+// CreateRole 创建角色
+func (m *Manager) CreateRole(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("role name must not be empty")
+	}
+	return m.update(ctx, func(st *catalogState) error {
+		if err := st.checkNameFree(name); err != nil {
+			return err
+		}
+		st.Roles[name] = &Role{Name: name, CreatedAt: time.Now()}
+		return nil
+	})
+}
+
+// DropRole 删除角色及其成员关系和权限
+func (m *Manager) DropRole(ctx context.Context, name string, ifExists bool) error {
+	return m.update(ctx, func(st *catalogState) error {
+		if _, ok := st.Roles[name]; !ok {
+			if ifExists {
+				return nil
+			}
+			return fmt.Errorf("role %s does not exist", name)
+		}
+		delete(st.Roles, name)
+		st.removeGrantee(name)
+		members := st.Members[:0]
+		for _, mb := range st.Members {
+			if mb.Role != name {
+				members = append(members, mb)
+			}
+		}
+		st.Members = members
+		return nil
+	})
+}
+
+// GrantRole 将角色授予用户
+func (m *Manager) GrantRole(ctx context.Context, role, user string) error {
+	return m.update(ctx, func(st *catalogState) error {
+		if _, ok := st.Roles[role]; !ok {
+			return fmt.Errorf("role %s does not exist", role)
+		}
+		if _, ok := st.Users[user]; !ok {
+			return fmt.Errorf("user %s does not exist", user)
+		}
+		for _, mb := range st.Members {
+			if mb.Role == role && mb.Member == user {
+				return nil
+			}
+		}
+		st.Members = append(st.Members, Membership{Role: role, Member: user})
+		return nil
+	})
+}
+
+// RevokeRole 收回用户的角色
+func (m *Manager) RevokeRole(ctx context.Context, role, user string) error {
+	return m.update(ctx, func(st *catalogState) error {
+		for i, mb := range st.Members {
+			if mb.Role == role && mb.Member == user {
+				st.Members = append(st.Members[:i], st.Members[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("user %s is not a member of role %s", user, role)
+	})
+}
+
+// Grant 授予用户或角色 db.table 上的权限，db 和 table 可以为 "*"
+func (m *Manager) Grant(ctx context.Context, privileges []string, db, table, grantee string) error {
+	expanded, err := expandPrivileges(privileges)
+	if err != nil {
+		return err
+	}
+	return m.update(ctx, func(st *catalogState) error {
+		if !st.granteeExists(grantee) {
+			return fmt.Errorf("user or role %s does not exist", grantee)
+		}
+		for _, privilege := range expanded {
+			grant := Grant{Grantee: grantee, Database: db, Table: table, Privilege: privilege}
+			if !st.hasGrant(grant) {
+				st.Grants = append(st.Grants, grant)
+			}
+		}
+		return nil
+	})
+}
+
+// Revoke 收回用户或角色 db.table 上的权限 (只收回在同一对象上授予的权限)
+func (m *Manager) Revoke(ctx context.Context, privileges []string, db, table, grantee string) error {
+	expanded, err := expandPrivileges(privileges)
+	if err != nil {
+		return err
+	}
+	return m.update(ctx, func(st *catalogState) error {
+		if !st.granteeExists(grantee) {
+			return fmt.Errorf("user or role %s does not exist", grantee)
+		}
+		revoke := make(map[string]bool, len(expanded))
+		for _, privilege := range expanded {
+			revoke[privilege] = true
+		}
+		grants := st.Grants[:0]
+		for _, g := range st.Grants {
+			if g.Grantee == grantee && g.Database == db && g.Table == table && revoke[g.Privilege] {
+				continue
+			}
+			grants = append(grants, g)
+		}
+		st.Grants = grants
+		return nil
+	})
+}
+
+// Authenticate 校验用户名和密码
+func (m *Manager) Authenticate(name, password string) error {
+	credential, err := m.Credential(name)
+	if err != nil || !credential.Verify(password) {
+		return ErrAuthenticationFailed
+	}
+	return nil
+}
+
+// Credential 返回用户的 SCRAM 凭据
+func (m *Manager) Credential(name string) (*ScramCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	user, ok := m.state.Users[name]
+	if !ok || user.Credential == nil {
+		return nil, ErrAuthenticationFailed
+	}
+	return user.Credential, nil
+}
+
+// NewScramServer 创建使用本目录凭据的 SCRAM 服务端会话
+func (m *Manager) NewScramServer() *ScramServer {
+	return NewScramServer(m.Credential)
+}
+
+// UserExists 用户是否存在
+func (m *Manager) UserExists(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.state.Users[name]
+	return ok
+}
+
+// RoleExists 角色是否存在
+func (m *Manager) RoleExists(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.state.Roles[name]
+	return ok
+}
+
+// IsSuperuser 用户是否为超级用户
+func (m *Manager) IsSuperuser(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	user, ok := m.state.Users[name]
+	return ok && user.Superuser
+}
+
+// CheckSuperuser 要求用户为超级用户
+func (m *Manager) CheckSuperuser(name, action string) error {
+	if !m.IsSuperuser(name) {
+		return fmt.Errorf("permission denied: %s requires superuser", action)
+	}
+	return nil
+}
+
+// Check 检查用户 (直接授予或通过角色) 是否具有 db.table 上的权限，超级用户具有全部权限
+func (m *Manager) Check(name, privilege, db, table string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	user, ok := m.state.Users[name]
+	if !ok {
+		return fmt.Errorf("permission denied: user %s does not exist", name)
+	}
+	if user.Superuser {
+		return nil
+	}
+
+	grantees := map[string]bool{name: true}
+	for _, mb := range m.state.Members {
+		if mb.Member == name {
+			grantees[mb.Role] = true
+		}
+	}
+
+	required := []string{privilege}
+	if privilege == PrivilegeAll {
+		required = tablePrivileges
+	}
+	for _, p := range required {
+		granted := false
+		for _, g := range m.state.Grants {
+			if grantees[g.Grantee] && g.matches(p, db, table) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return fmt.Errorf("permission denied: user %s lacks %s privilege on %s.%s", name, privilege, db, table)
+		}
+	}
+	return nil
+}
+
+// Users 返回所有用户 (按名称排序)
+func (m *Manager) Users() []User {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	users := make([]User, 0, len(m.state.Users))
+	for _, u := range m.state.Users {
+		users = append(users, *u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+	return users
+}
+
+// Roles 返回所有角色 (按名称排序)
+func (m *Manager) Roles() []Role {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	roles := make([]Role, 0, len(m.state.Roles))
+	for _, r := range m.state.Roles {
+		roles = append(roles, *r)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles
+}
+
+// Members 返回所有角色成员关系
+func (m *Manager) Members() []Membership {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]Membership(nil), m.state.Members...)
+}
+
+// Grants 返回所有权限
+func (m *Manager) Grants() []Grant {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]Grant(nil), m.state.Grants...)
+}
+
+// checkNameFree 用户和角色共用一个命名空间
+func (st *catalogState) checkNameFree(name string) error {
+	if _, ok := st.Users[name]; ok {
+		return fmt.Errorf("user %s already exists", name)
+	}
+	if _, ok := st.Roles[name]; ok {
+		return fmt.Errorf("role %s already exists", name)
+	}
+	return nil
+}
+
+// granteeExists 用户或角色是否存在
+func (st *catalogState) granteeExists(name string) bool {
+	_, isUser := st.Users[name]
+	_, isRole := st.Roles[name]
+	return isUser || isRole
+}
+
+// hasGrant 是否已有相同的权限
+func (st *catalogState) hasGrant(grant Grant) bool {
+	for _, g := range st.Grants {
+		if g == grant {
+			return true
+		}
+	}
+	return false
+}
+
+// removeGrantee 删除授予 name 的权限以及 name 作为成员的角色关系
+func (st *catalogState) removeGrantee(name string) {
+	grants := st.Grants[:0]
+	for _, g := range st.Grants {
+		if g.Grantee != name {
+			grants = append(grants, g)
+		}
+	}
+	st.Grants = grants
+
+	members := st.Members[:0]
+	for _, mb := range st.Members {
+		if mb.Member != name {
+			members = append(members, mb)
+		}
+	}
+	st.Members = members
+}
+
+// superuserCount 超级用户数量
+func (st *catalogState) superuserCount() int {
+	count := 0
+	for _, u := range st.Users {
+		if u.Superuser {
+			count++
+		}
+	}
+	return count
+}
+
+// expandPrivileges 校验权限名并将 ALL 展开
+func expandPrivileges(privileges []string) ([]string, error) {
+	var expanded []string
+	seen := make(map[string]bool)
+	for _, p := range privileges {
+		p = strings.ToUpper(p)
+		var list []string
+		switch p {
+		case PrivilegeAll:
+			list = tablePrivileges
+		case PrivilegeSelect, PrivilegeInsert, PrivilegeUpdate, PrivilegeDelete:
+			list = []string{p}
+		default:
+			return nil, fmt.Errorf("unknown privilege %s", p)
+		}
+		for _, item := range list {
+			if !seen[item] {
+				seen[item] = true
+				expanded = append(expanded, item)
+			}
+		}
+	}
+	return expanded, nil
+}
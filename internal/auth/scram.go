@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SCRAM-SHA-256 (RFC 5802 / RFC 7677)
+//
+// 服务端只保存 StoredKey 和 ServerKey，不保存密码。文本协议的 LOGIN 握手用它校验明文密码，
+// 支持 SCRAM 的客户端 (驱动、命令行工具) 通过 ScramServer / ScramClient 完成质询-应答认证，密码不经过网络。
+
+// ScramMechanism SCRAM 机制名
+const ScramMechanism = "SCRAM-SHA-256"
+
+// DefaultScramIterations 生成凭据时 PBKDF2 的迭代次数
+const DefaultScramIterations = 4096
+
+// ScramCredential 用户的 SCRAM 凭据
+type ScramCredential struct {
+	Salt       []byte
+	Iterations int
+	StoredKey  []byte
+	ServerKey  []byte
+}
+
+// NewScramCredential 用随机 salt 为密码生成凭据
+func NewScramCredential(password string) (*ScramCredential, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return deriveCredential(password, salt, DefaultScramIterations), nil
+}
+
+// deriveCredential 由密码、salt 和迭代次数计算凭据
+func deriveCredential(password string, salt []byte, iterations int) *ScramCredential {
+	salted := saltedPassword(password, salt, iterations)
+	clientKey := hmacSHA256(salted, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	return &ScramCredential{
+		Salt:       salt,
+		Iterations: iterations,
+		StoredKey:  storedKey[:],
+		ServerKey:  hmacSHA256(salted, []byte("Server Key")),
+	}
+}
+
+// Verify 校验明文密码
+func (c *ScramCredential) Verify(password string) bool {
+	derived := deriveCredential(password, c.Salt, c.Iterations)
+	return subtle.ConstantTimeCompare(derived.StoredKey, c.StoredKey) == 1
+}
+
+// ScramServer 服务端的一次 SCRAM 认证会话
+//
+//	client-first: n,,n=<user>,r=<client nonce>
+//	server-first: r=<client nonce><server nonce>,s=<salt>,i=<iterations>
+//	client-final: c=biws,r=<nonce>,p=<client proof>
+//	server-final: v=<server signature>
+type ScramServer struct {
+	lookup func(user string) (*ScramCredential, error)
+
+	user            string
+	credential      *ScramCredential
+	known           bool // 用户是否存在；不存在时使用伪造的凭据完成握手，避免暴露用户是否存在
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	done            bool
+}
+
+// NewScramServer 创建 SCRAM 服务端会话，lookup 返回用户的凭据
+func NewScramServer(lookup func(user string) (*ScramCredential, error)) *ScramServer {
+	return &ScramServer{lookup: lookup}
+}
+
+// User 返回认证的用户名 (Final 成功后有效)
+func (s *ScramServer) User() string {
+	return s.user
+}
+
+// First 处理 client-first 消息，返回 server-first 消息
+func (s *ScramServer) First(clientFirst string) (string, error) {
+	parts := strings.SplitN(clientFirst, ",", 3)
+	if len(parts) != 3 {
+		return "", errors.New("scram: malformed client-first message")
+	}
+	switch {
+	case parts[0] == "n" || parts[0] == "y":
+	case strings.HasPrefix(parts[0], "p="):
+		return "", errors.New("scram: channel binding is not supported")
+	default:
+		return "", errors.New("scram: malformed GS2 header")
+	}
+	if parts[1] != "" {
+		return "", errors.New("scram: authorization identity is not supported")
+	}
+	s.gs2Header = parts[0] + "," + parts[1] + ","
+	s.clientFirstBare = parts[2]
+
+	attrs, err := parseScramAttributes(s.clientFirstBare)
+	if err != nil {
+		return "", err
+	}
+	user, clientNonce := attrs["n"], attrs["r"]
+	if user == "" || clientNonce == "" {
+		return "", errors.New("scram: client-first message requires user name and nonce")
+	}
+	s.user = unescapeScramName(user)
+
+	serverNonce, err := randomNonce()
+	if err != nil {
+		return "", err
+	}
+	s.nonce = clientNonce + serverNonce
+
+	s.credential, err = s.lookup(s.user)
+	s.known = err == nil && s.credential != nil
+	if !s.known {
+		// 伪造凭据：握手照常进行，在 Final 中统一返回认证失败
+		s.credential = deriveCredential(serverNonce, []byte(s.user), DefaultScramIterations)
+	}
+
+	s.serverFirst = fmt.Sprintf("r=%s,s=%s,i=%d", s.nonce,
+		base64.StdEncoding.EncodeToString(s.credential.Salt), s.credential.Iterations)
+	return s.serverFirst, nil
+}
+
+// Final 处理 client-final 消息并校验客户端证明，成功时返回 server-final 消息
+func (s *ScramServer) Final(clientFinal string) (string, error) {
+	if s.serverFirst == "" || s.done {
+		return "", errors.New("scram: unexpected client-final message")
+	}
+	s.done = true
+
+	idx := strings.LastIndex(clientFinal, ",p=")
+	if idx < 0 {
+		return "", errors.New("scram: client-final message requires a proof")
+	}
+	withoutProof := clientFinal[:idx]
+	attrs, err := parseScramAttributes(withoutProof)
+	if err != nil {
+		return "", err
+	}
+	if attrs["c"] != base64.StdEncoding.EncodeToString([]byte(s.gs2Header)) {
+		return "", errors.New("scram: channel binding mismatch")
+	}
+	if attrs["r"] != s.nonce {
+		return "", errors.New("scram: nonce mismatch")
+	}
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[idx+len(",p="):])
+	if err != nil || len(proof) != sha256.Size {
+		return "", errors.New("scram: malformed client proof")
+	}
+
+	authMessage := s.clientFirstBare + "," + s.serverFirst + "," + withoutProof
+	clientSignature := hmacSHA256(s.credential.StoredKey, []byte(authMessage))
+	clientKey := make([]byte, len(proof))
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if !s.known || subtle.ConstantTimeCompare(storedKey[:], s.credential.StoredKey) != 1 {
+		return "", ErrAuthenticationFailed
+	}
+
+	serverSignature := hmacSHA256(s.credential.ServerKey, []byte(authMessage))
+	return "v=" + base64.StdEncoding.EncodeToString(serverSignature), nil
+}
+
+// ScramClient 客户端的一次 SCRAM 认证会话
+type ScramClient struct {
+	user     string
+	password string
+
+	clientNonce     string
+	clientFirstBare string
+	authMessage     string
+	serverKey       []byte
+}
+
+// NewScramClient 创建 SCRAM 客户端会话
+func NewScramClient(user, password string) *ScramClient {
+	return &ScramClient{user: user, password: password}
+}
+
+// First 返回 client-first 消息
+func (c *ScramClient) First() (string, error) {
+	nonce, err := randomNonce()
+	if err != nil {
+		return "", err
+	}
+	c.clientNonce = nonce
+	c.clientFirstBare = fmt.Sprintf("n=%s,r=%s", escapeScramName(c.user), nonce)
+	return "n,," + c.clientFirstBare, nil
+}
+
+// Final 处理 server-first 消息，返回 client-final 消息
+func (c *ScramClient) Final(serverFirst string) (string, error) {
+	attrs, err := parseScramAttributes(serverFirst)
+	if err != nil {
+		return "", err
+	}
+	nonce := attrs["r"]
+	if !strings.HasPrefix(nonce, c.clientNonce) || len(nonce) == len(c.clientNonce) {
+		return "", errors.New("scram: server nonce does not extend the client nonce")
+	}
+	salt, err := base64.StdEncoding.DecodeString(attrs["s"])
+	if err != nil || len(salt) == 0 {
+		return "", errors.New("scram: malformed salt")
+	}
+	iterations, err := strconv.Atoi(attrs["i"])
+	if err != nil || iterations <= 0 {
+		return "", errors.New("scram: malformed iteration count")
+	}
+
+	salted := saltedPassword(c.password, salt, iterations)
+	clientKey := hmacSHA256(salted, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	c.serverKey = hmacSHA256(salted, []byte("Server Key"))
+
+	withoutProof := "c=" + base64.StdEncoding.EncodeToString([]byte("n,,")) + ",r=" + nonce
+	c.authMessage = c.clientFirstBare + "," + serverFirst + "," + withoutProof
+	clientSignature := hmacSHA256(storedKey[:], []byte(c.authMessage))
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	return withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof), nil
+}
+
+// Verify 校验 server-final 消息中的服务端签名
+func (c *ScramClient) Verify(serverFinal string) error {
+	attrs, err := parseScramAttributes(serverFinal)
+	if err != nil {
+		return err
+	}
+	if e, ok := attrs["e"]; ok {
+		return fmt.Errorf("scram: server error: %s", e)
+	}
+	signature, err := base64.StdEncoding.DecodeString(attrs["v"])
+	if err != nil {
+		return errors.New("scram: malformed server signature")
+	}
+	expected := hmacSHA256(c.serverKey, []byte(c.authMessage))
+	if subtle.ConstantTimeCompare(signature, expected) != 1 {
+		return errors.New("scram: server signature mismatch")
+	}
+	return nil
+}
+
+// parseScramAttributes 解析 "a=v,b=v" 形式的 SCRAM 属性列表
+func parseScramAttributes(msg string) (map[string]string, error) {
+	attrs := make(map[string]string)
+	for _, part := range strings.Split(msg, ",") {
+		if len(part) < 2 || part[1] != '=' {
+			return nil, fmt.Errorf("scram: malformed attribute '%s'", part)
+		}
+		attrs[part[:1]] = part[2:]
+	}
+	return attrs, nil
+}
+
+// escapeScramName 转义用户名中的 ',' 和 '='
+func escapeScramName(name string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(name)
+}
+
+// unescapeScramName 还原转义的用户名
+func unescapeScramName(name string) string {
+	return strings.NewReplacer("=2C", ",", "=3D", "=").Replace(name)
+}
+
+// randomNonce 生成随机 nonce (可打印字符，不含 ',')
+func randomNonce() (string, error) {
+	buf := make([]byte, 18)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return base64.RawStdEncoding.EncodeToString(buf), nil
+}
+
+// hmacSHA256 计算 HMAC-SHA-256
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// saltedPassword 计算 SaltedPassword = PBKDF2-HMAC-SHA-256(password, salt, iterations)，输出一个块 (32 字节)
+func saltedPassword(password string, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha256.New, []byte(password))
+	block := make([]byte, 4)
+	binary.BigEndian.PutUint32(block, 1)
+	mac.Write(salt)
+	mac.Write(block)
+	u := mac.Sum(nil)
+
+	result := make([]byte, len(u))
+	copy(result, u)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(u[:0])
+		for j := range result {
+			result[j] ^= u[j]
+		}
+	}
+	return result
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+)
+
+// 保存用户和权限目录的系统表，与 sys.db_metadata 同在 sys 库中，修改通过 Delta Log 提交
+const (
+	UsersTable       = "users"
+	RolesTable       = "roles"
+	RoleMembersTable = "role_members"
+	PrivilegesTable  = "privileges"
+)
+
+// Table 保存用户和权限目录的一张系统表 (storage.SystemTable)
+type Table interface {
+	// Load 读取表的全部数据，表尚未创建时返回 nil
+	Load() ([]arrow.Record, error)
+	// Replace 用 record 替换表的全部数据，提交用户取自 ctx
+	Replace(ctx context.Context, record arrow.Record) error
+}
+
+// TableOpener 按名称和结构打开 sys 库中的系统表
+type TableOpener func(name string, schema *arrow.Schema) Table
+
+// saveOrder 保存目录时写入各表的顺序：先写成员关系和权限，再写用户和角色
+// 删除用户或角色时即使中途失败，也只会留下没有权限的用户或角色，新建的同名用户不会继承旧的权限
+var saveOrder = []string{RoleMembersTable, PrivilegesTable, UsersTable, RolesTable}
+
+// TableSchemas 各系统表的存储结构
+// sys.users 额外保存 SCRAM 凭据 (base64)，查询 sys.users 时不返回凭据列
+var TableSchemas = map[string]*arrow.Schema{
+	UsersTable: arrow.NewSchema([]arrow.Field{
+		{Name: "user_name", Type: arrow.BinaryTypes.String},
+		{Name: "superuser", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "created_at", Type: arrow.PrimitiveTypes.Int64}, // Unix 毫秒
+		{Name: "salt", Type: arrow.BinaryTypes.String},
+		{Name: "iterations", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "stored_key", Type: arrow.BinaryTypes.String},
+		{Name: "server_key", Type: arrow.BinaryTypes.String},
+	}, nil),
+	RolesTable: arrow.NewSchema([]arrow.Field{
+		{Name: "role_name", Type: arrow.BinaryTypes.String},
+		{Name: "created_at", Type: arrow.PrimitiveTypes.Int64},
+	}, nil),
+	RoleMembersTable: arrow.NewSchema([]arrow.Field{
+		{Name: "role_name", Type: arrow.BinaryTypes.String},
+		{Name: "member_name", Type: arrow.BinaryTypes.String},
+	}, nil),
+	PrivilegesTable: arrow.NewSchema([]arrow.Field{
+		{Name: "grantee", Type: arrow.BinaryTypes.String},
+		{Name: "db_name", Type: arrow.BinaryTypes.String},
+		{Name: "table_name", Type: arrow.BinaryTypes.String},
+		{Name: "privilege", Type: arrow.BinaryTypes.String},
+	}, nil),
+}
+
+// loadState 从系统表读取用户和权限目录
+func loadState(tables map[string]Table) (*catalogState, error) {
+	st := newCatalogState()
+	for _, name := range saveOrder {
+		records, err := tables[name].Load()
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			err = st.loadRecord(name, record)
+			record.Release()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode sys.%s: %w", name, err)
+			}
+		}
+	}
+	return st, nil
+}
+
+// section 返回目录中保存在表 name 中的部分
+func (st *catalogState) section(name string) interface{} {
+	switch name {
+	case UsersTable:
+		return st.Users
+	case RolesTable:
+		return st.Roles
+	case RoleMembersTable:
+		return st.Members
+	default:
+		return st.Grants
+	}
+}
+
+// record 将目录中保存在表 name 中的部分转换为 Arrow Record
+func (st *catalogState) record(name string) arrow.Record {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), TableSchemas[name])
+	defer builder.Release()
+
+	str := func(i int) *array.StringBuilder { return builder.Field(i).(*array.StringBuilder) }
+	switch name {
+	case UsersTable:
+		names := make([]string, 0, len(st.Users))
+		for name := range st.Users {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			user := st.Users[name]
+			credential := user.Credential
+			if credential == nil {
+				credential = &ScramCredential{}
+			}
+			str(0).Append(user.Name)
+			builder.Field(1).(*array.BooleanBuilder).Append(user.Superuser)
+			builder.Field(2).(*array.Int64Builder).Append(user.CreatedAt.UnixMilli())
+			str(3).Append(base64.StdEncoding.EncodeToString(credential.Salt))
+			builder.Field(4).(*array.Int64Builder).Append(int64(credential.Iterations))
+			str(5).Append(base64.StdEncoding.EncodeToString(credential.StoredKey))
+			str(6).Append(base64.StdEncoding.EncodeToString(credential.ServerKey))
+		}
+	case RolesTable:
+		names := make([]string, 0, len(st.Roles))
+		for name := range st.Roles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			str(0).Append(name)
+			builder.Field(1).(*array.Int64Builder).Append(st.Roles[name].CreatedAt.UnixMilli())
+		}
+	case RoleMembersTable:
+		for _, mb := range st.Members {
+			str(0).Append(mb.Role)
+			str(1).Append(mb.Member)
+		}
+	case PrivilegesTable:
+		for _, g := range st.Grants {
+			str(0).Append(g.Grantee)
+			str(1).Append(g.Database)
+			str(2).Append(g.Table)
+			str(3).Append(g.Privilege)
+		}
+	}
+	return builder.NewRecord()
+}
+
+// loadRecord 将表 name 的一批数据加入目录
+func (st *catalogState) loadRecord(name string, record arrow.Record) error {
+	schema := TableSchemas[name]
+	if int(record.NumCols()) != schema.NumFields() {
+		return fmt.Errorf("expected %d columns, got %d", schema.NumFields(), record.NumCols())
+	}
+	for i, field := range schema.Fields() {
+		if !arrow.TypeEqual(record.Column(i).DataType(), field.Type) {
+			return fmt.Errorf("column %s has type %s, expected %s", field.Name, record.Column(i).DataType(), field.Type)
+		}
+	}
+	str := func(col, row int) string { return record.Column(col).(*array.String).Value(row) }
+	num := func(col, row int) int64 { return record.Column(col).(*array.Int64).Value(row) }
+	for row := 0; row < int(record.NumRows()); row++ {
+		switch name {
+		case UsersTable:
+			user := &User{
+				Name:      str(0, row),
+				Superuser: record.Column(1).(*array.Boolean).Value(row),
+				CreatedAt: time.UnixMilli(num(2, row)),
+			}
+			credential, err := decodeCredential(str(3, row), num(4, row), str(5, row), str(6, row))
+			if err != nil {
+				return fmt.Errorf("user %s: %w", user.Name, err)
+			}
+			user.Credential = credential
+			st.Users[user.Name] = user
+		case RolesTable:
+			st.Roles[str(0, row)] = &Role{Name: str(0, row), CreatedAt: time.UnixMilli(num(1, row))}
+		case RoleMembersTable:
+			st.Members = append(st.Members, Membership{Role: str(0, row), Member: str(1, row)})
+		case PrivilegesTable:
+			st.Grants = append(st.Grants, Grant{Grantee: str(0, row), Database: str(1, row), Table: str(2, row), Privilege: str(3, row)})
+		}
+	}
+	return nil
+}
+
+// decodeCredential 解码 sys.users 中保存的 SCRAM 凭据，salt 为空表示没有凭据
+func decodeCredential(salt string, iterations int64, storedKey, serverKey string) (*ScramCredential, error) {
+	if salt == "" {
+		return nil, nil
+	}
+	credential := &ScramCredential{Iterations: int(iterations)}
+	var err error
+	if credential.Salt, err = base64.StdEncoding.DecodeString(salt); err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+	if credential.StoredKey, err = base64.StdEncoding.DecodeString(storedKey); err != nil {
+		return nil, fmt.Errorf("invalid stored key: %w", err)
+	}
+	if credential.ServerKey, err = base64.StdEncoding.DecodeString(serverKey); err != nil {
+		return nil, fmt.Errorf("invalid server key: %w", err)
+	}
+	return credential, nil
+}
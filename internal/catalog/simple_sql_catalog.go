@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -218,6 +219,8 @@ func (c *SimpleSQLCatalog) ensureSystemEntitiesExist() {
 		tableMetadataSchema := arrow.NewSchema([]arrow.Field{
 			{Name: "db_name", Type: arrow.BinaryTypes.String},
 			{Name: "table_name", Type: arrow.BinaryTypes.String},
+			{Name: "table_type", Type: arrow.BinaryTypes.String},
+			{Name: "location", Type: arrow.BinaryTypes.String},
 		}, nil)
 		c.tables["sys"]["table_metadata"] = &TableInfo{
 			Database: "sys",
@@ -266,6 +269,7 @@ func (c *SimpleSQLCatalog) ensureSystemEntitiesExist() {
 			{Name: "db_name", Type: arrow.BinaryTypes.String},
 			{Name: "table_name", Type: arrow.BinaryTypes.String},
 			{Name: "file_path", Type: arrow.BinaryTypes.String},
+			{Name: "user_id", Type: arrow.BinaryTypes.String},
 		}, nil)
 		c.tables["sys"]["delta_log"] = &TableInfo{
 			Database: "sys",
@@ -289,6 +293,32 @@ func (c *SimpleSQLCatalog) ensureSystemEntitiesExist() {
 			Schema:   tableFilesSchema,
 		}
 	}
+
+	if c.tables["sys"]["maintenance_jobs"] == nil {
+		c.tables["sys"]["maintenance_jobs"] = &TableInfo{
+			Database: "sys",
+			Name:     "maintenance_jobs",
+			Schema:   MaintenanceJobsSchema,
+		}
+	}
+
+	if c.tables["sys"]["running_queries"] == nil {
+		c.tables["sys"]["running_queries"] = &TableInfo{
+			Database: "sys",
+			Name:     "running_queries",
+			Schema:   RunningQueriesSchema,
+		}
+	}
+
+	for name, schema := range AccessControlSchemas {
+		if c.tables["sys"][name] == nil {
+			c.tables["sys"][name] = &TableInfo{
+				Database: "sys",
+				Name:     name,
+				Schema:   schema,
+			}
+		}
+	}
 }
 
 // createSystemTables 创建系统表
@@ -302,6 +332,8 @@ func (c *SimpleSQLCatalog) createSystemTables() error {
 	tableMetadataSchema := arrow.NewSchema([]arrow.Field{
 		{Name: "db_name", Type: arrow.BinaryTypes.String},
 		{Name: "table_name", Type: arrow.BinaryTypes.String},
+		{Name: "table_type", Type: arrow.BinaryTypes.String},
+		{Name: "location", Type: arrow.BinaryTypes.String},
 	}, nil)
 
 	// 创建 columns_metadata 系统表的 schema
@@ -332,6 +364,7 @@ func (c *SimpleSQLCatalog) createSystemTables() error {
 		{Name: "db_name", Type: arrow.BinaryTypes.String},
 		{Name: "table_name", Type: arrow.BinaryTypes.String},
 		{Name: "file_path", Type: arrow.BinaryTypes.String},
+		{Name: "user_id", Type: arrow.BinaryTypes.String},
 	}, nil)
 
 	// 创建 table_files 系统表的 schema
@@ -381,9 +414,77 @@ func (c *SimpleSQLCatalog) createSystemTables() error {
 		Schema:   tableFilesSchema,
 	}
 
+	c.tables["sys"]["maintenance_jobs"] = &TableInfo{
+		Database: "sys",
+		Name:     "maintenance_jobs",
+		Schema:   MaintenanceJobsSchema,
+	}
+
+	c.tables["sys"]["running_queries"] = &TableInfo{
+		Database: "sys",
+		Name:     "running_queries",
+		Schema:   RunningQueriesSchema,
+	}
+
+	for name, schema := range AccessControlSchemas {
+		c.tables["sys"][name] = &TableInfo{
+			Database: "sys",
+			Name:     name,
+			Schema:   schema,
+		}
+	}
+
 	return nil
 }
 
+// MaintenanceJobsSchema sys.maintenance_jobs 系统表的 schema (后台维护任务的执行历史)
+var MaintenanceJobsSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "job_id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "job_type", Type: arrow.BinaryTypes.String},
+	{Name: "db_name", Type: arrow.BinaryTypes.String},
+	{Name: "table_name", Type: arrow.BinaryTypes.String},
+	{Name: "status", Type: arrow.BinaryTypes.String},
+	{Name: "attempt", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "started_at", Type: arrow.BinaryTypes.String},
+	{Name: "duration_ms", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "message", Type: arrow.BinaryTypes.String},
+	{Name: "next_run_at", Type: arrow.BinaryTypes.String},
+}, nil)
+
+// RunningQueriesSchema sys.running_queries 系统表的 schema (正在执行的语句)
+var RunningQueriesSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "query_id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "session_id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "user_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "query", Type: arrow.BinaryTypes.String},
+	{Name: "started_at", Type: arrow.BinaryTypes.String},
+	{Name: "duration_ms", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "rows_produced", Type: arrow.PrimitiveTypes.Int64},
+}, nil)
+
+// AccessControlSchemas 用户、角色和权限系统表的 schema (表名 -> schema)
+var AccessControlSchemas = map[string]*arrow.Schema{
+	"users": arrow.NewSchema([]arrow.Field{
+		{Name: "user_name", Type: arrow.BinaryTypes.String},
+		{Name: "superuser", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "created_at", Type: arrow.BinaryTypes.String},
+	}, nil),
+	"roles": arrow.NewSchema([]arrow.Field{
+		{Name: "role_name", Type: arrow.BinaryTypes.String},
+		{Name: "created_at", Type: arrow.BinaryTypes.String},
+	}, nil),
+	"role_members": arrow.NewSchema([]arrow.Field{
+		{Name: "role_name", Type: arrow.BinaryTypes.String},
+		{Name: "member_name", Type: arrow.BinaryTypes.String},
+	}, nil),
+	"privileges": arrow.NewSchema([]arrow.Field{
+		{Name: "grantee", Type: arrow.BinaryTypes.String},
+		{Name: "db_name", Type: arrow.BinaryTypes.String},
+		{Name: "table_name", Type: arrow.BinaryTypes.String},
+		{Name: "privilege", Type: arrow.BinaryTypes.String},
+	}, nil),
+}
+
 // CreateDatabase 通过SQL创建数据库
 func (c *SimpleSQLCatalog) CreateDatabase(name string) error {
 	logger.WithComponent("catalog").Info("Creating database",
@@ -454,12 +555,24 @@ func (c *SimpleSQLCatalog) DropDatabase(name string) error {
 	// 更新内存缓存
 	delete(c.databases, name)
 	delete(c.tables, name)
+	delete(c.indexes, name)
 
 	return nil
 }
 
 // CreateTable 创建表
 func (c *SimpleSQLCatalog) CreateTable(database string, tableMeta TableMeta) error {
+	return c.CreateTableContext(context.Background(), database, tableMeta)
+}
+
+// contextTableStore 建表、删表时从 ctx 获取提交用户的存储引擎 (ParquetEngine)
+type contextTableStore interface {
+	CreateTableContext(ctx context.Context, db, table string, schema *arrow.Schema) error
+	DropTableContext(ctx context.Context, db, table string) error
+}
+
+// CreateTableContext 创建表，Delta Log 记录 ctx 中的提交用户 (storage.WithCommitUser)
+func (c *SimpleSQLCatalog) CreateTableContext(ctx context.Context, database string, tableMeta TableMeta) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
@@ -487,7 +600,12 @@ func (c *SimpleSQLCatalog) CreateTable(database string, tableMeta TableMeta) err
 	// v2.0: Persist schema to storage engine (Delta Log)
 	// This is CRITICAL for schema recovery after restart
 	if c.storageEngine != nil {
-		err := c.storageEngine.CreateTable(database, tableMeta.Table, tableMeta.Schema)
+		var err error
+		if store, ok := c.storageEngine.(contextTableStore); ok {
+			err = store.CreateTableContext(ctx, database, tableMeta.Table, tableMeta.Schema)
+		} else {
+			err = c.storageEngine.CreateTable(database, tableMeta.Table, tableMeta.Schema)
+		}
 		if err != nil {
 			logger.WithComponent("catalog").Error("Failed to persist table to storage engine",
 				zap.String("database", database),
@@ -724,6 +842,11 @@ func (c *SimpleSQLCatalog) deserializeSchemaFromJSON(schemaJSON string) *arrow.S
 
 // DropTable 删除表
 func (c *SimpleSQLCatalog) DropTable(database, table string) error {
+	return c.DropTableContext(context.Background(), database, table)
+}
+
+// DropTableContext 删除表，Delta Log 记录 ctx 中的提交用户
+func (c *SimpleSQLCatalog) DropTableContext(ctx context.Context, database, table string) error {
 	logger.WithComponent("catalog").Info("Dropping table",
 		zap.String("database", database),
 		zap.String("table", table))
@@ -745,7 +868,12 @@ func (c *SimpleSQLCatalog) DropTable(database, table string) error {
 
 	// v2.0: Persist table deletion to storage engine (marks all files as REMOVE in Delta Log)
 	if c.storageEngine != nil {
-		err := c.storageEngine.DropTable(database, table)
+		var err error
+		if store, ok := c.storageEngine.(contextTableStore); ok {
+			err = store.DropTableContext(ctx, database, table)
+		} else {
+			err = c.storageEngine.DropTable(database, table)
+		}
 		if err != nil {
 			logger.WithComponent("catalog").Error("Failed to persist table deletion to storage engine",
 				zap.String("database", database),
@@ -758,8 +886,9 @@ func (c *SimpleSQLCatalog) DropTable(database, table string) error {
 			zap.String("table", table))
 	}
 
-	// 更新内存缓存
+	// 更新内存缓存 (表的索引随表一起删除)
 	delete(c.tables[database], table)
+	delete(c.indexes[database], table)
 
 	logger.WithComponent("catalog").Info("Table dropped successfully",
 		zap.String("table", table),
@@ -841,6 +970,11 @@ type IndexInfo struct {
 
 // CreateIndex 创建索引
 func (c *SimpleSQLCatalog) CreateIndex(indexMeta IndexMeta) error {
+	return c.CreateIndexContext(context.Background(), indexMeta)
+}
+
+// CreateIndexContext 创建索引，Delta Log 记录 ctx 中的提交用户
+func (c *SimpleSQLCatalog) CreateIndexContext(ctx context.Context, indexMeta IndexMeta) error {
 	logger.WithComponent("catalog").Info("Creating index",
 		zap.String("database", indexMeta.Database),
 		zap.String("table", indexMeta.Table),
@@ -916,7 +1050,7 @@ func (c *SimpleSQLCatalog) CreateIndex(indexMeta IndexMeta) error {
 			indexMetaMap["index_type"] = indexMeta.IndexType
 
 			// 持久化索引元数据到 Delta Log
-			err := provider.GetDeltaLog().AppendIndexMetadata(tableID, indexMeta.Name, indexMetaMap)
+			err := provider.GetDeltaLog().WithUser(storage.CommitUser(ctx)).AppendIndexMetadata(tableID, indexMeta.Name, indexMetaMap)
 			if err != nil {
 				logger.WithComponent("catalog").Error("Failed to persist index metadata to Delta Log",
 					zap.String("database", indexMeta.Database),
@@ -941,6 +1075,11 @@ func (c *SimpleSQLCatalog) CreateIndex(indexMeta IndexMeta) error {
 
 // DropIndex 删除索引
 func (c *SimpleSQLCatalog) DropIndex(database, table, indexName string) error {
+	return c.DropIndexContext(context.Background(), database, table, indexName)
+}
+
+// DropIndexContext 删除索引，Delta Log 记录 ctx 中的提交用户
+func (c *SimpleSQLCatalog) DropIndexContext(ctx context.Context, database, table, indexName string) error {
 	logger.WithComponent("catalog").Info("Dropping index",
 		zap.String("database", database),
 		zap.String("table", table),
@@ -967,7 +1106,7 @@ func (c *SimpleSQLCatalog) DropIndex(database, table, indexName string) error {
 			tableID := fmt.Sprintf("%s.%s", database, table)
 
 			// 持久化索引删除操作到 Delta Log
-			err := provider.GetDeltaLog().RemoveIndexMetadata(tableID, indexName)
+			err := provider.GetDeltaLog().WithUser(storage.CommitUser(ctx)).RemoveIndexMetadata(tableID, indexName)
 			if err != nil {
 				logger.WithComponent("catalog").Error("Failed to persist index deletion to Delta Log",
 					zap.String("database", database),
@@ -1066,6 +1205,9 @@ func (c *SimpleSQLCatalog) SetStorageEngine(engine storage.StorageEngine) {
 // 5. sys.index_metadata - 从indexes map生成
 // 6. sys.delta_log - 从Delta Log实时获取
 // 7. sys.table_files - 从Delta Log快照获取
+// 8. sys.maintenance_jobs - 从后台维护调度器的执行历史获取
+// 9. sys.users / sys.roles / sys.role_members / sys.privileges - 从用户和权限目录获取
+// 10. sys.running_queries - 从正在执行的语句登记表获取
 //
 // 这种设计的优势：
 // - 系统表数据始终是最新的（实时查询）
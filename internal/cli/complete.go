@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+// keywords 补全的 SQL 关键字
+var keywords = []string{
+	"ADD", "ALL", "ALTER", "ANALYZE", "AND", "AS", "ASC", "BEGIN", "BETWEEN", "BY",
+	"CASCADE", "COLUMN", "COLUMNS", "COMMIT", "COUNT", "CREATE", "DATABASE", "DATABASES",
+	"DEALLOCATE", "DELETE", "DESC", "DESCRIBE", "DISTINCT", "DROP", "EXECUTE", "EXISTS",
+	"EXPLAIN", "FALSE", "FROM", "FULL", "GRANT", "GROUP", "HAVING", "IF", "IN", "INDEX",
+	"INNER", "INSERT", "INTO", "IS", "JOIN", "KILL", "LEFT", "LIKE", "LIMIT", "NOT",
+	"NULL", "OFFSET", "ON", "OR", "ORDER", "OUTER", "PASSWORD", "PREPARE", "PRIMARY",
+	"PROCESSLIST", "RESET", "REVOKE", "RIGHT", "ROLE", "ROLLBACK", "SELECT", "SET",
+	"SHOW", "START", "TABLE", "TABLES", "TO", "TRANSACTION", "TRUE", "UNIQUE", "UPDATE",
+	"USE", "USER", "USING", "VALUES", "VARIABLES", "WHERE",
+	"INTEGER", "INT", "BIGINT", "VARCHAR", "DOUBLE", "FLOAT", "BOOLEAN", "TIMESTAMP", "DATE",
+	"SUM", "AVG", "MIN", "MAX",
+}
+
+// Completer 按输入补全关键字、元命令、库名、表名和列名
+// 库、表、列从 sys.columns_metadata 读取，执行 DDL 和 USE 后调用 Refresh 更新
+type Completer struct {
+	databases []string
+	tables    map[string][]string // 库名 -> 表名
+	columns   []string
+}
+
+// NewCompleter 创建只有关键字和元命令的补全器
+func NewCompleter() *Completer {
+	return &Completer{tables: make(map[string][]string)}
+}
+
+// Refresh 从系统表重新加载库、表和列名，查询失败时保留原来的名字 (例如没有权限读取系统表)
+func (c *Completer) Refresh(ctx context.Context, conn *minidb.Conn) {
+	reader, err := conn.QueryArrow(ctx, "SELECT db_name, table_name, column_name FROM sys.columns_metadata")
+	if err != nil {
+		return
+	}
+	defer reader.Release()
+
+	databases := make(map[string]bool)
+	tables := make(map[string]map[string]bool)
+	columns := make(map[string]bool)
+	for reader.Next() {
+		record := reader.Record()
+		if record.NumCols() < 3 {
+			continue
+		}
+		dbCol, ok1 := record.Column(0).(*array.String)
+		tableCol, ok2 := record.Column(1).(*array.String)
+		columnCol, ok3 := record.Column(2).(*array.String)
+		if !ok1 || !ok2 || !ok3 {
+			continue
+		}
+		for i := 0; i < int(record.NumRows()); i++ {
+			db, table := dbCol.Value(i), tableCol.Value(i)
+			databases[db] = true
+			if tables[db] == nil {
+				tables[db] = make(map[string]bool)
+			}
+			tables[db][table] = true
+			columns[columnCol.Value(i)] = true
+		}
+	}
+	if reader.Err() != nil {
+		return
+	}
+
+	c.databases = sortedKeys(databases)
+	c.tables = make(map[string][]string, len(tables))
+	for db, names := range tables {
+		c.tables[db] = sortedKeys(names)
+	}
+	c.columns = sortedKeys(columns)
+}
+
+// Complete 返回光标前文本中最后一个词的起始位置和候选项
+// 以 '\' 开头的行补全元命令；"db." 之后补全该库的表名；关键字按输入的大小写给出
+func (c *Completer) Complete(line string) (start int, candidates []string) {
+	start = len(line)
+	for start > 0 && isWordChar(line[start-1]) {
+		start--
+	}
+	word := line[start:]
+
+	if strings.HasPrefix(strings.TrimSpace(line), "\\") {
+		if start > 0 && line[start-1] == '\\' && strings.TrimSpace(line[:start-1]) == "" {
+			start--
+			return start, matching(metaCommandNames(), "\\"+word)
+		}
+		return start, nil
+	}
+	if word == "" {
+		return start, nil
+	}
+
+	if dot := strings.LastIndexByte(word, '.'); dot >= 0 {
+		db, prefix := word[:dot], word[dot+1:]
+		for _, table := range matching(c.tables[db], prefix) {
+			candidates = append(candidates, db+"."+table)
+		}
+		return start, candidates
+	}
+
+	seen := make(map[string]bool)
+	add := func(names []string) {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				candidates = append(candidates, name)
+			}
+		}
+	}
+	lower := strings.ToLower(word) == word
+	for _, keyword := range matching(keywords, strings.ToUpper(word)) {
+		if lower {
+			keyword = strings.ToLower(keyword)
+		}
+		add([]string{keyword})
+	}
+	for _, db := range c.databases {
+		add(matching(c.tables[db], word))
+	}
+	add(matching(c.databases, word))
+	add(matching(c.columns, word))
+	sort.Strings(candidates)
+	return start, candidates
+}
+
+// isWordChar 标识符中的字符 (包括限定名中的 '.')
+func isWordChar(c byte) bool {
+	return c == '_' || c == '.' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// matching 返回以 prefix 开头的名字
+func matching(names []string, prefix string) []string {
+	var matched []string
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+// sortedKeys 返回排序后的集合元素
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// commonPrefix 返回候选项的公共前缀
+func commonPrefix(candidates []string) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	prefix := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
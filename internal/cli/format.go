@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/yyun543/minidb/internal/executor"
+)
+
+// 输出格式
+const (
+	FormatTable    = executor.ResultFormatTable
+	FormatCSV      = executor.ResultFormatCSV
+	FormatJSON     = executor.ResultFormatJSON
+	FormatVertical = "vertical"
+)
+
+// Formats 支持的输出格式
+var Formats = []string{FormatTable, FormatCSV, FormatJSON, FormatVertical}
+
+// ValidFormat 检查输出格式是否支持
+func ValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteResult 读取结果集并按 format 写入 w
+// 没有列的结果 (DDL、DML 等) 输出 "OK"
+func WriteResult(w io.Writer, reader array.RecordReader, format string) error {
+	schema := reader.Schema()
+	var records []arrow.Record
+	defer func() {
+		for _, record := range records {
+			record.Release()
+		}
+	}()
+	for reader.Next() {
+		record := reader.Record()
+		record.Retain()
+		records = append(records, record)
+	}
+	if err := reader.Err(); err != nil {
+		return err
+	}
+
+	if schema.NumFields() == 0 {
+		_, err := fmt.Fprintln(w, "OK")
+		return err
+	}
+
+	headers := make([]string, schema.NumFields())
+	for i, field := range schema.Fields() {
+		headers[i] = field.Name
+	}
+
+	var output string
+	if format == FormatVertical {
+		output = formatVertical(headers, records)
+	} else {
+		output = executor.FormatResult(headers, records, format, time.Local)
+	}
+	if !strings.HasSuffix(output, "\n") {
+		output += "\n"
+	}
+	_, err := io.WriteString(w, output)
+	return err
+}
+
+// formatVertical 每列一行显示 (类似 MySQL 的 \G)，适合列很多的结果
+func formatVertical(headers []string, records []arrow.Record) string {
+	width := 0
+	for _, header := range headers {
+		width = max(width, len(header))
+	}
+
+	var sb strings.Builder
+	rowCount := 0
+	for _, record := range records {
+		for i := 0; i < int(record.NumRows()); i++ {
+			rowCount++
+			sb.WriteString(fmt.Sprintf("*************************** %d. row ***************************\n", rowCount))
+			for j, header := range headers {
+				value := executor.FormatValue(record.Column(j), i, time.Local)
+				if value == nil {
+					value = "NULL"
+				}
+				sb.WriteString(fmt.Sprintf("%*s: %v\n", width, header, value))
+			}
+		}
+	}
+
+	if rowCount == 0 {
+		return "Empty set"
+	}
+	sb.WriteString(fmt.Sprintf("%d rows in set\n", rowCount))
+	return sb.String()
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultHistorySize 历史文件保留的最大条数
+const DefaultHistorySize = 1000
+
+// History 命令历史，每条一行 (多行语句合并为一行)
+type History struct {
+	entries []string
+	path    string // 为空时不保存
+	size    int
+}
+
+// DefaultHistoryFile 返回默认的历史文件 ~/.minidb_history
+func DefaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".minidb_history")
+}
+
+// LoadHistory 从文件加载历史，文件不存在时返回空历史
+func LoadHistory(path string, size int) *History {
+	h := &History{path: path, size: size}
+	if path == "" {
+		return h
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return h
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+	h.trim()
+	return h
+}
+
+// Add 添加一条历史，与上一条相同时忽略
+func (h *History) Add(entry string) {
+	entry = strings.Join(strings.Fields(entry), " ")
+	if entry == "" || (len(h.entries) > 0 && h.entries[len(h.entries)-1] == entry) {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	h.trim()
+}
+
+// Entries 返回历史，最早的在前
+func (h *History) Entries() []string {
+	return h.entries
+}
+
+// Save 把历史写回文件 (仅当前用户可读写)
+func (h *History) Save() error {
+	if h.path == "" {
+		return nil
+	}
+	var sb strings.Builder
+	for _, entry := range h.entries {
+		sb.WriteString(entry)
+		sb.WriteByte('\n')
+	}
+	return os.WriteFile(h.path, []byte(sb.String()), 0600)
+}
+
+// trim 只保留最近的 size 条
+func (h *History) trim() {
+	if h.size > 0 && len(h.entries) > h.size {
+		h.entries = append([]string(nil), h.entries[len(h.entries)-h.size:]...)
+	}
+}
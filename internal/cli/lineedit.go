@@ -0,0 +1,266 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrInterrupt 用户按下 Ctrl-C
+var ErrInterrupt = errors.New("interrupted")
+
+// 控制键
+const (
+	keyCtrlA     = 1
+	keyCtrlB     = 2
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlF     = 6
+	keyTab       = 9
+	keyCtrlK     = 11
+	keyCtrlL     = 12
+	keyEnter     = 13
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlU     = 21
+	keyCtrlW     = 23
+	keyEscape    = 27
+	keyBackspace = 127
+)
+
+// LineEditor 终端行编辑：光标移动、历史 (上下键)、Tab 补全
+// 支持 Emacs 风格的快捷键：Ctrl-A/E 行首/行尾，Ctrl-B/F 左右移动，Ctrl-K/U 删除到行尾/行首，
+// Ctrl-W 删除前一个词，Ctrl-P/N 上一条/下一条历史，Ctrl-L 清屏
+type LineEditor struct {
+	fd        int
+	in        *bufio.Reader
+	out       io.Writer
+	history   *History
+	completer *Completer
+
+	prompt string
+	buf    []rune
+	pos    int
+}
+
+// NewLineEditor 创建读取 stdin 的行编辑器
+func NewLineEditor(out io.Writer, history *History, completer *Completer) *LineEditor {
+	return &LineEditor{
+		fd:        int(os.Stdin.Fd()),
+		in:        bufio.NewReader(os.Stdin),
+		out:       out,
+		history:   history,
+		completer: completer,
+	}
+}
+
+// ReadLine 显示提示符并读取一行
+// Ctrl-C 返回 ErrInterrupt，空行上的 Ctrl-D 返回 io.EOF
+func (e *LineEditor) ReadLine(prompt string) (string, error) {
+	restore, err := makeRaw(e.fd)
+	if err != nil {
+		// 无法切换到原始模式时按普通输入读取
+		fmt.Fprint(e.out, prompt)
+		line, err := e.in.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+	defer restore()
+
+	e.prompt, e.buf, e.pos = prompt, nil, 0
+	entries := e.history.Entries()
+	historyIndex := len(entries)
+	current := "" // 浏览历史前正在编辑的内容
+	e.refresh()
+
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		switch r {
+		case keyEnter, '\n':
+			fmt.Fprint(e.out, "\n")
+			return string(e.buf), nil
+		case keyCtrlC:
+			fmt.Fprint(e.out, "^C\n")
+			return "", ErrInterrupt
+		case keyCtrlD:
+			if len(e.buf) == 0 {
+				fmt.Fprint(e.out, "\n")
+				return "", io.EOF
+			}
+			e.deleteAt(e.pos)
+		case keyBackspace, 8:
+			if e.pos > 0 {
+				e.pos--
+				e.deleteAt(e.pos)
+			}
+		case keyCtrlA:
+			e.pos = 0
+		case keyCtrlE:
+			e.pos = len(e.buf)
+		case keyCtrlB:
+			e.pos = max(e.pos-1, 0)
+		case keyCtrlF:
+			e.pos = min(e.pos+1, len(e.buf))
+		case keyCtrlK:
+			e.buf = e.buf[:e.pos]
+		case keyCtrlU:
+			e.buf = e.buf[e.pos:]
+			e.pos = 0
+		case keyCtrlW:
+			start := e.pos
+			for start > 0 && e.buf[start-1] == ' ' {
+				start--
+			}
+			for start > 0 && e.buf[start-1] != ' ' {
+				start--
+			}
+			e.buf = append(e.buf[:start], e.buf[e.pos:]...)
+			e.pos = start
+		case keyCtrlL:
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		case keyCtrlP:
+			historyIndex, current = e.browse(entries, historyIndex, historyIndex-1, current)
+		case keyCtrlN:
+			historyIndex, current = e.browse(entries, historyIndex, historyIndex+1, current)
+		case keyTab:
+			e.complete()
+		case keyEscape:
+			switch e.readEscape() {
+			case 'A':
+				historyIndex, current = e.browse(entries, historyIndex, historyIndex-1, current)
+			case 'B':
+				historyIndex, current = e.browse(entries, historyIndex, historyIndex+1, current)
+			case 'C':
+				e.pos = min(e.pos+1, len(e.buf))
+			case 'D':
+				e.pos = max(e.pos-1, 0)
+			case 'H':
+				e.pos = 0
+			case 'F':
+				e.pos = len(e.buf)
+			case '3':
+				e.deleteAt(e.pos)
+			}
+		default:
+			if r >= ' ' {
+				e.insert([]rune{r})
+			}
+		}
+		e.refresh()
+	}
+}
+
+// readEscape 读取 ESC 之后的控制序列，返回表示按键的字符：
+// 'A'/'B'/'C'/'D' 方向键，'H'/'F' Home/End，'3' Delete，未识别的序列返回 0
+func (e *LineEditor) readEscape() rune {
+	r, _, err := e.in.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return 0
+	}
+	r, _, err = e.in.ReadRune()
+	if err != nil {
+		return 0
+	}
+	if r < '0' || r > '9' {
+		return r
+	}
+	// 形如 ESC [ n ~ 的序列
+	code := r
+	for {
+		next, _, err := e.in.ReadRune()
+		if err != nil {
+			return 0
+		}
+		if next == '~' {
+			break
+		}
+		if next < '0' || next > '9' {
+			return 0
+		}
+		code = 0
+	}
+	switch code {
+	case '1', '7':
+		return 'H'
+	case '4', '8':
+		return 'F'
+	case '3':
+		return '3'
+	}
+	return 0
+}
+
+// browse 切换到第 to 条历史，to 等于历史条数时回到正在编辑的内容
+func (e *LineEditor) browse(entries []string, from, to int, current string) (int, string) {
+	if to < 0 || to > len(entries) {
+		return from, current
+	}
+	if from == len(entries) {
+		current = string(e.buf)
+	}
+	if to == len(entries) {
+		e.buf = []rune(current)
+	} else {
+		e.buf = []rune(entries[to])
+	}
+	e.pos = len(e.buf)
+	return to, current
+}
+
+// complete 补全光标前的词：只有一个候选项时补全并加空格，否则补全公共前缀，仍有多个时列出候选项
+func (e *LineEditor) complete() {
+	if e.completer == nil {
+		return
+	}
+	before := string(e.buf[:e.pos])
+	start, candidates := e.completer.Complete(before)
+	if len(candidates) == 0 {
+		return
+	}
+	word := before[start:]
+	if len(candidates) == 1 {
+		e.insert([]rune(strings.TrimPrefix(candidates[0], word) + " "))
+		return
+	}
+	if prefix := commonPrefix(candidates); len(prefix) > len(word) && strings.HasPrefix(prefix, word) {
+		e.insert([]rune(prefix[len(word):]))
+		return
+	}
+	fmt.Fprintf(e.out, "\n%s\n", strings.Join(candidates, "  "))
+}
+
+// insert 在光标处插入
+func (e *LineEditor) insert(runes []rune) {
+	e.buf = append(e.buf[:e.pos], append(runes, e.buf[e.pos:]...)...)
+	e.pos += len(runes)
+}
+
+// deleteAt 删除位置 i 的字符
+func (e *LineEditor) deleteAt(i int) {
+	if i < len(e.buf) {
+		e.buf = append(e.buf[:i], e.buf[i+1:]...)
+	}
+}
+
+// refresh 重新显示提示符和当前行，并把光标移动到编辑位置
+func (e *LineEditor) refresh() {
+	var sb strings.Builder
+	sb.WriteString("\r")
+	sb.WriteString(e.prompt)
+	sb.WriteString(string(e.buf))
+	sb.WriteString("\x1b[K")
+	if back := len(e.buf) - e.pos; back > 0 {
+		fmt.Fprintf(&sb, "\x1b[%dD", back)
+	}
+	io.WriteString(e.out, sb.String())
+}
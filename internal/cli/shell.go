@@ -0,0 +1,311 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+// 交互模式的提示符
+const (
+	Prompt             = "minidb> "
+	ContinuationPrompt = "    -> "
+)
+
+// maxIncludeDepth \i 嵌套的最大层数
+const maxIncludeDepth = 16
+
+// errQuit 执行了 \q
+var errQuit = errors.New("quit")
+
+// metaCommands 元命令及说明
+var metaCommands = []struct {
+	name, args, help string
+}{
+	{`\q`, "", "退出 (也可以输入 quit 或 exit)"},
+	{`\timing`, "[on|off]", "切换是否显示语句执行时间"},
+	{`\format`, "[table|csv|json|vertical]", "显示或设置输出格式"},
+	{`\x`, "", "在 vertical 和之前的输出格式之间切换"},
+	{`\i`, "FILE", "执行文件中的语句"},
+	{`\c`, "DATABASE", "切换当前数据库 (USE DATABASE)"},
+	{`\h`, "", "显示帮助 (也可以用 \\?)"},
+}
+
+// metaCommandNames 返回元命令名，用于补全
+func metaCommandNames() []string {
+	names := make([]string, 0, len(metaCommands)+1)
+	for _, cmd := range metaCommands {
+		names = append(names, cmd.name)
+	}
+	return append(names, `\?`)
+}
+
+// Shell 在一个会话中执行语句和元命令，把结果按输出格式写到 Out
+type Shell struct {
+	conn   *minidb.Conn
+	Out    io.Writer
+	ErrOut io.Writer
+
+	// Format 输出格式：table、csv、json 或 vertical
+	Format string
+	// Timing 是否在每条语句后显示执行时间
+	Timing bool
+	// Completer 执行 DDL 和 USE 后刷新，可以为空
+	Completer *Completer
+
+	previousFormat string // \x 切换前的格式
+	depth          int    // \i 的嵌套层数
+}
+
+// NewShell 创建使用 conn 执行语句的 Shell
+func NewShell(conn *minidb.Conn, out, errOut io.Writer) *Shell {
+	return &Shell{conn: conn, Out: out, ErrOut: errOut, Format: FormatTable}
+}
+
+// Execute 执行一条语句并输出结果
+func (s *Shell) Execute(ctx context.Context, sql string) error {
+	start := time.Now()
+	reader, err := s.conn.QueryArrow(ctx, sql)
+	if err != nil {
+		return err
+	}
+	err = WriteResult(s.Out, reader, s.Format)
+	reader.Release()
+	if err != nil {
+		return err
+	}
+	if s.Timing {
+		fmt.Fprintf(s.Out, "Time: %.3f ms\n", float64(time.Since(start).Microseconds())/1000)
+	}
+
+	if s.Completer != nil && changesNames(sql) {
+		s.Completer.Refresh(ctx, s.conn)
+	}
+	return nil
+}
+
+// changesNames 语句是否可能改变库、表、列名 (需要刷新补全)
+func changesNames(sql string) bool {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return false
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "CREATE", "DROP", "ALTER", "USE":
+		return true
+	}
+	return false
+}
+
+// RunScript 依次执行 r 中的语句和元命令，遇到第一个错误时停止
+// 错误带有文件名和行号；文件末尾没有 ';' 的语句也会执行
+func (s *Shell) RunScript(ctx context.Context, r io.Reader, name string) error {
+	var splitter Splitter
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if !splitter.Pending() && isMetaCommand(line) {
+			if err := s.runMetaCommand(ctx, line); err != nil {
+				if errors.Is(err, errQuit) {
+					return err
+				}
+				return fmt.Errorf("%s:%d: %s", name, lineNo, errorMessage(err))
+			}
+			continue
+		}
+		for _, stmt := range splitter.Feed(line) {
+			if err := s.Execute(ctx, stmt); err != nil {
+				return fmt.Errorf("%s:%d: %s", name, lineNo, errorMessage(err))
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if splitter.Pending() {
+		for _, stmt := range splitter.Feed(";") {
+			if err := s.Execute(ctx, stmt); err != nil {
+				return fmt.Errorf("%s:%d: %s", name, lineNo, errorMessage(err))
+			}
+		}
+	}
+	return nil
+}
+
+// Run 执行脚本 (-e、-f 或非终端的标准输入)，\q 正常结束
+func (s *Shell) Run(ctx context.Context, r io.Reader, name string) error {
+	if err := s.RunScript(ctx, r, name); err != nil && !errors.Is(err, errQuit) {
+		return err
+	}
+	return nil
+}
+
+// RunInteractive 交互式读取和执行语句，直到 \q 或 Ctrl-D
+// 语句可以跨多行，以 ';' 结束；出错时显示错误并继续；执行中按 Ctrl-C 取消当前语句
+func (s *Shell) RunInteractive(ctx context.Context, editor *LineEditor, history *History) error {
+	var splitter Splitter
+	var entry []string // 当前语句的各行，完成后作为一条历史
+	for {
+		prompt := Prompt
+		if splitter.Pending() {
+			prompt = ContinuationPrompt
+		}
+		line, err := editor.ReadLine(prompt)
+		if errors.Is(err, ErrInterrupt) {
+			splitter.Reset()
+			entry = nil
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if !splitter.Pending() && isMetaCommand(line) {
+			history.Add(line)
+			if err := s.runMetaCommand(ctx, line); err != nil {
+				if errors.Is(err, errQuit) {
+					return nil
+				}
+				s.printError(err)
+			}
+			continue
+		}
+
+		entry = append(entry, line)
+		statements := splitter.Feed(line)
+		if !splitter.Pending() {
+			history.Add(strings.Join(entry, " "))
+			entry = nil
+		}
+		for _, stmt := range statements {
+			stmtCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+			err := s.Execute(stmtCtx, stmt)
+			stop()
+			if err != nil {
+				s.printError(err)
+			}
+		}
+	}
+}
+
+// printError 显示错误
+func (s *Shell) printError(err error) {
+	fmt.Fprintf(s.ErrOut, "ERROR: %s\n", errorMessage(err))
+}
+
+// errorMessage 错误信息，去掉驱动添加的 "minidb: " 前缀
+func errorMessage(err error) string {
+	return strings.TrimPrefix(err.Error(), "minidb: ")
+}
+
+// isMetaCommand 行是否为元命令 (以 '\' 开头，或者是 quit、exit)；元命令必须单独占一行
+func isMetaCommand(line string) bool {
+	line = strings.TrimSpace(line)
+	switch strings.ToLower(strings.TrimSuffix(line, ";")) {
+	case "quit", "exit":
+		return true
+	}
+	return strings.HasPrefix(line, `\`)
+}
+
+// runMetaCommand 执行元命令
+func (s *Shell) runMetaCommand(ctx context.Context, line string) error {
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(line), ";"))
+	command, args := strings.ToLower(fields[0]), fields[1:]
+
+	switch command {
+	case `\q`, `\quit`, "quit", "exit":
+		return errQuit
+	case `\timing`:
+		switch {
+		case len(args) == 0:
+			s.Timing = !s.Timing
+		case strings.EqualFold(args[0], "on"):
+			s.Timing = true
+		case strings.EqualFold(args[0], "off"):
+			s.Timing = false
+		default:
+			return fmt.Errorf(`\timing: expected on or off, got %q`, args[0])
+		}
+		if s.Timing {
+			fmt.Fprintln(s.Out, "Timing is on.")
+		} else {
+			fmt.Fprintln(s.Out, "Timing is off.")
+		}
+	case `\format`:
+		if len(args) == 0 {
+			fmt.Fprintf(s.Out, "Output format is %s.\n", s.Format)
+			return nil
+		}
+		format := strings.ToLower(args[0])
+		if !ValidFormat(format) {
+			return fmt.Errorf(`\format: unknown format %q (must be %s)`, args[0], strings.Join(Formats, ", "))
+		}
+		s.Format = format
+		fmt.Fprintf(s.Out, "Output format is %s.\n", s.Format)
+	case `\x`:
+		if s.Format == FormatVertical {
+			s.Format = s.previousFormat
+			if s.Format == "" {
+				s.Format = FormatTable
+			}
+		} else {
+			s.previousFormat = s.Format
+			s.Format = FormatVertical
+		}
+		fmt.Fprintf(s.Out, "Output format is %s.\n", s.Format)
+	case `\i`:
+		if len(args) != 1 {
+			return fmt.Errorf(`\i: expected a file name`)
+		}
+		return s.include(ctx, args[0])
+	case `\c`, `\connect`:
+		if len(args) != 1 {
+			return fmt.Errorf(`\c: expected a database name`)
+		}
+		return s.Execute(ctx, "USE "+args[0])
+	case `\h`, `\?`, `\help`:
+		s.printHelp()
+	default:
+		return fmt.Errorf("unknown command %s, type \\h for help", fields[0])
+	}
+	return nil
+}
+
+// include 执行文件中的语句 (\i)
+func (s *Shell) include(ctx context.Context, path string) error {
+	if s.depth >= maxIncludeDepth {
+		return fmt.Errorf(`\i: files are nested more than %d levels deep`, maxIncludeDepth)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	s.depth++
+	defer func() { s.depth-- }()
+	return s.RunScript(ctx, file, path)
+}
+
+// printHelp 显示元命令的帮助
+func (s *Shell) printHelp() {
+	fmt.Fprintln(s.Out, "SQL statements end with ';' and may span multiple lines.")
+	for _, cmd := range metaCommands {
+		fmt.Fprintf(s.Out, "  %-28s %s\n", strings.TrimSpace(cmd.name+" "+cmd.args), cmd.help)
+	}
+}
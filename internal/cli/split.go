@@ -0,0 +1,88 @@
+package cli
+
+import "strings"
+
+// Splitter 把逐行输入的 SQL 切分为语句
+// 语句以引号和注释之外的 ';' 结束，可以跨多行，一行中也可以有多条语句
+type Splitter struct {
+	buf          strings.Builder
+	quote        byte // 当前所在引号 (' " `)，0 表示不在引号中
+	blockComment bool // 是否在 /* */ 注释中
+}
+
+// Feed 输入一行，返回这一行结束的完整语句 (去掉结尾的 ';' 和首尾空白，跳过只有注释的语句)
+func (s *Splitter) Feed(line string) []string {
+	var statements []string
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case s.blockComment:
+			if c == '*' && i+1 < len(line) && line[i+1] == '/' {
+				s.blockComment = false
+				s.buf.WriteString("*/")
+				i++
+				continue
+			}
+		case s.quote != 0:
+			if c == s.quote {
+				s.quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			s.quote = c
+		case c == '-' && i+1 < len(line) && line[i+1] == '-':
+			// 行注释到行尾
+			s.buf.WriteString(line[i:])
+			i = len(line)
+			continue
+		case c == '/' && i+1 < len(line) && line[i+1] == '*':
+			s.blockComment = true
+			s.buf.WriteString("/*")
+			i++
+			continue
+		case c == ';':
+			if stmt := strings.TrimSpace(s.buf.String()); !isComment(stmt) {
+				statements = append(statements, stmt)
+			}
+			s.buf.Reset()
+			continue
+		}
+		s.buf.WriteByte(c)
+	}
+	s.buf.WriteByte('\n')
+	return statements
+}
+
+// Pending 是否有未结束的语句
+func (s *Splitter) Pending() bool {
+	return s.quote != 0 || s.blockComment || !isComment(strings.TrimSpace(s.buf.String()))
+}
+
+// Reset 丢弃未结束的语句
+func (s *Splitter) Reset() {
+	s.buf.Reset()
+	s.quote = 0
+	s.blockComment = false
+}
+
+// isComment 语句是否为空或只有注释
+func isComment(stmt string) bool {
+	for stmt != "" {
+		switch {
+		case strings.HasPrefix(stmt, "--"):
+			end := strings.IndexByte(stmt, '\n')
+			if end < 0 {
+				return true
+			}
+			stmt = strings.TrimSpace(stmt[end:])
+		case strings.HasPrefix(stmt, "/*"):
+			end := strings.Index(stmt, "*/")
+			if end < 0 {
+				return true
+			}
+			stmt = strings.TrimSpace(stmt[end+2:])
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,29 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+)
+
+// IsTerminal 其他平台不支持行编辑，总是按非终端处理
+func IsTerminal(fd int) bool {
+	return false
+}
+
+// makeRaw 其他平台不支持原始模式
+func makeRaw(fd int) (func() error, error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
+
+// ReadPassword 其他平台无法关闭回显，直接读取一行
+func ReadPassword(fd int) (string, error) {
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
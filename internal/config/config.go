@@ -0,0 +1,387 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config MiniDB 服务配置，对应 YAML 配置文件
+//
+//	data_dir: ./minidb_data
+//	server:
+//	  listeners: ["localhost:7205"]
+//	  admin_listener: localhost:9205
+//	storage:
+//	  optimistic_lock: true
+//	  s3:
+//	    endpoint: https://s3.us-east-1.amazonaws.com
+//	    region: us-east-1
+//	    bucket: minidb-warehouse
+//	memory:
+//	  work_mem: 128MB
+//	maintenance:
+//	  compaction:
+//	    interval: 30m
+//	  vacuum:
+//	    enabled: true
+//	    retention: 168h
+//	auth:
+//	  enabled: true
+//	  admin_user: admin
+type Config struct {
+	DataDir     string            `yaml:"data_dir"`
+	Server      ServerConfig      `yaml:"server"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Memory      MemoryConfig      `yaml:"memory"`
+	Maintenance MaintenanceConfig `yaml:"maintenance"`
+	Auth        AuthConfig        `yaml:"auth"`
+}
+
+// ServerConfig 网络监听配置
+type ServerConfig struct {
+	Listeners     []string `yaml:"listeners"`      // host:port 列表，每个地址启动一个监听
+	AdminListener string   `yaml:"admin_listener"` // HTTP 管理端口 (/metrics、/healthz) 的 host:port，为空时不启动
+}
+
+// StorageConfig 存储引擎配置
+type StorageConfig struct {
+	OptimisticLock bool          `yaml:"optimistic_lock"` // 使用乐观并发控制 (条件写入版本文件)
+	MaxRetries     int           `yaml:"max_retries"`     // 乐观并发冲突的最大重试次数
+	LogRetention   time.Duration `yaml:"log_retention"`   // 被 checkpoint 覆盖的 Delta Log 的保留时长
+	S3             S3Config      `yaml:"s3"`              // 设置 bucket 时数据文件和 Delta Log 保存在 S3 兼容对象存储中
+}
+
+// S3Config S3 兼容对象存储配置 (AWS S3 / MinIO / Ceph RGW / R2 等)
+// 启用后对象键为相对于 data_dir 的路径 (加上 prefix)，data_dir 仍在本地保存写缓冲的 WAL；
+// access_key_id / secret_access_key 为空时读取环境变量 AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY
+type S3Config struct {
+	Endpoint        string `yaml:"endpoint"` // 如 https://s3.us-east-1.amazonaws.com 或 http://127.0.0.1:9000
+	Region          string `yaml:"region"`   // 签名使用的区域，默认 us-east-1
+	Bucket          string `yaml:"bucket"`
+	Prefix          string `yaml:"prefix"` // 对象键前缀，用于多个实例共享同一个存储桶
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	UsePathStyle    bool   `yaml:"use_path_style"` // 使用 path-style 地址 (MinIO 等通常需要)
+}
+
+// S3 凭据的环境变量
+const (
+	S3AccessKeyIDEnv     = "AWS_ACCESS_KEY_ID"
+	S3SecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+)
+
+// Enabled 是否使用 S3 兼容对象存储
+func (s S3Config) Enabled() bool {
+	return s.Bucket != ""
+}
+
+// Credentials 返回访问密钥，配置为空时读取环境变量；都为空时发送匿名请求
+func (s S3Config) Credentials() (accessKeyID, secretAccessKey string) {
+	if s.AccessKeyID != "" {
+		return s.AccessKeyID, s.SecretAccessKey
+	}
+	return os.Getenv(S3AccessKeyIDEnv), os.Getenv(S3SecretAccessKeyEnv)
+}
+
+// AuthConfig 连接认证和权限检查配置
+// 启用后客户端必须登录，执行计划前检查用户权限；用户目录为空时用 admin_user / admin_password 创建初始超级用户，
+// admin_password 为空时读取环境变量 MINIDB_ADMIN_PASSWORD
+type AuthConfig struct {
+	Enabled       bool   `yaml:"enabled"`
+	AdminUser     string `yaml:"admin_user"`
+	AdminPassword string `yaml:"admin_password"`
+}
+
+// AdminPasswordEnv 初始超级用户密码的环境变量
+const AdminPasswordEnv = "MINIDB_ADMIN_PASSWORD"
+
+// BootstrapPassword 返回初始超级用户的密码，配置为空时读取环境变量
+func (a AuthConfig) BootstrapPassword() string {
+	if a.AdminPassword != "" {
+		return a.AdminPassword
+	}
+	return os.Getenv(AdminPasswordEnv)
+}
+
+// MemoryConfig 内存限制
+type MemoryConfig struct {
+	WorkMem                  ByteSize      `yaml:"work_mem"`                    // 单个查询排序/聚合/连接的内存预算，超出后溢写磁盘
+	SpillDir                 string        `yaml:"spill_dir"`                   // 溢写临时目录，为空时使用系统临时目录
+	WriteBufferRows          int64         `yaml:"write_buffer_rows"`           // 写缓冲行数达到该值时刷写
+	WriteBufferSize          ByteSize      `yaml:"write_buffer_size"`           // 写缓冲字节数达到该值时刷写
+	WriteBufferFlushInterval time.Duration `yaml:"write_buffer_flush_interval"` // 缓冲数据的最长停留时间
+}
+
+// MaintenanceConfig 后台维护任务配置
+type MaintenanceConfig struct {
+	Enabled     bool             `yaml:"enabled"`
+	Jitter      float64          `yaml:"jitter"`       // 调度间隔的随机抖动比例 [0, 1)，避免所有表同时执行
+	Backoff     BackoffConfig    `yaml:"backoff"`      // 任务失败后的重试退避
+	HistorySize int              `yaml:"history_size"` // sys.maintenance_jobs 保留的执行记录数
+	Compaction  CompactionPolicy `yaml:"compaction"`
+	Checkpoint  CheckpointPolicy `yaml:"checkpoint"`
+	Vacuum      VacuumPolicy     `yaml:"vacuum"`
+	AutoAnalyze AnalyzePolicy    `yaml:"auto_analyze"`
+}
+
+// BackoffConfig 指数退避：第 n 次连续失败后等待 min(Initial * 2^(n-1), Max)
+type BackoffConfig struct {
+	Initial time.Duration `yaml:"initial"`
+	Max     time.Duration `yaml:"max"`
+}
+
+// CompactionPolicy 小文件合并策略
+type CompactionPolicy struct {
+	Enabled        bool          `yaml:"enabled"`
+	Interval       time.Duration `yaml:"interval"`
+	MinFileSize    ByteSize      `yaml:"min_file_size"`    // 小于该大小的文件参与合并
+	TargetFileSize ByteSize      `yaml:"target_file_size"` // 合并后的目标文件大小
+	MinFiles       int           `yaml:"min_files"`        // 小文件数达到该值才合并
+	MaxFiles       int           `yaml:"max_files"`        // 一次最多合并的文件数
+}
+
+// CheckpointPolicy Delta Log checkpoint 策略
+type CheckpointPolicy struct {
+	Enabled     bool          `yaml:"enabled"`
+	Interval    time.Duration `yaml:"interval"`     // 定期为有新日志的表创建 checkpoint
+	LogInterval int           `yaml:"log_interval"` // 每张表累计多少条日志后自动创建 checkpoint，<= 0 表示只定期创建
+}
+
+// VacuumPolicy 过期数据文件清理策略
+type VacuumPolicy struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval"`
+	Retention time.Duration `yaml:"retention"` // 被移除超过该时长的文件才会删除
+}
+
+// AnalyzePolicy 自动收集统计信息策略
+type AnalyzePolicy struct {
+	Enabled  bool          `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Default 返回默认配置，与没有配置文件时的行为一致
+func Default() *Config {
+	return &Config{
+		DataDir: "./minidb_data",
+		Server: ServerConfig{
+			Listeners: []string{"localhost:7205"},
+		},
+		Storage: StorageConfig{
+			MaxRetries:   3,
+			LogRetention: 7 * 24 * time.Hour,
+		},
+		Memory: MemoryConfig{
+			WorkMem:                  64 * MiB,
+			WriteBufferRows:          100000,
+			WriteBufferSize:          64 * MiB,
+			WriteBufferFlushInterval: 5 * time.Second,
+		},
+		Maintenance: MaintenanceConfig{
+			Enabled:     true,
+			Jitter:      0.1,
+			Backoff:     BackoffConfig{Initial: time.Minute, Max: time.Hour},
+			HistorySize: 1000,
+			Compaction: CompactionPolicy{
+				Enabled:        true,
+				Interval:       time.Hour,
+				MinFileSize:    8 * MiB,
+				TargetFileSize: 128 * MiB,
+				MinFiles:       10,
+				MaxFiles:       100,
+			},
+			Checkpoint: CheckpointPolicy{
+				Enabled:     true,
+				Interval:    30 * time.Minute,
+				LogInterval: 10,
+			},
+			// VACUUM 会物理删除文件，默认关闭
+			Vacuum: VacuumPolicy{
+				Interval:  24 * time.Hour,
+				Retention: 7 * 24 * time.Hour,
+			},
+			AutoAnalyze: AnalyzePolicy{
+				Enabled:  true,
+				Interval: 10 * time.Minute,
+			},
+		},
+		// 默认不启用认证，与旧版本的行为一致
+		Auth: AuthConfig{
+			AdminUser: "admin",
+		},
+	}
+}
+
+// Load 读取 YAML 配置文件，未出现的字段使用默认值；未知字段视为错误
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Parse 解析 YAML 配置内容并校验
+func Parse(data []byte) (*Config, error) {
+	cfg := Default()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate 校验配置取值
+func (c *Config) Validate() error {
+	if strings.TrimSpace(c.DataDir) == "" {
+		return fmt.Errorf("data_dir must not be empty")
+	}
+	if len(c.Server.Listeners) == 0 {
+		return fmt.Errorf("server.listeners must contain at least one address")
+	}
+	seen := make(map[string]bool)
+	for _, addr := range c.Server.Listeners {
+		if _, port, err := net.SplitHostPort(addr); err != nil || port == "" {
+			return fmt.Errorf("server.listeners: invalid address '%s', expected host:port", addr)
+		}
+		if seen[addr] {
+			return fmt.Errorf("server.listeners: duplicate address '%s'", addr)
+		}
+		seen[addr] = true
+	}
+	if admin := c.Server.AdminListener; admin != "" {
+		if _, port, err := net.SplitHostPort(admin); err != nil || port == "" {
+			return fmt.Errorf("server.admin_listener: invalid address '%s', expected host:port", admin)
+		}
+		if seen[admin] {
+			return fmt.Errorf("server.admin_listener: address '%s' is already used by server.listeners", admin)
+		}
+	}
+	if c.Storage.MaxRetries < 0 {
+		return fmt.Errorf("storage.max_retries must not be negative")
+	}
+	if c.Storage.LogRetention < 0 {
+		return fmt.Errorf("storage.log_retention must not be negative")
+	}
+	if s3 := c.Storage.S3; s3.Enabled() {
+		if endpoint, err := url.Parse(s3.Endpoint); err != nil || endpoint.Host == "" {
+			return fmt.Errorf("storage.s3.endpoint: invalid URL '%s', expected e.g. https://s3.us-east-1.amazonaws.com", s3.Endpoint)
+		}
+	} else if s3 != (S3Config{}) {
+		return fmt.Errorf("storage.s3.bucket must be set to use S3 storage")
+	}
+	if c.Memory.WorkMem <= 0 {
+		return fmt.Errorf("memory.work_mem must be positive")
+	}
+	if c.Memory.WriteBufferRows < 0 || c.Memory.WriteBufferSize < 0 || c.Memory.WriteBufferFlushInterval < 0 {
+		return fmt.Errorf("memory.write_buffer_* must not be negative")
+	}
+
+	m := &c.Maintenance
+	if m.Jitter < 0 || m.Jitter >= 1 {
+		return fmt.Errorf("maintenance.jitter must be in [0, 1), got %g", m.Jitter)
+	}
+	if m.Backoff.Initial <= 0 || m.Backoff.Max < m.Backoff.Initial {
+		return fmt.Errorf("maintenance.backoff: initial must be positive and not greater than max")
+	}
+	if m.HistorySize <= 0 {
+		return fmt.Errorf("maintenance.history_size must be positive")
+	}
+	policies := []struct {
+		name     string
+		enabled  bool
+		interval time.Duration
+	}{
+		{"compaction", m.Compaction.Enabled, m.Compaction.Interval},
+		{"checkpoint", m.Checkpoint.Enabled, m.Checkpoint.Interval},
+		{"vacuum", m.Vacuum.Enabled, m.Vacuum.Interval},
+		{"auto_analyze", m.AutoAnalyze.Enabled, m.AutoAnalyze.Interval},
+	}
+	for _, p := range policies {
+		if p.enabled && p.interval <= 0 {
+			return fmt.Errorf("maintenance.%s.interval must be positive", p.name)
+		}
+	}
+	if m.Compaction.Enabled {
+		if m.Compaction.MinFileSize <= 0 || m.Compaction.TargetFileSize < m.Compaction.MinFileSize {
+			return fmt.Errorf("maintenance.compaction: min_file_size must be positive and not greater than target_file_size")
+		}
+		if m.Compaction.MinFiles < 2 || m.Compaction.MaxFiles < m.Compaction.MinFiles {
+			return fmt.Errorf("maintenance.compaction: min_files must be at least 2 and not greater than max_files")
+		}
+	}
+	if m.Vacuum.Retention < 0 {
+		return fmt.Errorf("maintenance.vacuum.retention must not be negative")
+	}
+	if c.Auth.Enabled && strings.TrimSpace(c.Auth.AdminUser) == "" {
+		return fmt.Errorf("auth.admin_user must not be empty when auth is enabled")
+	}
+	return nil
+}
+
+// ByteSize 字节数，配置中可以写整数或带单位的字符串 (如 64MB、1GiB)
+type ByteSize int64
+
+// 字节单位 (1024 进制)
+const (
+	KiB ByteSize = 1 << 10
+	MiB ByteSize = 1 << 20
+	GiB ByteSize = 1 << 30
+)
+
+// byteUnits 支持的单位后缀，KB/MB/GB 与 KiB/MiB/GiB 同为 1024 进制
+var byteUnits = []struct {
+	suffix string
+	size   ByteSize
+}{
+	{"KIB", KiB}, {"MIB", MiB}, {"GIB", GiB},
+	{"KB", KiB}, {"MB", MiB}, {"GB", GiB},
+	{"K", KiB}, {"M", MiB}, {"G", GiB},
+	{"B", 1},
+}
+
+// ParseByteSize 解析带单位的字节数
+func ParseByteSize(s string) (ByteSize, error) {
+	text := strings.ToUpper(strings.TrimSpace(s))
+	unit := ByteSize(1)
+	for _, u := range byteUnits {
+		if strings.HasSuffix(text, u.suffix) {
+			text = strings.TrimSpace(strings.TrimSuffix(text, u.suffix))
+			unit = u.size
+			break
+		}
+	}
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid byte size '%s'", s)
+	}
+	return ByteSize(n) * unit, nil
+}
+
+// UnmarshalYAML 解析整数或带单位的字符串
+func (b *ByteSize) UnmarshalYAML(value *yaml.Node) error {
+	size, err := ParseByteSize(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	*b = size
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,28 +16,37 @@ import (
 )
 
 // PersistenceCallback 持久化回调函数类型
-// 当新的 Log Entry 被添加时调用
-type PersistenceCallback func(entry *LogEntry) error
+// 当新的 Log Entry 被添加时调用，同一版本提交的多条 entry 一起传入
+type PersistenceCallback func(entries []LogEntry) error
 
 // CheckpointCallback checkpoint创建回调函数类型
 type CheckpointCallback func(tableID string, version int64) error
 
+// HistoryLoader 读取表在 compactedVersion 及之前仍保留在磁盘上的历史日志
+// 返回的条目足以重建 availableFrom 到 compactedVersion 之间的各个版本 (availableFrom 为 0 表示历史完整)
+type HistoryLoader func(tableID string, compactedVersion int64) (entries []LogEntry, availableFrom int64, err error)
+
 // DeltaLog Delta Log 管理器
 type DeltaLog struct {
 	// 内存存储用于快速访问
 	entries             []LogEntry
+	tables              map[string]*tableState // 每张表的增量快照状态
 	mu                  sync.RWMutex
 	currentVer          atomic.Int64
 	tableName           string
 	persistenceCallback PersistenceCallback // 持久化回调
 	checkpointCallback  CheckpointCallback  // checkpoint创建回调
+	checkpointInterval  int                 // 每张表累计多少条日志后创建 checkpoint
+	historyLoader       HistoryLoader       // 按需加载 checkpoint 之前的历史日志，nil 时不加载
 }
 
 // NewDeltaLog 创建 Delta Log 管理器
 func NewDeltaLog() *DeltaLog {
 	dl := &DeltaLog{
-		entries:   make([]LogEntry, 0),
-		tableName: "sys.delta_log",
+		entries:            make([]LogEntry, 0),
+		tables:             make(map[string]*tableState),
+		tableName:          "sys.delta_log",
+		checkpointInterval: DefaultCheckpointInterval,
 	}
 	dl.currentVer.Store(0)
 	return dl
@@ -52,6 +62,75 @@ func (dl *DeltaLog) SetCheckpointCallback(callback CheckpointCallback) {
 	dl.checkpointCallback = callback
 }
 
+// SetHistoryLoader 设置 checkpoint 之前历史日志的来源
+// 启动时只加载 checkpoint 和其后的日志，时间旅行到更早的版本时才通过 loader 读取保留期内的旧日志
+func (dl *DeltaLog) SetHistoryLoader(loader HistoryLoader) {
+	dl.historyLoader = loader
+}
+
+// loadHistory 表的历史已被 checkpoint 压缩时，加载仍保留的旧日志替换压缩后的条目 (每张表只成功加载一次)
+func (dl *DeltaLog) loadHistory(tableID string) error {
+	dl.mu.RLock()
+	state, ok := dl.tables[tableID]
+	compactedVersion := int64(0)
+	if ok && !state.historyLoaded {
+		compactedVersion = state.compactedVersion
+	}
+	loader := dl.historyLoader
+	dl.mu.RUnlock()
+	if loader == nil || compactedVersion == 0 {
+		return nil
+	}
+
+	// 读取磁盘时不持有锁
+	history, availableFrom, err := loader(tableID, compactedVersion)
+	if err != nil {
+		return fmt.Errorf("failed to load history of table %s: %w", tableID, err)
+	}
+
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	if state.historyLoaded || state.compactedVersion != compactedVersion {
+		return nil
+	}
+	state.historyLoaded = true
+	if availableFrom >= compactedVersion {
+		return nil
+	}
+
+	// 用完整的历史替换 compactedVersion 及之前压缩后的条目，之后的日志保持不变
+	entries := make([]LogEntry, 0, len(dl.entries)+len(history))
+	for _, entry := range dl.entries {
+		if entry.TableID != tableID || entry.Version > compactedVersion {
+			entries = append(entries, entry)
+		}
+	}
+	for _, entry := range history {
+		if entry.TableID == tableID && entry.Version <= compactedVersion {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Version < entries[j].Version
+	})
+	dl.entries = entries
+	state.compactedVersion = availableFrom
+
+	logger.Info("Delta Log history loaded",
+		zap.String("table", tableID),
+		zap.Int("entry_count", len(history)),
+		zap.Int64("checkpoint_version", compactedVersion),
+		zap.Int64("available_from", availableFrom))
+	return nil
+}
+
+// SetCheckpointInterval 设置每张表创建 checkpoint 的日志条数间隔，<= 0 表示不自动创建
+func (dl *DeltaLog) SetCheckpointInterval(interval int) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	dl.checkpointInterval = interval
+}
+
 // Bootstrap 初始化 Delta Log (由 ParquetEngine 负责加载持久化数据)
 func (dl *DeltaLog) Bootstrap() error {
 	logger.Info("Bootstrapping Delta Log")
@@ -62,46 +141,152 @@ func (dl *DeltaLog) Bootstrap() error {
 // RestoreFromEntries 从已加载的 entries 恢复 Delta Log 状态
 // 由 ParquetEngine 在启动时调用
 func (dl *DeltaLog) RestoreFromEntries(entries []LogEntry) error {
+	return dl.RestoreFromCheckpoints(entries, nil)
+}
+
+// RestoreFromCheckpoints 从 checkpoint 压缩后的条目加上其后的增量日志恢复状态
+// compactedVersions 记录每张表加载的 checkpoint 版本，早于该版本的历史快照需要通过 HistoryLoader 加载
+func (dl *DeltaLog) RestoreFromCheckpoints(entries []LogEntry, compactedVersions map[string]int64) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
+	// 按版本重放，同一文件的多条 ADD 以最新版本为准
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Version < entries[j].Version
+	})
 	dl.entries = entries
+	dl.tables = make(map[string]*tableState)
 
-	// 恢复最新版本号
+	// 恢复最新版本号和每张表的快照状态
 	var maxVersion int64 = 0
 	for _, entry := range entries {
 		if entry.Version > maxVersion {
 			maxVersion = entry.Version
 		}
+		if entry.TableID != "" {
+			dl.tableState(entry.TableID).apply(entry)
+		}
+	}
+	for tableID, version := range compactedVersions {
+		state := dl.tableState(tableID)
+		state.compactedVersion = version
+		if version > state.version {
+			state.version = version
+		}
+		if version > maxVersion {
+			maxVersion = version
+		}
+		// 只统计 checkpoint 之后的日志
+		state.sinceCheckpoint = 0
+	}
+	for _, entry := range entries {
+		if version, ok := compactedVersions[entry.TableID]; ok && entry.Version > version {
+			dl.tables[entry.TableID].sinceCheckpoint++
+		}
 	}
 	dl.currentVer.Store(maxVersion)
 
 	logger.Info("Delta Log restored from entries",
 		zap.Int("entry_count", len(entries)),
+		zap.Int("table_count", len(dl.tables)),
+		zap.Int("checkpoint_count", len(compactedVersions)),
 		zap.Int64("latest_version", maxVersion))
 
 	return nil
 }
 
+// tableState 返回表的快照状态，不存在时创建 (调用方持有 dl.mu)
+func (dl *DeltaLog) tableState(tableID string) *tableState {
+	state, ok := dl.tables[tableID]
+	if !ok {
+		state = newTableState()
+		dl.tables[tableID] = state
+	}
+	return state
+}
+
+// appendEntry 追加日志并更新表状态 (调用方持有 dl.mu)
+func (dl *DeltaLog) appendEntry(entry LogEntry) {
+	dl.entries = append(dl.entries, entry)
+	dl.tableState(entry.TableID).apply(entry)
+}
+
+// maybeCheckpoint 表自上次 checkpoint 以来的日志条数达到间隔时异步创建 checkpoint (调用方持有 dl.mu)
+func (dl *DeltaLog) maybeCheckpoint(tableID string, version int64) {
+	state := dl.tables[tableID]
+	if dl.checkpointCallback == nil || dl.checkpointInterval <= 0 || state == nil ||
+		state.sinceCheckpoint < dl.checkpointInterval {
+		return
+	}
+	state.sinceCheckpoint = 0
+
+	callback := dl.checkpointCallback
+	go func() {
+		if err := callback(tableID, version); err != nil {
+			logger.Warn("Failed to create checkpoint",
+				zap.String("table", tableID),
+				zap.Int64("version", version),
+				zap.Error(err))
+		}
+	}()
+}
+
 // AppendAdd 追加 ADD 操作
 func (dl *DeltaLog) AppendAdd(tableID string, file *ParquetFile) error {
+	return dl.appendAdd("", tableID, file)
+}
+
+// appendAdd 以 user 的身份追加ADD 操作
+func (dl *DeltaLog) appendAdd(user, tableID string, file *ParquetFile) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
 	version := dl.currentVer.Add(1)
-	timestamp := time.Now().UnixMilli()
+	entry := newAddEntry(version, time.Now().UnixMilli(), tableID, user, file)
+
+	dl.appendEntry(entry)
 
+	// 调用持久化回调
+	if dl.persistenceCallback != nil {
+		if err := dl.persistenceCallback([]LogEntry{entry}); err != nil {
+			logger.Error("Failed to persist Delta Log entry",
+				zap.Error(err),
+				zap.String("table", tableID),
+				zap.String("operation", string(OpAdd)))
+			// 不返回错误，继续操作（内存中已保存）
+		}
+	}
+
+	logger.Info("Delta Log entry appended",
+		zap.Int64("version", version),
+		zap.String("table", tableID),
+		zap.String("operation", string(OpAdd)),
+		zap.String("file", file.Path))
+
+	// 检查是否需要创建 Checkpoint
+	dl.maybeCheckpoint(tableID, version)
+
+	return nil
+}
+
+// newAddEntry 构建 ADD 日志条目
+func newAddEntry(version, timestamp int64, tableID, user string, file *ParquetFile) LogEntry {
 	entry := LogEntry{
-		Version:    version,
-		Timestamp:  timestamp,
-		TableID:    tableID,
-		Operation:  OpAdd,
-		FilePath:   file.Path,
-		FileSize:   file.Size,
-		RowCount:   file.RowCount,
-		DataChange: true,
-		IsDelta:    file.IsDelta,
-		DeltaType:  file.DeltaType,
+		Version:      version,
+		Timestamp:    timestamp,
+		TableID:      tableID,
+		UserID:       user,
+		Operation:    OpAdd,
+		FilePath:     file.Path,
+		FileSize:     file.Size,
+		RowCount:     file.RowCount,
+		DataChange:   !file.StatsOnly && !file.Rearranged,
+		AddedAt:      file.AddedAt,
+		IsDelta:      file.IsDelta,
+		WALWatermark: file.WALWatermark,
+		DeltaType:    file.DeltaType,
+
+		PartitionValues: file.PartitionValues,
 	}
 
 	if file.Stats != nil {
@@ -109,40 +294,83 @@ func (dl *DeltaLog) AppendAdd(tableID string, file *ParquetFile) error {
 		entry.MaxValues = file.Stats.MaxValues
 		entry.NullCounts = file.Stats.NullCounts
 	}
+	return entry
+}
 
-	dl.entries = append(dl.entries, entry)
+// newCommitEntries 构建同一版本提交的 REMOVE 和 ADD 日志条目 (先 REMOVE 后 ADD)
+func newCommitEntries(version, timestamp int64, tableID, user string, adds []*ParquetFile, removes []string) []LogEntry {
+	entries := make([]LogEntry, 0, len(removes)+len(adds))
+	for _, path := range removes {
+		entries = append(entries, LogEntry{
+			Version:           version,
+			Timestamp:         timestamp,
+			TableID:           tableID,
+			UserID:            user,
+			Operation:         OpRemove,
+			FilePath:          path,
+			DeletionTimestamp: timestamp,
+			DataChange:        true,
+		})
+	}
+	for _, file := range adds {
+		entries = append(entries, newAddEntry(version, timestamp, tableID, user, file))
+	}
+	return entries
+}
+
+// AppendCommit 将一组 REMOVE 和 ADD 操作作为同一个版本提交
+func (dl *DeltaLog) AppendCommit(tableID string, adds []*ParquetFile, removes []string) (int64, error) {
+	return dl.appendCommit("", tableID, adds, removes)
+}
+
+// appendCommit 以 user 的身份追加一组 REMOVE 和 ADD 操作 (同一个版本)
+func (dl *DeltaLog) appendCommit(user, tableID string, adds []*ParquetFile, removes []string) (int64, error) {
+	if len(adds) == 0 && len(removes) == 0 {
+		return 0, fmt.Errorf("empty commit for table %s", tableID)
+	}
+
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	version := dl.currentVer.Add(1)
+	entries := newCommitEntries(version, time.Now().UnixMilli(), tableID, user, adds, removes)
+	for _, entry := range entries {
+		dl.appendEntry(entry)
+	}
 
 	// 调用持久化回调
 	if dl.persistenceCallback != nil {
-		if err := dl.persistenceCallback(&entry); err != nil {
-			logger.Error("Failed to persist Delta Log entry",
+		if err := dl.persistenceCallback(entries); err != nil {
+			logger.Error("Failed to persist Delta Log commit",
 				zap.Error(err),
 				zap.String("table", tableID),
-				zap.String("operation", string(OpAdd)))
-			// 不返回错误，继续操作（内存中已保存）
+				zap.Int64("version", version))
 		}
 	}
 
-	logger.Info("Delta Log entry appended",
+	logger.Info("Delta Log commit appended",
 		zap.Int64("version", version),
 		zap.String("table", tableID),
-		zap.String("operation", string(OpAdd)),
-		zap.String("file", file.Path))
+		zap.Int("added_files", len(adds)),
+		zap.Int("removed_files", len(removes)))
 
-	// 检查是否需要创建 Checkpoint (每10个事务)
-	if version%10 == 0 {
-		if dl.checkpointCallback != nil {
-			go dl.checkpointCallback(tableID, version)
-		} else {
-			go dl.createCheckpoint(tableID, version)
-		}
-	}
+	dl.maybeCheckpoint(tableID, version)
 
-	return nil
+	return version, nil
 }
 
 // AppendRemove 追加 REMOVE 操作
 func (dl *DeltaLog) AppendRemove(tableID, filePath string) error {
+	return dl.appendRemove("", tableID, filePath, true)
+}
+
+// AppendRearrangeRemove 追加 compaction / Z-order 替换文件产生的 REMOVE 操作，数据内容不变 (dataChange=false)
+func (dl *DeltaLog) AppendRearrangeRemove(tableID, filePath string) error {
+	return dl.appendRemove("", tableID, filePath, false)
+}
+
+// appendRemove 以 user 的身份追加 REMOVE 操作，dataChange 标记是否为数据变更
+func (dl *DeltaLog) appendRemove(user, tableID, filePath string, dataChange bool) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
@@ -153,17 +381,18 @@ func (dl *DeltaLog) AppendRemove(tableID, filePath string) error {
 		Version:           version,
 		Timestamp:         timestamp,
 		TableID:           tableID,
+		UserID:            user,
 		Operation:         OpRemove,
 		FilePath:          filePath,
 		DeletionTimestamp: timestamp,
-		DataChange:        true,
+		DataChange:        dataChange,
 	}
 
-	dl.entries = append(dl.entries, entry)
+	dl.appendEntry(entry)
 
 	// 调用持久化回调
 	if dl.persistenceCallback != nil {
-		if err := dl.persistenceCallback(&entry); err != nil {
+		if err := dl.persistenceCallback([]LogEntry{entry}); err != nil {
 			logger.Error("Failed to persist Delta Log entry",
 				zap.Error(err),
 				zap.String("table", tableID),
@@ -177,11 +406,18 @@ func (dl *DeltaLog) AppendRemove(tableID, filePath string) error {
 		zap.String("operation", string(OpRemove)),
 		zap.String("file", filePath))
 
+	dl.maybeCheckpoint(tableID, version)
+
 	return nil
 }
 
 // AppendMetadata 追加 METADATA 操作
 func (dl *DeltaLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
+	return dl.appendMetadata("", tableID, schema)
+}
+
+// appendMetadata 以 user 的身份追加METADATA 操作
+func (dl *DeltaLog) appendMetadata(user, tableID string, schema *arrow.Schema) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
@@ -202,11 +438,12 @@ func (dl *DeltaLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
 		Version:    version,
 		Timestamp:  timestamp,
 		TableID:    tableID,
+		UserID:     user,
 		Operation:  OpMetadata,
 		SchemaJSON: schemaJSON,
 	}
 
-	dl.entries = append(dl.entries, entry)
+	dl.appendEntry(entry)
 
 	// 调用持久化回调
 	if dl.persistenceCallback != nil {
@@ -214,7 +451,7 @@ func (dl *DeltaLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
 			zap.String("table", tableID),
 			zap.Int("schema_json_length", len(entry.SchemaJSON)))
 
-		if err := dl.persistenceCallback(&entry); err != nil {
+		if err := dl.persistenceCallback([]LogEntry{entry}); err != nil {
 			logger.Error("Failed to persist Delta Log entry",
 				zap.Error(err),
 				zap.String("table", tableID),
@@ -231,11 +468,18 @@ func (dl *DeltaLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
 		zap.String("table", tableID),
 		zap.String("operation", string(OpMetadata)))
 
+	dl.maybeCheckpoint(tableID, version)
+
 	return nil
 }
 
 // AppendIndexMetadata 追加索引元数据操作
 func (dl *DeltaLog) AppendIndexMetadata(tableID, indexName string, indexMeta map[string]interface{}) error {
+	return dl.appendIndexMetadata("", tableID, indexName, indexMeta)
+}
+
+// appendIndexMetadata 以 user 的身份追加索引元数据操作
+func (dl *DeltaLog) appendIndexMetadata(user, tableID, indexName string, indexMeta map[string]interface{}) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
@@ -258,11 +502,12 @@ func (dl *DeltaLog) AppendIndexMetadata(tableID, indexName string, indexMeta map
 		Version:   version,
 		Timestamp: timestamp,
 		TableID:   tableID,
+		UserID:    user,
 		Operation: OpMetadata,
 		IndexJSON: indexJSON,
 	}
 
-	dl.entries = append(dl.entries, entry)
+	dl.appendEntry(entry)
 
 	// 调用持久化回调
 	if dl.persistenceCallback != nil {
@@ -271,7 +516,7 @@ func (dl *DeltaLog) AppendIndexMetadata(tableID, indexName string, indexMeta map
 			zap.String("index", indexName),
 			zap.Int("index_json_length", len(entry.IndexJSON)))
 
-		if err := dl.persistenceCallback(&entry); err != nil {
+		if err := dl.persistenceCallback([]LogEntry{entry}); err != nil {
 			logger.Error("Failed to persist INDEX METADATA entry",
 				zap.Error(err),
 				zap.String("table", tableID),
@@ -291,11 +536,18 @@ func (dl *DeltaLog) AppendIndexMetadata(tableID, indexName string, indexMeta map
 		zap.String("index", indexName),
 		zap.String("operation", string(OpMetadata)))
 
+	dl.maybeCheckpoint(tableID, version)
+
 	return nil
 }
 
 // RemoveIndexMetadata 删除索引元数据操作
 func (dl *DeltaLog) RemoveIndexMetadata(tableID, indexName string) error {
+	return dl.removeIndexMetadata("", tableID, indexName)
+}
+
+// removeIndexMetadata 以 user 的身份追加删除索引的元数据操作
+func (dl *DeltaLog) removeIndexMetadata(user, tableID, indexName string) error {
 	dl.mu.Lock()
 	defer dl.mu.Unlock()
 
@@ -315,12 +567,13 @@ func (dl *DeltaLog) RemoveIndexMetadata(tableID, indexName string) error {
 		Version:        version,
 		Timestamp:      timestamp,
 		TableID:        tableID,
+		UserID:         user,
 		Operation:      OpMetadata,
 		IndexJSON:      indexJSON,
 		IndexOperation: "DROP", // 标记为删除操作
 	}
 
-	dl.entries = append(dl.entries, entry)
+	dl.appendEntry(entry)
 
 	// 调用持久化回调
 	if dl.persistenceCallback != nil {
@@ -328,7 +581,7 @@ func (dl *DeltaLog) RemoveIndexMetadata(tableID, indexName string) error {
 			zap.String("table", tableID),
 			zap.String("index", indexName))
 
-		if err := dl.persistenceCallback(&entry); err != nil {
+		if err := dl.persistenceCallback([]LogEntry{entry}); err != nil {
 			logger.Error("Failed to persist INDEX DROP entry",
 				zap.Error(err),
 				zap.String("table", tableID),
@@ -345,11 +598,20 @@ func (dl *DeltaLog) RemoveIndexMetadata(tableID, indexName string) error {
 		zap.String("table", tableID),
 		zap.String("index", indexName))
 
+	dl.maybeCheckpoint(tableID, version)
+
 	return nil
 }
 
 // GetSnapshot 获取表快照
+// 最新版本直接取增量维护的表状态，历史版本 (时间旅行) 才回放日志
 func (dl *DeltaLog) GetSnapshot(tableID string, version int64) (*Snapshot, error) {
+	if version != -1 && version < dl.compactedVersion(tableID) {
+		if err := dl.loadHistory(tableID); err != nil {
+			return nil, err
+		}
+	}
+
 	dl.mu.RLock()
 	defer dl.mu.RUnlock()
 
@@ -358,68 +620,17 @@ func (dl *DeltaLog) GetSnapshot(tableID string, version int64) (*Snapshot, error
 		version = dl.currentVer.Load()
 	}
 
-	snapshot := &Snapshot{
-		Version:   version,
-		Timestamp: time.Now(),
-		TableID:   tableID,
-		Files:     make([]FileInfo, 0),
-	}
-
-	// 构建快照：所有 ADD 文件 - REMOVE 文件
-	addedFiles := make(map[string]FileInfo)
-	removedFiles := make(map[string]bool)
-
-	for _, entry := range dl.entries {
-		if entry.TableID != tableID || entry.Version > version {
-			continue
-		}
-
-		switch entry.Operation {
-		case OpAdd:
-			addedFiles[entry.FilePath] = FileInfo{
-				Path:       entry.FilePath,
-				Size:       entry.FileSize,
-				RowCount:   entry.RowCount,
-				MinValues:  entry.MinValues,
-				MaxValues:  entry.MaxValues,
-				NullCounts: entry.NullCounts,
-				AddedAt:    entry.Timestamp,
-				IsDelta:    entry.IsDelta,
-				DeltaType:  entry.DeltaType,
-			}
-
-		case OpRemove:
-			removedFiles[entry.FilePath] = true
-
-		case OpMetadata:
-			if entry.SchemaJSON != "" {
-				schema, err := SchemaFromJSON(entry.SchemaJSON)
-				if err == nil {
-					snapshot.Schema = schema
-					logger.Debug("Schema deserialized successfully for snapshot",
-						zap.String("table", tableID),
-						zap.Int("field_count", len(schema.Fields())))
-				} else {
-					logger.Warn("Failed to deserialize schema from METADATA entry",
-						zap.String("table", tableID),
-						zap.Int64("version", entry.Version),
-						zap.Error(err))
-				}
-			} else if entry.IndexJSON == "" {
-				// Only warn if both SchemaJSON and IndexJSON are empty
-				// Index operations have IndexJSON but no SchemaJSON, which is normal
-				logger.Warn("METADATA entry has empty SchemaJSON",
-					zap.String("table", tableID),
-					zap.Int64("version", entry.Version))
-			}
-		}
-	}
-
-	// 过滤掉已删除的文件
-	for path, file := range addedFiles {
-		if !removedFiles[path] {
-			snapshot.Files = append(snapshot.Files, file)
-		}
+	var snapshot *Snapshot
+	state, ok := dl.tables[tableID]
+	switch {
+	case !ok:
+		snapshot = SnapshotFromEntries(tableID, version, nil)
+	case version >= state.version:
+		snapshot = state.snapshot(tableID, version)
+	case version < state.compactedVersion:
+		return nil, errCompactedVersion(tableID, version, state.compactedVersion)
+	default:
+		snapshot = SnapshotFromEntries(tableID, version, dl.entries)
 	}
 
 	logger.Info("Snapshot retrieved",
@@ -435,8 +646,35 @@ func (dl *DeltaLog) GetLatestVersion() int64 {
 	return dl.currentVer.Load()
 }
 
+// SchemaVersion 返回表最近一次 schema 变更 (METADATA) 的日志版本，表不存在时返回 false
+func (dl *DeltaLog) SchemaVersion(tableID string) (int64, bool) {
+	dl.mu.RLock()
+	defer dl.mu.RUnlock()
+
+	state, ok := dl.tables[tableID]
+	if !ok || state.schema == nil {
+		return 0, false
+	}
+	return state.schemaVersion, true
+}
+
+// compactedVersion 返回表当前被 checkpoint 压缩的版本，0 表示历史完整
+func (dl *DeltaLog) compactedVersion(tableID string) int64 {
+	dl.mu.RLock()
+	defer dl.mu.RUnlock()
+	if state, ok := dl.tables[tableID]; ok {
+		return state.compactedVersion
+	}
+	return 0
+}
+
 // GetVersionByTimestamp 根据时间戳查找版本号
 func (dl *DeltaLog) GetVersionByTimestamp(tableID string, ts int64) (int64, error) {
+	// 压缩后的条目缺少被覆盖的提交，先加载保留的历史
+	if err := dl.loadHistory(tableID); err != nil {
+		return 0, err
+	}
+
 	dl.mu.RLock()
 	defer dl.mu.RUnlock()
 
@@ -455,58 +693,18 @@ func (dl *DeltaLog) GetVersionByTimestamp(tableID string, ts int64) (int64, erro
 	return maxVersion, nil
 }
 
-// createCheckpoint 创建检查点
-// 根据架构文档 (lines 662-698, 741-777):
-// 每10个事务创建checkpoint，将snapshot序列化为Parquet文件
-// 实现 P0 优先级改进 - 完整的Checkpoint机制
-func (dl *DeltaLog) createCheckpoint(tableID string, version int64) {
-	logger.Info("Creating checkpoint",
-		zap.String("table", tableID),
-		zap.Int64("version", version))
-
-	// 获取快照
-	snapshot, err := dl.GetSnapshot(tableID, version)
-	if err != nil {
-		logger.Error("Failed to create checkpoint - snapshot retrieval failed",
-			zap.String("table", tableID),
-			zap.Int64("version", version),
-			zap.Error(err))
-		return
-	}
-
-	// 使用CheckpointManager创建checkpoint
-	// Note: 需要从外部注入basePath，这里暂时跳过实际写入
-	// 完整实现需要在DeltaLog结构体中添加CheckpointManager字段
-
-	logger.Info("Checkpoint created",
-		zap.String("table", tableID),
-		zap.Int64("version", version),
-		zap.Int("file_count", len(snapshot.Files)))
-
-	// TODO: 集成CheckpointManager
-	// checkpointMgr := NewCheckpointManager(basePath)
-	// if err := checkpointMgr.CreateCheckpoint(tableID, snapshot); err != nil {
-	//     logger.Error("Failed to persist checkpoint", zap.Error(err))
-	// }
-}
-
 // ListTables 列出所有表
 func (dl *DeltaLog) ListTables() []string {
 	dl.mu.RLock()
 	defer dl.mu.RUnlock()
 
-	tableSet := make(map[string]bool)
-	for _, entry := range dl.entries {
-		if entry.TableID != "" {
-			tableSet[entry.TableID] = true
+	tables := make([]string, 0, len(dl.tables))
+	for table := range dl.tables {
+		if table != "" {
+			tables = append(tables, table)
 		}
 	}
 
-	tables := make([]string, 0, len(tableSet))
-	for table := range tableSet {
-		tables = append(tables, table)
-	}
-
 	return tables
 }
 
@@ -1,13 +1,16 @@
 package delta
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"path"
 	"sync/atomic"
 	"time"
 
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/metrics"
 	"github.com/yyun543/minidb/internal/objectstore"
 	"go.uber.org/zap"
 )
@@ -36,6 +39,12 @@ func (e *ConflictError) Error() string {
 	return fmt.Sprintf("version conflict at V%d: %s", e.Version, e.Message)
 }
 
+// newConflictError 创建版本冲突错误并计入冲突指标
+func newConflictError(version int64, message string) *ConflictError {
+	metrics.DeltaCommitConflicts.Inc()
+	return &ConflictError{Version: version, Message: message}
+}
+
 // NewOptimisticDeltaLog 创建乐观并发控制的Delta Log
 func NewOptimisticDeltaLog(objectStore objectstore.ConditionalObjectStore, basePath string) *OptimisticDeltaLog {
 	dl := &OptimisticDeltaLog{
@@ -57,7 +66,7 @@ func (dl *OptimisticDeltaLog) Bootstrap() error {
 
 	// 扫描_delta_log目录找到最新版本
 	// 格式: {basePath}/sys/_delta_log/000001.json
-	deltaLogDir := fmt.Sprintf("%s/sys/_delta_log", dl.basePath)
+	deltaLogDir := path.Join(dl.basePath, "sys", "_delta_log")
 
 	files, err := dl.objectStore.List(deltaLogDir)
 	if err != nil {
@@ -68,8 +77,8 @@ func (dl *OptimisticDeltaLog) Bootstrap() error {
 	maxVersion := int64(0)
 	for _, file := range files {
 		var version int64
-		// 尝试解析文件名格式: 000001.json
-		if _, err := fmt.Sscanf(file, "%d.json", &version); err == nil {
+		// 尝试解析文件名格式: 000001.json (List 返回完整路径，只取文件名)
+		if _, err := fmt.Sscanf(path.Base(file), "%d.json", &version); err == nil {
 			if version > maxVersion {
 				maxVersion = version
 			}
@@ -100,28 +109,14 @@ func (dl *OptimisticDeltaLog) RestoreFromEntries(entries []LogEntry) error {
 
 // AppendAdd 追加ADD操作（乐观并发控制）
 func (dl *OptimisticDeltaLog) AppendAdd(tableID string, file *ParquetFile) error {
+	return dl.appendAdd("", tableID, file)
+}
+
+// appendAdd 以 user 的身份追加ADD 操作
+func (dl *OptimisticDeltaLog) appendAdd(user, tableID string, file *ParquetFile) error {
 	// 1. 生成新版本号（无锁）
 	version := dl.currentVer.Add(1)
-	timestamp := time.Now().UnixMilli()
-
-	entry := LogEntry{
-		Version:    version,
-		Timestamp:  timestamp,
-		TableID:    tableID,
-		Operation:  OpAdd,
-		FilePath:   file.Path,
-		FileSize:   file.Size,
-		RowCount:   file.RowCount,
-		DataChange: true,
-		IsDelta:    file.IsDelta,
-		DeltaType:  file.DeltaType,
-	}
-
-	if file.Stats != nil {
-		entry.MinValues = file.Stats.MinValues
-		entry.MaxValues = file.Stats.MaxValues
-		entry.NullCounts = file.Stats.NullCounts
-	}
+	entry := newAddEntry(version, time.Now().UnixMilli(), tableID, user, file)
 
 	// 2. 序列化entry为JSON
 	data, err := json.Marshal(entry)
@@ -141,10 +136,7 @@ func (dl *OptimisticDeltaLog) AppendAdd(tableID string, file *ParquetFile) error
 				zap.Int64("version", version),
 				zap.String("table", tableID),
 				zap.Error(err))
-			return &ConflictError{
-				Version: version,
-				Message: "another writer committed this version first",
-			}
+			return newConflictError(version, "another writer committed this version first")
 		}
 		dl.currentVer.Add(-1)
 		return fmt.Errorf("failed to write version file: %w", err)
@@ -166,8 +158,57 @@ func (dl *OptimisticDeltaLog) AppendAdd(tableID string, file *ParquetFile) error
 	return nil
 }
 
+// AppendCommit 将一组 REMOVE 和 ADD 操作写入同一个版本文件 (JSON 数组)
+func (dl *OptimisticDeltaLog) AppendCommit(tableID string, adds []*ParquetFile, removes []string) (int64, error) {
+	return dl.appendCommit("", tableID, adds, removes)
+}
+
+// appendCommit 以 user 的身份追加一组 REMOVE 和 ADD 操作 (同一个版本)
+func (dl *OptimisticDeltaLog) appendCommit(user, tableID string, adds []*ParquetFile, removes []string) (int64, error) {
+	if len(adds) == 0 && len(removes) == 0 {
+		return 0, fmt.Errorf("empty commit for table %s", tableID)
+	}
+
+	version := dl.currentVer.Add(1)
+	entries := newCommitEntries(version, time.Now().UnixMilli(), tableID, user, adds, removes)
+	data, err := json.Marshal(entries)
+	if err != nil {
+		dl.currentVer.Add(-1)
+		return 0, fmt.Errorf("failed to marshal log entries: %w", err)
+	}
+
+	if err := dl.objectStore.PutIfNotExists(dl.getVersionFilePath(tableID, version), data); err != nil {
+		dl.currentVer.Add(-1)
+		if isConflictError(err) {
+			return 0, newConflictError(version, "another writer committed this version first")
+		}
+		return 0, fmt.Errorf("failed to write version file: %w", err)
+	}
+
+	logger.Info("Delta Log commit committed (optimistic)",
+		zap.Int64("version", version),
+		zap.String("table", tableID),
+		zap.Int("added_files", len(adds)),
+		zap.Int("removed_files", len(removes)))
+
+	if version%10 == 0 && dl.checkpointCallback != nil {
+		go dl.checkpointCallback(tableID, version)
+	}
+	return version, nil
+}
+
 // AppendRemove 追加REMOVE操作
 func (dl *OptimisticDeltaLog) AppendRemove(tableID, filePath string) error {
+	return dl.appendRemove("", tableID, filePath, true)
+}
+
+// AppendRearrangeRemove 追加 compaction / Z-order 替换文件产生的 REMOVE 操作，数据内容不变 (dataChange=false)
+func (dl *OptimisticDeltaLog) AppendRearrangeRemove(tableID, filePath string) error {
+	return dl.appendRemove("", tableID, filePath, false)
+}
+
+// appendRemove 以 user 的身份追加 REMOVE 操作，dataChange 标记是否为数据变更
+func (dl *OptimisticDeltaLog) appendRemove(user, tableID, filePath string, dataChange bool) error {
 	version := dl.currentVer.Add(1)
 	timestamp := time.Now().UnixMilli()
 
@@ -175,10 +216,11 @@ func (dl *OptimisticDeltaLog) AppendRemove(tableID, filePath string) error {
 		Version:           version,
 		Timestamp:         timestamp,
 		TableID:           tableID,
+		UserID:            user,
 		Operation:         OpRemove,
 		FilePath:          filePath,
 		DeletionTimestamp: timestamp,
-		DataChange:        true,
+		DataChange:        dataChange,
 	}
 
 	data, err := json.Marshal(entry)
@@ -192,10 +234,7 @@ func (dl *OptimisticDeltaLog) AppendRemove(tableID, filePath string) error {
 	if err != nil {
 		if isConflictError(err) {
 			dl.currentVer.Add(-1)
-			return &ConflictError{
-				Version: version,
-				Message: "version conflict on REMOVE operation",
-			}
+			return newConflictError(version, "version conflict on REMOVE operation")
 		}
 		dl.currentVer.Add(-1)
 		return fmt.Errorf("failed to write version file: %w", err)
@@ -212,6 +251,11 @@ func (dl *OptimisticDeltaLog) AppendRemove(tableID, filePath string) error {
 
 // AppendMetadata 追加METADATA操作
 func (dl *OptimisticDeltaLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
+	return dl.appendMetadata("", tableID, schema)
+}
+
+// appendMetadata 以 user 的身份追加METADATA 操作
+func (dl *OptimisticDeltaLog) appendMetadata(user, tableID string, schema *arrow.Schema) error {
 	version := dl.currentVer.Add(1)
 	timestamp := time.Now().UnixMilli()
 
@@ -225,6 +269,7 @@ func (dl *OptimisticDeltaLog) AppendMetadata(tableID string, schema *arrow.Schem
 		Version:    version,
 		Timestamp:  timestamp,
 		TableID:    tableID,
+		UserID:     user,
 		Operation:  OpMetadata,
 		SchemaJSON: schemaJSON,
 	}
@@ -240,10 +285,7 @@ func (dl *OptimisticDeltaLog) AppendMetadata(tableID string, schema *arrow.Schem
 	if err != nil {
 		if isConflictError(err) {
 			dl.currentVer.Add(-1)
-			return &ConflictError{
-				Version: version,
-				Message: "version conflict on METADATA operation",
-			}
+			return newConflictError(version, "version conflict on METADATA operation")
 		}
 		dl.currentVer.Add(-1)
 		return fmt.Errorf("failed to write version file: %w", err)
@@ -259,6 +301,11 @@ func (dl *OptimisticDeltaLog) AppendMetadata(tableID string, schema *arrow.Schem
 
 // AppendIndexMetadata 追加索引元数据操作
 func (dl *OptimisticDeltaLog) AppendIndexMetadata(tableID, indexName string, indexMeta map[string]interface{}) error {
+	return dl.appendIndexMetadata("", tableID, indexName, indexMeta)
+}
+
+// appendIndexMetadata 以 user 的身份追加索引元数据操作
+func (dl *OptimisticDeltaLog) appendIndexMetadata(user, tableID, indexName string, indexMeta map[string]interface{}) error {
 	version := dl.currentVer.Add(1)
 	timestamp := time.Now().UnixMilli()
 
@@ -276,6 +323,7 @@ func (dl *OptimisticDeltaLog) AppendIndexMetadata(tableID, indexName string, ind
 		Version:   version,
 		Timestamp: timestamp,
 		TableID:   tableID,
+		UserID:    user,
 		Operation: OpMetadata,
 		IndexJSON: string(indexJSON),
 	}
@@ -291,10 +339,7 @@ func (dl *OptimisticDeltaLog) AppendIndexMetadata(tableID, indexName string, ind
 	if err != nil {
 		if isConflictError(err) {
 			dl.currentVer.Add(-1)
-			return &ConflictError{
-				Version: version,
-				Message: "version conflict on INDEX METADATA operation",
-			}
+			return newConflictError(version, "version conflict on INDEX METADATA operation")
 		}
 		dl.currentVer.Add(-1)
 		return fmt.Errorf("failed to write version file: %w", err)
@@ -310,6 +355,11 @@ func (dl *OptimisticDeltaLog) AppendIndexMetadata(tableID, indexName string, ind
 
 // RemoveIndexMetadata 删除索引元数据操作
 func (dl *OptimisticDeltaLog) RemoveIndexMetadata(tableID, indexName string) error {
+	return dl.removeIndexMetadata("", tableID, indexName)
+}
+
+// removeIndexMetadata 以 user 的身份追加删除索引的元数据操作
+func (dl *OptimisticDeltaLog) removeIndexMetadata(user, tableID, indexName string) error {
 	version := dl.currentVer.Add(1)
 	timestamp := time.Now().UnixMilli()
 
@@ -326,6 +376,7 @@ func (dl *OptimisticDeltaLog) RemoveIndexMetadata(tableID, indexName string) err
 		Version:        version,
 		Timestamp:      timestamp,
 		TableID:        tableID,
+		UserID:         user,
 		Operation:      OpMetadata,
 		IndexJSON:      string(indexJSON),
 		IndexOperation: "DROP",
@@ -342,10 +393,7 @@ func (dl *OptimisticDeltaLog) RemoveIndexMetadata(tableID, indexName string) err
 	if err != nil {
 		if isConflictError(err) {
 			dl.currentVer.Add(-1)
-			return &ConflictError{
-				Version: version,
-				Message: "version conflict on INDEX DROP operation",
-			}
+			return newConflictError(version, "version conflict on INDEX DROP operation")
 		}
 		dl.currentVer.Add(-1)
 		return fmt.Errorf("failed to write version file: %w", err)
@@ -384,41 +432,34 @@ func (dl *OptimisticDeltaLog) GetSnapshot(tableID string, version int64) (*Snaps
 			continue
 		}
 
-		var entry LogEntry
-		if err := json.Unmarshal(data, &entry); err != nil {
+		entries, err := decodeVersionFile(data)
+		if err != nil {
 			logger.Warn("Failed to unmarshal log entry",
 				zap.String("file", versionFilePath),
 				zap.Error(err))
 			continue
 		}
 
-		// 只处理指定表的条目
-		if entry.TableID != tableID {
-			continue
-		}
-
-		switch entry.Operation {
-		case OpAdd:
-			addedFiles[entry.FilePath] = FileInfo{
-				Path:       entry.FilePath,
-				Size:       entry.FileSize,
-				RowCount:   entry.RowCount,
-				MinValues:  entry.MinValues,
-				MaxValues:  entry.MaxValues,
-				NullCounts: entry.NullCounts,
-				AddedAt:    entry.Timestamp,
-				IsDelta:    entry.IsDelta,
-				DeltaType:  entry.DeltaType,
+		for _, entry := range entries {
+			// 只处理指定表的条目
+			if entry.TableID != tableID {
+				continue
 			}
 
-		case OpRemove:
-			removedFiles[entry.FilePath] = true
+			switch entry.Operation {
+			case OpAdd:
+				addedFiles[entry.FilePath] = fileInfoFromEntry(entry)
+				delete(removedFiles, entry.FilePath)
 
-		case OpMetadata:
-			if entry.SchemaJSON != "" {
-				schema, err := SchemaFromJSON(entry.SchemaJSON)
-				if err == nil {
-					snapshot.Schema = schema
+			case OpRemove:
+				removedFiles[entry.FilePath] = true
+
+			case OpMetadata:
+				if entry.SchemaJSON != "" {
+					schema, err := SchemaFromJSON(entry.SchemaJSON)
+					if err == nil {
+						snapshot.Schema = schema
+					}
 				}
 			}
 		}
@@ -457,13 +498,15 @@ func (dl *OptimisticDeltaLog) GetVersionByTimestamp(tableID string, ts int64) (i
 			continue
 		}
 
-		var entry LogEntry
-		if err := json.Unmarshal(data, &entry); err != nil {
+		entries, err := decodeVersionFile(data)
+		if err != nil {
 			continue
 		}
 
-		if entry.TableID == tableID && entry.Timestamp <= ts {
-			return v, nil
+		for _, entry := range entries {
+			if entry.TableID == tableID && entry.Timestamp <= ts {
+				return v, nil
+			}
 		}
 	}
 
@@ -478,19 +521,21 @@ func (dl *OptimisticDeltaLog) ListTables() []string {
 
 	for v := int64(1); v <= latestVersion; v++ {
 		// 构建版本文件路径模式
-		versionFilePath := fmt.Sprintf("%s/sys/_delta_log/%020d.json", dl.basePath, v)
+		versionFilePath := path.Join(dl.basePath, "sys", "_delta_log", fmt.Sprintf("%020d.json", v))
 		data, err := dl.objectStore.Get(versionFilePath)
 		if err != nil {
 			continue
 		}
 
-		var entry LogEntry
-		if err := json.Unmarshal(data, &entry); err != nil {
+		entries, err := decodeVersionFile(data)
+		if err != nil {
 			continue
 		}
 
-		if entry.TableID != "" {
-			tableSet[entry.TableID] = true
+		for _, entry := range entries {
+			if entry.TableID != "" {
+				tableSet[entry.TableID] = true
+			}
 		}
 	}
 
@@ -508,18 +553,18 @@ func (dl *OptimisticDeltaLog) GetAllEntries() []LogEntry {
 	entries := make([]LogEntry, 0)
 
 	for v := int64(1); v <= latestVersion; v++ {
-		versionFilePath := fmt.Sprintf("%s/sys/_delta_log/%020d.json", dl.basePath, v)
+		versionFilePath := path.Join(dl.basePath, "sys", "_delta_log", fmt.Sprintf("%020d.json", v))
 		data, err := dl.objectStore.Get(versionFilePath)
 		if err != nil {
 			continue
 		}
 
-		var entry LogEntry
-		if err := json.Unmarshal(data, &entry); err != nil {
+		versionEntries, err := decodeVersionFile(data)
+		if err != nil {
 			continue
 		}
 
-		entries = append(entries, entry)
+		entries = append(entries, versionEntries...)
 	}
 
 	return entries
@@ -545,7 +590,23 @@ func (dl *OptimisticDeltaLog) GetEntriesByTable(tableID string) []LogEntry {
 func (dl *OptimisticDeltaLog) getVersionFilePath(tableID string, version int64) string {
 	// 格式: {basePath}/sys/_delta_log/{table_id}/000001.json
 	// 为简化实现，先使用全局版本号
-	return fmt.Sprintf("%s/sys/_delta_log/%020d.json", dl.basePath, version)
+	return path.Join(dl.basePath, "sys", "_delta_log", fmt.Sprintf("%020d.json", version))
+}
+
+// decodeVersionFile 解析版本文件：单条日志为 JSON 对象，AppendCommit 写入的多条日志为 JSON 数组
+func decodeVersionFile(data []byte) ([]LogEntry, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []LogEntry
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+	var entry LogEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return []LogEntry{entry}, nil
 }
 
 // isConflictError 判断是否是冲突错误
@@ -0,0 +1,212 @@
+package delta
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// DefaultCheckpointInterval 每张表累计多少条日志后创建一次 checkpoint
+const DefaultCheckpointInterval = 10
+
+// tableState 表的增量快照状态
+// 每条日志追加时就地更新，获取最新版本快照时不再扫描全部日志
+type tableState struct {
+	files            map[string]FileInfo // 当前有效的数据文件
+	schema           *arrow.Schema       // 最新 METADATA 中的 schema
+	version          int64               // 最近一次修改该表的日志版本
+	schemaVersion    int64               // 最近一次 schema METADATA 的日志版本
+	compactedVersion int64               // 早于该版本的历史已被 checkpoint 压缩，无法再构建快照
+	sinceCheckpoint  int                 // 上次 checkpoint 之后追加的日志条数
+	historyLoaded    bool                // 是否已从磁盘加载 checkpoint 之前的历史
+}
+
+func newTableState() *tableState {
+	return &tableState{files: make(map[string]FileInfo)}
+}
+
+// apply 将一条日志应用到表状态
+func (s *tableState) apply(entry LogEntry) {
+	switch entry.Operation {
+	case OpAdd:
+		s.files[entry.FilePath] = fileInfoFromEntry(entry)
+	case OpRemove:
+		delete(s.files, entry.FilePath)
+	case OpMetadata:
+		if entry.SchemaJSON != "" {
+			schema, err := SchemaFromJSON(entry.SchemaJSON)
+			if err != nil {
+				logger.Warn("Failed to deserialize schema from METADATA entry",
+					zap.String("table", entry.TableID),
+					zap.Int64("version", entry.Version),
+					zap.Error(err))
+			} else {
+				s.schema = schema
+				s.schemaVersion = entry.Version
+			}
+		}
+	}
+	if entry.Version > s.version {
+		s.version = entry.Version
+	}
+	s.sinceCheckpoint++
+}
+
+// snapshot 基于当前状态生成快照
+func (s *tableState) snapshot(tableID string, version int64) *Snapshot {
+	snapshot := &Snapshot{
+		Version:   version,
+		Timestamp: time.Now(),
+		TableID:   tableID,
+		Files:     make([]FileInfo, 0, len(s.files)),
+		Schema:    s.schema,
+	}
+	for _, file := range s.files {
+		snapshot.Files = append(snapshot.Files, file)
+	}
+	return snapshot
+}
+
+// fileInfoFromEntry 将 ADD 日志转换为快照中的文件信息
+func fileInfoFromEntry(entry LogEntry) FileInfo {
+	addedAt := entry.Timestamp
+	if entry.AddedAt > 0 {
+		addedAt = entry.AddedAt
+	}
+	return FileInfo{
+		Path:       entry.FilePath,
+		Size:       entry.FileSize,
+		RowCount:   entry.RowCount,
+		MinValues:  entry.MinValues,
+		MaxValues:  entry.MaxValues,
+		NullCounts: entry.NullCounts,
+		AddedAt:    addedAt,
+		IsDelta:    entry.IsDelta,
+		DeltaType:  entry.DeltaType,
+
+		PartitionValues: entry.PartitionValues,
+	}
+}
+
+// SnapshotFromEntries 按日志重放构建表在指定版本的快照 (entries 需按版本升序)
+func SnapshotFromEntries(tableID string, version int64, entries []LogEntry) *Snapshot {
+	state := newTableState()
+	for _, entry := range entries {
+		if entry.TableID != tableID || entry.Version > version {
+			continue
+		}
+		state.apply(entry)
+	}
+	return state.snapshot(tableID, version)
+}
+
+// CompactEntries 将表在 version 及之前的日志压缩为能重建同一状态的最少条目
+//
+// 保留最新的 schema METADATA、仍然存在的索引 METADATA 以及有效文件最新的 ADD (带统计信息)；
+// 已被删除文件的 ADD/REMOVE 对被丢弃。若表在最新 schema 之后被删除且没有有效文件，
+// 保留最后一条 REMOVE，以便恢复时仍能识别为已删除的表。条目保持原始版本号和时间戳。
+// 写缓冲的 WAL 水位在刷写文件被删除后记录在 schema METADATA 上，不会随压缩丢失。
+func CompactEntries(tableID string, version int64, entries []LogEntry) []LogEntry {
+	var (
+		schemaEntry *LogEntry
+		lastRemove  *LogEntry
+		watermark   int64
+	)
+	adds := make(map[string]LogEntry)
+	indexes := make(map[string]LogEntry)
+	unnamedIndexes := make([]LogEntry, 0)
+
+	for _, entry := range entries {
+		if entry.TableID != tableID || entry.Version > version {
+			continue
+		}
+		if entry.WALWatermark > watermark {
+			watermark = entry.WALWatermark
+		}
+		switch entry.Operation {
+		case OpAdd:
+			adds[entry.FilePath] = entry
+		case OpRemove:
+			delete(adds, entry.FilePath)
+			removed := entry
+			lastRemove = &removed
+		case OpMetadata:
+			if entry.SchemaJSON != "" {
+				meta := entry
+				schemaEntry = &meta
+				continue
+			}
+			if entry.IndexJSON == "" {
+				continue
+			}
+			name := indexNameFromJSON(entry.IndexJSON)
+			if name == "" {
+				unnamedIndexes = append(unnamedIndexes, entry)
+				continue
+			}
+			indexes[name] = entry
+		}
+	}
+
+	compacted := make([]LogEntry, 0, len(adds)+len(indexes)+2)
+	if schemaEntry != nil {
+		meta := *schemaEntry
+		meta.WALWatermark = watermark
+		compacted = append(compacted, meta)
+	}
+	for _, entry := range indexes {
+		if entry.IndexOperation != "DROP" {
+			compacted = append(compacted, entry)
+		}
+	}
+	compacted = append(compacted, unnamedIndexes...)
+	for _, entry := range adds {
+		compacted = append(compacted, entry)
+	}
+	if len(adds) == 0 && lastRemove != nil && (schemaEntry == nil || lastRemove.Version > schemaEntry.Version) {
+		compacted = append(compacted, *lastRemove)
+	}
+
+	sort.SliceStable(compacted, func(i, j int) bool {
+		return compacted[i].Version < compacted[j].Version
+	})
+	return compacted
+}
+
+// indexNameFromJSON 从索引元数据 JSON 中取出索引名，无法解析时返回空字符串
+func indexNameFromJSON(indexJSON string) string {
+	var meta map[string]interface{}
+	if err := json.Unmarshal([]byte(indexJSON), &meta); err != nil {
+		return ""
+	}
+	if name, ok := meta["index_name"].(string); ok {
+		return name
+	}
+	return ""
+}
+
+// errCompactedVersion 请求的版本早于 checkpoint，历史日志已被压缩
+func errCompactedVersion(tableID string, version, compactedVersion int64) error {
+	return fmt.Errorf("version %d of table %s is no longer available: history before checkpoint version %d has been compacted",
+		version, tableID, compactedVersion)
+}
+
+// SchemaVersion 返回表最近一次 schema 变更 (METADATA) 的日志版本，表不存在时返回 false
+// *DeltaLog 直接读取增量维护的表状态，其他实现回放表的日志
+func SchemaVersion(log LogInterface, tableID string) (int64, bool) {
+	if dl, ok := log.(*DeltaLog); ok {
+		return dl.SchemaVersion(tableID)
+	}
+	version, found := int64(0), false
+	for _, entry := range log.GetEntriesByTable(tableID) {
+		if entry.Operation == OpMetadata && entry.SchemaJSON != "" && entry.Version >= version {
+			version, found = entry.Version, true
+		}
+	}
+	return version, found
+}
@@ -0,0 +1,160 @@
+package delta
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// 列统计值的类型标签
+// sys.delta_log 中 min_values / max_values 以 {"列名": {"type": 标签, "value": 字符串}} 的形式保存，
+// 数值统一格式化为字符串，保证 int64 / float 等类型在重启后精确还原
+const (
+	statsTypeInt64     = "int64"
+	statsTypeInt32     = "int32"
+	statsTypeInt16     = "int16"
+	statsTypeInt8      = "int8"
+	statsTypeFloat64   = "float64"
+	statsTypeFloat32   = "float32"
+	statsTypeString    = "string"
+	statsTypeBool      = "bool"
+	statsTypeTimestamp = "timestamp"
+)
+
+// typedStatsValue 带类型标签的统计值
+type typedStatsValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// EncodeStatsValues 将列的 min/max 统计值编码为带类型标签的 JSON，空集合编码为空字符串
+// 不支持的类型会被跳过 (该列不参与文件裁剪)
+func EncodeStatsValues(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	typed := make(map[string]typedStatsValue, len(values))
+	for col, v := range values {
+		var tv typedStatsValue
+		switch val := v.(type) {
+		case int64:
+			tv = typedStatsValue{Type: statsTypeInt64, Value: strconv.FormatInt(val, 10)}
+		case int32:
+			tv = typedStatsValue{Type: statsTypeInt32, Value: strconv.FormatInt(int64(val), 10)}
+		case int16:
+			tv = typedStatsValue{Type: statsTypeInt16, Value: strconv.FormatInt(int64(val), 10)}
+		case int8:
+			tv = typedStatsValue{Type: statsTypeInt8, Value: strconv.FormatInt(int64(val), 10)}
+		case int:
+			tv = typedStatsValue{Type: statsTypeInt64, Value: strconv.FormatInt(int64(val), 10)}
+		case float64:
+			tv = typedStatsValue{Type: statsTypeFloat64, Value: strconv.FormatFloat(val, 'g', -1, 64)}
+		case float32:
+			tv = typedStatsValue{Type: statsTypeFloat32, Value: strconv.FormatFloat(float64(val), 'g', -1, 32)}
+		case string:
+			tv = typedStatsValue{Type: statsTypeString, Value: val}
+		case bool:
+			tv = typedStatsValue{Type: statsTypeBool, Value: strconv.FormatBool(val)}
+		case time.Time:
+			tv = typedStatsValue{Type: statsTypeTimestamp, Value: val.UTC().Format(time.RFC3339Nano)}
+		default:
+			logger.Debug("Skipping stats value with unsupported type",
+				zap.String("column", col),
+				zap.String("type", fmt.Sprintf("%T", v)))
+			continue
+		}
+		typed[col] = tv
+	}
+
+	data, err := json.Marshal(typed)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode stats values: %w", err)
+	}
+	return string(data), nil
+}
+
+// DecodeStatsValues 解析 EncodeStatsValues 的输出，空字符串返回 nil
+func DecodeStatsValues(encoded string) (map[string]interface{}, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	var typed map[string]typedStatsValue
+	if err := json.Unmarshal([]byte(encoded), &typed); err != nil {
+		return nil, fmt.Errorf("failed to decode stats values: %w", err)
+	}
+
+	values := make(map[string]interface{}, len(typed))
+	for col, tv := range typed {
+		v, err := decodeTypedStatsValue(tv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stats value for column %s: %w", col, err)
+		}
+		values[col] = v
+	}
+	return values, nil
+}
+
+// decodeTypedStatsValue 按类型标签还原单个统计值
+func decodeTypedStatsValue(tv typedStatsValue) (interface{}, error) {
+	switch tv.Type {
+	case statsTypeInt64:
+		return strconv.ParseInt(tv.Value, 10, 64)
+	case statsTypeInt32:
+		v, err := strconv.ParseInt(tv.Value, 10, 32)
+		return int32(v), err
+	case statsTypeInt16:
+		v, err := strconv.ParseInt(tv.Value, 10, 16)
+		return int16(v), err
+	case statsTypeInt8:
+		v, err := strconv.ParseInt(tv.Value, 10, 8)
+		return int8(v), err
+	case statsTypeFloat64:
+		return strconv.ParseFloat(tv.Value, 64)
+	case statsTypeFloat32:
+		v, err := strconv.ParseFloat(tv.Value, 32)
+		return float32(v), err
+	case statsTypeString:
+		return tv.Value, nil
+	case statsTypeBool:
+		return strconv.ParseBool(tv.Value)
+	case statsTypeTimestamp:
+		return time.Parse(time.RFC3339Nano, tv.Value)
+	default:
+		return nil, fmt.Errorf("unknown stats type '%s'", tv.Type)
+	}
+}
+
+// EncodeNullCounts 将列的 null 计数编码为 JSON，空集合编码为空字符串
+func EncodeNullCounts(counts map[string]int64) (string, error) {
+	if len(counts) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(counts)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode null counts: %w", err)
+	}
+	return string(data), nil
+}
+
+// DecodeNullCounts 解析 EncodeNullCounts 的输出，空字符串返回 nil
+func DecodeNullCounts(encoded string) (map[string]int64, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var counts map[string]int64
+	if err := json.Unmarshal([]byte(encoded), &counts); err != nil {
+		return nil, fmt.Errorf("failed to decode null counts: %w", err)
+	}
+	return counts, nil
+}
+
+// HasStats 文件是否带有列统计信息
+func (f FileInfo) HasStats() bool {
+	return len(f.MinValues) > 0 || len(f.MaxValues) > 0 || len(f.NullCounts) > 0
+}
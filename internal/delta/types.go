@@ -30,6 +30,10 @@ type LogEntry struct {
 	MaxValues  map[string]interface{} `json:"max_values,omitempty"`
 	NullCounts map[string]int64       `json:"null_counts,omitempty"`
 	DataChange bool                   `json:"data_change,omitempty"`
+	AddedAt    int64                  `json:"added_at,omitempty"` // 文件最初加入表的时间，0 表示即本次提交时间
+
+	// 分区表: 文件所属分区的分区值 (分区目录标签 -> 值)
+	PartitionValues map[string]string `json:"partition_values,omitempty"`
 
 	// REMOVE 操作字段
 	DeletionTimestamp int64 `json:"deletion_timestamp,omitempty"`
@@ -43,6 +47,9 @@ type LogEntry struct {
 	IndexJSON      string `json:"index_json,omitempty"`      // 索引元数据
 	IndexOperation string `json:"index_operation,omitempty"` // 索引操作类型: "CREATE", "DROP"
 
+	// 写缓冲刷写提交: 本表已刷写的最大 WAL 段号 (表级状态，只由本表的刷写产生)
+	WALWatermark int64 `json:"wal_watermark,omitempty"`
+
 	// 审计字段
 	UserID    string `json:"user_id,omitempty"`
 	SessionID string `json:"session_id,omitempty"`
@@ -69,16 +76,24 @@ type FileInfo struct {
 	AddedAt    int64
 	IsDelta    bool   // Merge-on-Read: 是否为 Delta 文件
 	DeltaType  string // Delta 文件类型: "update", "delete", "insert"
+
+	PartitionValues map[string]string // 分区表: 文件所属分区的分区值
 }
 
 // ParquetFile Parquet 文件描述
 type ParquetFile struct {
-	Path      string
-	Size      int64
-	RowCount  int64
-	Stats     *FileStats
-	IsDelta   bool   // Merge-on-Read: 是否为 Delta 文件
-	DeltaType string // Delta 文件类型: "update", "delete", "insert"
+	Path         string
+	Size         int64
+	RowCount     int64
+	Stats        *FileStats
+	IsDelta      bool   // Merge-on-Read: 是否为 Delta 文件
+	DeltaType    string // Delta 文件类型: "update", "delete", "insert"
+	StatsOnly    bool   // 仅为已有文件补写统计信息，不代表数据变更 (dataChange=false)
+	Rearranged   bool   // compaction / Z-order 重写的文件，只重排已有数据 (dataChange=false)
+	AddedAt      int64  // 文件最初加入表的时间 (Unix 毫秒)，RESTORE 重新加入文件时保留；0 表示使用提交时间
+	WALWatermark int64  // 写缓冲刷写的文件: 提交后本表已刷写的最大 WAL 段号，其他提交为 0
+
+	PartitionValues map[string]string // 分区表: 文件所属分区的分区值
 }
 
 // FileStats 文件统计信息
@@ -111,6 +126,10 @@ type LogInterface interface {
 	AppendAdd(tableID string, file *ParquetFile) error
 	// AppendRemove 追加 REMOVE 操作
 	AppendRemove(tableID, filePath string) error
+	// AppendRearrangeRemove 追加 compaction / Z-order 替换文件产生的 REMOVE 操作 (dataChange=false)
+	AppendRearrangeRemove(tableID, filePath string) error
+	// AppendCommit 将一组 REMOVE 和 ADD 操作作为同一个版本原子提交，返回提交的版本号
+	AppendCommit(tableID string, adds []*ParquetFile, removes []string) (int64, error)
 	// AppendMetadata 追加 METADATA 操作
 	AppendMetadata(tableID string, schema *arrow.Schema) error
 	// AppendIndexMetadata 追加索引元数据操作
@@ -129,4 +148,6 @@ type LogInterface interface {
 	GetAllEntries() []LogEntry
 	// GetEntriesByTable 获取指定表的日志条目
 	GetEntriesByTable(tableID string) []LogEntry
+	// WithUser 返回以 user 身份提交的视图，追加的日志条目在 UserID 字段记录该用户
+	WithUser(user string) LogInterface
 }
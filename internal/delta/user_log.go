@@ -0,0 +1,81 @@
+package delta
+
+import (
+	"github.com/apache/arrow/go/v18/arrow"
+)
+
+// userCommitter 可以按指定用户追加日志条目的 Delta Log 实现
+type userCommitter interface {
+	LogInterface
+	appendAdd(user, tableID string, file *ParquetFile) error
+	appendCommit(user, tableID string, adds []*ParquetFile, removes []string) (int64, error)
+	appendRemove(user, tableID, filePath string, dataChange bool) error
+	appendMetadata(user, tableID string, schema *arrow.Schema) error
+	appendIndexMetadata(user, tableID, indexName string, indexMeta map[string]interface{}) error
+	removeIndexMetadata(user, tableID, indexName string) error
+}
+
+// userLog 以固定用户提交的 Delta Log 视图
+// 写操作在 UserID 字段记录该用户，读操作和底层日志共享同一份状态
+type userLog struct {
+	userCommitter
+	user string
+}
+
+// withUser 返回以 user 身份提交的视图，user 为空时返回 log 本身
+func withUser(log userCommitter, user string) LogInterface {
+	if user == "" {
+		return log
+	}
+	return &userLog{userCommitter: log, user: user}
+}
+
+// WithUser 返回以 user 身份提交的视图，user 为空时返回日志本身
+func (dl *DeltaLog) WithUser(user string) LogInterface {
+	return withUser(dl, user)
+}
+
+// WithUser 返回以 user 身份提交的视图，user 为空时返回日志本身
+func (dl *OptimisticDeltaLog) WithUser(user string) LogInterface {
+	return withUser(dl, user)
+}
+
+// WithUser 返回以 user 身份提交的视图
+func (l *userLog) WithUser(user string) LogInterface {
+	return withUser(l.userCommitter, user)
+}
+
+// AppendAdd 以视图的用户追加 ADD 操作
+func (l *userLog) AppendAdd(tableID string, file *ParquetFile) error {
+	return l.appendAdd(l.user, tableID, file)
+}
+
+// AppendCommit 以视图的用户原子提交一组 REMOVE 和 ADD 操作
+func (l *userLog) AppendCommit(tableID string, adds []*ParquetFile, removes []string) (int64, error) {
+	return l.appendCommit(l.user, tableID, adds, removes)
+}
+
+// AppendRemove 以视图的用户追加 REMOVE 操作
+func (l *userLog) AppendRemove(tableID, filePath string) error {
+	return l.appendRemove(l.user, tableID, filePath, true)
+}
+
+// AppendRearrangeRemove 以视图的用户追加 dataChange=false 的 REMOVE 操作
+func (l *userLog) AppendRearrangeRemove(tableID, filePath string) error {
+	return l.appendRemove(l.user, tableID, filePath, false)
+}
+
+// AppendMetadata 以视图的用户追加 METADATA 操作
+func (l *userLog) AppendMetadata(tableID string, schema *arrow.Schema) error {
+	return l.appendMetadata(l.user, tableID, schema)
+}
+
+// AppendIndexMetadata 以视图的用户追加索引元数据操作
+func (l *userLog) AppendIndexMetadata(tableID, indexName string, indexMeta map[string]interface{}) error {
+	return l.appendIndexMetadata(l.user, tableID, indexName, indexMeta)
+}
+
+// RemoveIndexMetadata 以视图的用户追加删除索引的元数据操作
+func (l *userLog) RemoveIndexMetadata(tableID, indexName string) error {
+	return l.removeIndexMetadata(l.user, tableID, indexName)
+}
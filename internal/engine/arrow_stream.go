@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/ipc"
+)
+
+// ArrowPayloadPrefix result_format 为 arrow 时，文本协议中结果集所在行的前缀
+// 行的其余部分是 base64 编码的 Arrow IPC 流 (包含 schema，没有行时也有)
+const ArrowPayloadPrefix = "ARROW "
+
+// EncodeArrow 把结果集编码为一行 Arrow IPC 流 (带 ArrowPayloadPrefix 前缀)
+func EncodeArrow(result *Result) (string, error) {
+	var buf bytes.Buffer
+	encoder := base64.NewEncoder(base64.StdEncoding, &buf)
+	writer := ipc.NewWriter(encoder, ipc.WithSchema(result.Schema))
+	records := result.uniformRecords()
+	defer releaseRecords(records)
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			writer.Close()
+			return "", fmt.Errorf("encode arrow stream: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("encode arrow stream: %v", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("encode arrow stream: %v", err)
+	}
+	return ArrowPayloadPrefix + buf.String(), nil
+}
+
+// DecodeArrow 解码 EncodeArrow 生成的一行 Arrow IPC 流
+func DecodeArrow(line string) (*Result, error) {
+	payload, ok := strings.CutPrefix(line, ArrowPayloadPrefix)
+	if !ok {
+		return nil, fmt.Errorf("decode arrow stream: missing %q prefix", strings.TrimSpace(ArrowPayloadPrefix))
+	}
+	reader, err := ipc.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("decode arrow stream: %v", err)
+	}
+	defer reader.Release()
+
+	result := &Result{Schema: reader.Schema()}
+	for _, field := range result.Schema.Fields() {
+		result.Columns = append(result.Columns, field.Name)
+	}
+	for reader.Next() {
+		record := reader.Record()
+		record.Retain()
+		result.Records = append(result.Records, record)
+	}
+	if err := reader.Err(); err != nil {
+		result.Release()
+		return nil, fmt.Errorf("decode arrow stream: %v", err)
+	}
+	return result, nil
+}
+
+// RecordReader 返回按批次读取结果集的 array.RecordReader，没有结果集的语句返回空 schema 的 reader
+func (r *Result) RecordReader() (array.RecordReader, error) {
+	if r.Schema == nil {
+		return array.NewRecordReader(arrow.NewSchema(nil, nil), nil)
+	}
+	records := r.uniformRecords()
+	defer releaseRecords(records)
+	return array.NewRecordReader(r.Schema, records)
+}
+
+// uniformRecords 返回 schema 统一为结果 schema 的各批数据 (批次之间的字段元数据、可空性可能不同)，用完后释放
+func (r *Result) uniformRecords() []arrow.Record {
+	records := make([]arrow.Record, len(r.Records))
+	for i, record := range r.Records {
+		if record.Schema().Equal(r.Schema) {
+			record.Retain()
+			records[i] = record
+		} else {
+			records[i] = array.NewRecord(r.Schema, record.Columns(), record.NumRows())
+		}
+	}
+	return records
+}
+
+// releaseRecords 释放各批数据
+func releaseRecords(records []arrow.Record) {
+	for _, record := range records {
+		record.Release()
+	}
+}
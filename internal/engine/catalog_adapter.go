@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// CatalogSQLAdapter 为catalog提供SQL执行能力的适配器
+// 这个适配器直接操作系统表存储，避免循环依赖
+type CatalogSQLAdapter struct {
+	storageEngine storage.StorageEngine
+}
+
+// NewCatalogSQLAdapter 创建catalog SQL适配器 (v2.0)
+func NewCatalogSQLAdapter(cat *catalog.Catalog, exec *executor.BaseExecutor, vectorizedExec *executor.VectorizedExecutor) *CatalogSQLAdapter {
+	return &CatalogSQLAdapter{
+		storageEngine: cat.GetStorageEngine(),
+	}
+}
+
+// ExecuteSQL 执行SQL语句（专门用于系统表操作）
+func (adapter *CatalogSQLAdapter) ExecuteSQL(sql string) (arrow.Record, error) {
+	// 对于catalog的SQL操作，我们直接操作存储层
+	// 这避免了执行器的循环依赖问题
+
+	// 解析SQL以确定操作类型
+	upperSQL := strings.ToUpper(strings.TrimSpace(sql))
+
+	if strings.HasPrefix(upperSQL, "INSERT INTO SYS.SCHEMATA") {
+		// 插入数据库记录 - 直接操作存储
+		return adapter.handleInsertSchemata(sql)
+	} else if strings.HasPrefix(upperSQL, "INSERT INTO SYS.TABLE_CATALOG") {
+		// 插入表记录 - 直接操作存储
+		return adapter.handleInsertTableCatalog(sql)
+	} else if strings.HasPrefix(upperSQL, "DELETE FROM SYS.SCHEMATA") ||
+		strings.HasPrefix(upperSQL, "DELETE FROM SYS.TABLE_CATALOG") ||
+		strings.HasPrefix(upperSQL, "UPDATE SYS.TABLE_CATALOG") {
+		// DELETE和UPDATE操作暂时跳过，因为catalog已经通过其他方式管理
+		return nil, nil
+	} else if strings.HasPrefix(upperSQL, "SELECT") {
+		// SELECT操作暂时返回空结果
+		return nil, nil
+	}
+
+	return nil, nil
+}
+
+// handleInsertSchemata 处理插入schemata表的操作
+func (adapter *CatalogSQLAdapter) handleInsertSchemata(sql string) (arrow.Record, error) {
+	// 简单解析: INSERT INTO sys.schemata (schema_name) VALUES ('database_name')
+	// 提取数据库名称
+	startIdx := strings.Index(strings.ToUpper(sql), "VALUES")
+	if startIdx == -1 {
+		return nil, fmt.Errorf("invalid INSERT syntax")
+	}
+
+	valuesStr := sql[startIdx+6:] // 跳过 "VALUES"
+	valuesStr = strings.TrimSpace(valuesStr)
+	valuesStr = strings.Trim(valuesStr, "()'\"")
+	dbName := strings.TrimSpace(valuesStr)
+
+	// 直接向系统表存储数据库信息
+	return adapter.insertDatabaseRecord(dbName)
+}
+
+// handleInsertTableCatalog 处理插入table_catalog表的操作
+func (adapter *CatalogSQLAdapter) handleInsertTableCatalog(sql string) (arrow.Record, error) {
+	// 简单解析: INSERT INTO sys.table_catalog (table_schema, table_name, ...) VALUES (...)
+	// 暂时跳过，因为表记录由catalog直接管理
+	return nil, nil
+}
+
+// insertDatabaseRecord 向系统表插入数据库记录
+// v2.0: Database creation is handled by StorageEngine.CreateDatabase()
+func (adapter *CatalogSQLAdapter) insertDatabaseRecord(dbName string) (arrow.Record, error) {
+	// In v2.0, database metadata is managed by Delta Log
+	// This is a stub for compatibility
+	return nil, nil
+}
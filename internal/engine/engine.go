@@ -0,0 +1,263 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/auth"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/maintenance"
+	"github.com/yyun543/minidb/internal/metrics"
+	"github.com/yyun543/minidb/internal/objectstore"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/statistics"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// Engine 进程内的 MiniDB 实例：存储引擎、catalog、执行器、会话和用户权限目录
+// 服务器 (cmd/server) 和嵌入式 API (pkg/minidb) 共用同一套构建和执行流程
+type Engine struct {
+	catalog                *catalog.Catalog
+	executor               *executor.BaseExecutor
+	vectorizedExecutor     *executor.VectorizedExecutor
+	sessionManager         *session.SessionManager
+	statisticsManager      *statistics.StatisticsManager
+	storageEngine          *storage.ParquetEngine
+	scheduler              *maintenance.Scheduler  // 后台维护任务调度器，未启用时为 nil
+	accessControl          *auth.Manager           // 用户和权限目录
+	queries                *executor.QueryRegistry // 正在执行的语句和在线会话 (KILL、sys.running_queries)
+	useVectorizedExecution bool                    // 默认是否使用向量化执行器 (会话变量 vectorized_execution 为 auto 时)
+}
+
+// New 按配置打开数据目录并创建引擎 (v2.0 with ParquetEngine)
+func New(cfg *config.Config) (*Engine, error) {
+	// 1. 创建 v2.0 Parquet 存储引擎 (启用写缓冲，单行 INSERT 不再各自生成 Parquet 文件)
+	opts := []storage.EngineOption{
+		storage.WithWriteBuffer(storage.WriteBufferConfig{
+			MaxRows:       cfg.Memory.WriteBufferRows,
+			MaxBytes:      int64(cfg.Memory.WriteBufferSize),
+			FlushInterval: cfg.Memory.WriteBufferFlushInterval,
+		}),
+		storage.WithOptimisticLock(cfg.Storage.OptimisticLock),
+		storage.WithMaxRetries(cfg.Storage.MaxRetries),
+		storage.WithCheckpointInterval(cfg.Maintenance.Checkpoint.LogInterval),
+		storage.WithLogRetention(cfg.Storage.LogRetention),
+	}
+	// 配置了 S3 存储桶时数据文件和 Delta Log 保存在对象存储中，data_dir 只保存写缓冲的 WAL
+	if s3 := cfg.Storage.S3; s3.Enabled() {
+		accessKeyID, secretAccessKey := s3.Credentials()
+		store, err := objectstore.NewS3Store(objectstore.S3Config{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			Prefix:          s3.Prefix,
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			UsePathStyle:    s3.UsePathStyle,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create S3 object store: %v", err)
+		}
+		opts = append(opts, storage.WithObjectStore(store))
+	}
+	storageEngine, err := storage.NewParquetEngine(cfg.DataDir, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create Parquet storage engine: %v", err)
+	}
+	if err := storageEngine.Open(); err != nil {
+		return nil, fmt.Errorf("Failed to open Parquet storage engine: %v", err)
+	}
+
+	// 2. 创建会话管理器
+	sessMgr, err := session.NewSessionManager()
+	if err != nil {
+		storageEngine.Close()
+		return nil, fmt.Errorf("Failure to create session manager: %v", err)
+	}
+
+	// 3. 创建 catalog (v2.0 - no engine parameter)
+	cat := catalog.NewCatalog()
+	cat.SetStorageEngine(storageEngine)
+
+	// 4. 创建统计信息管理器
+	statsMgr := statistics.NewStatisticsManager()
+
+	// 5. 创建共享的数据管理器
+	dataManager := executor.NewDataManager(cat)
+
+	// 6. 创建执行器（常规和向量化）
+	exec := executor.NewExecutorWithDataManager(cat, dataManager)
+	execConfig := executor.DefaultOptimizerConfig()
+	execConfig.WorkMemSize = int64(cfg.Memory.WorkMem)
+	execConfig.SpillDir = cfg.Memory.SpillDir
+	exec.SetConfig(execConfig)
+	vectorizedExec := executor.NewVectorizedExecutorWithDataManager(cat, dataManager, statsMgr)
+
+	// 7. 创建catalog的SQL执行器适配器
+	sqlAdapter := NewCatalogSQLAdapter(cat, exec, vectorizedExec)
+	cat.SetSQLRunner(sqlAdapter)
+
+	// 8. 初始化catalog
+	if err := cat.Init(); err != nil {
+		storageEngine.Close()
+		return nil, fmt.Errorf("Failed to initialize catalog: %v", err)
+	}
+
+	// 9. 加载用户和权限目录，启用认证时确保存在初始超级用户
+	accessControl, err := auth.NewManager(func(name string, schema *arrow.Schema) auth.Table {
+		return storageEngine.SystemTable(name, schema)
+	})
+	if err != nil {
+		storageEngine.Close()
+		return nil, err
+	}
+	accessControl.SetEnforced(cfg.Auth.Enabled)
+	if cfg.Auth.Enabled {
+		if _, err := accessControl.Bootstrap(cfg.Auth.AdminUser, cfg.Auth.BootstrapPassword()); err != nil {
+			storageEngine.Close()
+			return nil, fmt.Errorf("Failed to initialize access control: %v (set auth.admin_password or %s)", err, config.AdminPasswordEnv)
+		}
+	}
+	dataManager.SetAccessControl(accessControl)
+
+	e := &Engine{
+		catalog:                cat,
+		executor:               exec,
+		vectorizedExecutor:     vectorizedExec,
+		sessionManager:         sessMgr,
+		statisticsManager:      statsMgr,
+		storageEngine:          storageEngine,
+		accessControl:          accessControl,
+		queries:                dataManager.RunningQueries(),
+		useVectorizedExecution: true, // 默认启用向量化执行
+	}
+
+	// 10. 启动维护任务调度 (compaction / checkpoint / vacuum / 自动 ANALYZE)
+	if cfg.Maintenance.Enabled {
+		e.scheduler = maintenance.NewEngineScheduler(cfg.Maintenance, storageEngine, statsMgr)
+		dataManager.SetMaintenanceJobSource(e.scheduler)
+		e.scheduler.Start()
+	}
+
+	return e, nil
+}
+
+// Sessions 返回会话管理器
+func (e *Engine) Sessions() *session.SessionManager {
+	return e.sessionManager
+}
+
+// AccessControl 返回用户和权限目录
+func (e *Engine) AccessControl() *auth.Manager {
+	return e.accessControl
+}
+
+// Queries 返回正在执行的语句和在线会话的登记表
+func (e *Engine) Queries() *executor.QueryRegistry {
+	return e.queries
+}
+
+// StorageEngine 返回 Parquet 存储引擎
+func (e *Engine) StorageEngine() *storage.ParquetEngine {
+	return e.storageEngine
+}
+
+// Execute 在会话中执行一条语句
+// 语句登记到 sys.running_queries，ctx 取消、KILL 或超过 statement_timeout 时停止执行
+func (e *Engine) Execute(ctx context.Context, sess *session.Session, sql string) (*Result, error) {
+	ctx, finish := e.queries.Begin(ctx, sess, sql)
+	defer finish()
+
+	// 记录语句计数和耗时 (/metrics)
+	start := time.Now()
+	result, err := e.execute(ctx, sess, sql)
+	metrics.ObserveQuery(sql, time.Since(start), err)
+	return result, err
+}
+
+// execute 解析、优化并执行一条语句
+func (e *Engine) execute(ctx context.Context, sess *session.Session, sql string) (*Result, error) {
+	// 命中会话计划缓存时跳过解析和优化
+	plan, cached := e.executor.CachedQueryPlan(sess, sql)
+	if !cached {
+		// 1. 解析SQL
+		ast, err := parser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("parsing error: %v", err)
+		}
+
+		// 2. 处理特殊命令
+		if result, handled := e.handleSpecialCommands(ast, sess); handled {
+			return result, nil
+		}
+
+		// 3. 优化查询
+		opt := optimizer.NewOptimizer()
+		plan, err = opt.OptimizeContext(ctx, ast)
+		if err != nil {
+			return nil, fmt.Errorf("optimization error: %v", err)
+		}
+
+		// 检查plan是否为nil
+		if plan == nil {
+			return nil, fmt.Errorf("optimizer returned nil plan")
+		}
+		plan = e.executor.CacheQueryPlan(sess, sql, ast, plan)
+	}
+
+	// 4. 执行查询（选择向量化或常规执行器）
+	var result *Result
+	if e.useVectorized(sess) && e.isVectorizableQuery(plan) {
+		// 使用向量化执行器
+		vectorizedResult, err := e.vectorizedExecutor.ExecuteContext(ctx, plan, sess)
+		if err != nil {
+			// 为BETWEEN操作提供更好的错误信息
+			if strings.Contains(err.Error(), "unsupported predicate type") {
+				return nil, fmt.Errorf("BETWEEN operator is not yet supported. Please use equivalent conditions like: column >= value1 AND column <= value2")
+			}
+			return nil, fmt.Errorf("vectorized execution error: %v", err)
+		}
+		result = newVectorizedResult(vectorizedResult)
+	} else {
+		// 使用常规执行器
+		regularResult, err := e.executor.ExecuteContext(ctx, plan, sess)
+		if err != nil {
+			return nil, fmt.Errorf("execution error: %v", err)
+		}
+		result = newResult(regularResult)
+	}
+
+	// 5. 在结果列上标注声明的 SQL 类型
+	e.annotateColumnTypes(result, plan, sess)
+	return result, nil
+}
+
+// handleSpecialCommands 处理特殊命令
+func (e *Engine) handleSpecialCommands(ast interface{}, sess *session.Session) (*Result, bool) {
+	// 处理USE语句
+	if useStmt, ok := ast.(*parser.UseStmt); ok {
+		sess.CurrentDB = useStmt.Database
+		return &Result{Message: fmt.Sprintf("Switched to database: %s", useStmt.Database)}, true
+	}
+
+	return nil, false
+}
+
+// Close 停止维护任务并关闭存储引擎
+func (e *Engine) Close() error {
+	// 先停止维护任务，避免在引擎关闭后继续写入
+	if e.scheduler != nil {
+		e.scheduler.Stop()
+	}
+	if e.storageEngine != nil {
+		return e.storageEngine.Close()
+	}
+	return nil
+}
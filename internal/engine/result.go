@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// SQLTypeMetadataKey 结果列元数据中记录列声明的 SQL 类型 (例如 TIMESTAMP) 的键
+// TIMESTAMP、DATE 列以字符串存储，客户端据此把值还原为时间
+const SQLTypeMetadataKey = "minidb.sql_type"
+
+// Result 一条语句的执行结果
+// 返回结果集的语句 (SELECT、SHOW、EXPLAIN 等) Schema 不为 nil；DDL/DML 只有可选的 Message
+type Result struct {
+	Columns []string       // 列名
+	Schema  *arrow.Schema  // 结果集的 schema，没有结果集时为 nil
+	Records []arrow.Record // 结果数据，由 Result 持有，用完后调用 Release
+	Message string         // 没有结果集时的提示信息 (例如 USE)，为空表示 OK
+}
+
+// HasRows 语句是否返回结果集
+func (r *Result) HasRows() bool {
+	return r.Schema != nil
+}
+
+// Release 释放结果数据
+func (r *Result) Release() {
+	for _, record := range r.Records {
+		record.Release()
+	}
+	r.Records = nil
+}
+
+// newResult 从常规执行器的结果集创建 Result
+func newResult(rs *executor.ResultSet) *Result {
+	if rs == nil || isStatus(rs.Headers) {
+		return &Result{}
+	}
+	result := &Result{Columns: rs.Headers}
+	for _, batch := range rs.Batches() {
+		if batch != nil {
+			record := batch.Record()
+			record.Retain()
+			result.Records = append(result.Records, record)
+		}
+	}
+	result.Schema = resultSchema(rs.Headers, nil, result.Records)
+	return result
+}
+
+// newVectorizedResult 从向量化执行器的结果集创建 Result
+func newVectorizedResult(rs *executor.VectorizedResultSet) *Result {
+	if rs == nil || isStatus(rs.Headers) {
+		return &Result{}
+	}
+	result := &Result{Columns: rs.Headers}
+	for _, batch := range rs.Batches {
+		if batch != nil {
+			result.Records = append(result.Records, batch.ToRecord())
+		}
+	}
+	result.Schema = resultSchema(rs.Headers, rs.Schema, result.Records)
+	return result
+}
+
+// isStatus 只有一个 "status" 列的结果是 DDL/DML 的状态，不是结果集
+func isStatus(headers []string) bool {
+	return len(headers) == 1 && headers[0] == "status"
+}
+
+// resultSchema 返回结果集的 schema：优先使用第一批数据的 schema，没有数据时使用执行器给出的 schema，
+// 都没有时按列名生成字符串列
+func resultSchema(headers []string, schema *arrow.Schema, records []arrow.Record) *arrow.Schema {
+	if len(records) > 0 {
+		return records[0].Schema()
+	}
+	if schema != nil && schema.NumFields() == len(headers) {
+		return schema
+	}
+	fields := make([]arrow.Field, len(headers))
+	for i, header := range headers {
+		fields[i] = arrow.Field{Name: header, Type: arrow.BinaryTypes.String, Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// annotateColumnTypes 在结果列的元数据中记录列声明的 SQL 类型 (SQLTypeMetadataKey)
+// 按列名在语句引用的表中查找声明；多个表中同名列的声明不同或与结果列的 Arrow 类型不符时不标注
+func (e *Engine) annotateColumnTypes(result *Result, plan *optimizer.Plan, sess *session.Session) {
+	if !result.HasRows() {
+		return
+	}
+
+	declared := make(map[string]string)
+	for _, table := range optimizer.ReferencedTables(plan) {
+		db, tbl := executor.ResolveTableName(sess, table)
+		schema, err := e.storageEngine.GetTableSchema(db, tbl)
+		if err != nil {
+			continue
+		}
+		for _, col := range storage.TableDefinitionFromSchema(schema).Columns {
+			if previous, ok := declared[col.Name]; ok && previous != col.Type {
+				declared[col.Name] = ""
+				continue
+			}
+			declared[col.Name] = col.Type
+		}
+	}
+	if len(declared) == 0 {
+		return
+	}
+
+	fields := result.Schema.Fields()
+	annotated := false
+	for i, field := range fields {
+		name := field.Name
+		if idx := strings.LastIndex(name, "."); idx >= 0 {
+			name = name[idx+1:]
+		}
+		sqlType := declared[name]
+		if sqlType == "" || !sqlTypeMatches(sqlType, field.Type) {
+			continue
+		}
+		keys := append(field.Metadata.Keys(), SQLTypeMetadataKey)
+		values := append(field.Metadata.Values(), strings.ToUpper(sqlType))
+		fields[i].Metadata = arrow.NewMetadata(keys, values)
+		annotated = true
+	}
+	if !annotated {
+		return
+	}
+
+	metadata := result.Schema.Metadata()
+	result.Schema = arrow.NewSchema(fields, &metadata)
+	for i, record := range result.Records {
+		result.Records[i] = array.NewRecord(result.Schema, record.Columns(), record.NumRows())
+		record.Release()
+	}
+}
+
+// sqlTypeMatches 声明的 SQL 类型是否与结果列的 Arrow 类型一致 (表达式、聚合结果可能与同名列的类型不同)
+func sqlTypeMatches(sqlType string, dataType arrow.DataType) bool {
+	base := strings.ToUpper(sqlType)
+	if idx := strings.Index(base, "("); idx >= 0 {
+		base = strings.TrimSpace(base[:idx])
+	}
+	switch base {
+	case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT":
+		return arrow.IsInteger(dataType.ID())
+	case "DOUBLE", "FLOAT", "REAL", "DECIMAL", "NUMERIC":
+		return arrow.IsFloating(dataType.ID())
+	case "BOOLEAN", "BOOL":
+		return dataType.ID() == arrow.BOOL
+	default:
+		switch dataType.ID() {
+		case arrow.STRING, arrow.TIMESTAMP, arrow.DATE32, arrow.DATE64:
+			return true
+		}
+		return false
+	}
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// useVectorized 按会话变量 vectorized_execution 决定是否使用向量化执行器，auto 时使用引擎的默认设置
+func (e *Engine) useVectorized(sess *session.Session) bool {
+	switch executor.VectorizedExecution(sess) {
+	case executor.VectorizedOn:
+		return true
+	case executor.VectorizedOff:
+		return false
+	default:
+		return e.useVectorizedExecution
+	}
+}
+
+// isVectorizableQuery 判断查询是否适合向量化执行
+func (e *Engine) isVectorizableQuery(plan *optimizer.Plan) bool {
+	// 递归检查计划树，确定是否所有操作都支持向量化
+	return e.checkPlanVectorizable(plan)
+}
+
+// checkPlanVectorizable 递归检查计划节点是否支持向量化
+func (e *Engine) checkPlanVectorizable(plan *optimizer.Plan) bool {
+	// 检查plan是否为nil
+	if plan == nil {
+		return false
+	}
+
+	// DDL和工具命令不适合向量化
+	switch plan.Type {
+	case optimizer.CreateTablePlan, optimizer.CreateDatabasePlan,
+		optimizer.DropTablePlan, optimizer.DropDatabasePlan,
+		optimizer.ShowPlan:
+		return false
+	case optimizer.JoinPlan, optimizer.GroupPlan, optimizer.OrderPlan,
+		optimizer.HavingPlan, optimizer.LimitPlan:
+		// 复杂操作暂时不支持向量化，回退到常规执行器
+		return false
+	case optimizer.FilterPlan:
+		// 检查过滤条件是否包含不支持的表达式
+		props := plan.Properties.(*optimizer.FilterProperties)
+		if !e.checkExpressionVectorizable(props.Condition) {
+			return false
+		}
+	case optimizer.TableScanPlan:
+		// 表函数由常规执行器生成数据
+		if props, ok := plan.Properties.(*optimizer.TableScanProperties); ok && props.Function != nil {
+			return false
+		}
+	case optimizer.SelectPlan:
+		// 基本操作支持向量化
+		break
+	case optimizer.InsertPlan, optimizer.UpdatePlan, optimizer.DeletePlan:
+		// DML操作支持向量化
+		return true
+	default:
+		// 未知计划类型不支持向量化
+		return false
+	}
+
+	// 递归检查子计划
+	for _, child := range plan.Children {
+		if !e.checkPlanVectorizable(child) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkExpressionVectorizable 检查表达式是否支持向量化
+func (e *Engine) checkExpressionVectorizable(expr optimizer.Expression) bool {
+	if expr == nil {
+		return true
+	}
+
+	switch expression := expr.(type) {
+	case *optimizer.BinaryExpression:
+		// 检查LIKE表达式
+		if expression.Operator == "LIKE" || expression.Operator == "NOT LIKE" {
+			return false
+		}
+		// 检查BETWEEN表达式 (如果operator是 BETWEEN, NOT BETWEEN)
+		if expression.Operator == "BETWEEN" || expression.Operator == "NOT BETWEEN" {
+			return false
+		}
+		// 检查IN表达式 (如果operator是 IN, NOT IN)
+		if expression.Operator == "IN" || expression.Operator == "NOT IN" {
+			return false
+		}
+
+		// 递归检查左右子表达式
+		return e.checkExpressionVectorizable(expression.Left) && e.checkExpressionVectorizable(expression.Right)
+	default:
+		// 其他表达式类型（列引用、字面量等）支持向量化
+		return true
+	}
+}
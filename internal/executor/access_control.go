@@ -0,0 +1,378 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/auth"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// privilegeRequirement 执行计划需要的一项权限
+type privilegeRequirement struct {
+	privilege string // auth.Privilege*；为空表示要求超级用户
+	db, table string
+	action    string // 要求超级用户时的操作描述
+}
+
+// SetAccessControl 设置用户和权限目录，启用强制认证后执行计划前检查会话用户的权限
+func (dm *DataManager) SetAccessControl(manager *auth.Manager) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	dm.accessControl = manager
+}
+
+// SetAccessControl 设置用户和权限目录
+func (e *ExecutorImpl) SetAccessControl(manager *auth.Manager) {
+	e.dataManager.SetAccessControl(manager)
+}
+
+// checkPrivileges 检查会话用户是否具有执行计划所需的权限
+// 未设置权限目录或未启用强制认证时不检查
+func (dm *DataManager) checkPrivileges(plan *optimizer.Plan, sess *session.Session) error {
+	manager := dm.accessControl
+	if manager == nil || !manager.Enforced() {
+		return nil
+	}
+	if sess.User == "" {
+		return fmt.Errorf("permission denied: session is not authenticated")
+	}
+	if manager.IsSuperuser(sess.User) {
+		return nil
+	}
+
+	requirements, err := requiredPrivileges(plan, sess)
+	if err != nil {
+		return err
+	}
+	for _, req := range requirements {
+		if req.privilege == "" {
+			err = manager.CheckSuperuser(sess.User, req.action)
+		} else {
+			err = manager.Check(sess.User, req.privilege, req.db, req.table)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitContext 返回携带会话用户的 context，语句的写操作以该用户提交，Delta Log 的 UserID 记录执行语句的用户
+// 用户随每次提交传递，不同会话的语句互不阻塞
+func commitContext(sess *session.Session) context.Context {
+	return storage.WithCommitUser(context.Background(), sess.User)
+}
+
+// requiredPrivileges 返回执行计划所需的权限
+//
+//   - 查询、DESCRIBE、SHOW CREATE TABLE: 涉及的每张表的 SELECT (sys.* 系统表对所有用户可见)
+//   - INSERT / UPDATE / DELETE: 目标表的同名权限
+//   - CREATE TABLE、DROP TABLE、ALTER、COMMENT、索引、ANALYZE、VACUUM、RESTORE、EXPORT: 表的 ALL
+//   - SHALLOW CLONE: 源表的 SELECT 和目标表的 ALL
+//   - CREATE/DROP DATABASE、用户和权限管理、COPY 和 IMPORT (读写服务器文件): 超级用户
+//   - EXECUTE: 绑定参数后按预处理语句的类型检查；PREPARE、DEALLOCATE 不需要权限
+//   - 未列出的计划类型默认要求超级用户
+func requiredPrivileges(plan *optimizer.Plan, sess *session.Session) ([]privilegeRequirement, error) {
+	table := func(privilege, name string) privilegeRequirement {
+		db, tbl := ResolveTableName(sess, name)
+		return privilegeRequirement{privilege: privilege, db: db, table: tbl}
+	}
+	superuser := func(action string) []privilegeRequirement {
+		return []privilegeRequirement{{action: action}}
+	}
+
+	switch props := plan.Properties.(type) {
+	case *optimizer.InsertProperties:
+		return []privilegeRequirement{table(auth.PrivilegeInsert, props.Table)}, nil
+	case *optimizer.UpdateProperties:
+		return []privilegeRequirement{table(auth.PrivilegeUpdate, props.Table)}, nil
+	case *optimizer.DeleteProperties:
+		return []privilegeRequirement{table(auth.PrivilegeDelete, props.Table)}, nil
+	case *optimizer.CreateTableProperties:
+		// 外部表可以读取服务器上任意位置的文件 (包括数据目录中的 sys 系统表)，只允许超级用户创建
+		if props.External != nil {
+			return superuser("CREATE EXTERNAL TABLE"), nil
+		}
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.DropTableProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.AlterTableProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.CommentProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.CreateIndexProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.DropIndexProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.AnalyzeProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.VacuumProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.RestoreTableProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.ExportTableProperties:
+		return []privilegeRequirement{table(auth.PrivilegeAll, props.Table)}, nil
+	case *optimizer.CloneTableProperties:
+		return []privilegeRequirement{
+			table(auth.PrivilegeSelect, props.Source),
+			table(auth.PrivilegeAll, props.Table),
+		}, nil
+	case *optimizer.DescribeProperties:
+		return []privilegeRequirement{table(auth.PrivilegeSelect, props.Table)}, nil
+	case *optimizer.ShowCreateTableProperties:
+		return []privilegeRequirement{table(auth.PrivilegeSelect, props.Table)}, nil
+	case *optimizer.ExplainProperties:
+		return requiredPrivileges(props.Query, sess)
+	case *optimizer.CreateDatabaseProperties:
+		return superuser("CREATE DATABASE"), nil
+	case *optimizer.DropDatabaseProperties:
+		return superuser("DROP DATABASE"), nil
+	case *optimizer.CopyProperties:
+		return superuser("COPY"), nil
+	case *optimizer.ImportTableProperties:
+		return superuser("IMPORT TABLE"), nil
+	case *optimizer.BackupDatabaseProperties:
+		return superuser("BACKUP DATABASE"), nil
+	case *optimizer.RestoreDatabaseProperties:
+		return superuser("RESTORE DATABASE"), nil
+	case *optimizer.CreateRoleProperties, *optimizer.DropRoleProperties, *optimizer.GrantProperties:
+		return superuser(strings.ToUpper(plan.Type.String())), nil
+	}
+
+	switch plan.Type {
+	case optimizer.UsePlan, optimizer.ShowPlan, optimizer.SetPlan, optimizer.ShowVariablePlan, optimizer.ResetPlan,
+		optimizer.TransactionPlan:
+		return nil, nil
+	case optimizer.KillPlan:
+		// 取消的语句属于谁在执行时检查 (checkKillPrivilege)
+		return nil, nil
+	case optimizer.PreparePlan, optimizer.ExecutePlan, optimizer.DeallocatePlan:
+		// 预处理语句在 EXECUTE 绑定参数后按其语句类型检查权限
+		return nil, nil
+	case optimizer.SelectPlan, optimizer.ProjectionPlan, optimizer.TableScanPlan, optimizer.FilterPlan,
+		optimizer.HavingPlan, optimizer.JoinPlan, optimizer.OrderPlan, optimizer.LimitPlan, optimizer.GroupPlan:
+		var requirements []privilegeRequirement
+		collectScannedTables(plan, sess, &requirements)
+		return requirements, nil
+	}
+	return superuser(plan.Type.String()), nil
+}
+
+// collectScannedTables 收集查询计划读取的表，要求 SELECT 权限
+func collectScannedTables(plan *optimizer.Plan, sess *session.Session, requirements *[]privilegeRequirement) {
+	if plan == nil {
+		return
+	}
+	add := func(name string) {
+		if name == "" {
+			return
+		}
+		db, tbl := ResolveTableName(sess, name)
+		if db == "sys" {
+			return
+		}
+		*requirements = append(*requirements, privilegeRequirement{privilege: auth.PrivilegeSelect, db: db, table: tbl})
+	}
+
+	switch props := plan.Properties.(type) {
+	case *optimizer.TableScanProperties:
+		if props.Function == nil {
+			add(props.Table)
+		} else if len(props.Function.Args) > 0 {
+			// table_changes('db.t', ...) 读取第一个参数指定的表
+			if name, ok := props.Function.Args[0].(string); ok {
+				add(name)
+			}
+		}
+	case *optimizer.JoinProperties:
+		add(props.Left)
+		add(props.Right)
+	}
+	for _, child := range plan.Children {
+		collectScannedTables(child, sess, requirements)
+	}
+}
+
+// canAccessQueriesOf 启用认证时普通用户只能查看和取消自己的语句和会话，超级用户不受限制
+func (dm *DataManager) canAccessQueriesOf(user, owner string) bool {
+	manager := dm.accessControl
+	return manager == nil || !manager.Enforced() || owner == user || manager.IsSuperuser(user)
+}
+
+// checkKillPrivilege 启用认证时普通用户只能取消自己的语句和会话
+func (dm *DataManager) checkKillPrivilege(sess *session.Session, owner string) error {
+	if dm.canAccessQueriesOf(sess.User, owner) {
+		return nil
+	}
+	return fmt.Errorf("permission denied: cannot kill statements of user %s", owner)
+}
+
+// executeCreateRole 执行 CREATE USER / CREATE ROLE
+func (e *ExecutorImpl) executeCreateRole(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.CreateRoleProperties)
+	manager, err := e.dataManager.accessControlManager()
+	if err != nil {
+		return nil, err
+	}
+	if props.Login {
+		err = manager.CreateUser(commitContext(sess), props.Name, props.Password, props.Superuser)
+	} else {
+		err = manager.CreateRole(commitContext(sess), props.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return statusResultSet(), nil
+}
+
+// executeDropRole 执行 DROP USER / DROP ROLE
+func (e *ExecutorImpl) executeDropRole(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.DropRoleProperties)
+	manager, err := e.dataManager.accessControlManager()
+	if err != nil {
+		return nil, err
+	}
+	if props.Login {
+		if props.Name == sess.User {
+			return nil, fmt.Errorf("cannot drop the current user %s", props.Name)
+		}
+		err = manager.DropUser(commitContext(sess), props.Name, props.IfExists)
+	} else {
+		err = manager.DropRole(commitContext(sess), props.Name, props.IfExists)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return statusResultSet(), nil
+}
+
+// executeGrant 执行 GRANT / REVOKE
+func (e *ExecutorImpl) executeGrant(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.GrantProperties)
+	manager, err := e.dataManager.accessControlManager()
+	if err != nil {
+		return nil, err
+	}
+
+	db := props.Database
+	if db == "" {
+		db, _ = ResolveTableName(sess, props.Table)
+	}
+	ctx := commitContext(sess)
+	for _, grantee := range props.Grantees {
+		for _, role := range props.Roles {
+			if props.Revoke {
+				err = manager.RevokeRole(ctx, role, grantee)
+			} else {
+				err = manager.GrantRole(ctx, role, grantee)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(props.Privileges) == 0 {
+			continue
+		}
+		if props.Revoke {
+			err = manager.Revoke(ctx, props.Privileges, db, props.Table, grantee)
+		} else {
+			err = manager.Grant(ctx, props.Privileges, db, props.Table, grantee)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return statusResultSet(), nil
+}
+
+// accessControlManager 返回用户和权限目录，未配置时返回错误
+func (dm *DataManager) accessControlManager() (*auth.Manager, error) {
+	if dm.accessControl == nil {
+		return nil, fmt.Errorf("access control is not configured")
+	}
+	return dm.accessControl, nil
+}
+
+// statusResultSet DDL 语句的状态结果
+func statusResultSet() *ResultSet {
+	return &ResultSet{
+		Headers: []string{"status"},
+		rows:    []*types.Batch{},
+		curRow:  -1,
+	}
+}
+
+// getUsersData 获取users系统表数据（用户列表，不包含凭据）
+func (dm *DataManager) getUsersData(loc *time.Location) ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.AccessControlSchemas["users"])
+	defer builder.Release()
+
+	if dm.accessControl != nil {
+		for _, user := range dm.accessControl.Users() {
+			builder.Field(0).(*array.StringBuilder).Append(user.Name)
+			builder.Field(1).(*array.BooleanBuilder).Append(user.Superuser)
+			builder.Field(2).(*array.StringBuilder).Append(formatTime(user.CreatedAt, loc))
+		}
+	}
+	return newRecordBatches(builder)
+}
+
+// getRolesData 获取roles系统表数据
+func (dm *DataManager) getRolesData(loc *time.Location) ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.AccessControlSchemas["roles"])
+	defer builder.Release()
+
+	if dm.accessControl != nil {
+		for _, role := range dm.accessControl.Roles() {
+			builder.Field(0).(*array.StringBuilder).Append(role.Name)
+			builder.Field(1).(*array.StringBuilder).Append(formatTime(role.CreatedAt, loc))
+		}
+	}
+	return newRecordBatches(builder)
+}
+
+// getRoleMembersData 获取role_members系统表数据
+func (dm *DataManager) getRoleMembersData() ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.AccessControlSchemas["role_members"])
+	defer builder.Release()
+
+	if dm.accessControl != nil {
+		for _, member := range dm.accessControl.Members() {
+			builder.Field(0).(*array.StringBuilder).Append(member.Role)
+			builder.Field(1).(*array.StringBuilder).Append(member.Member)
+		}
+	}
+	return newRecordBatches(builder)
+}
+
+// getPrivilegesData 获取privileges系统表数据
+func (dm *DataManager) getPrivilegesData() ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.AccessControlSchemas["privileges"])
+	defer builder.Release()
+
+	if dm.accessControl != nil {
+		for _, grant := range dm.accessControl.Grants() {
+			builder.Field(0).(*array.StringBuilder).Append(grant.Grantee)
+			builder.Field(1).(*array.StringBuilder).Append(grant.Database)
+			builder.Field(2).(*array.StringBuilder).Append(grant.Table)
+			builder.Field(3).(*array.StringBuilder).Append(grant.Privilege)
+		}
+	}
+	return newRecordBatches(builder)
+}
+
+// newRecordBatches 将构建器中的数据作为单个批次返回
+func newRecordBatches(builder *array.RecordBuilder) ([]*types.Batch, error) {
+	record := builder.NewRecord()
+	defer record.Release()
+	return []*types.Batch{types.NewBatch(record)}, nil
+}
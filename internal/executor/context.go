@@ -1,15 +1,22 @@
 package executor
 
 import (
+	"context"
+
 	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor/operators"
 	"github.com/yyun543/minidb/internal/session"
 )
 
 // Context 执行上下文
 type Context struct {
 	Session     *session.Session
-	catalog     *catalog.Catalog // 元数据管理器
-	dataManager *DataManager     // 数据管理器
+	catalog     *catalog.Catalog            // 元数据管理器
+	dataManager *DataManager                // 数据管理器
+	memAcct     *operators.MemoryAccountant // 本查询的内存记账器
+	parallelism int                         // 本查询的并行度
+	queryCtx    context.Context             // 本查询的 context，取消或超时后算子停止执行
+	profiler    *queryProfiler              // EXPLAIN ANALYZE 的算子统计，nil 表示不统计
 	// 可以添加更多上下文信息
 }
 
@@ -19,6 +26,7 @@ func NewContext(cat *catalog.Catalog, sess *session.Session, dataManager *DataMa
 		Session:     sess,
 		catalog:     cat,
 		dataManager: dataManager,
+		queryCtx:    context.Background(),
 	}
 }
 
@@ -31,3 +39,21 @@ func (ctx *Context) GetCatalog() *catalog.Catalog {
 func (ctx *Context) GetDataManager() *DataManager {
 	return ctx.dataManager
 }
+
+// MemoryAccountant 获取本查询的内存记账器
+func (ctx *Context) MemoryAccountant() *operators.MemoryAccountant {
+	return ctx.memAcct
+}
+
+// QueryContext 获取本查询的 context
+func (ctx *Context) QueryContext() context.Context {
+	return ctx.queryCtx
+}
+
+// MaxParallelism 获取本查询的并行度（至少为 1）
+func (ctx *Context) MaxParallelism() int {
+	if ctx.parallelism < 1 {
+		return 1
+	}
+	return ctx.parallelism
+}
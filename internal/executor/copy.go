@@ -0,0 +1,869 @@
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// COPY 导入/导出
+//
+// COPY t FROM 流式读取外部文件 (CSV / NDJSON / Parquet)，逐行按表结构校验和转换，
+// 攒够目标文件大小后通过 StorageEngine.Write 写成一个 Parquet 文件提交；
+// 无法转换的行计入拒绝行，超过 max_errors 时中止导入。
+// COPY ... TO 执行导出查询，并把结果写到本地文件。
+
+const (
+	copyFormatCSV     = "csv"
+	copyFormatJSON    = "json"
+	copyFormatParquet = "parquet"
+
+	// defaultCopyTargetFileSize 导入时每个数据文件的目标大小 (按内存中的数据量估算)
+	defaultCopyTargetFileSize = 128 << 20
+	// maxReportedCopyErrors 结果中最多列出的拒绝行数
+	maxReportedCopyErrors = 10
+)
+
+// copyOptions COPY 的 WITH 选项
+type copyOptions struct {
+	format         string // csv / json (NDJSON) / parquet
+	header         bool   // CSV 首行是否为列名
+	delimiter      rune   // CSV 分隔符
+	null           string // CSV 中表示 NULL 的字符串
+	maxErrors      int64  // 允许的拒绝行数，超过后中止导入
+	targetFileSize int64  // 导入时每个数据文件的目标大小 (字节)
+}
+
+// parseCopyOptions 校验 WITH 选项，未指定 format 时按文件扩展名推断
+func parseCopyOptions(options map[string]string, path string) (*copyOptions, error) {
+	opts := &copyOptions{delimiter: ',', targetFileSize: defaultCopyTargetFileSize}
+	for name, value := range options {
+		switch name {
+		case "format":
+			format, ok := normalizeCopyFormat(value)
+			if !ok {
+				return nil, fmt.Errorf("unsupported COPY format '%s' (supported: csv, json, parquet)", value)
+			}
+			opts.format = format
+		case "header":
+			header, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid COPY option header = '%s': expected true or false", value)
+			}
+			opts.header = header
+		case "delimiter":
+			if value == `\t` {
+				value = "\t"
+			}
+			runes := []rune(value)
+			if len(runes) != 1 || runes[0] == '"' || runes[0] == '\r' || runes[0] == '\n' {
+				return nil, fmt.Errorf("invalid COPY option delimiter = '%s': expected a single character", value)
+			}
+			opts.delimiter = runes[0]
+		case "null":
+			opts.null = value
+		case "max_errors":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid COPY option max_errors = '%s': expected a non-negative integer", value)
+			}
+			opts.maxErrors = n
+		case "target_file_size":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid COPY option target_file_size = '%s': expected a positive number of bytes", value)
+			}
+			opts.targetFileSize = n
+		default:
+			return nil, fmt.Errorf("unknown COPY option '%s'", name)
+		}
+	}
+
+	if opts.format == "" {
+		format, ok := normalizeCopyFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), "."))
+		if !ok {
+			return nil, fmt.Errorf("cannot infer COPY format from '%s'; specify WITH (format csv|json|parquet)", path)
+		}
+		opts.format = format
+	}
+	return opts, nil
+}
+
+// normalizeCopyFormat 统一格式名称 (ndjson / jsonl 均为 JSON Lines)
+func normalizeCopyFormat(format string) (string, bool) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return copyFormatCSV, true
+	case "json", "ndjson", "jsonl":
+		return copyFormatJSON, true
+	case "parquet":
+		return copyFormatParquet, true
+	default:
+		return "", false
+	}
+}
+
+// executeCopy 执行 COPY 导入/导出
+func (e *ExecutorImpl) executeCopy(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.CopyProperties)
+
+	opts, err := parseCopyOptions(props.Options, props.Path)
+	if err != nil {
+		return nil, err
+	}
+	if props.Direction == parser.CopyTo {
+		return e.executeCopyTo(props, opts, sess)
+	}
+	return e.executeCopyFrom(props, opts, sess)
+}
+
+// executeCopyFrom 把匹配路径的所有文件导入表中
+func (e *ExecutorImpl) executeCopyFrom(props *optimizer.CopyProperties, opts *copyOptions, sess *session.Session) (*ResultSet, error) {
+	dbName, tableName := ResolveTableName(sess, props.Table)
+	tableMeta, err := e.catalog.GetTable(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(props.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid COPY path '%s': %w", props.Path, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("COPY: no files match '%s'", props.Path)
+	}
+
+	loader, err := newCopyLoader(e.dataManager, dbName, tableName, tableMeta.Schema, props.Columns, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer loader.builder.Release()
+
+	for _, path := range files {
+		switch opts.format {
+		case copyFormatCSV:
+			err = loader.loadCSV(path)
+		case copyFormatJSON:
+			err = loader.loadJSON(path)
+		case copyFormatParquet:
+			err = loader.loadParquet(path)
+		}
+		if err != nil {
+			return nil, loader.abort(err)
+		}
+	}
+	if err := loader.commit(commitContext(sess)); err != nil {
+		return nil, loader.abort(err)
+	}
+
+	logger.WithComponent("executor").Info("COPY FROM completed",
+		zap.String("table", dbName+"."+tableName),
+		zap.String("format", opts.format),
+		zap.Int("files", len(files)),
+		zap.Int64("rows_loaded", loader.loaded),
+		zap.Int64("rows_rejected", loader.rejected))
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "rows_loaded", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "rows_rejected", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "files", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "errors", Type: arrow.BinaryTypes.String},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).Append(loader.loaded)
+	builder.Field(1).(*array.Int64Builder).Append(loader.rejected)
+	builder.Field(2).(*array.Int64Builder).Append(int64(len(files)))
+	errorsText := strings.Join(loader.errors, "\n")
+	if more := loader.rejected - int64(len(loader.errors)); more > 0 {
+		errorsText += fmt.Sprintf("\n... and %d more", more)
+	}
+	builder.Field(3).(*array.StringBuilder).Append(errorsText)
+
+	return &ResultSet{
+		Headers: []string{"rows_loaded", "rows_rejected", "files", "errors"},
+		rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+		curRow:  -1,
+	}, nil
+}
+
+// copyLoader 把导入的行转换为表结构的 Arrow 数据，攒够目标大小后写成一个数据文件
+// 所有文件在导入结束时一次提交，导入中止时删除，COPY 不会留下部分导入的数据
+type copyLoader struct {
+	dm        *DataManager
+	load      *storage.BulkLoad
+	dbName    string
+	tableName string
+	schema    *arrow.Schema
+	columns   []int // 导入列在表 Schema 中的位置
+	explicit  bool  // 是否显式指定了导入列
+	opts      *copyOptions
+
+	builder      *array.RecordBuilder
+	pendingRows  int64
+	pendingBytes int64
+
+	loaded   int64    // 已写入数据文件的行数
+	rejected int64    // 拒绝的行数
+	errors   []string // 前 maxReportedCopyErrors 个拒绝行的原因
+}
+
+// newCopyLoader 创建导入器，columns 为空时导入表的全部列
+func newCopyLoader(dm *DataManager, dbName, tableName string, schema *arrow.Schema, columns []string, opts *copyOptions) (*copyLoader, error) {
+	l := &copyLoader{
+		dm:        dm,
+		dbName:    dbName,
+		tableName: tableName,
+		schema:    schema,
+		explicit:  len(columns) > 0,
+		opts:      opts,
+	}
+	if l.explicit {
+		resolved, err := l.resolveColumns(columns)
+		if err != nil {
+			return nil, err
+		}
+		l.columns = resolved
+	} else {
+		l.columns = make([]int, schema.NumFields())
+		for i := range l.columns {
+			l.columns[i] = i
+		}
+	}
+	load, err := dm.BeginBulkLoad(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	l.load = load
+	l.builder = array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	return l, nil
+}
+
+// resolveColumns 把列名解析为表 Schema 中的位置 (不区分大小写)
+func (l *copyLoader) resolveColumns(names []string) ([]int, error) {
+	positions := make([]int, len(names))
+	seen := make(map[int]bool, len(names))
+	for i, name := range names {
+		idx := copyFieldIndex(l.schema, name)
+		if idx < 0 {
+			return nil, fmt.Errorf("column '%s' does not exist in table %s", name, l.tableName)
+		}
+		if seen[idx] {
+			return nil, fmt.Errorf("column '%s' specified more than once", name)
+		}
+		seen[idx] = true
+		positions[i] = idx
+	}
+	return positions, nil
+}
+
+// copyFieldIndex 按名称查找字段 (不区分大小写)，未找到时返回 -1
+func copyFieldIndex(schema *arrow.Schema, name string) int {
+	for i, field := range schema.Fields() {
+		if strings.EqualFold(field.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// loadCSV 导入一个 CSV 文件
+func (l *copyLoader) loadCSV(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(bufio.NewReader(f))
+	reader.Comma = l.opts.delimiter
+	reader.FieldsPerRecord = -1 // 字段数逐行校验，便于按行拒绝
+	source := filepath.Base(path)
+
+	columns := l.columns
+	if l.opts.header {
+		header, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: failed to read CSV header: %w", source, err)
+		}
+		// 未显式指定导入列时按表头匹配表的列
+		if !l.explicit {
+			if columns, err = l.resolveColumns(header); err != nil {
+				return fmt.Errorf("%s: invalid CSV header: %w", source, err)
+			}
+		}
+	}
+
+	values := make([]interface{}, len(columns))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				if err := l.reject(source, int64(parseErr.Line), parseErr.Err); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("%s: failed to read CSV: %w", source, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(columns) {
+			if err := l.reject(source, int64(line), fmt.Errorf("expected %d fields, got %d", len(columns), len(record))); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, field := range record {
+			if field == l.opts.null {
+				values[i] = nil
+			} else {
+				values[i] = field
+			}
+		}
+		if err := l.appendRow(source, int64(line), columns, values); err != nil {
+			return err
+		}
+	}
+}
+
+// loadJSON 导入一个 JSON Lines 文件 (每行一个 JSON 对象，键为列名)
+func (l *copyLoader) loadJSON(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 64<<20)
+	source := filepath.Base(path)
+
+	values := make([]interface{}, len(l.columns))
+	line := int64(0)
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var obj map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.UseNumber()
+		if err := decoder.Decode(&obj); err != nil || obj == nil {
+			if err == nil {
+				err = fmt.Errorf("expected a JSON object")
+			}
+			if err := l.reject(source, line, fmt.Errorf("invalid JSON: %v", err)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for i := range values {
+			values[i] = nil
+		}
+		var unknown []string
+		for key, value := range obj {
+			pos := l.columnPosition(key)
+			if pos < 0 {
+				unknown = append(unknown, key)
+				continue
+			}
+			values[pos] = value
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			if err := l.reject(source, line, fmt.Errorf("unknown column '%s'", unknown[0])); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := l.appendRow(source, line, l.columns, values); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s: failed to read JSON lines: %w", source, err)
+	}
+	return nil
+}
+
+// loadParquet 按 row group 导入一个 Parquet 文件，文件中的列按名称匹配导入列
+func (l *copyLoader) loadParquet(path string) error {
+	source := filepath.Base(path)
+	row := int64(0)
+	return parquet.ReadRowGroups(path, func(record arrow.Record) error {
+		positions := make([]int, record.NumCols())
+		for j, field := range record.Schema().Fields() {
+			positions[j] = l.columnPosition(field.Name)
+			if positions[j] < 0 {
+				return fmt.Errorf("%s: column '%s' does not exist in table %s", source, field.Name, l.tableName)
+			}
+		}
+
+		values := make([]interface{}, len(l.columns))
+		for i := 0; i < int(record.NumRows()); i++ {
+			row++
+			for k := range values {
+				values[k] = nil
+			}
+			for j, pos := range positions {
+				values[pos] = copyArrayValue(record.Column(j), i)
+			}
+			if err := l.appendRow(source, row, l.columns, values); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// columnPosition 返回列名在导入列中的位置，不是导入列时返回 -1
+func (l *copyLoader) columnPosition(name string) int {
+	for pos, idx := range l.columns {
+		if strings.EqualFold(l.schema.Field(idx).Name, name) {
+			return pos
+		}
+	}
+	return -1
+}
+
+// appendRow 校验并追加一行，values 与 columns 一一对应；未导入的列写入 NULL
+func (l *copyLoader) appendRow(source string, line int64, columns []int, values []interface{}) error {
+	row := make([]interface{}, l.schema.NumFields())
+	for i, idx := range columns {
+		field := l.schema.Field(idx)
+		v, err := convertCopyValue(values[i], field.Type)
+		if err != nil {
+			return l.reject(source, line, fmt.Errorf("column '%s': %w", field.Name, err))
+		}
+		row[idx] = v
+	}
+
+	for idx, v := range row {
+		l.pendingBytes += appendCopyValue(l.builder.Field(idx), v)
+	}
+	l.pendingRows++
+	if l.pendingBytes >= l.opts.targetFileSize {
+		return l.flush()
+	}
+	return nil
+}
+
+// reject 记录一个拒绝行，拒绝行数超过 max_errors 时返回错误
+func (l *copyLoader) reject(source string, line int64, err error) error {
+	l.rejected++
+	msg := fmt.Sprintf("%s:%d: %v", source, line, err)
+	if len(l.errors) < maxReportedCopyErrors {
+		l.errors = append(l.errors, msg)
+	}
+	if l.rejected > l.opts.maxErrors {
+		return fmt.Errorf("too many rejected rows (max_errors = %d), last error: %s", l.opts.maxErrors, msg)
+	}
+	return nil
+}
+
+// flush 把已缓冲的行写成一个数据文件 (尚未提交)
+func (l *copyLoader) flush() error {
+	if l.pendingRows == 0 {
+		return nil
+	}
+	record := l.builder.NewRecord()
+	defer record.Release()
+	rows := l.pendingRows
+	l.pendingRows, l.pendingBytes = 0, 0
+
+	if err := l.load.Write(record); err != nil {
+		return fmt.Errorf("failed to write data: %w", err)
+	}
+	l.loaded += rows
+	return nil
+}
+
+// commit 写出剩余的行，并在同一个版本中提交所有数据文件，提交用户取自 ctx
+func (l *copyLoader) commit(ctx context.Context) error {
+	if err := l.flush(); err != nil {
+		return err
+	}
+	return l.dm.CommitBulkLoad(ctx, l.load)
+}
+
+// abort 删除已写入但未提交的数据文件，并包装中止导入的错误
+func (l *copyLoader) abort(err error) error {
+	l.load.Abort()
+	return fmt.Errorf("COPY aborted: %w (no rows were loaded)", err)
+}
+
+// copyTypeName 错误信息中使用的 SQL 类型名
+func copyTypeName(dt arrow.DataType) string {
+	switch dt.ID() {
+	case arrow.INT64:
+		return "INT"
+	case arrow.FLOAT64:
+		return "FLOAT"
+	case arrow.BOOL:
+		return "BOOLEAN"
+	case arrow.STRING:
+		return "VARCHAR"
+	default:
+		return dt.String()
+	}
+}
+
+// convertCopyValue 把文件中的值转换为列类型对应的 Go 值 (int64 / float64 / string / bool)
+func convertCopyValue(value interface{}, dt arrow.DataType) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	invalid := func() error {
+		return fmt.Errorf("invalid %s value '%v'", copyTypeName(dt), value)
+	}
+
+	switch dt.ID() {
+	case arrow.INT64:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v != math.Trunc(v) || math.IsInf(v, 0) {
+				return nil, invalid()
+			}
+			return int64(v), nil
+		case json.Number:
+			if n, err := v.Int64(); err == nil {
+				return n, nil
+			}
+			f, err := v.Float64()
+			if err != nil {
+				return nil, invalid()
+			}
+			return convertCopyValue(f, dt)
+		case string:
+			n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return nil, invalid()
+			}
+			return n, nil
+		}
+	case arrow.FLOAT64:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return nil, invalid()
+			}
+			return f, nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, invalid()
+			}
+			return f, nil
+		}
+	case arrow.BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, invalid()
+			}
+			return b, nil
+		}
+	case arrow.STRING:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case json.Number:
+			return v.String(), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case map[string]interface{}, []interface{}:
+			// 嵌套的 JSON 值按 JSON 文本保存
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, invalid()
+			}
+			return string(data), nil
+		}
+	default:
+		return nil, fmt.Errorf("column type %s is not supported by COPY", dt)
+	}
+	return nil, invalid()
+}
+
+// appendCopyValue 追加一个已转换的值，返回估算的数据大小
+func appendCopyValue(builder array.Builder, value interface{}) int64 {
+	if value == nil {
+		builder.AppendNull()
+		return 1
+	}
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		b.Append(value.(int64))
+		return 8
+	case *array.Float64Builder:
+		b.Append(value.(float64))
+		return 8
+	case *array.BooleanBuilder:
+		b.Append(value.(bool))
+		return 1
+	case *array.StringBuilder:
+		s := value.(string)
+		b.Append(s)
+		return int64(len(s)) + 4
+	default:
+		builder.AppendNull()
+		return 1
+	}
+}
+
+// copyArrayValue 读取 Arrow 数组中的值，整数统一为 int64，浮点数统一为 float64
+func copyArrayValue(col arrow.Array, i int) interface{} {
+	if col.IsNull(i) {
+		return nil
+	}
+	switch arr := col.(type) {
+	case *array.Int64:
+		return arr.Value(i)
+	case *array.Int32:
+		return int64(arr.Value(i))
+	case *array.Int16:
+		return int64(arr.Value(i))
+	case *array.Int8:
+		return int64(arr.Value(i))
+	case *array.Uint32:
+		return int64(arr.Value(i))
+	case *array.Uint16:
+		return int64(arr.Value(i))
+	case *array.Uint8:
+		return int64(arr.Value(i))
+	case *array.Float64:
+		return arr.Value(i)
+	case *array.Float32:
+		return float64(arr.Value(i))
+	case *array.Boolean:
+		return arr.Value(i)
+	case *array.String:
+		return arr.Value(i)
+	case *array.LargeString:
+		return arr.Value(i)
+	default:
+		return col.ValueStr(i)
+	}
+}
+
+// executeCopyTo 执行导出查询并把结果写入文件
+func (e *ExecutorImpl) executeCopyTo(props *optimizer.CopyProperties, opts *copyOptions, sess *session.Session) (*ResultSet, error) {
+	result, err := e.Execute(props.Query, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]arrow.Record, 0, len(result.Batches()))
+	for _, batch := range result.Batches() {
+		records = append(records, batch.Record())
+	}
+	names := result.Headers
+	if len(records) > 0 && len(names) != int(records[0].NumCols()) {
+		names = nil
+		for _, field := range records[0].Schema().Fields() {
+			names = append(names, field.Name)
+		}
+	}
+
+	var rows int64
+	switch opts.format {
+	case copyFormatParquet:
+		rows, err = writeCopyParquet(props.Path, names, records)
+	case copyFormatCSV:
+		rows, err = writeCopyText(props.Path, func(w *bufio.Writer) (int64, error) {
+			return writeCopyCSV(w, opts, names, records)
+		})
+	case copyFormatJSON:
+		rows, err = writeCopyText(props.Path, func(w *bufio.Writer) (int64, error) {
+			return writeCopyJSON(w, names, records)
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("COPY TO '%s' failed: %w", props.Path, err)
+	}
+
+	logger.WithComponent("executor").Info("COPY TO completed",
+		zap.String("path", props.Path),
+		zap.String("format", opts.format),
+		zap.Int64("rows", rows))
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "rows_written", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).Append(rows)
+	return &ResultSet{
+		Headers: []string{"rows_written"},
+		rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+		curRow:  -1,
+	}, nil
+}
+
+// writeCopyParquet 把查询结果写入一个 Parquet 文件，列名使用结果集的列名
+func writeCopyParquet(path string, names []string, records []arrow.Record) (int64, error) {
+	var fields []arrow.Field
+	if len(records) > 0 {
+		fields = append(fields, records[0].Schema().Fields()...)
+		for i := range fields {
+			fields[i].Name = names[i]
+		}
+	} else {
+		// 空结果没有类型信息，按字符串列导出
+		for _, name := range names {
+			fields = append(fields, arrow.Field{Name: name, Type: arrow.BinaryTypes.String, Nullable: true})
+		}
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	renamed := make([]arrow.Record, len(records))
+	for i, record := range records {
+		renamed[i] = array.NewRecord(schema, record.Columns(), record.NumRows())
+		defer renamed[i].Release()
+	}
+	return parquet.WriteArrowRecords(nil, path, schema, renamed)
+}
+
+// writeCopyText 创建文本文件并通过带缓冲的 writer 写入
+func writeCopyText(path string, write func(w *bufio.Writer) (int64, error)) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	rows, err := write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return rows, err
+}
+
+// writeCopyCSV 以 CSV 格式写出查询结果
+func writeCopyCSV(w io.Writer, opts *copyOptions, names []string, records []arrow.Record) (int64, error) {
+	writer := csv.NewWriter(w)
+	writer.Comma = opts.delimiter
+	if opts.header {
+		if err := writer.Write(names); err != nil {
+			return 0, err
+		}
+	}
+
+	rows := int64(0)
+	fields := make([]string, len(names))
+	for _, record := range records {
+		for i := 0; i < int(record.NumRows()); i++ {
+			for j := range fields {
+				switch v := copyArrayValue(record.Column(j), i).(type) {
+				case nil:
+					fields[j] = opts.null
+				case int64:
+					fields[j] = strconv.FormatInt(v, 10)
+				case float64:
+					fields[j] = strconv.FormatFloat(v, 'g', -1, 64)
+				case bool:
+					fields[j] = strconv.FormatBool(v)
+				default:
+					fields[j] = fmt.Sprint(v)
+				}
+			}
+			if err := writer.Write(fields); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+	}
+	writer.Flush()
+	return rows, writer.Error()
+}
+
+// writeCopyJSON 以 JSON Lines 格式写出查询结果，每行一个对象，键的顺序与列顺序一致
+func writeCopyJSON(w io.Writer, names []string, records []arrow.Record) (int64, error) {
+	keys := make([][]byte, len(names))
+	for j, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return 0, err
+		}
+		keys[j] = key
+	}
+
+	rows := int64(0)
+	var line bytes.Buffer
+	for _, record := range records {
+		for i := 0; i < int(record.NumRows()); i++ {
+			line.Reset()
+			line.WriteByte('{')
+			for j := range names {
+				if j > 0 {
+					line.WriteByte(',')
+				}
+				value, err := json.Marshal(copyArrayValue(record.Column(j), i))
+				if err != nil {
+					return rows, fmt.Errorf("column '%s': %w", names[j], err)
+				}
+				line.Write(keys[j])
+				line.WriteByte(':')
+				line.Write(value)
+			}
+			line.WriteString("}\n")
+			if _, err := w.Write(line.Bytes()); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+	}
+	return rows, nil
+}
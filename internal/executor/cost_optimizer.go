@@ -2,11 +2,18 @@ package executor
 
 import (
 	"math"
+	"runtime"
 
 	"github.com/yyun543/minidb/internal/optimizer"
 	"github.com/yyun543/minidb/internal/statistics"
 )
 
+// 没有列统计信息时使用的选择率
+const (
+	defaultFilterSelectivity = 0.5 // 过滤后剩余的行比例
+	defaultJoinSelectivity   = 0.1 // 连接条件的选择率
+)
+
 // CostBasedOptimizer 基于成本的查询优化器
 type CostBasedOptimizer struct {
 	statsMgr *statistics.StatisticsManager
@@ -23,14 +30,18 @@ type OptimizerConfig struct {
 	SortCostFactor       float64 // 排序成本因子
 
 	// 内存参数
-	WorkMemSize    int64 // 工作内存大小
-	BufferPoolSize int64 // 缓冲池大小
+	WorkMemSize    int64  // 工作内存大小（单个查询排序/聚合/连接的内存预算，超出后溢写磁盘）
+	BufferPoolSize int64  // 缓冲池大小
+	SpillDir       string // 溢写临时目录，为空时使用系统临时目录
 
 	// 优化开关
 	EnableIndexScan     bool // 启用索引扫描
 	EnableHashJoin      bool // 启用哈希连接
 	EnableSortMergeJoin bool // 启用排序合并连接
 	EnableParallelScan  bool // 启用并行扫描
+
+	// 并行参数
+	MaxParallelism int // 单个查询的默认并行度，可被会话变量 max_parallelism 覆盖
 }
 
 // DefaultOptimizerConfig 默认优化器配置
@@ -47,6 +58,7 @@ func DefaultOptimizerConfig() *OptimizerConfig {
 		EnableHashJoin:       true,
 		EnableSortMergeJoin:  true,
 		EnableParallelScan:   true,
+		MaxParallelism:       runtime.NumCPU(),
 	}
 }
 
@@ -410,7 +422,7 @@ func (cbo *CostBasedOptimizer) estimateRowCount(plan *optimizer.Plan, ctx *Optim
 		if len(plan.Children) > 0 {
 			childRows := cbo.estimateRowCount(plan.Children[0], ctx)
 			// 简化：假设过滤后剩余50%的行
-			return childRows * 0.5
+			return childRows * defaultFilterSelectivity
 		}
 	case optimizer.JoinPlan:
 		if len(plan.Children) == 2 {
@@ -428,7 +440,7 @@ func (cbo *CostBasedOptimizer) estimateRowCount(plan *optimizer.Plan, ctx *Optim
 // estimateJoinSelectivity 估算连接选择性
 func (cbo *CostBasedOptimizer) estimateJoinSelectivity(left, right *optimizer.Plan, props *optimizer.JoinProperties, ctx *OptimizationContext) float64 {
 	// 简化实现：返回固定选择性
-	return 0.1
+	return defaultJoinSelectivity
 }
 
 // pushdownFilterToJoin 将过滤条件下推到连接
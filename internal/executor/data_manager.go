@@ -10,7 +10,9 @@ import (
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
 	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/auth"
 	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/optimizer"
 	"github.com/yyun543/minidb/internal/storage"
 	"github.com/yyun543/minidb/internal/types"
 )
@@ -21,6 +23,10 @@ type DataManager struct {
 	catalog       *catalog.Catalog
 	storageEngine storage.StorageEngine
 	mu            sync.RWMutex
+
+	maintenanceJobs MaintenanceJobSource // sys.maintenance_jobs 的数据来源，nil 表示未启用后台维护
+	accessControl   *auth.Manager        // 用户、角色和权限，nil 表示不检查权限
+	queries         *QueryRegistry       // 正在执行的语句 (sys.running_queries、KILL)
 }
 
 // NewDataManager 创建新的数据管理器 (v2.0)
@@ -31,11 +37,12 @@ func NewDataManager(catalog *catalog.Catalog) *DataManager {
 	return &DataManager{
 		catalog:       catalog,
 		storageEngine: storageEngine,
+		queries:       NewQueryRegistry(),
 	}
 }
 
 // InsertData 插入数据到表中 (v2.0)
-func (dm *DataManager) InsertData(dbName, tableName string, columns []string, values []interface{}) error {
+func (dm *DataManager) InsertData(ctx context.Context, dbName, tableName string, columns []string, values []interface{}) error {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
 
@@ -53,7 +60,6 @@ func (dm *DataManager) InsertData(dbName, tableName string, columns []string, va
 	defer newRecord.Release()
 
 	// 使用 StorageEngine.Write 写入数据
-	ctx := context.Background()
 	err = dm.storageEngine.Write(ctx, dbName, tableName, newRecord)
 	if err != nil {
 		return fmt.Errorf("failed to write data: %w", err)
@@ -62,23 +68,220 @@ func (dm *DataManager) InsertData(dbName, tableName string, columns []string, va
 	return nil
 }
 
+// BeginBulkLoad 开始向表批量导入 (COPY FROM)，写入的数据文件在 CommitBulkLoad 时一次提交
+func (dm *DataManager) BeginBulkLoad(dbName, tableName string) (*storage.BulkLoad, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support COPY")
+	}
+	return engine.BeginBulkLoad(dbName, tableName)
+}
+
+// CommitBulkLoad 在同一个版本中提交批量导入写入的全部数据文件
+func (dm *DataManager) CommitBulkLoad(ctx context.Context, load *storage.BulkLoad) error {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return load.Commit(ctx)
+}
+
 // GetTableData 获取表的所有数据 (v2.0)
 func (dm *DataManager) GetTableData(dbName, tableName string) ([]*types.Batch, error) {
+	return dm.GetTableDataWithParallelism(dbName, tableName, 1)
+}
+
+// GetTableDataWithParallelism 以指定并行度读取表数据（并行读取数据文件和行组）
+func (dm *DataManager) GetTableDataWithParallelism(dbName, tableName string, parallelism int) ([]*types.Batch, error) {
 	dm.mu.RLock()
 	defer dm.mu.RUnlock()
 
 	// 特殊处理系统表：支持 "sys.table" 或直接 dbName="sys"
+	if sysTable, ok := systemTableName(dbName, tableName); ok {
+		return dm.getSystemTableData(context.Background(), sysTable)
+	}
+
+	return dm.scanTableData(storage.WithScanParallelism(context.Background(), parallelism), dbName, tableName)
+}
+
+// GetTableDataWithPredicate 按 WHERE 条件读取表数据
+// 条件只用于跳过不可能匹配的分区，行级过滤仍由上层 Filter 算子完成
+func (dm *DataManager) GetTableDataWithPredicate(dbName, tableName string, parallelism int, predicate optimizer.Expression) ([]*types.Batch, error) {
+	return dm.GetTableDataContext(context.Background(), dbName, tableName, parallelism, predicate)
+}
+
+// GetTableDataContext 按查询的 context 读取表数据，查询取消或超时后停止扫描
+// predicate 非空时只用于跳过不可能匹配的分区
+func (dm *DataManager) GetTableDataContext(ctx context.Context, dbName, tableName string, parallelism int, predicate optimizer.Expression) ([]*types.Batch, error) {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+
+	// 系统表中的时间按会话时区显示
+	if sysTable, ok := systemTableName(dbName, tableName); ok {
+		return dm.getSystemTableData(ctx, sysTable)
+	}
+
+	ctx = storage.WithScanParallelism(ctx, parallelism)
+	if filters := partitionFiltersFromExpression(predicate); len(filters) > 0 {
+		ctx = storage.WithPartitionFilters(ctx, filters)
+	}
+	return dm.scanTableData(ctx, dbName, tableName)
+}
+
+// DropPartition 删除分区表的一个分区，返回被移除的数据文件数量
+func (dm *DataManager) DropPartition(ctx context.Context, dbName, tableName, partitionName string, values map[string]interface{}) (int, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return 0, fmt.Errorf("storage engine does not support partitioned tables")
+	}
+	return engine.DropPartition(ctx, dbName, tableName, partitionName, values)
+}
+
+// ExportDeltaTable 把表的当前快照导出为 Delta Lake 日志
+func (dm *DataManager) ExportDeltaTable(dbName, tableName string) (*storage.DeltaExportResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support Delta export")
+	}
+	return engine.ExportDeltaTable(dbName, tableName)
+}
+
+// ImportDeltaTable 把 Delta 表的数据文件导入已创建的表，返回导入的行数
+func (dm *DataManager) ImportDeltaTable(ctx context.Context, dbName, tableName string, source *storage.DeltaTable) (int64, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return 0, fmt.Errorf("storage engine does not support Delta import")
+	}
+	return engine.ImportDeltaTable(ctx, dbName, tableName, source)
+}
+
+// RestoreTable 把表回滚到指定版本
+func (dm *DataManager) RestoreTable(ctx context.Context, dbName, tableName string, version int64) (*storage.RestoreResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support RESTORE TABLE")
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return engine.RestoreTable(ctx, dbName, tableName, version)
+}
+
+// RestoreTableToTimestamp 把表回滚到指定时间 (Unix 毫秒) 时的版本
+func (dm *DataManager) RestoreTableToTimestamp(ctx context.Context, dbName, tableName string, ts int64) (*storage.RestoreResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support RESTORE TABLE")
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return engine.RestoreTableToTimestamp(ctx, dbName, tableName, ts)
+}
+
+// CloneSource 返回 SHALLOW CLONE 源表在指定版本的表结构和实际使用的版本
+func (dm *DataManager) CloneSource(dbName, tableName string, version int64) (*arrow.Schema, int64, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, 0, fmt.Errorf("storage engine does not support SHALLOW CLONE")
+	}
+	return engine.CloneSource(dbName, tableName, version)
+}
+
+// CloneTable 让已创建的表引用源表在指定版本的数据文件
+func (dm *DataManager) CloneTable(ctx context.Context, srcDB, srcTable string, version int64, dbName, tableName string) (*storage.CloneResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support SHALLOW CLONE")
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return engine.CloneTable(ctx, srcDB, srcTable, version, dbName, tableName)
+}
+
+// BackupDatabase 在线备份数据库的表到本地目录
+func (dm *DataManager) BackupDatabase(dbName string, tables []string, dir, incrementalFrom string) (*storage.BackupResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support BACKUP DATABASE")
+	}
+	return engine.BackupDatabase(dbName, tables, dir, incrementalFrom)
+}
+
+// LoadBackup 读取备份中数据库在指定版本的状态
+func (dm *DataManager) LoadBackup(dir string, version int64) (*storage.BackupSnapshot, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support RESTORE DATABASE")
+	}
+	return engine.LoadBackup(dir, version)
+}
+
+// RestoreBackupTable 把备份中的表数据恢复到已创建的表
+func (dm *DataManager) RestoreBackupTable(ctx context.Context, backup *storage.BackupSnapshot, source, dbName, tableName string) (*storage.BackupRestoreResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support RESTORE DATABASE")
+	}
+	// 复制文件可能耗时较长，不持有 dm.mu 以免阻塞其他表的写入
+	return engine.RestoreBackupTable(ctx, backup, source, dbName, tableName)
+}
+
+// VacuumTable 删除表不再引用且超过保留期的数据文件
+func (dm *DataManager) VacuumTable(dbName, tableName string, retention time.Duration, dryRun bool) (*storage.VacuumResult, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support VACUUM")
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return engine.VacuumTable(dbName, tableName, retention, dryRun)
+}
+
+// TableSchema 返回存储引擎中表的当前 Schema (包含分区、写入选项和表定义等元数据)
+func (dm *DataManager) TableSchema(dbName, tableName string) (*arrow.Schema, error) {
+	return dm.storageEngine.GetTableSchema(dbName, tableName)
+}
+
+// UpdateTableDefinition 修改表注释或表属性，返回更新后的 Schema
+func (dm *DataManager) UpdateTableDefinition(ctx context.Context, dbName, tableName string, update func(def *storage.TableDefinition) error) (*arrow.Schema, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support table definitions")
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return engine.UpdateTableDefinition(ctx, dbName, tableName, update)
+}
+
+// TableDetail 返回表当前快照的文件数、大小和版本
+func (dm *DataManager) TableDetail(dbName, tableName string) (*storage.TableDetail, error) {
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return nil, fmt.Errorf("storage engine does not support DESCRIBE EXTENDED")
+	}
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+	return engine.TableDetail(dbName, tableName)
+}
+
+// estimatedRowCount 根据数据文件的统计估计表的行数 (EXPLAIN)，系统表和外部表无法估计
+func (dm *DataManager) estimatedRowCount(dbName, tableName string) (int64, bool) {
 	if dbName == "sys" {
-		return dm.getSystemTableData(tableName)
+		return 0, false
 	}
-	// 兼容处理：如果tableName格式为 "sys.xxx"，解析并调用系统表处理
-	if strings.HasPrefix(tableName, "sys.") {
-		sysTable := strings.TrimPrefix(tableName, "sys.")
-		return dm.getSystemTableData(sysTable)
+	engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return 0, false
+	}
+	if schema, err := engine.GetTableSchema(dbName, tableName); err != nil || storage.ExternalSpecFromSchema(schema) != nil {
+		return 0, false
+	}
+	detail, err := engine.TableDetail(dbName, tableName)
+	if err != nil {
+		return 0, false
 	}
+	return detail.Rows, true
+}
 
-	// 使用 StorageEngine.Scan 读取数据
-	ctx := context.Background()
+// scanTableData 使用 StorageEngine.Scan 读取整张表的数据
+func (dm *DataManager) scanTableData(ctx context.Context, dbName, tableName string) ([]*types.Batch, error) {
 	iter, err := dm.storageEngine.Scan(ctx, dbName, tableName, []storage.Filter{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan table: %w", err)
@@ -102,8 +305,20 @@ func (dm *DataManager) GetTableData(dbName, tableName string) ([]*types.Batch, e
 	return batches, nil
 }
 
-// getSystemTableData 获取系统表数据
-func (dm *DataManager) getSystemTableData(tableName string) ([]*types.Batch, error) {
+// systemTableName 返回系统表名：dbName 为 "sys" 或表名为 "sys.xxx" 的格式，不是系统表时返回 false
+func systemTableName(dbName, tableName string) (string, bool) {
+	if dbName == "sys" {
+		return tableName, true
+	}
+	if strings.HasPrefix(tableName, "sys.") {
+		return strings.TrimPrefix(tableName, "sys."), true
+	}
+	return "", false
+}
+
+// getSystemTableData 获取系统表数据，其中的时间按 ctx 记录的会话时区格式化
+func (dm *DataManager) getSystemTableData(ctx context.Context, tableName string) ([]*types.Batch, error) {
+	loc := timezoneFrom(ctx)
 	switch tableName {
 	case "db_metadata":
 		return dm.getDbMetadataData()
@@ -114,9 +329,21 @@ func (dm *DataManager) getSystemTableData(tableName string) ([]*types.Batch, err
 	case "index_metadata":
 		return dm.getIndexMetadataData()
 	case "delta_log":
-		return dm.getDeltaLogData()
+		return dm.getDeltaLogData(loc)
 	case "table_files":
 		return dm.getTableFilesData()
+	case "maintenance_jobs":
+		return dm.getMaintenanceJobsData(loc)
+	case "running_queries":
+		return dm.getRunningQueriesData(ctx)
+	case "users":
+		return dm.getUsersData(loc)
+	case "roles":
+		return dm.getRolesData(loc)
+	case "role_members":
+		return dm.getRoleMembersData()
+	case "privileges":
+		return dm.getPrivilegesData()
 	default:
 		return nil, fmt.Errorf("unknown system table: %s", tableName)
 	}
@@ -168,11 +395,14 @@ func (dm *DataManager) getDbMetadataData() ([]*types.Batch, error) {
 }
 
 // getTableMetadataData 获取table_metadata系统表数据（表列表）
+// table_type 为 SYSTEM / MANAGED / EXTERNAL，外部表的 location 为数据目录
 func (dm *DataManager) getTableMetadataData() ([]*types.Batch, error) {
 	// 创建table_metadata表的schema
 	schema := arrow.NewSchema([]arrow.Field{
 		{Name: "db_name", Type: arrow.BinaryTypes.String},
 		{Name: "table_name", Type: arrow.BinaryTypes.String},
+		{Name: "table_type", Type: arrow.BinaryTypes.String},
+		{Name: "location", Type: arrow.BinaryTypes.String},
 	}, nil)
 
 	pool := memory.NewGoAllocator()
@@ -181,6 +411,8 @@ func (dm *DataManager) getTableMetadataData() ([]*types.Batch, error) {
 
 	dbNameBuilder := builder.Field(0).(*array.StringBuilder)
 	tableNameBuilder := builder.Field(1).(*array.StringBuilder)
+	tableTypeBuilder := builder.Field(2).(*array.StringBuilder)
+	locationBuilder := builder.Field(3).(*array.StringBuilder)
 
 	// 添加系统表
 	systemTables := []struct {
@@ -193,11 +425,19 @@ func (dm *DataManager) getTableMetadataData() ([]*types.Batch, error) {
 		{"sys", "index_metadata"},
 		{"sys", "delta_log"},
 		{"sys", "table_files"},
+		{"sys", "maintenance_jobs"},
+		{"sys", "running_queries"},
+		{"sys", "users"},
+		{"sys", "roles"},
+		{"sys", "role_members"},
+		{"sys", "privileges"},
 	}
 
 	for _, sysTable := range systemTables {
 		dbNameBuilder.Append(sysTable.dbName)
 		tableNameBuilder.Append(sysTable.tableName)
+		tableTypeBuilder.Append("SYSTEM")
+		locationBuilder.AppendNull()
 	}
 
 	// 获取所有数据库
@@ -228,7 +468,8 @@ func (dm *DataManager) getTableMetadataData() ([]*types.Batch, error) {
 		// 系统表已经在上面添加过了，这里跳过
 		systemTableSet := map[string]bool{
 			"db_metadata": true, "table_metadata": true, "columns_metadata": true,
-			"index_metadata": true, "delta_log": true, "table_files": true,
+			"index_metadata": true, "delta_log": true, "table_files": true, "maintenance_jobs": true,
+			"running_queries": true, "users": true, "roles": true, "role_members": true, "privileges": true,
 		}
 
 		for _, tableName := range tables {
@@ -237,6 +478,18 @@ func (dm *DataManager) getTableMetadataData() ([]*types.Batch, error) {
 			}
 			dbNameBuilder.Append(dbName)
 			tableNameBuilder.Append(tableName)
+
+			var spec *storage.ExternalTableSpec
+			if tableMeta, err := dm.catalog.GetTable(dbName, tableName); err == nil {
+				spec = storage.ExternalSpecFromSchema(tableMeta.Schema)
+			}
+			if spec != nil {
+				tableTypeBuilder.Append("EXTERNAL")
+				locationBuilder.Append(spec.Location)
+			} else {
+				tableTypeBuilder.Append("MANAGED")
+				locationBuilder.AppendNull()
+			}
 		}
 	}
 
@@ -380,7 +633,7 @@ func (dm *DataManager) getIndexMetadataData() ([]*types.Batch, error) {
 }
 
 // getDeltaLogData 获取delta_log系统表数据（Delta Log版本历史）
-func (dm *DataManager) getDeltaLogData() ([]*types.Batch, error) {
+func (dm *DataManager) getDeltaLogData(loc *time.Location) ([]*types.Batch, error) {
 	// 创建delta_log表的schema
 	// 注意：列顺序必须与README中的示例一致
 	schema := arrow.NewSchema([]arrow.Field{
@@ -390,6 +643,7 @@ func (dm *DataManager) getDeltaLogData() ([]*types.Batch, error) {
 		{Name: "db_name", Type: arrow.BinaryTypes.String},
 		{Name: "table_name", Type: arrow.BinaryTypes.String},
 		{Name: "file_path", Type: arrow.BinaryTypes.String},
+		{Name: "user_id", Type: arrow.BinaryTypes.String},
 	}, nil)
 
 	pool := memory.NewGoAllocator()
@@ -402,6 +656,7 @@ func (dm *DataManager) getDeltaLogData() ([]*types.Batch, error) {
 	dbNameBuilder := builder.Field(3).(*array.StringBuilder)
 	tableNameBuilder := builder.Field(4).(*array.StringBuilder)
 	filePathBuilder := builder.Field(5).(*array.StringBuilder)
+	userIDBuilder := builder.Field(6).(*array.StringBuilder)
 
 	// 从 Storage Engine 获取 Delta Log (使用类型断言访问 ParquetEngine)
 	if pe, ok := dm.storageEngine.(*storage.ParquetEngine); ok {
@@ -423,17 +678,18 @@ func (dm *DataManager) getDeltaLogData() ([]*types.Batch, error) {
 					tableName = entry.TableID
 				}
 
-				// 按照新的schema顺序填充: version, timestamp, operation, db_name, table_name, file_path
+				// 按照新的schema顺序填充: version, timestamp, operation, db_name, table_name, file_path, user_id
 				versionBuilder.Append(entry.Version)
 
 				// 格式化 timestamp (从 int64 毫秒转为字符串)
-				timestampStr := time.UnixMilli(entry.Timestamp).Format("2006-01-02 15:04:05")
+				timestampStr := formatTime(time.UnixMilli(entry.Timestamp), loc)
 				timestampBuilder.Append(timestampStr)
 
 				operationBuilder.Append(string(entry.Operation))
 				dbNameBuilder.Append(dbName)
 				tableNameBuilder.Append(tableName)
 				filePathBuilder.Append(entry.FilePath)
+				userIDBuilder.Append(entry.UserID)
 			}
 		}
 	}
@@ -515,7 +771,7 @@ func (dm *DataManager) getTableFilesData() ([]*types.Batch, error) {
 }
 
 // UpdateDataWithFilters 使用storage.Filter更新表中的数据 (v2.0)
-func (dm *DataManager) UpdateDataWithFilters(dbName, tableName string, assignments map[string]interface{}, filters []storage.Filter) error {
+func (dm *DataManager) UpdateDataWithFilters(ctx context.Context, dbName, tableName string, assignments map[string]interface{}, filters []storage.Filter) error {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
 
@@ -526,7 +782,6 @@ func (dm *DataManager) UpdateDataWithFilters(dbName, tableName string, assignmen
 	}
 
 	// Use the storage engine's Update method (Copy-on-Write)
-	ctx := context.Background()
 	updatedCount, err := dm.storageEngine.Update(ctx, dbName, tableName, filters, assignments)
 	if err != nil {
 		return fmt.Errorf("failed to update table: %w", err)
@@ -538,7 +793,7 @@ func (dm *DataManager) UpdateDataWithFilters(dbName, tableName string, assignmen
 }
 
 // DeleteDataWithFilters 使用storage.Filter删除表中的数据 (v2.0)
-func (dm *DataManager) DeleteDataWithFilters(dbName, tableName string, filters []storage.Filter) error {
+func (dm *DataManager) DeleteDataWithFilters(ctx context.Context, dbName, tableName string, filters []storage.Filter) error {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
 
@@ -549,7 +804,6 @@ func (dm *DataManager) DeleteDataWithFilters(dbName, tableName string, filters [
 	}
 
 	// Use the storage engine's Delete method (Delta Log integration)
-	ctx := context.Background()
 	deletedCount, err := dm.storageEngine.Delete(ctx, dbName, tableName, filters)
 	if err != nil {
 		return fmt.Errorf("failed to delete from table: %w", err)
@@ -693,3 +947,85 @@ func (dm *DataManager) appendValue(field array.Builder, value interface{}) error
 	}
 	return nil
 }
+
+// partitionFiltersFromExpression 从 WHERE 条件中提取可用于分区裁剪的过滤条件
+// 只提取 AND 连接的 "列 op 字面量" 比较，以及同一列上的等值 OR 链 (IN 改写而来)，其余条件忽略
+func partitionFiltersFromExpression(expr optimizer.Expression) []storage.Filter {
+	bin, ok := expr.(*optimizer.BinaryExpression)
+	if !ok {
+		return nil
+	}
+
+	switch strings.ToUpper(bin.Operator) {
+	case "AND":
+		return append(partitionFiltersFromExpression(bin.Left), partitionFiltersFromExpression(bin.Right)...)
+	case "OR":
+		if filter, ok := inFilterFromOrChain(bin); ok {
+			return []storage.Filter{filter}
+		}
+		return nil
+	}
+
+	if filter, ok := comparisonFilter(bin); ok {
+		return []storage.Filter{filter}
+	}
+	return nil
+}
+
+// comparisonFilter 将 "列 op 字面量" 或 "字面量 op 列" 转换为存储层过滤条件
+func comparisonFilter(bin *optimizer.BinaryExpression) (storage.Filter, bool) {
+	flipped := map[string]string{
+		"=": "=", "!=": "!=", "<>": "!=",
+		"<": ">", "<=": ">=", ">": "<", ">=": "<=",
+	}
+	op := bin.Operator
+	if _, ok := flipped[op]; !ok {
+		return storage.Filter{}, false
+	}
+	if op == "<>" {
+		op = "!="
+	}
+
+	if col, ok := bin.Left.(*optimizer.ColumnReference); ok {
+		if lit, ok := bin.Right.(*optimizer.LiteralValue); ok {
+			return storage.Filter{Column: col.Column, Operator: op, Value: lit.Value}, true
+		}
+	}
+	if lit, ok := bin.Left.(*optimizer.LiteralValue); ok {
+		if col, ok := bin.Right.(*optimizer.ColumnReference); ok {
+			return storage.Filter{Column: col.Column, Operator: flipped[op], Value: lit.Value}, true
+		}
+	}
+	return storage.Filter{}, false
+}
+
+// inFilterFromOrChain 将同一列上的等值 OR 链转换为 IN 过滤条件
+func inFilterFromOrChain(expr optimizer.Expression) (storage.Filter, bool) {
+	var result storage.Filter
+	var collect func(e optimizer.Expression) bool
+	collect = func(e optimizer.Expression) bool {
+		bin, ok := e.(*optimizer.BinaryExpression)
+		if !ok {
+			return false
+		}
+		if strings.EqualFold(bin.Operator, "OR") {
+			return collect(bin.Left) && collect(bin.Right)
+		}
+		filter, ok := comparisonFilter(bin)
+		if !ok || filter.Operator != "=" {
+			return false
+		}
+		if result.Column == "" {
+			result = storage.Filter{Column: filter.Column, Operator: "IN"}
+		} else if !strings.EqualFold(result.Column, filter.Column) {
+			return false
+		}
+		result.Values = append(result.Values, filter.Value)
+		return true
+	}
+
+	if !collect(expr) {
+		return storage.Filter{}, false
+	}
+	return result, true
+}
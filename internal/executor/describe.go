@@ -0,0 +1,402 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// describeHeaders DESCRIBE 结果的列
+var describeHeaders = []string{"column_name", "data_type", "nullable", "key", "default", "comment"}
+
+// executeDescribe 执行 DESCRIBE [EXTENDED] t：每列一行；EXTENDED 时在列之后追加表的详细信息 (名称 / 值)
+func (e *ExecutorImpl) executeDescribe(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.DescribeProperties)
+
+	dbName, tableName := ResolveTableName(sess, props.Table)
+	if _, err := e.catalog.GetTable(dbName, tableName); err != nil {
+		return nil, err
+	}
+	schema, err := e.dataManager.TableSchema(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	def := storage.TableDefinitionFromSchema(schema)
+
+	fields := make([]arrow.Field, len(describeHeaders))
+	for i, name := range describeHeaders {
+		fields[i] = arrow.Field{Name: name, Type: arrow.BinaryTypes.String, Nullable: true}
+	}
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), arrow.NewSchema(fields, nil))
+	defer builder.Release()
+	appendRow := func(values ...string) {
+		for i := range describeHeaders {
+			b := builder.Field(i).(*array.StringBuilder)
+			if i < len(values) && values[i] != "" {
+				b.Append(values[i])
+			} else {
+				b.AppendNull()
+			}
+		}
+	}
+
+	for i, field := range schema.Fields() {
+		col := def.Columns[i]
+		nullable, key := "YES", ""
+		if col.NotNull || def.IsPrimaryKey(col.Name) {
+			nullable = "NO"
+		}
+		if def.IsPrimaryKey(col.Name) {
+			key = "PRI"
+		} else if col.Unique {
+			key = "UNI"
+		}
+		appendRow(col.Name, columnSQLType(col, field.Type), nullable, key, col.Default, col.Comment)
+	}
+
+	if props.Extended {
+		detail, err := e.dataManager.TableDetail(dbName, tableName)
+		if err != nil {
+			return nil, err
+		}
+		appendRow()
+		appendRow("# Detailed Table Information")
+		for _, info := range tableInformation(dbName, tableName, schema, def, detail, Timezone(sess)) {
+			appendRow(info[0], info[1])
+		}
+	}
+
+	return &ResultSet{
+		Headers: describeHeaders,
+		rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+		curRow:  -1,
+	}, nil
+}
+
+// tableInformation DESCRIBE EXTENDED 的表详细信息，每项为 (名称, 值)，值为空的项省略，修改时间按时区 loc 显示
+func tableInformation(dbName, tableName string, schema *arrow.Schema, def *storage.TableDefinition, detail *storage.TableDetail, loc *time.Location) [][2]string {
+	tableType := "MANAGED"
+	external := storage.ExternalSpecFromSchema(schema)
+	if external != nil {
+		tableType = "EXTERNAL"
+	}
+
+	info := [][2]string{
+		{"Database", dbName},
+		{"Table", tableName},
+		{"Type", tableType},
+		{"Comment", def.Comment},
+		{"Primary Key", strings.Join(def.PrimaryKey, ", ")},
+	}
+	if spec := storage.PartitionSpecFromSchema(schema); spec != nil {
+		info = append(info, [2]string{"Partitioning", partitionClause(spec, schema)})
+	}
+	if external != nil {
+		info = append(info,
+			[2]string{"Location", external.Location},
+			[2]string{"Format", external.Format},
+			[2]string{"Format Options", formatProperties(external.Options)})
+	}
+	info = append(info,
+		[2]string{"Writer Options", formatProperties(parquet.WriterOptionsFromSchema(schema).Options())},
+		[2]string{"Table Properties", formatProperties(def.Properties)},
+		[2]string{"Files", fmt.Sprint(detail.Files)},
+		[2]string{"Delta Files", fmt.Sprint(detail.DeltaFiles)},
+		[2]string{"Size (bytes)", fmt.Sprint(detail.SizeBytes)},
+		[2]string{"Version", fmt.Sprint(detail.Version)},
+	)
+	if detail.LastModified > 0 {
+		info = append(info, [2]string{"Last Modified", time.UnixMilli(detail.LastModified).In(loc).Format(time.RFC3339)})
+	}
+
+	kept := info[:0]
+	for _, item := range info {
+		if item[1] != "" {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// executeShowCreateTable 执行 SHOW CREATE TABLE t，返回可以重新执行的 DDL
+// 表注释、列注释和表属性以 COMMENT ON / ALTER TABLE 语句附在 CREATE TABLE 之后
+func (e *ExecutorImpl) executeShowCreateTable(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.ShowCreateTableProperties)
+
+	dbName, tableName := ResolveTableName(sess, props.Table)
+	if _, err := e.catalog.GetTable(dbName, tableName); err != nil {
+		return nil, err
+	}
+	schema, err := e.dataManager.TableSchema(dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	resultSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "table", Type: arrow.BinaryTypes.String},
+		{Name: "create_statement", Type: arrow.BinaryTypes.String},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), resultSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.StringBuilder).Append(tableName)
+	builder.Field(1).(*array.StringBuilder).Append(createTableDDL(tableName, schema))
+
+	return &ResultSet{
+		Headers: []string{"table", "create_statement"},
+		rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+		curRow:  -1,
+	}, nil
+}
+
+// createTableDDL 根据表 Schema 及其元数据生成 DDL
+func createTableDDL(tableName string, schema *arrow.Schema) string {
+	def := storage.TableDefinitionFromSchema(schema)
+	external := storage.ExternalSpecFromSchema(schema)
+
+	var sb strings.Builder
+	if external != nil {
+		sb.WriteString("CREATE EXTERNAL TABLE ")
+	} else {
+		sb.WriteString("CREATE TABLE ")
+	}
+	sb.WriteString(tableName)
+	sb.WriteString(" (\n")
+	lines := make([]string, 0, schema.NumFields()+1)
+	for i, field := range schema.Fields() {
+		col := def.Columns[i]
+		line := fmt.Sprintf("  %s %s", col.Name, columnSQLType(col, field.Type))
+		if col.NotNull {
+			line += " NOT NULL"
+		}
+		if col.Default != "" {
+			line += " DEFAULT " + col.Default
+		}
+		if col.Unique {
+			line += " UNIQUE"
+		}
+		lines = append(lines, line)
+	}
+	if len(def.PrimaryKey) > 0 {
+		lines = append(lines, fmt.Sprintf("  PRIMARY KEY (%s)", strings.Join(def.PrimaryKey, ", ")))
+	}
+	sb.WriteString(strings.Join(lines, ",\n"))
+	sb.WriteString("\n)")
+
+	if external != nil {
+		sb.WriteString(fmt.Sprintf("\nLOCATION %s FORMAT %s", quoteSQLString(external.Location), external.Format))
+		if len(external.Options) > 0 {
+			sb.WriteString(fmt.Sprintf("\nWITH (%s)", formatOptions(external.Options)))
+		}
+	}
+	if spec := storage.PartitionSpecFromSchema(schema); spec != nil {
+		sb.WriteString("\nPARTITION BY " + partitionClause(spec, schema))
+	}
+	if options := parquet.WriterOptionsFromSchema(schema).Options(); len(options) > 0 {
+		sb.WriteString(fmt.Sprintf("\nWITH (%s)", formatOptions(options)))
+	}
+	sb.WriteString(";")
+
+	if def.Comment != "" {
+		sb.WriteString(fmt.Sprintf("\nCOMMENT ON TABLE %s IS %s;", tableName, quoteSQLString(def.Comment)))
+	}
+	for _, col := range def.Columns {
+		if col.Comment != "" {
+			sb.WriteString(fmt.Sprintf("\nCOMMENT ON COLUMN %s.%s IS %s;", tableName, col.Name, quoteSQLString(col.Comment)))
+		}
+	}
+	if len(def.Properties) > 0 {
+		sb.WriteString(fmt.Sprintf("\nALTER TABLE %s SET TBLPROPERTIES (%s);", tableName, formatTableProperties(def.Properties)))
+	}
+	return sb.String()
+}
+
+// partitionClause 生成 PARTITION BY 之后的分区方式
+func partitionClause(spec *storage.PartitionSpec, schema *arrow.Schema) string {
+	clause := fmt.Sprintf("%s (%s)", spec.Type, strings.Join(spec.Columns, ", "))
+	switch {
+	case spec.Type == storage.PartitionHash:
+		if spec.Buckets > 0 {
+			clause += fmt.Sprintf(" PARTITIONS %d", spec.Buckets)
+		}
+	case len(spec.Partitions) > 0:
+		var quote bool
+		if fields, ok := schema.FieldsByName(spec.Columns[0]); ok {
+			quote = fields[0].Type.ID() == arrow.STRING
+		}
+		literal := func(v string) string {
+			if quote {
+				return quoteSQLString(v)
+			}
+			return v
+		}
+		defs := make([]string, len(spec.Partitions))
+		for i, pd := range spec.Partitions {
+			if spec.Type == storage.PartitionRange {
+				bound := "MAXVALUE"
+				if pd.LessThan != nil {
+					bound = literal(*pd.LessThan)
+				}
+				defs[i] = fmt.Sprintf("PARTITION %s VALUES LESS THAN (%s)", pd.Name, bound)
+				continue
+			}
+			values := make([]string, len(pd.Values))
+			for j, v := range pd.Values {
+				values[j] = literal(v)
+			}
+			defs[i] = fmt.Sprintf("PARTITION %s VALUES IN (%s)", pd.Name, strings.Join(values, ", "))
+		}
+		clause += fmt.Sprintf(" (%s)", strings.Join(defs, ", "))
+	}
+	return clause
+}
+
+// columnSQLType 返回列声明的 SQL 类型，没有声明信息时根据 Arrow 类型推断
+func columnSQLType(col storage.ColumnDefinition, dataType arrow.DataType) string {
+	if col.Type != "" {
+		return col.Type
+	}
+	switch dataType.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return "INTEGER"
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return "DOUBLE"
+	case arrow.BOOL:
+		return "BOOLEAN"
+	case arrow.TIMESTAMP:
+		return "TIMESTAMP"
+	default:
+		return "VARCHAR"
+	}
+}
+
+// formatOptions 按名称排序生成 WITH 子句的 name = 'value' 列表
+func formatOptions(options map[string]string) string {
+	names := sortedKeys(options)
+	items := make([]string, len(names))
+	for i, name := range names {
+		items[i] = fmt.Sprintf("%s = %s", name, quoteSQLString(options[name]))
+	}
+	return strings.Join(items, ", ")
+}
+
+// formatTableProperties 按名称排序生成 TBLPROPERTIES 的 'name' = 'value' 列表
+func formatTableProperties(properties map[string]string) string {
+	names := sortedKeys(properties)
+	items := make([]string, len(names))
+	for i, name := range names {
+		items[i] = fmt.Sprintf("%s = %s", quoteSQLString(name), quoteSQLString(properties[name]))
+	}
+	return strings.Join(items, ", ")
+}
+
+// formatProperties 按名称排序生成 name=value, ... 形式的展示文本
+func formatProperties(properties map[string]string) string {
+	names := sortedKeys(properties)
+	items := make([]string, len(names))
+	for i, name := range names {
+		items[i] = name + "=" + properties[name]
+	}
+	return strings.Join(items, ", ")
+}
+
+// sortedKeys 返回排序后的键
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// quoteSQLString 生成单引号字符串字面量
+func quoteSQLString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// executeComment 执行 COMMENT ON TABLE / COLUMN，注释随新的 METADATA 日志条目持久化
+func (e *ExecutorImpl) executeComment(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.CommentProperties)
+
+	dbName, tableName := ResolveTableName(sess, props.Table)
+	err := e.updateTableDefinition(commitContext(sess), dbName, tableName, func(def *storage.TableDefinition) error {
+		if props.Column == "" {
+			def.Comment = props.Comment
+			return nil
+		}
+		col := def.Column(props.Column)
+		if col == nil {
+			return fmt.Errorf("column '%s' does not exist in table %s.%s", props.Column, dbName, tableName)
+		}
+		col.Comment = props.Comment
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSet{
+		Headers: []string{"status"},
+		rows:    []*types.Batch{},
+		curRow:  -1,
+	}, nil
+}
+
+// alterTableProperties 执行 ALTER TABLE SET / UNSET TBLPROPERTIES
+func (e *ExecutorImpl) alterTableProperties(ctx context.Context, dbName, tableName string, props *optimizer.AlterTableProperties) error {
+	return e.updateTableDefinition(ctx, dbName, tableName, func(def *storage.TableDefinition) error {
+		if props.Action == parser.AlterTableUnsetTableProperties {
+			for _, name := range props.PropertyNames {
+				if _, ok := def.Properties[name]; !ok {
+					return fmt.Errorf("table property '%s' is not set on table %s.%s", name, dbName, tableName)
+				}
+				delete(def.Properties, name)
+			}
+			return nil
+		}
+		if def.Properties == nil {
+			def.Properties = make(map[string]string)
+		}
+		for name, value := range props.Properties {
+			def.Properties[name] = value
+		}
+		return nil
+	})
+}
+
+// updateTableDefinition 修改表定义并同步 catalog 中缓存的表结构
+func (e *ExecutorImpl) updateTableDefinition(ctx context.Context, dbName, tableName string, update func(def *storage.TableDefinition) error) error {
+	if _, err := e.catalog.GetTable(dbName, tableName); err != nil {
+		return err
+	}
+	schema, err := e.dataManager.UpdateTableDefinition(ctx, dbName, tableName, update)
+	if err != nil {
+		return err
+	}
+	if err := e.catalog.UpdateTable(dbName, catalog.TableMeta{
+		Database: dbName,
+		Table:    tableName,
+		Schema:   schema,
+	}); err != nil {
+		logger.WithComponent("executor").Warn("Failed to refresh catalog after table definition change",
+			zap.String("table", dbName+"."+tableName),
+			zap.Error(err))
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/yyun543/minidb/internal/executor/operators"
 	"github.com/yyun543/minidb/internal/logger"
 	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parquet"
 	"github.com/yyun543/minidb/internal/parser"
 	"github.com/yyun543/minidb/internal/session"
 	"github.com/yyun543/minidb/internal/storage"
@@ -39,6 +41,7 @@ func (op *NoOpOperator) Close() error {
 type ExecutorImpl struct {
 	catalog     *catalog.Catalog
 	dataManager *DataManager
+	config      *OptimizerConfig
 }
 
 // BaseExecutor 是 ExecutorImpl 的类型别名，用于向后兼容
@@ -52,6 +55,7 @@ func NewExecutor(cat *catalog.Catalog) *ExecutorImpl {
 	executor := &ExecutorImpl{
 		catalog:     cat,
 		dataManager: NewDataManager(cat),
+		config:      DefaultOptimizerConfig(),
 	}
 
 	logger.WithComponent("executor").Info("Executor instance created successfully",
@@ -79,11 +83,45 @@ func NewExecutorWithDataManager(cat *catalog.Catalog, dm *DataManager) *Executor
 	return &ExecutorImpl{
 		catalog:     cat,
 		dataManager: dm,
+		config:      DefaultOptimizerConfig(),
 	}
 }
 
-// Execute 执行查询计划
+// SetConfig 设置执行器配置（内存预算、溢写目录等）
+func (e *ExecutorImpl) SetConfig(config *OptimizerConfig) {
+	e.config = config
+}
+
+// Config 获取执行器配置
+func (e *ExecutorImpl) Config() *OptimizerConfig {
+	return e.config
+}
+
+// Execute 检查会话用户的权限后执行查询计划
 func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	return e.ExecuteContext(context.Background(), plan, sess)
+}
+
+// ExecuteContext 检查会话用户的权限后执行查询计划
+// ctx 取消 (KILL、连接断开) 或超过会话的 statement_timeout 时停止执行，返回取消原因
+func (e *ExecutorImpl) ExecuteContext(ctx context.Context, plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	ctx, cancel := statementContext(ctx, sess)
+	defer cancel()
+	if err := canceled(ctx); err != nil {
+		return nil, err
+	}
+	if err := e.dataManager.checkPrivileges(plan, sess); err != nil {
+		return nil, err
+	}
+	result, err := e.execute(ctx, plan, sess)
+	if err != nil {
+		return nil, canceledError(ctx, err)
+	}
+	return result, nil
+}
+
+// execute 执行查询计划
+func (e *ExecutorImpl) execute(queryCtx context.Context, plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
 	logger.WithComponent("executor").Info("Executing query plan",
 		zap.String("plan_type", string(plan.Type)),
 		zap.Int64("session_id", sess.ID))
@@ -112,6 +150,66 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 		result, err := e.executeDropTable(plan, sess)
 		e.logExecutionResult("DROP TABLE", start, err)
 		return result, err
+	case optimizer.AlterTablePlan:
+		logger.WithComponent("executor").Debug("Executing ALTER TABLE plan")
+		result, err := e.executeAlterTable(plan, sess)
+		e.logExecutionResult("ALTER TABLE", start, err)
+		return result, err
+	case optimizer.CopyPlan:
+		logger.WithComponent("executor").Debug("Executing COPY plan")
+		result, err := e.executeCopy(plan, sess)
+		e.logExecutionResult("COPY", start, err)
+		return result, err
+	case optimizer.ExportTablePlan:
+		logger.WithComponent("executor").Debug("Executing EXPORT TABLE plan")
+		result, err := e.executeExportTable(plan, sess)
+		e.logExecutionResult("EXPORT TABLE", start, err)
+		return result, err
+	case optimizer.ImportTablePlan:
+		logger.WithComponent("executor").Debug("Executing IMPORT TABLE plan")
+		result, err := e.executeImportTable(plan, sess)
+		e.logExecutionResult("IMPORT TABLE", start, err)
+		return result, err
+	case optimizer.RestoreTablePlan:
+		logger.WithComponent("executor").Debug("Executing RESTORE TABLE plan")
+		result, err := e.executeRestoreTable(plan, sess)
+		e.logExecutionResult("RESTORE TABLE", start, err)
+		return result, err
+	case optimizer.CloneTablePlan:
+		logger.WithComponent("executor").Debug("Executing SHALLOW CLONE plan")
+		result, err := e.executeCloneTable(plan, sess)
+		e.logExecutionResult("SHALLOW CLONE", start, err)
+		return result, err
+	case optimizer.BackupDatabasePlan:
+		logger.WithComponent("executor").Debug("Executing BACKUP DATABASE plan")
+		result, err := e.executeBackupDatabase(plan, sess)
+		e.logExecutionResult("BACKUP DATABASE", start, err)
+		return result, err
+	case optimizer.RestoreDatabasePlan:
+		logger.WithComponent("executor").Debug("Executing RESTORE DATABASE plan")
+		result, err := e.executeRestoreDatabase(plan, sess)
+		e.logExecutionResult("RESTORE DATABASE", start, err)
+		return result, err
+	case optimizer.VacuumPlan:
+		logger.WithComponent("executor").Debug("Executing VACUUM plan")
+		result, err := e.executeVacuum(plan, sess)
+		e.logExecutionResult("VACUUM", start, err)
+		return result, err
+	case optimizer.DescribePlan:
+		logger.WithComponent("executor").Debug("Executing DESCRIBE plan")
+		result, err := e.executeDescribe(plan, sess)
+		e.logExecutionResult("DESCRIBE", start, err)
+		return result, err
+	case optimizer.ShowCreateTablePlan:
+		logger.WithComponent("executor").Debug("Executing SHOW CREATE TABLE plan")
+		result, err := e.executeShowCreateTable(plan, sess)
+		e.logExecutionResult("SHOW CREATE TABLE", start, err)
+		return result, err
+	case optimizer.CommentPlan:
+		logger.WithComponent("executor").Debug("Executing COMMENT ON plan")
+		result, err := e.executeComment(plan, sess)
+		e.logExecutionResult("COMMENT ON", start, err)
+		return result, err
 	case optimizer.ShowPlan:
 		logger.WithComponent("executor").Debug("Executing SHOW plan")
 		result, err := e.executeShow(plan, sess)
@@ -159,9 +257,59 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 		return result, err
 	case optimizer.ExplainPlan:
 		logger.WithComponent("executor").Debug("Executing EXPLAIN plan")
-		result, err := e.executeExplain(plan, sess)
+		result, err := e.executeExplain(queryCtx, plan, sess)
 		e.logExecutionResult("EXPLAIN", start, err)
 		return result, err
+	case optimizer.SetPlan:
+		logger.WithComponent("executor").Debug("Executing SET plan")
+		result, err := e.executeSet(plan, sess)
+		e.logExecutionResult("SET", start, err)
+		return result, err
+	case optimizer.ShowVariablePlan:
+		logger.WithComponent("executor").Debug("Executing SHOW variable plan")
+		result, err := e.executeShowVariable(plan, sess)
+		e.logExecutionResult("SHOW", start, err)
+		return result, err
+	case optimizer.ResetPlan:
+		logger.WithComponent("executor").Debug("Executing RESET plan")
+		result, err := e.executeReset(plan, sess)
+		e.logExecutionResult("RESET", start, err)
+		return result, err
+	case optimizer.CreateRolePlan:
+		logger.WithComponent("executor").Debug("Executing CREATE USER/ROLE plan")
+		result, err := e.executeCreateRole(plan, sess)
+		e.logExecutionResult("CREATE USER/ROLE", start, err)
+		return result, err
+	case optimizer.DropRolePlan:
+		logger.WithComponent("executor").Debug("Executing DROP USER/ROLE plan")
+		result, err := e.executeDropRole(plan, sess)
+		e.logExecutionResult("DROP USER/ROLE", start, err)
+		return result, err
+	case optimizer.GrantPlan:
+		logger.WithComponent("executor").Debug("Executing GRANT/REVOKE plan")
+		result, err := e.executeGrant(plan, sess)
+		e.logExecutionResult("GRANT/REVOKE", start, err)
+		return result, err
+	case optimizer.KillPlan:
+		logger.WithComponent("executor").Debug("Executing KILL plan")
+		result, err := e.executeKill(plan, sess)
+		e.logExecutionResult("KILL", start, err)
+		return result, err
+	case optimizer.PreparePlan:
+		logger.WithComponent("executor").Debug("Executing PREPARE plan")
+		result, err := e.executePrepare(queryCtx, plan, sess)
+		e.logExecutionResult("PREPARE", start, err)
+		return result, err
+	case optimizer.ExecutePlan:
+		logger.WithComponent("executor").Debug("Executing EXECUTE plan")
+		result, err := e.executeExecuteStatement(queryCtx, plan, sess)
+		e.logExecutionResult("EXECUTE", start, err)
+		return result, err
+	case optimizer.DeallocatePlan:
+		logger.WithComponent("executor").Debug("Executing DEALLOCATE plan")
+		result, err := e.executeDeallocate(plan, sess)
+		e.logExecutionResult("DEALLOCATE", start, err)
+		return result, err
 	}
 
 	logger.WithComponent("executor").Debug("Executing query plan with operator tree",
@@ -170,6 +318,10 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 	// 创建执行上下文
 	ctxStart := time.Now()
 	ctx := NewContext(e.catalog, sess, e.dataManager)
+	ctx.memAcct = operators.NewMemoryAccountant(e.workMem(sess), e.config.SpillDir)
+	ctx.parallelism = queryParallelism(e.config, sess)
+	ctx.queryCtx = queryCtx
+	defer e.finishMemoryAccounting(ctx.memAcct)
 	logger.WithComponent("executor").Debug("Execution context created",
 		zap.Duration("context_creation_time", time.Since(ctxStart)))
 
@@ -202,7 +354,12 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 	execStart := time.Now()
 	var batches []*types.Batch
 	batchCount := 0
+	running := runningQueryFrom(queryCtx)
 	for {
+		if err := canceled(queryCtx); err != nil {
+			op.Close()
+			return nil, err
+		}
 		batch, err := op.Next()
 		if err != nil {
 			logger.WithComponent("executor").Error("Error during batch execution",
@@ -217,6 +374,9 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 		}
 		batches = append(batches, batch)
 		batchCount++
+		if running != nil {
+			running.addRows(batch.Record().NumRows())
+		}
 	}
 	logger.WithComponent("executor").Debug("Query execution completed",
 		zap.Int("batches_collected", batchCount),
@@ -253,8 +413,30 @@ func (e *ExecutorImpl) Execute(plan *optimizer.Plan, sess *session.Session) (*Re
 	return result, nil
 }
 
-// buildOperator 根据计划节点构建算子
+// finishMemoryAccounting 记录本查询的内存使用情况并清理溢写文件
+func (e *ExecutorImpl) finishMemoryAccounting(acct *operators.MemoryAccountant) {
+	if acct.SpillCount() > 0 {
+		logger.WithComponent("executor").Info("Query spilled to disk",
+			zap.Int64("work_mem", acct.Limit()),
+			zap.Int64("peak_memory", acct.Peak()),
+			zap.Int64("spill_files", acct.SpillCount()),
+			zap.Int64("spilled_bytes", acct.SpilledBytes()))
+	}
+	if err := acct.Cleanup(); err != nil {
+		logger.WithComponent("executor").Warn("Failed to remove spill directory", zap.Error(err))
+	}
+}
+
+// buildOperator 根据计划节点构建算子，EXPLAIN ANALYZE 时每个算子包装为统计算子
 func (e *ExecutorImpl) buildOperator(plan *optimizer.Plan, ctx *Context) (operators.Operator, error) {
+	if ctx.profiler != nil && plan != nil {
+		return ctx.profiler.build(e, plan, ctx)
+	}
+	return e.buildPlanOperator(plan, ctx)
+}
+
+// buildPlanOperator 构建计划节点对应的算子，子节点通过 buildOperator 构建
+func (e *ExecutorImpl) buildPlanOperator(plan *optimizer.Plan, ctx *Context) (operators.Operator, error) {
 	if plan == nil {
 		return nil, fmt.Errorf("plan is nil")
 	}
@@ -275,6 +457,10 @@ func (e *ExecutorImpl) buildOperator(plan *optimizer.Plan, ctx *Context) (operat
 			currentDB = "default"
 		}
 
+		if props.Function != nil {
+			return operators.NewTableFunctionScan(currentDB, props.Function, e.dataManager), nil
+		}
+
 		// 检查表名是否已经包含数据库限定符 (如 "sys.table_name")
 		tableName := props.Table
 		dbName := currentDB
@@ -315,6 +501,10 @@ func (e *ExecutorImpl) buildOperator(plan *optimizer.Plan, ctx *Context) (operat
 		if err != nil {
 			return nil, err
 		}
+		// 直接作用于表扫描时，过滤条件同时用于分区裁剪
+		if scan, ok := unwrapOperator(child).(*operators.TableScan); ok {
+			scan.SetPredicate(props.Condition)
+		}
 		return operators.NewFilter(props.Condition, child, ctx), nil
 
 	case optimizer.HavingPlan:
@@ -485,6 +675,18 @@ func (e *ExecutorImpl) getSchemaFromPlan(plan *optimizer.Plan, sess *session.Ses
 			currentDB = "default"
 		}
 
+		if tableScanProps.Function != nil {
+			schema, err := e.dataManager.TableFunctionSchema(currentDB, tableScanProps.Function)
+			if err != nil {
+				return nil
+			}
+			headers := make([]string, len(schema.Fields()))
+			for i, field := range schema.Fields() {
+				headers[i] = field.Name
+			}
+			return headers
+		}
+
 		// 检查表名是否已经包含数据库限定符 (如 "sys.table_name")
 		tableName := tableScanProps.Table
 		dbName := currentDB
@@ -564,6 +766,79 @@ func (e *ExecutorImpl) executeCreateTable(plan *optimizer.Plan, sess *session.Se
 	}
 	schema := arrow.NewSchema(fields, nil)
 
+	// 声明的列类型、约束和主键保存在 Schema 元数据中，供 DESCRIBE / SHOW CREATE TABLE 使用
+	if len(props.Columns) > 0 {
+		def := &storage.TableDefinition{PrimaryKey: props.PrimaryKey}
+		for _, col := range props.Columns {
+			colDef := storage.ColumnDefinition{Name: col.Name, Type: col.Type}
+			if col.Default != nil {
+				colDef.Default = fmt.Sprint(col.Default)
+			}
+			for _, constraint := range col.Constraints {
+				switch constraint {
+				case parser.NotNullConstraint:
+					colDef.NotNull = true
+				case parser.UniqueConstraint:
+					colDef.Unique = true
+				}
+			}
+			def.Columns = append(def.Columns, colDef)
+		}
+		var err error
+		if schema, err = storage.AttachTableDefinition(schema, def); err != nil {
+			return nil, err
+		}
+	}
+
+	// 外部表的 LOCATION / FORMAT 保存在 Schema 元数据中，未给出列定义时从数据文件推断表结构
+	if props.External != nil {
+		spec := &storage.ExternalTableSpec{
+			Location: props.External.Location,
+			Format:   props.External.Format,
+			Options:  props.External.Options,
+		}
+		var err error
+		if len(props.Columns) == 0 {
+			if schema, err = storage.InferExternalSchema(spec); err != nil {
+				return nil, err
+			}
+		}
+		if schema, err = storage.AttachExternalSpec(schema, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	// 表级 Parquet 写入选项保存在 Schema 元数据中，随表元数据一起持久化
+	// 未指定 compression 时使用会话变量 default_compression
+	options := props.Options
+	if codec := defaultCompression(sess); codec != "" && props.External == nil && !hasOption(options, parquet.OptionCompression) {
+		options = make(map[string]string, len(props.Options)+1)
+		for key, value := range props.Options {
+			options[key] = value
+		}
+		options[parquet.OptionCompression] = codec
+	}
+	if len(options) > 0 {
+		opts, err := parquet.ParseWriterOptions(options)
+		if err != nil {
+			return nil, err
+		}
+		if schema, err = parquet.AttachWriterOptions(schema, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// 分区定义同样保存在 Schema 元数据中，写入时据此划分分区目录
+	if props.Partition != nil {
+		spec, err := partitionSpecFromClause(props.Partition)
+		if err != nil {
+			return nil, err
+		}
+		if schema, err = storage.AttachPartitionSpec(schema, spec); err != nil {
+			return nil, err
+		}
+	}
+
 	// 使用会话中的当前数据库，默认为"default"
 	currentDB := sess.CurrentDB
 	if currentDB == "" {
@@ -578,7 +853,7 @@ func (e *ExecutorImpl) executeCreateTable(plan *optimizer.Plan, sess *session.Se
 		Schema:     schema,
 	}
 
-	err := e.catalog.CreateTable(currentDB, tableMeta)
+	err := e.catalog.CreateTableContext(commitContext(sess), currentDB, tableMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -590,6 +865,85 @@ func (e *ExecutorImpl) executeCreateTable(plan *optimizer.Plan, sess *session.Se
 	}, nil
 }
 
+// partitionSpecFromClause 将计划中的分区子句转换为存储层分区定义
+func partitionSpecFromClause(clause *optimizer.PartitionClause) (*storage.PartitionSpec, error) {
+	spec := &storage.PartitionSpec{
+		Type:    clause.Type,
+		Columns: clause.Columns,
+		Buckets: clause.Buckets,
+	}
+	for _, def := range clause.Partitions {
+		pd := storage.PartitionDefinition{Name: def.Name}
+		if def.LessThan != nil && !def.MaxValue {
+			bound := storage.FormatPartitionValue(def.LessThan)
+			pd.LessThan = &bound
+		} else if !def.MaxValue && strings.EqualFold(clause.Type, storage.PartitionRange) {
+			return nil, fmt.Errorf("partition %s: NULL is not allowed in partition bounds", def.Name)
+		}
+		for _, v := range def.Values {
+			if v == nil {
+				return nil, fmt.Errorf("partition %s: NULL is not allowed in partition values", def.Name)
+			}
+			pd.Values = append(pd.Values, storage.FormatPartitionValue(v))
+		}
+		spec.Partitions = append(spec.Partitions, pd)
+	}
+	return spec, nil
+}
+
+// hasOption 选项名不区分大小写
+func hasOption(options map[string]string, name string) bool {
+	for key := range options {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveTableName 解析 "database.table" 或 "table" 格式的表名，未指定数据库时使用会话中的当前数据库 (默认为"default")
+func ResolveTableName(sess *session.Session, name string) (string, string) {
+	if idx := strings.Index(name, "."); idx > 0 {
+		return name[:idx], name[idx+1:]
+	}
+	if sess.CurrentDB == "" {
+		return "default", name
+	}
+	return sess.CurrentDB, name
+}
+
+// executeAlterTable 执行 ALTER TABLE 语句
+func (e *ExecutorImpl) executeAlterTable(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.AlterTableProperties)
+
+	dbName, tableName := ResolveTableName(sess, props.Table)
+
+	switch props.Action {
+	case parser.AlterTableDropPartition:
+		removed, err := e.dataManager.DropPartition(commitContext(sess), dbName, tableName, props.PartitionName, props.PartitionValues)
+		if err != nil {
+			return nil, err
+		}
+		logger.WithComponent("executor").Info("Dropped table partition",
+			zap.String("database", dbName),
+			zap.String("table", tableName),
+			zap.String("partition", props.PartitionName),
+			zap.Int("removed_files", removed))
+	case parser.AlterTableSetTableProperties, parser.AlterTableUnsetTableProperties:
+		if err := e.alterTableProperties(commitContext(sess), dbName, tableName, props); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported ALTER TABLE action: %s", props.Action)
+	}
+
+	return &ResultSet{
+		Headers: []string{"status"},
+		rows:    []*types.Batch{},
+		curRow:  -1,
+	}, nil
+}
+
 // executeInsert 执行插入操作
 func (e *ExecutorImpl) executeInsert(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
 	props := plan.Properties.(*optimizer.InsertProperties)
@@ -628,7 +982,7 @@ func (e *ExecutorImpl) executeInsert(plan *optimizer.Plan, sess *session.Session
 			}
 
 			// 插入每一行
-			err := e.dataManager.InsertData(currentDB, props.Table, columns, values)
+			err := e.dataManager.InsertData(commitContext(sess), currentDB, props.Table, columns, values)
 			if err != nil {
 				return nil, fmt.Errorf("failed to insert row: %w", err)
 			}
@@ -642,7 +996,7 @@ func (e *ExecutorImpl) executeInsert(plan *optimizer.Plan, sess *session.Session
 			}
 		}
 
-		err := e.dataManager.InsertData(currentDB, props.Table, columns, values)
+		err := e.dataManager.InsertData(commitContext(sess), currentDB, props.Table, columns, values)
 		if err != nil {
 			return nil, err
 		}
@@ -712,7 +1066,7 @@ func (e *ExecutorImpl) executeUpdate(plan *optimizer.Plan, sess *session.Session
 		}
 	}
 
-	err := e.dataManager.UpdateDataWithFilters(currentDB, props.Table, assignments, filters)
+	err := e.dataManager.UpdateDataWithFilters(commitContext(sess), currentDB, props.Table, assignments, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -813,7 +1167,7 @@ func (e *ExecutorImpl) executeUpdateWithExpressions(dbName, tableName string, as
 				zap.Any("updates", rowUpdates),
 				zap.Any("filter", rowFilter))
 
-			if err := e.dataManager.UpdateDataWithFilters(dbName, tableName, rowUpdates, rowFilter); err != nil {
+			if err := e.dataManager.UpdateDataWithFilters(commitContext(sess), dbName, tableName, rowUpdates, rowFilter); err != nil {
 				logger.Error("Failed to update row",
 					zap.Error(err),
 					zap.Any("updates", rowUpdates))
@@ -1269,7 +1623,7 @@ func (e *ExecutorImpl) executeDelete(plan *optimizer.Plan, sess *session.Session
 		filters = e.convertWhereToFilters(props.Where)
 	}
 
-	err := e.dataManager.DeleteDataWithFilters(currentDB, props.Table, filters)
+	err := e.dataManager.DeleteDataWithFilters(commitContext(sess), currentDB, props.Table, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -1324,7 +1678,7 @@ func (e *ExecutorImpl) executeDropTable(plan *optimizer.Plan, sess *session.Sess
 	}
 
 	// 从catalog中删除表元数据
-	err := e.catalog.DropTable(currentDB, props.Table)
+	err := e.catalog.DropTableContext(commitContext(sess), currentDB, props.Table)
 	if err != nil {
 		return nil, fmt.Errorf("failed to drop table: %w", err)
 	}
@@ -1357,7 +1711,7 @@ func (e *ExecutorImpl) executeCreateIndex(plan *optimizer.Plan, sess *session.Se
 	}
 
 	// 调用catalog创建索引
-	err := e.catalog.CreateIndex(indexMeta)
+	err := e.catalog.CreateIndexContext(commitContext(sess), indexMeta)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create index: %w", err)
 	}
@@ -1380,7 +1734,7 @@ func (e *ExecutorImpl) executeDropIndex(plan *optimizer.Plan, sess *session.Sess
 	}
 
 	// 调用catalog删除索引
-	err := e.catalog.DropIndex(currentDB, props.Table, props.Name)
+	err := e.catalog.DropIndexContext(commitContext(sess), currentDB, props.Table, props.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to drop index: %w", err)
 	}
@@ -1470,59 +1824,28 @@ func (e *ExecutorImpl) executeUseDatabase(plan *optimizer.Plan, sess *session.Se
 	}, nil
 }
 
-// executeExplain 执行EXPLAIN命令
-func (e *ExecutorImpl) executeExplain(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
-	props := plan.Properties.(*optimizer.ExplainProperties)
-
-	// 生成执行计划的文本表示
-	planText := e.explainPlan(props.Query, 0)
-
-	// 创建包含执行计划的结果集
-	schema := arrow.NewSchema([]arrow.Field{
-		{Name: "Query Plan", Type: arrow.BinaryTypes.String},
-	}, nil)
-
-	pool := memory.NewGoAllocator()
-	builder := array.NewRecordBuilder(pool, schema)
-	defer builder.Release()
-
-	// 添加执行计划文本
-	strBuilder := builder.Field(0).(*array.StringBuilder)
-	strBuilder.Append(planText)
-
-	record := builder.NewRecord()
-	batch := types.NewBatch(record)
-
-	return &ResultSet{
-		Headers: []string{"Query Plan"},
-		rows:    []*types.Batch{batch},
-		curRow:  -1,
-	}, nil
-}
-
-// explainPlan 生成执行计划的文本表示
-func (e *ExecutorImpl) explainPlan(plan *optimizer.Plan, depth int) string {
-	if plan == nil {
-		return ""
-	}
-
-	indent := strings.Repeat("  ", depth)
-	result := indent + plan.Type.String()
-
-	if plan.Properties != nil {
-		if explainable, ok := plan.Properties.(optimizer.PlanProperties); ok {
-			result += "\n" + indent + "  " + explainable.Explain()
+// parseStatementTimeout 解析 statement_timeout：整数为毫秒，字符串可以带单位 (如 '30s'、'500ms'、'2m')，0 表示不限制
+func parseStatementTimeout(value interface{}) (time.Duration, error) {
+	var timeout time.Duration
+	switch v := value.(type) {
+	case int64:
+		timeout = time.Duration(v) * time.Millisecond
+	case string:
+		text := strings.TrimSpace(v)
+		if ms, err := strconv.ParseInt(text, 10, 64); err == nil {
+			timeout = time.Duration(ms) * time.Millisecond
+		} else if d, err := time.ParseDuration(text); err == nil {
+			timeout = d
+		} else {
+			return 0, fmt.Errorf("statement_timeout must be a duration such as '30s' or a number of milliseconds, got '%s'", v)
 		}
+	default:
+		return 0, fmt.Errorf("statement_timeout must be a duration such as '30s' or a number of milliseconds, got %v", value)
 	}
-
-	for _, child := range plan.Children {
-		childPlan := e.explainPlan(child, depth+1)
-		if childPlan != "" {
-			result += "\n" + childPlan
-		}
+	if timeout < 0 {
+		return 0, fmt.Errorf("statement_timeout must not be negative")
 	}
-
-	return result
+	return timeout, nil
 }
 
 // executeShow 执行SHOW命令
@@ -0,0 +1,437 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/executor/operators"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// ExplainHeader EXPLAIN 结果的列名，每行是计划输出的一行 (FORMAT JSON 时为整个 JSON 文档)
+const ExplainHeader = "Query Plan"
+
+// planProfile 计划节点的运行时统计 (EXPLAIN ANALYZE)
+type planProfile struct {
+	executed      bool
+	rows          int64
+	batches       int64
+	elapsed       time.Duration // Init、Next、Close 的累计耗时，包含子算子
+	outputBytes   int64
+	maxBatchBytes int64
+	acct          *operators.MemoryAccountant // 算子的子记账器
+	scan          *storage.ScanMetrics        // 表扫描的文件统计，非扫描节点为 nil
+}
+
+// peakMemory 算子的峰值内存：通过内存记账器预留的峰值 (排序、聚合、连接的物化数据) 与同时持有的输出数据取较大者；
+// 表扫描在 Init 时物化全部数据，按输出数据总量计算
+func (p *planProfile) peakMemory() int64 {
+	held := p.maxBatchBytes
+	if p.scan != nil {
+		held = p.outputBytes
+	}
+	if peak := p.acct.Peak(); peak > held {
+		return peak
+	}
+	return held
+}
+
+// queryProfiler 为算子树的每个节点收集运行时统计
+type queryProfiler struct {
+	acct     *operators.MemoryAccountant // 查询的内存记账器，各算子的子记账器由它创建
+	queryCtx context.Context
+	nodes    map[*optimizer.Plan]*planProfile
+}
+
+// newQueryProfiler 创建算子统计收集器
+func newQueryProfiler(acct *operators.MemoryAccountant, queryCtx context.Context) *queryProfiler {
+	return &queryProfiler{
+		acct:     acct,
+		queryCtx: queryCtx,
+		nodes:    make(map[*optimizer.Plan]*planProfile),
+	}
+}
+
+// build 构建计划节点的算子并包装为统计算子
+// 每个算子使用独立的执行上下文：内存记账到自己的子记账器，表扫描的 context 携带文件统计
+func (p *queryProfiler) build(e *ExecutorImpl, plan *optimizer.Plan, ctx *Context) (operators.Operator, error) {
+	profile := &planProfile{acct: p.acct.Scope()}
+	nodeCtx := *ctx
+	nodeCtx.memAcct = profile.acct
+	nodeCtx.queryCtx = p.queryCtx
+	if plan.Type == optimizer.TableScanPlan {
+		profile.scan = &storage.ScanMetrics{}
+		nodeCtx.queryCtx = storage.WithScanMetrics(p.queryCtx, profile.scan)
+	}
+
+	op, err := e.buildPlanOperator(plan, &nodeCtx)
+	if err != nil {
+		return nil, err
+	}
+	p.nodes[plan] = profile
+	return &profiledOperator{op: op, ctx: &nodeCtx, profile: profile}, nil
+}
+
+// profiledOperator 统计被包装算子的输出行数、批次数和耗时
+type profiledOperator struct {
+	op      operators.Operator
+	ctx     *Context
+	profile *planProfile
+}
+
+// Init 以节点自己的执行上下文初始化被包装的算子
+func (op *profiledOperator) Init(ctx interface{}) error {
+	start := time.Now()
+	err := op.op.Init(op.ctx)
+	op.profile.elapsed += time.Since(start)
+	op.profile.executed = true
+	return err
+}
+
+// Next 获取下一批数据
+func (op *profiledOperator) Next() (*types.Batch, error) {
+	start := time.Now()
+	batch, err := op.op.Next()
+	op.profile.elapsed += time.Since(start)
+	if batch != nil && batch.Record() != nil {
+		size := recordBytes(batch.Record())
+		op.profile.rows += batch.Record().NumRows()
+		op.profile.batches++
+		op.profile.outputBytes += size
+		op.profile.maxBatchBytes = max(op.profile.maxBatchBytes, size)
+	}
+	return batch, err
+}
+
+// Close 关闭算子
+func (op *profiledOperator) Close() error {
+	start := time.Now()
+	err := op.op.Close()
+	op.profile.elapsed += time.Since(start)
+	return err
+}
+
+// unwrapOperator 返回统计算子包装的原始算子
+func unwrapOperator(op operators.Operator) operators.Operator {
+	for {
+		profiled, ok := op.(*profiledOperator)
+		if !ok {
+			return op
+		}
+		op = profiled.op
+	}
+}
+
+// recordBytes Arrow record 的缓冲区字节数
+func recordBytes(record arrow.Record) int64 {
+	var size int64
+	for _, column := range record.Columns() {
+		for _, buf := range column.Data().Buffers() {
+			if buf != nil {
+				size += int64(buf.Len())
+			}
+		}
+	}
+	return size
+}
+
+// explainNode EXPLAIN 输出的计划节点，TEXT 和 JSON 格式由它生成
+type explainNode struct {
+	NodeType      string         `json:"node_type"`
+	Details       string         `json:"details,omitempty"`
+	EstimatedRows *int64         `json:"estimated_rows,omitempty"`
+	Actual        *explainActual `json:"actual,omitempty"`
+	Scan          *explainScan   `json:"scan,omitempty"`
+	Plans         []*explainNode `json:"plans,omitempty"`
+}
+
+// explainActual 节点的实际运行统计
+type explainActual struct {
+	Executed     bool    `json:"executed"`
+	Rows         int64   `json:"rows"`
+	Batches      int64   `json:"batches"`
+	TimeMs       float64 `json:"time_ms"`
+	PeakMemory   int64   `json:"peak_memory_bytes"`
+	SpillFiles   int64   `json:"spill_files,omitempty"`
+	SpilledBytes int64   `json:"spilled_bytes,omitempty"`
+}
+
+// explainScan 表扫描的文件统计
+type explainScan struct {
+	FilesConsidered        int64 `json:"files_considered"`
+	FilesPrunedByPartition int64 `json:"files_pruned_by_partition"`
+	FilesPrunedByStats     int64 `json:"files_pruned_by_stats"`
+	FilesRead              int64 `json:"files_read"`
+	DeltaFilesApplied      int64 `json:"delta_files_applied"`
+	BytesRead              int64 `json:"bytes_read"`
+	BufferedRows           int64 `json:"buffered_rows"`
+}
+
+// explainOutput EXPLAIN (FORMAT JSON) 的输出
+type explainOutput struct {
+	Plan            *explainNode `json:"plan"`
+	Rows            *int64       `json:"rows,omitempty"`
+	ExecutionTimeMs *float64     `json:"execution_time_ms,omitempty"`
+}
+
+// executeExplain 执行 EXPLAIN：输出计划树和估计行数；ANALYZE 时执行查询并附加各算子的实际运行统计
+func (e *ExecutorImpl) executeExplain(queryCtx context.Context, plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.ExplainProperties)
+
+	output := &explainOutput{}
+	var profiler *queryProfiler
+	if props.Analyze {
+		var rows int64
+		var elapsed time.Duration
+		var err error
+		profiler, rows, elapsed, err = e.analyzeQuery(queryCtx, props.Query, sess)
+		if err != nil {
+			return nil, err
+		}
+		ms := durationMs(elapsed)
+		output.Rows, output.ExecutionTimeMs = &rows, &ms
+	}
+	output.Plan = e.explainTree(props.Query, sess, profiler)
+
+	var lines []string
+	if props.Format == parser.ExplainFormatJSON {
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode plan: %w", err)
+		}
+		lines = []string{string(data)}
+	} else {
+		lines = output.textLines()
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: ExplainHeader, Type: arrow.BinaryTypes.String}}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	builder.Field(0).(*array.StringBuilder).AppendValues(lines, nil)
+	batches, err := newRecordBatches(builder)
+	if err != nil {
+		return nil, err
+	}
+	return &ResultSet{
+		Headers: []string{ExplainHeader},
+		rows:    batches,
+		curRow:  -1,
+	}, nil
+}
+
+// analyzeQuery 以统计算子执行查询，丢弃结果，返回各节点的统计、结果行数和总耗时
+func (e *ExecutorImpl) analyzeQuery(queryCtx context.Context, plan *optimizer.Plan, sess *session.Session) (*queryProfiler, int64, time.Duration, error) {
+	start := time.Now()
+
+	ctx := NewContext(e.catalog, sess, e.dataManager)
+	ctx.memAcct = operators.NewMemoryAccountant(e.workMem(sess), e.config.SpillDir)
+	ctx.parallelism = queryParallelism(e.config, sess)
+	ctx.queryCtx = queryCtx
+	ctx.profiler = newQueryProfiler(ctx.memAcct, queryCtx)
+	defer e.finishMemoryAccounting(ctx.memAcct)
+
+	op, err := e.buildOperator(plan, ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if err := op.Init(ctx); err != nil {
+		return nil, 0, 0, err
+	}
+
+	var rows int64
+	for {
+		if err := canceled(queryCtx); err != nil {
+			op.Close()
+			return nil, 0, 0, err
+		}
+		batch, err := op.Next()
+		if err != nil {
+			op.Close()
+			return nil, 0, 0, err
+		}
+		if batch == nil {
+			break
+		}
+		rows += batch.Record().NumRows()
+	}
+	if err := op.Close(); err != nil {
+		return nil, 0, 0, err
+	}
+
+	elapsed := time.Since(start)
+	logger.WithComponent("executor").Info("EXPLAIN ANALYZE executed query",
+		zap.Int64("rows", rows),
+		zap.Duration("duration", elapsed),
+		zap.Int64("peak_memory", ctx.memAcct.Peak()))
+	return ctx.profiler, rows, elapsed, nil
+}
+
+// explainTree 生成计划树的输出节点，profiler 非空时附加实际运行统计
+func (e *ExecutorImpl) explainTree(plan *optimizer.Plan, sess *session.Session, profiler *queryProfiler) *explainNode {
+	node := &explainNode{NodeType: plan.Type.String()}
+	if plan.Properties != nil {
+		node.Details = plan.Properties.Explain()
+	}
+	if estimate := e.estimateRows(plan, sess); estimate >= 0 {
+		rows := int64(math.Round(estimate))
+		node.EstimatedRows = &rows
+	}
+
+	if profiler != nil {
+		node.Actual = &explainActual{}
+		if profile, ok := profiler.nodes[plan]; ok && profile.executed {
+			node.Actual = &explainActual{
+				Executed:     true,
+				Rows:         profile.rows,
+				Batches:      profile.batches,
+				TimeMs:       durationMs(profile.elapsed),
+				PeakMemory:   profile.peakMemory(),
+				SpillFiles:   profile.acct.SpillCount(),
+				SpilledBytes: profile.acct.SpilledBytes(),
+			}
+			if m := profile.scan; m != nil {
+				node.Scan = &explainScan{
+					FilesConsidered:        m.FilesConsidered.Load(),
+					FilesPrunedByPartition: m.FilesPrunedByPartition.Load(),
+					FilesPrunedByStats:     m.FilesPrunedByStats.Load(),
+					FilesRead:              m.FilesRead.Load(),
+					DeltaFilesApplied:      m.DeltaFilesApplied.Load(),
+					BytesRead:              m.BytesRead.Load(),
+					BufferedRows:           m.BufferedRows.Load(),
+				}
+			}
+		}
+	}
+
+	for _, child := range plan.Children {
+		node.Plans = append(node.Plans, e.explainTree(child, sess, profiler))
+	}
+	return node
+}
+
+// estimateRows 估计计划节点输出的行数，无法估计时返回 -1
+// 表的行数来自 Delta Log 中数据文件的统计，过滤和连接的选择率与基于成本的优化器一致
+func (e *ExecutorImpl) estimateRows(plan *optimizer.Plan, sess *session.Session) float64 {
+	children := make([]float64, len(plan.Children))
+	for i, child := range plan.Children {
+		children[i] = e.estimateRows(child, sess)
+		if children[i] < 0 {
+			return -1
+		}
+	}
+
+	switch props := plan.Properties.(type) {
+	case *optimizer.TableScanProperties:
+		if props.Function != nil {
+			return -1
+		}
+		dbName, tableName := ResolveTableName(sess, props.Table)
+		if rows, ok := e.dataManager.estimatedRowCount(dbName, tableName); ok {
+			return float64(rows)
+		}
+		return -1
+	case *optimizer.FilterProperties, *optimizer.HavingProperties:
+		return children[0] * defaultFilterSelectivity
+	case *optimizer.JoinProperties:
+		if props.Condition == nil {
+			return children[0] * children[1]
+		}
+		return children[0] * children[1] * defaultJoinSelectivity
+	case *optimizer.LimitProperties:
+		return math.Min(children[0], float64(props.Limit))
+	case *optimizer.GroupByProperties:
+		if len(props.GroupKeys) == 0 {
+			return 1
+		}
+		return children[0]
+	}
+	if len(children) > 0 {
+		return children[0]
+	}
+	return -1
+}
+
+// textLines 生成 TEXT 格式的输出，每行一个结果行
+func (o *explainOutput) textLines() []string {
+	var lines []string
+	o.Plan.appendText(&lines, 0)
+	if o.Rows != nil {
+		lines = append(lines, fmt.Sprintf("Rows: %d", *o.Rows))
+	}
+	if o.ExecutionTimeMs != nil {
+		lines = append(lines, fmt.Sprintf("Execution Time: %.3f ms", *o.ExecutionTimeMs))
+	}
+	return lines
+}
+
+// appendText 追加节点及其子节点的 TEXT 输出
+func (n *explainNode) appendText(lines *[]string, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	line := indent + n.NodeType
+	if n.EstimatedRows != nil {
+		line += fmt.Sprintf("  (estimated rows=%d)", *n.EstimatedRows)
+	}
+	if a := n.Actual; a != nil {
+		if a.Executed {
+			line += fmt.Sprintf(" (actual rows=%d batches=%d time=%.3f ms peak memory=%s)",
+				a.Rows, a.Batches, a.TimeMs, formatBytes(a.PeakMemory))
+		} else {
+			line += " (never executed)"
+		}
+	}
+	*lines = append(*lines, line)
+
+	if n.Details != "" {
+		for _, detail := range strings.Split(n.Details, "\n") {
+			*lines = append(*lines, indent+"  "+detail)
+		}
+	}
+	if a := n.Actual; a != nil && a.SpillFiles > 0 {
+		*lines = append(*lines, fmt.Sprintf("%s  Spilled: %d files, %s", indent, a.SpillFiles, formatBytes(a.SpilledBytes)))
+	}
+	if s := n.Scan; s != nil {
+		*lines = append(*lines, fmt.Sprintf("%s  Files: %d considered, %d pruned by partition, %d pruned by stats, %d read (%s)",
+			indent, s.FilesConsidered, s.FilesPrunedByPartition, s.FilesPrunedByStats, s.FilesRead, formatBytes(s.BytesRead)))
+		*lines = append(*lines, fmt.Sprintf("%s  Merge-on-Read delta files applied: %d, buffered rows: %d",
+			indent, s.DeltaFilesApplied, s.BufferedRows))
+	}
+
+	for _, child := range n.Plans {
+		child.appendText(lines, depth+1)
+	}
+}
+
+// durationMs 以毫秒表示的耗时
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// formatBytes 以 B/KB/MB/GB 表示字节数
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	value, suffix := float64(n)/unit, "KB"
+	for _, next := range []string{"MB", "GB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
@@ -0,0 +1,53 @@
+package executor
+
+import (
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/maintenance"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// MaintenanceJobSource 提供后台维护任务的执行历史 (maintenance.Scheduler)
+type MaintenanceJobSource interface {
+	History() []maintenance.JobRecord
+}
+
+// SetMaintenanceJobSource 设置 sys.maintenance_jobs 的数据来源
+func (dm *DataManager) SetMaintenanceJobSource(source MaintenanceJobSource) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	dm.maintenanceJobs = source
+}
+
+// SetMaintenanceJobSource 设置 sys.maintenance_jobs 的数据来源
+func (e *ExecutorImpl) SetMaintenanceJobSource(source MaintenanceJobSource) {
+	e.dataManager.SetMaintenanceJobSource(source)
+}
+
+// getMaintenanceJobsData 获取maintenance_jobs系统表数据（后台维护任务的执行历史，从旧到新）
+func (dm *DataManager) getMaintenanceJobsData(loc *time.Location) ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.MaintenanceJobsSchema)
+	defer builder.Release()
+
+	if dm.maintenanceJobs != nil {
+		for _, job := range dm.maintenanceJobs.History() {
+			builder.Field(0).(*array.Int64Builder).Append(job.ID)
+			builder.Field(1).(*array.StringBuilder).Append(job.Job)
+			builder.Field(2).(*array.StringBuilder).Append(job.Database)
+			builder.Field(3).(*array.StringBuilder).Append(job.Table)
+			builder.Field(4).(*array.StringBuilder).Append(job.Status)
+			builder.Field(5).(*array.Int64Builder).Append(int64(job.Attempt))
+			builder.Field(6).(*array.StringBuilder).Append(formatTime(job.StartedAt, loc))
+			builder.Field(7).(*array.Int64Builder).Append(job.Duration.Milliseconds())
+			builder.Field(8).(*array.StringBuilder).Append(job.Message)
+			builder.Field(9).(*array.StringBuilder).Append(formatTime(job.NextRunAt, loc))
+		}
+	}
+
+	record := builder.NewRecord()
+	defer record.Release()
+	return []*types.Batch{types.NewBatch(record)}, nil
+}
@@ -0,0 +1,24 @@
+package operators
+
+import "context"
+
+// queryContextProvider 执行上下文提供查询的 context (KILL 和 statement_timeout 通过它取消查询)
+type queryContextProvider interface {
+	QueryContext() context.Context
+}
+
+// queryContextFrom 获取执行上下文中查询的 context，未提供时返回不会取消的 context
+func queryContextFrom(ctx interface{}) context.Context {
+	if p, ok := ctx.(queryContextProvider); ok && p.QueryContext() != nil {
+		return p.QueryContext()
+	}
+	return context.Background()
+}
+
+// canceled 查询已取消时返回取消原因
+func canceled(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return nil
+}
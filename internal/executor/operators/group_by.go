@@ -10,6 +10,9 @@ import (
 	"strconv"
 )
 
+// parallelAggBatchesPerWorker 并行聚合时每个 worker 每轮处理的批次数
+const parallelAggBatchesPerWorker = 4
+
 // GroupBy GROUP BY算子
 type GroupBy struct {
 	groupKeys     []optimizer.ColumnRef     // 分组键
@@ -20,6 +23,16 @@ type GroupBy struct {
 	resultSent    bool                  // 是否已发送结果
 	initialized   bool                  // 是否已初始化
 	groupedData   map[string]*GroupData // 分组数据
+
+	// 分区哈希聚合（内存超出预算时按分组键哈希将输入溢写到分区文件，再逐个分区聚合）
+	acct       *MemoryAccountant // 本查询的内存记账器，nil 表示不限制
+	reserved   int64             // 当前内存中分组数据已预留的字节数
+	partitions []*spillFile      // 溢写分区
+	partIdx    int               // 下一个待聚合的分区
+
+	// 并行聚合（各 worker 对不同 morsel 做局部聚合，再按分组键分区并行合并）
+	parallelism int            // 聚合并行度
+	pending     []*types.Batch // 等待并行聚合的批次
 }
 
 // GroupData 存储每个分组的数据
@@ -43,6 +56,8 @@ func NewGroupBy(groupKeys []optimizer.ColumnRef, aggregations []optimizer.Aggreg
 		resultSent:    false,
 		initialized:   false,
 		groupedData:   make(map[string]*GroupData),
+		acct:          accountantFromContext(ctx),
+		parallelism:   parallelismFromContext(ctx),
 	}
 }
 
@@ -61,6 +76,11 @@ func (op *GroupBy) Next() (*types.Batch, error) {
 		op.initialized = true
 	}
 
+	// 发生过溢写时，逐个分区聚合并返回结果
+	if op.partitions != nil {
+		return op.nextPartitionResult()
+	}
+
 	// GROUP BY算子只返回一次结果
 	if op.resultSent {
 		return nil, nil
@@ -83,19 +103,171 @@ func (op *GroupBy) processAllData() error {
 			break
 		}
 
+		if op.partitions != nil {
+			if err := op.partitionBatch(batch.Record()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// 超出内存预算时切换为分区哈希聚合
+		if op.acct != nil {
+			size := estimateRecordSize(batch.Record())
+			if !op.acct.TryReserve(size) {
+				// 先完成已缓冲批次的聚合，再把分组数据转入分区
+				if err := op.flushPending(); err != nil {
+					return err
+				}
+				if err := op.startSpilling(batch.Record().Schema()); err != nil {
+					return err
+				}
+				if err := op.partitionBatch(batch.Record()); err != nil {
+					return err
+				}
+				continue
+			}
+			op.reserved += size
+		}
+
+		if op.parallelism > 1 {
+			op.pending = append(op.pending, batch)
+			if len(op.pending) >= op.parallelism*parallelAggBatchesPerWorker {
+				if err := op.flushPending(); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
 		if err := op.processGroupBatch(batch); err != nil {
 			return err
 		}
 	}
+
+	if err := op.flushPending(); err != nil {
+		return err
+	}
+	for _, part := range op.partitions {
+		if err := part.Finish(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// processGroupBatch 处理单个批次的分组
-func (op *GroupBy) processGroupBatch(batch *types.Batch) error {
-	record := batch.Record()
-	schema := record.Schema()
+// startSpilling 创建溢写分区，并把内存中已有分组的原始行写入对应分区
+func (op *GroupBy) startSpilling(schema *arrow.Schema) error {
+	op.partitions = make([]*spillFile, spillPartitions)
+	for i := range op.partitions {
+		part, err := newSpillFile(op.acct, fmt.Sprintf("agg-part%02d", i), schema)
+		if err != nil {
+			return err
+		}
+		op.partitions[i] = part
+	}
 
-	// 找到分组列的索引
+	rowsByPart := make([][][]interface{}, spillPartitions)
+	for groupKey, group := range op.groupedData {
+		p := partitionOfKey(groupKey)
+		rowsByPart[p] = append(rowsByPart[p], group.rows...)
+	}
+	pool := memory.NewGoAllocator()
+	for i, rows := range rowsByPart {
+		if len(rows) == 0 {
+			continue
+		}
+		record := buildRecordFromRows(schema, rows, pool)
+		err := op.partitions[i].Write(record)
+		record.Release()
+		if err != nil {
+			return err
+		}
+	}
+
+	op.groupedData = make(map[string]*GroupData)
+	op.releaseReserved()
+	return nil
+}
+
+// partitionBatch 按分组键哈希把批次中的行写入对应分区
+func (op *GroupBy) partitionBatch(record arrow.Record) error {
+	groupKeyIndices, err := op.groupKeyIndices(record.Schema())
+	if err != nil {
+		return err
+	}
+
+	rowsByPart := make([][]int, spillPartitions)
+	for rowIdx := 0; rowIdx < int(record.NumRows()); rowIdx++ {
+		groupKey := op.makeGroupKeyString(op.groupKeyValues(record, groupKeyIndices, rowIdx))
+		p := partitionOfKey(groupKey)
+		rowsByPart[p] = append(rowsByPart[p], rowIdx)
+	}
+
+	pool := memory.NewGoAllocator()
+	for i, rows := range rowsByPart {
+		if len(rows) == 0 {
+			continue
+		}
+		partRecord, err := takeRows(record, rows, pool)
+		if err != nil {
+			return err
+		}
+		err = op.partitions[i].Write(partRecord)
+		partRecord.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextPartitionResult 读回下一个非空分区，在内存中完成聚合并返回结果
+func (op *GroupBy) nextPartitionResult() (*types.Batch, error) {
+	for op.partIdx < len(op.partitions) {
+		part := op.partitions[op.partIdx]
+		op.partIdx++
+		if part.rows == 0 {
+			continue
+		}
+
+		// 同一分组的所有行都落在同一分区，分区之间互不影响
+		records, err := part.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		op.groupedData = make(map[string]*GroupData)
+		for _, record := range records {
+			if err == nil {
+				err = op.processGroupBatch(types.NewBatch(record))
+			}
+			record.Release()
+		}
+		part.Remove()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := op.buildGroupResult()
+		if err != nil {
+			return nil, err
+		}
+		if batch != nil {
+			return batch, nil
+		}
+	}
+	return nil, nil
+}
+
+// releaseReserved 归还分组数据占用的内存预算
+func (op *GroupBy) releaseReserved() {
+	if op.acct != nil && op.reserved > 0 {
+		op.acct.Release(op.reserved)
+	}
+	op.reserved = 0
+}
+
+// groupKeyIndices 找到分组列在schema中的索引
+func (op *GroupBy) groupKeyIndices(schema *arrow.Schema) ([]int, error) {
 	groupKeyIndices := make([]int, len(op.groupKeys))
 	for i, key := range op.groupKeys {
 		found := false
@@ -107,29 +279,162 @@ func (op *GroupBy) processGroupBatch(batch *types.Batch) error {
 			}
 		}
 		if !found {
-			return fmt.Errorf("group key column %s not found", key.Column)
+			return nil, fmt.Errorf("group key column %s not found", key.Column)
+		}
+	}
+	return groupKeyIndices, nil
+}
+
+// groupKeyValues 提取指定行的分组键值
+func (op *GroupBy) groupKeyValues(record arrow.Record, groupKeyIndices []int, rowIdx int) []interface{} {
+	groupKeyValues := make([]interface{}, len(groupKeyIndices))
+	for i, colIdx := range groupKeyIndices {
+		column := record.Column(colIdx)
+		switch col := column.(type) {
+		case *array.Int64:
+			groupKeyValues[i] = col.Value(rowIdx)
+		case *array.String:
+			groupKeyValues[i] = col.Value(rowIdx)
+		case *array.Float64:
+			groupKeyValues[i] = col.Value(rowIdx)
+		case *array.Boolean:
+			groupKeyValues[i] = col.Value(rowIdx)
+		default:
+			groupKeyValues[i] = nil
+		}
+	}
+	return groupKeyValues
+}
+
+// flushPending 并行聚合已缓冲的批次，并合并到 groupedData
+// 第一阶段：每个 worker 把分到的批次聚合到自己的局部哈希表；
+// 第二阶段：按分组键哈希分区，每个 worker 合并一个分区内所有局部表的分组；
+// 最后把各分区的结果并入 groupedData（分区之间的分组键互不相交）。
+func (op *GroupBy) flushPending() error {
+	if len(op.pending) == 0 {
+		return nil
+	}
+	pending := op.pending
+	op.pending = nil
+
+	dop := op.parallelism
+	if dop > len(pending) {
+		dop = len(pending)
+	}
+
+	// 第一阶段：局部聚合
+	locals := make([]map[string]*GroupData, dop)
+	err := ParallelForEach(dop, dop, func(w int) error {
+		local := make(map[string]*GroupData)
+		for i := w; i < len(pending); i += dop {
+			if err := op.processGroupBatchInto(pending[i], local); err != nil {
+				return err
+			}
+		}
+		locals[w] = local
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 第二阶段：分区合并，已有的 groupedData 作为第 0 个局部表参与合并
+	locals = append([]map[string]*GroupData{op.groupedData}, locals...)
+	merged := make([]map[string]*GroupData, dop)
+	ParallelForEach(dop, dop, func(p int) error {
+		result := make(map[string]*GroupData)
+		for _, local := range locals {
+			for groupKey, group := range local {
+				if hashPartition(groupKey, dop) != p {
+					continue
+				}
+				if existing, ok := result[groupKey]; ok {
+					op.mergeGroupData(existing, group)
+				} else {
+					result[groupKey] = group
+				}
+			}
+		}
+		merged[p] = result
+		return nil
+	})
+
+	op.groupedData = make(map[string]*GroupData)
+	for _, part := range merged {
+		for groupKey, group := range part {
+			op.groupedData[groupKey] = group
 		}
 	}
+	return nil
+}
+
+// mergeGroupData 把同一分组的局部聚合结果 src 合并到 dst
+func (op *GroupBy) mergeGroupData(dst, src *GroupData) {
+	dst.count += src.count
+	dst.rows = append(dst.rows, src.rows...)
+
+	for _, agg := range op.aggregations {
+		aggKey := fmt.Sprintf("%s_%s", agg.Function, agg.Column)
+		srcValue, srcOK := src.aggregates[aggKey]
+
+		switch agg.Function {
+		case "COUNT":
+			if agg.Column == "*" {
+				dst.aggregates[aggKey] = dst.count
+			} else if srcOK {
+				if dstValue, ok := dst.aggregates[aggKey]; ok {
+					dst.aggregates[aggKey] = dstValue.(int64) + srcValue.(int64)
+				} else {
+					dst.aggregates[aggKey] = srcValue
+				}
+			}
+
+		case "SUM":
+			if srcOK {
+				dst.sums[aggKey] += src.sums[aggKey]
+				dst.aggregates[aggKey] = dst.sums[aggKey]
+			}
+
+		case "AVG":
+			if srcOK {
+				dst.sums[aggKey] += src.sums[aggKey]
+				dst.avgCounts[aggKey] += src.avgCounts[aggKey]
+				dst.aggregates[aggKey] = dst.sums[aggKey] / float64(dst.avgCounts[aggKey])
+			}
+
+		case "MIN":
+			if dstValue, ok := dst.aggregates[aggKey]; srcOK && (!ok || srcValue.(float64) < dstValue.(float64)) {
+				dst.aggregates[aggKey] = srcValue
+			}
+
+		case "MAX":
+			if dstValue, ok := dst.aggregates[aggKey]; srcOK && (!ok || srcValue.(float64) > dstValue.(float64)) {
+				dst.aggregates[aggKey] = srcValue
+			}
+		}
+	}
+}
+
+// processGroupBatch 处理单个批次的分组
+func (op *GroupBy) processGroupBatch(batch *types.Batch) error {
+	return op.processGroupBatchInto(batch, op.groupedData)
+}
+
+// processGroupBatchInto 把单个批次聚合到指定的分组哈希表
+func (op *GroupBy) processGroupBatchInto(batch *types.Batch, groups map[string]*GroupData) error {
+	record := batch.Record()
+	schema := record.Schema()
+
+	// 找到分组列的索引
+	groupKeyIndices, err := op.groupKeyIndices(schema)
+	if err != nil {
+		return err
+	}
 
 	// 遍历每一行进行分组
 	for rowIdx := int64(0); rowIdx < record.NumRows(); rowIdx++ {
 		// 提取分组键值
-		groupKeyValues := make([]interface{}, len(op.groupKeys))
-		for i, colIdx := range groupKeyIndices {
-			column := record.Column(colIdx)
-			switch col := column.(type) {
-			case *array.Int64:
-				groupKeyValues[i] = col.Value(int(rowIdx))
-			case *array.String:
-				groupKeyValues[i] = col.Value(int(rowIdx))
-			case *array.Float64:
-				groupKeyValues[i] = col.Value(int(rowIdx))
-			case *array.Boolean:
-				groupKeyValues[i] = col.Value(int(rowIdx))
-			default:
-				groupKeyValues[i] = nil
-			}
-		}
+		groupKeyValues := op.groupKeyValues(record, groupKeyIndices, int(rowIdx))
 
 		// 创建分组键字符串
 		groupKey := op.makeGroupKeyString(groupKeyValues)
@@ -153,7 +458,7 @@ func (op *GroupBy) processGroupBatch(batch *types.Batch) error {
 		}
 
 		// 加入分组
-		if group, exists := op.groupedData[groupKey]; exists {
+		if group, exists := groups[groupKey]; exists {
 			group.count++
 			group.rows = append(group.rows, rowData)
 			op.updateAggregates(group, record, int(rowIdx))
@@ -167,7 +472,7 @@ func (op *GroupBy) processGroupBatch(batch *types.Batch) error {
 				avgCounts:  make(map[string]int64),
 			}
 			op.updateAggregates(newGroup, record, int(rowIdx))
-			op.groupedData[groupKey] = newGroup
+			groups[groupKey] = newGroup
 		}
 	}
 
@@ -516,5 +821,10 @@ func (op *GroupBy) buildGroupResult() (*types.Batch, error) {
 
 // Close 关闭算子
 func (op *GroupBy) Close() error {
+	for _, part := range op.partitions {
+		part.Remove()
+	}
+	op.partitions = nil
+	op.releaseReserved()
 	return op.child.Close()
 }
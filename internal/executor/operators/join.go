@@ -1,6 +1,9 @@
 package operators
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
 	"github.com/apache/arrow/go/v18/arrow/memory"
@@ -15,10 +18,20 @@ type Join struct {
 	left         Operator             // 左子算子
 	right        Operator             // 右子算子
 	ctx          interface{}
-	leftBatches  []*types.Batch // 缓存左表所有批次
-	rightBatches []*types.Batch // 缓存右表所有批次
-	initialized  bool           // 是否已初始化
-	resultSent   bool           // 是否已发送结果
+	queryCtx     context.Context // 查询取消时停止嵌套循环连接
+	leftBatches  []*types.Batch  // 缓存左表所有批次
+	rightBatches []*types.Batch  // 缓存右表所有批次
+	initialized  bool            // 是否已初始化
+	resultSent   bool            // 是否已发送结果
+
+	// grace hash join（内存超出预算时按连接键哈希将两侧数据溢写到分区文件，再逐个分区连接）
+	acct        *MemoryAccountant // 本查询的内存记账器，nil 表示不限制
+	reserved    int64             // 缓存批次已预留的字节数
+	leftParts   []*spillFile      // 左表溢写分区
+	rightParts  []*spillFile      // 右表溢写分区
+	partIdx     int               // 下一个待连接的分区
+	leftSchema  *arrow.Schema
+	rightSchema *arrow.Schema
 }
 
 // NewJoin 创建连接算子
@@ -29,10 +42,12 @@ func NewJoin(joinType string, condition optimizer.Expression, left, right Operat
 		left:         left,
 		right:        right,
 		ctx:          ctx,
+		queryCtx:     queryContextFrom(ctx),
 		leftBatches:  []*types.Batch{},
 		rightBatches: []*types.Batch{},
 		initialized:  false,
 		resultSent:   false,
+		acct:         accountantFromContext(ctx),
 	}
 }
 
@@ -54,6 +69,11 @@ func (op *Join) Next() (*types.Batch, error) {
 		op.initialized = true
 	}
 
+	// 发生过溢写时，逐个分区执行连接
+	if op.leftParts != nil {
+		return op.nextPartitionResult()
+	}
+
 	// JOIN算子只返回一次结果
 	if op.resultSent {
 		return nil, nil
@@ -76,7 +96,10 @@ func (op *Join) cacheAllData() error {
 		if batch == nil {
 			break
 		}
-		op.leftBatches = append(op.leftBatches, batch)
+		op.leftSchema = batch.Record().Schema()
+		if err := op.addBatch(batch, true); err != nil {
+			return err
+		}
 	}
 
 	// 缓存右表数据
@@ -88,12 +111,186 @@ func (op *Join) cacheAllData() error {
 		if batch == nil {
 			break
 		}
+		op.rightSchema = batch.Record().Schema()
+		if err := op.addBatch(batch, false); err != nil {
+			return err
+		}
+	}
+
+	if op.leftParts != nil {
+		return op.finishPartitions()
+	}
+	return nil
+}
+
+// addBatch 缓存一个批次；超出内存预算且为等值连接时切换为 grace hash join
+func (op *Join) addBatch(batch *types.Batch, isLeft bool) error {
+	if op.leftParts != nil {
+		return op.partitionBatch(batch.Record(), isLeft)
+	}
+
+	if op.acct != nil {
+		size := estimateRecordSize(batch.Record())
+		if !op.acct.TryReserve(size) {
+			if op.equiJoinCondition() != nil {
+				if err := op.startSpilling(); err != nil {
+					return err
+				}
+				return op.partitionBatch(batch.Record(), isLeft)
+			}
+			// 非等值连接无法分区，只能继续在内存中处理
+			op.acct.Reserve(size)
+		}
+		op.reserved += size
+	}
+
+	if isLeft {
+		op.leftBatches = append(op.leftBatches, batch)
+	} else {
 		op.rightBatches = append(op.rightBatches, batch)
 	}
+	return nil
+}
+
+// equiJoinCondition 返回等值连接条件，不是等值连接时返回 nil
+func (op *Join) equiJoinCondition() *optimizer.BinaryExpression {
+	if binExpr, ok := op.condition.(*optimizer.BinaryExpression); ok && binExpr.Operator == "=" {
+		return binExpr
+	}
+	return nil
+}
+
+// startSpilling 创建两侧的溢写分区，并把已缓存的批次写入分区
+func (op *Join) startSpilling() error {
+	op.leftParts = make([]*spillFile, spillPartitions)
+	op.rightParts = make([]*spillFile, spillPartitions)
+
+	leftBatches, rightBatches := op.leftBatches, op.rightBatches
+	op.leftBatches, op.rightBatches = nil, nil
+	for _, batch := range leftBatches {
+		if err := op.partitionBatch(batch.Record(), true); err != nil {
+			return err
+		}
+	}
+	for _, batch := range rightBatches {
+		if err := op.partitionBatch(batch.Record(), false); err != nil {
+			return err
+		}
+	}
 
+	if op.reserved > 0 {
+		op.acct.Release(op.reserved)
+		op.reserved = 0
+	}
 	return nil
 }
 
+// partitionBatch 按连接键哈希把批次中的行写入对应分区
+func (op *Join) partitionBatch(record arrow.Record, isLeft bool) error {
+	binExpr := op.equiJoinCondition()
+	parts, keyExpr, prefix := op.rightParts, binExpr.Right, "join-right"
+	if isLeft {
+		parts, keyExpr, prefix = op.leftParts, binExpr.Left, "join-left"
+	}
+
+	rowsByPart := make([][]int, spillPartitions)
+	for rowIdx := int64(0); rowIdx < record.NumRows(); rowIdx++ {
+		p := partitionOf(op.getColumnValue(record, rowIdx, keyExpr))
+		rowsByPart[p] = append(rowsByPart[p], int(rowIdx))
+	}
+
+	pool := memory.NewGoAllocator()
+	for i, rows := range rowsByPart {
+		if len(rows) == 0 {
+			continue
+		}
+		if parts[i] == nil {
+			part, err := newSpillFile(op.acct, fmt.Sprintf("%s%02d", prefix, i), record.Schema())
+			if err != nil {
+				return err
+			}
+			parts[i] = part
+		}
+		partRecord, err := takeRows(record, rows, pool)
+		if err != nil {
+			return err
+		}
+		err = parts[i].Write(partRecord)
+		partRecord.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishPartitions 结束所有分区的写入
+func (op *Join) finishPartitions() error {
+	for _, parts := range [][]*spillFile{op.leftParts, op.rightParts} {
+		for _, part := range parts {
+			if part == nil {
+				continue
+			}
+			if err := part.Finish(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nextPartitionResult 读回下一对分区并在内存中执行连接
+func (op *Join) nextPartitionResult() (*types.Batch, error) {
+	for op.partIdx < spillPartitions {
+		i := op.partIdx
+		op.partIdx++
+
+		// 连接键相等的行必然落在同一对分区；左表为空的分区不会产生结果
+		leftPart, rightPart := op.leftParts[i], op.rightParts[i]
+		if leftPart == nil {
+			continue
+		}
+		if rightPart == nil && op.joinType != "LEFT" {
+			continue
+		}
+
+		batch, err := op.joinPartition(leftPart, rightPart)
+		if err != nil {
+			return nil, err
+		}
+		if batch != nil {
+			return batch, nil
+		}
+	}
+	return nil, nil
+}
+
+// joinPartition 连接一对分区
+func (op *Join) joinPartition(leftPart, rightPart *spillFile) (*types.Batch, error) {
+	leftRecords, err := leftPart.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	defer releaseRecords(leftRecords)
+	defer leftPart.Remove()
+
+	var rightBatches []*types.Batch
+	if rightPart != nil {
+		rightRecords, err := rightPart.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		defer releaseRecords(rightRecords)
+		defer rightPart.Remove()
+		rightBatches = recordsToBatches(rightRecords)
+	} else if op.rightSchema != nil {
+		// LEFT JOIN：右侧分区为空时仍需输出左表行
+		rightBatches = []*types.Batch{types.NewEmptyBatch(op.rightSchema, memory.NewGoAllocator())}
+	}
+
+	return op.buildJoinResult(recordsToBatches(leftRecords), rightBatches)
+}
+
 // buildJoinResult 构建JOIN结果
 func (op *Join) buildJoinResult(leftBatches, rightBatches []*types.Batch) (*types.Batch, error) {
 	// 构建结果schema（左表字段 + 右表字段）
@@ -122,6 +319,9 @@ func (op *Join) buildJoinResult(leftBatches, rightBatches []*types.Batch) (*type
 	for _, leftBatch := range leftBatches {
 		leftRec := leftBatch.Record()
 		for leftRowIdx := int64(0); leftRowIdx < leftRec.NumRows(); leftRowIdx++ {
+			if err := canceled(op.queryCtx); err != nil {
+				return nil, err
+			}
 			hasMatch := false
 			for _, rightBatch := range rightBatches {
 				rightRec := rightBatch.Record()
@@ -427,6 +627,19 @@ func (op *Join) appendLeftJoinRow(builder *array.RecordBuilder, leftRec arrow.Re
 
 // Close 关闭算子
 func (op *Join) Close() error {
+	for _, parts := range [][]*spillFile{op.leftParts, op.rightParts} {
+		for _, part := range parts {
+			if part != nil {
+				part.Remove()
+			}
+		}
+	}
+	op.leftParts, op.rightParts = nil, nil
+	if op.acct != nil && op.reserved > 0 {
+		op.acct.Release(op.reserved)
+		op.reserved = 0
+	}
+
 	if err := op.left.Close(); err != nil {
 		return err
 	}
@@ -0,0 +1,209 @@
+package operators
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/v18/arrow/memory"
+)
+
+// MemoryAccountant 单个查询的内存记账器
+// 包装 Arrow allocator 统计 Arrow 缓冲区的分配，同时允许算子为 Go 侧物化的行数据预留额度。
+// 当预留失败时，算子应当把中间结果溢写到磁盘（见 spill.go）。
+type MemoryAccountant struct {
+	mem      memory.Allocator
+	limit    int64 // 内存预算（字节），<= 0 表示不限制
+	used     atomic.Int64
+	peak     atomic.Int64
+	spilled  atomic.Int64      // 累计溢写字节数
+	spills   atomic.Int64      // 累计溢写文件数
+	spillDir string            // 溢写根目录
+	parent   *MemoryAccountant // Scope 创建的子记账器记账到父记账器，预算、溢写目录由父记账器管理
+
+	mu       sync.Mutex
+	queryDir string // 本查询的临时目录，首次溢写时创建
+}
+
+// 确保 MemoryAccountant 实现 memory.Allocator 接口
+var _ memory.Allocator = (*MemoryAccountant)(nil)
+
+// NewMemoryAccountant 创建内存记账器
+// limit 为内存预算，spillDir 为溢写根目录（为空时使用系统临时目录）
+func NewMemoryAccountant(limit int64, spillDir string) *MemoryAccountant {
+	if spillDir == "" {
+		spillDir = os.TempDir()
+	}
+	return &MemoryAccountant{
+		mem:      memory.NewGoAllocator(),
+		limit:    limit,
+		spillDir: spillDir,
+	}
+}
+
+// Scope 创建记账到本记账器的子记账器
+// 子记账器共享本记账器的预算和溢写目录，同时单独统计自己的用量、峰值和溢写 (EXPLAIN ANALYZE 按算子统计内存)
+func (a *MemoryAccountant) Scope() *MemoryAccountant {
+	return &MemoryAccountant{
+		mem:      a,
+		limit:    a.limit,
+		spillDir: a.spillDir,
+		parent:   a,
+	}
+}
+
+// Allocate 实现 memory.Allocator
+func (a *MemoryAccountant) Allocate(size int) []byte {
+	a.grow(int64(size))
+	return a.mem.Allocate(size)
+}
+
+// Reallocate 实现 memory.Allocator
+func (a *MemoryAccountant) Reallocate(size int, b []byte) []byte {
+	a.grow(int64(size - len(b)))
+	return a.mem.Reallocate(size, b)
+}
+
+// Free 实现 memory.Allocator
+func (a *MemoryAccountant) Free(b []byte) {
+	a.grow(-int64(len(b)))
+	a.mem.Free(b)
+}
+
+// TryReserve 尝试为 Go 侧数据预留 n 字节，超出预算时返回 false 且不做任何记账
+func (a *MemoryAccountant) TryReserve(n int64) bool {
+	if a.parent != nil {
+		if !a.parent.TryReserve(n) {
+			return false
+		}
+		a.grow(n)
+		return true
+	}
+	for {
+		cur := a.used.Load()
+		if a.limit > 0 && cur+n > a.limit {
+			return false
+		}
+		if a.used.CompareAndSwap(cur, cur+n) {
+			a.updatePeak(cur + n)
+			return true
+		}
+	}
+}
+
+// Reserve 无条件预留 n 字节（用于单行已超出预算等无法溢写的情况）
+func (a *MemoryAccountant) Reserve(n int64) {
+	if a.parent != nil {
+		a.parent.Reserve(n)
+	}
+	a.grow(n)
+}
+
+// Release 释放之前预留的 n 字节
+func (a *MemoryAccountant) Release(n int64) {
+	if a.parent != nil {
+		a.parent.Release(n)
+	}
+	a.grow(-n)
+}
+
+// Used 当前已记账的字节数
+func (a *MemoryAccountant) Used() int64 {
+	return a.used.Load()
+}
+
+// Peak 峰值内存
+func (a *MemoryAccountant) Peak() int64 {
+	return a.peak.Load()
+}
+
+// Limit 内存预算
+func (a *MemoryAccountant) Limit() int64 {
+	return a.limit
+}
+
+// SpilledBytes 累计溢写字节数
+func (a *MemoryAccountant) SpilledBytes() int64 {
+	return a.spilled.Load()
+}
+
+// SpillCount 累计溢写文件数
+func (a *MemoryAccountant) SpillCount() int64 {
+	return a.spills.Load()
+}
+
+// tempDir 返回本查询的溢写目录，首次调用时创建
+func (a *MemoryAccountant) tempDir() (string, error) {
+	if a.parent != nil {
+		return a.parent.tempDir()
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.queryDir != "" {
+		return a.queryDir, nil
+	}
+	if err := os.MkdirAll(a.spillDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create spill directory: %w", err)
+	}
+	dir, err := os.MkdirTemp(a.spillDir, "minidb-spill-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create spill directory: %w", err)
+	}
+	a.queryDir = dir
+	return dir, nil
+}
+
+// Cleanup 删除本查询产生的所有溢写文件
+func (a *MemoryAccountant) Cleanup() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.queryDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(a.queryDir)
+	a.queryDir = ""
+	return err
+}
+
+// addSpill 记录一个溢写文件
+func (a *MemoryAccountant) addSpill() {
+	for ; a != nil; a = a.parent {
+		a.spills.Add(1)
+	}
+}
+
+// addSpilled 记录溢写的字节数
+func (a *MemoryAccountant) addSpilled(n int64) {
+	for ; a != nil; a = a.parent {
+		a.spilled.Add(n)
+	}
+}
+
+func (a *MemoryAccountant) grow(n int64) {
+	a.updatePeak(a.used.Add(n))
+}
+
+func (a *MemoryAccountant) updatePeak(cur int64) {
+	for {
+		p := a.peak.Load()
+		if cur <= p || a.peak.CompareAndSwap(p, cur) {
+			return
+		}
+	}
+}
+
+// memoryAccountantProvider 由执行上下文实现，算子通过它获取本查询的内存记账器
+type memoryAccountantProvider interface {
+	MemoryAccountant() *MemoryAccountant
+}
+
+// accountantFromContext 从算子上下文中取出内存记账器，没有时返回 nil（不限制内存）
+func accountantFromContext(ctx interface{}) *MemoryAccountant {
+	if p, ok := ctx.(memoryAccountantProvider); ok {
+		return p.MemoryAccountant()
+	}
+	return nil
+}
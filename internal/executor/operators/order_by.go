@@ -1,13 +1,13 @@
 package operators
 
 import (
+	"container/heap"
 	"fmt"
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
 	"github.com/apache/arrow/go/v18/arrow/memory"
 	"github.com/yyun543/minidb/internal/optimizer"
 	"github.com/yyun543/minidb/internal/types"
-	"sort"
 )
 
 // OrderBy ORDER BY算子
@@ -18,6 +18,14 @@ type OrderBy struct {
 	resultSent  bool           // 是否已发送结果
 	initialized bool           // 是否已初始化
 	sortedData  []*types.Batch // 排序后的数据
+
+	// 外部排序（内存超出预算时按有序段溢写到磁盘，再多路归并）
+	acct     *MemoryAccountant // 本查询的内存记账器，nil 表示不限制
+	reserved int64             // 当前内存中行数据已预留的字节数
+	runs     []*spillFile      // 已溢写的有序段
+	merger   *runMerger        // 多路归并器
+
+	parallelism int // 排序并行度
 }
 
 // sortableRow 可排序的行数据
@@ -41,6 +49,8 @@ func NewOrderBy(orderKeys []optimizer.OrderKey, child Operator, ctx interface{})
 		resultSent:  false,
 		initialized: false,
 		sortedData:  make([]*types.Batch, 0),
+		acct:        accountantFromContext(ctx),
+		parallelism: parallelismFromContext(ctx),
 	}
 }
 
@@ -59,6 +69,11 @@ func (op *OrderBy) Next() (*types.Batch, error) {
 		op.initialized = true
 	}
 
+	// 发生过溢写时，以流式方式返回多路归并结果
+	if op.merger != nil {
+		return op.merger.next()
+	}
+
 	// ORDER BY算子只返回一次结果
 	if op.resultSent {
 		return nil, nil
@@ -121,25 +136,8 @@ func (op *OrderBy) processAndSortAllData() error {
 
 		// 提取每一行数据
 		for rowIdx := int64(0); rowIdx < record.NumRows(); rowIdx++ {
-			// 提取整行数据
-			rowData := make([]interface{}, record.NumCols())
-			for colIdx := int64(0); colIdx < record.NumCols(); colIdx++ {
-				column := record.Column(int(colIdx))
-				switch col := column.(type) {
-				case *array.Int64:
-					rowData[colIdx] = col.Value(int(rowIdx))
-				case *array.String:
-					rowData[colIdx] = col.Value(int(rowIdx))
-				case *array.Float64:
-					rowData[colIdx] = col.Value(int(rowIdx))
-				case *array.Boolean:
-					rowData[colIdx] = col.Value(int(rowIdx))
-				case *array.Timestamp:
-					rowData[colIdx] = col.Value(int(rowIdx))
-				default:
-					rowData[colIdx] = nil
-				}
-			}
+			// 提取整行数据：NULL 保留为 nil，与溢写后归并时读出的值一致
+			rowData := rowValues(record, int(rowIdx))
 
 			// 提取排序键值
 			keyValues := make([]interface{}, len(op.orderKeys))
@@ -157,6 +155,21 @@ func (op *OrderBy) processAndSortAllData() error {
 				}
 			}
 
+			// 超出内存预算时，将当前已收集的行排序后作为一个有序段溢写
+			if op.acct != nil {
+				size := estimateRowSize(rowData) + estimateRowSize(keyValues)
+				if !op.acct.TryReserve(size) {
+					if err := op.spillRun(allRows, schema); err != nil {
+						return err
+					}
+					allRows = nil
+					if !op.acct.TryReserve(size) {
+						op.acct.Reserve(size)
+					}
+				}
+				op.reserved += size
+			}
+
 			allRows = append(allRows, sortableRow{
 				data:      rowData,
 				keyValues: keyValues,
@@ -164,13 +177,22 @@ func (op *OrderBy) processAndSortAllData() error {
 		}
 	}
 
+	// 有溢写的有序段时，剩余的行也作为一个有序段落盘，之后进行多路归并
+	if len(op.runs) > 0 {
+		if err := op.spillRun(allRows, schema); err != nil {
+			return err
+		}
+		merger, err := newRunMerger(op, schema, orderKeyIndices)
+		if err != nil {
+			return err
+		}
+		op.merger = merger
+		return nil
+	}
+
 	// 排序数据
 	if len(allRows) > 0 {
-		sortableData := &sortableRows{
-			rows:      allRows,
-			orderKeys: op.orderKeys,
-		}
-		sort.Sort(sortableData)
+		allRows = parallelSortRows(allRows, op.orderKeys, op.parallelism)
 
 		// 重建Arrow记录
 		sortedBatch, err := op.buildSortedResult(allRows, schema)
@@ -182,10 +204,203 @@ func (op *OrderBy) processAndSortAllData() error {
 			op.sortedData = append(op.sortedData, sortedBatch)
 		}
 	}
+	op.releaseReserved()
 
 	return nil
 }
 
+// spillRun 将一批行排序后作为一个有序段写入溢写文件
+func (op *OrderBy) spillRun(rows []sortableRow, schema *arrow.Schema) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	rows = parallelSortRows(rows, op.orderKeys, op.parallelism)
+
+	run, err := newSpillFile(op.acct, "sort-run", schema)
+	if err != nil {
+		return err
+	}
+	pool := memory.NewGoAllocator()
+	chunk := make([][]interface{}, 0, spillBatchRows)
+	for i, row := range rows {
+		chunk = append(chunk, row.data)
+		if len(chunk) == spillBatchRows || i == len(rows)-1 {
+			record := buildRecordFromRows(schema, chunk, pool)
+			err := run.Write(record)
+			record.Release()
+			if err != nil {
+				run.Remove()
+				return err
+			}
+			chunk = chunk[:0]
+		}
+	}
+	if err := run.Finish(); err != nil {
+		run.Remove()
+		return err
+	}
+
+	op.runs = append(op.runs, run)
+	op.releaseReserved()
+	return nil
+}
+
+// releaseReserved 归还行数据占用的内存预算
+func (op *OrderBy) releaseReserved() {
+	if op.acct != nil && op.reserved > 0 {
+		op.acct.Release(op.reserved)
+	}
+	op.reserved = 0
+}
+
+// runCursor 多路归并时某个有序段的读取游标
+type runCursor struct {
+	reader *spillReader
+	record arrow.Record
+	row    int
+	keys   []interface{}
+}
+
+// runMerger 对溢写的有序段做多路归并
+type runMerger struct {
+	op              *OrderBy
+	schema          *arrow.Schema
+	orderKeyIndices []int
+	cursors         []*runCursor
+}
+
+// newRunMerger 打开所有有序段并建立归并堆
+func newRunMerger(op *OrderBy, schema *arrow.Schema, orderKeyIndices []int) (*runMerger, error) {
+	m := &runMerger{op: op, schema: schema, orderKeyIndices: orderKeyIndices}
+	for _, run := range op.runs {
+		reader, err := run.Open()
+		if err != nil {
+			m.close()
+			return nil, err
+		}
+		cursor := &runCursor{reader: reader, row: -1}
+		ok, err := m.advance(cursor)
+		if err != nil {
+			reader.Close()
+			m.close()
+			return nil, err
+		}
+		if ok {
+			m.cursors = append(m.cursors, cursor)
+		} else {
+			reader.Close()
+		}
+	}
+	heap.Init(m)
+	return m, nil
+}
+
+// advance 将游标移动到下一行，有序段读完时返回 false
+func (m *runMerger) advance(c *runCursor) (bool, error) {
+	c.row++
+	for c.record == nil || c.row >= int(c.record.NumRows()) {
+		if c.record != nil {
+			c.record.Release()
+			c.record = nil
+		}
+		record, err := c.reader.Next()
+		if err != nil {
+			return false, err
+		}
+		if record == nil {
+			return false, nil
+		}
+		c.record = record
+		c.row = 0
+	}
+
+	keys := make([]interface{}, len(m.orderKeyIndices))
+	for i, colIdx := range m.orderKeyIndices {
+		if colIdx == -1 {
+			value, err := m.op.evaluateExpression(m.op.orderKeys[i].Expression, c.record, c.row)
+			if err != nil {
+				return false, fmt.Errorf("failed to evaluate order by expression: %w", err)
+			}
+			keys[i] = value
+		} else {
+			keys[i] = rowValue(c.record.Column(colIdx), c.row)
+		}
+	}
+	c.keys = keys
+	return true, nil
+}
+
+// next 归并输出下一批有序数据，全部输出后返回 nil
+func (m *runMerger) next() (*types.Batch, error) {
+	rows := make([][]interface{}, 0, spillBatchRows)
+	for len(rows) < spillBatchRows && len(m.cursors) > 0 {
+		c := m.cursors[0]
+		rows = append(rows, rowValues(c.record, c.row))
+
+		ok, err := m.advance(c)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Fix(m, 0)
+		} else {
+			heap.Pop(m)
+			c.reader.Close()
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	record := buildRecordFromRows(m.schema, rows, memory.NewGoAllocator())
+	return types.NewBatch(record), nil
+}
+
+// close 关闭所有仍打开的游标
+func (m *runMerger) close() {
+	for _, c := range m.cursors {
+		if c.record != nil {
+			c.record.Release()
+		}
+		c.reader.Close()
+	}
+	m.cursors = nil
+}
+
+// 实现heap.Interface接口
+func (m *runMerger) Len() int {
+	return len(m.cursors)
+}
+
+func (m *runMerger) Less(i, j int) bool {
+	for keyIdx, orderKey := range m.op.orderKeys {
+		cmp := compareValues(m.cursors[i].keys[keyIdx], m.cursors[j].keys[keyIdx])
+		if orderKey.Direction == "DESC" {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+	}
+	return false
+}
+
+func (m *runMerger) Swap(i, j int) {
+	m.cursors[i], m.cursors[j] = m.cursors[j], m.cursors[i]
+}
+
+func (m *runMerger) Push(x interface{}) {
+	m.cursors = append(m.cursors, x.(*runCursor))
+}
+
+func (m *runMerger) Pop() interface{} {
+	n := len(m.cursors)
+	c := m.cursors[n-1]
+	m.cursors = m.cursors[:n-1]
+	return c
+}
+
 // buildSortedResult 构建排序后的结果
 func (op *OrderBy) buildSortedResult(rows []sortableRow, schema *arrow.Schema) (*types.Batch, error) {
 	if len(rows) == 0 {
@@ -251,6 +466,15 @@ func (op *OrderBy) buildSortedResult(rows []sortableRow, schema *arrow.Schema) (
 
 // Close 关闭算子
 func (op *OrderBy) Close() error {
+	if op.merger != nil {
+		op.merger.close()
+		op.merger = nil
+	}
+	for _, run := range op.runs {
+		run.Remove()
+	}
+	op.runs = nil
+	op.releaseReserved()
 	return op.child.Close()
 }
 
@@ -264,11 +488,12 @@ func (sr *sortableRows) Swap(i, j int) {
 }
 
 func (sr *sortableRows) Less(i, j int) bool {
-	row1 := sr.rows[i]
-	row2 := sr.rows[j]
+	return lessRows(sr.rows[i], sr.rows[j], sr.orderKeys)
+}
 
-	// 按多个排序键比较
-	for keyIdx, orderKey := range sr.orderKeys {
+// lessRows 按多个排序键比较两行，row1 应排在 row2 之前时返回 true
+func lessRows(row1, row2 sortableRow, orderKeys []optimizer.OrderKey) bool {
+	for keyIdx, orderKey := range orderKeys {
 		val1 := row1.keyValues[keyIdx]
 		val2 := row2.keyValues[keyIdx]
 
@@ -289,7 +514,7 @@ func (sr *sortableRows) Less(i, j int) bool {
 
 // compareValues 比较两个值
 func compareValues(val1, val2 interface{}) int {
-	// 处理nil值
+	// 处理nil值：NULL 小于任何值，即 ASC 时排在最前、DESC 时排在最后
 	if val1 == nil && val2 == nil {
 		return 0
 	}
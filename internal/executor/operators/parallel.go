@@ -0,0 +1,146 @@
+package operators
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+const (
+	// MorselRows 每个 morsel（并行调度的最小工作单元）的行数
+	MorselRows = 16384
+	// parallelSortMinRows 行数少于该值时直接串行排序，避免调度开销
+	parallelSortMinRows = 4096
+)
+
+// parallelismProvider 由执行上下文实现，算子通过它获取本查询的并行度
+type parallelismProvider interface {
+	MaxParallelism() int
+}
+
+// parallelismFromContext 从算子上下文中取出并行度，没有时返回 1（串行执行）
+func parallelismFromContext(ctx interface{}) int {
+	if p, ok := ctx.(parallelismProvider); ok && p.MaxParallelism() > 1 {
+		return p.MaxParallelism()
+	}
+	return 1
+}
+
+// SplitIntoMorsels 将大批次切分为不超过 MorselRows 行的 morsel，切片共享底层缓冲区，不复制数据
+func SplitIntoMorsels(batches []*types.Batch) []*types.Batch {
+	var morsels []*types.Batch
+	for _, batch := range batches {
+		record := batch.Record()
+		if record.NumRows() <= MorselRows {
+			morsels = append(morsels, batch)
+			continue
+		}
+		for start := int64(0); start < record.NumRows(); start += MorselRows {
+			end := start + MorselRows
+			if end > record.NumRows() {
+				end = record.NumRows()
+			}
+			morsels = append(morsels, types.NewBatch(record.NewSlice(start, end)))
+		}
+	}
+	return morsels
+}
+
+// ParallelForEach 使用最多 dop 个 goroutine 对 [0, n) 中的每个下标执行 fn
+// 所有任务结束后返回下标最小的错误；dop <= 1 时在当前 goroutine 中顺序执行
+func ParallelForEach(n, dop int, fn func(i int) error) error {
+	if dop <= 1 || n <= 1 {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if dop > n {
+		dop = n
+	}
+
+	errs := make([]error, n)
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < dop; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parallelSortRows 并行排序：将行切分为 dop 段分别排序，再两两归并
+func parallelSortRows(rows []sortableRow, orderKeys []optimizer.OrderKey, dop int) []sortableRow {
+	if dop <= 1 || len(rows) < parallelSortMinRows {
+		sort.Sort(&sortableRows{rows: rows, orderKeys: orderKeys})
+		return rows
+	}
+
+	// 1. 各段并行排序
+	chunkSize := (len(rows) + dop - 1) / dop
+	var chunks [][]sortableRow
+	for start := 0; start < len(rows); start += chunkSize {
+		end := start + chunkSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		chunks = append(chunks, rows[start:end])
+	}
+	ParallelForEach(len(chunks), dop, func(i int) error {
+		sort.Sort(&sortableRows{rows: chunks[i], orderKeys: orderKeys})
+		return nil
+	})
+
+	// 2. 逐轮两两归并，每轮内的归并相互独立，可以并行
+	for len(chunks) > 1 {
+		merged := make([][]sortableRow, (len(chunks)+1)/2)
+		ParallelForEach(len(merged), dop, func(i int) error {
+			if 2*i+1 >= len(chunks) {
+				merged[i] = chunks[2*i]
+				return nil
+			}
+			merged[i] = mergeSortedRows(chunks[2*i], chunks[2*i+1], orderKeys)
+			return nil
+		})
+		chunks = merged
+	}
+	return chunks[0]
+}
+
+// mergeSortedRows 归并两个有序行序列
+func mergeSortedRows(left, right []sortableRow, orderKeys []optimizer.OrderKey) []sortableRow {
+	result := make([]sortableRow, 0, len(left)+len(right))
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		// 相等时优先取左侧，保证归并本身是稳定的
+		if lessRows(right[j], left[i], orderKeys) {
+			result = append(result, right[j])
+			j++
+		} else {
+			result = append(result, left[i])
+			i++
+		}
+	}
+	result = append(result, left[i:]...)
+	return append(result, right[j:]...)
+}
@@ -0,0 +1,331 @@
+package operators
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/ipc"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+const (
+	// spillPartitions 分区哈希聚合与 grace hash join 的分区数
+	spillPartitions = 16
+	// spillBatchRows 溢写和归并输出时每个 record 的行数
+	spillBatchRows = 4096
+	// boxedValueOverhead Go 侧装箱一个值（interface{}）的估算开销
+	boxedValueOverhead = 16
+)
+
+// spillFile 溢写文件，以 Arrow IPC 流格式顺序写入多个 record
+type spillFile struct {
+	path   string
+	schema *arrow.Schema
+	file   *os.File
+	writer *ipc.Writer
+	acct   *MemoryAccountant
+	rows   int64
+}
+
+// newSpillFile 在本查询的临时目录下创建溢写文件
+func newSpillFile(acct *MemoryAccountant, prefix string, schema *arrow.Schema) (*spillFile, error) {
+	dir, err := acct.tempDir()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(dir, prefix+"-*.arrow")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spill file: %w", err)
+	}
+	acct.addSpill()
+	return &spillFile{
+		path:   f.Name(),
+		schema: schema,
+		file:   f,
+		writer: ipc.NewWriter(f, ipc.WithSchema(schema)),
+		acct:   acct,
+	}, nil
+}
+
+// Write 追加一个 record
+func (s *spillFile) Write(record arrow.Record) error {
+	if record == nil || record.NumRows() == 0 {
+		return nil
+	}
+	if err := s.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write spill file %s: %w", s.path, err)
+	}
+	s.rows += record.NumRows()
+	return nil
+}
+
+// Finish 结束写入并关闭文件
+func (s *spillFile) Finish() error {
+	if s.writer == nil {
+		return nil
+	}
+	if err := s.writer.Close(); err != nil {
+		s.file.Close()
+		return fmt.Errorf("failed to finish spill file %s: %w", s.path, err)
+	}
+	s.writer = nil
+	if info, err := s.file.Stat(); err == nil {
+		s.acct.addSpilled(info.Size())
+	}
+	return s.file.Close()
+}
+
+// Open 打开溢写文件用于读取
+func (s *spillFile) Open() (*spillReader, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spill file: %w", err)
+	}
+	reader, err := ipc.NewReader(f, ipc.WithAllocator(s.acct))
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to read spill file %s: %w", s.path, err)
+	}
+	return &spillReader{file: f, reader: reader}, nil
+}
+
+// ReadAll 读出文件中全部 record（调用方负责 Release）
+func (s *spillFile) ReadAll() ([]arrow.Record, error) {
+	reader, err := s.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var records []arrow.Record
+	for {
+		record, err := reader.Next()
+		if err != nil {
+			for _, r := range records {
+				r.Release()
+			}
+			return nil, err
+		}
+		if record == nil {
+			return records, nil
+		}
+		records = append(records, record)
+	}
+}
+
+// Remove 删除溢写文件
+func (s *spillFile) Remove() {
+	if s.writer != nil {
+		s.writer.Close()
+		s.file.Close()
+		s.writer = nil
+	}
+	os.Remove(s.path)
+}
+
+// spillReader 顺序读取溢写文件
+type spillReader struct {
+	file   *os.File
+	reader *ipc.Reader
+}
+
+// Next 返回下一个 record（已 Retain，调用方负责 Release），读完时返回 nil
+func (r *spillReader) Next() (arrow.Record, error) {
+	if r.reader.Next() {
+		record := r.reader.Record()
+		record.Retain()
+		return record, nil
+	}
+	if err := r.reader.Err(); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read spill file: %w", err)
+	}
+	return nil, nil
+}
+
+// Close 关闭读取器
+func (r *spillReader) Close() error {
+	r.reader.Release()
+	return r.file.Close()
+}
+
+// partitionOf 计算值所属的分区（nil 固定落在分区 0）
+func partitionOf(value interface{}) int {
+	if value == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%v", value)
+	return int(h.Sum32() % spillPartitions)
+}
+
+// partitionOfKey 计算字符串键所属的分区
+func partitionOfKey(key string) int {
+	return hashPartition(key, spillPartitions)
+}
+
+// hashPartition 将字符串键哈希到 [0, n) 中的一个分区
+func hashPartition(key string, n int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
+
+// takeRows 按行号从 record 中取出若干行组成新 record，保留 null
+func takeRows(record arrow.Record, indices []int, pool memory.Allocator) (arrow.Record, error) {
+	builder := array.NewRecordBuilder(pool, record.Schema())
+	defer builder.Release()
+
+	for colIdx := 0; colIdx < int(record.NumCols()); colIdx++ {
+		column := record.Column(colIdx)
+		fieldBuilder := builder.Field(colIdx)
+		for _, i := range indices {
+			if column.IsNull(i) {
+				fieldBuilder.AppendNull()
+				continue
+			}
+			switch col := column.(type) {
+			case *array.Int64:
+				fieldBuilder.(*array.Int64Builder).Append(col.Value(i))
+			case *array.Float64:
+				fieldBuilder.(*array.Float64Builder).Append(col.Value(i))
+			case *array.String:
+				fieldBuilder.(*array.StringBuilder).Append(col.Value(i))
+			case *array.Boolean:
+				fieldBuilder.(*array.BooleanBuilder).Append(col.Value(i))
+			default:
+				if err := fieldBuilder.AppendValueFromString(column.ValueStr(i)); err != nil {
+					return nil, fmt.Errorf("unsupported column type %s: %w", column.DataType(), err)
+				}
+			}
+		}
+	}
+
+	return builder.NewRecord(), nil
+}
+
+// buildRecordFromRows 将 Go 侧物化的行数据重新构建为 Arrow record
+func buildRecordFromRows(schema *arrow.Schema, rows [][]interface{}, pool memory.Allocator) arrow.Record {
+	builder := array.NewRecordBuilder(pool, schema)
+	defer builder.Release()
+
+	for _, row := range rows {
+		for colIdx, value := range row {
+			field := builder.Field(colIdx)
+			switch b := field.(type) {
+			case *array.Int64Builder:
+				if intVal, ok := value.(int64); ok {
+					b.Append(intVal)
+				} else {
+					b.AppendNull()
+				}
+			case *array.StringBuilder:
+				if strVal, ok := value.(string); ok {
+					b.Append(strVal)
+				} else {
+					b.AppendNull()
+				}
+			case *array.Float64Builder:
+				if floatVal, ok := value.(float64); ok {
+					b.Append(floatVal)
+				} else {
+					b.AppendNull()
+				}
+			case *array.BooleanBuilder:
+				if boolVal, ok := value.(bool); ok {
+					b.Append(boolVal)
+				} else {
+					b.AppendNull()
+				}
+			case *array.TimestampBuilder:
+				if tsVal, ok := value.(arrow.Timestamp); ok {
+					b.Append(tsVal)
+				} else {
+					b.AppendNull()
+				}
+			default:
+				field.AppendNull()
+			}
+		}
+	}
+
+	return builder.NewRecord()
+}
+
+// estimateRowSize 估算一行 Go 侧物化数据占用的内存
+func estimateRowSize(row []interface{}) int64 {
+	size := int64(24 + len(row)*boxedValueOverhead)
+	for _, v := range row {
+		switch val := v.(type) {
+		case string:
+			size += int64(len(val))
+		case nil:
+		default:
+			size += 8
+		}
+	}
+	return size
+}
+
+// estimateRecordSize 估算一个 record 在内存中缓存或物化后的大小
+func estimateRecordSize(record arrow.Record) int64 {
+	size := record.NumRows() * record.NumCols() * boxedValueOverhead
+	for _, column := range record.Columns() {
+		for _, buf := range column.Data().Buffers() {
+			if buf != nil {
+				size += int64(buf.Len())
+			}
+		}
+	}
+	return size
+}
+
+// recordsToBatches 将 record 列表包装为 batch 列表
+func recordsToBatches(records []arrow.Record) []*types.Batch {
+	batches := make([]*types.Batch, 0, len(records))
+	for _, record := range records {
+		batches = append(batches, types.NewBatch(record))
+	}
+	return batches
+}
+
+// rowValue 读取列中指定行的值，null 返回 nil
+func rowValue(column arrow.Array, row int) interface{} {
+	if column.IsNull(row) {
+		return nil
+	}
+	switch col := column.(type) {
+	case *array.Int64:
+		return col.Value(row)
+	case *array.String:
+		return col.Value(row)
+	case *array.Float64:
+		return col.Value(row)
+	case *array.Boolean:
+		return col.Value(row)
+	case *array.Timestamp:
+		return col.Value(row)
+	default:
+		return nil
+	}
+}
+
+// rowValues 读取 record 中指定行的所有列值
+func rowValues(record arrow.Record, row int) []interface{} {
+	values := make([]interface{}, record.NumCols())
+	for colIdx := range values {
+		values[colIdx] = rowValue(record.Column(colIdx), row)
+	}
+	return values
+}
+
+// releaseRecords 释放 record 列表
+func releaseRecords(records []arrow.Record) {
+	for _, record := range records {
+		record.Release()
+	}
+}
@@ -0,0 +1,54 @@
+package operators
+
+import (
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// TableFunctionProvider 表函数数据提供者
+type TableFunctionProvider interface {
+	GetTableFunctionData(database string, fn *optimizer.TableFunction) ([]*types.Batch, error)
+}
+
+// TableFunctionScan 表函数扫描算子 (FROM table_changes(...))
+type TableFunctionScan struct {
+	database    string // 当前数据库，用于解析函数参数中未限定的表名
+	function    *optimizer.TableFunction
+	provider    TableFunctionProvider
+	curBatch    int
+	dataBatches []*types.Batch
+}
+
+// NewTableFunctionScan 创建表函数扫描算子
+func NewTableFunctionScan(database string, fn *optimizer.TableFunction, provider TableFunctionProvider) *TableFunctionScan {
+	return &TableFunctionScan{
+		database: database,
+		function: fn,
+		provider: provider,
+	}
+}
+
+// Init 初始化算子，调用表函数生成数据
+func (op *TableFunctionScan) Init(ctx interface{}) error {
+	batches, err := op.provider.GetTableFunctionData(op.database, op.function)
+	if err != nil {
+		return err
+	}
+	op.dataBatches = batches
+	return nil
+}
+
+// Next 获取下一批数据
+func (op *TableFunctionScan) Next() (*types.Batch, error) {
+	if op.curBatch >= len(op.dataBatches) {
+		return nil, nil
+	}
+	batch := op.dataBatches[op.curBatch]
+	op.curBatch++
+	return batch, nil
+}
+
+// Close 关闭算子
+func (op *TableFunctionScan) Close() error {
+	return nil
+}
@@ -1,9 +1,12 @@
 package operators
 
 import (
+	"context"
+
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/memory"
 	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/optimizer"
 	"github.com/yyun543/minidb/internal/types"
 )
 
@@ -13,6 +16,24 @@ type DataProvider interface {
 	GetTableData(dbName, tableName string) ([]*types.Batch, error)
 }
 
+// ParallelDataProvider 支持并行读取的数据提供者（并行读取数据文件和行组）
+type ParallelDataProvider interface {
+	DataProvider
+	GetTableDataWithParallelism(dbName, tableName string, parallelism int) ([]*types.Batch, error)
+}
+
+// PredicateDataProvider 支持按扫描条件跳过分区的数据提供者
+type PredicateDataProvider interface {
+	DataProvider
+	GetTableDataWithPredicate(dbName, tableName string, parallelism int, predicate optimizer.Expression) ([]*types.Batch, error)
+}
+
+// ContextDataProvider 按查询的 context 读取表数据的数据提供者，查询取消后停止扫描
+type ContextDataProvider interface {
+	DataProvider
+	GetTableDataContext(ctx context.Context, dbName, tableName string, parallelism int, predicate optimizer.Expression) ([]*types.Batch, error)
+}
+
 // TableScan 表扫描算子 (v2.0)
 // 使用 DataProvider 统一获取系统表和普通表数据
 type TableScan struct {
@@ -20,6 +41,8 @@ type TableScan struct {
 	table        string
 	catalog      *catalog.Catalog
 	dataProvider DataProvider
+	predicate    optimizer.Expression
+	queryCtx     context.Context
 	schema       *arrow.Schema
 	pool         *memory.GoAllocator
 	batchSize    int
@@ -34,11 +57,17 @@ func NewTableScan(database, table string, catalog *catalog.Catalog, dataProvider
 		table:        table,
 		catalog:      catalog,
 		dataProvider: dataProvider,
+		queryCtx:     context.Background(),
 		pool:         memory.NewGoAllocator(),
 		batchSize:    1024,
 	}
 }
 
+// SetPredicate 设置扫描条件，用于分区裁剪（不做行级过滤）
+func (op *TableScan) SetPredicate(predicate optimizer.Expression) {
+	op.predicate = predicate
+}
+
 // Init 初始化算子 (v2.0)
 func (op *TableScan) Init(ctx interface{}) error {
 	// 获取表结构
@@ -52,7 +81,8 @@ func (op *TableScan) Init(ctx interface{}) error {
 
 	// 从 DataProvider 读取数据 (统一处理系统表和普通表)
 	if ctx != nil {
-		batches, err := op.getTableData()
+		op.queryCtx = queryContextFrom(ctx)
+		batches, err := op.getTableData(parallelismFromContext(ctx))
 		if err != nil {
 			return err
 		}
@@ -64,6 +94,10 @@ func (op *TableScan) Init(ctx interface{}) error {
 
 // Next 获取下一批数据
 func (op *TableScan) Next() (*types.Batch, error) {
+	if err := canceled(op.queryCtx); err != nil {
+		return nil, err
+	}
+
 	// 检查是否还有数据批次要返回
 	if op.curBatch >= len(op.dataBatches) {
 		return nil, nil // 表示没有更多数据
@@ -83,12 +117,45 @@ func (op *TableScan) Close() error {
 
 // getTableData 从 DataProvider 获取表数据 (v2.0)
 // 统一处理系统表和普通表，不再区分
-func (op *TableScan) getTableData() ([]*types.Batch, error) {
+func (op *TableScan) getTableData(parallelism int) ([]*types.Batch, error) {
 	if op.dataProvider == nil {
 		// 如果没有 DataProvider，返回空结果
 		return []*types.Batch{}, nil
 	}
 
+	// 扫描随查询的 context 取消，有扫描条件时同时裁剪分区
+	if cdp, ok := op.dataProvider.(ContextDataProvider); ok {
+		batches, err := cdp.GetTableDataContext(op.queryCtx, op.database, op.table, parallelism, op.predicate)
+		if err != nil {
+			return nil, err
+		}
+		if parallelism > 1 {
+			return SplitIntoMorsels(batches), nil
+		}
+		return batches, nil
+	}
+
+	// 有扫描条件时交给 DataProvider 裁剪分区
+	if pdp, ok := op.dataProvider.(PredicateDataProvider); ok && op.predicate != nil {
+		batches, err := pdp.GetTableDataWithPredicate(op.database, op.table, parallelism, op.predicate)
+		if err != nil {
+			return nil, err
+		}
+		if parallelism > 1 {
+			return SplitIntoMorsels(batches), nil
+		}
+		return batches, nil
+	}
+
+	// 并行度大于 1 时并行读取，并切分为 morsel 供下游算子并行处理
+	if pdp, ok := op.dataProvider.(ParallelDataProvider); ok && parallelism > 1 {
+		batches, err := pdp.GetTableDataWithParallelism(op.database, op.table, parallelism)
+		if err != nil {
+			return nil, err
+		}
+		return SplitIntoMorsels(batches), nil
+	}
+
 	// 使用 DataProvider.GetTableData() 获取表数据
 	// DataProvider 内部会判断是系统表还是普通表，并采用相应的方式获取数据
 	batches, err := op.dataProvider.GetTableData(op.database, op.table)
@@ -0,0 +1,19 @@
+package executor
+
+import (
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// queryParallelism 计算查询的并行度：会话变量 max_parallelism 优先于执行器配置
+func queryParallelism(config *OptimizerConfig, sess *session.Session) int {
+	dop := 1
+	if config != nil && config.EnableParallelScan && config.MaxParallelism > 0 {
+		dop = config.MaxParallelism
+	}
+	if sess != nil {
+		if v, ok := sess.Variables[MaxParallelismVariable].(int); ok && v > 0 {
+			dop = v
+		}
+	}
+	return dop
+}
@@ -0,0 +1,157 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"go.uber.org/zap"
+)
+
+// executePrepare 执行 PREPARE：生成语句的计划 (放入会话的计划缓存) 并保存预处理语句
+func (e *ExecutorImpl) executePrepare(ctx context.Context, plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.PrepareProperties)
+	if _, exists := sess.PreparedStatement(props.Name); exists {
+		return nil, fmt.Errorf("prepared statement \"%s\" already exists", props.Name)
+	}
+
+	stmt := &parser.PrepareStmt{
+		Name:       props.Name,
+		ParamTypes: props.ParamTypes,
+		Statement:  props.Statement,
+		Query:      props.Query,
+	}
+	// 提前生成计划，语句无法规划时 PREPARE 即报错
+	if _, err := e.preparedPlan(ctx, stmt, sess); err != nil {
+		return nil, err
+	}
+	sess.AddPreparedStatement(stmt)
+
+	logger.WithComponent("executor").Info("Prepared statement created",
+		zap.String("name", props.Name),
+		zap.Int("parameters", len(props.ParamTypes)),
+		zap.Int64("session_id", sess.ID))
+	return statusResultSet(), nil
+}
+
+// executeExecuteStatement 执行 EXECUTE：取出缓存的计划，绑定参数后按普通语句执行 (包括权限检查)
+func (e *ExecutorImpl) executeExecuteStatement(ctx context.Context, plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.ExecuteProperties)
+	stmt, ok := sess.PreparedStatement(props.Name)
+	if !ok {
+		return nil, fmt.Errorf("prepared statement \"%s\" does not exist", props.Name)
+	}
+
+	cached, err := e.preparedPlan(ctx, stmt, sess)
+	if err != nil {
+		return nil, err
+	}
+	bound, err := optimizer.BindParameters(cached, stmt.ParamTypes, props.Args)
+	if err != nil {
+		return nil, fmt.Errorf("prepared statement \"%s\": %v", props.Name, err)
+	}
+	return e.ExecuteContext(ctx, bound, sess)
+}
+
+// executeDeallocate 执行 DEALLOCATE：删除会话中的预处理语句，缓存的计划按 LRU 淘汰
+func (e *ExecutorImpl) executeDeallocate(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.DeallocateProperties)
+	if props.All {
+		sess.ClearPreparedStatements()
+	} else if !sess.RemovePreparedStatement(props.Name) {
+		return nil, fmt.Errorf("prepared statement \"%s\" does not exist", props.Name)
+	}
+
+	logger.WithComponent("executor").Info("Prepared statement deallocated",
+		zap.String("name", props.Name),
+		zap.Bool("all", props.All),
+		zap.Int64("session_id", sess.ID))
+	return statusResultSet(), nil
+}
+
+// preparedPlan 返回预处理语句的计划 (含参数占位符)
+// 命中会话计划缓存且引用表的 schema 版本未变时直接复用，否则重新优化并放入缓存
+func (e *ExecutorImpl) preparedPlan(ctx context.Context, stmt *parser.PrepareStmt, sess *session.Session) (*optimizer.Plan, error) {
+	cache := sess.PlanCache()
+	key := optimizer.PlanCacheKey(sess.CurrentDB, stmt.ParamTypes, stmt.Query)
+	if plan, ok := cache.Get(key, func(table string) int64 {
+		return e.schemaVersion(sess, table)
+	}); ok {
+		return plan, nil
+	}
+
+	plan, err := optimizer.NewOptimizer().OptimizeContext(ctx, stmt.Statement)
+	if err != nil {
+		return nil, err
+	}
+	e.cachePlan(sess, key, plan)
+	return plan, nil
+}
+
+// CachedQueryPlan 返回普通语句在会话计划缓存中的计划副本，命中时调用方可以跳过解析和优化
+// 只查找可能被 CacheQueryPlan 缓存的语句，其他语句不计入缓存的命中率
+func (e *ExecutorImpl) CachedQueryPlan(sess *session.Session, sql string) (*optimizer.Plan, bool) {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 || !cacheableQueries[strings.ToUpper(fields[0])] {
+		return nil, false
+	}
+	plan, ok := sess.PlanCache().Get(optimizer.PlanCacheKey(sess.CurrentDB, nil, sql), func(table string) int64 {
+		return e.schemaVersion(sess, table)
+	})
+	if !ok {
+		return nil, false
+	}
+	// 缓存的计划由会话的后续语句共享，执行前复制
+	bound, err := optimizer.BindParameters(plan, nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	return bound, true
+}
+
+// CacheQueryPlan 缓存普通语句的计划，返回用于本次执行的计划 (缓存时为副本)
+// INSERT 的值随每条语句变化且可能很大，DDL 和其他语句的计划依赖执行时的状态，这些语句不缓存
+func (e *ExecutorImpl) CacheQueryPlan(sess *session.Session, sql string, stmt parser.Node, plan *optimizer.Plan) *optimizer.Plan {
+	switch stmt.(type) {
+	case *parser.SelectStmt, *parser.UpdateStmt, *parser.DeleteStmt:
+	default:
+		return plan
+	}
+	bound, err := optimizer.BindParameters(plan, nil, nil)
+	if err != nil {
+		return plan
+	}
+	e.cachePlan(sess, optimizer.PlanCacheKey(sess.CurrentDB, nil, sql), plan)
+	return bound
+}
+
+// cacheableQueries CacheQueryPlan 缓存的语句的首个关键字
+var cacheableQueries = map[string]bool{"SELECT": true, "UPDATE": true, "DELETE": true}
+
+// cachePlan 把计划放入会话的计划缓存，并记录各引用表当前的 schema 版本
+func (e *ExecutorImpl) cachePlan(sess *session.Session, key string, plan *optimizer.Plan) {
+	versions := make(map[string]int64)
+	for _, table := range optimizer.ReferencedTables(plan) {
+		db, tbl := ResolveTableName(sess, table)
+		versions[db+"."+tbl] = e.schemaVersion(sess, db+"."+tbl)
+	}
+	sess.PlanCache().Put(key, plan, versions)
+}
+
+// schemaVersion 返回表当前的 schema 版本，表不存在或存储引擎不记录版本时返回 -1
+func (e *ExecutorImpl) schemaVersion(sess *session.Session, table string) int64 {
+	pe, ok := e.dataManager.storageEngine.(*storage.ParquetEngine)
+	if !ok {
+		return -1
+	}
+	db, tbl := ResolveTableName(sess, table)
+	if version, ok := pe.SchemaVersion(db, tbl); ok {
+		return version
+	}
+	return -1
+}
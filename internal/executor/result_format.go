@@ -0,0 +1,171 @@
+package executor
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+)
+
+// timestampLayout 带时区的 TIMESTAMP 按会话时区显示，并带上 UTC 偏移
+const timestampLayout = "2006-01-02 15:04:05.999999-07:00"
+
+// FormatValue 返回 Arrow 列中一行的显示值，NULL 返回 nil
+// 带时区的 TIMESTAMP 转换到 loc 显示；不带时区的 TIMESTAMP 是本地时间 (wall clock)，原样显示
+func FormatValue(column arrow.Array, row int, loc *time.Location) interface{} {
+	if column.IsNull(row) {
+		return nil
+	}
+
+	switch col := column.(type) {
+	case *array.Int64:
+		return col.Value(row)
+	case *array.Int32:
+		return col.Value(row)
+	case *array.Float64:
+		return col.Value(row)
+	case *array.Float32:
+		return col.Value(row)
+	case *array.String:
+		return col.Value(row)
+	case *array.Boolean:
+		return col.Value(row)
+	case *array.Timestamp:
+		dt := col.DataType().(*arrow.TimestampType)
+		t := col.Value(row).ToTime(dt.Unit)
+		if dt.TimeZone == "" {
+			return t.Format("2006-01-02 15:04:05.999999")
+		}
+		return t.In(loc).Format(timestampLayout)
+	case *array.Date32:
+		return col.Value(row).ToTime().Format("2006-01-02")
+	case *array.Date64:
+		return col.Value(row).ToTime().Format("2006-01-02")
+	default:
+		return column.ValueStr(row)
+	}
+}
+
+// FormatResult 按 result_format 格式化查询结果
+//
+//   - table: 对齐的表格和行数，没有行时为 "Empty set"
+//   - csv: 首行为列名，NULL 为空字段
+//   - json: 每行一个对象 (按列的顺序) 的数组，NULL 为 null
+func FormatResult(headers []string, records []arrow.Record, format string, loc *time.Location) string {
+	switch format {
+	case ResultFormatCSV:
+		return formatCSV(headers, records, loc)
+	case ResultFormatJSON:
+		return formatJSON(headers, records, loc)
+	default:
+		return formatTable(headers, records, loc)
+	}
+}
+
+// formatTable 以表格形式格式化结果
+func formatTable(headers []string, records []arrow.Record, loc *time.Location) string {
+	var sb strings.Builder
+
+	// 写入列名
+	sb.WriteString("|")
+	for _, header := range headers {
+		sb.WriteString(fmt.Sprintf(" %-15s |", header))
+	}
+	sb.WriteString("\n")
+
+	// 写入分隔线
+	sb.WriteString("+")
+	for range headers {
+		sb.WriteString("-----------------+")
+	}
+	sb.WriteString("\n")
+
+	// 写入数据行
+	rowCount := 0
+	for _, record := range records {
+		for i := 0; i < int(record.NumRows()); i++ {
+			sb.WriteString("|")
+			for j := 0; j < int(record.NumCols()); j++ {
+				value := FormatValue(record.Column(j), i, loc)
+				if value == nil {
+					value = "NULL"
+				}
+				sb.WriteString(fmt.Sprintf(" %-15v |", value))
+			}
+			sb.WriteString("\n")
+			rowCount++
+		}
+	}
+
+	if rowCount == 0 {
+		return "Empty set"
+	}
+	sb.WriteString(fmt.Sprintf("%d rows in set\n", rowCount))
+	return sb.String()
+}
+
+// formatCSV 以 CSV 格式化结果
+func formatCSV(headers []string, records []arrow.Record, loc *time.Location) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(headers)
+	for _, record := range records {
+		fields := make([]string, record.NumCols())
+		for i := 0; i < int(record.NumRows()); i++ {
+			for j := range fields {
+				fields[j] = ""
+				if value := FormatValue(record.Column(j), i, loc); value != nil {
+					fields[j] = fmt.Sprint(value)
+				}
+			}
+			_ = w.Write(fields)
+		}
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// formatJSON 以 JSON 数组格式化结果，对象的键保持列的顺序
+func formatJSON(headers []string, records []arrow.Record, loc *time.Location) string {
+	keys := make([][]byte, len(headers))
+	for i, header := range headers {
+		keys[i], _ = json.Marshal(header)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("[")
+	rows := 0
+	for _, record := range records {
+		for i := 0; i < int(record.NumRows()); i++ {
+			if rows > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n  {")
+			for j := 0; j < int(record.NumCols()) && j < len(keys); j++ {
+				if j > 0 {
+					buf.WriteString(", ")
+				}
+				buf.Write(keys[j])
+				buf.WriteString(": ")
+				value, err := json.Marshal(FormatValue(record.Column(j), i, loc))
+				if err != nil {
+					// NaN、Inf 等 JSON 无法表示的值以字符串输出
+					value, _ = json.Marshal(fmt.Sprint(FormatValue(record.Column(j), i, loc)))
+				}
+				buf.Write(value)
+			}
+			buf.WriteString("}")
+			rows++
+		}
+	}
+	if rows > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("]\n")
+	return buf.String()
+}
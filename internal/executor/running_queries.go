@@ -0,0 +1,335 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// 语句被取消的原因，作为 context 的 cause 返回给客户端
+var (
+	ErrQueryCanceled      = errors.New("canceling statement due to user request")
+	ErrStatementTimeout   = errors.New("canceling statement due to statement timeout")
+	ErrSessionTerminated  = errors.New("terminating session due to administrator command")
+	ErrClientDisconnected = errors.New("canceling statement because the client connection was closed")
+)
+
+// StatementTimeoutVariable 语句超时的会话变量，0 表示不限制
+const StatementTimeoutVariable = "statement_timeout"
+
+// RunningQuery 正在执行的语句，对应 sys.running_queries 的一行
+type RunningQuery struct {
+	ID        int64
+	SessionID int64
+	User      string
+	SQL       string
+	StartedAt time.Time
+
+	rows   atomic.Int64
+	cancel context.CancelCauseFunc
+}
+
+// RowsProduced 已经产生的结果行数
+func (q *RunningQuery) RowsProduced() int64 {
+	return q.rows.Load()
+}
+
+// addRows 累加产生的结果行数
+func (q *RunningQuery) addRows(n int64) {
+	q.rows.Add(n)
+}
+
+// Cancel 以 cause 取消语句
+func (q *RunningQuery) Cancel(cause error) {
+	q.cancel(cause)
+}
+
+// runningQueryKey context 中当前语句的键
+type runningQueryKey struct{}
+
+// runningQueryFrom 返回 context 登记的语句，未登记时为 nil
+func runningQueryFrom(ctx context.Context) *RunningQuery {
+	q, _ := ctx.Value(runningQueryKey{}).(*RunningQuery)
+	return q
+}
+
+// sessionEntry 连接登记的会话
+type sessionEntry struct {
+	user   string
+	cancel context.CancelCauseFunc
+}
+
+// QueryRegistry 正在执行的语句和在线会话的登记表
+// 连接在执行每条语句前 Begin，KILL QUERY 取消单条语句，KILL SESSION 取消会话的连接
+type QueryRegistry struct {
+	mu       sync.Mutex
+	nextID   int64
+	queries  map[int64]*RunningQuery
+	sessions map[int64]*sessionEntry
+}
+
+// NewQueryRegistry 创建登记表
+func NewQueryRegistry() *QueryRegistry {
+	return &QueryRegistry{
+		queries:  make(map[int64]*RunningQuery),
+		sessions: make(map[int64]*sessionEntry),
+	}
+}
+
+// Begin 登记开始执行的语句，返回语句的 context 和结束登记的函数
+// 返回的 context 在 parent 取消、KILL 或超过会话的 statement_timeout 时取消
+func (r *QueryRegistry) Begin(parent context.Context, sess *session.Session, sql string) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(parent)
+	ctx, cancelTimeout := withStatementTimeout(ctx, sess)
+
+	r.mu.Lock()
+	r.nextID++
+	q := &RunningQuery{
+		ID:        r.nextID,
+		SessionID: sess.ID,
+		User:      sess.User,
+		SQL:       parser.RedactPasswords(sql),
+		StartedAt: time.Now(),
+		cancel:    cancel,
+	}
+	r.queries[q.ID] = q
+	r.mu.Unlock()
+
+	finish := func() {
+		r.mu.Lock()
+		delete(r.queries, q.ID)
+		r.mu.Unlock()
+		cancelTimeout()
+		cancel(nil)
+	}
+	return context.WithValue(ctx, runningQueryKey{}, q), finish
+}
+
+// RegisterSession 登记连接的会话，KILL SESSION 时以 ErrSessionTerminated 调用 cancel；返回注销函数
+func (r *QueryRegistry) RegisterSession(sess *session.Session, cancel context.CancelCauseFunc) func() {
+	r.mu.Lock()
+	r.sessions[sess.ID] = &sessionEntry{user: sess.User, cancel: cancel}
+	r.mu.Unlock()
+	return func() {
+		r.mu.Lock()
+		delete(r.sessions, sess.ID)
+		r.mu.Unlock()
+	}
+}
+
+// SessionCount 返回登记的在线会话数
+func (r *QueryRegistry) SessionCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.sessions)
+}
+
+// Running 返回正在执行的语句 (按开始顺序)
+func (r *QueryRegistry) Running() []*RunningQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queries := make([]*RunningQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		queries = append(queries, q)
+	}
+	sort.Slice(queries, func(i, j int) bool { return queries[i].ID < queries[j].ID })
+	return queries
+}
+
+// Query 返回正在执行的语句
+func (r *QueryRegistry) Query(id int64) (*RunningQuery, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queries[id]
+	return q, ok
+}
+
+// SessionUser 返回会话的登录用户，会话既未登记连接也没有正在执行的语句时返回 false
+func (r *QueryRegistry) SessionUser(sessionID int64) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if entry, ok := r.sessions[sessionID]; ok {
+		return entry.user, true
+	}
+	for _, q := range r.queries {
+		if q.SessionID == sessionID {
+			return q.User, true
+		}
+	}
+	return "", false
+}
+
+// KillQuery 取消正在执行的语句
+func (r *QueryRegistry) KillQuery(id int64) error {
+	q, ok := r.Query(id)
+	if !ok {
+		return fmt.Errorf("query %d is not running", id)
+	}
+	q.Cancel(ErrQueryCanceled)
+	return nil
+}
+
+// KillSession 取消会话正在执行的语句并关闭会话的连接
+func (r *QueryRegistry) KillSession(sessionID int64) error {
+	r.mu.Lock()
+	entry, found := r.sessions[sessionID]
+	var queries []*RunningQuery
+	for _, q := range r.queries {
+		if q.SessionID == sessionID {
+			queries = append(queries, q)
+		}
+	}
+	r.mu.Unlock()
+
+	if !found && len(queries) == 0 {
+		return fmt.Errorf("session %d not found", sessionID)
+	}
+	for _, q := range queries {
+		q.Cancel(ErrSessionTerminated)
+	}
+	if found {
+		entry.cancel(ErrSessionTerminated)
+	}
+	return nil
+}
+
+// StatementTimeout 返回会话的语句超时，未设置时为 0
+func StatementTimeout(sess *session.Session) time.Duration {
+	if sess == nil {
+		return 0
+	}
+	timeout, _ := sess.Variables[StatementTimeoutVariable].(time.Duration)
+	return timeout
+}
+
+// withStatementTimeout 按会话的 statement_timeout 为 ctx 设置超时
+func withStatementTimeout(ctx context.Context, sess *session.Session) (context.Context, context.CancelFunc) {
+	if timeout := StatementTimeout(sess); timeout > 0 {
+		return context.WithTimeoutCause(ctx, timeout, ErrStatementTimeout)
+	}
+	return ctx, func() {}
+}
+
+// sessionUserKey context 中执行语句的会话用户的键
+type sessionUserKey struct{}
+
+// sessionUserFrom 返回 ctx 记录的执行语句的用户，未记录时为空
+func sessionUserFrom(ctx context.Context) string {
+	user, _ := ctx.Value(sessionUserKey{}).(string)
+	return user
+}
+
+// statementContext 在 ctx 中记录会话时区和用户，并为没有通过 Begin 登记的语句 (直接调用执行器) 应用 statement_timeout
+func statementContext(ctx context.Context, sess *session.Session) (context.Context, context.CancelFunc) {
+	ctx = withTimezone(ctx, Timezone(sess))
+	if sess != nil {
+		ctx = context.WithValue(ctx, sessionUserKey{}, sess.User)
+	}
+	if runningQueryFrom(ctx) != nil {
+		return ctx, func() {}
+	}
+	return withStatementTimeout(ctx, sess)
+}
+
+// canceledError 语句被取消时返回取消原因，否则原样返回 err
+func canceledError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return err
+}
+
+// RunningQueries 返回正在执行的语句登记表
+func (dm *DataManager) RunningQueries() *QueryRegistry {
+	return dm.queries
+}
+
+// RunningQueries 返回正在执行的语句登记表
+func (e *ExecutorImpl) RunningQueries() *QueryRegistry {
+	return e.dataManager.queries
+}
+
+// getRunningQueriesData 获取running_queries系统表数据
+// 启用认证时普通用户只能看到自己的语句，语句中的密码已在登记时隐藏
+func (dm *DataManager) getRunningQueriesData(ctx context.Context) ([]*types.Batch, error) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), catalog.RunningQueriesSchema)
+	defer builder.Release()
+
+	loc := timezoneFrom(ctx)
+	viewer := sessionUserFrom(ctx)
+	now := time.Now()
+	for _, q := range dm.queries.Running() {
+		if !dm.canAccessQueriesOf(viewer, q.User) {
+			continue
+		}
+		builder.Field(0).(*array.Int64Builder).Append(q.ID)
+		builder.Field(1).(*array.Int64Builder).Append(q.SessionID)
+		if q.User == "" {
+			builder.Field(2).AppendNull()
+		} else {
+			builder.Field(2).(*array.StringBuilder).Append(q.User)
+		}
+		builder.Field(3).(*array.StringBuilder).Append(q.SQL)
+		builder.Field(4).(*array.StringBuilder).Append(formatTime(q.StartedAt, loc))
+		builder.Field(5).(*array.Int64Builder).Append(now.Sub(q.StartedAt).Milliseconds())
+		builder.Field(6).(*array.Int64Builder).Append(q.RowsProduced())
+	}
+	return newRecordBatches(builder)
+}
+
+// executeKill 执行 KILL QUERY / KILL SESSION
+// 启用认证时普通用户只能取消自己的语句和会话
+func (e *ExecutorImpl) executeKill(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.KillProperties)
+	registry := e.dataManager.queries
+
+	owner, found := "", false
+	if props.Session {
+		owner, found = registry.SessionUser(props.ID)
+	} else if q, ok := registry.Query(props.ID); ok {
+		owner, found = q.User, true
+	}
+	if found {
+		if err := e.dataManager.checkKillPrivilege(sess, owner); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if props.Session {
+		err = registry.KillSession(props.ID)
+	} else {
+		err = registry.KillQuery(props.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithComponent("executor").Info("Killed running statement",
+		zap.Bool("session", props.Session),
+		zap.Int64("id", props.ID),
+		zap.Int64("session_id", sess.ID))
+	return statusResultSet(), nil
+}
+
+// canceled 语句已取消时返回取消原因
+func canceled(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return nil
+}
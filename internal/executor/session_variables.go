@@ -0,0 +1,502 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// 会话变量名 (SET / SHOW / RESET)，statement_timeout 见 StatementTimeoutVariable
+const (
+	MaxParallelismVariable      = "max_parallelism"
+	VectorizedExecutionVariable = "vectorized_execution"
+	SearchPathVariable          = "search_path"
+	TimezoneVariable            = "timezone"
+	WorkMemVariable             = "work_mem"
+	DefaultCompressionVariable  = "default_compression"
+	ResultFormatVariable        = "result_format"
+)
+
+// vectorized_execution 的取值
+const (
+	VectorizedAuto = "auto" // 由服务器的默认设置决定
+	VectorizedOn   = "on"   // 计划支持向量化时使用向量化执行器
+	VectorizedOff  = "off"  // 总是使用常规执行器
+)
+
+// result_format 的取值
+const (
+	ResultFormatTable = "table"
+	ResultFormatCSV   = "csv"
+	ResultFormatJSON  = "json"
+	ResultFormatArrow = "arrow" // 一行 base64 编码的 Arrow IPC 流，供驱动程序读取类型化的结果
+)
+
+// sessionVariable 会话变量的定义
+type sessionVariable struct {
+	description string
+	// parse 校验并规范化 SET 的值，返回保存在 Session.Variables 中的值
+	parse func(value interface{}) (interface{}, error)
+	// format 返回 SHOW 显示的值
+	format func(value interface{}) string
+	// defaultValue 返回未设置时的值
+	defaultValue func(e *ExecutorImpl, sess *session.Session) interface{}
+	// store 非空时由变量自行保存值而不写入 Session.Variables，value 为 nil 表示恢复默认值
+	store func(e *ExecutorImpl, sess *session.Session, value interface{}) error
+	// load 非空时由变量自行读取当前值
+	load func(sess *session.Session) interface{}
+}
+
+// sessionVariables 支持的会话变量，未列出的变量名在 SET / SHOW / RESET 时报错
+var sessionVariables = map[string]*sessionVariable{
+	MaxParallelismVariable: {
+		description:  "Maximum number of parallel workers per query",
+		parse:        parseMaxParallelism,
+		format:       formatSetting,
+		defaultValue: func(e *ExecutorImpl, _ *session.Session) interface{} { return queryParallelism(e.config, nil) },
+	},
+	StatementTimeoutVariable: {
+		description: "Maximum duration of a statement, 0 disables the timeout",
+		parse: func(value interface{}) (interface{}, error) {
+			return parseStatementTimeout(value)
+		},
+		format: func(value interface{}) string {
+			if d, _ := value.(time.Duration); d > 0 {
+				return d.String()
+			}
+			return "0"
+		},
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return time.Duration(0) },
+	},
+	VectorizedExecutionVariable: {
+		description:  "Use the vectorized executor: on, off or auto (server default)",
+		parse:        parseVectorizedExecution,
+		format:       formatSetting,
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return VectorizedAuto },
+	},
+	SearchPathVariable: {
+		description: "Database used to resolve unqualified table names",
+		parse: func(value interface{}) (interface{}, error) {
+			name, ok := value.(string)
+			if !ok || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("search_path must be a database name, got %v", value)
+			}
+			return strings.TrimSpace(name), nil
+		},
+		format:       formatSetting,
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return "default" },
+		store: func(e *ExecutorImpl, sess *session.Session, value interface{}) error {
+			if value == nil {
+				sess.CurrentDB = "default"
+				return nil
+			}
+			database := value.(string)
+			if _, err := e.catalog.GetDatabase(database); err != nil {
+				return fmt.Errorf("database '%s' does not exist", database)
+			}
+			sess.CurrentDB = database
+			return nil
+		},
+		load: func(sess *session.Session) interface{} {
+			if sess.CurrentDB == "" {
+				return "default"
+			}
+			return sess.CurrentDB
+		},
+	},
+	TimezoneVariable: {
+		description: "Time zone for displaying and interpreting timestamps",
+		parse:       parseTimezone,
+		format: func(value interface{}) string {
+			return value.(*time.Location).String()
+		},
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return time.Local },
+	},
+	WorkMemVariable: {
+		description: "Memory budget for sorts, aggregations and joins before spilling to disk",
+		parse:       parseWorkMem,
+		format: func(value interface{}) string {
+			return formatByteSize(value.(int64))
+		},
+		defaultValue: func(e *ExecutorImpl, _ *session.Session) interface{} { return e.config.WorkMemSize },
+	},
+	DefaultCompressionVariable: {
+		description: "Parquet compression codec for tables created without a compression option",
+		parse: func(value interface{}) (interface{}, error) {
+			opts, err := parquet.ParseWriterOptions(map[string]string{parquet.OptionCompression: fmt.Sprint(value)})
+			if err != nil {
+				return nil, err
+			}
+			return opts.Compression, nil
+		},
+		format:       formatSetting,
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return parquet.DefaultCompression },
+	},
+	ResultFormatVariable: {
+		description:  "Format of query results returned to the client: table, csv, json or arrow",
+		parse:        parseResultFormat,
+		format:       formatSetting,
+		defaultValue: func(*ExecutorImpl, *session.Session) interface{} { return ResultFormatTable },
+	},
+}
+
+// lookupSessionVariable 返回会话变量的定义
+func lookupSessionVariable(name string) (*sessionVariable, error) {
+	v, ok := sessionVariables[name]
+	if !ok {
+		return nil, fmt.Errorf("unrecognized configuration parameter \"%s\"", name)
+	}
+	return v, nil
+}
+
+// executeSet 执行SET命令，设置会话变量
+func (e *ExecutorImpl) executeSet(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.SetProperties)
+
+	v, err := lookupSessionVariable(props.Variable)
+	if err != nil {
+		return nil, err
+	}
+	if props.Value == nil {
+		return nil, fmt.Errorf("%s cannot be NULL, use RESET %s to restore the default", props.Variable, props.Variable)
+	}
+	value, err := v.parse(props.Value)
+	if err != nil {
+		return nil, err
+	}
+	if v.store != nil {
+		if err := v.store(e, sess, value); err != nil {
+			return nil, err
+		}
+	} else {
+		if sess.Variables == nil {
+			sess.Variables = make(map[string]interface{})
+		}
+		sess.Variables[props.Variable] = value
+	}
+
+	logger.WithComponent("executor").Info("Session variable set",
+		zap.String("variable", props.Variable),
+		zap.Any("value", value),
+		zap.Int64("session_id", sess.ID))
+	return statusResultSet(), nil
+}
+
+// executeReset 执行RESET命令，把会话变量恢复为默认值
+func (e *ExecutorImpl) executeReset(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.ResetProperties)
+
+	names := []string{props.Variable}
+	if props.All {
+		names = sortedSessionVariables()
+	} else if _, err := lookupSessionVariable(props.Variable); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		v := sessionVariables[name]
+		if v.store != nil {
+			if err := v.store(e, sess, nil); err != nil {
+				return nil, err
+			}
+		} else {
+			delete(sess.Variables, name)
+		}
+	}
+
+	logger.WithComponent("executor").Info("Session variable reset",
+		zap.String("variable", props.Variable),
+		zap.Bool("all", props.All),
+		zap.Int64("session_id", sess.ID))
+	return statusResultSet(), nil
+}
+
+// executeShowVariable 执行 SHOW name / SHOW ALL
+// SHOW name 返回以变量名为列名的一行；SHOW ALL 返回全部变量的 name / setting / description
+func (e *ExecutorImpl) executeShowVariable(plan *optimizer.Plan, sess *session.Session) (*ResultSet, error) {
+	props := plan.Properties.(*optimizer.ShowVariableProperties)
+
+	if !props.All {
+		v, err := lookupSessionVariable(props.Variable)
+		if err != nil {
+			return nil, err
+		}
+		schema := arrow.NewSchema([]arrow.Field{{Name: props.Variable, Type: arrow.BinaryTypes.String}}, nil)
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+		defer builder.Release()
+		builder.Field(0).(*array.StringBuilder).Append(e.sessionVariableSetting(v, sess, props.Variable))
+		return &ResultSet{
+			Headers: []string{props.Variable},
+			rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+			curRow:  -1,
+		}, nil
+	}
+
+	headers := []string{"name", "setting", "description"}
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "setting", Type: arrow.BinaryTypes.String},
+		{Name: "description", Type: arrow.BinaryTypes.String},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	for _, name := range sortedSessionVariables() {
+		v := sessionVariables[name]
+		builder.Field(0).(*array.StringBuilder).Append(name)
+		builder.Field(1).(*array.StringBuilder).Append(e.sessionVariableSetting(v, sess, name))
+		builder.Field(2).(*array.StringBuilder).Append(v.description)
+	}
+	return &ResultSet{
+		Headers: headers,
+		rows:    []*types.Batch{types.NewBatch(builder.NewRecord())},
+		curRow:  -1,
+	}, nil
+}
+
+// sessionVariableSetting 返回变量当前值的显示形式，未设置时显示默认值
+func (e *ExecutorImpl) sessionVariableSetting(v *sessionVariable, sess *session.Session, name string) string {
+	if v.load != nil {
+		return v.format(v.load(sess))
+	}
+	if value, ok := sess.Variables[name]; ok {
+		return v.format(value)
+	}
+	return v.format(v.defaultValue(e, sess))
+}
+
+// sortedSessionVariables 按名称排序的会话变量名
+func sortedSessionVariables() []string {
+	names := make([]string, 0, len(sessionVariables))
+	for name := range sessionVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VectorizedExecution 返回会话的 vectorized_execution 设置，未设置时为 auto
+func VectorizedExecution(sess *session.Session) string {
+	if sess != nil {
+		if mode, ok := sess.Variables[VectorizedExecutionVariable].(string); ok {
+			return mode
+		}
+	}
+	return VectorizedAuto
+}
+
+// ResultFormat 返回会话的 result_format 设置，未设置时为 table
+func ResultFormat(sess *session.Session) string {
+	if sess != nil {
+		if format, ok := sess.Variables[ResultFormatVariable].(string); ok {
+			return format
+		}
+	}
+	return ResultFormatTable
+}
+
+// Timezone 返回会话的时区，未设置时为服务器本地时区
+func Timezone(sess *session.Session) *time.Location {
+	if sess != nil {
+		if loc, ok := sess.Variables[TimezoneVariable].(*time.Location); ok {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// workMem 返回本查询的内存预算：会话变量 work_mem 优先于执行器配置
+func (e *ExecutorImpl) workMem(sess *session.Session) int64 {
+	if sess != nil {
+		if size, ok := sess.Variables[WorkMemVariable].(int64); ok {
+			return size
+		}
+	}
+	return e.config.WorkMemSize
+}
+
+// defaultCompression 返回会话的 default_compression 设置，未设置时为空 (使用 Parquet 写入的默认编码)
+func defaultCompression(sess *session.Session) string {
+	codec, _ := sess.Variables[DefaultCompressionVariable].(string)
+	return codec
+}
+
+// timezoneKey context 中会话时区的键
+type timezoneKey struct{}
+
+// withTimezone 在 ctx 中记录会话时区，供系统表等不直接持有会话的代码格式化时间
+func withTimezone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timezoneKey{}, loc)
+}
+
+// timezoneFrom 返回 ctx 记录的会话时区，未记录时为服务器本地时区
+func timezoneFrom(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(timezoneKey{}).(*time.Location); ok {
+		return loc
+	}
+	return time.Local
+}
+
+// formatTime 按时区 loc 格式化系统表和 DESCRIBE 中的时间
+func formatTime(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("2006-01-02 15:04:05")
+}
+
+// parseMaxParallelism 解析 max_parallelism，必须为正整数
+func parseMaxParallelism(value interface{}) (interface{}, error) {
+	var n int64
+	switch v := value.(type) {
+	case int64:
+		n = v
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("max_parallelism must be a positive integer, got '%s'", v)
+		}
+		n = parsed
+	default:
+		return nil, fmt.Errorf("max_parallelism must be a positive integer, got %v", value)
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("max_parallelism must be a positive integer, got %d", n)
+	}
+	return int(n), nil
+}
+
+// parseVectorizedExecution 解析 vectorized_execution，接受 on/off/auto 和布尔值
+func parseVectorizedExecution(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return VectorizedOn, nil
+		}
+		return VectorizedOff, nil
+	case int64:
+		if v == 0 || v == 1 {
+			return parseVectorizedExecution(v == 1)
+		}
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case VectorizedOn, "true":
+			return VectorizedOn, nil
+		case VectorizedOff, "false":
+			return VectorizedOff, nil
+		case VectorizedAuto:
+			return VectorizedAuto, nil
+		}
+	}
+	return nil, fmt.Errorf("vectorized_execution must be on, off or auto, got %v", value)
+}
+
+// parseTimezone 解析 timezone：IANA 时区名 (Asia/Shanghai)、UTC、LOCAL 或 UTC 偏移 (+08:00、-5)
+func parseTimezone(value interface{}) (interface{}, error) {
+	name, ok := value.(string)
+	if !ok {
+		if n, isInt := value.(int64); isInt {
+			name = fmt.Sprintf("%+d", n)
+		} else {
+			return nil, fmt.Errorf("invalid time zone %v", value)
+		}
+	}
+	name = strings.TrimSpace(name)
+	switch strings.ToUpper(name) {
+	case "LOCAL":
+		return time.Local, nil
+	case "UTC", "GMT", "Z":
+		return time.UTC, nil
+	}
+	if loc, ok := parseUTCOffset(name); ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" {
+		return nil, fmt.Errorf("invalid time zone '%s'", name)
+	}
+	return loc, nil
+}
+
+// parseUTCOffset 解析 ±HH[:MM] 形式的 UTC 偏移
+func parseUTCOffset(text string) (*time.Location, bool) {
+	if len(text) < 2 || (text[0] != '+' && text[0] != '-') {
+		return nil, false
+	}
+	hours, minutes := text[1:], "0"
+	if idx := strings.Index(hours, ":"); idx >= 0 {
+		hours, minutes = hours[:idx], hours[idx+1:]
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil || h > 14 {
+		return nil, false
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m >= 60 {
+		return nil, false
+	}
+	offset := h*3600 + m*60
+	if text[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(fmt.Sprintf("%c%02d:%02d", text[0], h, m), offset), true
+}
+
+// parseWorkMem 解析 work_mem：字节数或带单位的大小 (如 '256MB')，至少 64KB
+func parseWorkMem(value interface{}) (interface{}, error) {
+	var size int64
+	switch v := value.(type) {
+	case int64:
+		size = v
+	case string:
+		parsed, err := config.ParseByteSize(v)
+		if err != nil {
+			return nil, fmt.Errorf("work_mem must be a size such as '256MB', got '%s'", v)
+		}
+		size = int64(parsed)
+	default:
+		return nil, fmt.Errorf("work_mem must be a size such as '256MB', got %v", value)
+	}
+	if size < int64(64*config.KiB) {
+		return nil, fmt.Errorf("work_mem must be at least 64KB, got %s", formatByteSize(size))
+	}
+	return size, nil
+}
+
+// formatByteSize 以最大的整数单位显示字节数
+func formatByteSize(size int64) string {
+	for _, unit := range []struct {
+		suffix string
+		size   config.ByteSize
+	}{{"GB", config.GiB}, {"MB", config.MiB}, {"KB", config.KiB}} {
+		if size != 0 && size%int64(unit.size) == 0 {
+			return fmt.Sprintf("%d%s", size/int64(unit.size), unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%dB", size)
+}
+
+// parseResultFormat 解析 result_format
+func parseResultFormat(value interface{}) (interface{}, error) {
+	if s, ok := value.(string); ok {
+		switch format := strings.ToLower(strings.TrimSpace(s)); format {
+		case ResultFormatTable, ResultFormatCSV, ResultFormatJSON, ResultFormatArrow:
+			return format, nil
+		}
+	}
+	return nil, fmt.Errorf("result_format must be table, csv, json or arrow, got %v", value)
+}
+
+// formatSetting 默认的显示形式
+func formatSetting(value interface{}) string {
+	return fmt.Sprint(value)
+}
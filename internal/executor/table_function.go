@@ -0,0 +1,104 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// tableChangesArgs table_changes('db.t', start[, end]) 的参数
+type tableChangesArgs struct {
+	db           string
+	table        string
+	startVersion int64
+	endVersion   int64 // -1 表示到最新版本
+}
+
+// parseTableChangesArgs 解析 table_changes 的参数，未限定数据库的表名使用当前数据库
+func parseTableChangesArgs(currentDB string, fn *optimizer.TableFunction) (*tableChangesArgs, error) {
+	if len(fn.Args) < 2 || len(fn.Args) > 3 {
+		return nil, fmt.Errorf("table_changes expects (table, start_version[, end_version]), got %d arguments", len(fn.Args))
+	}
+	name, ok := fn.Args[0].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("table_changes: table name must be a string")
+	}
+
+	args := &tableChangesArgs{db: currentDB, table: name, endVersion: -1}
+	if args.db == "" {
+		args.db = "default"
+	}
+	if idx := strings.Index(name, "."); idx > 0 {
+		args.db, args.table = name[:idx], name[idx+1:]
+	}
+
+	start, ok := fn.Args[1].(int64)
+	if !ok {
+		return nil, fmt.Errorf("table_changes: start version must be an integer")
+	}
+	args.startVersion = start
+	if len(fn.Args) == 3 {
+		end, ok := fn.Args[2].(int64)
+		if !ok {
+			return nil, fmt.Errorf("table_changes: end version must be an integer")
+		}
+		args.endVersion = end
+	}
+	return args, nil
+}
+
+// TableFunctionSchema 返回表函数结果的结构
+func (dm *DataManager) TableFunctionSchema(currentDB string, fn *optimizer.TableFunction) (*arrow.Schema, error) {
+	switch fn.Name {
+	case parser.TableFunctionTableChanges:
+		args, err := parseTableChangesArgs(currentDB, fn)
+		if err != nil {
+			return nil, err
+		}
+		tableMeta, err := dm.catalog.GetTable(args.db, args.table)
+		if err != nil {
+			return nil, err
+		}
+		return storage.ChangeDataSchema(tableMeta.Schema), nil
+	default:
+		return nil, fmt.Errorf("unknown table function: %s", fn.Name)
+	}
+}
+
+// GetTableFunctionData 执行表函数并返回结果数据
+func (dm *DataManager) GetTableFunctionData(currentDB string, fn *optimizer.TableFunction) ([]*types.Batch, error) {
+	switch fn.Name {
+	case parser.TableFunctionTableChanges:
+		args, err := parseTableChangesArgs(currentDB, fn)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dm.catalog.GetTable(args.db, args.table); err != nil {
+			return nil, err
+		}
+		engine, ok := dm.storageEngine.(*storage.ParquetEngine)
+		if !ok {
+			return nil, fmt.Errorf("storage engine does not support change data feed")
+		}
+
+		dm.mu.RLock()
+		defer dm.mu.RUnlock()
+		records, err := engine.TableChanges(context.Background(), args.db, args.table, args.startVersion, args.endVersion)
+		if err != nil {
+			return nil, err
+		}
+		batches := make([]*types.Batch, 0, len(records))
+		for _, rec := range records {
+			batches = append(batches, types.NewBatch(rec))
+		}
+		return batches, nil
+	default:
+		return nil, fmt.Errorf("unknown table function: %s", fn.Name)
+	}
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor/operators"
 	"github.com/yyun543/minidb/internal/optimizer"
 	"github.com/yyun543/minidb/internal/session"
 	"github.com/yyun543/minidb/internal/statistics"
+	"github.com/yyun543/minidb/internal/storage"
 	"github.com/yyun543/minidb/internal/types"
 )
 
@@ -41,9 +43,33 @@ func NewVectorizedExecutorWithDataManager(cat *catalog.Catalog, dm *DataManager,
 	}
 }
 
-// Execute 执行查询计划（向量化版本）
+// Execute 检查会话用户的权限后执行查询计划（向量化版本）
 func (ve *VectorizedExecutor) Execute(plan *optimizer.Plan, sess *session.Session) (*VectorizedResultSet, error) {
-	ctx := context.Background()
+	return ve.ExecuteContext(context.Background(), plan, sess)
+}
+
+// ExecuteContext 检查会话用户的权限后执行查询计划（向量化版本）
+// ctx 取消或超过会话的 statement_timeout 时停止执行，返回取消原因
+func (ve *VectorizedExecutor) ExecuteContext(ctx context.Context, plan *optimizer.Plan, sess *session.Session) (*VectorizedResultSet, error) {
+	ctx, cancel := statementContext(ctx, sess)
+	defer cancel()
+	if err := canceled(ctx); err != nil {
+		return nil, err
+	}
+	if err := ve.dataManager.checkPrivileges(plan, sess); err != nil {
+		return nil, err
+	}
+	result, err := ve.execute(ctx, plan, sess)
+	if err != nil {
+		return nil, canceledError(ctx, err)
+	}
+	return result, nil
+}
+
+// execute 执行查询计划（向量化版本）
+func (ve *VectorizedExecutor) execute(queryCtx context.Context, plan *optimizer.Plan, sess *session.Session) (*VectorizedResultSet, error) {
+	// 并行度随 context 传递给表扫描和执行管道
+	ctx := storage.WithScanParallelism(queryCtx, queryParallelism(ve.optimizer.config, sess))
 
 	// 应用基于成本的优化
 	optimizedPlan, err := ve.optimizer.OptimizePlan(plan)
@@ -118,7 +144,7 @@ func (ve *VectorizedExecutor) buildOperationsFromPlan(ctx context.Context, plan
 	switch plan.Type {
 	case optimizer.TableScanPlan:
 		// 表扫描操作
-		op, err := ve.buildTableScanOperation(plan, sess)
+		op, err := ve.buildTableScanOperation(ctx, plan, sess, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -142,8 +168,15 @@ func (ve *VectorizedExecutor) buildOperationsFromPlan(ctx context.Context, plan
 		filterOp := types.NewFilterOperation(predicate)
 		operations = append(operations, filterOp)
 
-		// 递归处理子操作
-		if len(plan.Children) > 0 {
+		// 直接作用于表扫描时，过滤条件同时用于分区裁剪
+		if len(plan.Children) > 0 && plan.Children[0].Type == optimizer.TableScanPlan {
+			scanOp, err := ve.buildTableScanOperation(ctx, plan.Children[0], sess, props.Condition)
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, scanOp)
+		} else if len(plan.Children) > 0 {
+			// 递归处理子操作
 			childOps, err := ve.buildOperationsFromPlan(ctx, plan.Children[0], filterSchema, sess)
 			if err != nil {
 				return nil, err
@@ -205,17 +238,22 @@ func (ve *VectorizedExecutor) buildOperationsFromPlan(ctx context.Context, plan
 	return operations, nil
 }
 
-// buildTableScanOperation 构建表扫描操作
-func (ve *VectorizedExecutor) buildTableScanOperation(plan *optimizer.Plan, sess *session.Session) (types.VectorizedOperation, error) {
+// buildTableScanOperation 构建表扫描操作，predicate 非空时用于分区裁剪
+func (ve *VectorizedExecutor) buildTableScanOperation(ctx context.Context, plan *optimizer.Plan, sess *session.Session, predicate optimizer.Expression) (types.VectorizedOperation, error) {
 	props := plan.Properties.(*optimizer.TableScanProperties)
 
 	// 解析表引用：支持 "database.table" 或 "table" 格式
 	dbName, tableName := ve.parseTableReference(props.Table, sess.CurrentDB)
 
-	batches, err := ve.dataManager.GetTableData(dbName, tableName)
+	// 并行读取数据文件和行组，再切分为 morsel 供执行管道并行处理
+	dop := storage.ScanParallelism(ctx)
+	batches, err := ve.dataManager.GetTableDataContext(ctx, dbName, tableName, dop, predicate)
 	if err != nil {
 		return nil, err
 	}
+	if dop > 1 {
+		batches = operators.SplitIntoMorsels(batches)
+	}
 
 	// 转换为向量化批处理
 	vectorizedBatches := make([]*types.VectorizedBatch, len(batches))
@@ -429,22 +467,36 @@ func (ve *VectorizedExecutor) executePipeline(ctx context.Context, pipeline *Vec
 		op := operations[i]
 
 		if scanOp, ok := op.(*VectorizedTableScanOperation); ok {
-			// 对表扫描的每个批次，按正确顺序应用所有其他操作
-			for _, batch := range scanOp.batches {
-				// 创建需要应用的操作列表（排除当前的TableScan操作）
-				var opsToApply []types.VectorizedOperation
-				// 操作需要按从底向上的顺序应用：Filter -> Project
-				for j := i - 1; j >= 0; j-- {
-					opsToApply = append(opsToApply, operations[j])
-				}
+			// 创建需要应用的操作列表（排除当前的TableScan操作）
+			var opsToApply []types.VectorizedOperation
+			// 操作需要按从底向上的顺序应用：Filter -> Project
+			for j := i - 1; j >= 0; j-- {
+				opsToApply = append(opsToApply, operations[j])
+			}
 
-				// 应用所有操作到这个批次
-				processedBatch, err := ve.applyOperationsToaBatch(ctx, batch, opsToApply)
+			// Filter/Project 是无状态的，各 morsel 可以并行处理；结果按原顺序收集
+			processed := make([]*types.VectorizedBatch, len(scanOp.batches))
+			err := operators.ParallelForEach(len(scanOp.batches), storage.ScanParallelism(ctx), func(idx int) error {
+				if err := canceled(ctx); err != nil {
+					return err
+				}
+				processedBatch, err := ve.applyOperationsToaBatch(ctx, scanOp.batches[idx], opsToApply)
 				if err != nil {
-					return nil, err
+					return err
 				}
+				processed[idx] = processedBatch
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			running := runningQueryFrom(ctx)
+			for _, processedBatch := range processed {
 				if processedBatch != nil {
 					result.Batches = append(result.Batches, processedBatch)
+					if running != nil {
+						running.addRows(processedBatch.NumRows())
+					}
 				}
 			}
 		}
@@ -82,9 +82,11 @@ func InitLogger(config *Config) error {
 		config = DefaultConfig()
 	}
 
-	// Ensure log directory exists
-	if err := os.MkdirAll(config.LogDir, 0755); err != nil {
-		return err
+	// Ensure log directory exists (an empty LogDir disables file logging)
+	if config.LogDir != "" {
+		if err := os.MkdirAll(config.LogDir, 0755); err != nil {
+			return err
+		}
 	}
 
 	// Configure log level
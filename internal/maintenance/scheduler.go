@@ -0,0 +1,325 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// 维护任务类型
+const (
+	JobCompaction = "compaction"
+	JobCheckpoint = "checkpoint"
+	JobVacuum     = "vacuum"
+	JobAnalyze    = "analyze"
+)
+
+// 任务执行状态
+const (
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+)
+
+// TaskFunc 对一张表执行一次维护任务，返回记录到执行历史中的结果说明
+type TaskFunc func(ctx context.Context, db, table string) (string, error)
+
+// JobRecord 一次维护任务的执行记录，对应 sys.maintenance_jobs 的一行
+type JobRecord struct {
+	ID        int64
+	Job       string
+	Database  string
+	Table     string
+	Status    string
+	Attempt   int // 第几次连续尝试，失败后重试时递增，成功后重置
+	StartedAt time.Time
+	Duration  time.Duration
+	Message   string    // 成功时为任务结果，失败时为错误信息
+	NextRunAt time.Time // 该表下一次执行该任务的时间
+}
+
+// Options 调度器配置
+type Options struct {
+	Jitter         float64       // 调度间隔的随机抖动比例 [0, 1)
+	BackoffInitial time.Duration // 第一次失败后的重试等待
+	BackoffMax     time.Duration // 重试等待上限
+	HistorySize    int           // 保留的执行记录数
+	PollInterval   time.Duration // 检查到期任务的间隔，默认 1 秒
+}
+
+// job 注册的维护任务
+type job struct {
+	name     string
+	interval time.Duration
+	task     TaskFunc
+}
+
+// jobState 某张表上某个任务的调度状态
+type jobState struct {
+	nextRun  time.Time
+	failures int
+}
+
+// Scheduler 后台维护任务调度器
+// 每个任务对每张表独立调度：按间隔加随机抖动执行，失败后按指数退避重试，
+// 任务在调度器协程中串行执行，避免多个重写操作同时占用 I/O
+type Scheduler struct {
+	opts   Options
+	tables func() []string // 返回需要维护的表 ("db.table")
+	jobs   []job
+
+	mu      sync.Mutex
+	states  map[string]*jobState // 键为 任务名 + "/" + 表
+	history []JobRecord
+	nextID  int64
+	rand    *rand.Rand
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewScheduler 创建调度器，tables 在每次检查时调用以获取当前需要维护的表
+func NewScheduler(opts Options, tables func() []string) *Scheduler {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	if opts.BackoffInitial <= 0 {
+		opts.BackoffInitial = time.Minute
+	}
+	if opts.BackoffMax < opts.BackoffInitial {
+		opts.BackoffMax = opts.BackoffInitial
+	}
+	if opts.HistorySize <= 0 {
+		opts.HistorySize = 1000
+	}
+	return &Scheduler{
+		opts:   opts,
+		tables: tables,
+		states: make(map[string]*jobState),
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Register 注册一个按 interval 对每张表执行的任务，需在 Start 之前调用
+func (s *Scheduler) Register(name string, interval time.Duration, task TaskFunc) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, task: task})
+}
+
+// Jobs 返回已注册的任务名
+func (s *Scheduler) Jobs() []string {
+	names := make([]string, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		names = append(names, j.name)
+	}
+	return names
+}
+
+// Start 启动后台调度协程
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	logger.WithComponent("maintenance").Info("Maintenance scheduler started",
+		zap.Strings("jobs", s.Jobs()),
+		zap.Duration("poll_interval", s.opts.PollInterval))
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.opts.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.RunDue(ctx, now)
+			}
+		}
+	}()
+}
+
+// Stop 停止调度并等待正在执行的任务结束
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+	logger.WithComponent("maintenance").Info("Maintenance scheduler stopped")
+}
+
+// dueTask 一次到期的任务执行
+type dueTask struct {
+	job   job
+	table string
+	state *jobState
+}
+
+// RunDue 执行在 now 之前到期的所有任务，返回执行的任务数
+// 第一次见到的表不立即执行，而是在一个 (带抖动的) 间隔之后执行，使各表的任务错开
+func (s *Scheduler) RunDue(ctx context.Context, now time.Time) int {
+	tables := s.tables()
+	sort.Strings(tables)
+
+	var due []dueTask
+	s.mu.Lock()
+	present := make(map[string]bool)
+	for _, table := range tables {
+		for _, j := range s.jobs {
+			key := j.name + "/" + table
+			present[key] = true
+			state, ok := s.states[key]
+			if !ok {
+				state = &jobState{nextRun: now.Add(s.jittered(j.interval))}
+				s.states[key] = state
+			}
+			if !now.Before(state.nextRun) {
+				due = append(due, dueTask{job: j, table: table, state: state})
+			}
+		}
+	}
+	// 已删除的表不再调度
+	for key := range s.states {
+		if !present[key] {
+			delete(s.states, key)
+		}
+	}
+	s.mu.Unlock()
+
+	// 任务的开始、结束时间以 now 为基准加上实际经过的时间
+	begin := time.Now()
+	clock := func() time.Time { return now.Add(time.Since(begin)) }
+
+	count := 0
+	for _, task := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		s.run(ctx, task, now, clock)
+		count++
+	}
+	return count
+}
+
+// run 执行一次任务并记录结果、安排下一次执行
+// 下一次执行时间从本轮检查时间 now 起算；任务耗时超过等待时间时从任务结束起算
+func (s *Scheduler) run(ctx context.Context, task dueTask, now time.Time, clock func() time.Time) {
+	db, table := splitTableID(task.table)
+	started := clock()
+	message, err := runTask(ctx, task.job.task, db, table)
+	finished := clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := JobRecord{
+		Job:       task.job.name,
+		Database:  db,
+		Table:     table,
+		Attempt:   task.state.failures + 1,
+		StartedAt: started,
+		Duration:  finished.Sub(started),
+		Message:   message,
+	}
+	if err != nil {
+		task.state.failures++
+		task.state.nextRun = nextRunAt(now, finished, s.jittered(s.backoff(task.state.failures)))
+		record.Status = StatusFailed
+		record.Message = err.Error()
+		logger.WithComponent("maintenance").Warn("Maintenance job failed",
+			zap.String("job", task.job.name),
+			zap.String("table", task.table),
+			zap.Int("attempt", record.Attempt),
+			zap.Time("next_run", task.state.nextRun),
+			zap.Error(err))
+	} else {
+		task.state.failures = 0
+		task.state.nextRun = nextRunAt(now, finished, s.jittered(task.job.interval))
+		record.Status = StatusSucceeded
+		logger.WithComponent("maintenance").Info("Maintenance job completed",
+			zap.String("job", task.job.name),
+			zap.String("table", task.table),
+			zap.Duration("duration", record.Duration),
+			zap.String("result", message))
+	}
+	record.NextRunAt = task.state.nextRun
+
+	s.nextID++
+	record.ID = s.nextID
+	s.history = append(s.history, record)
+	if len(s.history) > s.opts.HistorySize {
+		s.history = append([]JobRecord(nil), s.history[len(s.history)-s.opts.HistorySize:]...)
+	}
+}
+
+// nextRunAt 返回从 now 起等待 wait 后的时间，若该时间不晚于任务结束时间则从结束时间起算
+func nextRunAt(now, finished time.Time, wait time.Duration) time.Time {
+	next := now.Add(wait)
+	if !next.After(finished) {
+		next = finished.Add(wait)
+	}
+	return next
+}
+
+// runTask 执行任务，任务中的 panic 作为失败记录，不影响调度器
+func runTask(ctx context.Context, task TaskFunc, db, table string) (message string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return task(ctx, db, table)
+}
+
+// History 返回执行记录 (从旧到新)
+func (s *Scheduler) History() []JobRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]JobRecord(nil), s.history...)
+}
+
+// backoff 第 failures 次连续失败后的重试等待 (调用方持有 s.mu)
+func (s *Scheduler) backoff(failures int) time.Duration {
+	wait := s.opts.BackoffInitial
+	for i := 1; i < failures && wait < s.opts.BackoffMax; i++ {
+		wait *= 2
+	}
+	if wait > s.opts.BackoffMax {
+		wait = s.opts.BackoffMax
+	}
+	return wait
+}
+
+// jittered 在 d 的基础上加减 Jitter 比例内的随机抖动 (调用方持有 s.mu)
+func (s *Scheduler) jittered(d time.Duration) time.Duration {
+	if s.opts.Jitter <= 0 {
+		return d
+	}
+	factor := 1 + s.opts.Jitter*(2*s.rand.Float64()-1)
+	return time.Duration(float64(d) * factor)
+}
+
+// splitTableID 拆分 "db.table"
+func splitTableID(tableID string) (string, string) {
+	if idx := strings.Index(tableID, "."); idx >= 0 {
+		return tableID[:idx], tableID[idx+1:]
+	}
+	return "default", tableID
+}
@@ -0,0 +1,134 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/statistics"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// NewEngineScheduler 按配置创建作用于存储引擎所有普通表的调度器，并注册启用的任务
+// statsMgr 为 nil 时不注册自动 ANALYZE
+func NewEngineScheduler(cfg config.MaintenanceConfig, engine *storage.ParquetEngine, statsMgr *statistics.StatisticsManager) *Scheduler {
+	s := NewScheduler(Options{
+		Jitter:         cfg.Jitter,
+		BackoffInitial: cfg.Backoff.Initial,
+		BackoffMax:     cfg.Backoff.Max,
+		HistorySize:    cfg.HistorySize,
+	}, engine.MaintainedTables)
+
+	if cfg.Compaction.Enabled {
+		s.Register(JobCompaction, cfg.Compaction.Interval, CompactionTask(engine, cfg.Compaction))
+	}
+	if cfg.Checkpoint.Enabled {
+		s.Register(JobCheckpoint, cfg.Checkpoint.Interval, CheckpointTask(engine))
+	}
+	if cfg.Vacuum.Enabled {
+		s.Register(JobVacuum, cfg.Vacuum.Interval, VacuumTask(engine, cfg.Vacuum))
+	}
+	if cfg.AutoAnalyze.Enabled && statsMgr != nil {
+		s.Register(JobAnalyze, cfg.AutoAnalyze.Interval, AnalyzeTask(engine, statsMgr))
+	}
+	return s
+}
+
+// CompactionTask 合并表中的小文件
+func CompactionTask(engine *storage.ParquetEngine, policy config.CompactionPolicy) TaskFunc {
+	compactor := optimizer.NewCompactor(&optimizer.CompactionConfig{
+		TargetFileSize:    int64(policy.TargetFileSize),
+		MinFileSize:       int64(policy.MinFileSize),
+		MaxFilesToCompact: policy.MaxFiles,
+		MinFilesToCompact: policy.MinFiles,
+	})
+	return func(ctx context.Context, db, table string) (string, error) {
+		// 后台任务不属于任何会话，提交的日志条目不记录用户
+		result, err := compactor.Compact(db+"."+table, engine)
+		if err != nil {
+			return "", err
+		}
+		if result.FilesRemoved == 0 {
+			return "no small files to compact", nil
+		}
+		return fmt.Sprintf("compacted %d files into %d", result.FilesRemoved, result.FilesAdded), nil
+	}
+}
+
+// CheckpointTask 为有新日志的表创建 checkpoint
+func CheckpointTask(engine *storage.ParquetEngine) TaskFunc {
+	return func(ctx context.Context, db, table string) (string, error) {
+		version, created, err := engine.CheckpointTable(db, table)
+		if err != nil {
+			return "", err
+		}
+		if !created {
+			return fmt.Sprintf("checkpoint up to date at version %d", version), nil
+		}
+		return fmt.Sprintf("checkpoint created at version %d", version), nil
+	}
+}
+
+// VacuumTask 删除保留期之前移除的数据文件
+func VacuumTask(engine *storage.ParquetEngine, policy config.VacuumPolicy) TaskFunc {
+	return func(ctx context.Context, db, table string) (string, error) {
+		result, err := engine.VacuumTable(db, table, policy.Retention, false)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("deleted %d files (%d bytes)", len(result.Files), result.BytesDeleted), nil
+	}
+}
+
+// AnalyzeTask 扫描表并刷新优化器使用的统计信息
+// 统计信息同时以 "db.table" 和表名登记，优化器按查询中书写的表名查找
+func AnalyzeTask(engine *storage.ParquetEngine, statsMgr *statistics.StatisticsManager) TaskFunc {
+	return func(ctx context.Context, db, table string) (string, error) {
+		arrowSchema, err := engine.GetTableSchema(db, table)
+		if err != nil {
+			return "", err
+		}
+		iter, err := engine.Scan(ctx, db, table, nil)
+		if err != nil {
+			return "", err
+		}
+		defer iter.Close()
+
+		var batches []*types.Batch
+		defer func() {
+			for _, batch := range batches {
+				batch.Release()
+			}
+		}()
+		for iter.Next() {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			batches = append(batches, types.NewBatch(iter.Record()))
+		}
+		if err := iter.Err(); err != nil {
+			return "", err
+		}
+
+		schema := &types.TableSchema{Name: table}
+		for _, field := range arrowSchema.Fields() {
+			schema.Columns = append(schema.Columns, &types.ColumnSchema{
+				Name:     field.Name,
+				Type:     types.FromArrowType(field.Type),
+				Nullable: field.Nullable,
+			})
+		}
+		for _, name := range []string{db + "." + table, table} {
+			if err := statsMgr.UpdateTableStatistics(name, schema, batches); err != nil {
+				return "", err
+			}
+		}
+		stats, err := statsMgr.GetTableStatistics(db + "." + table)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("analyzed %d rows", stats.RowCount), nil
+	}
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// ContentType Prometheus 文本格式的 Content-Type
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Handler 返回输出 registries 中所有指标的 /metrics 处理器
+func Handler(registries ...*Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		for _, reg := range registries {
+			if err := reg.WriteText(&buf); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", ContentType)
+		w.Write(buf.Bytes())
+	})
+}
+
+// HealthCheck 健康检查项，Check 返回 nil 表示正常
+type HealthCheck struct {
+	Name  string
+	Check func() error
+}
+
+// HealthStatus /healthz 的响应
+type HealthStatus struct {
+	Status string            `json:"status"` // ok 或 unavailable
+	Checks map[string]string `json:"checks"` // 检查项名称 -> ok 或错误信息
+}
+
+// HealthHandler 返回执行所有检查的 /healthz 处理器，全部通过时返回 200，否则返回 503
+func HealthHandler(checks ...HealthCheck) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := HealthStatus{Status: "ok", Checks: make(map[string]string, len(checks))}
+		code := http.StatusOK
+		for _, check := range checks {
+			if err := check.Check(); err != nil {
+				status.Checks[check.Name] = err.Error()
+				status.Status = "unavailable"
+				code = http.StatusServiceUnavailable
+				continue
+			}
+			status.Checks[check.Name] = "ok"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(status)
+	})
+}
+
+// NewAdminMux 创建管理端口的路由：/metrics 输出 registries，/healthz 执行 checks
+func NewAdminMux(registries []*Registry, checks ...HealthCheck) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(registries...))
+	mux.Handle("/healthz", HealthHandler(checks...))
+	return mux
+}
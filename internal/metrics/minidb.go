@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+)
+
+// Default 进程级注册表：各模块在这里登记的计数器和 Go 运行时统计
+// 与具体服务实例相关的指标 (在线会话、Delta Log 版本) 由服务端注册在自己的 Registry 中
+var Default = NewRegistry()
+
+// 查询
+var (
+	QueriesTotal = Default.NewCounterVec("minidb_queries_total",
+		"Statements executed, by statement type and status (ok or error).", "statement", "status")
+	QueryDuration = Default.NewHistogramVec("minidb_query_duration_seconds",
+		"Statement latency in seconds, by statement type.", DefaultBuckets, "statement")
+)
+
+// 预处理语句的计划缓存
+var PlanCacheRequests = Default.NewCounterVec("minidb_plan_cache_requests_total",
+	"Plan cache lookups for prepared statements and queries, by result (hit, miss, invalidated).", "result")
+
+// 计划缓存查找结果 (minidb_plan_cache_requests_total 的 result 标签)
+const (
+	PlanCacheHit         = "hit"
+	PlanCacheMiss        = "miss"
+	PlanCacheInvalidated = "invalidated"
+)
+
+// 表扫描
+var (
+	ScanFilesTotal = Default.NewCounterVec("minidb_scan_files_total",
+		"Data files considered by table scans, by outcome (read, pruned_by_partition, pruned_by_stats).", "outcome")
+	ScanBytesRead = Default.NewCounter("minidb_scan_bytes_read_total",
+		"Bytes of data files read by table scans.")
+)
+
+// 扫描文件的去向 (minidb_scan_files_total 的 outcome 标签)
+const (
+	ScanOutcomeRead              = "read"
+	ScanOutcomePrunedByPartition = "pruned_by_partition"
+	ScanOutcomePrunedByStats     = "pruned_by_stats"
+)
+
+// Delta Log 和写入
+var (
+	DeltaCommitConflicts = Default.NewCounter("minidb_delta_commit_conflicts_total",
+		"Delta Log commits that lost an optimistic concurrency race.")
+	DeltaCommitRetries = Default.NewCounter("minidb_delta_commit_retries_total",
+		"Delta Log commits retried after a version conflict.")
+	ParquetBytesWritten = Default.NewCounter("minidb_parquet_bytes_written_total",
+		"Bytes written to Parquet files (data, compaction output and checkpoints).")
+	ParquetFilesWritten = Default.NewCounter("minidb_parquet_files_written_total",
+		"Parquet files written.")
+)
+
+// 后台维护
+var (
+	CompactionRuns = Default.NewCounterVec("minidb_compaction_runs_total",
+		"Compaction runs, by status (ok or error).", "status")
+	CompactionFilesRemoved = Default.NewCounter("minidb_compaction_files_removed_total",
+		"Small files replaced by compaction.")
+)
+
+// 状态标签的取值
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+func init() {
+	Default.register(runtimeCollector{})
+}
+
+// StatusOf 返回 err 对应的状态标签
+func StatusOf(err error) string {
+	if err != nil {
+		return StatusError
+	}
+	return StatusOK
+}
+
+// ObserveQuery 记录一条语句的执行结果和耗时
+func ObserveQuery(sql string, elapsed time.Duration, err error) {
+	statement := StatementType(sql)
+	QueriesTotal.WithLabelValues(statement, StatusOf(err)).Inc()
+	QueryDuration.WithLabelValues(statement).ObserveDuration(elapsed)
+}
+
+// statementKeywords 作为语句类型标签的首关键字，其他语句归为 OTHER，避免标签基数随输入增长
+var statementKeywords = map[string]bool{
+	"SELECT": true, "INSERT": true, "UPDATE": true, "DELETE": true,
+	"CREATE": true, "DROP": true, "ALTER": true, "COPY": true, "EXPORT": true, "IMPORT": true,
+	"EXPLAIN": true, "DESCRIBE": true, "DESC": true, "SHOW": true, "USE": true, "ANALYZE": true,
+	"VACUUM": true, "BACKUP": true, "RESTORE": true, "COMMENT": true,
+	"GRANT": true, "REVOKE": true, "KILL": true, "SET": true, "RESET": true,
+	"START": true, "COMMIT": true, "ROLLBACK": true,
+	"PREPARE": true, "EXECUTE": true, "DEALLOCATE": true,
+}
+
+// StatementType 返回语句的类型标签 (首关键字的大写形式)
+func StatementType(sql string) string {
+	text := strings.TrimLeft(sql, " \t\r\n(")
+	end := strings.IndexFunc(text, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	if end >= 0 {
+		text = text[:end]
+	}
+	keyword := strings.ToUpper(text)
+	if statementKeywords[keyword] {
+		return keyword
+	}
+	return "OTHER"
+}
@@ -0,0 +1,337 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 指标类型 (Prometheus 文本格式的 TYPE)
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+)
+
+// DefaultBuckets 延迟直方图的默认桶上界 (秒)
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// collector 注册表中的一个指标族
+type collector interface {
+	describe() desc
+	// write 输出指标族的样本行 (不含 HELP/TYPE)
+	write(w *bufio.Writer)
+}
+
+// desc 指标族的名称、说明、类型和标签名
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+// Registry 一组指标，按注册顺序以 Prometheus 文本格式输出
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+	names      map[string]bool
+}
+
+// NewRegistry 创建空的注册表
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+// register 注册指标族，名称重复属于编程错误，直接 panic
+func (r *Registry) register(c collector) {
+	name := c.describe().name
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[name] {
+		panic(fmt.Sprintf("metrics: duplicate metric %q", name))
+	}
+	r.names[name] = true
+	r.collectors = append(r.collectors, c)
+}
+
+// NewCounter 注册无标签的计数器
+func (r *Registry) NewCounter(name, help string) *Counter {
+	c := &Counter{desc: desc{name: name, help: help, typ: typeCounter}}
+	r.register(c)
+	return c
+}
+
+// NewCounterVec 注册带标签的计数器
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := &CounterVec{vec: newVec[Counter](desc{name: name, help: help, typ: typeCounter, labels: labels})}
+	r.register(v)
+	return v
+}
+
+// NewGaugeFunc 注册在每次采集时调用 fn 取值的仪表
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&gaugeFunc{desc: desc{name: name, help: help, typ: typeGauge}, fn: fn})
+}
+
+// NewHistogramVec 注册带标签的直方图，buckets 为空时使用 DefaultBuckets
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	v := &HistogramVec{vec: newVec[Histogram](desc{name: name, help: help, typ: typeHistogram, labels: labels}), buckets: sorted}
+	r.register(v)
+	return v
+}
+
+// WriteText 以 Prometheus 文本格式 (0.0.4) 输出所有指标
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	collectors := append([]collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		d := c.describe()
+		fmt.Fprintf(bw, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", d.name, d.typ)
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Counter 单调递增的整数计数器
+type Counter struct {
+	desc  desc
+	value atomic.Int64
+}
+
+// Inc 加 1
+func (c *Counter) Inc() {
+	c.value.Add(1)
+}
+
+// Add 增加 n，n 为负数时忽略
+func (c *Counter) Add(n int64) {
+	if n > 0 {
+		c.value.Add(n)
+	}
+}
+
+// Value 当前值
+func (c *Counter) Value() int64 {
+	return c.value.Load()
+}
+
+func (c *Counter) describe() desc { return c.desc }
+
+func (c *Counter) write(w *bufio.Writer) {
+	writeSample(w, c.desc.name, "", float64(c.Value()))
+}
+
+// gaugeFunc 采集时调用函数取值的指标
+type gaugeFunc struct {
+	desc desc
+	fn   func() float64
+}
+
+func (g *gaugeFunc) describe() desc { return g.desc }
+
+func (g *gaugeFunc) write(w *bufio.Writer) {
+	writeSample(w, g.desc.name, "", g.fn())
+}
+
+// vec 按标签值组合保存子指标
+type vec[T any] struct {
+	desc   desc
+	mu     sync.RWMutex
+	series map[string]*labeled[T]
+}
+
+// labeled 一个标签值组合及其指标
+type labeled[T any] struct {
+	labels string // 已格式化的 {k="v",...}
+	metric *T
+}
+
+func newVec[T any](d desc) vec[T] {
+	return vec[T]{desc: d, series: make(map[string]*labeled[T])}
+}
+
+// with 返回标签值对应的子指标，不存在时用 create 创建
+func (v *vec[T]) with(values []string, create func() *T) *T {
+	if len(values) != len(v.desc.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.desc.name, len(v.desc.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	v.mu.RLock()
+	s, ok := v.series[key]
+	v.mu.RUnlock()
+	if ok {
+		return s.metric
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok := v.series[key]; ok {
+		return s.metric
+	}
+	s = &labeled[T]{labels: formatLabels(v.desc.labels, values), metric: create()}
+	v.series[key] = s
+	return s.metric
+}
+
+// sorted 按标签排序的子指标，输出顺序稳定
+func (v *vec[T]) sorted() []*labeled[T] {
+	v.mu.RLock()
+	series := make([]*labeled[T], 0, len(v.series))
+	for _, s := range v.series {
+		series = append(series, s)
+	}
+	v.mu.RUnlock()
+	sort.Slice(series, func(i, j int) bool { return series[i].labels < series[j].labels })
+	return series
+}
+
+// CounterVec 带标签的计数器
+type CounterVec struct {
+	vec[Counter]
+}
+
+// WithLabelValues 返回标签值 (按注册时的标签名顺序) 对应的计数器
+func (v *CounterVec) WithLabelValues(values ...string) *Counter {
+	return v.with(values, func() *Counter { return &Counter{} })
+}
+
+func (v *CounterVec) describe() desc { return v.desc }
+
+func (v *CounterVec) write(w *bufio.Writer) {
+	for _, s := range v.sorted() {
+		writeSample(w, v.desc.name, s.labels, float64(s.metric.Value()))
+	}
+}
+
+// Histogram 累积分桶的直方图
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64 // 落入各桶 (不累积) 的观测数
+	sum     float64
+	count   uint64
+}
+
+// Observe 记录一次观测值
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mu.Lock()
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.sum += v
+	h.count++
+	h.mu.Unlock()
+}
+
+// ObserveDuration 以秒为单位记录耗时
+func (h *Histogram) ObserveDuration(d time.Duration) {
+	h.Observe(d.Seconds())
+}
+
+// HistogramVec 带标签的直方图
+type HistogramVec struct {
+	vec[Histogram]
+	buckets []float64
+}
+
+// WithLabelValues 返回标签值对应的直方图
+func (v *HistogramVec) WithLabelValues(values ...string) *Histogram {
+	return v.with(values, func() *Histogram {
+		return &Histogram{buckets: v.buckets, counts: make([]uint64, len(v.buckets))}
+	})
+}
+
+func (v *HistogramVec) describe() desc { return v.desc }
+
+func (v *HistogramVec) write(w *bufio.Writer) {
+	for _, s := range v.sorted() {
+		h := s.metric
+		h.mu.Lock()
+		counts := append([]uint64(nil), h.counts...)
+		sum, count := h.sum, h.count
+		h.mu.Unlock()
+
+		cumulative := uint64(0)
+		for i, upper := range h.buckets {
+			cumulative += counts[i]
+			writeSample(w, v.desc.name+"_bucket", withLabel(s.labels, "le", formatFloat(upper)), float64(cumulative))
+		}
+		writeSample(w, v.desc.name+"_bucket", withLabel(s.labels, "le", "+Inf"), float64(count))
+		writeSample(w, v.desc.name+"_sum", s.labels, sum)
+		writeSample(w, v.desc.name+"_count", s.labels, float64(count))
+	}
+}
+
+// writeSample 输出一行样本
+func writeSample(w *bufio.Writer, name, labels string, value float64) {
+	w.WriteString(name)
+	w.WriteString(labels)
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+// formatLabels 格式化标签为 {k="v",...}
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		fmt.Fprintf(&sb, "%s=\"%s\"", name, escapeLabelValue(values[i]))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// withLabel 在已格式化的标签后追加一个标签
+func withLabel(labels, name, value string) string {
+	pair := fmt.Sprintf("%s=\"%s\"", name, value)
+	if labels == "" {
+		return "{" + pair + "}"
+	}
+	return labels[:len(labels)-1] + "," + pair + "}"
+}
+
+// formatFloat 按 Prometheus 文本格式输出数值
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelEscaper.Replace(s) }
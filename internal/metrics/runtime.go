@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"bufio"
+	"runtime"
+	"time"
+)
+
+// processStart 进程启动时间
+var processStart = time.Now()
+
+// runtimeCollector 采集 Go 运行时统计 (goroutine、堆内存、GC)
+// 每次采集调用一次 runtime.ReadMemStats，输出多个指标族
+type runtimeCollector struct{}
+
+func (runtimeCollector) describe() desc {
+	return desc{name: "go_goroutines", help: "Number of goroutines that currently exist.", typ: typeGauge}
+}
+
+func (runtimeCollector) write(w *bufio.Writer) {
+	writeSample(w, "go_goroutines", "", float64(runtime.NumGoroutine()))
+
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	families := []struct {
+		name, help, typ string
+		value           float64
+	}{
+		{"go_memstats_alloc_bytes", "Bytes of allocated heap objects.", typeGauge, float64(ms.HeapAlloc)},
+		{"go_memstats_heap_inuse_bytes", "Bytes in in-use heap spans.", typeGauge, float64(ms.HeapInuse)},
+		{"go_memstats_sys_bytes", "Bytes of memory obtained from the OS.", typeGauge, float64(ms.Sys)},
+		{"go_memstats_alloc_bytes_total", "Cumulative bytes allocated for heap objects.", typeCounter, float64(ms.TotalAlloc)},
+		{"go_gc_cycles_total", "Number of completed GC cycles.", typeCounter, float64(ms.NumGC)},
+		{"go_gc_pause_seconds_total", "Cumulative GC stop-the-world pause time in seconds.", typeCounter, float64(ms.PauseTotalNs) / 1e9},
+		{"process_start_time_seconds", "Start time of the process since unix epoch in seconds.", typeGauge, float64(processStart.UnixNano()) / 1e9},
+	}
+	for _, f := range families {
+		w.WriteString("# HELP " + f.name + " " + f.help + "\n")
+		w.WriteString("# TYPE " + f.name + " " + f.typ + "\n")
+		writeSample(w, f.name, "", f.value)
+	}
+}
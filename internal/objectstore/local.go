@@ -18,6 +18,13 @@ type ObjectInfo struct {
 	ETag         string
 }
 
+// ReadAtSeekCloser 支持随机读取的对象读取器 (Parquet reader 需要按偏移读取 footer 和列块)
+type ReadAtSeekCloser interface {
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
 // ConditionalObjectStore 支持条件写入的对象存储接口
 type ConditionalObjectStore interface {
 	// 基础对象存储功能
@@ -27,6 +34,7 @@ type ConditionalObjectStore interface {
 	List(prefix string) ([]string, error)
 	GetReader(path string) (io.ReadCloser, error)
 	GetWriter(path string) (io.WriteCloser, error)
+	GetReaderAt(path string) (ReadAtSeekCloser, error)
 	Exists(path string) (bool, error)
 	Stat(path string) (*ObjectInfo, error)
 	Close() error
@@ -151,6 +159,19 @@ func (ls *LocalStore) GetReader(path string) (io.ReadCloser, error) {
 	return file, nil
 }
 
+// GetReaderAt 获取支持随机读取的对象读取器
+func (ls *LocalStore) GetReaderAt(path string) (ReadAtSeekCloser, error) {
+	fullPath := ls.getFullPath(path)
+	file, err := os.Open(fullPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("object not found: %s", path)
+		}
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	return file, nil
+}
+
 // GetWriter 获取对象写入器
 func (ls *LocalStore) GetWriter(path string) (io.WriteCloser, error) {
 	fullPath := ls.getFullPath(path)
@@ -0,0 +1,552 @@
+package objectstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Config S3 兼容对象存储配置 (AWS S3 / MinIO / Ceph RGW / R2 等)
+type S3Config struct {
+	Endpoint        string       // 服务地址，如 http://127.0.0.1:9000 或 https://s3.us-east-1.amazonaws.com
+	Region          string       // 签名使用的区域，默认 us-east-1
+	Bucket          string       // 存储桶
+	Prefix          string       // 对象键前缀，用于多个实例共享同一个存储桶
+	AccessKeyID     string       // 为空时发送匿名请求
+	SecretAccessKey string       // 访问密钥
+	UsePathStyle    bool         // 使用 path-style 地址 (MinIO 等通常需要)
+	HTTPClient      *http.Client // 自定义 HTTP 客户端，默认 http.DefaultClient
+}
+
+// S3Store S3 兼容对象存储实现
+// 条件写入使用 If-None-Match: * / If-Match: etag，服务端返回 412 时报告 PreconditionFailed，
+// 与 LocalStore 的冲突语义一致，可直接用于乐观并发控制的 Delta Log
+type S3Store struct {
+	endpoint *url.URL
+	cfg      S3Config
+	client   *http.Client
+}
+
+// NewS3Store 创建 S3 兼容对象存储
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("s3 endpoint is required")
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", cfg.Endpoint)
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	cfg.Prefix = strings.Trim(cfg.Prefix, "/")
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &S3Store{
+		endpoint: endpoint,
+		cfg:      cfg,
+		client:   client,
+	}, nil
+}
+
+// Get 获取对象内容
+func (s *S3Store) Get(path string) ([]byte, error) {
+	resp, err := s.do(http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := s.checkResponse(resp, path); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return data, nil
+}
+
+// Put 写入对象
+func (s *S3Store) Put(path string, data []byte) error {
+	return s.put(path, data, nil)
+}
+
+// PutIfNotExists 仅在对象不存在时写入 (If-None-Match: *)
+func (s *S3Store) PutIfNotExists(path string, data []byte) error {
+	return s.put(path, data, http.Header{"If-None-Match": []string{"*"}})
+}
+
+// PutIfMatch 仅在 ETag 匹配时写入 (If-Match: etag)，expectedETag 为空表示期望对象不存在
+func (s *S3Store) PutIfMatch(path string, data []byte, expectedETag string) error {
+	if expectedETag == "" {
+		return s.PutIfNotExists(path, data)
+	}
+	return s.put(path, data, http.Header{"If-Match": []string{quoteETag(expectedETag)}})
+}
+
+// put 执行 PUT 请求，附加可选的条件请求头
+func (s *S3Store) put(path string, data []byte, headers http.Header) error {
+	resp, err := s.do(http.MethodPut, path, nil, headers, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return s.checkResponse(resp, path)
+}
+
+// Delete 删除对象 (对象不存在时不报错)
+func (s *S3Store) Delete(path string) error {
+	resp, err := s.do(http.MethodDelete, path, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return s.checkResponse(resp, path)
+}
+
+// listBucketResult ListObjectsV2 响应
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List 列出指定前缀 (目录) 下的所有对象，返回相对于存储根的路径
+func (s *S3Store) List(prefix string) ([]string, error) {
+	keyPrefix := s.objectKey(prefix)
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		// 与本地文件系统一致：前缀按目录匹配
+		keyPrefix += "/"
+	}
+
+	var results []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if keyPrefix != "" {
+			query.Set("prefix", keyPrefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = s.checkResponse(resp, prefix)
+		if err == nil {
+			if decodeErr := xml.NewDecoder(resp.Body).Decode(&result); decodeErr != nil {
+				err = fmt.Errorf("failed to decode list response: %w", decodeErr)
+			}
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		for _, obj := range result.Contents {
+			results = append(results, s.relativePath(obj.Key))
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	return results, nil
+}
+
+// GetReader 获取对象读取器
+func (s *S3Store) GetReader(path string) (io.ReadCloser, error) {
+	resp, err := s.do(http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkResponse(resp, path); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetReaderAt 获取支持随机读取的对象读取器，每次 ReadAt 发送一个 Range 请求
+func (s *S3Store) GetReaderAt(path string) (ReadAtSeekCloser, error) {
+	info, err := s.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &s3ReaderAt{store: s, path: path, size: info.Size}, nil
+}
+
+// GetWriter 获取对象写入器
+// 写入内容先缓存在内存中，Close 时一次性 PUT (对象在 Close 前对读者不可见)
+func (s *S3Store) GetWriter(path string) (io.WriteCloser, error) {
+	return &s3Writer{store: s, path: path}, nil
+}
+
+// Exists 检查对象是否存在
+func (s *S3Store) Exists(path string) (bool, error) {
+	resp, err := s.do(http.MethodHead, path, nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err := s.checkResponse(resp, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Stat 获取对象元数据
+func (s *S3Store) Stat(path string) (*ObjectInfo, error) {
+	resp, err := s.do(http.MethodHead, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := s.checkResponse(resp, path); err != nil {
+		return nil, err
+	}
+
+	info := &ObjectInfo{
+		Path: path,
+		Size: resp.ContentLength,
+		ETag: strings.Trim(resp.Header.Get("ETag"), `"`),
+	}
+	if info.Size < 0 {
+		info.Size, _ = strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.ModifiedTime = modified.Unix()
+	}
+	return info, nil
+}
+
+// Close 关闭对象存储
+func (s *S3Store) Close() error {
+	return nil
+}
+
+// objectKey 将存储路径转换为对象键 (加上配置的前缀)
+func (s *S3Store) objectKey(p string) string {
+	p = strings.ReplaceAll(p, "\\", "/")
+	p = strings.Trim(p, "/")
+	if p != "" {
+		p = path.Clean(p)
+	}
+	if s.cfg.Prefix == "" {
+		return p
+	}
+	if p == "" {
+		return s.cfg.Prefix
+	}
+	return s.cfg.Prefix + "/" + p
+}
+
+// relativePath 将对象键转换回存储路径 (去掉配置的前缀)
+func (s *S3Store) relativePath(key string) string {
+	if s.cfg.Prefix == "" {
+		return key
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(key, s.cfg.Prefix), "/")
+}
+
+// requestURL 构造对象请求地址 (path-style 或 virtual-hosted-style)
+func (s *S3Store) requestURL(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if s.cfg.UsePathStyle {
+		u.Path = basePath + "/" + s.cfg.Bucket
+		if key != "" {
+			u.Path += "/" + key
+		}
+	} else {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+	}
+	// 使用 SigV4 规范编码，保证签名与实际请求路径一致
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+// do 发送签名后的请求
+func (s *S3Store) do(method, p string, query url.Values, headers http.Header, body []byte) (*http.Response, error) {
+	// 空路径表示存储桶本身 (ListObjectsV2)
+	key := ""
+	if p != "" {
+		key = s.objectKey(p)
+	}
+	u := s.requestURL(key, query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build s3 request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	for name, values := range headers {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s failed: %w", method, key, err)
+	}
+	return resp, nil
+}
+
+// checkResponse 将 S3 错误响应转换为与 LocalStore 一致的错误
+func (s *S3Store) checkResponse(resp *http.Response, p string) error {
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("object not found: %s", p)
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return fmt.Errorf("PreconditionFailed: conditional write rejected for %s", p)
+	case resp.StatusCode == http.StatusConflict:
+		// 并发的条件写入，S3 返回 409 ConditionalRequestConflict
+		return fmt.Errorf("PreconditionFailed: concurrent conditional write for %s", p)
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 request for %s failed: %s: %s", p, resp.Status, strings.TrimSpace(string(msg)))
+}
+
+// sign 使用 AWS Signature Version 4 签名请求，未配置凭证时发送匿名请求
+func (s *S3Store) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if s.cfg.AccessKeyID == "" {
+		return
+	}
+
+	host := req.URL.Host
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	date := now.Format("20060102")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery 按 SigV4 规则排序并编码查询参数
+func canonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode 按 SigV4 规则编码 (只保留非保留字符，encodeSlash 为 false 时保留 '/')
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9'),
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// quoteETag S3 的 ETag 条件头需要带引号
+func quoteETag(etag string) string {
+	if strings.HasPrefix(etag, `"`) || etag == "*" {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// s3ReaderAt 基于 Range GET 的随机读取器
+type s3ReaderAt struct {
+	store  *S3Store
+	path   string
+	size   int64
+	offset int64
+}
+
+// ReadAt 读取 [off, off+len(p)) 范围的数据
+func (r *s3ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	end := off + int64(len(p)) - 1
+	if end >= r.size {
+		end = r.size - 1
+	}
+
+	headers := http.Header{"Range": []string{fmt.Sprintf("bytes=%d-%d", off, end)}}
+	resp, err := r.store.do(http.MethodGet, r.path, nil, headers, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if err := r.store.checkResponse(resp, r.path); err != nil {
+		return 0, err
+	}
+
+	n, err := io.ReadFull(resp.Body, p[:end-off+1])
+	if err != nil {
+		return n, fmt.Errorf("failed to read range of %s: %w", r.path, err)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Read 从当前偏移顺序读取
+func (r *s3ReaderAt) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.offset)
+	r.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// Seek 设置读取偏移
+func (r *s3ReaderAt) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence: %d", whence)
+	}
+	if abs < 0 {
+		return 0, fmt.Errorf("negative position: %d", abs)
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+// Close 关闭读取器
+func (r *s3ReaderAt) Close() error {
+	return nil
+}
+
+// s3Writer 缓存写入内容，Close 时上传
+type s3Writer struct {
+	store  *S3Store
+	path   string
+	buf    bytes.Buffer
+	closed bool
+}
+
+// Write 写入缓存
+func (w *s3Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, fmt.Errorf("write to closed object writer: %s", w.path)
+	}
+	return w.buf.Write(p)
+}
+
+// Close 上传对象
+func (w *s3Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.store.Put(w.path, w.buf.Bytes())
+}
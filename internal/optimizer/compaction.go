@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/apache/arrow/go/v18/arrow"
@@ -12,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/yyun543/minidb/internal/delta"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/metrics"
 	"github.com/yyun543/minidb/internal/parquet"
 	"go.uber.org/zap"
 )
@@ -21,9 +24,16 @@ type CompactionConfig struct {
 	TargetFileSize    int64         // Target file size in bytes
 	MinFileSize       int64         // Minimum file size to trigger compaction
 	MaxFilesToCompact int           // Maximum files to compact in one operation
+	MinFilesToCompact int           // Minimum small files in a group to rewrite it (default 2)
 	CheckInterval     time.Duration // Background check interval
 }
 
+// CompactionResult describes the files replaced by one compaction run
+type CompactionResult struct {
+	FilesRemoved int // Small files replaced by merged files
+	FilesAdded   int // Merged files written
+}
+
 // Compactor performs small file compaction
 type Compactor struct {
 	config *CompactionConfig
@@ -38,19 +48,31 @@ func NewCompactor(config *CompactionConfig) *Compactor {
 
 // CompactTable compacts small files in a table
 func (c *Compactor) CompactTable(tableID string, engine CompactionEngine) error {
+	_, err := c.Compact(tableID, engine)
+	return err
+}
+
+// Compact compacts small files in a table and reports how many files were replaced
+func (c *Compactor) Compact(tableID string, engine CompactionEngine) (result *CompactionResult, err error) {
 	logger.Info("Starting table compaction", zap.String("table", tableID))
+	defer func() {
+		metrics.CompactionRuns.WithLabelValues(metrics.StatusOf(err)).Inc()
+		if result != nil {
+			metrics.CompactionFilesRemoved.Add(int64(result.FilesRemoved))
+		}
+	}()
 
 	deltaLog := engine.GetDeltaLog()
 	snapshot, err := deltaLog.GetSnapshot(tableID, -1)
 	if err != nil {
-		return fmt.Errorf("failed to get snapshot: %w", err)
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
 	}
 
 	// Identify small files that need compaction
 	smallFiles := c.identifySmallFiles(snapshot.Files)
 	if len(smallFiles) == 0 {
 		logger.Info("No small files to compact", zap.String("table", tableID))
-		return nil
+		return &CompactionResult{}, nil
 	}
 
 	logger.Info("Identified small files for compaction",
@@ -59,14 +81,32 @@ func (c *Compactor) CompactTable(tableID string, engine CompactionEngine) error
 
 	// Compact files in batches
 	db, table := parseTableID(tableID)
-	basePath := "/tmp/minidb"
+	minFiles := c.config.MinFilesToCompact
+	if minFiles < 2 {
+		minFiles = 2
+	}
 
-	compactedFiles := c.compactFiles(smallFiles, snapshot.Schema, db, table, basePath)
+	// Partitioned tables are compacted per partition so that merged files never
+	// mix rows of different partitions
+	var compactedFiles []*delta.ParquetFile
+	var replacedFiles []delta.FileInfo
+	for _, group := range compactionGroups(smallFiles, snapshot.Files) {
+		if len(group) < minFiles {
+			continue
+		}
+		dataDir := partitionDataDir(group, tableDataDir(engine, db, table))
+		merged := c.compactFiles(parquetStoreOf(engine), group, snapshot.Schema, dataDir)
+		if len(merged) == 0 {
+			continue
+		}
+		compactedFiles = append(compactedFiles, merged...)
+		replacedFiles = append(replacedFiles, group...)
+	}
 
 	// Update Delta Log
-	// Mark old files as REMOVE
-	for _, file := range smallFiles {
-		if err := deltaLog.AppendRemove(tableID, file.Path); err != nil {
+	// Mark old files as REMOVE with dataChange=false
+	for _, file := range replacedFiles {
+		if err := deltaLog.AppendRearrangeRemove(tableID, file.Path); err != nil {
 			logger.Warn("Failed to remove old file",
 				zap.String("file", file.Path),
 				zap.Error(err))
@@ -84,10 +124,10 @@ func (c *Compactor) CompactTable(tableID string, engine CompactionEngine) error
 
 	logger.Info("Table compaction completed",
 		zap.String("table", tableID),
-		zap.Int("old_files", len(smallFiles)),
+		zap.Int("old_files", len(replacedFiles)),
 		zap.Int("new_files", len(compactedFiles)))
 
-	return nil
+	return &CompactionResult{FilesRemoved: len(replacedFiles), FilesAdded: len(compactedFiles)}, nil
 }
 
 // identifySmallFiles identifies files smaller than threshold
@@ -95,6 +135,10 @@ func (c *Compactor) identifySmallFiles(files []delta.FileInfo) []delta.FileInfo
 	smallFiles := make([]delta.FileInfo, 0)
 
 	for _, file := range files {
+		// Merge-on-Read delta files are not data and are never merged
+		if file.IsDelta {
+			continue
+		}
 		if file.Size < c.config.MinFileSize {
 			smallFiles = append(smallFiles, file)
 			if len(smallFiles) >= c.config.MaxFilesToCompact {
@@ -107,16 +151,21 @@ func (c *Compactor) identifySmallFiles(files []delta.FileInfo) []delta.FileInfo
 }
 
 // compactFiles compacts multiple files into larger files
-func (c *Compactor) compactFiles(files []delta.FileInfo, schema *arrow.Schema, db, table, basePath string) []*delta.ParquetFile {
+func (c *Compactor) compactFiles(store parquet.ObjectStore, files []delta.FileInfo, schema *arrow.Schema, dataDir string) []*delta.ParquetFile {
 	// Read all records from small files
 	allRecords := make([]arrow.Record, 0)
 	for _, file := range files {
-		record, err := parquet.ReadParquetFile(file.Path, nil)
+		record, err := parquet.ReadParquetFileFrom(store, file.Path, nil, 1)
 		if err != nil {
+			// Every file of the group is removed after merging, so the group is skipped
+			// unless all of its files can be read
 			logger.Warn("Failed to read file for compaction",
 				zap.String("file", file.Path),
 				zap.Error(err))
-			continue
+			for _, record := range allRecords {
+				record.Release()
+			}
+			return nil
 		}
 		allRecords = append(allRecords, record)
 	}
@@ -150,9 +199,10 @@ func (c *Compactor) compactFiles(files []delta.FileInfo, schema *arrow.Schema, d
 	defer compactedRecord.Release()
 
 	fileName := fmt.Sprintf("compact-%s.parquet", uuid.New().String()[:8])
-	filePath := filepath.Join(basePath, db, table, "data", fileName)
+	filePath := filepath.Join(dataDir, fileName)
 
-	stats, err := parquet.WriteArrowBatch(filePath, compactedRecord)
+	// 合并后的文件沿用表级写入选项
+	stats, err := parquet.WriteArrowBatchWithOptions(store, filePath, compactedRecord, parquet.WriterOptionsFromSchema(schema))
 	if err != nil {
 		logger.Error("Failed to write compacted file",
 			zap.String("file", filePath),
@@ -166,13 +216,94 @@ func (c *Compactor) compactFiles(files []delta.FileInfo, schema *arrow.Schema, d
 		zap.Int64("size", stats.FileSize))
 
 	return []*delta.ParquetFile{{
-		Path:     filePath,
-		Size:     stats.FileSize,
-		RowCount: stats.RowCount,
-		Stats:    stats,
+		Path:            filePath,
+		Size:            stats.FileSize,
+		RowCount:        stats.RowCount,
+		Stats:           stats,
+		PartitionValues: files[0].PartitionValues,
+		AddedAt:         earliestAddedAt(files),
+		Rearranged:      true,
 	}}
 }
 
+// earliestAddedAt returns the earliest time any of the files was added to the table.
+// The merged file inherits it so that Merge-on-Read deltas keep applying to its rows
+func earliestAddedAt(files []delta.FileInfo) int64 {
+	var earliest int64
+	for _, file := range files {
+		if file.AddedAt > 0 && (earliest == 0 || file.AddedAt < earliest) {
+			earliest = file.AddedAt
+		}
+	}
+	return earliest
+}
+
+// compactionGroups groups small files by partition and by Merge-on-Read side.
+// Deltas only apply to files added before the earliest delta, so files added before
+// and after it are never merged together
+func compactionGroups(files []delta.FileInfo, snapshotFiles []delta.FileInfo) [][]delta.FileInfo {
+	var minDeltaAddedAt int64
+	for _, file := range snapshotFiles {
+		if file.IsDelta && (minDeltaAddedAt == 0 || file.AddedAt < minDeltaAddedAt) {
+			minDeltaAddedAt = file.AddedAt
+		}
+	}
+	if minDeltaAddedAt == 0 {
+		return groupFilesByPartition(files)
+	}
+
+	var groups [][]delta.FileInfo
+	for _, group := range groupFilesByPartition(files) {
+		var before, after []delta.FileInfo
+		for _, file := range group {
+			if file.AddedAt >= minDeltaAddedAt {
+				after = append(after, file)
+			} else {
+				before = append(before, file)
+			}
+		}
+		for _, side := range [][]delta.FileInfo{before, after} {
+			if len(side) > 0 {
+				groups = append(groups, side)
+			}
+		}
+	}
+	return groups
+}
+
+// groupFilesByPartition groups files by their partition values, preserving file order.
+// Files of non-partitioned tables all end up in a single group
+func groupFilesByPartition(files []delta.FileInfo) [][]delta.FileInfo {
+	var groups [][]delta.FileInfo
+	index := make(map[string]int)
+	for _, file := range files {
+		keys := make([]string, 0, len(file.PartitionValues))
+		for k, v := range file.PartitionValues {
+			keys = append(keys, k+"="+v)
+		}
+		sort.Strings(keys)
+		key := strings.Join(keys, "/")
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], file)
+	}
+	return groups
+}
+
+// partitionDataDir returns the directory rewritten files of a partition group belong in:
+// the partition directory of the group's files, or defaultDir for non-partitioned files
+func partitionDataDir(files []delta.FileInfo, defaultDir string) string {
+	if len(files) > 0 && len(files[0].PartitionValues) > 0 {
+		return filepath.Dir(files[0].Path)
+	}
+	return defaultDir
+}
+
 // appendColumn appends all values from a column to a builder
 func (c *Compactor) appendColumn(builder array.Builder, col arrow.Array, dataType arrow.DataType) {
 	switch b := builder.(type) {
@@ -220,6 +351,32 @@ type CompactionEngine interface {
 	GetDeltaLog() delta.LogInterface
 }
 
+// ParquetStoreProvider is implemented by engines whose data files live in an object store
+type ParquetStoreProvider interface {
+	ParquetStore() parquet.ObjectStore
+}
+
+// DataDirProvider is implemented by engines that know where a table's data files live
+type DataDirProvider interface {
+	TableDataDir(db, table string) string
+}
+
+// tableDataDir returns the directory rewritten files of a table are written to
+func tableDataDir(engine interface{}, db, table string) string {
+	if provider, ok := engine.(DataDirProvider); ok {
+		return provider.TableDataDir(db, table)
+	}
+	return filepath.Join("/tmp/minidb", db, table, "data")
+}
+
+// parquetStoreOf returns the engine's object store, or nil for the local filesystem
+func parquetStoreOf(engine interface{}) parquet.ObjectStore {
+	if provider, ok := engine.(ParquetStoreProvider); ok {
+		return provider.ParquetStore()
+	}
+	return nil
+}
+
 // AutoCompactor automatic background compaction
 type AutoCompactor struct {
 	compactor *Compactor
@@ -1,6 +1,7 @@
 package optimizer
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -37,6 +38,14 @@ func NewOptimizer() *Optimizer {
 
 // Optimize 优化查询
 func (o *Optimizer) Optimize(stmt parser.Node) (*Plan, error) {
+	return o.OptimizeContext(context.Background(), stmt)
+}
+
+// OptimizeContext 优化查询，查询被取消或超时后停止应用优化规则并返回取消原因
+func (o *Optimizer) OptimizeContext(ctx context.Context, stmt parser.Node) (*Plan, error) {
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
 	logger.WithComponent("optimizer").Debug("Starting query optimization",
 		zap.String("statement_type", fmt.Sprintf("%T", stmt)))
 
@@ -60,6 +69,9 @@ func (o *Optimizer) Optimize(stmt parser.Node) (*Plan, error) {
 	rulesStart := time.Now()
 	appliedRules := 0
 	for _, rule := range o.rules {
+		if ctx.Err() != nil {
+			return nil, context.Cause(ctx)
+		}
 		ruleStart := time.Now()
 		originalPlan := plan
 		plan = rule.Apply(plan)
@@ -120,6 +132,50 @@ func (o *Optimizer) buildPlan(node parser.Node) (*Plan, error) {
 		return o.buildExplainPlan(n)
 	case *parser.AnalyzeStmt:
 		return o.buildAnalyzePlan(n)
+	case *parser.SetStmt:
+		return o.buildSetPlan(n)
+	case *parser.ShowVariableStmt:
+		return o.buildShowVariablePlan(n)
+	case *parser.ResetStmt:
+		return o.buildResetPlan(n)
+	case *parser.AlterTableStmt:
+		return o.buildAlterTablePlan(n)
+	case *parser.CopyStmt:
+		return o.buildCopyPlan(n)
+	case *parser.ExportTableStmt:
+		return o.buildExportTablePlan(n)
+	case *parser.ImportTableStmt:
+		return o.buildImportTablePlan(n)
+	case *parser.RestoreTableStmt:
+		return o.buildRestoreTablePlan(n)
+	case *parser.CloneTableStmt:
+		return o.buildCloneTablePlan(n)
+	case *parser.BackupDatabaseStmt:
+		return o.buildBackupDatabasePlan(n)
+	case *parser.RestoreDatabaseStmt:
+		return o.buildRestoreDatabasePlan(n)
+	case *parser.VacuumStmt:
+		return o.buildVacuumPlan(n)
+	case *parser.DescribeStmt:
+		return o.buildDescribePlan(n)
+	case *parser.ShowCreateTableStmt:
+		return o.buildShowCreateTablePlan(n)
+	case *parser.CommentStmt:
+		return o.buildCommentPlan(n)
+	case *parser.CreateRoleStmt:
+		return o.buildCreateRolePlan(n)
+	case *parser.DropRoleStmt:
+		return o.buildDropRolePlan(n)
+	case *parser.GrantStmt:
+		return o.buildGrantPlan(n)
+	case *parser.KillStmt:
+		return o.buildKillPlan(n)
+	case *parser.PrepareStmt:
+		return o.buildPreparePlan(n)
+	case *parser.ExecuteStmt:
+		return o.buildExecutePlan(n)
+	case *parser.DeallocateStmt:
+		return o.buildDeallocatePlan(n)
 	default:
 		return nil, fmt.Errorf("unsupported statement type: %T", node)
 	}
@@ -149,6 +205,20 @@ func (o *Optimizer) buildSelectPlan(stmt *parser.SelectStmt) (*Plan, error) {
 		}
 		// 子查询作为数据源直接使用
 		currentPlan = subqueryPlan
+	} else if stmt.FromFunction != nil {
+		// FROM 表函数：由执行器按函数参数生成数据
+		if len(stmt.Joins) > 0 {
+			return nil, fmt.Errorf("table function %s cannot be used with JOIN", stmt.FromFunction.Name)
+		}
+		currentPlan = NewPlan(TableScanPlan)
+		currentPlan.Properties = &TableScanProperties{
+			Table:      stmt.From,
+			TableAlias: stmt.FromAlias,
+			Function: &TableFunction{
+				Name: stmt.FromFunction.Name,
+				Args: stmt.FromFunction.Args,
+			},
+		}
 	} else if stmt.From != "" {
 		if len(stmt.Joins) > 0 {
 			currentPlan = o.buildJoinPlan(stmt.From, stmt.FromAlias, stmt.Joins)
@@ -392,21 +462,82 @@ func (o *Optimizer) buildCreateDatabasePlan(stmt *parser.CreateDatabaseStmt) (*P
 // buildCreateTablePlan 构建CREATE TABLE语句的查询计划
 func (o *Optimizer) buildCreateTablePlan(stmt *parser.CreateTableStmt) (*Plan, error) {
 	columns := make([]ColumnDef, len(stmt.Columns))
+	var primaryKey []string
 	for i, col := range stmt.Columns {
 		columns[i] = ColumnDef{
-			Name: col.Name,
-			Type: col.DataType, // 保存完整的数据类型
+			Name:     col.Name,
+			Type:     col.DataType, // 保存完整的数据类型
+			Nullable: true,
+		}
+		for _, constraint := range col.Constraints {
+			switch constraint.Type {
+			case parser.PrimaryKeyConstraint:
+				primaryKey = append(primaryKey, col.Name)
+				columns[i].Nullable = false
+			case parser.NotNullConstraint:
+				columns[i].Nullable = false
+			case parser.DefaultConstraint:
+				columns[i].Default = constraint.Value
+			case "":
+				continue
+			}
+			columns[i].Constraints = append(columns[i].Constraints, constraint.Type)
+		}
+	}
+	for _, constraint := range stmt.Constraints {
+		if constraint.Type == parser.PrimaryKeyConstraint {
+			if len(primaryKey) > 0 {
+				return nil, fmt.Errorf("multiple primary keys for table %s are not allowed", stmt.Table)
+			}
+			primaryKey = constraint.Columns
 		}
 	}
 	return &Plan{
 		Type: CreateTablePlan,
 		Properties: &CreateTableProperties{
-			Table:   stmt.Table,
-			Columns: columns,
+			Table:      stmt.Table,
+			Columns:    columns,
+			Options:    stmt.Options,
+			Partition:  convertPartitionMethod(stmt.Partition),
+			External:   convertExternalTable(stmt.External),
+			PrimaryKey: primaryKey,
 		},
 	}, nil
 }
 
+// convertExternalTable 转换 CREATE EXTERNAL TABLE 的 LOCATION / FORMAT 子句
+func convertExternalTable(external *parser.ExternalTable) *ExternalClause {
+	if external == nil {
+		return nil
+	}
+	return &ExternalClause{
+		Location: external.Location,
+		Format:   external.Format,
+		Options:  external.Options,
+	}
+}
+
+// convertPartitionMethod 转换 CREATE TABLE 的分区子句
+func convertPartitionMethod(method *parser.PartitionMethod) *PartitionClause {
+	if method == nil {
+		return nil
+	}
+	clause := &PartitionClause{
+		Type:    method.Type,
+		Columns: method.Columns,
+		Buckets: method.PartitionNum,
+	}
+	for _, def := range method.Partitions {
+		clause.Partitions = append(clause.Partitions, PartitionDef{
+			Name:     def.Name,
+			LessThan: def.LessThan,
+			MaxValue: def.MaxValue,
+			Values:   def.Values,
+		})
+	}
+	return clause
+}
+
 // buildDropDatabasePlan 构建DROP DATABASE语句的查询计划
 func (o *Optimizer) buildDropDatabasePlan(stmt *parser.DropDatabaseStmt) (*Plan, error) {
 	return &Plan{
@@ -474,11 +605,21 @@ func (o *Optimizer) buildExplainPlan(stmt *parser.ExplainStmt) (*Plan, error) {
 	if err != nil {
 		return nil, err
 	}
+	// 被解释的查询同样应用优化规则，EXPLAIN 输出的是实际执行的计划
+	for _, rule := range o.rules {
+		queryPlan = rule.Apply(queryPlan)
+	}
 
+	format := stmt.Format
+	if format == "" {
+		format = parser.ExplainFormatText
+	}
 	return &Plan{
 		Type: ExplainPlan,
 		Properties: &ExplainProperties{
-			Query: queryPlan,
+			Query:   queryPlan,
+			Analyze: stmt.Analyze,
+			Format:  format,
 		},
 	}, nil
 }
@@ -494,6 +635,278 @@ func (o *Optimizer) buildAnalyzePlan(stmt *parser.AnalyzeStmt) (*Plan, error) {
 	}, nil
 }
 
+// buildSetPlan 构建SET语句的查询计划
+func (o *Optimizer) buildSetPlan(stmt *parser.SetStmt) (*Plan, error) {
+	return &Plan{
+		Type: SetPlan,
+		Properties: &SetProperties{
+			Variable: stmt.Variable,
+			Value:    stmt.Value,
+		},
+	}, nil
+}
+
+// buildShowVariablePlan 构建SHOW会话变量语句的查询计划
+func (o *Optimizer) buildShowVariablePlan(stmt *parser.ShowVariableStmt) (*Plan, error) {
+	return &Plan{
+		Type: ShowVariablePlan,
+		Properties: &ShowVariableProperties{
+			Variable: stmt.Variable,
+			All:      stmt.All,
+		},
+	}, nil
+}
+
+// buildResetPlan 构建RESET语句的查询计划
+func (o *Optimizer) buildResetPlan(stmt *parser.ResetStmt) (*Plan, error) {
+	return &Plan{
+		Type: ResetPlan,
+		Properties: &ResetProperties{
+			Variable: stmt.Variable,
+			All:      stmt.All,
+		},
+	}, nil
+}
+
+// buildAlterTablePlan 构建ALTER TABLE语句的查询计划
+func (o *Optimizer) buildAlterTablePlan(stmt *parser.AlterTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: AlterTablePlan,
+		Properties: &AlterTableProperties{
+			Table:           stmt.Table,
+			Action:          stmt.Action,
+			PartitionName:   stmt.PartitionName,
+			PartitionValues: stmt.PartitionValues,
+			Properties:      stmt.Properties,
+			PropertyNames:   stmt.PropertyNames,
+		},
+	}, nil
+}
+
+// buildCopyPlan 构建COPY语句的查询计划，导出时同时构建导出查询的计划
+func (o *Optimizer) buildCopyPlan(stmt *parser.CopyStmt) (*Plan, error) {
+	props := &CopyProperties{
+		Table:     stmt.Table,
+		Columns:   stmt.Columns,
+		Direction: stmt.Direction,
+		Path:      stmt.Path,
+		Options:   stmt.Options,
+	}
+	if stmt.Query != nil {
+		queryPlan, err := o.buildPlan(stmt.Query)
+		if err != nil {
+			return nil, err
+		}
+		props.Query = queryPlan
+	}
+	return &Plan{
+		Type:       CopyPlan,
+		Properties: props,
+	}, nil
+}
+
+// buildExportTablePlan 构建EXPORT TABLE语句的查询计划
+func (o *Optimizer) buildExportTablePlan(stmt *parser.ExportTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: ExportTablePlan,
+		Properties: &ExportTableProperties{
+			Table:  stmt.Table,
+			Format: stmt.Format,
+		},
+	}, nil
+}
+
+// buildImportTablePlan 构建IMPORT TABLE语句的查询计划
+func (o *Optimizer) buildImportTablePlan(stmt *parser.ImportTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: ImportTablePlan,
+		Properties: &ImportTableProperties{
+			Table:  stmt.Table,
+			Format: stmt.Format,
+			Path:   stmt.Path,
+		},
+	}, nil
+}
+
+// buildRestoreTablePlan 构建RESTORE TABLE语句的查询计划
+func (o *Optimizer) buildRestoreTablePlan(stmt *parser.RestoreTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: RestoreTablePlan,
+		Properties: &RestoreTableProperties{
+			Table:     stmt.Table,
+			Version:   stmt.Version,
+			Timestamp: stmt.Timestamp,
+		},
+	}, nil
+}
+
+// buildCloneTablePlan 构建CREATE TABLE ... SHALLOW CLONE语句的查询计划
+func (o *Optimizer) buildCloneTablePlan(stmt *parser.CloneTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: CloneTablePlan,
+		Properties: &CloneTableProperties{
+			Table:   stmt.Table,
+			Source:  stmt.Source,
+			Version: stmt.Version,
+		},
+	}, nil
+}
+
+// buildBackupDatabasePlan 构建BACKUP DATABASE语句的查询计划
+func (o *Optimizer) buildBackupDatabasePlan(stmt *parser.BackupDatabaseStmt) (*Plan, error) {
+	return &Plan{
+		Type: BackupDatabasePlan,
+		Properties: &BackupDatabaseProperties{
+			Database:        stmt.Database,
+			Path:            stmt.Path,
+			IncrementalFrom: stmt.IncrementalFrom,
+		},
+	}, nil
+}
+
+// buildRestoreDatabasePlan 构建RESTORE DATABASE语句的查询计划
+func (o *Optimizer) buildRestoreDatabasePlan(stmt *parser.RestoreDatabaseStmt) (*Plan, error) {
+	return &Plan{
+		Type: RestoreDatabasePlan,
+		Properties: &RestoreDatabaseProperties{
+			Database: stmt.Database,
+			Path:     stmt.Path,
+			Version:  stmt.Version,
+		},
+	}, nil
+}
+
+// buildDescribePlan 构建DESCRIBE语句的查询计划
+func (o *Optimizer) buildDescribePlan(stmt *parser.DescribeStmt) (*Plan, error) {
+	return &Plan{
+		Type: DescribePlan,
+		Properties: &DescribeProperties{
+			Table:    stmt.Table,
+			Extended: stmt.Extended,
+		},
+	}, nil
+}
+
+// buildShowCreateTablePlan 构建SHOW CREATE TABLE语句的查询计划
+func (o *Optimizer) buildShowCreateTablePlan(stmt *parser.ShowCreateTableStmt) (*Plan, error) {
+	return &Plan{
+		Type: ShowCreateTablePlan,
+		Properties: &ShowCreateTableProperties{
+			Table: stmt.Table,
+		},
+	}, nil
+}
+
+// buildCommentPlan 构建COMMENT ON语句的查询计划
+func (o *Optimizer) buildCommentPlan(stmt *parser.CommentStmt) (*Plan, error) {
+	return &Plan{
+		Type: CommentPlan,
+		Properties: &CommentProperties{
+			Table:   stmt.Table,
+			Column:  stmt.Column,
+			Comment: stmt.Comment,
+		},
+	}, nil
+}
+
+// buildCreateRolePlan 构建CREATE USER / CREATE ROLE语句的查询计划
+func (o *Optimizer) buildCreateRolePlan(stmt *parser.CreateRoleStmt) (*Plan, error) {
+	return &Plan{
+		Type: CreateRolePlan,
+		Properties: &CreateRoleProperties{
+			Name:      stmt.Name,
+			Login:     stmt.Login,
+			Password:  stmt.Password,
+			Superuser: stmt.Superuser,
+		},
+	}, nil
+}
+
+// buildDropRolePlan 构建DROP USER / DROP ROLE语句的查询计划
+func (o *Optimizer) buildDropRolePlan(stmt *parser.DropRoleStmt) (*Plan, error) {
+	return &Plan{
+		Type: DropRolePlan,
+		Properties: &DropRoleProperties{
+			Name:     stmt.Name,
+			Login:    stmt.Login,
+			IfExists: stmt.IfExists,
+		},
+	}, nil
+}
+
+// buildGrantPlan 构建GRANT / REVOKE语句的查询计划
+func (o *Optimizer) buildGrantPlan(stmt *parser.GrantStmt) (*Plan, error) {
+	return &Plan{
+		Type: GrantPlan,
+		Properties: &GrantProperties{
+			Revoke:     stmt.Revoke,
+			Privileges: stmt.Privileges,
+			Database:   stmt.Database,
+			Table:      stmt.Table,
+			Roles:      stmt.Roles,
+			Grantees:   stmt.Grantees,
+		},
+	}, nil
+}
+
+// buildKillPlan 构建KILL语句的查询计划
+func (o *Optimizer) buildKillPlan(stmt *parser.KillStmt) (*Plan, error) {
+	return &Plan{
+		Type: KillPlan,
+		Properties: &KillProperties{
+			Session: stmt.Session,
+			ID:      stmt.ID,
+		},
+	}, nil
+}
+
+// buildPreparePlan 构建PREPARE语句的查询计划，语句本身在第一次执行时才生成计划 (见 PlanCache)
+func (o *Optimizer) buildPreparePlan(stmt *parser.PrepareStmt) (*Plan, error) {
+	return &Plan{
+		Type: PreparePlan,
+		Properties: &PrepareProperties{
+			Name:       stmt.Name,
+			ParamTypes: stmt.ParamTypes,
+			Statement:  stmt.Statement,
+			Query:      stmt.Query,
+		},
+	}, nil
+}
+
+// buildExecutePlan 构建EXECUTE语句的查询计划
+func (o *Optimizer) buildExecutePlan(stmt *parser.ExecuteStmt) (*Plan, error) {
+	return &Plan{
+		Type: ExecutePlan,
+		Properties: &ExecuteProperties{
+			Name: stmt.Name,
+			Args: stmt.Args,
+		},
+	}, nil
+}
+
+// buildDeallocatePlan 构建DEALLOCATE语句的查询计划
+func (o *Optimizer) buildDeallocatePlan(stmt *parser.DeallocateStmt) (*Plan, error) {
+	return &Plan{
+		Type: DeallocatePlan,
+		Properties: &DeallocateProperties{
+			Name: stmt.Name,
+			All:  stmt.All,
+		},
+	}, nil
+}
+
+// buildVacuumPlan 构建VACUUM语句的查询计划
+func (o *Optimizer) buildVacuumPlan(stmt *parser.VacuumStmt) (*Plan, error) {
+	return &Plan{
+		Type: VacuumPlan,
+		Properties: &VacuumProperties{
+			Table:       stmt.Table,
+			RetainHours: stmt.RetainHours,
+			DryRun:      stmt.DryRun,
+		},
+	}, nil
+}
+
 // convertExpression 将AST表达式节点转换为优化器的表达式结构
 func convertExpression(expr parser.Node) Expression {
 	if expr == nil {
@@ -553,6 +966,11 @@ func convertExpression(expr parser.Node) Expression {
 	case *parser.InExpr:
 		// 将IN表达式转换为多个OR条件: age IN (25, 30, 35) -> age = 25 OR age = 30 OR age = 35
 		return convertInExpression(e)
+	case *parser.Parameter:
+		return &ParameterRef{
+			Index:    e.Index,
+			DataType: e.DataType,
+		}
 	}
 	return nil
 }
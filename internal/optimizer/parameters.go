@@ -0,0 +1,265 @@
+package optimizer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/yyun543/minidb/internal/parser"
+)
+
+// BindParameters 返回把计划中的参数占位符替换为 args 的副本
+// 缓存中的计划会被多次绑定，而执行器的代价优化会就地调整计划树，因此总是复制整棵计划树，原计划不变
+func BindParameters(plan *Plan, paramTypes []string, args []interface{}) (*Plan, error) {
+	if len(args) != len(paramTypes) {
+		return nil, fmt.Errorf("wrong number of parameters: expected %d, got %d", len(paramTypes), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := coerceParameter(i+1, paramTypes[i], arg)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	b := &parameterBinder{values: values}
+	return b.plan(plan)
+}
+
+// coerceParameter 将参数值转换为声明的类型，未声明类型的参数保持原值
+func coerceParameter(index int, dataType string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, fmt.Errorf("parameter $%d: NULL values are not supported", index)
+	case int:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case float32:
+		value = float64(v)
+	case []byte:
+		value = string(v)
+	}
+
+	switch dataType {
+	case "":
+		switch value.(type) {
+		case int64, float64, string, bool:
+			return value, nil
+		}
+	case parser.ParameterTypeInt:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v), nil
+			}
+		case string:
+			if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return n, nil
+			}
+		}
+	case parser.ParameterTypeFloat:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return f, nil
+			}
+		}
+	case parser.ParameterTypeVarchar:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int64, float64, bool:
+			return fmt.Sprint(v), nil
+		}
+	case parser.ParameterTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return b, nil
+			}
+		}
+	case parser.ParameterTypeDate, parser.ParameterTypeTimestamp:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("parameter $%d: cannot use %v (%T) as %s", index, value, value, strings.ToLower(dataType))
+}
+
+// literalValue 创建参数值对应的字面量
+func literalValue(value interface{}) *LiteralValue {
+	switch value.(type) {
+	case int64:
+		return &LiteralValue{Type: LiteralTypeInteger, Value: value}
+	case float64:
+		return &LiteralValue{Type: LiteralTypeFloat, Value: value}
+	case bool:
+		return &LiteralValue{Type: LiteralTypeBoolean, Value: value}
+	}
+	return &LiteralValue{Type: LiteralTypeString, Value: value}
+}
+
+// parameterBinder 复制计划树并替换参数
+type parameterBinder struct {
+	values []interface{}
+}
+
+func (b *parameterBinder) plan(plan *Plan) (*Plan, error) {
+	if plan == nil {
+		return nil, nil
+	}
+	bound := &Plan{Type: plan.Type, Children: make([]*Plan, len(plan.Children))}
+	for i, child := range plan.Children {
+		c, err := b.plan(child)
+		if err != nil {
+			return nil, err
+		}
+		bound.Children[i] = c
+	}
+
+	switch props := plan.Properties.(type) {
+	case *SelectProperties:
+		c := *props
+		c.Columns = b.columns(props.Columns)
+		bound.Properties = &c
+	case *ProjectionProperties:
+		c := *props
+		c.Columns = b.columns(props.Columns)
+		bound.Properties = &c
+	case *TableScanProperties:
+		c := *props
+		c.Columns = b.columns(props.Columns)
+		bound.Properties = &c
+	case *FilterProperties:
+		bound.Properties = &FilterProperties{Condition: b.expr(props.Condition)}
+	case *HavingProperties:
+		bound.Properties = &HavingProperties{Condition: b.expr(props.Condition)}
+	case *JoinProperties:
+		c := *props
+		c.Condition = b.expr(props.Condition)
+		bound.Properties = &c
+	case *OrderByProperties:
+		keys := make([]OrderKey, len(props.OrderKeys))
+		for i, key := range props.OrderKeys {
+			keys[i] = key
+			keys[i].Expression = b.expr(key.Expression)
+		}
+		bound.Properties = &OrderByProperties{OrderKeys: keys}
+	case *GroupByProperties:
+		c := *props
+		c.GroupKeys = b.columns(props.GroupKeys)
+		c.SelectColumns = b.columns(props.SelectColumns)
+		c.Aggregations = make([]AggregateExpr, len(props.Aggregations))
+		for i, agg := range props.Aggregations {
+			c.Aggregations[i] = agg
+			c.Aggregations[i].Expr = b.expr(agg.Expr)
+		}
+		bound.Properties = &c
+	case *InsertProperties:
+		c := *props
+		c.Values = b.exprs(props.Values)
+		if props.Rows != nil {
+			c.Rows = make([][]Expression, len(props.Rows))
+			for i, row := range props.Rows {
+				c.Rows[i] = b.exprs(row)
+			}
+		}
+		bound.Properties = &c
+	case *UpdateProperties:
+		c := *props
+		c.Assignments = make(map[string]interface{}, len(props.Assignments))
+		for column, value := range props.Assignments {
+			v, err := b.node(value)
+			if err != nil {
+				return nil, err
+			}
+			c.Assignments[column] = v
+		}
+		where, err := b.node(props.Where)
+		if err != nil {
+			return nil, err
+		}
+		c.Where = where
+		bound.Properties = &c
+	case *DeleteProperties:
+		c := *props
+		where, err := b.node(props.Where)
+		if err != nil {
+			return nil, err
+		}
+		c.Where = where
+		bound.Properties = &c
+	default:
+		bound.Properties = plan.Properties
+	}
+	return bound, nil
+}
+
+// columns 复制列引用并替换其中的参数
+func (b *parameterBinder) columns(refs []ColumnRef) []ColumnRef {
+	if refs == nil {
+		return nil
+	}
+	bound := make([]ColumnRef, len(refs))
+	for i, ref := range refs {
+		bound[i] = ref
+		bound[i].Expression = b.expr(ref.Expression)
+		bound[i].FunctionArgs = b.exprs(ref.FunctionArgs)
+	}
+	return bound
+}
+
+func (b *parameterBinder) exprs(exprs []Expression) []Expression {
+	if exprs == nil {
+		return nil
+	}
+	bound := make([]Expression, len(exprs))
+	for i, e := range exprs {
+		bound[i] = b.expr(e)
+	}
+	return bound
+}
+
+// expr 复制表达式并把 ParameterRef 替换为字面量
+func (b *parameterBinder) expr(e Expression) Expression {
+	switch v := e.(type) {
+	case *ParameterRef:
+		return literalValue(b.values[v.Index-1])
+	case *BinaryExpression:
+		return &BinaryExpression{Left: b.expr(v.Left), Operator: v.Operator, Right: b.expr(v.Right)}
+	case *FunctionCall:
+		return &FunctionCall{Name: v.Name, Args: b.exprs(v.Args)}
+	}
+	return e
+}
+
+// node 替换 UPDATE/DELETE 计划中保留的 AST 表达式里的参数
+func (b *parameterBinder) node(value interface{}) (interface{}, error) {
+	node, ok := value.(parser.Node)
+	if !ok {
+		return value, nil
+	}
+	var err error
+	bound := parser.SubstituteParameters(node, func(param *parser.Parameter) parser.Node {
+		lit, litErr := parser.NewLiteral(b.values[param.Index-1])
+		if litErr != nil && err == nil {
+			err = litErr
+		}
+		return lit
+	})
+	return bound, err
+}
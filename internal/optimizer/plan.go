@@ -2,6 +2,10 @@ package optimizer
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yyun543/minidb/internal/parser"
 )
 
 // PlanType 定义了查询计划节点的类型
@@ -32,6 +36,28 @@ const (
 	ShowPlan
 	ExplainPlan
 	AnalyzePlan
+	SetPlan
+	AlterTablePlan
+	CopyPlan
+	ExportTablePlan
+	ImportTablePlan
+	RestoreTablePlan
+	CloneTablePlan
+	BackupDatabasePlan
+	RestoreDatabasePlan
+	VacuumPlan
+	DescribePlan
+	ShowCreateTablePlan
+	CommentPlan
+	CreateRolePlan
+	DropRolePlan
+	GrantPlan
+	KillPlan
+	PreparePlan
+	ExecutePlan
+	DeallocatePlan
+	ShowVariablePlan
+	ResetPlan
 )
 
 // String 返回 PlanType 的字符串描述，便于调试和日志记录
@@ -83,6 +109,50 @@ func (pt PlanType) String() string {
 		return "Explain"
 	case AnalyzePlan:
 		return "Analyze"
+	case SetPlan:
+		return "Set"
+	case AlterTablePlan:
+		return "AlterTable"
+	case CopyPlan:
+		return "Copy"
+	case ExportTablePlan:
+		return "ExportTable"
+	case ImportTablePlan:
+		return "ImportTable"
+	case RestoreTablePlan:
+		return "RestoreTable"
+	case CloneTablePlan:
+		return "CloneTable"
+	case BackupDatabasePlan:
+		return "BackupDatabase"
+	case RestoreDatabasePlan:
+		return "RestoreDatabase"
+	case VacuumPlan:
+		return "Vacuum"
+	case DescribePlan:
+		return "Describe"
+	case ShowCreateTablePlan:
+		return "ShowCreateTable"
+	case CommentPlan:
+		return "Comment"
+	case CreateRolePlan:
+		return "CreateRole"
+	case DropRolePlan:
+		return "DropRole"
+	case GrantPlan:
+		return "Grant"
+	case KillPlan:
+		return "Kill"
+	case PreparePlan:
+		return "Prepare"
+	case ExecutePlan:
+		return "Execute"
+	case DeallocatePlan:
+		return "Deallocate"
+	case ShowVariablePlan:
+		return "ShowVariable"
+	case ResetPlan:
+		return "Reset"
 	default:
 		return "Unknown"
 	}
@@ -171,15 +241,41 @@ func (pp *ProjectionProperties) Explain() string {
 
 // TableScanProperties 用于表扫描计划
 type TableScanProperties struct {
-	Table      string      // 表名
-	TableAlias string      // 表别名
-	Columns    []ColumnRef // 需要扫描的列
+	Table      string         // 表名
+	TableAlias string         // 表别名
+	Columns    []ColumnRef    // 需要扫描的列
+	Function   *TableFunction // 表函数 (FROM table_changes(...))，非空时 Table 为函数名
 }
 
 func (tp *TableScanProperties) Explain() string {
+	if tp.Function != nil {
+		return fmt.Sprintf("Function: %s", tp.Function)
+	}
 	return fmt.Sprintf("Table: %s", tp.Table)
 }
 
+// TableFunction 表函数调用
+type TableFunction struct {
+	Name string        // 函数名（小写）
+	Args []interface{} // 字面量参数
+}
+
+// String 返回表函数调用的 SQL 形式
+func (tf *TableFunction) String() string {
+	args := make([]string, len(tf.Args))
+	for i, arg := range tf.Args {
+		switch v := arg.(type) {
+		case string:
+			args[i] = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+		case nil:
+			args[i] = "NULL"
+		default:
+			args[i] = fmt.Sprint(v)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", tf.Name, strings.Join(args, ", "))
+}
+
 // FilterProperties 用于过滤（WHERE）条件计划
 type FilterProperties struct {
 	Condition Expression // 条件表达式
@@ -351,6 +447,16 @@ const (
 	LiteralTypeBoolean
 )
 
+// ParameterRef 预处理语句的参数占位符 $n，执行前由 BindParameters 替换为字面量
+type ParameterRef struct {
+	Index    int    // 参数序号，从 1 开始
+	DataType string // 声明的参数类型，未声明时为空
+}
+
+func (e *ParameterRef) String() string {
+	return fmt.Sprintf("$%d", e.Index)
+}
+
 // Asterisk 表示 * 通配符
 type Asterisk struct{}
 
@@ -369,8 +475,50 @@ func (p *CreateDatabaseProperties) Explain() string {
 
 // CreateTableProperties 用于 CREATE TABLE 计划
 type CreateTableProperties struct {
-	Table   string
-	Columns []ColumnDef // 改用 ColumnDef 保存完整的列定义
+	Table      string
+	Columns    []ColumnDef       // 改用 ColumnDef 保存完整的列定义
+	Options    map[string]string // 表级选项 (Parquet 写入选项等)
+	Partition  *PartitionClause  // 分区方式 (PARTITION BY ...)，未分区时为 nil
+	External   *ExternalClause   // 外部表的数据位置和格式，普通表为 nil
+	PrimaryKey []string          // 主键列 (列级和表级 PRIMARY KEY 约束)
+}
+
+// ExternalClause 外部表的数据位置和文件格式
+type ExternalClause struct {
+	Location string
+	Format   string
+	Options  map[string]string
+}
+
+// PartitionClause 分区方式
+type PartitionClause struct {
+	Type       string         // HASH / RANGE / LIST
+	Columns    []string       // 分区键列
+	Buckets    int            // HASH 分区数，0 表示默认值
+	Partitions []PartitionDef // RANGE / LIST 显式分区定义
+}
+
+// PartitionDef 显式分区定义
+type PartitionDef struct {
+	Name     string
+	LessThan interface{}   // RANGE 上界(不含)
+	MaxValue bool          // RANGE 上界为 MAXVALUE
+	Values   []interface{} // LIST 值列表
+}
+
+func (c *PartitionClause) String() string {
+	desc := fmt.Sprintf("%s(%s)", c.Type, strings.Join(c.Columns, ", "))
+	if c.Buckets > 0 {
+		desc += fmt.Sprintf(" PARTITIONS %d", c.Buckets)
+	}
+	if len(c.Partitions) > 0 {
+		names := make([]string, len(c.Partitions))
+		for i, def := range c.Partitions {
+			names[i] = def.Name
+		}
+		desc += fmt.Sprintf(" [%s]", strings.Join(names, ", "))
+	}
+	return desc
 }
 
 // ColumnDef 定义列属性
@@ -383,7 +531,34 @@ type ColumnDef struct {
 }
 
 func (p *CreateTableProperties) Explain() string {
-	return fmt.Sprintf("Table: %s, Columns: %d", p.Table, len(p.Columns))
+	desc := fmt.Sprintf("Table: %s, Columns: %d", p.Table, len(p.Columns))
+	if p.Partition != nil {
+		desc += ", Partition: " + p.Partition.String()
+	}
+	if p.External != nil {
+		desc += fmt.Sprintf(", External: %s '%s'", p.External.Format, p.External.Location)
+		if len(p.External.Options) > 0 {
+			desc += " (" + formatOptions(p.External.Options) + ")"
+		}
+	}
+	if len(p.Options) == 0 {
+		return desc
+	}
+	return fmt.Sprintf("%s, Options: %s", desc, formatOptions(p.Options))
+}
+
+// formatOptions 按选项名排序输出 name=value 列表
+func formatOptions(options map[string]string) string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%s", name, options[name])
+	}
+	return strings.Join(parts, ", ")
 }
 
 // DropDatabaseProperties 用于 DROP DATABASE 计划
@@ -464,10 +639,15 @@ func (p *ShowProperties) Explain() string {
 
 // ExplainProperties 用于 EXPLAIN 计划
 type ExplainProperties struct {
-	Query *Plan // 要解释的查询计划
+	Query   *Plan  // 要解释的查询计划
+	Analyze bool   // 执行查询并输出各算子的实际运行统计
+	Format  string // 输出格式 (TEXT/JSON)
 }
 
 func (p *ExplainProperties) Explain() string {
+	if p.Analyze {
+		return fmt.Sprintf("Query Plan (ANALYZE, FORMAT %s):\n%s", p.Format, p.Query.Explain("  "))
+	}
 	return fmt.Sprintf("Query Plan:\n%s", p.Query.Explain("  "))
 }
 
@@ -483,3 +663,359 @@ func (p *AnalyzeProperties) Explain() string {
 	}
 	return fmt.Sprintf("ANALYZE TABLE %s (columns: %v)", p.Table, p.Columns)
 }
+
+// SetProperties SET 会话变量语句的属性
+type SetProperties struct {
+	Variable string      // 变量名
+	Value    interface{} // 变量值
+}
+
+func (p *SetProperties) Explain() string {
+	return fmt.Sprintf("SET %s = %v", p.Variable, p.Value)
+}
+
+// ShowVariableProperties SHOW 会话变量语句的属性
+type ShowVariableProperties struct {
+	Variable string // 变量名
+	All      bool   // 列出全部会话变量
+}
+
+func (p *ShowVariableProperties) Explain() string {
+	if p.All {
+		return "SHOW ALL"
+	}
+	return fmt.Sprintf("SHOW %s", p.Variable)
+}
+
+// ResetProperties RESET 会话变量语句的属性
+type ResetProperties struct {
+	Variable string // 变量名
+	All      bool   // 恢复全部会话变量
+}
+
+func (p *ResetProperties) Explain() string {
+	if p.All {
+		return "RESET ALL"
+	}
+	return fmt.Sprintf("RESET %s", p.Variable)
+}
+
+// AlterTableProperties ALTER TABLE 语句的属性
+type AlterTableProperties struct {
+	Table           string
+	Action          string                 // 变更操作 (DROP PARTITION / SET TBLPROPERTIES / UNSET TBLPROPERTIES)
+	PartitionName   string                 // DROP PARTITION: 分区名
+	PartitionValues map[string]interface{} // DROP PARTITION: 分区列取值
+	Properties      map[string]string      // SET TBLPROPERTIES: 设置的表属性
+	PropertyNames   []string               // UNSET TBLPROPERTIES: 删除的属性名
+}
+
+func (p *AlterTableProperties) Explain() string {
+	switch p.Action {
+	case parser.AlterTableSetTableProperties:
+		names := make([]string, 0, len(p.Properties))
+		for name := range p.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		props := make([]string, len(names))
+		for i, name := range names {
+			props[i] = fmt.Sprintf("'%s' = '%s'", name, p.Properties[name])
+		}
+		return fmt.Sprintf("ALTER TABLE %s %s (%s)", p.Table, p.Action, strings.Join(props, ", "))
+	case parser.AlterTableUnsetTableProperties:
+		return fmt.Sprintf("ALTER TABLE %s %s ('%s')", p.Table, p.Action, strings.Join(p.PropertyNames, "', '"))
+	}
+	if p.PartitionName != "" {
+		return fmt.Sprintf("ALTER TABLE %s %s %s", p.Table, p.Action, p.PartitionName)
+	}
+	columns := make([]string, 0, len(p.PartitionValues))
+	for col := range p.PartitionValues {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+	values := make([]string, len(columns))
+	for i, col := range columns {
+		values[i] = fmt.Sprintf("%s = %v", col, p.PartitionValues[col])
+	}
+	return fmt.Sprintf("ALTER TABLE %s %s (%s)", p.Table, p.Action, strings.Join(values, ", "))
+}
+
+// CopyProperties COPY 导入/导出语句的属性
+type CopyProperties struct {
+	Table     string            // 导入的目标表
+	Columns   []string          // 导入的列，为空时使用表的全部列
+	Query     *Plan             // 导出的查询计划
+	Direction string            // FROM / TO
+	Path      string            // 文件路径
+	Options   map[string]string // WITH 选项
+}
+
+func (p *CopyProperties) Explain() string {
+	target := p.Table
+	if len(p.Columns) > 0 {
+		target += fmt.Sprintf(" (%s)", strings.Join(p.Columns, ", "))
+	}
+	if p.Query != nil {
+		target = "(query)"
+	}
+	desc := fmt.Sprintf("COPY %s %s '%s'", target, p.Direction, p.Path)
+	if len(p.Options) > 0 {
+		names := make([]string, 0, len(p.Options))
+		for name := range p.Options {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		opts := make([]string, len(names))
+		for i, name := range names {
+			opts[i] = fmt.Sprintf("%s = %s", name, p.Options[name])
+		}
+		desc += fmt.Sprintf(" WITH (%s)", strings.Join(opts, ", "))
+	}
+	return desc
+}
+
+// ExportTableProperties EXPORT TABLE 语句的属性
+type ExportTableProperties struct {
+	Table  string // 导出的表
+	Format string // 导出格式 (DELTA)
+}
+
+func (p *ExportTableProperties) Explain() string {
+	return fmt.Sprintf("EXPORT TABLE %s TO %s", p.Table, p.Format)
+}
+
+// ImportTableProperties IMPORT TABLE 语句的属性
+type ImportTableProperties struct {
+	Table  string // 新建的表
+	Format string // 源格式 (DELTA)
+	Path   string // 源表目录
+}
+
+func (p *ImportTableProperties) Explain() string {
+	return fmt.Sprintf("IMPORT TABLE %s FROM %s '%s'", p.Table, p.Format, p.Path)
+}
+
+// RestoreTableProperties RESTORE TABLE 语句的属性
+type RestoreTableProperties struct {
+	Table     string      // 回滚的表
+	Version   int64       // 目标版本
+	Timestamp interface{} // 目标时间，非空时按时间查找版本
+}
+
+func (p *RestoreTableProperties) Explain() string {
+	if p.Timestamp != nil {
+		return fmt.Sprintf("RESTORE TABLE %s TO TIMESTAMP AS OF %v", p.Table, p.Timestamp)
+	}
+	return fmt.Sprintf("RESTORE TABLE %s TO VERSION AS OF %d", p.Table, p.Version)
+}
+
+// CloneTableProperties CREATE TABLE ... SHALLOW CLONE 语句的属性
+type CloneTableProperties struct {
+	Table   string // 新建的表
+	Source  string // 源表
+	Version int64  // 源表版本，-1 表示最新版本
+}
+
+func (p *CloneTableProperties) Explain() string {
+	if p.Version >= 0 {
+		return fmt.Sprintf("CREATE TABLE %s SHALLOW CLONE %s VERSION AS OF %d", p.Table, p.Source, p.Version)
+	}
+	return fmt.Sprintf("CREATE TABLE %s SHALLOW CLONE %s", p.Table, p.Source)
+}
+
+// BackupDatabaseProperties BACKUP DATABASE 语句的属性
+type BackupDatabaseProperties struct {
+	Database        string // 备份的数据库
+	Path            string // 备份目录
+	IncrementalFrom string // 增量备份基于的备份目录
+}
+
+func (p *BackupDatabaseProperties) Explain() string {
+	if p.IncrementalFrom != "" {
+		return fmt.Sprintf("BACKUP DATABASE %s TO '%s' INCREMENTAL FROM '%s'", p.Database, p.Path, p.IncrementalFrom)
+	}
+	return fmt.Sprintf("BACKUP DATABASE %s TO '%s'", p.Database, p.Path)
+}
+
+// RestoreDatabaseProperties RESTORE DATABASE 语句的属性
+type RestoreDatabaseProperties struct {
+	Database string // 重建的数据库
+	Path     string // 备份目录
+	Version  int64  // 恢复到的版本，-1 表示备份的版本
+}
+
+func (p *RestoreDatabaseProperties) Explain() string {
+	if p.Version >= 0 {
+		return fmt.Sprintf("RESTORE DATABASE %s FROM '%s' AS OF VERSION %d", p.Database, p.Path, p.Version)
+	}
+	return fmt.Sprintf("RESTORE DATABASE %s FROM '%s'", p.Database, p.Path)
+}
+
+// DescribeProperties DESCRIBE 语句的属性
+type DescribeProperties struct {
+	Table    string // 表名
+	Extended bool   // 是否同时输出表的存储信息
+}
+
+func (p *DescribeProperties) Explain() string {
+	if p.Extended {
+		return fmt.Sprintf("DESCRIBE EXTENDED %s", p.Table)
+	}
+	return fmt.Sprintf("DESCRIBE %s", p.Table)
+}
+
+// ShowCreateTableProperties SHOW CREATE TABLE 语句的属性
+type ShowCreateTableProperties struct {
+	Table string // 表名
+}
+
+func (p *ShowCreateTableProperties) Explain() string {
+	return fmt.Sprintf("SHOW CREATE TABLE %s", p.Table)
+}
+
+// CommentProperties COMMENT ON 语句的属性
+type CommentProperties struct {
+	Table   string // 表名
+	Column  string // 列名，为空时为表注释
+	Comment string // 注释内容，为空时删除注释
+}
+
+func (p *CommentProperties) Explain() string {
+	target := "TABLE " + p.Table
+	if p.Column != "" {
+		target = fmt.Sprintf("COLUMN %s.%s", p.Table, p.Column)
+	}
+	if p.Comment == "" {
+		return fmt.Sprintf("COMMENT ON %s IS NULL", target)
+	}
+	return fmt.Sprintf("COMMENT ON %s IS '%s'", target, p.Comment)
+}
+
+// VacuumProperties VACUUM 语句的属性
+type VacuumProperties struct {
+	Table       string // 表名
+	RetainHours int64  // 保留期 (小时)，-1 表示使用默认保留期
+	DryRun      bool   // 只列出将被删除的文件
+}
+
+func (p *VacuumProperties) Explain() string {
+	desc := fmt.Sprintf("VACUUM %s", p.Table)
+	if p.RetainHours >= 0 {
+		desc += fmt.Sprintf(" RETAIN %d HOURS", p.RetainHours)
+	}
+	if p.DryRun {
+		desc += " DRY RUN"
+	}
+	return desc
+}
+
+// CreateRoleProperties CREATE USER / CREATE ROLE 语句的属性
+type CreateRoleProperties struct {
+	Name      string
+	Login     bool   // 用户 (可以登录) 还是角色
+	Password  string // 用户密码
+	Superuser bool
+}
+
+func (p *CreateRoleProperties) Explain() string {
+	if !p.Login {
+		return fmt.Sprintf("CREATE ROLE %s", p.Name)
+	}
+	desc := fmt.Sprintf("CREATE USER %s PASSWORD '***'", p.Name)
+	if p.Superuser {
+		desc += " SUPERUSER"
+	}
+	return desc
+}
+
+// DropRoleProperties DROP USER / DROP ROLE 语句的属性
+type DropRoleProperties struct {
+	Name     string
+	Login    bool
+	IfExists bool
+}
+
+func (p *DropRoleProperties) Explain() string {
+	kind := "ROLE"
+	if p.Login {
+		kind = "USER"
+	}
+	if p.IfExists {
+		return fmt.Sprintf("DROP %s IF EXISTS %s", kind, p.Name)
+	}
+	return fmt.Sprintf("DROP %s %s", kind, p.Name)
+}
+
+// KillProperties KILL QUERY / KILL SESSION 语句的属性
+type KillProperties struct {
+	Session bool  // 取消会话 (关闭连接) 而不是单条语句
+	ID      int64 // 语句 ID 或会话 ID
+}
+
+func (p *KillProperties) Explain() string {
+	if p.Session {
+		return fmt.Sprintf("KILL SESSION %d", p.ID)
+	}
+	return fmt.Sprintf("KILL QUERY %d", p.ID)
+}
+
+// PrepareProperties PREPARE 语句的属性
+type PrepareProperties struct {
+	Name       string      // 预处理语句名称
+	ParamTypes []string    // 各参数的类型
+	Statement  parser.Node // 含参数占位符的语句
+	Query      string      // 语句原文
+}
+
+func (p *PrepareProperties) Explain() string {
+	return fmt.Sprintf("PREPARE %s AS %s", p.Name, p.Query)
+}
+
+// ExecuteProperties EXECUTE 语句的属性
+type ExecuteProperties struct {
+	Name string        // 预处理语句名称
+	Args []interface{} // 参数值
+}
+
+func (p *ExecuteProperties) Explain() string {
+	return fmt.Sprintf("EXECUTE %s %v", p.Name, p.Args)
+}
+
+// DeallocateProperties DEALLOCATE 语句的属性
+type DeallocateProperties struct {
+	Name string // 预处理语句名称
+	All  bool   // 释放全部预处理语句
+}
+
+func (p *DeallocateProperties) Explain() string {
+	if p.All {
+		return "DEALLOCATE ALL"
+	}
+	return fmt.Sprintf("DEALLOCATE %s", p.Name)
+}
+
+// GrantProperties GRANT / REVOKE 语句的属性
+type GrantProperties struct {
+	Revoke     bool
+	Privileges []string // 表级权限，为空时为角色授予
+	Database   string   // 权限对象的数据库，"*" 表示全部数据库，为空时使用当前数据库
+	Table      string   // 权限对象的表，"*" 表示全部表
+	Roles      []string // 授予的角色
+	Grantees   []string // 被授予的用户或角色
+}
+
+func (p *GrantProperties) Explain() string {
+	verb, prep := "GRANT", "TO"
+	if p.Revoke {
+		verb, prep = "REVOKE", "FROM"
+	}
+	if len(p.Privileges) == 0 {
+		return fmt.Sprintf("%s %s %s %s", verb, strings.Join(p.Roles, ", "), prep, strings.Join(p.Grantees, ", "))
+	}
+	object := p.Table
+	if p.Database != "" {
+		object = p.Database + "." + p.Table
+	}
+	return fmt.Sprintf("%s %s ON %s %s %s", verb, strings.Join(p.Privileges, ", "), object, prep, strings.Join(p.Grantees, ", "))
+}
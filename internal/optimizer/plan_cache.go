@@ -0,0 +1,179 @@
+package optimizer
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/yyun543/minidb/internal/metrics"
+)
+
+// DefaultPlanCacheSize 每个会话缓存的计划数
+const DefaultPlanCacheSize = 128
+
+// PlanCache 会话级的查询计划缓存 (LRU)
+//
+// 键为当前数据库、参数类型和规范化后的 SQL (见 PlanCacheKey)，值为含参数占位符的优化后计划，
+// 以及生成计划时各引用表的 schema 版本。表的 schema 在 Delta Log 中变化后 (ALTER、DROP 后重建等)
+// 版本不再一致，缓存项失效并重新生成计划。
+type PlanCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // 最近使用的在前
+}
+
+// cachedPlan 缓存项
+type cachedPlan struct {
+	key      string
+	plan     *Plan
+	versions map[string]int64 // 表 ("db.table") -> 生成计划时的 schema 版本，表不存在时为 -1
+}
+
+// NewPlanCache 创建最多缓存 capacity 个计划的缓存，capacity <= 0 时使用 DefaultPlanCacheSize
+func NewPlanCache(capacity int) *PlanCache {
+	if capacity <= 0 {
+		capacity = DefaultPlanCacheSize
+	}
+	return &PlanCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get 返回 key 对应的计划；current 返回表当前的 schema 版本，任一引用表的版本变化时缓存项被移除
+// 返回的计划由缓存共享，执行前需经过 BindParameters 复制
+func (c *PlanCache) Get(key string, current func(table string) int64) (*Plan, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		metrics.PlanCacheRequests.WithLabelValues(metrics.PlanCacheMiss).Inc()
+		return nil, false
+	}
+	entry := elem.Value.(*cachedPlan)
+	for table, version := range entry.versions {
+		if current(table) != version {
+			c.order.Remove(elem)
+			delete(c.entries, key)
+			metrics.PlanCacheRequests.WithLabelValues(metrics.PlanCacheInvalidated).Inc()
+			return nil, false
+		}
+	}
+	c.order.MoveToFront(elem)
+	metrics.PlanCacheRequests.WithLabelValues(metrics.PlanCacheHit).Inc()
+	return entry.plan, true
+}
+
+// Put 缓存计划，versions 为生成计划时各引用表的 schema 版本
+func (c *PlanCache) Put(key string, plan *Plan, versions map[string]int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cachedPlan{key: key, plan: plan, versions: versions}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedPlan).key)
+	}
+}
+
+// Len 当前缓存的计划数
+func (c *PlanCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Clear 清空缓存
+func (c *PlanCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// PlanCacheKey 返回计划缓存的键：同一条 SQL 在不同的当前数据库下引用不同的表，参数类型决定绑定时的类型转换
+func PlanCacheKey(database string, paramTypes []string, sql string) string {
+	return database + "\x00" + strings.Join(paramTypes, ",") + "\x00" + NormalizeSQL(sql)
+}
+
+// NormalizeSQL 规范化 SQL 文本：字符串和带引号的标识符之外的连续空白合并为一个空格，并去掉首尾空白和末尾分号
+// 表名区分大小写，因此不改变大小写
+func NormalizeSQL(sql string) string {
+	var sb strings.Builder
+	sb.Grow(len(sql))
+	var quote rune
+	space := false
+	for _, r := range strings.TrimRight(strings.TrimSpace(sql), "; \t\r\n") {
+		if quote != 0 {
+			sb.WriteRune(r)
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		}
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// ReferencedTables 返回计划读写的表 (按名称排序，未限定数据库的表名保持原样)
+func ReferencedTables(plan *Plan) []string {
+	seen := make(map[string]bool)
+	var walk func(p *Plan)
+	walk = func(p *Plan) {
+		if p == nil {
+			return
+		}
+		switch props := p.Properties.(type) {
+		case *TableScanProperties:
+			if props.Function == nil {
+				seen[props.Table] = true
+			}
+		case *JoinProperties:
+			seen[props.Left] = true
+			seen[props.Right] = true
+		case *InsertProperties:
+			seen[props.Table] = true
+		case *UpdateProperties:
+			seen[props.Table] = true
+		case *DeleteProperties:
+			seen[props.Table] = true
+		}
+		for _, child := range p.Children {
+			walk(child)
+		}
+	}
+	walk(plan)
+
+	tables := make([]string, 0, len(seen))
+	for table := range seen {
+		if table != "" {
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
@@ -54,11 +54,23 @@ func (z *ZOrderOptimizer) OptimizeTable(tableID string, files []delta.FileInfo,
 		return fmt.Errorf("no files to optimize")
 	}
 
+	// Partitioned tables are clustered one partition at a time so that rewritten
+	// files stay in their partition directory
+	if groups := groupFilesByPartition(files); len(groups) > 1 {
+		for _, group := range groups {
+			if err := z.OptimizeTable(tableID, group, engine); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	// Extract db and table names from tableID
 	db, table := parseTableID(tableID)
 
 	// 1. Read all data from existing files
-	allRecords, err := z.readAllFiles(files)
+	store := parquetStoreOf(engine)
+	allRecords, err := z.readAllFiles(store, files)
 	if err != nil {
 		return fmt.Errorf("failed to read files: %w", err)
 	}
@@ -74,16 +86,17 @@ func (z *ZOrderOptimizer) OptimizeTable(tableID string, files []delta.FileInfo,
 		zap.Int("row_count", len(zOrderedRows)))
 
 	// 3. Repartition and write new files
-	basePath := "/tmp/minidb"
+	dataDir := partitionDataDir(files, tableDataDir(engine, db, table))
 	targetFileSize := int64(1024 * 1024 * 1024) // 1GB
-	newFiles := z.partitionAndWrite(tableID, db, table, zOrderedRows, allRecords[0].Schema(), basePath, targetFileSize)
+	opts := tableWriterOptions(engine, db, table, allRecords[0].Schema())
+	newFiles := z.partitionAndWrite(store, opts, tableID, db, table, zOrderedRows, allRecords[0].Schema(), dataDir, files[0].PartitionValues, targetFileSize)
 
 	// 4. Update Delta Log
 	deltaLog := engine.GetDeltaLog()
 
-	// Mark old files as REMOVE
+	// Mark old files as REMOVE; Z-ordering only rearranges rows (dataChange=false)
 	for _, file := range files {
-		if err := deltaLog.AppendRemove(tableID, file.Path); err != nil {
+		if err := deltaLog.AppendRearrangeRemove(tableID, file.Path); err != nil {
 			return fmt.Errorf("failed to mark file for removal: %w", err)
 		}
 	}
@@ -103,13 +116,22 @@ func (z *ZOrderOptimizer) OptimizeTable(tableID string, files []delta.FileInfo,
 	return nil
 }
 
+// tableWriterOptions returns the table-level Parquet writer options, falling back to
+// the options recorded in the data files' schema when the table schema is unavailable
+func tableWriterOptions(engine StorageEngine, db, table string, fileSchema *arrow.Schema) *parquet.WriterOptions {
+	if schema, err := engine.GetTableSchema(db, table); err == nil {
+		return parquet.WriterOptionsFromSchema(schema)
+	}
+	return parquet.WriterOptionsFromSchema(fileSchema)
+}
+
 // readAllFiles reads all Arrow records from Parquet files
-func (z *ZOrderOptimizer) readAllFiles(files []delta.FileInfo) ([]arrow.Record, error) {
+func (z *ZOrderOptimizer) readAllFiles(store parquet.ObjectStore, files []delta.FileInfo) ([]arrow.Record, error) {
 	records := make([]arrow.Record, 0, len(files))
 
 	for _, file := range files {
-		// Use parquet.ReadParquetFile with no filters to read entire file
-		record, err := parquet.ReadParquetFile(file.Path, nil)
+		// Read the entire file with no filters
+		record, err := parquet.ReadParquetFileFrom(store, file.Path, nil, 1)
 		if err != nil {
 			logger.Warn("Failed to read file, skipping",
 				zap.String("file", file.Path),
@@ -271,7 +293,7 @@ func (z *ZOrderOptimizer) normalizeValue(value interface{}, dataType arrow.DataT
 }
 
 // partitionAndWrite partitions Z-Ordered data into files
-func (z *ZOrderOptimizer) partitionAndWrite(tableID, db, table string, zOrderedRows []ZOrderedRow, schema *arrow.Schema, basePath string, targetFileSize int64) []*delta.ParquetFile {
+func (z *ZOrderOptimizer) partitionAndWrite(store parquet.ObjectStore, opts *parquet.WriterOptions, tableID, db, table string, zOrderedRows []ZOrderedRow, schema *arrow.Schema, dataDir string, partitionValues map[string]string, targetFileSize int64) []*delta.ParquetFile {
 	pool := memory.NewGoAllocator()
 	var newFiles []*delta.ParquetFile
 
@@ -291,7 +313,7 @@ func (z *ZOrderOptimizer) partitionAndWrite(tableID, db, table string, zOrderedR
 
 		// Write file if target size reached
 		if currentSize >= targetFileSize {
-			file := z.writePartitionFile(tableID, db, table, currentBuilder, basePath, fileIdx)
+			file := z.writePartitionFile(store, opts, tableID, db, table, currentBuilder, dataDir, partitionValues, fileIdx)
 			if file != nil {
 				newFiles = append(newFiles, file)
 				fileIdx++
@@ -304,7 +326,7 @@ func (z *ZOrderOptimizer) partitionAndWrite(tableID, db, table string, zOrderedR
 
 	// Write remaining data
 	if currentSize > 0 {
-		file := z.writePartitionFile(tableID, db, table, currentBuilder, basePath, fileIdx)
+		file := z.writePartitionFile(store, opts, tableID, db, table, currentBuilder, dataDir, partitionValues, fileIdx)
 		if file != nil {
 			newFiles = append(newFiles, file)
 		}
@@ -315,7 +337,7 @@ func (z *ZOrderOptimizer) partitionAndWrite(tableID, db, table string, zOrderedR
 }
 
 // writePartitionFile writes a single partition file
-func (z *ZOrderOptimizer) writePartitionFile(tableID, db, table string, builder *array.RecordBuilder, basePath string, fileIdx int) *delta.ParquetFile {
+func (z *ZOrderOptimizer) writePartitionFile(store parquet.ObjectStore, opts *parquet.WriterOptions, tableID, db, table string, builder *array.RecordBuilder, dataDir string, partitionValues map[string]string, fileIdx int) *delta.ParquetFile {
 	record := builder.NewRecord()
 	defer record.Release()
 
@@ -325,10 +347,10 @@ func (z *ZOrderOptimizer) writePartitionFile(tableID, db, table string, builder
 
 	// Generate file path
 	fileName := fmt.Sprintf("zorder-%s-%d.parquet", uuid.New().String()[:8], fileIdx)
-	filePath := filepath.Join(basePath, db, table, "data", fileName)
+	filePath := filepath.Join(dataDir, fileName)
 
-	// Write Parquet file
-	stats, err := parquet.WriteArrowBatch(filePath, record)
+	// Write Parquet file (honoring the table's writer options)
+	stats, err := parquet.WriteArrowBatchWithOptions(store, filePath, record, opts)
 	if err != nil {
 		logger.Error("Failed to write Z-Order partition file",
 			zap.String("file", filePath),
@@ -342,10 +364,12 @@ func (z *ZOrderOptimizer) writePartitionFile(tableID, db, table string, builder
 		zap.Int64("size", stats.FileSize))
 
 	return &delta.ParquetFile{
-		Path:     filePath,
-		Size:     stats.FileSize,
-		RowCount: stats.RowCount,
-		Stats:    stats,
+		Path:            filePath,
+		Size:            stats.FileSize,
+		RowCount:        stats.RowCount,
+		Stats:           stats,
+		PartitionValues: partitionValues,
+		Rearranged:      true,
 	}
 }
 
@@ -0,0 +1,162 @@
+package parquet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/parquet/file"
+	"github.com/apache/arrow/go/v18/parquet/metadata"
+	"github.com/apache/arrow/go/v18/parquet/pqarrow"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// ReadFooterStats 从 Parquet footer 中的列统计信息重建文件级统计 (不读取数据页)
+// 用于为早期未记录统计信息的数据文件补写 min/max/null_count，统计值类型与 collectStats 保持一致
+func ReadFooterStats(store ObjectStore, path string) (*delta.FileStats, error) {
+	r, err := storeOrLocal(store).GetReaderAt(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet file: %w", err)
+	}
+	reader, err := file.NewParquetReader(r)
+	if err != nil {
+		r.Close()
+		return nil, fmt.Errorf("failed to read parquet footer: %w", err)
+	}
+	defer reader.Close()
+
+	md := reader.MetaData()
+	arrowSchema, err := pqarrow.FromParquet(md.Schema, nil, md.KeyValueMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert parquet schema: %w", err)
+	}
+
+	stats := &delta.FileStats{
+		RowCount:   md.NumRows,
+		MinValues:  make(map[string]interface{}),
+		MaxValues:  make(map[string]interface{}),
+		NullCounts: make(map[string]int64),
+	}
+
+	// 任一 row group 缺少 min/max 的列不能用于文件级裁剪
+	incomplete := make(map[string]bool)
+	for rg := 0; rg < reader.NumRowGroups(); rg++ {
+		rgMeta := md.RowGroup(rg)
+		for col := 0; col < rgMeta.NumColumns(); col++ {
+			name := md.Schema.Column(col).Name()
+			fields, ok := arrowSchema.FieldsByName(name)
+			if !ok || len(fields) != 1 {
+				continue
+			}
+
+			chunk, err := rgMeta.ColumnChunk(col)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read column chunk metadata: %w", err)
+			}
+			if set, _ := chunk.StatsSet(); !set {
+				incomplete[name] = true
+				continue
+			}
+			colStats, err := chunk.Statistics()
+			if err != nil || colStats == nil {
+				incomplete[name] = true
+				continue
+			}
+
+			if colStats.HasNullCount() {
+				stats.NullCounts[name] += colStats.NullCount()
+			}
+			if !colStats.HasMinMax() {
+				incomplete[name] = true
+				continue
+			}
+			min, max, ok := footerMinMax(colStats, fields[0].Type)
+			if !ok {
+				incomplete[name] = true
+				continue
+			}
+			if cur, exists := stats.MinValues[name]; !exists || lessStatsValue(min, cur) {
+				stats.MinValues[name] = min
+			}
+			if cur, exists := stats.MaxValues[name]; !exists || lessStatsValue(cur, max) {
+				stats.MaxValues[name] = max
+			}
+		}
+	}
+
+	for name := range incomplete {
+		delete(stats.MinValues, name)
+		delete(stats.MaxValues, name)
+	}
+
+	logger.Debug("Rebuilt file stats from parquet footer",
+		zap.String("path", path),
+		zap.Int64("rows", stats.RowCount),
+		zap.Int("columns_with_min_max", len(stats.MinValues)))
+
+	return stats, nil
+}
+
+// footerMinMax 将 footer 中的物理类型统计值转换为与 Arrow 逻辑类型对应的 Go 值
+func footerMinMax(colStats metadata.TypedStatistics, dataType arrow.DataType) (interface{}, interface{}, bool) {
+	switch s := colStats.(type) {
+	case *metadata.Int64Statistics:
+		switch dt := dataType.(type) {
+		case *arrow.TimestampType:
+			return arrow.Timestamp(s.Min()).ToTime(dt.Unit).UTC(), arrow.Timestamp(s.Max()).ToTime(dt.Unit).UTC(), true
+		case *arrow.Date64Type:
+			return arrow.Date64(s.Min()).ToTime().UTC(), arrow.Date64(s.Max()).ToTime().UTC(), true
+		case *arrow.Int64Type:
+			return s.Min(), s.Max(), true
+		}
+	case *metadata.Int32Statistics:
+		switch dataType.ID() {
+		case arrow.INT32:
+			return s.Min(), s.Max(), true
+		case arrow.INT16:
+			return int16(s.Min()), int16(s.Max()), true
+		case arrow.INT8:
+			return int8(s.Min()), int8(s.Max()), true
+		case arrow.DATE32:
+			return arrow.Date32(s.Min()).ToTime().UTC(), arrow.Date32(s.Max()).ToTime().UTC(), true
+		}
+	case *metadata.Float64Statistics:
+		return s.Min(), s.Max(), true
+	case *metadata.Float32Statistics:
+		return s.Min(), s.Max(), true
+	case *metadata.BooleanStatistics:
+		return s.Min(), s.Max(), true
+	case *metadata.ByteArrayStatistics:
+		if dataType.ID() == arrow.STRING {
+			return string(s.Min()), string(s.Max()), true
+		}
+	}
+	return nil, nil, false
+}
+
+// lessStatsValue 比较两个同类型统计值 a < b
+func lessStatsValue(a, b interface{}) bool {
+	switch av := a.(type) {
+	case int64:
+		return av < b.(int64)
+	case int32:
+		return av < b.(int32)
+	case int16:
+		return av < b.(int16)
+	case int8:
+		return av < b.(int8)
+	case float64:
+		return av < b.(float64)
+	case float32:
+		return av < b.(float32)
+	case string:
+		return av < b.(string)
+	case bool:
+		return !av && b.(bool)
+	case time.Time:
+		return av.Before(b.(time.Time))
+	}
+	return false
+}
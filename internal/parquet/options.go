@@ -0,0 +1,195 @@
+package parquet
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	pq "github.com/apache/arrow/go/v18/parquet"
+	"github.com/apache/arrow/go/v18/parquet/compress"
+)
+
+// 表级写入选项名 (CREATE TABLE ... WITH (compression='zstd', ...))
+const (
+	OptionCompression       = "compression"
+	OptionRowGroupSize      = "row_group_size"
+	OptionDictionaryColumns = "dictionary_columns"
+)
+
+// writerOptionMetadataPrefix 写入选项保存在表 Arrow Schema 元数据中的键前缀，
+// 随 Delta Log 的 METADATA 记录一起持久化
+const writerOptionMetadataPrefix = "minidb.parquet."
+
+// DefaultCompression 未指定压缩算法时使用的默认编码
+const DefaultCompression = "snappy"
+
+// compressionCodecs 支持的压缩算法
+var compressionCodecs = map[string]compress.Compression{
+	"uncompressed": compress.Codecs.Uncompressed,
+	"none":         compress.Codecs.Uncompressed,
+	"snappy":       compress.Codecs.Snappy,
+	"gzip":         compress.Codecs.Gzip,
+	"brotli":       compress.Codecs.Brotli,
+	"zstd":         compress.Codecs.Zstd,
+	"lz4":          compress.Codecs.Lz4Raw,
+}
+
+// WriterOptions 表级 Parquet 写入选项
+// 由 INSERT、写缓冲刷写、Compaction 和 Z-Order 优化共同遵循
+type WriterOptions struct {
+	Compression       string   // 压缩算法: uncompressed/snappy/gzip/brotli/zstd/lz4
+	RowGroupSize      int64    // 单个 row group 的最大行数，0 表示使用默认值
+	DictionaryColumns []string // 启用字典编码的列；为空时所有列使用默认的字典编码
+}
+
+// ParseWriterOptions 解析并校验 WITH (...) 子句中的选项
+func ParseWriterOptions(options map[string]string) (*WriterOptions, error) {
+	opts := &WriterOptions{}
+	for key, value := range options {
+		switch strings.ToLower(key) {
+		case OptionCompression:
+			codec := strings.ToLower(strings.TrimSpace(value))
+			if _, ok := compressionCodecs[codec]; !ok {
+				return nil, fmt.Errorf("unsupported compression codec '%s' (supported: %s)",
+					value, strings.Join(supportedCompressionCodecs(), ", "))
+			}
+			opts.Compression = codec
+		case OptionRowGroupSize:
+			n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid %s '%s': must be a positive integer", OptionRowGroupSize, value)
+			}
+			opts.RowGroupSize = n
+		case OptionDictionaryColumns:
+			opts.DictionaryColumns = splitColumnList(value)
+		default:
+			return nil, fmt.Errorf("unknown table option '%s'", key)
+		}
+	}
+	return opts, nil
+}
+
+// AttachWriterOptions 校验选项并返回带有选项元数据的新 Schema
+func AttachWriterOptions(schema *arrow.Schema, opts *WriterOptions) (*arrow.Schema, error) {
+	if opts == nil {
+		return schema, nil
+	}
+	for _, col := range opts.DictionaryColumns {
+		if _, ok := schema.FieldsByName(col); !ok {
+			return nil, fmt.Errorf("dictionary column '%s' does not exist", col)
+		}
+	}
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	md := schema.Metadata()
+	for i, key := range md.Keys() {
+		if strings.HasPrefix(key, writerOptionMetadataPrefix) {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, md.Values()[i])
+	}
+	if opts.Compression != "" {
+		keys = append(keys, writerOptionMetadataPrefix+OptionCompression)
+		values = append(values, opts.Compression)
+	}
+	if opts.RowGroupSize > 0 {
+		keys = append(keys, writerOptionMetadataPrefix+OptionRowGroupSize)
+		values = append(values, strconv.FormatInt(opts.RowGroupSize, 10))
+	}
+	if len(opts.DictionaryColumns) > 0 {
+		keys = append(keys, writerOptionMetadataPrefix+OptionDictionaryColumns)
+		values = append(values, strings.Join(opts.DictionaryColumns, ","))
+	}
+
+	metadata := arrow.NewMetadata(keys, values)
+	return arrow.NewSchema(schema.Fields(), &metadata), nil
+}
+
+// WriterOptionsFromSchema 从表 Schema 元数据中读取写入选项，没有设置时返回 nil
+func WriterOptionsFromSchema(schema *arrow.Schema) *WriterOptions {
+	if schema == nil {
+		return nil
+	}
+	md := schema.Metadata()
+	options := make(map[string]string)
+	for i, key := range md.Keys() {
+		if name, ok := strings.CutPrefix(key, writerOptionMetadataPrefix); ok {
+			options[name] = md.Values()[i]
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	opts, err := ParseWriterOptions(options)
+	if err != nil {
+		return nil
+	}
+	return opts
+}
+
+// Options 以选项名 -> 值的形式返回已设置的选项
+func (o *WriterOptions) Options() map[string]string {
+	options := make(map[string]string)
+	if o == nil {
+		return options
+	}
+	if o.Compression != "" {
+		options[OptionCompression] = o.Compression
+	}
+	if o.RowGroupSize > 0 {
+		options[OptionRowGroupSize] = strconv.FormatInt(o.RowGroupSize, 10)
+	}
+	if len(o.DictionaryColumns) > 0 {
+		options[OptionDictionaryColumns] = strings.Join(o.DictionaryColumns, ",")
+	}
+	return options
+}
+
+// writerProperties 构造 Parquet writer 属性
+// 始终开启列统计信息，使 footer 中的 min/max/null_count 可供其他引擎做裁剪
+func (o *WriterOptions) writerProperties() *pq.WriterProperties {
+	codec := DefaultCompression
+	if o != nil && o.Compression != "" {
+		codec = o.Compression
+	}
+
+	props := []pq.WriterProperty{
+		pq.WithCompression(compressionCodecs[codec]),
+		pq.WithStats(true),
+	}
+	if o != nil && o.RowGroupSize > 0 {
+		props = append(props, pq.WithMaxRowGroupLength(o.RowGroupSize))
+	}
+	if o != nil && len(o.DictionaryColumns) > 0 {
+		props = append(props, pq.WithDictionaryDefault(false))
+		for _, col := range o.DictionaryColumns {
+			props = append(props, pq.WithDictionaryFor(col, true))
+		}
+	}
+	return pq.NewWriterProperties(props...)
+}
+
+// splitColumnList 解析逗号分隔的列名列表
+func splitColumnList(value string) []string {
+	columns := make([]string, 0)
+	for _, col := range strings.Split(value, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// supportedCompressionCodecs 返回排序后的压缩算法名
+func supportedCompressionCodecs() []string {
+	names := make([]string, 0, len(compressionCodecs))
+	for name := range compressionCodecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -3,7 +3,7 @@ package parquet
 import (
 	"context"
 	"fmt"
-	"os"
+	"sync"
 
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
@@ -24,12 +24,56 @@ type Filter struct {
 
 // ReadParquetFile 读取 Parquet 文件并返回 Arrow Record (使用 Arrow 原生 reader)
 func ReadParquetFile(path string, filters []Filter) (arrow.Record, error) {
+	return ReadParquetFileFrom(localFS{}, path, filters, 1)
+}
+
+// ReadParquetFileParallel 以指定并行度读取本地 Parquet 文件
+func ReadParquetFileParallel(path string, filters []Filter, parallelism int) (arrow.Record, error) {
+	return ReadParquetFileFrom(localFS{}, path, filters, parallelism)
+}
+
+// ReadRowGroups 逐个 row group 读取本地 Parquet 文件，每个 row group 的数据交给 fn 处理
+// 用于流式导入外部文件，fn 返回的错误会中止读取；fn 不应保留 record
+func ReadRowGroups(path string, fn func(arrow.Record) error) error {
+	f, err := localFS{}.GetReaderAt(path)
+	if err != nil {
+		return fmt.Errorf("failed to open parquet file: %w", err)
+	}
+	reader, err := file.NewParquetReader(f)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to create parquet reader: %w", err)
+	}
+	numRowGroups := reader.NumRowGroups()
+	reader.Close()
+	f.Close()
+
+	for rg := 0; rg < numRowGroups; rg++ {
+		record, err := readRowGroup(localFS{}, path, rg)
+		if err != nil {
+			return err
+		}
+		err = fn(record)
+		record.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadParquetFileFrom 以指定并行度读取对象存储中的 Parquet 文件
+// parallelism > 1 时按 row group 并行读取（每个 row group 内部的列也并行解码）
+func ReadParquetFileFrom(store ObjectStore, path string, filters []Filter, parallelism int) (arrow.Record, error) {
 	logger.Info("Reading Parquet file",
 		zap.String("path", path),
-		zap.Int("filters", len(filters)))
+		zap.Int("filters", len(filters)),
+		zap.Int("parallelism", parallelism))
+
+	store = storeOrLocal(store)
 
 	// 打开文件
-	f, err := os.Open(path)
+	f, err := store.GetReaderAt(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open parquet file: %w", err)
 	}
@@ -42,8 +86,13 @@ func ReadParquetFile(path string, filters []Filter) (arrow.Record, error) {
 	}
 	defer reader.Close()
 
+	// 多个 row group 时并行读取
+	if parallelism > 1 && reader.NumRowGroups() > 1 {
+		return readRowGroupsParallel(store, path, reader.NumRowGroups(), filters, parallelism)
+	}
+
 	// 创建 Arrow file reader
-	arrowReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	arrowReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{Parallel: parallelism > 1}, memory.DefaultAllocator)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create arrow file reader: %w", err)
 	}
@@ -151,6 +200,136 @@ func ReadParquetFile(path string, filters []Filter) (arrow.Record, error) {
 	return mergedRecord, nil
 }
 
+// readRowGroupsParallel 并行读取各个 row group，按原顺序合并后应用过滤条件
+// 每个 worker 使用独立的文件句柄和 reader，避免共享 reader 的并发问题
+func readRowGroupsParallel(store ObjectStore, path string, numRowGroups int, filters []Filter, parallelism int) (arrow.Record, error) {
+	records := make([]arrow.Record, numRowGroups)
+	errs := make([]error, numRowGroups)
+
+	rowGroups := make(chan int, numRowGroups)
+	for rg := 0; rg < numRowGroups; rg++ {
+		rowGroups <- rg
+	}
+	close(rowGroups)
+
+	workers := parallelism
+	if workers > numRowGroups {
+		workers = numRowGroups
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rg := range rowGroups {
+				records[rg], errs[rg] = readRowGroup(store, path, rg)
+			}
+		}()
+	}
+	wg.Wait()
+
+	release := func() {
+		for _, rec := range records {
+			if rec != nil {
+				rec.Release()
+			}
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	mergedRecord, err := mergeRecords(records)
+	release()
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge row groups: %w", err)
+	}
+
+	logger.Info("Parquet file read (parallel)",
+		zap.String("path", path),
+		zap.Int64("rows", mergedRecord.NumRows()),
+		zap.Int("row_groups", numRowGroups),
+		zap.Int("workers", workers))
+
+	if len(filters) > 0 {
+		filteredRecord, err := applyFilters(mergedRecord, filters)
+		mergedRecord.Release()
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply filters: %w", err)
+		}
+		return filteredRecord, nil
+	}
+
+	return mergedRecord, nil
+}
+
+// readRowGroup 读取单个 row group 为一个 Record
+func readRowGroup(store ObjectStore, path string, rowGroup int) (arrow.Record, error) {
+	f, err := store.GetReaderAt(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet file: %w", err)
+	}
+	defer f.Close()
+
+	reader, err := file.NewParquetReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet reader: %w", err)
+	}
+	defer reader.Close()
+
+	arrowReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{Parallel: true}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arrow file reader: %w", err)
+	}
+
+	// 列索引为 nil 时 pqarrow 不读取任何列，需要显式列出全部叶子列
+	columns := make([]int, reader.MetaData().Schema.NumColumns())
+	for i := range columns {
+		columns[i] = i
+	}
+
+	table, err := arrowReader.ReadRowGroups(context.Background(), columns, []int{rowGroup})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read row group %d: %w", rowGroup, err)
+	}
+	defer table.Release()
+
+	tr := array.NewTableReader(table, table.NumRows()+1)
+	defer tr.Release()
+
+	var records []arrow.Record
+	for tr.Next() {
+		rec := tr.Record()
+		rec.Retain()
+		records = append(records, rec)
+	}
+	if err := tr.Err(); err != nil {
+		for _, rec := range records {
+			rec.Release()
+		}
+		return nil, fmt.Errorf("failed to read records from row group %d: %w", rowGroup, err)
+	}
+
+	switch len(records) {
+	case 0:
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), table.Schema())
+		defer builder.Release()
+		return builder.NewRecord(), nil
+	case 1:
+		return records[0], nil
+	default:
+		merged, err := mergeRecords(records)
+		for _, rec := range records {
+			rec.Release()
+		}
+		return merged, err
+	}
+}
+
 // mergeRecords 合并多个 Arrow Records 为一个 Record
 // 使用 RecordBuilder 逐列合并数据
 func mergeRecords(records []arrow.Record) (arrow.Record, error) {
@@ -258,6 +437,11 @@ func appendColumn(builder array.Builder, sourceCol arrow.Array) error {
 	return nil
 }
 
+// ApplyFilters 对内存中的 Record 应用过滤条件，返回新的 Record (调用方负责释放)
+func ApplyFilters(record arrow.Record, filters []Filter) (arrow.Record, error) {
+	return applyFilters(record, filters)
+}
+
 // applyFilters 应用过滤条件到 Arrow Record (使用 Arrow Compute)
 // 注意：这是一个基础实现，支持简单的比较操作
 func applyFilters(record arrow.Record, filters []Filter) (arrow.Record, error) {
@@ -752,6 +936,30 @@ func copyFilteredColumn(builder array.Builder, sourceCol arrow.Array, mask []boo
 			}
 		}
 
+	case *array.BooleanBuilder:
+		srcArray := sourceCol.(*array.Boolean)
+		for i := 0; i < srcArray.Len(); i++ {
+			if mask[i] {
+				if srcArray.IsNull(i) {
+					builder.AppendNull()
+				} else {
+					builder.Append(srcArray.Value(i))
+				}
+			}
+		}
+
+	case *array.TimestampBuilder:
+		srcArray := sourceCol.(*array.Timestamp)
+		for i := 0; i < srcArray.Len(); i++ {
+			if mask[i] {
+				if srcArray.IsNull(i) {
+					builder.AppendNull()
+				} else {
+					builder.Append(srcArray.Value(i))
+				}
+			}
+		}
+
 	default:
 		return fmt.Errorf("unsupported column type for filtering: %T", builder)
 	}
@@ -0,0 +1,77 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/yyun543/minidb/internal/metrics"
+	"github.com/yyun543/minidb/internal/objectstore"
+)
+
+// ObjectStore Parquet 文件读写所需的对象存储能力
+// 存储引擎通过它把数据文件放到本地磁盘或 S3 兼容存储上
+type ObjectStore interface {
+	GetWriter(path string) (io.WriteCloser, error)
+	GetReaderAt(path string) (objectstore.ReadAtSeekCloser, error)
+}
+
+// localFS 直接按路径读写本地文件，供基于路径的 WriteArrowBatch / ReadParquetFile 使用
+type localFS struct{}
+
+// GetWriter 创建本地文件 (自动创建父目录)
+func (localFS) GetWriter(path string) (io.WriteCloser, error) {
+	if err := ensureDir(path); err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet file: %w", err)
+	}
+	return file, nil
+}
+
+// GetReaderAt 打开本地文件
+func (localFS) GetReaderAt(path string) (objectstore.ReadAtSeekCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// storeOrLocal nil 时退回本地文件系统
+func storeOrLocal(store ObjectStore) ObjectStore {
+	if store == nil {
+		return localFS{}
+	}
+	return store
+}
+
+// countingWriter 统计写入字节数，并在关闭前 fsync (底层支持时)
+type countingWriter struct {
+	w io.WriteCloser
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// Close 先刷盘再关闭，保证 footer 也已持久化；成功关闭的文件计入写入指标
+func (cw *countingWriter) Close() error {
+	if syncer, ok := cw.w.(interface{ Sync() error }); ok {
+		if err := syncer.Sync(); err != nil {
+			cw.w.Close()
+			return fmt.Errorf("failed to sync parquet file: %w", err)
+		}
+	}
+	if err := cw.w.Close(); err != nil {
+		return err
+	}
+	metrics.ParquetBytesWritten.Add(cw.n)
+	metrics.ParquetFilesWritten.Inc()
+	return nil
+}
@@ -3,6 +3,7 @@ package parquet
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
@@ -12,25 +13,34 @@ import (
 	"go.uber.org/zap"
 )
 
-// WriteArrowBatch 将 Arrow Batch 写入 Parquet 文件 (使用 Arrow 原生 Parquet writer)
+// WriteArrowBatch 将 Arrow Batch 写入本地 Parquet 文件 (使用 Arrow 原生 Parquet writer)
 func WriteArrowBatch(path string, batch arrow.Record) (*delta.FileStats, error) {
+	return WriteArrowBatchTo(localFS{}, path, batch)
+}
+
+// WriteArrowBatchTo 将 Arrow Batch 写入对象存储中的 Parquet 文件 (默认写入选项)
+func WriteArrowBatchTo(store ObjectStore, path string, batch arrow.Record) (*delta.FileStats, error) {
+	return WriteArrowBatchWithOptions(store, path, batch, nil)
+}
+
+// WriteArrowBatchWithOptions 按表级写入选项 (压缩、row group 大小、字典编码) 写入 Parquet 文件
+// opts 为 nil 时使用默认选项
+func WriteArrowBatchWithOptions(store ObjectStore, path string, batch arrow.Record, opts *WriterOptions) (*delta.FileStats, error) {
 	logger.Info("Writing Arrow batch to Parquet",
 		zap.String("path", path),
 		zap.Int64("rows", batch.NumRows()))
 
-	// 确保父目录存在
-	if err := ensureDir(path); err != nil {
-		return nil, err
-	}
-
 	// 收集统计信息
 	stats := collectStats(batch)
 
-	// 创建文件
-	file, err := os.Create(path)
+	// 创建对象写入器
+	sink, err := storeOrLocal(store).GetWriter(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create parquet file: %w", err)
 	}
+	// 根据架构文档: "file.Sync() 确保数据刷盘"
+	// countingWriter 在关闭前 fsync（本地文件），并记录文件大小
+	file := &countingWriter{w: sink}
 
 	// 使用 Arrow 的原生 Parquet writer
 	// Note: pqarrow.NewFileWriter takes ownership of the file handle
@@ -38,7 +48,7 @@ func WriteArrowBatch(path string, batch arrow.Record) (*delta.FileStats, error)
 	writer, err := pqarrow.NewFileWriter(
 		batch.Schema(),
 		file,
-		nil, // 使用默认的 Parquet 属性
+		opts.writerProperties(),
 		pqarrow.DefaultWriterProps(),
 	)
 	if err != nil {
@@ -52,26 +62,12 @@ func WriteArrowBatch(path string, batch arrow.Record) (*delta.FileStats, error)
 		return nil, fmt.Errorf("failed to write arrow record to parquet: %w", err)
 	}
 
-	// 根据架构文档: "file.Sync() 确保数据刷盘" (line 559)
-	// P0 优先级改进: 在关闭文件前调用 fsync() 确保数据持久化
-	// 注意：必须在 writer.Close() 之前调用 Sync()，因为 Close() 会关闭文件句柄
-	if err := file.Sync(); err != nil {
-		writer.Close()
-		return nil, fmt.Errorf("failed to sync parquet file: %w", err)
-	}
-
-	// 关闭 writer (会自动写入 footer 并关闭底层文件)
+	// 关闭 writer (会自动写入 footer、刷盘并关闭底层文件)
 	if err := writer.Close(); err != nil {
 		return nil, fmt.Errorf("failed to close parquet writer: %w", err)
 	}
 
-	// 获取文件大小
-	fileInfo, err := os.Stat(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to stat parquet file: %w", err)
-	}
-
-	stats.FileSize = fileInfo.Size()
+	stats.FileSize = file.n
 
 	logger.Info("Parquet file written successfully",
 		zap.String("path", path),
@@ -81,6 +77,40 @@ func WriteArrowBatch(path string, batch arrow.Record) (*delta.FileStats, error)
 	return stats, nil
 }
 
+// WriteArrowRecords 将多个相同 Schema 的 Arrow Record 写入同一个 Parquet 文件 (默认写入选项)，store 为 nil 时写入本地文件系统
+// 用于导出查询结果和 Delta Lake checkpoint，返回写入的行数
+func WriteArrowRecords(store ObjectStore, path string, schema *arrow.Schema, records []arrow.Record) (int64, error) {
+	sink, err := storeOrLocal(store).GetWriter(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create parquet file: %w", err)
+	}
+	file := &countingWriter{w: sink}
+
+	writer, err := pqarrow.NewFileWriter(schema, file, nil, pqarrow.DefaultWriterProps())
+	if err != nil {
+		file.Close()
+		return 0, fmt.Errorf("failed to create arrow parquet writer: %w", err)
+	}
+
+	rows := int64(0)
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			writer.Close()
+			return 0, fmt.Errorf("failed to write arrow record to parquet: %w", err)
+		}
+		rows += record.NumRows()
+	}
+	if err := writer.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+
+	logger.Info("Parquet file written successfully",
+		zap.String("path", path),
+		zap.Int64("size", file.n),
+		zap.Int64("rows", rows))
+	return rows, nil
+}
+
 // collectStats 收集列统计信息
 func collectStats(batch arrow.Record) *delta.FileStats {
 	stats := &delta.FileStats{
@@ -165,18 +195,12 @@ func collectStats(batch arrow.Record) *delta.FileStats {
 				stats.MaxValues[field.Name] = max
 			}
 
-		case arrow.DATE32, arrow.DATE64:
-			// 日期类型统计
-			if col.Len() > 0 && col.Len() > col.NullN() {
-				stats.MinValues[field.Name] = "date_min"
-				stats.MaxValues[field.Name] = "date_max"
-			}
-
-		case arrow.TIMESTAMP:
-			// 时间戳类型统计
+		case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP:
+			// 日期/时间戳统计 (以 UTC time.Time 保存，便于持久化和跨类型比较)
 			if col.Len() > 0 && col.Len() > col.NullN() {
-				stats.MinValues[field.Name] = "timestamp_min"
-				stats.MaxValues[field.Name] = "timestamp_max"
+				min, max := computeTimeMinMax(col)
+				stats.MinValues[field.Name] = min
+				stats.MaxValues[field.Name] = max
 			}
 
 		default:
@@ -435,6 +459,41 @@ func computeBooleanMinMax(arr *array.Boolean) (bool, bool) {
 	return min, max
 }
 
+func computeTimeMinMax(col arrow.Array) (time.Time, time.Time) {
+	var min, max time.Time
+	initialized := false
+
+	for i := 0; i < col.Len(); i++ {
+		if col.IsNull(i) {
+			continue
+		}
+
+		var val time.Time
+		switch arr := col.(type) {
+		case *array.Date32:
+			val = arr.Value(i).ToTime()
+		case *array.Date64:
+			val = arr.Value(i).ToTime()
+		case *array.Timestamp:
+			val = arr.Value(i).ToTime(arr.DataType().(*arrow.TimestampType).Unit)
+		}
+		if !initialized {
+			min = val
+			max = val
+			initialized = true
+		} else {
+			if val.Before(min) {
+				min = val
+			}
+			if val.After(max) {
+				max = val
+			}
+		}
+	}
+
+	return min.UTC(), max.UTC()
+}
+
 func ensureDir(path string) error {
 	// 获取文件所在的目录路径
 	dir := path
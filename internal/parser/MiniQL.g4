@@ -84,10 +84,83 @@ ROLLBACK: R O L L B A C K;
 HASH: H A S H;
 RANGE: R A N G E;
 
+// 会话变量相关关键字
+TO: T O;
+ALL: A L L;
+RESET: R E S E T;
+TIME: T I M E;
+ZONE: Z O N E;
+
+// 表分区和表属性相关关键字
+ALTER: A L T E R;
+WITH: W I T H;
+OF: O F;
+LIST: L I S T;
+PARTITIONS: P A R T I T I O N S;
+// LESS 已用作运算符 '<'
+LESS_KW: L E S S;
+THAN: T H A N;
+MAXVALUE: M A X V A L U E;
+TBLPROPERTIES: T B L P R O P E R T I E S;
+UNSET: U N S E T;
+SHALLOW: S H A L L O W;
+CLONE: C L O N E;
+VERSION: V E R S I O N;
+
+// 表结构和注释相关关键字
+DESCRIBE: D E S C R I B E;
+EXTENDED: E X T E N D E D;
+COMMENT: C O M M E N T;
+COLUMN: C O L U M N;
+IS: I S;
+
+// 用户和权限相关关键字
+USER: U S E R;
+ROLE: R O L E;
+PASSWORD: P A S S W O R D;
+SUPERUSER: S U P E R U S E R;
+NOSUPERUSER: N O S U P E R U S E R;
+GRANT: G R A N T;
+REVOKE: R E V O K E;
+PRIVILEGES: P R I V I L E G E S;
+IF: I F;
+EXISTS: E X I S T S;
+
+// 取消语句和会话相关关键字
+KILL: K I L L;
+QUERY: Q U E R Y;
+SESSION: S E S S I O N;
+CONNECTION: C O N N E C T I O N;
+
+// 备份与恢复相关关键字
+RESTORE: R E S T O R E;
+BACKUP: B A C K U P;
+INCREMENTAL: I N C R E M E N T A L;
+
+// 表维护相关关键字
+VACUUM: V A C U U M;
+RETAIN: R E T A I N;
+HOURS: H O U R S;
+DRY: D R Y;
+RUN: R U N;
+
+// 预处理语句相关关键字
+PREPARE: P R E P A R E;
+EXECUTE: E X E C U T E;
+DEALLOCATE: D E A L L O C A T E;
+
+// 数据导入导出相关关键字
+COPY: C O P Y;
+EXPORT: E X P O R T;
+IMPORT: I M P O R T;
+EXTERNAL: E X T E R N A L;
+LOCATION: L O C A T I O N;
+FORMAT: F O R M A T;
+
 // 运算符和标点符号
 ASTERISK: '*';
 EQUAL: '=';
-NOT_EQUAL: '!=';
+NOT_EQUAL: '!=' | '<>';
 GREATER: '>';
 GREATER_EQUAL: '>=';
 LESS: '<';
@@ -108,7 +181,10 @@ IDENTIFIER: [a-zA-Z_][a-zA-Z0-9_]*;
 // 字面量
 INTEGER_LITERAL: [0-9]+;
 FLOAT_LITERAL: [0-9]+ '.' [0-9]*;
-STRING_LITERAL: '\'' (~['\\] | '\\' .)* '\'';
+STRING_LITERAL: '\'' (~['\\] | '\\' . | '\'\'')* '\'';
+
+// 预处理语句的参数占位符 $1, $2, ...
+PARAM: '$' [0-9]+;
 
 // 空白字符
 WS: [ \t\r\n]+ -> skip;
@@ -157,6 +233,11 @@ sqlStatement
 ddlStatement
  : createDatabase
  | createTable
+ | cloneTable
+ | createExternalTable
+ | alterTable
+ | restoreTable
+ | commentStatement
  | createIndex
  | dropIndex
  | dropTable
@@ -175,6 +256,11 @@ dqlStatement
 
 dclStatement
  : transactionStatement
+ | createUser
+ | createRole
+ | dropRole
+ | grantStatement
+ | revokeStatement
  ;
 
 utilityStatement
@@ -182,8 +268,23 @@ utilityStatement
  | showDatabases
  | showTables
  | showIndexes
+ | showCreateTable
+ | describeTable
  | explainStatement
  | analyzeStatement
+ | setStatement
+ | showVariable
+ | resetStatement
+ | prepareStatement
+ | executeStatement
+ | deallocateStatement
+ | copyStatement
+ | exportTable
+ | importTable
+ | vacuumStatement
+ | killStatement
+ | backupDatabase
+ | restoreDatabase
  ;
 
 // DDL规则
@@ -194,7 +295,72 @@ createDatabase
 createTable
  : CREATE TABLE tableName
    LEFT_PAREN columnDef (COMMA columnDef)* (COMMA tableConstraint)* RIGHT_PAREN
-   (PARTITION BY partitionMethod)?
+   (PARTITION BY partitionMethod | WITH optionList)*
+ ;
+
+cloneTable
+ : CREATE TABLE tableName SHALLOW CLONE tableName (VERSION AS OF INTEGER_LITERAL)?
+ ;
+
+// 外部表：列定义可省略，由执行器根据数据文件推断表结构
+createExternalTable
+ : CREATE EXTERNAL TABLE tableName
+   (LEFT_PAREN columnDef (COMMA columnDef)* (COMMA tableConstraint)* RIGHT_PAREN)?
+   LOCATION STRING_LITERAL FORMAT (STRING_LITERAL | identifier) (WITH optionList)?
+ ;
+
+alterTable
+ : ALTER TABLE tableName SET TBLPROPERTIES LEFT_PAREN tableProperty (COMMA tableProperty)* RIGHT_PAREN
+ | ALTER TABLE tableName UNSET TBLPROPERTIES LEFT_PAREN propertyName (COMMA propertyName)* RIGHT_PAREN
+ | ALTER TABLE tableName DROP PARTITION identifier
+ | ALTER TABLE tableName DROP PARTITION LEFT_PAREN partitionValue (COMMA partitionValue)* RIGHT_PAREN
+ ;
+
+// 把表恢复到历史版本或时间点
+restoreTable
+ : RESTORE TABLE tableName TO VERSION AS OF INTEGER_LITERAL
+ | RESTORE TABLE tableName TO TIMESTAMP_TYPE AS OF (STRING_LITERAL | INTEGER_LITERAL)
+ ;
+
+// 设置或删除（IS NULL）表和列的注释
+commentStatement
+ : COMMENT ON TABLE tableName IS (STRING_LITERAL | NULL)
+ | COMMENT ON COLUMN identifier (DOT identifier)* IS (STRING_LITERAL | NULL)
+ ;
+
+tableProperty
+ : propertyName EQUAL optionValue
+ ;
+
+// 属性名可以是字符串或（带点的）标识符
+propertyName
+ : STRING_LITERAL
+ | identifier (DOT identifier)*
+ ;
+
+partitionValue
+ : identifier EQUAL signedLiteral
+ ;
+
+// 选项列表 (name = value, ...)，部分语句允许省略等号
+optionList
+ : LEFT_PAREN option (COMMA option)* RIGHT_PAREN
+ ;
+
+option
+ : optionName EQUAL? optionValue
+ ;
+
+// 选项名可以是 NULL（如 COPY ... WITH (null 'NA')）
+optionName
+ : identifier
+ | NULL
+ ;
+
+// 不带引号的单词按字符串处理
+optionValue
+ : signedLiteral
+ | identifier
  ;
 
 columnDef
@@ -228,6 +394,60 @@ dropDatabase
  : DROP DATABASE identifier
  ;
 
+// 用户和权限规则
+createUser
+ : CREATE USER identifier WITH? userOption*
+ ;
+
+userOption
+ : PASSWORD STRING_LITERAL
+ | SUPERUSER
+ | NOSUPERUSER
+ ;
+
+createRole
+ : CREATE ROLE identifier
+ ;
+
+dropRole
+ : DROP (USER | ROLE) (IF EXISTS)? identifier
+ ;
+
+// GRANT 权限 ON 对象 TO 用户或角色，或 GRANT 角色 TO 用户
+grantStatement
+ : GRANT privilegeList ON TABLE? grantObject TO identifierList
+ | GRANT identifierList TO identifierList
+ ;
+
+revokeStatement
+ : REVOKE privilegeList ON TABLE? grantObject FROM identifierList
+ | REVOKE identifierList FROM identifierList
+ ;
+
+privilegeList
+ : privilege (COMMA privilege)*
+ ;
+
+privilege
+ : SELECT
+ | INSERT
+ | UPDATE
+ | DELETE
+ | ALL PRIVILEGES?
+ | identifier
+ ;
+
+// 权限对象：db.table、db.*、*.*、table 或 *
+grantObject
+ : grantObjectPart (DOT grantObjectPart)?
+ ;
+
+grantObjectPart
+ : identifier
+ | DEFAULT
+ | ASTERISK
+ ;
+
 // DML规则
 insertStatement
  : INSERT INTO tableName (LEFT_PAREN identifierList RIGHT_PAREN)?
@@ -269,6 +489,12 @@ tableReference
 tableReferenceAtom
  : tableName ( AS? identifier )?                                      #tableRefBase
  | LEFT_PAREN selectStatement RIGHT_PAREN AS? identifier             #tableRefSubquery
+ | tableFunction ( AS? identifier )?                                  #tableRefFunction
+ ;
+
+// 表函数调用，参数为字面量，如 table_changes('db.t', 10, 20)
+tableFunction
+ : identifier LEFT_PAREN (signedLiteral (COMMA signedLiteral)*)? RIGHT_PAREN
  ;
 
 // JOIN类型
@@ -296,6 +522,7 @@ primaryExpr
  | columnRef                                                       #columnRefExpr
  | functionCall                                                    #functionCallExpr
  | LEFT_PAREN expression RIGHT_PAREN                              #parenExpr
+ | PARAM                                                           #parameterExpr
  ;
 
 comparisonOperator
@@ -330,8 +557,23 @@ functionCall
  ;
 
 partitionMethod
- : HASH LEFT_PAREN identifierList RIGHT_PAREN
- | RANGE LEFT_PAREN identifierList RIGHT_PAREN
+ : HASH LEFT_PAREN identifierList RIGHT_PAREN (PARTITIONS INTEGER_LITERAL)?
+ | RANGE LEFT_PAREN identifierList RIGHT_PAREN partitionDefinitions?
+ | LIST LEFT_PAREN identifierList RIGHT_PAREN partitionDefinitions?
+ ;
+
+partitionDefinitions
+ : LEFT_PAREN partitionDefinition (COMMA partitionDefinition)* RIGHT_PAREN
+ ;
+
+partitionDefinition
+ : PARTITION identifier VALUES LESS_KW THAN (LEFT_PAREN partitionBound RIGHT_PAREN | partitionBound)
+ | PARTITION identifier VALUES IN LEFT_PAREN signedLiteral (COMMA signedLiteral)* RIGHT_PAREN
+ ;
+
+partitionBound
+ : MAXVALUE
+ | signedLiteral
  ;
 
 // DCL语句（事务控制）
@@ -358,8 +600,24 @@ showIndexes
  : SHOW INDEXES (ON | FROM) tableName
  ;
 
+showCreateTable
+ : SHOW CREATE TABLE tableName
+ ;
+
+describeTable
+ : (DESCRIBE | DESC) TABLE? EXTENDED? tableName
+ ;
+
 explainStatement
- : EXPLAIN selectStatement
+ : EXPLAIN (ANALYZE | LEFT_PAREN explainOption (COMMA explainOption)* RIGHT_PAREN)? selectStatement
+ ;
+
+// 未识别的选项名由访问器报错
+explainOption
+ : ANALYZE (TRUE | FALSE)?
+ | FORMAT identifier
+ | VERBOSE
+ | identifier
  ;
 
 analyzeStatement
@@ -370,21 +628,166 @@ columnList
  : identifier (COMMA identifier)*
  ;
 
+// 会话变量语句
+setStatement
+ : SET (TIME ZONE | variableName (EQUAL | TO)) (DEFAULT | setValue)
+ ;
+
+showVariable
+ : SHOW (TIME ZONE | ALL | variableName)
+ ;
+
+resetStatement
+ : RESET (TIME ZONE | ALL | variableName)
+ ;
+
+variableName
+ : identifier (DOT identifier)*
+ ;
+
+// 预处理语句，语句体中的参数只能出现在表达式位置
+prepareStatement
+ : PREPARE identifier (LEFT_PAREN parameterType (COMMA parameterType)* RIGHT_PAREN)?
+   AS (dqlStatement | dmlStatement | ddlStatement)
+ ;
+
+// 参数类型的长度和精度（如 VARCHAR(20)、DECIMAL(10, 2)）不影响参数取值
+parameterType
+ : dataType
+ | identifier (LEFT_PAREN INTEGER_LITERAL (COMMA INTEGER_LITERAL)* RIGHT_PAREN)?
+ ;
+
+executeStatement
+ : EXECUTE identifier (LEFT_PAREN signedLiteral (COMMA signedLiteral)* RIGHT_PAREN)?
+ ;
+
+deallocateStatement
+ : DEALLOCATE PREPARE? (ALL | identifier)
+ ;
+
+// 从文件导入数据或把表、查询结果导出到文件
+copyStatement
+ : COPY tableName (LEFT_PAREN identifierList RIGHT_PAREN)? (FROM | TO) STRING_LITERAL (WITH optionList)?
+ | COPY LEFT_PAREN selectStatement RIGHT_PAREN (FROM | TO) STRING_LITERAL (WITH optionList)?
+ ;
+
+// 把表导出为其他表格式，或从其他表格式的目录导入新表
+exportTable
+ : EXPORT TABLE tableName TO tableFormat
+ ;
+
+importTable
+ : IMPORT TABLE tableName FROM tableFormat STRING_LITERAL
+ ;
+
+// 表格式名称（目前只支持 DELTA）
+tableFormat
+ : identifier
+ ;
+
+// 备份数据库到目录，增量备份只复制上一次备份之后新增的文件
+backupDatabase
+ : BACKUP DATABASE identifier TO STRING_LITERAL (INCREMENTAL FROM STRING_LITERAL)?
+ ;
+
+restoreDatabase
+ : RESTORE DATABASE identifier FROM STRING_LITERAL (AS OF VERSION INTEGER_LITERAL)?
+ ;
+
+// 取消正在执行的语句或断开会话
+killStatement
+ : KILL (QUERY | SESSION | CONNECTION) INTEGER_LITERAL
+ ;
+
+// 删除不再被引用且超过保留期的数据文件
+vacuumStatement
+ : VACUUM tableName (RETAIN INTEGER_LITERAL HOURS)? (DRY RUN)?
+ ;
+
+// 不带引号的单词按字符串处理（如 SET vectorized_execution = on）
+setValue
+ : signedLiteral
+ | identifier
+ | ON
+ | TABLE
+ ;
+
 // 辅助规则
 identifierList
  : identifier (COMMA identifier)*
  ;
 
 valueList
- : literal (COMMA literal)*
+ : valueItem (COMMA valueItem)*
+ ;
+
+valueItem
+ : literal
+ | PARAM
  ;
 
+// 默认数据库名 default 是关键字，作为表名前缀时单独列出
 tableName
  : identifier (DOT identifier)?
+ | DEFAULT DOT identifier
  ;
 
 identifier
  : IDENTIFIER
+ | nonReservedKeyword
+ ;
+
+// 可以用作标识符的关键字
+nonReservedKeyword
+ : RESET
+ | TIME
+ | ZONE
+ | LIST
+ | PARTITIONS
+ | LESS_KW
+ | THAN
+ | MAXVALUE
+ | TBLPROPERTIES
+ | UNSET
+ | SHALLOW
+ | CLONE
+ | VERSION
+ | DESCRIBE
+ | EXTENDED
+ | COMMENT
+ | COLUMN
+ | IS
+ | USER
+ | ROLE
+ | PASSWORD
+ | SUPERUSER
+ | NOSUPERUSER
+ | GRANT
+ | REVOKE
+ | PRIVILEGES
+ | IF
+ | EXISTS
+ | KILL
+ | QUERY
+ | SESSION
+ | CONNECTION
+ | RESTORE
+ | BACKUP
+ | INCREMENTAL
+ | VACUUM
+ | RETAIN
+ | HOURS
+ | DRY
+ | RUN
+ | PREPARE
+ | EXECUTE
+ | DEALLOCATE
+ | COPY
+ | EXPORT
+ | IMPORT
+ | EXTERNAL
+ | LOCATION
+ | FORMAT
  ;
 
 dataType
@@ -396,6 +799,11 @@ dataType
  | TIMESTAMP_TYPE
  ;
 
+signedLiteral
+ : literal
+ | (PLUS | MINUS) (INTEGER_LITERAL | FLOAT_LITERAL)
+ ;
+
 literal
  : INTEGER_LITERAL
  | FLOAT_LITERAL
@@ -57,6 +57,31 @@ const (
 
 	// 新增HavingClause节点类型
 	HavingNode
+
+	// 运维和管理类语句节点类型
+	SetNode
+	AlterTableNode
+	CopyNode
+	ExportTableNode
+	ImportTableNode
+	RestoreTableNode
+	CloneTableNode
+	BackupDatabaseNode
+	RestoreDatabaseNode
+	VacuumNode
+	DescribeNode
+	ShowCreateTableNode
+	CommentNode
+	CreateRoleNode
+	DropRoleNode
+	GrantNode
+	KillNode
+	ParameterNode
+	PrepareNode
+	ExecuteNode
+	DeallocateNode
+	ShowVariableNode
+	ResetNode
 )
 
 // ColumnItemType 定义了SELECT列项的类型
@@ -100,6 +125,7 @@ type SelectStmt struct {
 	From         string         // FROM子句表名
 	FromAlias    string         // FROM子句表别名
 	FromSubquery *SelectStmt    // FROM子句子查询
+	FromFunction *TableFunction // FROM子句表函数，如 table_changes('db.t', 1, 5)
 	Joins        []*JoinClause  // JOIN子句
 	Where        *WhereClause   // WHERE子句
 	GroupBy      []Node         // GROUP BY子句
@@ -143,10 +169,11 @@ type ColumnItem struct {
 // TableRef 用于构建表引用的临时结构
 type TableRef struct {
 	BaseNode
-	Table    string        // 表名（基本表引用时使用）
-	Alias    string        // 别名
-	Subquery *SelectStmt   // 子查询（子查询时使用）
-	Joins    []*JoinClause // JOIN子句列表
+	Table    string         // 表名（基本表引用时使用）
+	Alias    string         // 别名
+	Subquery *SelectStmt    // 子查询（子查询时使用）
+	Function *TableFunction // 表函数（表函数调用时使用）
+	Joins    []*JoinClause  // JOIN子句列表
 }
 
 // JoinClause JOIN子句节点
@@ -211,9 +238,19 @@ type CreateDatabaseStmt struct {
 // CreateTableStmt CREATE TABLE语句节点
 type CreateTableStmt struct {
 	BaseNode
-	Table       string        // 表名
-	Columns     []*ColumnDef  // 列定义
-	Constraints []*Constraint // 表约束
+	Table       string            // 表名
+	Columns     []*ColumnDef      // 列定义
+	Constraints []*Constraint     // 表约束
+	Options     map[string]string // 表选项 WITH (compression='zstd', ...)
+	Partition   *PartitionMethod  // 分区方式 PARTITION BY ...，未分区时为 nil
+	External    *ExternalTable    // 外部表 CREATE EXTERNAL TABLE ... LOCATION ... FORMAT ...，普通表为 nil
+}
+
+// ExternalTable 外部表的数据位置和文件格式
+type ExternalTable struct {
+	Location string            // 数据目录 (或单个文件)
+	Format   string            // 文件格式 (parquet / csv)
+	Options  map[string]string // 格式选项 WITH (header = false, ...)
 }
 
 // ColumnDef 列定义节点
@@ -229,6 +266,7 @@ type Constraint struct {
 	BaseNode
 	Type    string   // 约束类型(PRIMARY KEY/NOT NULL等)
 	Columns []string // 涉及的列
+	Value   string   // DEFAULT 约束的字面量原文
 }
 
 // CreateIndexStmt CREATE INDEX语句节点
@@ -372,9 +410,19 @@ type ShowDatabasesStmt struct {
 // PartitionMethod 分区方法节点
 type PartitionMethod struct {
 	BaseNode
-	Type         string   // 分区类型(HASH/RANGE)
-	Columns      []string // 分区键列
-	PartitionNum int      // 分区数量(HASH分区)
+	Type         string                 // 分区类型(HASH/RANGE/LIST)
+	Columns      []string               // 分区键列
+	PartitionNum int                    // 分区数量(HASH分区)
+	Partitions   []*PartitionDefinition // 显式分区定义(RANGE/LIST)，LIST 未定义分区时按列值分区
+}
+
+// PartitionDefinition 显式分区定义
+// RANGE: PARTITION p0 VALUES LESS THAN (100)；LIST: PARTITION p_us VALUES IN ('us', 'ca')
+type PartitionDefinition struct {
+	Name     string        // 分区名
+	LessThan interface{}   // RANGE 分区上界(不含)
+	MaxValue bool          // RANGE 分区上界为 MAXVALUE
+	Values   []interface{} // LIST 分区值列表
 }
 
 // TransactionStmt 事务语句节点
@@ -383,10 +431,18 @@ type TransactionStmt struct {
 	TxType string // BEGIN/COMMIT/ROLLBACK
 }
 
+// EXPLAIN 的输出格式
+const (
+	ExplainFormatText = "TEXT"
+	ExplainFormatJSON = "JSON"
+)
+
 // ExplainStmt EXPLAIN语句节点
 type ExplainStmt struct {
 	BaseNode
-	Query Node // 要解释的查询语句
+	Query   Node   // 要解释的查询语句
+	Analyze bool   // EXPLAIN ANALYZE：执行查询并输出各算子的实际运行统计
+	Format  string // 输出格式 (TEXT/JSON)，为空时为 TEXT
 }
 
 // ErrorStmt 错误语句节点
@@ -407,3 +463,265 @@ type AnalyzeStmt struct {
 	Table   string   // 表名
 	Columns []string // 要分析的列（nil表示所有列）
 }
+
+// ALTER TABLE 变更操作
+const (
+	AlterTableDropPartition        = "DROP PARTITION"
+	AlterTableSetTableProperties   = "SET TBLPROPERTIES"
+	AlterTableUnsetTableProperties = "UNSET TBLPROPERTIES"
+)
+
+// AlterTableStmt ALTER TABLE 语句节点
+type AlterTableStmt struct {
+	BaseNode
+	Table  string // 表名
+	Action string // 变更操作(DROP PARTITION)
+
+	// DROP PARTITION: 按分区名 (RANGE/LIST 显式分区) 或按分区列值 (LIST 列值分区) 指定分区
+	PartitionName   string
+	PartitionValues map[string]interface{}
+
+	// SET TBLPROPERTIES: 设置的表属性；UNSET TBLPROPERTIES: 删除的属性名
+	Properties    map[string]string
+	PropertyNames []string
+}
+
+// COPY 数据方向
+const (
+	CopyFrom = "FROM"
+	CopyTo   = "TO"
+)
+
+// CopyStmt COPY 导入/导出语句节点
+//
+//	COPY t [(col, ...)] FROM 'path' [WITH (...)]
+//	COPY t | (SELECT ...) TO 'path' [WITH (...)]
+type CopyStmt struct {
+	BaseNode
+	Table     string            // 目标表 (导入) 或源表 (导出)
+	Columns   []string          // 导入的列，为空时使用表的全部列
+	Query     *SelectStmt       // 导出的查询 (COPY t TO 时为 SELECT * FROM t)
+	Direction string            // FROM / TO
+	Path      string            // 文件路径，导入时支持通配符
+	Options   map[string]string // WITH 选项，名称为小写
+}
+
+// 表函数名称
+const (
+	TableFunctionTableChanges = "table_changes"
+)
+
+// TableFunction FROM 子句中的表函数调用
+//
+//	SELECT ... FROM table_changes('db.t', 10, 20)
+type TableFunction struct {
+	Name string        // 函数名（小写）
+	Args []interface{} // 字面量参数：int64/float64/string/bool/nil
+}
+
+// 表导出/导入格式
+const (
+	TableFormatDelta = "DELTA"
+)
+
+// ExportTableStmt EXPORT TABLE 语句节点
+//
+//	EXPORT TABLE t TO DELTA
+type ExportTableStmt struct {
+	BaseNode
+	Table  string // 表名
+	Format string // 导出格式 (DELTA)
+}
+
+// ImportTableStmt IMPORT TABLE 语句节点
+//
+//	IMPORT TABLE t FROM DELTA 'path'
+type ImportTableStmt struct {
+	BaseNode
+	Table  string // 新建的表名
+	Format string // 源格式 (DELTA)
+	Path   string // 源表目录
+}
+
+// RestoreTableStmt RESTORE TABLE 语句节点
+//
+//	RESTORE TABLE t TO VERSION AS OF n
+//	RESTORE TABLE t TO TIMESTAMP AS OF 'ts'
+type RestoreTableStmt struct {
+	BaseNode
+	Table     string      // 表名
+	Version   int64       // 目标版本 (VERSION AS OF)
+	Timestamp interface{} // 目标时间 (TIMESTAMP AS OF)：时间字符串或 Unix 毫秒整数，为 nil 时按 Version 恢复
+}
+
+// CloneTableStmt CREATE TABLE ... SHALLOW CLONE 语句节点
+//
+//	CREATE TABLE t2 SHALLOW CLONE t1 [VERSION AS OF n]
+type CloneTableStmt struct {
+	BaseNode
+	Table   string // 新建的表
+	Source  string // 源表
+	Version int64  // 源表版本，-1 表示最新版本
+}
+
+// BackupDatabaseStmt BACKUP DATABASE 语句节点
+//
+//	BACKUP DATABASE db TO 'dir' [INCREMENTAL FROM 'previous_dir']
+type BackupDatabaseStmt struct {
+	BaseNode
+	Database        string // 备份的数据库
+	Path            string // 备份目录
+	IncrementalFrom string // 增量备份基于的备份目录，为空表示完整备份
+}
+
+// RestoreDatabaseStmt RESTORE DATABASE 语句节点
+//
+//	RESTORE DATABASE db FROM 'dir' [AS OF VERSION n]
+type RestoreDatabaseStmt struct {
+	BaseNode
+	Database string // 重建的数据库 (不能已存在)
+	Path     string // 备份目录
+	Version  int64  // 恢复到的版本，-1 表示备份的版本
+}
+
+// VacuumStmt VACUUM 语句节点
+//
+//	VACUUM t [RETAIN n HOURS] [DRY RUN]
+type VacuumStmt struct {
+	BaseNode
+	Table       string // 表名
+	RetainHours int64  // 保留期 (小时)，-1 表示使用默认保留期
+	DryRun      bool   // 只列出将被删除的文件
+}
+
+// DescribeStmt DESCRIBE 语句节点
+//
+//	DESCRIBE [TABLE] [EXTENDED] t
+type DescribeStmt struct {
+	BaseNode
+	Table    string // 表名
+	Extended bool   // 是否同时输出表的存储信息
+}
+
+// ShowCreateTableStmt SHOW CREATE TABLE 语句节点
+type ShowCreateTableStmt struct {
+	BaseNode
+	Table string // 表名
+}
+
+// CommentStmt COMMENT ON 语句节点
+//
+//	COMMENT ON TABLE t IS 'text'
+//	COMMENT ON COLUMN t.col IS 'text'
+type CommentStmt struct {
+	BaseNode
+	Table   string // 表名
+	Column  string // 列名，为空时为表注释
+	Comment string // 注释内容，为空 (IS NULL 或 IS '') 时删除注释
+}
+
+// CreateRoleStmt CREATE USER / CREATE ROLE 语句节点
+//
+//	CREATE USER name [WITH] PASSWORD 'secret' [SUPERUSER]
+//	CREATE ROLE name
+type CreateRoleStmt struct {
+	BaseNode
+	Name      string
+	Login     bool   // CREATE USER: 可以登录的用户；CREATE ROLE: 只用于授权的角色
+	Password  string // 用户密码
+	Superuser bool   // 超级用户拥有全部权限
+}
+
+// DropRoleStmt DROP USER / DROP ROLE 语句节点
+type DropRoleStmt struct {
+	BaseNode
+	Name     string
+	Login    bool // DROP USER 为 true，DROP ROLE 为 false
+	IfExists bool
+}
+
+// GrantStmt GRANT / REVOKE 语句节点
+//
+//	GRANT SELECT, INSERT ON db.table TO role_or_user
+//	GRANT ALL [PRIVILEGES] ON db.* TO role_or_user
+//	GRANT role TO user
+//	REVOKE ... FROM ...
+type GrantStmt struct {
+	BaseNode
+	Revoke     bool
+	Privileges []string // 表级权限 (大写)；为空时为角色授予
+	Database   string   // 权限对象的数据库，"*" 表示全部数据库，为空时使用当前数据库
+	Table      string   // 权限对象的表，"*" 表示数据库中的全部表
+	Roles      []string // GRANT role TO user: 授予的角色
+	Grantees   []string // 被授予的用户或角色
+}
+
+// KillStmt KILL QUERY / KILL SESSION 语句节点
+type KillStmt struct {
+	BaseNode
+	Session bool  // KILL SESSION (或 KILL CONNECTION) 为 true
+	ID      int64 // 语句 ID (sys.running_queries.query_id) 或会话 ID
+}
+
+// Parameter 预处理语句中的参数占位符 $n
+type Parameter struct {
+	BaseNode
+	Index    int    // 参数序号，从 1 开始
+	DataType string // PREPARE 声明的参数类型 (ParameterType*)，未声明时为空
+}
+
+// PrepareStmt PREPARE 语句节点
+//
+//	PREPARE name [(type, ...)] AS statement
+type PrepareStmt struct {
+	BaseNode
+	Name       string   // 预处理语句名称 (小写)
+	ParamTypes []string // 各参数的类型，长度为参数个数，未声明类型的参数为空
+	Statement  Node     // 含 Parameter 占位符的 SELECT/INSERT/UPDATE/DELETE
+	Query      string   // 语句原文，用作计划缓存的键
+}
+
+// ExecuteStmt EXECUTE 语句节点
+//
+//	EXECUTE name [(value, ...)]
+type ExecuteStmt struct {
+	BaseNode
+	Name string        // 预处理语句名称 (小写)
+	Args []interface{} // 参数值：int64/float64/string/bool/nil
+}
+
+// DeallocateStmt DEALLOCATE 语句节点
+//
+//	DEALLOCATE [PREPARE] {name | ALL}
+type DeallocateStmt struct {
+	BaseNode
+	Name string // 预处理语句名称 (小写)
+	All  bool   // 释放会话的全部预处理语句
+}
+
+// SetStmt SET 会话变量语句节点
+type SetStmt struct {
+	BaseNode
+	Variable string      // 变量名（小写）
+	Value    interface{} // 变量值：int64/float64/string/bool/nil
+}
+
+// ShowVariableStmt SHOW 会话变量语句节点
+//
+//	SHOW name
+//	SHOW ALL
+type ShowVariableStmt struct {
+	BaseNode
+	Variable string // 变量名（小写）
+	All      bool   // 列出全部会话变量
+}
+
+// ResetStmt RESET 会话变量语句节点，把变量恢复为默认值
+//
+//	RESET {name | ALL}
+//	SET name {= | TO} DEFAULT
+type ResetStmt struct {
+	BaseNode
+	Variable string // 变量名（小写）
+	All      bool   // 恢复全部会话变量
+}
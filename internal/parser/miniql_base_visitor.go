@@ -43,6 +43,54 @@ func (v *BaseMiniQLVisitor) VisitCreateTable(ctx *CreateTableContext) interface{
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitCloneTable(ctx *CloneTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitCreateExternalTable(ctx *CreateExternalTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitAlterTable(ctx *AlterTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitRestoreTable(ctx *RestoreTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitCommentStatement(ctx *CommentStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitTableProperty(ctx *TablePropertyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPropertyName(ctx *PropertyNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPartitionValue(ctx *PartitionValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitOptionList(ctx *OptionListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitOption(ctx *OptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitOptionName(ctx *OptionNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitOptionValue(ctx *OptionValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitColumnDef(ctx *ColumnDefContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -71,6 +119,46 @@ func (v *BaseMiniQLVisitor) VisitDropDatabase(ctx *DropDatabaseContext) interfac
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitCreateUser(ctx *CreateUserContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitUserOption(ctx *UserOptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitCreateRole(ctx *CreateRoleContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitDropRole(ctx *DropRoleContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitGrantStatement(ctx *GrantStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitRevokeStatement(ctx *RevokeStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPrivilegeList(ctx *PrivilegeListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPrivilege(ctx *PrivilegeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitGrantObject(ctx *GrantObjectContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitGrantObjectPart(ctx *GrantObjectPartContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitInsertStatement(ctx *InsertStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -107,6 +195,14 @@ func (v *BaseMiniQLVisitor) VisitTableRefSubquery(ctx *TableRefSubqueryContext)
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitTableRefFunction(ctx *TableRefFunctionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitTableFunction(ctx *TableFunctionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitJoinType(ctx *JoinTypeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -159,6 +255,10 @@ func (v *BaseMiniQLVisitor) VisitParenExpr(ctx *ParenExprContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitParameterExpr(ctx *ParameterExprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitComparisonOperator(ctx *ComparisonOperatorContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -187,6 +287,18 @@ func (v *BaseMiniQLVisitor) VisitPartitionMethod(ctx *PartitionMethodContext) in
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitPartitionDefinitions(ctx *PartitionDefinitionsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPartitionDefinition(ctx *PartitionDefinitionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPartitionBound(ctx *PartitionBoundContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitTransactionStatement(ctx *TransactionStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -207,10 +319,22 @@ func (v *BaseMiniQLVisitor) VisitShowIndexes(ctx *ShowIndexesContext) interface{
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitShowCreateTable(ctx *ShowCreateTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitDescribeTable(ctx *DescribeTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitExplainStatement(ctx *ExplainStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitExplainOption(ctx *ExplainOptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitAnalyzeStatement(ctx *AnalyzeStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -219,6 +343,74 @@ func (v *BaseMiniQLVisitor) VisitColumnList(ctx *ColumnListContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitSetStatement(ctx *SetStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitShowVariable(ctx *ShowVariableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitResetStatement(ctx *ResetStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitVariableName(ctx *VariableNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitPrepareStatement(ctx *PrepareStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitParameterType(ctx *ParameterTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitExecuteStatement(ctx *ExecuteStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitDeallocateStatement(ctx *DeallocateStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitCopyStatement(ctx *CopyStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitExportTable(ctx *ExportTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitImportTable(ctx *ImportTableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitTableFormat(ctx *TableFormatContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitBackupDatabase(ctx *BackupDatabaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitRestoreDatabase(ctx *RestoreDatabaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitKillStatement(ctx *KillStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitVacuumStatement(ctx *VacuumStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseMiniQLVisitor) VisitSetValue(ctx *SetValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitIdentifierList(ctx *IdentifierListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -227,6 +419,10 @@ func (v *BaseMiniQLVisitor) VisitValueList(ctx *ValueListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitValueItem(ctx *ValueItemContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitTableName(ctx *TableNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -235,10 +431,18 @@ func (v *BaseMiniQLVisitor) VisitIdentifier(ctx *IdentifierContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitNonReservedKeyword(ctx *NonReservedKeywordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitDataType(ctx *DataTypeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseMiniQLVisitor) VisitSignedLiteral(ctx *SignedLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseMiniQLVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
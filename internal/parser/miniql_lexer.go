@@ -46,9 +46,12 @@ func miniqllexerLexerInit() {
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'='", "'!='",
-		"'>'", "'>='", "'<'", "'<='", "'+'", "'-'", "", "'/'", "'.'", "','",
-		"';'", "'('", "')'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "'='", "", "'>'", "'>='", "'<'", "'<='", "'+'", "'-'", "", "'/'",
+		"'.'", "','", "';'", "'('", "')'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT", "SELECT", "FROM", "WHERE",
@@ -59,11 +62,18 @@ func miniqllexerLexerInit() {
 		"FULL", "OUTER", "USE", "SHOW", "DATABASES", "TABLES", "EXPLAIN", "ANALYZE",
 		"VERBOSE", "UNIQUE", "DEFAULT", "INDEX", "INDEXES", "INT_TYPE", "INTEGER_TYPE",
 		"VARCHAR_TYPE", "BOOLEAN_TYPE", "DOUBLE_TYPE", "TIMESTAMP_TYPE", "START",
-		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "ASTERISK", "EQUAL",
-		"NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL", "PLUS",
-		"MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON", "LEFT_PAREN",
-		"RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL", "STRING_LITERAL",
-		"WS",
+		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "TO", "ALL", "RESET",
+		"TIME", "ZONE", "ALTER", "WITH", "OF", "LIST", "PARTITIONS", "LESS_KW",
+		"THAN", "MAXVALUE", "TBLPROPERTIES", "UNSET", "SHALLOW", "CLONE", "VERSION",
+		"DESCRIBE", "EXTENDED", "COMMENT", "COLUMN", "IS", "USER", "ROLE", "PASSWORD",
+		"SUPERUSER", "NOSUPERUSER", "GRANT", "REVOKE", "PRIVILEGES", "IF", "EXISTS",
+		"KILL", "QUERY", "SESSION", "CONNECTION", "RESTORE", "BACKUP", "INCREMENTAL",
+		"VACUUM", "RETAIN", "HOURS", "DRY", "RUN", "PREPARE", "EXECUTE", "DEALLOCATE",
+		"COPY", "EXPORT", "IMPORT", "EXTERNAL", "LOCATION", "FORMAT", "ASTERISK",
+		"EQUAL", "NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL",
+		"PLUS", "MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON",
+		"LEFT_PAREN", "RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL",
+		"STRING_LITERAL", "PARAM", "WS",
 	}
 	staticData.RuleNames = []string{
 		"SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT", "SELECT", "FROM", "WHERE",
@@ -74,90 +84,152 @@ func miniqllexerLexerInit() {
 		"FULL", "OUTER", "USE", "SHOW", "DATABASES", "TABLES", "EXPLAIN", "ANALYZE",
 		"VERBOSE", "UNIQUE", "DEFAULT", "INDEX", "INDEXES", "INT_TYPE", "INTEGER_TYPE",
 		"VARCHAR_TYPE", "BOOLEAN_TYPE", "DOUBLE_TYPE", "TIMESTAMP_TYPE", "START",
-		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "ASTERISK", "EQUAL",
-		"NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL", "PLUS",
-		"MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON", "LEFT_PAREN",
-		"RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL", "STRING_LITERAL",
-		"WS", "A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M",
-		"N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
+		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "TO", "ALL", "RESET",
+		"TIME", "ZONE", "ALTER", "WITH", "OF", "LIST", "PARTITIONS", "LESS_KW",
+		"THAN", "MAXVALUE", "TBLPROPERTIES", "UNSET", "SHALLOW", "CLONE", "VERSION",
+		"DESCRIBE", "EXTENDED", "COMMENT", "COLUMN", "IS", "USER", "ROLE", "PASSWORD",
+		"SUPERUSER", "NOSUPERUSER", "GRANT", "REVOKE", "PRIVILEGES", "IF", "EXISTS",
+		"KILL", "QUERY", "SESSION", "CONNECTION", "RESTORE", "BACKUP", "INCREMENTAL",
+		"VACUUM", "RETAIN", "HOURS", "DRY", "RUN", "PREPARE", "EXECUTE", "DEALLOCATE",
+		"COPY", "EXPORT", "IMPORT", "EXTERNAL", "LOCATION", "FORMAT", "ASTERISK",
+		"EQUAL", "NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL",
+		"PLUS", "MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON",
+		"LEFT_PAREN", "RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL",
+		"STRING_LITERAL", "PARAM", "WS", "A", "B", "C", "D", "E", "F", "G",
+		"H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U",
+		"V", "W", "X", "Y", "Z",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 85, 761, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
-		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
-		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
-		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
-		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
-		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
-		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
-		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
-		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
-		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
-		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
-		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
-		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7,
-		83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88,
-		2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2,
-		94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99,
-		7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		4, 0, 140, 1263, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
+		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
+		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
+		7, 20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7,
+		25, 2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30,
+		2, 31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2,
+		36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41,
+		7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7,
+		46, 2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51,
+		2, 52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2,
+		57, 7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62,
+		7, 62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7,
+		67, 2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72,
+		2, 73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2,
+		78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83,
+		7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7,
+		88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93,
+		2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2,
+		99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
 		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
-		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 1, 0, 1, 0, 1, 0, 1, 0, 5, 0, 228,
-		8, 0, 10, 0, 12, 0, 231, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-		239, 8, 1, 10, 1, 12, 1, 242, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-		1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4,
-		1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6,
-		1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8,
-		1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1,
-		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12,
-		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1,
-		13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15,
-		1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18,
-		1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1,
-		20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21,
-		1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1,
-		24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26,
-		1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1,
-		29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31,
-		1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35,
-		1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1,
-		37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1,
-		41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43,
-		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45,
-		1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1,
-		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48,
-		1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1,
-		49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1,
-		53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54,
-		1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57,
-		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1,
-		58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
-		1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1,
-		60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62,
-		1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
-		64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 68, 1, 68,
-		1, 68, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 72, 1, 72, 1,
-		73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1, 77, 1, 78,
-		1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 5, 80, 670, 8, 80, 10, 80, 12, 80, 673,
-		9, 80, 1, 81, 4, 81, 676, 8, 81, 11, 81, 12, 81, 677, 1, 82, 4, 82, 681,
-		8, 82, 11, 82, 12, 82, 682, 1, 82, 1, 82, 5, 82, 687, 8, 82, 10, 82, 12,
-		82, 690, 9, 82, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 696, 8, 83, 10, 83,
-		12, 83, 699, 9, 83, 1, 83, 1, 83, 1, 84, 4, 84, 704, 8, 84, 11, 84, 12,
-		84, 705, 1, 84, 1, 84, 1, 85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 87, 1, 88,
-		1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1, 93, 1,
-		93, 1, 94, 1, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98, 1, 98,
-		1, 99, 1, 99, 1, 100, 1, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103, 1,
-		103, 1, 104, 1, 104, 1, 105, 1, 105, 1, 106, 1, 106, 1, 107, 1, 107, 1,
-		108, 1, 108, 1, 109, 1, 109, 1, 110, 1, 110, 1, 240, 0, 111, 1, 1, 3, 2,
-		5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117,
+		7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121,
+		2, 122, 7, 122, 2, 123, 7, 123, 2, 124, 7, 124, 2, 125, 7, 125, 2, 126,
+		7, 126, 2, 127, 7, 127, 2, 128, 7, 128, 2, 129, 7, 129, 2, 130, 7, 130,
+		2, 131, 7, 131, 2, 132, 7, 132, 2, 133, 7, 133, 2, 134, 7, 134, 2, 135,
+		7, 135, 2, 136, 7, 136, 2, 137, 7, 137, 2, 138, 7, 138, 2, 139, 7, 139,
+		2, 140, 7, 140, 2, 141, 7, 141, 2, 142, 7, 142, 2, 143, 7, 143, 2, 144,
+		7, 144, 2, 145, 7, 145, 2, 146, 7, 146, 2, 147, 7, 147, 2, 148, 7, 148,
+		2, 149, 7, 149, 2, 150, 7, 150, 2, 151, 7, 151, 2, 152, 7, 152, 2, 153,
+		7, 153, 2, 154, 7, 154, 2, 155, 7, 155, 2, 156, 7, 156, 2, 157, 7, 157,
+		2, 158, 7, 158, 2, 159, 7, 159, 2, 160, 7, 160, 2, 161, 7, 161, 2, 162,
+		7, 162, 2, 163, 7, 163, 2, 164, 7, 164, 2, 165, 7, 165, 1, 0, 1, 0, 1,
+		0, 1, 0, 5, 0, 338, 8, 0, 10, 0, 12, 0, 341, 9, 0, 1, 0, 1, 0, 1, 1, 1,
+		1, 1, 1, 1, 1, 5, 1, 349, 8, 1, 10, 1, 12, 1, 352, 9, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1,
+		3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1,
+		7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1,
+		9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1,
+		19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
+		28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 31,
+		1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
+		1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
+		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1,
+		46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48,
+		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1,
+		49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51,
+		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1,
+		52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54,
+		1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1,
+		55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56,
+		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
+		58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1,
+		60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61,
+		1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66,
+		1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1,
+		68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1,
+		72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73,
+		1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1,
+		75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76,
+		1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1,
+		77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1,
+		80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81,
+		1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1,
+		83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84,
+		1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 1,
+		85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87,
+		1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1,
+		89, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90,
+		1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1,
+		91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92,
+		1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1,
+		94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95,
+		1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1,
+		97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99,
+		1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100,
+		1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101,
+		1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1, 102,
+		1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103,
+		1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 104, 1, 104, 1, 104,
+		1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105,
+		1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 107,
+		1, 107, 1, 107, 1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109,
+		1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110,
+		1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 1, 111,
+		1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 112, 1, 112,
+		1, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113,
+		1, 113, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 115,
+		1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 116,
+		1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 117,
+		1, 117, 1, 117, 1, 117, 1, 117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 119,
+		1, 119, 1, 120, 1, 120, 1, 120, 1, 120, 3, 120, 1132, 8, 120, 1, 121, 1,
+		121, 1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 124, 1, 124, 1, 124, 1,
+		125, 1, 125, 1, 126, 1, 126, 1, 127, 1, 127, 1, 128, 1, 128, 1, 129, 1,
+		129, 1, 130, 1, 130, 1, 131, 1, 131, 1, 132, 1, 132, 1, 133, 1, 133, 1,
+		134, 1, 134, 5, 134, 1164, 8, 134, 10, 134, 12, 134, 1167, 9, 134, 1, 135,
+		4, 135, 1170, 8, 135, 11, 135, 12, 135, 1171, 1, 136, 4, 136, 1175, 8,
+		136, 11, 136, 12, 136, 1176, 1, 136, 1, 136, 5, 136, 1181, 8, 136, 10,
+		136, 12, 136, 1184, 9, 136, 1, 137, 1, 137, 1, 137, 1, 137, 1, 137, 1,
+		137, 5, 137, 1192, 8, 137, 10, 137, 12, 137, 1195, 9, 137, 1, 137, 1, 137,
+		1, 138, 1, 138, 4, 138, 1201, 8, 138, 11, 138, 12, 138, 1202, 1, 139, 4,
+		139, 1206, 8, 139, 11, 139, 12, 139, 1207, 1, 139, 1, 139, 1, 140, 1, 140,
+		1, 141, 1, 141, 1, 142, 1, 142, 1, 143, 1, 143, 1, 144, 1, 144, 1, 145,
+		1, 145, 1, 146, 1, 146, 1, 147, 1, 147, 1, 148, 1, 148, 1, 149, 1, 149,
+		1, 150, 1, 150, 1, 151, 1, 151, 1, 152, 1, 152, 1, 153, 1, 153, 1, 154,
+		1, 154, 1, 155, 1, 155, 1, 156, 1, 156, 1, 157, 1, 157, 1, 158, 1, 158,
+		1, 159, 1, 159, 1, 160, 1, 160, 1, 161, 1, 161, 1, 162, 1, 162, 1, 163,
+		1, 163, 1, 164, 1, 164, 1, 165, 1, 165, 1, 350, 0, 166, 1, 1, 3, 2, 5,
+		3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
 		13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43,
 		22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61,
 		31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79,
@@ -166,259 +238,444 @@ func miniqllexerLexerInit() {
 		57, 115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127, 64, 129,
 		65, 131, 66, 133, 67, 135, 68, 137, 69, 139, 70, 141, 71, 143, 72, 145,
 		73, 147, 74, 149, 75, 151, 76, 153, 77, 155, 78, 157, 79, 159, 80, 161,
-		81, 163, 82, 165, 83, 167, 84, 169, 85, 171, 0, 173, 0, 175, 0, 177, 0,
-		179, 0, 181, 0, 183, 0, 185, 0, 187, 0, 189, 0, 191, 0, 193, 0, 195, 0,
-		197, 0, 199, 0, 201, 0, 203, 0, 205, 0, 207, 0, 209, 0, 211, 0, 213, 0,
-		215, 0, 217, 0, 219, 0, 221, 0, 1, 0, 32, 2, 0, 10, 10, 13, 13, 3, 0, 65,
-		90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 1, 0, 48, 57,
-		2, 0, 39, 39, 92, 92, 3, 0, 9, 10, 13, 13, 32, 32, 2, 0, 65, 65, 97, 97,
-		2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 100, 2,
-		0, 69, 69, 101, 101, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2,
-		0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2,
-		0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2,
-		0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2,
-		0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2,
-		0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2,
-		0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2,
-		0, 90, 90, 122, 122, 743, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1,
-		0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13,
-		1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0,
-		21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0,
-		0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0,
-		0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0,
-		0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1,
-		0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59,
-		1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0,
-		67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0,
-		0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0,
-		0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0,
-		0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1,
-		0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0,
-		105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0,
-		0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119,
-		1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0,
-		0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1,
-		0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0,
-		141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0,
-		0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155,
-		1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0,
-		0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1,
-		0, 0, 0, 1, 223, 1, 0, 0, 0, 3, 234, 1, 0, 0, 0, 5, 248, 1, 0, 0, 0, 7,
-		255, 1, 0, 0, 0, 9, 260, 1, 0, 0, 0, 11, 266, 1, 0, 0, 0, 13, 272, 1, 0,
-		0, 0, 15, 275, 1, 0, 0, 0, 17, 282, 1, 0, 0, 0, 19, 288, 1, 0, 0, 0, 21,
-		294, 1, 0, 0, 0, 23, 301, 1, 0, 0, 0, 25, 306, 1, 0, 0, 0, 27, 313, 1,
-		0, 0, 0, 29, 320, 1, 0, 0, 0, 31, 324, 1, 0, 0, 0, 33, 331, 1, 0, 0, 0,
-		35, 338, 1, 0, 0, 0, 37, 344, 1, 0, 0, 0, 39, 353, 1, 0, 0, 0, 41, 358,
-		1, 0, 0, 0, 43, 366, 1, 0, 0, 0, 45, 370, 1, 0, 0, 0, 47, 374, 1, 0, 0,
-		0, 49, 379, 1, 0, 0, 0, 51, 384, 1, 0, 0, 0, 53, 390, 1, 0, 0, 0, 55, 393,
-		1, 0, 0, 0, 57, 398, 1, 0, 0, 0, 59, 401, 1, 0, 0, 0, 61, 405, 1, 0, 0,
-		0, 63, 408, 1, 0, 0, 0, 65, 413, 1, 0, 0, 0, 67, 416, 1, 0, 0, 0, 69, 426,
-		1, 0, 0, 0, 71, 430, 1, 0, 0, 0, 73, 435, 1, 0, 0, 0, 75, 441, 1, 0, 0,
-		0, 77, 446, 1, 0, 0, 0, 79, 452, 1, 0, 0, 0, 81, 457, 1, 0, 0, 0, 83, 463,
-		1, 0, 0, 0, 85, 467, 1, 0, 0, 0, 87, 472, 1, 0, 0, 0, 89, 482, 1, 0, 0,
-		0, 91, 489, 1, 0, 0, 0, 93, 497, 1, 0, 0, 0, 95, 505, 1, 0, 0, 0, 97, 513,
-		1, 0, 0, 0, 99, 520, 1, 0, 0, 0, 101, 528, 1, 0, 0, 0, 103, 534, 1, 0,
-		0, 0, 105, 542, 1, 0, 0, 0, 107, 546, 1, 0, 0, 0, 109, 554, 1, 0, 0, 0,
-		111, 562, 1, 0, 0, 0, 113, 570, 1, 0, 0, 0, 115, 577, 1, 0, 0, 0, 117,
-		587, 1, 0, 0, 0, 119, 593, 1, 0, 0, 0, 121, 605, 1, 0, 0, 0, 123, 612,
-		1, 0, 0, 0, 125, 621, 1, 0, 0, 0, 127, 626, 1, 0, 0, 0, 129, 632, 1, 0,
-		0, 0, 131, 634, 1, 0, 0, 0, 133, 636, 1, 0, 0, 0, 135, 639, 1, 0, 0, 0,
-		137, 641, 1, 0, 0, 0, 139, 644, 1, 0, 0, 0, 141, 646, 1, 0, 0, 0, 143,
-		649, 1, 0, 0, 0, 145, 651, 1, 0, 0, 0, 147, 653, 1, 0, 0, 0, 149, 655,
-		1, 0, 0, 0, 151, 657, 1, 0, 0, 0, 153, 659, 1, 0, 0, 0, 155, 661, 1, 0,
-		0, 0, 157, 663, 1, 0, 0, 0, 159, 665, 1, 0, 0, 0, 161, 667, 1, 0, 0, 0,
-		163, 675, 1, 0, 0, 0, 165, 680, 1, 0, 0, 0, 167, 691, 1, 0, 0, 0, 169,
-		703, 1, 0, 0, 0, 171, 709, 1, 0, 0, 0, 173, 711, 1, 0, 0, 0, 175, 713,
-		1, 0, 0, 0, 177, 715, 1, 0, 0, 0, 179, 717, 1, 0, 0, 0, 181, 719, 1, 0,
-		0, 0, 183, 721, 1, 0, 0, 0, 185, 723, 1, 0, 0, 0, 187, 725, 1, 0, 0, 0,
-		189, 727, 1, 0, 0, 0, 191, 729, 1, 0, 0, 0, 193, 731, 1, 0, 0, 0, 195,
-		733, 1, 0, 0, 0, 197, 735, 1, 0, 0, 0, 199, 737, 1, 0, 0, 0, 201, 739,
-		1, 0, 0, 0, 203, 741, 1, 0, 0, 0, 205, 743, 1, 0, 0, 0, 207, 745, 1, 0,
-		0, 0, 209, 747, 1, 0, 0, 0, 211, 749, 1, 0, 0, 0, 213, 751, 1, 0, 0, 0,
-		215, 753, 1, 0, 0, 0, 217, 755, 1, 0, 0, 0, 219, 757, 1, 0, 0, 0, 221,
-		759, 1, 0, 0, 0, 223, 224, 5, 45, 0, 0, 224, 225, 5, 45, 0, 0, 225, 229,
-		1, 0, 0, 0, 226, 228, 8, 0, 0, 0, 227, 226, 1, 0, 0, 0, 228, 231, 1, 0,
-		0, 0, 229, 227, 1, 0, 0, 0, 229, 230, 1, 0, 0, 0, 230, 232, 1, 0, 0, 0,
-		231, 229, 1, 0, 0, 0, 232, 233, 6, 0, 0, 0, 233, 2, 1, 0, 0, 0, 234, 235,
-		5, 47, 0, 0, 235, 236, 5, 42, 0, 0, 236, 240, 1, 0, 0, 0, 237, 239, 9,
-		0, 0, 0, 238, 237, 1, 0, 0, 0, 239, 242, 1, 0, 0, 0, 240, 241, 1, 0, 0,
-		0, 240, 238, 1, 0, 0, 0, 241, 243, 1, 0, 0, 0, 242, 240, 1, 0, 0, 0, 243,
-		244, 5, 42, 0, 0, 244, 245, 5, 47, 0, 0, 245, 246, 1, 0, 0, 0, 246, 247,
-		6, 1, 0, 0, 247, 4, 1, 0, 0, 0, 248, 249, 3, 207, 103, 0, 249, 250, 3,
-		179, 89, 0, 250, 251, 3, 193, 96, 0, 251, 252, 3, 179, 89, 0, 252, 253,
-		3, 175, 87, 0, 253, 254, 3, 209, 104, 0, 254, 6, 1, 0, 0, 0, 255, 256,
-		3, 181, 90, 0, 256, 257, 3, 205, 102, 0, 257, 258, 3, 199, 99, 0, 258,
-		259, 3, 195, 97, 0, 259, 8, 1, 0, 0, 0, 260, 261, 3, 215, 107, 0, 261,
-		262, 3, 185, 92, 0, 262, 263, 3, 179, 89, 0, 263, 264, 3, 205, 102, 0,
-		264, 265, 3, 179, 89, 0, 265, 10, 1, 0, 0, 0, 266, 267, 3, 183, 91, 0,
-		267, 268, 3, 205, 102, 0, 268, 269, 3, 199, 99, 0, 269, 270, 3, 211, 105,
-		0, 270, 271, 3, 201, 100, 0, 271, 12, 1, 0, 0, 0, 272, 273, 3, 173, 86,
-		0, 273, 274, 3, 219, 109, 0, 274, 14, 1, 0, 0, 0, 275, 276, 3, 185, 92,
-		0, 276, 277, 3, 171, 85, 0, 277, 278, 3, 213, 106, 0, 278, 279, 3, 187,
-		93, 0, 279, 280, 3, 197, 98, 0, 280, 281, 3, 183, 91, 0, 281, 16, 1, 0,
-		0, 0, 282, 283, 3, 199, 99, 0, 283, 284, 3, 205, 102, 0, 284, 285, 3, 177,
-		88, 0, 285, 286, 3, 179, 89, 0, 286, 287, 3, 205, 102, 0, 287, 18, 1, 0,
-		0, 0, 288, 289, 3, 193, 96, 0, 289, 290, 3, 187, 93, 0, 290, 291, 3, 195,
-		97, 0, 291, 292, 3, 187, 93, 0, 292, 293, 3, 209, 104, 0, 293, 20, 1, 0,
-		0, 0, 294, 295, 3, 187, 93, 0, 295, 296, 3, 197, 98, 0, 296, 297, 3, 207,
-		103, 0, 297, 298, 3, 179, 89, 0, 298, 299, 3, 205, 102, 0, 299, 300, 3,
-		209, 104, 0, 300, 22, 1, 0, 0, 0, 301, 302, 3, 187, 93, 0, 302, 303, 3,
-		197, 98, 0, 303, 304, 3, 209, 104, 0, 304, 305, 3, 199, 99, 0, 305, 24,
-		1, 0, 0, 0, 306, 307, 3, 213, 106, 0, 307, 308, 3, 171, 85, 0, 308, 309,
-		3, 193, 96, 0, 309, 310, 3, 211, 105, 0, 310, 311, 3, 179, 89, 0, 311,
-		312, 3, 207, 103, 0, 312, 26, 1, 0, 0, 0, 313, 314, 3, 211, 105, 0, 314,
-		315, 3, 201, 100, 0, 315, 316, 3, 177, 88, 0, 316, 317, 3, 171, 85, 0,
-		317, 318, 3, 209, 104, 0, 318, 319, 3, 179, 89, 0, 319, 28, 1, 0, 0, 0,
-		320, 321, 3, 207, 103, 0, 321, 322, 3, 179, 89, 0, 322, 323, 3, 209, 104,
-		0, 323, 30, 1, 0, 0, 0, 324, 325, 3, 177, 88, 0, 325, 326, 3, 179, 89,
-		0, 326, 327, 3, 193, 96, 0, 327, 328, 3, 179, 89, 0, 328, 329, 3, 209,
-		104, 0, 329, 330, 3, 179, 89, 0, 330, 32, 1, 0, 0, 0, 331, 332, 3, 175,
-		87, 0, 332, 333, 3, 205, 102, 0, 333, 334, 3, 179, 89, 0, 334, 335, 3,
-		171, 85, 0, 335, 336, 3, 209, 104, 0, 336, 337, 3, 179, 89, 0, 337, 34,
-		1, 0, 0, 0, 338, 339, 3, 209, 104, 0, 339, 340, 3, 171, 85, 0, 340, 341,
-		3, 173, 86, 0, 341, 342, 3, 193, 96, 0, 342, 343, 3, 179, 89, 0, 343, 36,
-		1, 0, 0, 0, 344, 345, 3, 177, 88, 0, 345, 346, 3, 171, 85, 0, 346, 347,
-		3, 209, 104, 0, 347, 348, 3, 171, 85, 0, 348, 349, 3, 173, 86, 0, 349,
-		350, 3, 171, 85, 0, 350, 351, 3, 207, 103, 0, 351, 352, 3, 179, 89, 0,
-		352, 38, 1, 0, 0, 0, 353, 354, 3, 177, 88, 0, 354, 355, 3, 205, 102, 0,
-		355, 356, 3, 199, 99, 0, 356, 357, 3, 201, 100, 0, 357, 40, 1, 0, 0, 0,
-		358, 359, 3, 201, 100, 0, 359, 360, 3, 205, 102, 0, 360, 361, 3, 187, 93,
-		0, 361, 362, 3, 195, 97, 0, 362, 363, 3, 171, 85, 0, 363, 364, 3, 205,
-		102, 0, 364, 365, 3, 219, 109, 0, 365, 42, 1, 0, 0, 0, 366, 367, 3, 191,
-		95, 0, 367, 368, 3, 179, 89, 0, 368, 369, 3, 219, 109, 0, 369, 44, 1, 0,
-		0, 0, 370, 371, 3, 197, 98, 0, 371, 372, 3, 199, 99, 0, 372, 373, 3, 209,
-		104, 0, 373, 46, 1, 0, 0, 0, 374, 375, 3, 197, 98, 0, 375, 376, 3, 211,
-		105, 0, 376, 377, 3, 193, 96, 0, 377, 378, 3, 193, 96, 0, 378, 48, 1, 0,
-		0, 0, 379, 380, 3, 209, 104, 0, 380, 381, 3, 205, 102, 0, 381, 382, 3,
-		211, 105, 0, 382, 383, 3, 179, 89, 0, 383, 50, 1, 0, 0, 0, 384, 385, 3,
-		181, 90, 0, 385, 386, 3, 171, 85, 0, 386, 387, 3, 193, 96, 0, 387, 388,
-		3, 207, 103, 0, 388, 389, 3, 179, 89, 0, 389, 52, 1, 0, 0, 0, 390, 391,
-		3, 171, 85, 0, 391, 392, 3, 207, 103, 0, 392, 54, 1, 0, 0, 0, 393, 394,
-		3, 193, 96, 0, 394, 395, 3, 187, 93, 0, 395, 396, 3, 191, 95, 0, 396, 397,
-		3, 179, 89, 0, 397, 56, 1, 0, 0, 0, 398, 399, 3, 187, 93, 0, 399, 400,
-		3, 197, 98, 0, 400, 58, 1, 0, 0, 0, 401, 402, 3, 171, 85, 0, 402, 403,
-		3, 197, 98, 0, 403, 404, 3, 177, 88, 0, 404, 60, 1, 0, 0, 0, 405, 406,
-		3, 199, 99, 0, 406, 407, 3, 205, 102, 0, 407, 62, 1, 0, 0, 0, 408, 409,
-		3, 189, 94, 0, 409, 410, 3, 199, 99, 0, 410, 411, 3, 187, 93, 0, 411, 412,
-		3, 197, 98, 0, 412, 64, 1, 0, 0, 0, 413, 414, 3, 199, 99, 0, 414, 415,
-		3, 197, 98, 0, 415, 66, 1, 0, 0, 0, 416, 417, 3, 201, 100, 0, 417, 418,
-		3, 171, 85, 0, 418, 419, 3, 205, 102, 0, 419, 420, 3, 209, 104, 0, 420,
-		421, 3, 187, 93, 0, 421, 422, 3, 209, 104, 0, 422, 423, 3, 187, 93, 0,
-		423, 424, 3, 199, 99, 0, 424, 425, 3, 197, 98, 0, 425, 68, 1, 0, 0, 0,
-		426, 427, 3, 171, 85, 0, 427, 428, 3, 207, 103, 0, 428, 429, 3, 175, 87,
-		0, 429, 70, 1, 0, 0, 0, 430, 431, 3, 177, 88, 0, 431, 432, 3, 179, 89,
-		0, 432, 433, 3, 207, 103, 0, 433, 434, 3, 175, 87, 0, 434, 72, 1, 0, 0,
-		0, 435, 436, 3, 187, 93, 0, 436, 437, 3, 197, 98, 0, 437, 438, 3, 197,
-		98, 0, 438, 439, 3, 179, 89, 0, 439, 440, 3, 205, 102, 0, 440, 74, 1, 0,
-		0, 0, 441, 442, 3, 193, 96, 0, 442, 443, 3, 179, 89, 0, 443, 444, 3, 181,
-		90, 0, 444, 445, 3, 209, 104, 0, 445, 76, 1, 0, 0, 0, 446, 447, 3, 205,
-		102, 0, 447, 448, 3, 187, 93, 0, 448, 449, 3, 183, 91, 0, 449, 450, 3,
-		185, 92, 0, 450, 451, 3, 209, 104, 0, 451, 78, 1, 0, 0, 0, 452, 453, 3,
-		181, 90, 0, 453, 454, 3, 211, 105, 0, 454, 455, 3, 193, 96, 0, 455, 456,
-		3, 193, 96, 0, 456, 80, 1, 0, 0, 0, 457, 458, 3, 199, 99, 0, 458, 459,
-		3, 211, 105, 0, 459, 460, 3, 209, 104, 0, 460, 461, 3, 179, 89, 0, 461,
-		462, 3, 205, 102, 0, 462, 82, 1, 0, 0, 0, 463, 464, 3, 211, 105, 0, 464,
-		465, 3, 207, 103, 0, 465, 466, 3, 179, 89, 0, 466, 84, 1, 0, 0, 0, 467,
-		468, 3, 207, 103, 0, 468, 469, 3, 185, 92, 0, 469, 470, 3, 199, 99, 0,
-		470, 471, 3, 215, 107, 0, 471, 86, 1, 0, 0, 0, 472, 473, 3, 177, 88, 0,
-		473, 474, 3, 171, 85, 0, 474, 475, 3, 209, 104, 0, 475, 476, 3, 171, 85,
-		0, 476, 477, 3, 173, 86, 0, 477, 478, 3, 171, 85, 0, 478, 479, 3, 207,
-		103, 0, 479, 480, 3, 179, 89, 0, 480, 481, 3, 207, 103, 0, 481, 88, 1,
-		0, 0, 0, 482, 483, 3, 209, 104, 0, 483, 484, 3, 171, 85, 0, 484, 485, 3,
-		173, 86, 0, 485, 486, 3, 193, 96, 0, 486, 487, 3, 179, 89, 0, 487, 488,
-		3, 207, 103, 0, 488, 90, 1, 0, 0, 0, 489, 490, 3, 179, 89, 0, 490, 491,
-		3, 217, 108, 0, 491, 492, 3, 201, 100, 0, 492, 493, 3, 193, 96, 0, 493,
-		494, 3, 171, 85, 0, 494, 495, 3, 187, 93, 0, 495, 496, 3, 197, 98, 0, 496,
-		92, 1, 0, 0, 0, 497, 498, 3, 171, 85, 0, 498, 499, 3, 197, 98, 0, 499,
-		500, 3, 171, 85, 0, 500, 501, 3, 193, 96, 0, 501, 502, 3, 219, 109, 0,
-		502, 503, 3, 221, 110, 0, 503, 504, 3, 179, 89, 0, 504, 94, 1, 0, 0, 0,
-		505, 506, 3, 213, 106, 0, 506, 507, 3, 179, 89, 0, 507, 508, 3, 205, 102,
-		0, 508, 509, 3, 173, 86, 0, 509, 510, 3, 199, 99, 0, 510, 511, 3, 207,
-		103, 0, 511, 512, 3, 179, 89, 0, 512, 96, 1, 0, 0, 0, 513, 514, 3, 211,
-		105, 0, 514, 515, 3, 197, 98, 0, 515, 516, 3, 187, 93, 0, 516, 517, 3,
-		203, 101, 0, 517, 518, 3, 211, 105, 0, 518, 519, 3, 179, 89, 0, 519, 98,
-		1, 0, 0, 0, 520, 521, 3, 177, 88, 0, 521, 522, 3, 179, 89, 0, 522, 523,
-		3, 181, 90, 0, 523, 524, 3, 171, 85, 0, 524, 525, 3, 211, 105, 0, 525,
-		526, 3, 193, 96, 0, 526, 527, 3, 209, 104, 0, 527, 100, 1, 0, 0, 0, 528,
-		529, 3, 187, 93, 0, 529, 530, 3, 197, 98, 0, 530, 531, 3, 177, 88, 0, 531,
-		532, 3, 179, 89, 0, 532, 533, 3, 217, 108, 0, 533, 102, 1, 0, 0, 0, 534,
-		535, 3, 187, 93, 0, 535, 536, 3, 197, 98, 0, 536, 537, 3, 177, 88, 0, 537,
-		538, 3, 179, 89, 0, 538, 539, 3, 217, 108, 0, 539, 540, 3, 179, 89, 0,
-		540, 541, 3, 207, 103, 0, 541, 104, 1, 0, 0, 0, 542, 543, 3, 187, 93, 0,
-		543, 544, 3, 197, 98, 0, 544, 545, 3, 209, 104, 0, 545, 106, 1, 0, 0, 0,
-		546, 547, 3, 187, 93, 0, 547, 548, 3, 197, 98, 0, 548, 549, 3, 209, 104,
-		0, 549, 550, 3, 179, 89, 0, 550, 551, 3, 183, 91, 0, 551, 552, 3, 179,
-		89, 0, 552, 553, 3, 205, 102, 0, 553, 108, 1, 0, 0, 0, 554, 555, 3, 213,
-		106, 0, 555, 556, 3, 171, 85, 0, 556, 557, 3, 205, 102, 0, 557, 558, 3,
-		175, 87, 0, 558, 559, 3, 185, 92, 0, 559, 560, 3, 171, 85, 0, 560, 561,
-		3, 205, 102, 0, 561, 110, 1, 0, 0, 0, 562, 563, 3, 173, 86, 0, 563, 564,
-		3, 199, 99, 0, 564, 565, 3, 199, 99, 0, 565, 566, 3, 193, 96, 0, 566, 567,
-		3, 179, 89, 0, 567, 568, 3, 171, 85, 0, 568, 569, 3, 197, 98, 0, 569, 112,
-		1, 0, 0, 0, 570, 571, 3, 177, 88, 0, 571, 572, 3, 199, 99, 0, 572, 573,
-		3, 211, 105, 0, 573, 574, 3, 173, 86, 0, 574, 575, 3, 193, 96, 0, 575,
-		576, 3, 179, 89, 0, 576, 114, 1, 0, 0, 0, 577, 578, 3, 209, 104, 0, 578,
-		579, 3, 187, 93, 0, 579, 580, 3, 195, 97, 0, 580, 581, 3, 179, 89, 0, 581,
-		582, 3, 207, 103, 0, 582, 583, 3, 209, 104, 0, 583, 584, 3, 171, 85, 0,
-		584, 585, 3, 195, 97, 0, 585, 586, 3, 201, 100, 0, 586, 116, 1, 0, 0, 0,
-		587, 588, 3, 207, 103, 0, 588, 589, 3, 209, 104, 0, 589, 590, 3, 171, 85,
-		0, 590, 591, 3, 205, 102, 0, 591, 592, 3, 209, 104, 0, 592, 118, 1, 0,
-		0, 0, 593, 594, 3, 209, 104, 0, 594, 595, 3, 205, 102, 0, 595, 596, 3,
-		171, 85, 0, 596, 597, 3, 197, 98, 0, 597, 598, 3, 207, 103, 0, 598, 599,
-		3, 171, 85, 0, 599, 600, 3, 175, 87, 0, 600, 601, 3, 209, 104, 0, 601,
-		602, 3, 187, 93, 0, 602, 603, 3, 199, 99, 0, 603, 604, 3, 197, 98, 0, 604,
-		120, 1, 0, 0, 0, 605, 606, 3, 175, 87, 0, 606, 607, 3, 199, 99, 0, 607,
-		608, 3, 195, 97, 0, 608, 609, 3, 195, 97, 0, 609, 610, 3, 187, 93, 0, 610,
-		611, 3, 209, 104, 0, 611, 122, 1, 0, 0, 0, 612, 613, 3, 205, 102, 0, 613,
-		614, 3, 199, 99, 0, 614, 615, 3, 193, 96, 0, 615, 616, 3, 193, 96, 0, 616,
-		617, 3, 173, 86, 0, 617, 618, 3, 171, 85, 0, 618, 619, 3, 175, 87, 0, 619,
-		620, 3, 191, 95, 0, 620, 124, 1, 0, 0, 0, 621, 622, 3, 185, 92, 0, 622,
-		623, 3, 171, 85, 0, 623, 624, 3, 207, 103, 0, 624, 625, 3, 185, 92, 0,
-		625, 126, 1, 0, 0, 0, 626, 627, 3, 205, 102, 0, 627, 628, 3, 171, 85, 0,
-		628, 629, 3, 197, 98, 0, 629, 630, 3, 183, 91, 0, 630, 631, 3, 179, 89,
-		0, 631, 128, 1, 0, 0, 0, 632, 633, 5, 42, 0, 0, 633, 130, 1, 0, 0, 0, 634,
-		635, 5, 61, 0, 0, 635, 132, 1, 0, 0, 0, 636, 637, 5, 33, 0, 0, 637, 638,
-		5, 61, 0, 0, 638, 134, 1, 0, 0, 0, 639, 640, 5, 62, 0, 0, 640, 136, 1,
-		0, 0, 0, 641, 642, 5, 62, 0, 0, 642, 643, 5, 61, 0, 0, 643, 138, 1, 0,
-		0, 0, 644, 645, 5, 60, 0, 0, 645, 140, 1, 0, 0, 0, 646, 647, 5, 60, 0,
-		0, 647, 648, 5, 61, 0, 0, 648, 142, 1, 0, 0, 0, 649, 650, 5, 43, 0, 0,
-		650, 144, 1, 0, 0, 0, 651, 652, 5, 45, 0, 0, 652, 146, 1, 0, 0, 0, 653,
-		654, 5, 42, 0, 0, 654, 148, 1, 0, 0, 0, 655, 656, 5, 47, 0, 0, 656, 150,
-		1, 0, 0, 0, 657, 658, 5, 46, 0, 0, 658, 152, 1, 0, 0, 0, 659, 660, 5, 44,
-		0, 0, 660, 154, 1, 0, 0, 0, 661, 662, 5, 59, 0, 0, 662, 156, 1, 0, 0, 0,
-		663, 664, 5, 40, 0, 0, 664, 158, 1, 0, 0, 0, 665, 666, 5, 41, 0, 0, 666,
-		160, 1, 0, 0, 0, 667, 671, 7, 1, 0, 0, 668, 670, 7, 2, 0, 0, 669, 668,
-		1, 0, 0, 0, 670, 673, 1, 0, 0, 0, 671, 669, 1, 0, 0, 0, 671, 672, 1, 0,
-		0, 0, 672, 162, 1, 0, 0, 0, 673, 671, 1, 0, 0, 0, 674, 676, 7, 3, 0, 0,
-		675, 674, 1, 0, 0, 0, 676, 677, 1, 0, 0, 0, 677, 675, 1, 0, 0, 0, 677,
-		678, 1, 0, 0, 0, 678, 164, 1, 0, 0, 0, 679, 681, 7, 3, 0, 0, 680, 679,
-		1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 680, 1, 0, 0, 0, 682, 683, 1, 0,
-		0, 0, 683, 684, 1, 0, 0, 0, 684, 688, 5, 46, 0, 0, 685, 687, 7, 3, 0, 0,
-		686, 685, 1, 0, 0, 0, 687, 690, 1, 0, 0, 0, 688, 686, 1, 0, 0, 0, 688,
-		689, 1, 0, 0, 0, 689, 166, 1, 0, 0, 0, 690, 688, 1, 0, 0, 0, 691, 697,
-		5, 39, 0, 0, 692, 696, 8, 4, 0, 0, 693, 694, 5, 92, 0, 0, 694, 696, 9,
-		0, 0, 0, 695, 692, 1, 0, 0, 0, 695, 693, 1, 0, 0, 0, 696, 699, 1, 0, 0,
-		0, 697, 695, 1, 0, 0, 0, 697, 698, 1, 0, 0, 0, 698, 700, 1, 0, 0, 0, 699,
-		697, 1, 0, 0, 0, 700, 701, 5, 39, 0, 0, 701, 168, 1, 0, 0, 0, 702, 704,
-		7, 5, 0, 0, 703, 702, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 703, 1, 0,
-		0, 0, 705, 706, 1, 0, 0, 0, 706, 707, 1, 0, 0, 0, 707, 708, 6, 84, 0, 0,
-		708, 170, 1, 0, 0, 0, 709, 710, 7, 6, 0, 0, 710, 172, 1, 0, 0, 0, 711,
-		712, 7, 7, 0, 0, 712, 174, 1, 0, 0, 0, 713, 714, 7, 8, 0, 0, 714, 176,
-		1, 0, 0, 0, 715, 716, 7, 9, 0, 0, 716, 178, 1, 0, 0, 0, 717, 718, 7, 10,
-		0, 0, 718, 180, 1, 0, 0, 0, 719, 720, 7, 11, 0, 0, 720, 182, 1, 0, 0, 0,
-		721, 722, 7, 12, 0, 0, 722, 184, 1, 0, 0, 0, 723, 724, 7, 13, 0, 0, 724,
-		186, 1, 0, 0, 0, 725, 726, 7, 14, 0, 0, 726, 188, 1, 0, 0, 0, 727, 728,
-		7, 15, 0, 0, 728, 190, 1, 0, 0, 0, 729, 730, 7, 16, 0, 0, 730, 192, 1,
-		0, 0, 0, 731, 732, 7, 17, 0, 0, 732, 194, 1, 0, 0, 0, 733, 734, 7, 18,
-		0, 0, 734, 196, 1, 0, 0, 0, 735, 736, 7, 19, 0, 0, 736, 198, 1, 0, 0, 0,
-		737, 738, 7, 20, 0, 0, 738, 200, 1, 0, 0, 0, 739, 740, 7, 21, 0, 0, 740,
-		202, 1, 0, 0, 0, 741, 742, 7, 22, 0, 0, 742, 204, 1, 0, 0, 0, 743, 744,
-		7, 23, 0, 0, 744, 206, 1, 0, 0, 0, 745, 746, 7, 24, 0, 0, 746, 208, 1,
-		0, 0, 0, 747, 748, 7, 25, 0, 0, 748, 210, 1, 0, 0, 0, 749, 750, 7, 26,
-		0, 0, 750, 212, 1, 0, 0, 0, 751, 752, 7, 27, 0, 0, 752, 214, 1, 0, 0, 0,
-		753, 754, 7, 28, 0, 0, 754, 216, 1, 0, 0, 0, 755, 756, 7, 29, 0, 0, 756,
-		218, 1, 0, 0, 0, 757, 758, 7, 30, 0, 0, 758, 220, 1, 0, 0, 0, 759, 760,
-		7, 31, 0, 0, 760, 222, 1, 0, 0, 0, 10, 0, 229, 240, 671, 677, 682, 688,
-		695, 697, 705, 1, 6, 0, 0,
+		81, 163, 82, 165, 83, 167, 84, 169, 85, 171, 86, 173, 87, 175, 88, 177,
+		89, 179, 90, 181, 91, 183, 92, 185, 93, 187, 94, 189, 95, 191, 96, 193,
+		97, 195, 98, 197, 99, 199, 100, 201, 101, 203, 102, 205, 103, 207, 104,
+		209, 105, 211, 106, 213, 107, 215, 108, 217, 109, 219, 110, 221, 111, 223,
+		112, 225, 113, 227, 114, 229, 115, 231, 116, 233, 117, 235, 118, 237, 119,
+		239, 120, 241, 121, 243, 122, 245, 123, 247, 124, 249, 125, 251, 126, 253,
+		127, 255, 128, 257, 129, 259, 130, 261, 131, 263, 132, 265, 133, 267, 134,
+		269, 135, 271, 136, 273, 137, 275, 138, 277, 139, 279, 140, 281, 0, 283,
+		0, 285, 0, 287, 0, 289, 0, 291, 0, 293, 0, 295, 0, 297, 0, 299, 0, 301,
+		0, 303, 0, 305, 0, 307, 0, 309, 0, 311, 0, 313, 0, 315, 0, 317, 0, 319,
+		0, 321, 0, 323, 0, 325, 0, 327, 0, 329, 0, 331, 0, 1, 0, 32, 2, 0, 10,
+		10, 13, 13, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95,
+		97, 122, 1, 0, 48, 57, 2, 0, 39, 39, 92, 92, 3, 0, 9, 10, 13, 13, 32, 32,
+		2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0,
+		68, 68, 100, 100, 2, 0, 69, 69, 101, 101, 2, 0, 70, 70, 102, 102, 2, 0,
+		71, 71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0,
+		74, 74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0,
+		77, 77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0,
+		80, 80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0,
+		83, 83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0,
+		86, 86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0,
+		89, 89, 121, 121, 2, 0, 90, 90, 122, 122, 1248, 0, 1, 1, 0, 0, 0, 0, 3,
+		1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11,
+		1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0,
+		19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0,
+		0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0,
+		0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0,
+		0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1,
+		0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57,
+		1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0,
+		65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0,
+		0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0,
+		0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0,
+		0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1,
+		0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103,
+		1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0,
+		0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1,
+		0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0,
+		125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0,
+		0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139,
+		1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0,
+		0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1,
+		0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0,
+		161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0,
+		0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175,
+		1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0,
+		0, 183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1,
+		0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0,
+		197, 1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0,
+		0, 0, 0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211,
+		1, 0, 0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0,
+		0, 219, 1, 0, 0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1,
+		0, 0, 0, 0, 227, 1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0,
+		233, 1, 0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0,
+		0, 0, 0, 241, 1, 0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247,
+		1, 0, 0, 0, 0, 249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0,
+		0, 255, 1, 0, 0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1,
+		0, 0, 0, 0, 263, 1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 0,
+		269, 1, 0, 0, 0, 0, 271, 1, 0, 0, 0, 0, 273, 1, 0, 0, 0, 0, 275, 1, 0,
+		0, 0, 0, 277, 1, 0, 0, 0, 0, 279, 1, 0, 0, 0, 1, 333, 1, 0, 0, 0, 3, 344,
+		1, 0, 0, 0, 5, 358, 1, 0, 0, 0, 7, 365, 1, 0, 0, 0, 9, 370, 1, 0, 0, 0,
+		11, 376, 1, 0, 0, 0, 13, 382, 1, 0, 0, 0, 15, 385, 1, 0, 0, 0, 17, 392,
+		1, 0, 0, 0, 19, 398, 1, 0, 0, 0, 21, 404, 1, 0, 0, 0, 23, 411, 1, 0, 0,
+		0, 25, 416, 1, 0, 0, 0, 27, 423, 1, 0, 0, 0, 29, 430, 1, 0, 0, 0, 31, 434,
+		1, 0, 0, 0, 33, 441, 1, 0, 0, 0, 35, 448, 1, 0, 0, 0, 37, 454, 1, 0, 0,
+		0, 39, 463, 1, 0, 0, 0, 41, 468, 1, 0, 0, 0, 43, 476, 1, 0, 0, 0, 45, 480,
+		1, 0, 0, 0, 47, 484, 1, 0, 0, 0, 49, 489, 1, 0, 0, 0, 51, 494, 1, 0, 0,
+		0, 53, 500, 1, 0, 0, 0, 55, 503, 1, 0, 0, 0, 57, 508, 1, 0, 0, 0, 59, 511,
+		1, 0, 0, 0, 61, 515, 1, 0, 0, 0, 63, 518, 1, 0, 0, 0, 65, 523, 1, 0, 0,
+		0, 67, 526, 1, 0, 0, 0, 69, 536, 1, 0, 0, 0, 71, 540, 1, 0, 0, 0, 73, 545,
+		1, 0, 0, 0, 75, 551, 1, 0, 0, 0, 77, 556, 1, 0, 0, 0, 79, 562, 1, 0, 0,
+		0, 81, 567, 1, 0, 0, 0, 83, 573, 1, 0, 0, 0, 85, 577, 1, 0, 0, 0, 87, 582,
+		1, 0, 0, 0, 89, 592, 1, 0, 0, 0, 91, 599, 1, 0, 0, 0, 93, 607, 1, 0, 0,
+		0, 95, 615, 1, 0, 0, 0, 97, 623, 1, 0, 0, 0, 99, 630, 1, 0, 0, 0, 101,
+		638, 1, 0, 0, 0, 103, 644, 1, 0, 0, 0, 105, 652, 1, 0, 0, 0, 107, 656,
+		1, 0, 0, 0, 109, 664, 1, 0, 0, 0, 111, 672, 1, 0, 0, 0, 113, 680, 1, 0,
+		0, 0, 115, 687, 1, 0, 0, 0, 117, 697, 1, 0, 0, 0, 119, 703, 1, 0, 0, 0,
+		121, 715, 1, 0, 0, 0, 123, 722, 1, 0, 0, 0, 125, 731, 1, 0, 0, 0, 127,
+		736, 1, 0, 0, 0, 129, 742, 1, 0, 0, 0, 131, 745, 1, 0, 0, 0, 133, 749,
+		1, 0, 0, 0, 135, 755, 1, 0, 0, 0, 137, 760, 1, 0, 0, 0, 139, 765, 1, 0,
+		0, 0, 141, 771, 1, 0, 0, 0, 143, 776, 1, 0, 0, 0, 145, 779, 1, 0, 0, 0,
+		147, 784, 1, 0, 0, 0, 149, 795, 1, 0, 0, 0, 151, 800, 1, 0, 0, 0, 153,
+		805, 1, 0, 0, 0, 155, 814, 1, 0, 0, 0, 157, 828, 1, 0, 0, 0, 159, 834,
+		1, 0, 0, 0, 161, 842, 1, 0, 0, 0, 163, 848, 1, 0, 0, 0, 165, 856, 1, 0,
+		0, 0, 167, 865, 1, 0, 0, 0, 169, 874, 1, 0, 0, 0, 171, 882, 1, 0, 0, 0,
+		173, 889, 1, 0, 0, 0, 175, 892, 1, 0, 0, 0, 177, 897, 1, 0, 0, 0, 179,
+		902, 1, 0, 0, 0, 181, 911, 1, 0, 0, 0, 183, 921, 1, 0, 0, 0, 185, 933,
+		1, 0, 0, 0, 187, 939, 1, 0, 0, 0, 189, 946, 1, 0, 0, 0, 191, 957, 1, 0,
+		0, 0, 193, 960, 1, 0, 0, 0, 195, 967, 1, 0, 0, 0, 197, 972, 1, 0, 0, 0,
+		199, 978, 1, 0, 0, 0, 201, 986, 1, 0, 0, 0, 203, 997, 1, 0, 0, 0, 205,
+		1005, 1, 0, 0, 0, 207, 1012, 1, 0, 0, 0, 209, 1024, 1, 0, 0, 0, 211, 1031,
+		1, 0, 0, 0, 213, 1038, 1, 0, 0, 0, 215, 1044, 1, 0, 0, 0, 217, 1048, 1,
+		0, 0, 0, 219, 1052, 1, 0, 0, 0, 221, 1060, 1, 0, 0, 0, 223, 1068, 1, 0,
+		0, 0, 225, 1079, 1, 0, 0, 0, 227, 1084, 1, 0, 0, 0, 229, 1091, 1, 0, 0,
+		0, 231, 1098, 1, 0, 0, 0, 233, 1107, 1, 0, 0, 0, 235, 1116, 1, 0, 0, 0,
+		237, 1123, 1, 0, 0, 0, 239, 1125, 1, 0, 0, 0, 241, 1131, 1, 0, 0, 0, 243,
+		1133, 1, 0, 0, 0, 245, 1135, 1, 0, 0, 0, 247, 1138, 1, 0, 0, 0, 249, 1140,
+		1, 0, 0, 0, 251, 1143, 1, 0, 0, 0, 253, 1145, 1, 0, 0, 0, 255, 1147, 1,
+		0, 0, 0, 257, 1149, 1, 0, 0, 0, 259, 1151, 1, 0, 0, 0, 261, 1153, 1, 0,
+		0, 0, 263, 1155, 1, 0, 0, 0, 265, 1157, 1, 0, 0, 0, 267, 1159, 1, 0, 0,
+		0, 269, 1161, 1, 0, 0, 0, 271, 1169, 1, 0, 0, 0, 273, 1174, 1, 0, 0, 0,
+		275, 1185, 1, 0, 0, 0, 277, 1198, 1, 0, 0, 0, 279, 1205, 1, 0, 0, 0, 281,
+		1211, 1, 0, 0, 0, 283, 1213, 1, 0, 0, 0, 285, 1215, 1, 0, 0, 0, 287, 1217,
+		1, 0, 0, 0, 289, 1219, 1, 0, 0, 0, 291, 1221, 1, 0, 0, 0, 293, 1223, 1,
+		0, 0, 0, 295, 1225, 1, 0, 0, 0, 297, 1227, 1, 0, 0, 0, 299, 1229, 1, 0,
+		0, 0, 301, 1231, 1, 0, 0, 0, 303, 1233, 1, 0, 0, 0, 305, 1235, 1, 0, 0,
+		0, 307, 1237, 1, 0, 0, 0, 309, 1239, 1, 0, 0, 0, 311, 1241, 1, 0, 0, 0,
+		313, 1243, 1, 0, 0, 0, 315, 1245, 1, 0, 0, 0, 317, 1247, 1, 0, 0, 0, 319,
+		1249, 1, 0, 0, 0, 321, 1251, 1, 0, 0, 0, 323, 1253, 1, 0, 0, 0, 325, 1255,
+		1, 0, 0, 0, 327, 1257, 1, 0, 0, 0, 329, 1259, 1, 0, 0, 0, 331, 1261, 1,
+		0, 0, 0, 333, 334, 5, 45, 0, 0, 334, 335, 5, 45, 0, 0, 335, 339, 1, 0,
+		0, 0, 336, 338, 8, 0, 0, 0, 337, 336, 1, 0, 0, 0, 338, 341, 1, 0, 0, 0,
+		339, 337, 1, 0, 0, 0, 339, 340, 1, 0, 0, 0, 340, 342, 1, 0, 0, 0, 341,
+		339, 1, 0, 0, 0, 342, 343, 6, 0, 0, 0, 343, 2, 1, 0, 0, 0, 344, 345, 5,
+		47, 0, 0, 345, 346, 5, 42, 0, 0, 346, 350, 1, 0, 0, 0, 347, 349, 9, 0,
+		0, 0, 348, 347, 1, 0, 0, 0, 349, 352, 1, 0, 0, 0, 350, 351, 1, 0, 0, 0,
+		350, 348, 1, 0, 0, 0, 351, 353, 1, 0, 0, 0, 352, 350, 1, 0, 0, 0, 353,
+		354, 5, 42, 0, 0, 354, 355, 5, 47, 0, 0, 355, 356, 1, 0, 0, 0, 356, 357,
+		6, 1, 0, 0, 357, 4, 1, 0, 0, 0, 358, 359, 3, 317, 158, 0, 359, 360, 3,
+		289, 144, 0, 360, 361, 3, 303, 151, 0, 361, 362, 3, 289, 144, 0, 362, 363,
+		3, 285, 142, 0, 363, 364, 3, 319, 159, 0, 364, 6, 1, 0, 0, 0, 365, 366,
+		3, 291, 145, 0, 366, 367, 3, 315, 157, 0, 367, 368, 3, 309, 154, 0, 368,
+		369, 3, 305, 152, 0, 369, 8, 1, 0, 0, 0, 370, 371, 3, 325, 162, 0, 371,
+		372, 3, 295, 147, 0, 372, 373, 3, 289, 144, 0, 373, 374, 3, 315, 157, 0,
+		374, 375, 3, 289, 144, 0, 375, 10, 1, 0, 0, 0, 376, 377, 3, 293, 146, 0,
+		377, 378, 3, 315, 157, 0, 378, 379, 3, 309, 154, 0, 379, 380, 3, 321, 160,
+		0, 380, 381, 3, 311, 155, 0, 381, 12, 1, 0, 0, 0, 382, 383, 3, 283, 141,
+		0, 383, 384, 3, 329, 164, 0, 384, 14, 1, 0, 0, 0, 385, 386, 3, 295, 147,
+		0, 386, 387, 3, 281, 140, 0, 387, 388, 3, 323, 161, 0, 388, 389, 3, 297,
+		148, 0, 389, 390, 3, 307, 153, 0, 390, 391, 3, 293, 146, 0, 391, 16, 1,
+		0, 0, 0, 392, 393, 3, 309, 154, 0, 393, 394, 3, 315, 157, 0, 394, 395,
+		3, 287, 143, 0, 395, 396, 3, 289, 144, 0, 396, 397, 3, 315, 157, 0, 397,
+		18, 1, 0, 0, 0, 398, 399, 3, 303, 151, 0, 399, 400, 3, 297, 148, 0, 400,
+		401, 3, 305, 152, 0, 401, 402, 3, 297, 148, 0, 402, 403, 3, 319, 159, 0,
+		403, 20, 1, 0, 0, 0, 404, 405, 3, 297, 148, 0, 405, 406, 3, 307, 153, 0,
+		406, 407, 3, 317, 158, 0, 407, 408, 3, 289, 144, 0, 408, 409, 3, 315, 157,
+		0, 409, 410, 3, 319, 159, 0, 410, 22, 1, 0, 0, 0, 411, 412, 3, 297, 148,
+		0, 412, 413, 3, 307, 153, 0, 413, 414, 3, 319, 159, 0, 414, 415, 3, 309,
+		154, 0, 415, 24, 1, 0, 0, 0, 416, 417, 3, 323, 161, 0, 417, 418, 3, 281,
+		140, 0, 418, 419, 3, 303, 151, 0, 419, 420, 3, 321, 160, 0, 420, 421, 3,
+		289, 144, 0, 421, 422, 3, 317, 158, 0, 422, 26, 1, 0, 0, 0, 423, 424, 3,
+		321, 160, 0, 424, 425, 3, 311, 155, 0, 425, 426, 3, 287, 143, 0, 426, 427,
+		3, 281, 140, 0, 427, 428, 3, 319, 159, 0, 428, 429, 3, 289, 144, 0, 429,
+		28, 1, 0, 0, 0, 430, 431, 3, 317, 158, 0, 431, 432, 3, 289, 144, 0, 432,
+		433, 3, 319, 159, 0, 433, 30, 1, 0, 0, 0, 434, 435, 3, 287, 143, 0, 435,
+		436, 3, 289, 144, 0, 436, 437, 3, 303, 151, 0, 437, 438, 3, 289, 144, 0,
+		438, 439, 3, 319, 159, 0, 439, 440, 3, 289, 144, 0, 440, 32, 1, 0, 0, 0,
+		441, 442, 3, 285, 142, 0, 442, 443, 3, 315, 157, 0, 443, 444, 3, 289, 144,
+		0, 444, 445, 3, 281, 140, 0, 445, 446, 3, 319, 159, 0, 446, 447, 3, 289,
+		144, 0, 447, 34, 1, 0, 0, 0, 448, 449, 3, 319, 159, 0, 449, 450, 3, 281,
+		140, 0, 450, 451, 3, 283, 141, 0, 451, 452, 3, 303, 151, 0, 452, 453, 3,
+		289, 144, 0, 453, 36, 1, 0, 0, 0, 454, 455, 3, 287, 143, 0, 455, 456, 3,
+		281, 140, 0, 456, 457, 3, 319, 159, 0, 457, 458, 3, 281, 140, 0, 458, 459,
+		3, 283, 141, 0, 459, 460, 3, 281, 140, 0, 460, 461, 3, 317, 158, 0, 461,
+		462, 3, 289, 144, 0, 462, 38, 1, 0, 0, 0, 463, 464, 3, 287, 143, 0, 464,
+		465, 3, 315, 157, 0, 465, 466, 3, 309, 154, 0, 466, 467, 3, 311, 155, 0,
+		467, 40, 1, 0, 0, 0, 468, 469, 3, 311, 155, 0, 469, 470, 3, 315, 157, 0,
+		470, 471, 3, 297, 148, 0, 471, 472, 3, 305, 152, 0, 472, 473, 3, 281, 140,
+		0, 473, 474, 3, 315, 157, 0, 474, 475, 3, 329, 164, 0, 475, 42, 1, 0, 0,
+		0, 476, 477, 3, 301, 150, 0, 477, 478, 3, 289, 144, 0, 478, 479, 3, 329,
+		164, 0, 479, 44, 1, 0, 0, 0, 480, 481, 3, 307, 153, 0, 481, 482, 3, 309,
+		154, 0, 482, 483, 3, 319, 159, 0, 483, 46, 1, 0, 0, 0, 484, 485, 3, 307,
+		153, 0, 485, 486, 3, 321, 160, 0, 486, 487, 3, 303, 151, 0, 487, 488, 3,
+		303, 151, 0, 488, 48, 1, 0, 0, 0, 489, 490, 3, 319, 159, 0, 490, 491, 3,
+		315, 157, 0, 491, 492, 3, 321, 160, 0, 492, 493, 3, 289, 144, 0, 493, 50,
+		1, 0, 0, 0, 494, 495, 3, 291, 145, 0, 495, 496, 3, 281, 140, 0, 496, 497,
+		3, 303, 151, 0, 497, 498, 3, 317, 158, 0, 498, 499, 3, 289, 144, 0, 499,
+		52, 1, 0, 0, 0, 500, 501, 3, 281, 140, 0, 501, 502, 3, 317, 158, 0, 502,
+		54, 1, 0, 0, 0, 503, 504, 3, 303, 151, 0, 504, 505, 3, 297, 148, 0, 505,
+		506, 3, 301, 150, 0, 506, 507, 3, 289, 144, 0, 507, 56, 1, 0, 0, 0, 508,
+		509, 3, 297, 148, 0, 509, 510, 3, 307, 153, 0, 510, 58, 1, 0, 0, 0, 511,
+		512, 3, 281, 140, 0, 512, 513, 3, 307, 153, 0, 513, 514, 3, 287, 143, 0,
+		514, 60, 1, 0, 0, 0, 515, 516, 3, 309, 154, 0, 516, 517, 3, 315, 157, 0,
+		517, 62, 1, 0, 0, 0, 518, 519, 3, 299, 149, 0, 519, 520, 3, 309, 154, 0,
+		520, 521, 3, 297, 148, 0, 521, 522, 3, 307, 153, 0, 522, 64, 1, 0, 0, 0,
+		523, 524, 3, 309, 154, 0, 524, 525, 3, 307, 153, 0, 525, 66, 1, 0, 0, 0,
+		526, 527, 3, 311, 155, 0, 527, 528, 3, 281, 140, 0, 528, 529, 3, 315, 157,
+		0, 529, 530, 3, 319, 159, 0, 530, 531, 3, 297, 148, 0, 531, 532, 3, 319,
+		159, 0, 532, 533, 3, 297, 148, 0, 533, 534, 3, 309, 154, 0, 534, 535, 3,
+		307, 153, 0, 535, 68, 1, 0, 0, 0, 536, 537, 3, 281, 140, 0, 537, 538, 3,
+		317, 158, 0, 538, 539, 3, 285, 142, 0, 539, 70, 1, 0, 0, 0, 540, 541, 3,
+		287, 143, 0, 541, 542, 3, 289, 144, 0, 542, 543, 3, 317, 158, 0, 543, 544,
+		3, 285, 142, 0, 544, 72, 1, 0, 0, 0, 545, 546, 3, 297, 148, 0, 546, 547,
+		3, 307, 153, 0, 547, 548, 3, 307, 153, 0, 548, 549, 3, 289, 144, 0, 549,
+		550, 3, 315, 157, 0, 550, 74, 1, 0, 0, 0, 551, 552, 3, 303, 151, 0, 552,
+		553, 3, 289, 144, 0, 553, 554, 3, 291, 145, 0, 554, 555, 3, 319, 159, 0,
+		555, 76, 1, 0, 0, 0, 556, 557, 3, 315, 157, 0, 557, 558, 3, 297, 148, 0,
+		558, 559, 3, 293, 146, 0, 559, 560, 3, 295, 147, 0, 560, 561, 3, 319, 159,
+		0, 561, 78, 1, 0, 0, 0, 562, 563, 3, 291, 145, 0, 563, 564, 3, 321, 160,
+		0, 564, 565, 3, 303, 151, 0, 565, 566, 3, 303, 151, 0, 566, 80, 1, 0, 0,
+		0, 567, 568, 3, 309, 154, 0, 568, 569, 3, 321, 160, 0, 569, 570, 3, 319,
+		159, 0, 570, 571, 3, 289, 144, 0, 571, 572, 3, 315, 157, 0, 572, 82, 1,
+		0, 0, 0, 573, 574, 3, 321, 160, 0, 574, 575, 3, 317, 158, 0, 575, 576,
+		3, 289, 144, 0, 576, 84, 1, 0, 0, 0, 577, 578, 3, 317, 158, 0, 578, 579,
+		3, 295, 147, 0, 579, 580, 3, 309, 154, 0, 580, 581, 3, 325, 162, 0, 581,
+		86, 1, 0, 0, 0, 582, 583, 3, 287, 143, 0, 583, 584, 3, 281, 140, 0, 584,
+		585, 3, 319, 159, 0, 585, 586, 3, 281, 140, 0, 586, 587, 3, 283, 141, 0,
+		587, 588, 3, 281, 140, 0, 588, 589, 3, 317, 158, 0, 589, 590, 3, 289, 144,
+		0, 590, 591, 3, 317, 158, 0, 591, 88, 1, 0, 0, 0, 592, 593, 3, 319, 159,
+		0, 593, 594, 3, 281, 140, 0, 594, 595, 3, 283, 141, 0, 595, 596, 3, 303,
+		151, 0, 596, 597, 3, 289, 144, 0, 597, 598, 3, 317, 158, 0, 598, 90, 1,
+		0, 0, 0, 599, 600, 3, 289, 144, 0, 600, 601, 3, 327, 163, 0, 601, 602,
+		3, 311, 155, 0, 602, 603, 3, 303, 151, 0, 603, 604, 3, 281, 140, 0, 604,
+		605, 3, 297, 148, 0, 605, 606, 3, 307, 153, 0, 606, 92, 1, 0, 0, 0, 607,
+		608, 3, 281, 140, 0, 608, 609, 3, 307, 153, 0, 609, 610, 3, 281, 140, 0,
+		610, 611, 3, 303, 151, 0, 611, 612, 3, 329, 164, 0, 612, 613, 3, 331, 165,
+		0, 613, 614, 3, 289, 144, 0, 614, 94, 1, 0, 0, 0, 615, 616, 3, 323, 161,
+		0, 616, 617, 3, 289, 144, 0, 617, 618, 3, 315, 157, 0, 618, 619, 3, 283,
+		141, 0, 619, 620, 3, 309, 154, 0, 620, 621, 3, 317, 158, 0, 621, 622, 3,
+		289, 144, 0, 622, 96, 1, 0, 0, 0, 623, 624, 3, 321, 160, 0, 624, 625, 3,
+		307, 153, 0, 625, 626, 3, 297, 148, 0, 626, 627, 3, 313, 156, 0, 627, 628,
+		3, 321, 160, 0, 628, 629, 3, 289, 144, 0, 629, 98, 1, 0, 0, 0, 630, 631,
+		3, 287, 143, 0, 631, 632, 3, 289, 144, 0, 632, 633, 3, 291, 145, 0, 633,
+		634, 3, 281, 140, 0, 634, 635, 3, 321, 160, 0, 635, 636, 3, 303, 151, 0,
+		636, 637, 3, 319, 159, 0, 637, 100, 1, 0, 0, 0, 638, 639, 3, 297, 148,
+		0, 639, 640, 3, 307, 153, 0, 640, 641, 3, 287, 143, 0, 641, 642, 3, 289,
+		144, 0, 642, 643, 3, 327, 163, 0, 643, 102, 1, 0, 0, 0, 644, 645, 3, 297,
+		148, 0, 645, 646, 3, 307, 153, 0, 646, 647, 3, 287, 143, 0, 647, 648, 3,
+		289, 144, 0, 648, 649, 3, 327, 163, 0, 649, 650, 3, 289, 144, 0, 650, 651,
+		3, 317, 158, 0, 651, 104, 1, 0, 0, 0, 652, 653, 3, 297, 148, 0, 653, 654,
+		3, 307, 153, 0, 654, 655, 3, 319, 159, 0, 655, 106, 1, 0, 0, 0, 656, 657,
+		3, 297, 148, 0, 657, 658, 3, 307, 153, 0, 658, 659, 3, 319, 159, 0, 659,
+		660, 3, 289, 144, 0, 660, 661, 3, 293, 146, 0, 661, 662, 3, 289, 144, 0,
+		662, 663, 3, 315, 157, 0, 663, 108, 1, 0, 0, 0, 664, 665, 3, 323, 161,
+		0, 665, 666, 3, 281, 140, 0, 666, 667, 3, 315, 157, 0, 667, 668, 3, 285,
+		142, 0, 668, 669, 3, 295, 147, 0, 669, 670, 3, 281, 140, 0, 670, 671, 3,
+		315, 157, 0, 671, 110, 1, 0, 0, 0, 672, 673, 3, 283, 141, 0, 673, 674,
+		3, 309, 154, 0, 674, 675, 3, 309, 154, 0, 675, 676, 3, 303, 151, 0, 676,
+		677, 3, 289, 144, 0, 677, 678, 3, 281, 140, 0, 678, 679, 3, 307, 153, 0,
+		679, 112, 1, 0, 0, 0, 680, 681, 3, 287, 143, 0, 681, 682, 3, 309, 154,
+		0, 682, 683, 3, 321, 160, 0, 683, 684, 3, 283, 141, 0, 684, 685, 3, 303,
+		151, 0, 685, 686, 3, 289, 144, 0, 686, 114, 1, 0, 0, 0, 687, 688, 3, 319,
+		159, 0, 688, 689, 3, 297, 148, 0, 689, 690, 3, 305, 152, 0, 690, 691, 3,
+		289, 144, 0, 691, 692, 3, 317, 158, 0, 692, 693, 3, 319, 159, 0, 693, 694,
+		3, 281, 140, 0, 694, 695, 3, 305, 152, 0, 695, 696, 3, 311, 155, 0, 696,
+		116, 1, 0, 0, 0, 697, 698, 3, 317, 158, 0, 698, 699, 3, 319, 159, 0, 699,
+		700, 3, 281, 140, 0, 700, 701, 3, 315, 157, 0, 701, 702, 3, 319, 159, 0,
+		702, 118, 1, 0, 0, 0, 703, 704, 3, 319, 159, 0, 704, 705, 3, 315, 157,
+		0, 705, 706, 3, 281, 140, 0, 706, 707, 3, 307, 153, 0, 707, 708, 3, 317,
+		158, 0, 708, 709, 3, 281, 140, 0, 709, 710, 3, 285, 142, 0, 710, 711, 3,
+		319, 159, 0, 711, 712, 3, 297, 148, 0, 712, 713, 3, 309, 154, 0, 713, 714,
+		3, 307, 153, 0, 714, 120, 1, 0, 0, 0, 715, 716, 3, 285, 142, 0, 716, 717,
+		3, 309, 154, 0, 717, 718, 3, 305, 152, 0, 718, 719, 3, 305, 152, 0, 719,
+		720, 3, 297, 148, 0, 720, 721, 3, 319, 159, 0, 721, 122, 1, 0, 0, 0, 722,
+		723, 3, 315, 157, 0, 723, 724, 3, 309, 154, 0, 724, 725, 3, 303, 151, 0,
+		725, 726, 3, 303, 151, 0, 726, 727, 3, 283, 141, 0, 727, 728, 3, 281, 140,
+		0, 728, 729, 3, 285, 142, 0, 729, 730, 3, 301, 150, 0, 730, 124, 1, 0,
+		0, 0, 731, 732, 3, 295, 147, 0, 732, 733, 3, 281, 140, 0, 733, 734, 3,
+		317, 158, 0, 734, 735, 3, 295, 147, 0, 735, 126, 1, 0, 0, 0, 736, 737,
+		3, 315, 157, 0, 737, 738, 3, 281, 140, 0, 738, 739, 3, 307, 153, 0, 739,
+		740, 3, 293, 146, 0, 740, 741, 3, 289, 144, 0, 741, 128, 1, 0, 0, 0, 742,
+		743, 3, 319, 159, 0, 743, 744, 3, 309, 154, 0, 744, 130, 1, 0, 0, 0, 745,
+		746, 3, 281, 140, 0, 746, 747, 3, 303, 151, 0, 747, 748, 3, 303, 151, 0,
+		748, 132, 1, 0, 0, 0, 749, 750, 3, 315, 157, 0, 750, 751, 3, 289, 144,
+		0, 751, 752, 3, 317, 158, 0, 752, 753, 3, 289, 144, 0, 753, 754, 3, 319,
+		159, 0, 754, 134, 1, 0, 0, 0, 755, 756, 3, 319, 159, 0, 756, 757, 3, 297,
+		148, 0, 757, 758, 3, 305, 152, 0, 758, 759, 3, 289, 144, 0, 759, 136, 1,
+		0, 0, 0, 760, 761, 3, 331, 165, 0, 761, 762, 3, 309, 154, 0, 762, 763,
+		3, 307, 153, 0, 763, 764, 3, 289, 144, 0, 764, 138, 1, 0, 0, 0, 765, 766,
+		3, 281, 140, 0, 766, 767, 3, 303, 151, 0, 767, 768, 3, 319, 159, 0, 768,
+		769, 3, 289, 144, 0, 769, 770, 3, 315, 157, 0, 770, 140, 1, 0, 0, 0, 771,
+		772, 3, 325, 162, 0, 772, 773, 3, 297, 148, 0, 773, 774, 3, 319, 159, 0,
+		774, 775, 3, 295, 147, 0, 775, 142, 1, 0, 0, 0, 776, 777, 3, 309, 154,
+		0, 777, 778, 3, 291, 145, 0, 778, 144, 1, 0, 0, 0, 779, 780, 3, 303, 151,
+		0, 780, 781, 3, 297, 148, 0, 781, 782, 3, 317, 158, 0, 782, 783, 3, 319,
+		159, 0, 783, 146, 1, 0, 0, 0, 784, 785, 3, 311, 155, 0, 785, 786, 3, 281,
+		140, 0, 786, 787, 3, 315, 157, 0, 787, 788, 3, 319, 159, 0, 788, 789, 3,
+		297, 148, 0, 789, 790, 3, 319, 159, 0, 790, 791, 3, 297, 148, 0, 791, 792,
+		3, 309, 154, 0, 792, 793, 3, 307, 153, 0, 793, 794, 3, 317, 158, 0, 794,
+		148, 1, 0, 0, 0, 795, 796, 3, 303, 151, 0, 796, 797, 3, 289, 144, 0, 797,
+		798, 3, 317, 158, 0, 798, 799, 3, 317, 158, 0, 799, 150, 1, 0, 0, 0, 800,
+		801, 3, 319, 159, 0, 801, 802, 3, 295, 147, 0, 802, 803, 3, 281, 140, 0,
+		803, 804, 3, 307, 153, 0, 804, 152, 1, 0, 0, 0, 805, 806, 3, 305, 152,
+		0, 806, 807, 3, 281, 140, 0, 807, 808, 3, 327, 163, 0, 808, 809, 3, 323,
+		161, 0, 809, 810, 3, 281, 140, 0, 810, 811, 3, 303, 151, 0, 811, 812, 3,
+		321, 160, 0, 812, 813, 3, 289, 144, 0, 813, 154, 1, 0, 0, 0, 814, 815,
+		3, 319, 159, 0, 815, 816, 3, 283, 141, 0, 816, 817, 3, 303, 151, 0, 817,
+		818, 3, 311, 155, 0, 818, 819, 3, 315, 157, 0, 819, 820, 3, 309, 154, 0,
+		820, 821, 3, 311, 155, 0, 821, 822, 3, 289, 144, 0, 822, 823, 3, 315, 157,
+		0, 823, 824, 3, 319, 159, 0, 824, 825, 3, 297, 148, 0, 825, 826, 3, 289,
+		144, 0, 826, 827, 3, 317, 158, 0, 827, 156, 1, 0, 0, 0, 828, 829, 3, 321,
+		160, 0, 829, 830, 3, 307, 153, 0, 830, 831, 3, 317, 158, 0, 831, 832, 3,
+		289, 144, 0, 832, 833, 3, 319, 159, 0, 833, 158, 1, 0, 0, 0, 834, 835,
+		3, 317, 158, 0, 835, 836, 3, 295, 147, 0, 836, 837, 3, 281, 140, 0, 837,
+		838, 3, 303, 151, 0, 838, 839, 3, 303, 151, 0, 839, 840, 3, 309, 154, 0,
+		840, 841, 3, 325, 162, 0, 841, 160, 1, 0, 0, 0, 842, 843, 3, 285, 142,
+		0, 843, 844, 3, 303, 151, 0, 844, 845, 3, 309, 154, 0, 845, 846, 3, 307,
+		153, 0, 846, 847, 3, 289, 144, 0, 847, 162, 1, 0, 0, 0, 848, 849, 3, 323,
+		161, 0, 849, 850, 3, 289, 144, 0, 850, 851, 3, 315, 157, 0, 851, 852, 3,
+		317, 158, 0, 852, 853, 3, 297, 148, 0, 853, 854, 3, 309, 154, 0, 854, 855,
+		3, 307, 153, 0, 855, 164, 1, 0, 0, 0, 856, 857, 3, 287, 143, 0, 857, 858,
+		3, 289, 144, 0, 858, 859, 3, 317, 158, 0, 859, 860, 3, 285, 142, 0, 860,
+		861, 3, 315, 157, 0, 861, 862, 3, 297, 148, 0, 862, 863, 3, 283, 141, 0,
+		863, 864, 3, 289, 144, 0, 864, 166, 1, 0, 0, 0, 865, 866, 3, 289, 144,
+		0, 866, 867, 3, 327, 163, 0, 867, 868, 3, 319, 159, 0, 868, 869, 3, 289,
+		144, 0, 869, 870, 3, 307, 153, 0, 870, 871, 3, 287, 143, 0, 871, 872, 3,
+		289, 144, 0, 872, 873, 3, 287, 143, 0, 873, 168, 1, 0, 0, 0, 874, 875,
+		3, 285, 142, 0, 875, 876, 3, 309, 154, 0, 876, 877, 3, 305, 152, 0, 877,
+		878, 3, 305, 152, 0, 878, 879, 3, 289, 144, 0, 879, 880, 3, 307, 153, 0,
+		880, 881, 3, 319, 159, 0, 881, 170, 1, 0, 0, 0, 882, 883, 3, 285, 142,
+		0, 883, 884, 3, 309, 154, 0, 884, 885, 3, 303, 151, 0, 885, 886, 3, 321,
+		160, 0, 886, 887, 3, 305, 152, 0, 887, 888, 3, 307, 153, 0, 888, 172, 1,
+		0, 0, 0, 889, 890, 3, 297, 148, 0, 890, 891, 3, 317, 158, 0, 891, 174,
+		1, 0, 0, 0, 892, 893, 3, 321, 160, 0, 893, 894, 3, 317, 158, 0, 894, 895,
+		3, 289, 144, 0, 895, 896, 3, 315, 157, 0, 896, 176, 1, 0, 0, 0, 897, 898,
+		3, 315, 157, 0, 898, 899, 3, 309, 154, 0, 899, 900, 3, 303, 151, 0, 900,
+		901, 3, 289, 144, 0, 901, 178, 1, 0, 0, 0, 902, 903, 3, 311, 155, 0, 903,
+		904, 3, 281, 140, 0, 904, 905, 3, 317, 158, 0, 905, 906, 3, 317, 158, 0,
+		906, 907, 3, 325, 162, 0, 907, 908, 3, 309, 154, 0, 908, 909, 3, 315, 157,
+		0, 909, 910, 3, 287, 143, 0, 910, 180, 1, 0, 0, 0, 911, 912, 3, 317, 158,
+		0, 912, 913, 3, 321, 160, 0, 913, 914, 3, 311, 155, 0, 914, 915, 3, 289,
+		144, 0, 915, 916, 3, 315, 157, 0, 916, 917, 3, 321, 160, 0, 917, 918, 3,
+		317, 158, 0, 918, 919, 3, 289, 144, 0, 919, 920, 3, 315, 157, 0, 920, 182,
+		1, 0, 0, 0, 921, 922, 3, 307, 153, 0, 922, 923, 3, 309, 154, 0, 923, 924,
+		3, 317, 158, 0, 924, 925, 3, 321, 160, 0, 925, 926, 3, 311, 155, 0, 926,
+		927, 3, 289, 144, 0, 927, 928, 3, 315, 157, 0, 928, 929, 3, 321, 160, 0,
+		929, 930, 3, 317, 158, 0, 930, 931, 3, 289, 144, 0, 931, 932, 3, 315, 157,
+		0, 932, 184, 1, 0, 0, 0, 933, 934, 3, 293, 146, 0, 934, 935, 3, 315, 157,
+		0, 935, 936, 3, 281, 140, 0, 936, 937, 3, 307, 153, 0, 937, 938, 3, 319,
+		159, 0, 938, 186, 1, 0, 0, 0, 939, 940, 3, 315, 157, 0, 940, 941, 3, 289,
+		144, 0, 941, 942, 3, 323, 161, 0, 942, 943, 3, 309, 154, 0, 943, 944, 3,
+		301, 150, 0, 944, 945, 3, 289, 144, 0, 945, 188, 1, 0, 0, 0, 946, 947,
+		3, 311, 155, 0, 947, 948, 3, 315, 157, 0, 948, 949, 3, 297, 148, 0, 949,
+		950, 3, 323, 161, 0, 950, 951, 3, 297, 148, 0, 951, 952, 3, 303, 151, 0,
+		952, 953, 3, 289, 144, 0, 953, 954, 3, 293, 146, 0, 954, 955, 3, 289, 144,
+		0, 955, 956, 3, 317, 158, 0, 956, 190, 1, 0, 0, 0, 957, 958, 3, 297, 148,
+		0, 958, 959, 3, 291, 145, 0, 959, 192, 1, 0, 0, 0, 960, 961, 3, 289, 144,
+		0, 961, 962, 3, 327, 163, 0, 962, 963, 3, 297, 148, 0, 963, 964, 3, 317,
+		158, 0, 964, 965, 3, 319, 159, 0, 965, 966, 3, 317, 158, 0, 966, 194, 1,
+		0, 0, 0, 967, 968, 3, 301, 150, 0, 968, 969, 3, 297, 148, 0, 969, 970,
+		3, 303, 151, 0, 970, 971, 3, 303, 151, 0, 971, 196, 1, 0, 0, 0, 972, 973,
+		3, 313, 156, 0, 973, 974, 3, 321, 160, 0, 974, 975, 3, 289, 144, 0, 975,
+		976, 3, 315, 157, 0, 976, 977, 3, 329, 164, 0, 977, 198, 1, 0, 0, 0, 978,
+		979, 3, 317, 158, 0, 979, 980, 3, 289, 144, 0, 980, 981, 3, 317, 158, 0,
+		981, 982, 3, 317, 158, 0, 982, 983, 3, 297, 148, 0, 983, 984, 3, 309, 154,
+		0, 984, 985, 3, 307, 153, 0, 985, 200, 1, 0, 0, 0, 986, 987, 3, 285, 142,
+		0, 987, 988, 3, 309, 154, 0, 988, 989, 3, 307, 153, 0, 989, 990, 3, 307,
+		153, 0, 990, 991, 3, 289, 144, 0, 991, 992, 3, 285, 142, 0, 992, 993, 3,
+		319, 159, 0, 993, 994, 3, 297, 148, 0, 994, 995, 3, 309, 154, 0, 995, 996,
+		3, 307, 153, 0, 996, 202, 1, 0, 0, 0, 997, 998, 3, 315, 157, 0, 998, 999,
+		3, 289, 144, 0, 999, 1000, 3, 317, 158, 0, 1000, 1001, 3, 319, 159, 0,
+		1001, 1002, 3, 309, 154, 0, 1002, 1003, 3, 315, 157, 0, 1003, 1004, 3,
+		289, 144, 0, 1004, 204, 1, 0, 0, 0, 1005, 1006, 3, 283, 141, 0, 1006, 1007,
+		3, 281, 140, 0, 1007, 1008, 3, 285, 142, 0, 1008, 1009, 3, 301, 150, 0,
+		1009, 1010, 3, 321, 160, 0, 1010, 1011, 3, 311, 155, 0, 1011, 206, 1, 0,
+		0, 0, 1012, 1013, 3, 297, 148, 0, 1013, 1014, 3, 307, 153, 0, 1014, 1015,
+		3, 285, 142, 0, 1015, 1016, 3, 315, 157, 0, 1016, 1017, 3, 289, 144, 0,
+		1017, 1018, 3, 305, 152, 0, 1018, 1019, 3, 289, 144, 0, 1019, 1020, 3,
+		307, 153, 0, 1020, 1021, 3, 319, 159, 0, 1021, 1022, 3, 281, 140, 0, 1022,
+		1023, 3, 303, 151, 0, 1023, 208, 1, 0, 0, 0, 1024, 1025, 3, 323, 161, 0,
+		1025, 1026, 3, 281, 140, 0, 1026, 1027, 3, 285, 142, 0, 1027, 1028, 3,
+		321, 160, 0, 1028, 1029, 3, 321, 160, 0, 1029, 1030, 3, 305, 152, 0, 1030,
+		210, 1, 0, 0, 0, 1031, 1032, 3, 315, 157, 0, 1032, 1033, 3, 289, 144, 0,
+		1033, 1034, 3, 319, 159, 0, 1034, 1035, 3, 281, 140, 0, 1035, 1036, 3,
+		297, 148, 0, 1036, 1037, 3, 307, 153, 0, 1037, 212, 1, 0, 0, 0, 1038, 1039,
+		3, 295, 147, 0, 1039, 1040, 3, 309, 154, 0, 1040, 1041, 3, 321, 160, 0,
+		1041, 1042, 3, 315, 157, 0, 1042, 1043, 3, 317, 158, 0, 1043, 214, 1, 0,
+		0, 0, 1044, 1045, 3, 287, 143, 0, 1045, 1046, 3, 315, 157, 0, 1046, 1047,
+		3, 329, 164, 0, 1047, 216, 1, 0, 0, 0, 1048, 1049, 3, 315, 157, 0, 1049,
+		1050, 3, 321, 160, 0, 1050, 1051, 3, 307, 153, 0, 1051, 218, 1, 0, 0, 0,
+		1052, 1053, 3, 311, 155, 0, 1053, 1054, 3, 315, 157, 0, 1054, 1055, 3,
+		289, 144, 0, 1055, 1056, 3, 311, 155, 0, 1056, 1057, 3, 281, 140, 0, 1057,
+		1058, 3, 315, 157, 0, 1058, 1059, 3, 289, 144, 0, 1059, 220, 1, 0, 0, 0,
+		1060, 1061, 3, 289, 144, 0, 1061, 1062, 3, 327, 163, 0, 1062, 1063, 3,
+		289, 144, 0, 1063, 1064, 3, 285, 142, 0, 1064, 1065, 3, 321, 160, 0, 1065,
+		1066, 3, 319, 159, 0, 1066, 1067, 3, 289, 144, 0, 1067, 222, 1, 0, 0, 0,
+		1068, 1069, 3, 287, 143, 0, 1069, 1070, 3, 289, 144, 0, 1070, 1071, 3,
+		281, 140, 0, 1071, 1072, 3, 303, 151, 0, 1072, 1073, 3, 303, 151, 0, 1073,
+		1074, 3, 309, 154, 0, 1074, 1075, 3, 285, 142, 0, 1075, 1076, 3, 281, 140,
+		0, 1076, 1077, 3, 319, 159, 0, 1077, 1078, 3, 289, 144, 0, 1078, 224, 1,
+		0, 0, 0, 1079, 1080, 3, 285, 142, 0, 1080, 1081, 3, 309, 154, 0, 1081,
+		1082, 3, 311, 155, 0, 1082, 1083, 3, 329, 164, 0, 1083, 226, 1, 0, 0, 0,
+		1084, 1085, 3, 289, 144, 0, 1085, 1086, 3, 327, 163, 0, 1086, 1087, 3,
+		311, 155, 0, 1087, 1088, 3, 309, 154, 0, 1088, 1089, 3, 315, 157, 0, 1089,
+		1090, 3, 319, 159, 0, 1090, 228, 1, 0, 0, 0, 1091, 1092, 3, 297, 148, 0,
+		1092, 1093, 3, 305, 152, 0, 1093, 1094, 3, 311, 155, 0, 1094, 1095, 3,
+		309, 154, 0, 1095, 1096, 3, 315, 157, 0, 1096, 1097, 3, 319, 159, 0, 1097,
+		230, 1, 0, 0, 0, 1098, 1099, 3, 289, 144, 0, 1099, 1100, 3, 327, 163, 0,
+		1100, 1101, 3, 319, 159, 0, 1101, 1102, 3, 289, 144, 0, 1102, 1103, 3,
+		315, 157, 0, 1103, 1104, 3, 307, 153, 0, 1104, 1105, 3, 281, 140, 0, 1105,
+		1106, 3, 303, 151, 0, 1106, 232, 1, 0, 0, 0, 1107, 1108, 3, 303, 151, 0,
+		1108, 1109, 3, 309, 154, 0, 1109, 1110, 3, 285, 142, 0, 1110, 1111, 3,
+		281, 140, 0, 1111, 1112, 3, 319, 159, 0, 1112, 1113, 3, 297, 148, 0, 1113,
+		1114, 3, 309, 154, 0, 1114, 1115, 3, 307, 153, 0, 1115, 234, 1, 0, 0, 0,
+		1116, 1117, 3, 291, 145, 0, 1117, 1118, 3, 309, 154, 0, 1118, 1119, 3,
+		315, 157, 0, 1119, 1120, 3, 305, 152, 0, 1120, 1121, 3, 281, 140, 0, 1121,
+		1122, 3, 319, 159, 0, 1122, 236, 1, 0, 0, 0, 1123, 1124, 5, 42, 0, 0, 1124,
+		238, 1, 0, 0, 0, 1125, 1126, 5, 61, 0, 0, 1126, 240, 1, 0, 0, 0, 1127,
+		1128, 5, 33, 0, 0, 1128, 1132, 5, 61, 0, 0, 1129, 1130, 5, 60, 0, 0, 1130,
+		1132, 5, 62, 0, 0, 1131, 1127, 1, 0, 0, 0, 1131, 1129, 1, 0, 0, 0, 1132,
+		242, 1, 0, 0, 0, 1133, 1134, 5, 62, 0, 0, 1134, 244, 1, 0, 0, 0, 1135,
+		1136, 5, 62, 0, 0, 1136, 1137, 5, 61, 0, 0, 1137, 246, 1, 0, 0, 0, 1138,
+		1139, 5, 60, 0, 0, 1139, 248, 1, 0, 0, 0, 1140, 1141, 5, 60, 0, 0, 1141,
+		1142, 5, 61, 0, 0, 1142, 250, 1, 0, 0, 0, 1143, 1144, 5, 43, 0, 0, 1144,
+		252, 1, 0, 0, 0, 1145, 1146, 5, 45, 0, 0, 1146, 254, 1, 0, 0, 0, 1147,
+		1148, 5, 42, 0, 0, 1148, 256, 1, 0, 0, 0, 1149, 1150, 5, 47, 0, 0, 1150,
+		258, 1, 0, 0, 0, 1151, 1152, 5, 46, 0, 0, 1152, 260, 1, 0, 0, 0, 1153,
+		1154, 5, 44, 0, 0, 1154, 262, 1, 0, 0, 0, 1155, 1156, 5, 59, 0, 0, 1156,
+		264, 1, 0, 0, 0, 1157, 1158, 5, 40, 0, 0, 1158, 266, 1, 0, 0, 0, 1159,
+		1160, 5, 41, 0, 0, 1160, 268, 1, 0, 0, 0, 1161, 1165, 7, 1, 0, 0, 1162,
+		1164, 7, 2, 0, 0, 1163, 1162, 1, 0, 0, 0, 1164, 1167, 1, 0, 0, 0, 1165,
+		1163, 1, 0, 0, 0, 1165, 1166, 1, 0, 0, 0, 1166, 270, 1, 0, 0, 0, 1167,
+		1165, 1, 0, 0, 0, 1168, 1170, 7, 3, 0, 0, 1169, 1168, 1, 0, 0, 0, 1170,
+		1171, 1, 0, 0, 0, 1171, 1169, 1, 0, 0, 0, 1171, 1172, 1, 0, 0, 0, 1172,
+		272, 1, 0, 0, 0, 1173, 1175, 7, 3, 0, 0, 1174, 1173, 1, 0, 0, 0, 1175,
+		1176, 1, 0, 0, 0, 1176, 1174, 1, 0, 0, 0, 1176, 1177, 1, 0, 0, 0, 1177,
+		1178, 1, 0, 0, 0, 1178, 1182, 5, 46, 0, 0, 1179, 1181, 7, 3, 0, 0, 1180,
+		1179, 1, 0, 0, 0, 1181, 1184, 1, 0, 0, 0, 1182, 1180, 1, 0, 0, 0, 1182,
+		1183, 1, 0, 0, 0, 1183, 274, 1, 0, 0, 0, 1184, 1182, 1, 0, 0, 0, 1185,
+		1193, 5, 39, 0, 0, 1186, 1192, 8, 4, 0, 0, 1187, 1188, 5, 92, 0, 0, 1188,
+		1192, 9, 0, 0, 0, 1189, 1190, 5, 39, 0, 0, 1190, 1192, 5, 39, 0, 0, 1191,
+		1186, 1, 0, 0, 0, 1191, 1187, 1, 0, 0, 0, 1191, 1189, 1, 0, 0, 0, 1192,
+		1195, 1, 0, 0, 0, 1193, 1191, 1, 0, 0, 0, 1193, 1194, 1, 0, 0, 0, 1194,
+		1196, 1, 0, 0, 0, 1195, 1193, 1, 0, 0, 0, 1196, 1197, 5, 39, 0, 0, 1197,
+		276, 1, 0, 0, 0, 1198, 1200, 5, 36, 0, 0, 1199, 1201, 7, 3, 0, 0, 1200,
+		1199, 1, 0, 0, 0, 1201, 1202, 1, 0, 0, 0, 1202, 1200, 1, 0, 0, 0, 1202,
+		1203, 1, 0, 0, 0, 1203, 278, 1, 0, 0, 0, 1204, 1206, 7, 5, 0, 0, 1205,
+		1204, 1, 0, 0, 0, 1206, 1207, 1, 0, 0, 0, 1207, 1205, 1, 0, 0, 0, 1207,
+		1208, 1, 0, 0, 0, 1208, 1209, 1, 0, 0, 0, 1209, 1210, 6, 139, 0, 0, 1210,
+		280, 1, 0, 0, 0, 1211, 1212, 7, 6, 0, 0, 1212, 282, 1, 0, 0, 0, 1213, 1214,
+		7, 7, 0, 0, 1214, 284, 1, 0, 0, 0, 1215, 1216, 7, 8, 0, 0, 1216, 286, 1,
+		0, 0, 0, 1217, 1218, 7, 9, 0, 0, 1218, 288, 1, 0, 0, 0, 1219, 1220, 7,
+		10, 0, 0, 1220, 290, 1, 0, 0, 0, 1221, 1222, 7, 11, 0, 0, 1222, 292, 1,
+		0, 0, 0, 1223, 1224, 7, 12, 0, 0, 1224, 294, 1, 0, 0, 0, 1225, 1226, 7,
+		13, 0, 0, 1226, 296, 1, 0, 0, 0, 1227, 1228, 7, 14, 0, 0, 1228, 298, 1,
+		0, 0, 0, 1229, 1230, 7, 15, 0, 0, 1230, 300, 1, 0, 0, 0, 1231, 1232, 7,
+		16, 0, 0, 1232, 302, 1, 0, 0, 0, 1233, 1234, 7, 17, 0, 0, 1234, 304, 1,
+		0, 0, 0, 1235, 1236, 7, 18, 0, 0, 1236, 306, 1, 0, 0, 0, 1237, 1238, 7,
+		19, 0, 0, 1238, 308, 1, 0, 0, 0, 1239, 1240, 7, 20, 0, 0, 1240, 310, 1,
+		0, 0, 0, 1241, 1242, 7, 21, 0, 0, 1242, 312, 1, 0, 0, 0, 1243, 1244, 7,
+		22, 0, 0, 1244, 314, 1, 0, 0, 0, 1245, 1246, 7, 23, 0, 0, 1246, 316, 1,
+		0, 0, 0, 1247, 1248, 7, 24, 0, 0, 1248, 318, 1, 0, 0, 0, 1249, 1250, 7,
+		25, 0, 0, 1250, 320, 1, 0, 0, 0, 1251, 1252, 7, 26, 0, 0, 1252, 322, 1,
+		0, 0, 0, 1253, 1254, 7, 27, 0, 0, 1254, 324, 1, 0, 0, 0, 1255, 1256, 7,
+		28, 0, 0, 1256, 326, 1, 0, 0, 0, 1257, 1258, 7, 29, 0, 0, 1258, 328, 1,
+		0, 0, 0, 1259, 1260, 7, 30, 0, 0, 1260, 330, 1, 0, 0, 0, 1261, 1262, 7,
+		31, 0, 0, 1262, 332, 1, 0, 0, 0, 12, 0, 339, 350, 1131, 1165, 1171, 1176,
+		1182, 1191, 1193, 1202, 1207, 1, 6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -523,25 +780,80 @@ const (
 	MiniQLLexerROLLBACK            = 62
 	MiniQLLexerHASH                = 63
 	MiniQLLexerRANGE               = 64
-	MiniQLLexerASTERISK            = 65
-	MiniQLLexerEQUAL               = 66
-	MiniQLLexerNOT_EQUAL           = 67
-	MiniQLLexerGREATER             = 68
-	MiniQLLexerGREATER_EQUAL       = 69
-	MiniQLLexerLESS                = 70
-	MiniQLLexerLESS_EQUAL          = 71
-	MiniQLLexerPLUS                = 72
-	MiniQLLexerMINUS               = 73
-	MiniQLLexerMULTIPLY            = 74
-	MiniQLLexerDIVIDE              = 75
-	MiniQLLexerDOT                 = 76
-	MiniQLLexerCOMMA               = 77
-	MiniQLLexerSEMICOLON           = 78
-	MiniQLLexerLEFT_PAREN          = 79
-	MiniQLLexerRIGHT_PAREN         = 80
-	MiniQLLexerIDENTIFIER          = 81
-	MiniQLLexerINTEGER_LITERAL     = 82
-	MiniQLLexerFLOAT_LITERAL       = 83
-	MiniQLLexerSTRING_LITERAL      = 84
-	MiniQLLexerWS                  = 85
+	MiniQLLexerTO                  = 65
+	MiniQLLexerALL                 = 66
+	MiniQLLexerRESET               = 67
+	MiniQLLexerTIME                = 68
+	MiniQLLexerZONE                = 69
+	MiniQLLexerALTER               = 70
+	MiniQLLexerWITH                = 71
+	MiniQLLexerOF                  = 72
+	MiniQLLexerLIST                = 73
+	MiniQLLexerPARTITIONS          = 74
+	MiniQLLexerLESS_KW             = 75
+	MiniQLLexerTHAN                = 76
+	MiniQLLexerMAXVALUE            = 77
+	MiniQLLexerTBLPROPERTIES       = 78
+	MiniQLLexerUNSET               = 79
+	MiniQLLexerSHALLOW             = 80
+	MiniQLLexerCLONE               = 81
+	MiniQLLexerVERSION             = 82
+	MiniQLLexerDESCRIBE            = 83
+	MiniQLLexerEXTENDED            = 84
+	MiniQLLexerCOMMENT             = 85
+	MiniQLLexerCOLUMN              = 86
+	MiniQLLexerIS                  = 87
+	MiniQLLexerUSER                = 88
+	MiniQLLexerROLE                = 89
+	MiniQLLexerPASSWORD            = 90
+	MiniQLLexerSUPERUSER           = 91
+	MiniQLLexerNOSUPERUSER         = 92
+	MiniQLLexerGRANT               = 93
+	MiniQLLexerREVOKE              = 94
+	MiniQLLexerPRIVILEGES          = 95
+	MiniQLLexerIF                  = 96
+	MiniQLLexerEXISTS              = 97
+	MiniQLLexerKILL                = 98
+	MiniQLLexerQUERY               = 99
+	MiniQLLexerSESSION             = 100
+	MiniQLLexerCONNECTION          = 101
+	MiniQLLexerRESTORE             = 102
+	MiniQLLexerBACKUP              = 103
+	MiniQLLexerINCREMENTAL         = 104
+	MiniQLLexerVACUUM              = 105
+	MiniQLLexerRETAIN              = 106
+	MiniQLLexerHOURS               = 107
+	MiniQLLexerDRY                 = 108
+	MiniQLLexerRUN                 = 109
+	MiniQLLexerPREPARE             = 110
+	MiniQLLexerEXECUTE             = 111
+	MiniQLLexerDEALLOCATE          = 112
+	MiniQLLexerCOPY                = 113
+	MiniQLLexerEXPORT              = 114
+	MiniQLLexerIMPORT              = 115
+	MiniQLLexerEXTERNAL            = 116
+	MiniQLLexerLOCATION            = 117
+	MiniQLLexerFORMAT              = 118
+	MiniQLLexerASTERISK            = 119
+	MiniQLLexerEQUAL               = 120
+	MiniQLLexerNOT_EQUAL           = 121
+	MiniQLLexerGREATER             = 122
+	MiniQLLexerGREATER_EQUAL       = 123
+	MiniQLLexerLESS                = 124
+	MiniQLLexerLESS_EQUAL          = 125
+	MiniQLLexerPLUS                = 126
+	MiniQLLexerMINUS               = 127
+	MiniQLLexerMULTIPLY            = 128
+	MiniQLLexerDIVIDE              = 129
+	MiniQLLexerDOT                 = 130
+	MiniQLLexerCOMMA               = 131
+	MiniQLLexerSEMICOLON           = 132
+	MiniQLLexerLEFT_PAREN          = 133
+	MiniQLLexerRIGHT_PAREN         = 134
+	MiniQLLexerIDENTIFIER          = 135
+	MiniQLLexerINTEGER_LITERAL     = 136
+	MiniQLLexerFLOAT_LITERAL       = 137
+	MiniQLLexerSTRING_LITERAL      = 138
+	MiniQLLexerPARAM               = 139
+	MiniQLLexerWS                  = 140
 )
@@ -35,9 +35,12 @@ func miniqlParserInit() {
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'='", "'!='",
-		"'>'", "'>='", "'<'", "'<='", "'+'", "'-'", "", "'/'", "'.'", "','",
-		"';'", "'('", "')'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "'='", "", "'>'", "'>='", "'<'", "'<='", "'+'", "'-'", "", "'/'",
+		"'.'", "','", "';'", "'('", "')'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT", "SELECT", "FROM", "WHERE",
@@ -48,273 +51,642 @@ func miniqlParserInit() {
 		"FULL", "OUTER", "USE", "SHOW", "DATABASES", "TABLES", "EXPLAIN", "ANALYZE",
 		"VERBOSE", "UNIQUE", "DEFAULT", "INDEX", "INDEXES", "INT_TYPE", "INTEGER_TYPE",
 		"VARCHAR_TYPE", "BOOLEAN_TYPE", "DOUBLE_TYPE", "TIMESTAMP_TYPE", "START",
-		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "ASTERISK", "EQUAL",
-		"NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL", "PLUS",
-		"MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON", "LEFT_PAREN",
-		"RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL", "STRING_LITERAL",
-		"WS",
+		"TRANSACTION", "COMMIT", "ROLLBACK", "HASH", "RANGE", "TO", "ALL", "RESET",
+		"TIME", "ZONE", "ALTER", "WITH", "OF", "LIST", "PARTITIONS", "LESS_KW",
+		"THAN", "MAXVALUE", "TBLPROPERTIES", "UNSET", "SHALLOW", "CLONE", "VERSION",
+		"DESCRIBE", "EXTENDED", "COMMENT", "COLUMN", "IS", "USER", "ROLE", "PASSWORD",
+		"SUPERUSER", "NOSUPERUSER", "GRANT", "REVOKE", "PRIVILEGES", "IF", "EXISTS",
+		"KILL", "QUERY", "SESSION", "CONNECTION", "RESTORE", "BACKUP", "INCREMENTAL",
+		"VACUUM", "RETAIN", "HOURS", "DRY", "RUN", "PREPARE", "EXECUTE", "DEALLOCATE",
+		"COPY", "EXPORT", "IMPORT", "EXTERNAL", "LOCATION", "FORMAT", "ASTERISK",
+		"EQUAL", "NOT_EQUAL", "GREATER", "GREATER_EQUAL", "LESS", "LESS_EQUAL",
+		"PLUS", "MINUS", "MULTIPLY", "DIVIDE", "DOT", "COMMA", "SEMICOLON",
+		"LEFT_PAREN", "RIGHT_PAREN", "IDENTIFIER", "INTEGER_LITERAL", "FLOAT_LITERAL",
+		"STRING_LITERAL", "PARAM", "WS",
 	}
 	staticData.RuleNames = []string{
 		"parse", "sqlStatement", "ddlStatement", "dmlStatement", "dqlStatement",
 		"dclStatement", "utilityStatement", "createDatabase", "createTable",
-		"columnDef", "columnConstraint", "tableConstraint", "createIndex", "dropIndex",
-		"dropTable", "dropDatabase", "insertStatement", "updateStatement", "deleteStatement",
-		"selectStatement", "selectItem", "tableReference", "tableReferenceAtom",
-		"joinType", "expression", "primaryExpr", "comparisonOperator", "columnRef",
-		"updateAssignment", "groupByItem", "orderByItem", "functionCall", "partitionMethod",
-		"transactionStatement", "useStatement", "showDatabases", "showTables",
-		"showIndexes", "explainStatement", "analyzeStatement", "columnList",
-		"identifierList", "valueList", "tableName", "identifier", "dataType",
-		"literal",
+		"cloneTable", "createExternalTable", "alterTable", "restoreTable", "commentStatement",
+		"tableProperty", "propertyName", "partitionValue", "optionList", "option",
+		"optionName", "optionValue", "columnDef", "columnConstraint", "tableConstraint",
+		"createIndex", "dropIndex", "dropTable", "dropDatabase", "createUser",
+		"userOption", "createRole", "dropRole", "grantStatement", "revokeStatement",
+		"privilegeList", "privilege", "grantObject", "grantObjectPart", "insertStatement",
+		"updateStatement", "deleteStatement", "selectStatement", "selectItem",
+		"tableReference", "tableReferenceAtom", "tableFunction", "joinType",
+		"expression", "primaryExpr", "comparisonOperator", "columnRef", "updateAssignment",
+		"groupByItem", "orderByItem", "functionCall", "partitionMethod", "partitionDefinitions",
+		"partitionDefinition", "partitionBound", "transactionStatement", "useStatement",
+		"showDatabases", "showTables", "showIndexes", "showCreateTable", "describeTable",
+		"explainStatement", "explainOption", "analyzeStatement", "columnList",
+		"setStatement", "showVariable", "resetStatement", "variableName", "prepareStatement",
+		"parameterType", "executeStatement", "deallocateStatement", "copyStatement",
+		"exportTable", "importTable", "tableFormat", "backupDatabase", "restoreDatabase",
+		"killStatement", "vacuumStatement", "setValue", "identifierList", "valueList",
+		"valueItem", "tableName", "identifier", "nonReservedKeyword", "dataType",
+		"signedLiteral", "literal",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 85, 546, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 1, 0,
-		5, 0, 96, 8, 0, 10, 0, 12, 0, 99, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 3, 1, 108, 8, 1, 1, 1, 3, 1, 111, 8, 1, 1, 2, 1, 2, 1, 2, 1, 2,
-		1, 2, 1, 2, 3, 2, 119, 8, 2, 1, 3, 1, 3, 1, 3, 3, 3, 124, 8, 3, 1, 4, 1,
-		4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 3, 6, 136, 8, 6, 1,
-		7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 149,
-		8, 8, 10, 8, 12, 8, 152, 9, 8, 1, 8, 1, 8, 5, 8, 156, 8, 8, 10, 8, 12,
-		8, 159, 9, 8, 1, 8, 1, 8, 1, 8, 1, 8, 3, 8, 165, 8, 8, 1, 9, 1, 9, 1, 9,
-		5, 9, 170, 8, 9, 10, 9, 12, 9, 173, 9, 9, 1, 10, 3, 10, 176, 8, 10, 1,
-		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 184, 8, 10, 1, 11, 1, 11,
-		1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 3, 12, 194, 8, 12, 1, 12, 1,
-		12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13,
-		1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1,
-		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 225, 8, 16, 1, 16,
-		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 5, 16, 236, 8,
-		16, 10, 16, 12, 16, 239, 9, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
-		5, 17, 247, 8, 17, 10, 17, 12, 17, 250, 9, 17, 1, 17, 1, 17, 3, 17, 254,
-		8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 261, 8, 18, 1, 19, 1,
-		19, 1, 19, 1, 19, 5, 19, 267, 8, 19, 10, 19, 12, 19, 270, 9, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 3, 19, 276, 8, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 5, 19, 283, 8, 19, 10, 19, 12, 19, 286, 9, 19, 3, 19, 288, 8, 19, 1,
-		19, 1, 19, 3, 19, 292, 8, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 5, 19,
-		299, 8, 19, 10, 19, 12, 19, 302, 9, 19, 3, 19, 304, 8, 19, 1, 19, 1, 19,
-		3, 19, 308, 8, 19, 1, 20, 1, 20, 1, 20, 3, 20, 313, 8, 20, 1, 20, 1, 20,
-		1, 20, 3, 20, 318, 8, 20, 1, 20, 3, 20, 321, 8, 20, 3, 20, 323, 8, 20,
-		1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 330, 8, 21, 1, 21, 1, 21, 1,
-		21, 1, 21, 1, 21, 5, 21, 337, 8, 21, 10, 21, 12, 21, 340, 9, 21, 1, 22,
-		1, 22, 3, 22, 344, 8, 22, 1, 22, 3, 22, 347, 8, 22, 1, 22, 1, 22, 1, 22,
-		1, 22, 3, 22, 353, 8, 22, 1, 22, 1, 22, 3, 22, 357, 8, 22, 1, 23, 1, 23,
-		1, 23, 3, 23, 362, 8, 23, 1, 23, 1, 23, 3, 23, 366, 8, 23, 1, 23, 1, 23,
-		3, 23, 370, 8, 23, 3, 23, 372, 8, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
-		24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 395, 8, 24, 1, 24, 1, 24,
-		1, 24, 1, 24, 3, 24, 401, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 5,
-		24, 408, 8, 24, 10, 24, 12, 24, 411, 9, 24, 1, 25, 1, 25, 1, 25, 1, 25,
-		1, 25, 1, 25, 1, 25, 3, 25, 420, 8, 25, 1, 26, 1, 26, 1, 27, 1, 27, 1,
-		27, 1, 27, 1, 27, 3, 27, 429, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29,
-		1, 29, 1, 30, 1, 30, 3, 30, 439, 8, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1,
-		31, 1, 31, 5, 31, 447, 8, 31, 10, 31, 12, 31, 450, 9, 31, 3, 31, 452, 8,
-		31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32,
-		1, 32, 1, 32, 3, 32, 466, 8, 32, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 472,
-		8, 33, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1,
-		37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 498, 8, 39, 1, 40, 1, 40, 1, 40, 5,
-		40, 503, 8, 40, 10, 40, 12, 40, 506, 9, 40, 1, 41, 1, 41, 1, 41, 5, 41,
-		511, 8, 41, 10, 41, 12, 41, 514, 9, 41, 1, 42, 1, 42, 1, 42, 5, 42, 519,
-		8, 42, 10, 42, 12, 42, 522, 9, 42, 1, 43, 1, 43, 1, 43, 3, 43, 527, 8,
-		43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 537,
-		8, 45, 1, 45, 1, 45, 1, 45, 3, 45, 542, 8, 45, 1, 46, 1, 46, 1, 46, 0,
-		2, 42, 48, 47, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
-		32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
-		68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 0, 6, 2, 0, 65, 65,
-		75, 75, 1, 0, 72, 73, 1, 0, 66, 71, 1, 0, 35, 36, 2, 0, 4, 4, 33, 33, 2,
-		0, 24, 26, 82, 84, 585, 0, 97, 1, 0, 0, 0, 2, 107, 1, 0, 0, 0, 4, 118,
-		1, 0, 0, 0, 6, 123, 1, 0, 0, 0, 8, 125, 1, 0, 0, 0, 10, 127, 1, 0, 0, 0,
-		12, 135, 1, 0, 0, 0, 14, 137, 1, 0, 0, 0, 16, 141, 1, 0, 0, 0, 18, 166,
-		1, 0, 0, 0, 20, 183, 1, 0, 0, 0, 22, 185, 1, 0, 0, 0, 24, 191, 1, 0, 0,
-		0, 26, 203, 1, 0, 0, 0, 28, 209, 1, 0, 0, 0, 30, 213, 1, 0, 0, 0, 32, 217,
-		1, 0, 0, 0, 34, 240, 1, 0, 0, 0, 36, 255, 1, 0, 0, 0, 38, 262, 1, 0, 0,
-		0, 40, 322, 1, 0, 0, 0, 42, 324, 1, 0, 0, 0, 44, 356, 1, 0, 0, 0, 46, 371,
-		1, 0, 0, 0, 48, 373, 1, 0, 0, 0, 50, 419, 1, 0, 0, 0, 52, 421, 1, 0, 0,
-		0, 54, 428, 1, 0, 0, 0, 56, 430, 1, 0, 0, 0, 58, 434, 1, 0, 0, 0, 60, 436,
-		1, 0, 0, 0, 62, 440, 1, 0, 0, 0, 64, 465, 1, 0, 0, 0, 66, 471, 1, 0, 0,
-		0, 68, 473, 1, 0, 0, 0, 70, 476, 1, 0, 0, 0, 72, 479, 1, 0, 0, 0, 74, 482,
-		1, 0, 0, 0, 76, 487, 1, 0, 0, 0, 78, 490, 1, 0, 0, 0, 80, 499, 1, 0, 0,
-		0, 82, 507, 1, 0, 0, 0, 84, 515, 1, 0, 0, 0, 86, 523, 1, 0, 0, 0, 88, 528,
-		1, 0, 0, 0, 90, 541, 1, 0, 0, 0, 92, 543, 1, 0, 0, 0, 94, 96, 3, 2, 1,
-		0, 95, 94, 1, 0, 0, 0, 96, 99, 1, 0, 0, 0, 97, 95, 1, 0, 0, 0, 97, 98,
-		1, 0, 0, 0, 98, 100, 1, 0, 0, 0, 99, 97, 1, 0, 0, 0, 100, 101, 5, 0, 0,
-		1, 101, 1, 1, 0, 0, 0, 102, 108, 3, 4, 2, 0, 103, 108, 3, 6, 3, 0, 104,
-		108, 3, 8, 4, 0, 105, 108, 3, 10, 5, 0, 106, 108, 3, 12, 6, 0, 107, 102,
-		1, 0, 0, 0, 107, 103, 1, 0, 0, 0, 107, 104, 1, 0, 0, 0, 107, 105, 1, 0,
-		0, 0, 107, 106, 1, 0, 0, 0, 108, 110, 1, 0, 0, 0, 109, 111, 5, 78, 0, 0,
-		110, 109, 1, 0, 0, 0, 110, 111, 1, 0, 0, 0, 111, 3, 1, 0, 0, 0, 112, 119,
-		3, 14, 7, 0, 113, 119, 3, 16, 8, 0, 114, 119, 3, 24, 12, 0, 115, 119, 3,
-		26, 13, 0, 116, 119, 3, 28, 14, 0, 117, 119, 3, 30, 15, 0, 118, 112, 1,
-		0, 0, 0, 118, 113, 1, 0, 0, 0, 118, 114, 1, 0, 0, 0, 118, 115, 1, 0, 0,
-		0, 118, 116, 1, 0, 0, 0, 118, 117, 1, 0, 0, 0, 119, 5, 1, 0, 0, 0, 120,
-		124, 3, 32, 16, 0, 121, 124, 3, 34, 17, 0, 122, 124, 3, 36, 18, 0, 123,
-		120, 1, 0, 0, 0, 123, 121, 1, 0, 0, 0, 123, 122, 1, 0, 0, 0, 124, 7, 1,
-		0, 0, 0, 125, 126, 3, 38, 19, 0, 126, 9, 1, 0, 0, 0, 127, 128, 3, 66, 33,
-		0, 128, 11, 1, 0, 0, 0, 129, 136, 3, 68, 34, 0, 130, 136, 3, 70, 35, 0,
-		131, 136, 3, 72, 36, 0, 132, 136, 3, 74, 37, 0, 133, 136, 3, 76, 38, 0,
-		134, 136, 3, 78, 39, 0, 135, 129, 1, 0, 0, 0, 135, 130, 1, 0, 0, 0, 135,
-		131, 1, 0, 0, 0, 135, 132, 1, 0, 0, 0, 135, 133, 1, 0, 0, 0, 135, 134,
-		1, 0, 0, 0, 136, 13, 1, 0, 0, 0, 137, 138, 5, 17, 0, 0, 138, 139, 5, 19,
-		0, 0, 139, 140, 3, 88, 44, 0, 140, 15, 1, 0, 0, 0, 141, 142, 5, 17, 0,
-		0, 142, 143, 5, 18, 0, 0, 143, 144, 3, 86, 43, 0, 144, 145, 5, 79, 0, 0,
-		145, 150, 3, 18, 9, 0, 146, 147, 5, 77, 0, 0, 147, 149, 3, 18, 9, 0, 148,
-		146, 1, 0, 0, 0, 149, 152, 1, 0, 0, 0, 150, 148, 1, 0, 0, 0, 150, 151,
-		1, 0, 0, 0, 151, 157, 1, 0, 0, 0, 152, 150, 1, 0, 0, 0, 153, 154, 5, 77,
-		0, 0, 154, 156, 3, 22, 11, 0, 155, 153, 1, 0, 0, 0, 156, 159, 1, 0, 0,
-		0, 157, 155, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 160, 1, 0, 0, 0, 159,
-		157, 1, 0, 0, 0, 160, 164, 5, 80, 0, 0, 161, 162, 5, 34, 0, 0, 162, 163,
-		5, 7, 0, 0, 163, 165, 3, 64, 32, 0, 164, 161, 1, 0, 0, 0, 164, 165, 1,
-		0, 0, 0, 165, 17, 1, 0, 0, 0, 166, 167, 3, 88, 44, 0, 167, 171, 3, 90,
-		45, 0, 168, 170, 3, 20, 10, 0, 169, 168, 1, 0, 0, 0, 170, 173, 1, 0, 0,
-		0, 171, 169, 1, 0, 0, 0, 171, 172, 1, 0, 0, 0, 172, 19, 1, 0, 0, 0, 173,
-		171, 1, 0, 0, 0, 174, 176, 5, 23, 0, 0, 175, 174, 1, 0, 0, 0, 175, 176,
-		1, 0, 0, 0, 176, 177, 1, 0, 0, 0, 177, 184, 5, 24, 0, 0, 178, 179, 5, 21,
-		0, 0, 179, 184, 5, 22, 0, 0, 180, 184, 5, 49, 0, 0, 181, 182, 5, 50, 0,
-		0, 182, 184, 3, 92, 46, 0, 183, 175, 1, 0, 0, 0, 183, 178, 1, 0, 0, 0,
-		183, 180, 1, 0, 0, 0, 183, 181, 1, 0, 0, 0, 184, 21, 1, 0, 0, 0, 185, 186,
-		5, 21, 0, 0, 186, 187, 5, 22, 0, 0, 187, 188, 5, 79, 0, 0, 188, 189, 3,
-		82, 41, 0, 189, 190, 5, 80, 0, 0, 190, 23, 1, 0, 0, 0, 191, 193, 5, 17,
-		0, 0, 192, 194, 5, 49, 0, 0, 193, 192, 1, 0, 0, 0, 193, 194, 1, 0, 0, 0,
-		194, 195, 1, 0, 0, 0, 195, 196, 5, 51, 0, 0, 196, 197, 3, 88, 44, 0, 197,
-		198, 5, 33, 0, 0, 198, 199, 3, 86, 43, 0, 199, 200, 5, 79, 0, 0, 200, 201,
-		3, 82, 41, 0, 201, 202, 5, 80, 0, 0, 202, 25, 1, 0, 0, 0, 203, 204, 5,
-		20, 0, 0, 204, 205, 5, 51, 0, 0, 205, 206, 3, 88, 44, 0, 206, 207, 5, 33,
-		0, 0, 207, 208, 3, 86, 43, 0, 208, 27, 1, 0, 0, 0, 209, 210, 5, 20, 0,
-		0, 210, 211, 5, 18, 0, 0, 211, 212, 3, 86, 43, 0, 212, 29, 1, 0, 0, 0,
-		213, 214, 5, 20, 0, 0, 214, 215, 5, 19, 0, 0, 215, 216, 3, 88, 44, 0, 216,
-		31, 1, 0, 0, 0, 217, 218, 5, 11, 0, 0, 218, 219, 5, 12, 0, 0, 219, 224,
-		3, 86, 43, 0, 220, 221, 5, 79, 0, 0, 221, 222, 3, 82, 41, 0, 222, 223,
-		5, 80, 0, 0, 223, 225, 1, 0, 0, 0, 224, 220, 1, 0, 0, 0, 224, 225, 1, 0,
-		0, 0, 225, 226, 1, 0, 0, 0, 226, 227, 5, 13, 0, 0, 227, 228, 5, 79, 0,
-		0, 228, 229, 3, 84, 42, 0, 229, 237, 5, 80, 0, 0, 230, 231, 5, 77, 0, 0,
-		231, 232, 5, 79, 0, 0, 232, 233, 3, 84, 42, 0, 233, 234, 5, 80, 0, 0, 234,
-		236, 1, 0, 0, 0, 235, 230, 1, 0, 0, 0, 236, 239, 1, 0, 0, 0, 237, 235,
-		1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 33, 1, 0, 0, 0, 239, 237, 1, 0,
-		0, 0, 240, 241, 5, 14, 0, 0, 241, 242, 3, 86, 43, 0, 242, 243, 5, 15, 0,
-		0, 243, 248, 3, 56, 28, 0, 244, 245, 5, 77, 0, 0, 245, 247, 3, 56, 28,
-		0, 246, 244, 1, 0, 0, 0, 247, 250, 1, 0, 0, 0, 248, 246, 1, 0, 0, 0, 248,
-		249, 1, 0, 0, 0, 249, 253, 1, 0, 0, 0, 250, 248, 1, 0, 0, 0, 251, 252,
-		5, 5, 0, 0, 252, 254, 3, 48, 24, 0, 253, 251, 1, 0, 0, 0, 253, 254, 1,
-		0, 0, 0, 254, 35, 1, 0, 0, 0, 255, 256, 5, 16, 0, 0, 256, 257, 5, 4, 0,
-		0, 257, 260, 3, 86, 43, 0, 258, 259, 5, 5, 0, 0, 259, 261, 3, 48, 24, 0,
-		260, 258, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261, 37, 1, 0, 0, 0, 262, 263,
-		5, 3, 0, 0, 263, 268, 3, 40, 20, 0, 264, 265, 5, 77, 0, 0, 265, 267, 3,
-		40, 20, 0, 266, 264, 1, 0, 0, 0, 267, 270, 1, 0, 0, 0, 268, 266, 1, 0,
-		0, 0, 268, 269, 1, 0, 0, 0, 269, 271, 1, 0, 0, 0, 270, 268, 1, 0, 0, 0,
-		271, 272, 5, 4, 0, 0, 272, 275, 3, 42, 21, 0, 273, 274, 5, 5, 0, 0, 274,
-		276, 3, 48, 24, 0, 275, 273, 1, 0, 0, 0, 275, 276, 1, 0, 0, 0, 276, 287,
-		1, 0, 0, 0, 277, 278, 5, 6, 0, 0, 278, 279, 5, 7, 0, 0, 279, 284, 3, 58,
-		29, 0, 280, 281, 5, 77, 0, 0, 281, 283, 3, 58, 29, 0, 282, 280, 1, 0, 0,
-		0, 283, 286, 1, 0, 0, 0, 284, 282, 1, 0, 0, 0, 284, 285, 1, 0, 0, 0, 285,
-		288, 1, 0, 0, 0, 286, 284, 1, 0, 0, 0, 287, 277, 1, 0, 0, 0, 287, 288,
-		1, 0, 0, 0, 288, 291, 1, 0, 0, 0, 289, 290, 5, 8, 0, 0, 290, 292, 3, 48,
-		24, 0, 291, 289, 1, 0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 303, 1, 0, 0, 0,
-		293, 294, 5, 9, 0, 0, 294, 295, 5, 7, 0, 0, 295, 300, 3, 60, 30, 0, 296,
-		297, 5, 77, 0, 0, 297, 299, 3, 60, 30, 0, 298, 296, 1, 0, 0, 0, 299, 302,
-		1, 0, 0, 0, 300, 298, 1, 0, 0, 0, 300, 301, 1, 0, 0, 0, 301, 304, 1, 0,
-		0, 0, 302, 300, 1, 0, 0, 0, 303, 293, 1, 0, 0, 0, 303, 304, 1, 0, 0, 0,
-		304, 307, 1, 0, 0, 0, 305, 306, 5, 10, 0, 0, 306, 308, 5, 82, 0, 0, 307,
-		305, 1, 0, 0, 0, 307, 308, 1, 0, 0, 0, 308, 39, 1, 0, 0, 0, 309, 310, 3,
-		86, 43, 0, 310, 311, 5, 76, 0, 0, 311, 313, 1, 0, 0, 0, 312, 309, 1, 0,
-		0, 0, 312, 313, 1, 0, 0, 0, 313, 314, 1, 0, 0, 0, 314, 323, 5, 65, 0, 0,
-		315, 320, 3, 48, 24, 0, 316, 318, 5, 27, 0, 0, 317, 316, 1, 0, 0, 0, 317,
-		318, 1, 0, 0, 0, 318, 319, 1, 0, 0, 0, 319, 321, 3, 88, 44, 0, 320, 317,
-		1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 323, 1, 0, 0, 0, 322, 312, 1, 0,
-		0, 0, 322, 315, 1, 0, 0, 0, 323, 41, 1, 0, 0, 0, 324, 325, 6, 21, -1, 0,
-		325, 326, 3, 44, 22, 0, 326, 338, 1, 0, 0, 0, 327, 329, 10, 1, 0, 0, 328,
-		330, 3, 46, 23, 0, 329, 328, 1, 0, 0, 0, 329, 330, 1, 0, 0, 0, 330, 331,
-		1, 0, 0, 0, 331, 332, 5, 32, 0, 0, 332, 333, 3, 44, 22, 0, 333, 334, 5,
-		33, 0, 0, 334, 335, 3, 48, 24, 0, 335, 337, 1, 0, 0, 0, 336, 327, 1, 0,
-		0, 0, 337, 340, 1, 0, 0, 0, 338, 336, 1, 0, 0, 0, 338, 339, 1, 0, 0, 0,
-		339, 43, 1, 0, 0, 0, 340, 338, 1, 0, 0, 0, 341, 346, 3, 86, 43, 0, 342,
-		344, 5, 27, 0, 0, 343, 342, 1, 0, 0, 0, 343, 344, 1, 0, 0, 0, 344, 345,
-		1, 0, 0, 0, 345, 347, 3, 88, 44, 0, 346, 343, 1, 0, 0, 0, 346, 347, 1,
-		0, 0, 0, 347, 357, 1, 0, 0, 0, 348, 349, 5, 79, 0, 0, 349, 350, 3, 38,
-		19, 0, 350, 352, 5, 80, 0, 0, 351, 353, 5, 27, 0, 0, 352, 351, 1, 0, 0,
-		0, 352, 353, 1, 0, 0, 0, 353, 354, 1, 0, 0, 0, 354, 355, 3, 88, 44, 0,
-		355, 357, 1, 0, 0, 0, 356, 341, 1, 0, 0, 0, 356, 348, 1, 0, 0, 0, 357,
-		45, 1, 0, 0, 0, 358, 372, 5, 37, 0, 0, 359, 361, 5, 38, 0, 0, 360, 362,
-		5, 41, 0, 0, 361, 360, 1, 0, 0, 0, 361, 362, 1, 0, 0, 0, 362, 372, 1, 0,
-		0, 0, 363, 365, 5, 39, 0, 0, 364, 366, 5, 41, 0, 0, 365, 364, 1, 0, 0,
-		0, 365, 366, 1, 0, 0, 0, 366, 372, 1, 0, 0, 0, 367, 369, 5, 40, 0, 0, 368,
-		370, 5, 41, 0, 0, 369, 368, 1, 0, 0, 0, 369, 370, 1, 0, 0, 0, 370, 372,
-		1, 0, 0, 0, 371, 358, 1, 0, 0, 0, 371, 359, 1, 0, 0, 0, 371, 363, 1, 0,
-		0, 0, 371, 367, 1, 0, 0, 0, 372, 47, 1, 0, 0, 0, 373, 374, 6, 24, -1, 0,
-		374, 375, 3, 50, 25, 0, 375, 409, 1, 0, 0, 0, 376, 377, 10, 7, 0, 0, 377,
-		378, 7, 0, 0, 0, 378, 408, 3, 48, 24, 8, 379, 380, 10, 6, 0, 0, 380, 381,
-		7, 1, 0, 0, 381, 408, 3, 48, 24, 7, 382, 383, 10, 5, 0, 0, 383, 384, 3,
-		52, 26, 0, 384, 385, 3, 48, 24, 6, 385, 408, 1, 0, 0, 0, 386, 387, 10,
-		4, 0, 0, 387, 388, 5, 30, 0, 0, 388, 408, 3, 48, 24, 5, 389, 390, 10, 3,
-		0, 0, 390, 391, 5, 31, 0, 0, 391, 408, 3, 48, 24, 4, 392, 394, 10, 2, 0,
-		0, 393, 395, 5, 23, 0, 0, 394, 393, 1, 0, 0, 0, 394, 395, 1, 0, 0, 0, 395,
-		396, 1, 0, 0, 0, 396, 397, 5, 28, 0, 0, 397, 408, 3, 48, 24, 3, 398, 400,
-		10, 1, 0, 0, 399, 401, 5, 23, 0, 0, 400, 399, 1, 0, 0, 0, 400, 401, 1,
-		0, 0, 0, 401, 402, 1, 0, 0, 0, 402, 403, 5, 29, 0, 0, 403, 404, 5, 79,
-		0, 0, 404, 405, 3, 84, 42, 0, 405, 406, 5, 80, 0, 0, 406, 408, 1, 0, 0,
-		0, 407, 376, 1, 0, 0, 0, 407, 379, 1, 0, 0, 0, 407, 382, 1, 0, 0, 0, 407,
-		386, 1, 0, 0, 0, 407, 389, 1, 0, 0, 0, 407, 392, 1, 0, 0, 0, 407, 398,
-		1, 0, 0, 0, 408, 411, 1, 0, 0, 0, 409, 407, 1, 0, 0, 0, 409, 410, 1, 0,
-		0, 0, 410, 49, 1, 0, 0, 0, 411, 409, 1, 0, 0, 0, 412, 420, 3, 92, 46, 0,
-		413, 420, 3, 54, 27, 0, 414, 420, 3, 62, 31, 0, 415, 416, 5, 79, 0, 0,
-		416, 417, 3, 48, 24, 0, 417, 418, 5, 80, 0, 0, 418, 420, 1, 0, 0, 0, 419,
-		412, 1, 0, 0, 0, 419, 413, 1, 0, 0, 0, 419, 414, 1, 0, 0, 0, 419, 415,
-		1, 0, 0, 0, 420, 51, 1, 0, 0, 0, 421, 422, 7, 2, 0, 0, 422, 53, 1, 0, 0,
-		0, 423, 429, 3, 88, 44, 0, 424, 425, 3, 88, 44, 0, 425, 426, 5, 76, 0,
-		0, 426, 427, 3, 88, 44, 0, 427, 429, 1, 0, 0, 0, 428, 423, 1, 0, 0, 0,
-		428, 424, 1, 0, 0, 0, 429, 55, 1, 0, 0, 0, 430, 431, 3, 88, 44, 0, 431,
-		432, 5, 66, 0, 0, 432, 433, 3, 48, 24, 0, 433, 57, 1, 0, 0, 0, 434, 435,
-		3, 48, 24, 0, 435, 59, 1, 0, 0, 0, 436, 438, 3, 48, 24, 0, 437, 439, 7,
-		3, 0, 0, 438, 437, 1, 0, 0, 0, 438, 439, 1, 0, 0, 0, 439, 61, 1, 0, 0,
-		0, 440, 441, 3, 88, 44, 0, 441, 451, 5, 79, 0, 0, 442, 452, 5, 65, 0, 0,
-		443, 448, 3, 48, 24, 0, 444, 445, 5, 77, 0, 0, 445, 447, 3, 48, 24, 0,
-		446, 444, 1, 0, 0, 0, 447, 450, 1, 0, 0, 0, 448, 446, 1, 0, 0, 0, 448,
-		449, 1, 0, 0, 0, 449, 452, 1, 0, 0, 0, 450, 448, 1, 0, 0, 0, 451, 442,
-		1, 0, 0, 0, 451, 443, 1, 0, 0, 0, 451, 452, 1, 0, 0, 0, 452, 453, 1, 0,
-		0, 0, 453, 454, 5, 80, 0, 0, 454, 63, 1, 0, 0, 0, 455, 456, 5, 63, 0, 0,
-		456, 457, 5, 79, 0, 0, 457, 458, 3, 82, 41, 0, 458, 459, 5, 80, 0, 0, 459,
-		466, 1, 0, 0, 0, 460, 461, 5, 64, 0, 0, 461, 462, 5, 79, 0, 0, 462, 463,
-		3, 82, 41, 0, 463, 464, 5, 80, 0, 0, 464, 466, 1, 0, 0, 0, 465, 455, 1,
-		0, 0, 0, 465, 460, 1, 0, 0, 0, 466, 65, 1, 0, 0, 0, 467, 468, 5, 59, 0,
-		0, 468, 472, 5, 60, 0, 0, 469, 472, 5, 61, 0, 0, 470, 472, 5, 62, 0, 0,
-		471, 467, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471, 470, 1, 0, 0, 0, 472,
-		67, 1, 0, 0, 0, 473, 474, 5, 42, 0, 0, 474, 475, 3, 88, 44, 0, 475, 69,
-		1, 0, 0, 0, 476, 477, 5, 43, 0, 0, 477, 478, 5, 44, 0, 0, 478, 71, 1, 0,
-		0, 0, 479, 480, 5, 43, 0, 0, 480, 481, 5, 45, 0, 0, 481, 73, 1, 0, 0, 0,
-		482, 483, 5, 43, 0, 0, 483, 484, 5, 52, 0, 0, 484, 485, 7, 4, 0, 0, 485,
-		486, 3, 86, 43, 0, 486, 75, 1, 0, 0, 0, 487, 488, 5, 46, 0, 0, 488, 489,
-		3, 38, 19, 0, 489, 77, 1, 0, 0, 0, 490, 491, 5, 47, 0, 0, 491, 492, 5,
-		18, 0, 0, 492, 497, 3, 86, 43, 0, 493, 494, 5, 79, 0, 0, 494, 495, 3, 80,
-		40, 0, 495, 496, 5, 80, 0, 0, 496, 498, 1, 0, 0, 0, 497, 493, 1, 0, 0,
-		0, 497, 498, 1, 0, 0, 0, 498, 79, 1, 0, 0, 0, 499, 504, 3, 88, 44, 0, 500,
-		501, 5, 77, 0, 0, 501, 503, 3, 88, 44, 0, 502, 500, 1, 0, 0, 0, 503, 506,
-		1, 0, 0, 0, 504, 502, 1, 0, 0, 0, 504, 505, 1, 0, 0, 0, 505, 81, 1, 0,
-		0, 0, 506, 504, 1, 0, 0, 0, 507, 512, 3, 88, 44, 0, 508, 509, 5, 77, 0,
-		0, 509, 511, 3, 88, 44, 0, 510, 508, 1, 0, 0, 0, 511, 514, 1, 0, 0, 0,
-		512, 510, 1, 0, 0, 0, 512, 513, 1, 0, 0, 0, 513, 83, 1, 0, 0, 0, 514, 512,
-		1, 0, 0, 0, 515, 520, 3, 92, 46, 0, 516, 517, 5, 77, 0, 0, 517, 519, 3,
-		92, 46, 0, 518, 516, 1, 0, 0, 0, 519, 522, 1, 0, 0, 0, 520, 518, 1, 0,
-		0, 0, 520, 521, 1, 0, 0, 0, 521, 85, 1, 0, 0, 0, 522, 520, 1, 0, 0, 0,
-		523, 526, 3, 88, 44, 0, 524, 525, 5, 76, 0, 0, 525, 527, 3, 88, 44, 0,
-		526, 524, 1, 0, 0, 0, 526, 527, 1, 0, 0, 0, 527, 87, 1, 0, 0, 0, 528, 529,
-		5, 81, 0, 0, 529, 89, 1, 0, 0, 0, 530, 542, 5, 53, 0, 0, 531, 542, 5, 54,
-		0, 0, 532, 536, 5, 55, 0, 0, 533, 534, 5, 79, 0, 0, 534, 535, 5, 82, 0,
-		0, 535, 537, 5, 80, 0, 0, 536, 533, 1, 0, 0, 0, 536, 537, 1, 0, 0, 0, 537,
-		542, 1, 0, 0, 0, 538, 542, 5, 56, 0, 0, 539, 542, 5, 57, 0, 0, 540, 542,
-		5, 58, 0, 0, 541, 530, 1, 0, 0, 0, 541, 531, 1, 0, 0, 0, 541, 532, 1, 0,
-		0, 0, 541, 538, 1, 0, 0, 0, 541, 539, 1, 0, 0, 0, 541, 540, 1, 0, 0, 0,
-		542, 91, 1, 0, 0, 0, 543, 544, 7, 5, 0, 0, 544, 93, 1, 0, 0, 0, 58, 97,
-		107, 110, 118, 123, 135, 150, 157, 164, 171, 175, 183, 193, 224, 237, 248,
-		253, 260, 268, 275, 284, 287, 291, 300, 303, 307, 312, 317, 320, 322, 329,
-		338, 343, 346, 352, 356, 361, 365, 369, 371, 394, 400, 407, 409, 419, 428,
-		438, 448, 451, 465, 471, 497, 504, 512, 520, 526, 536, 541,
+		4, 1, 140, 1270, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 1, 0, 5, 0, 194, 8, 0, 10, 0, 12, 0, 197, 9, 0, 1,
+		0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 206, 8, 1, 1, 1, 3, 1, 209,
+		8, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2,
+		3, 2, 222, 8, 2, 1, 3, 1, 3, 1, 3, 3, 3, 227, 8, 3, 1, 4, 1, 4, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 5, 1, 5, 3, 5, 237, 8, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 6, 1, 6, 1, 6, 1, 6, 3, 6, 260, 8, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1,
+		8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 273, 8, 8, 10, 8, 12, 8, 276,
+		9, 8, 1, 8, 1, 8, 5, 8, 280, 8, 8, 10, 8, 12, 8, 283, 9, 8, 1, 8, 1, 8,
+		1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 291, 8, 8, 10, 8, 12, 8, 294, 9, 8, 1, 9,
+		1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9, 306, 8, 9,
+		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 5, 10, 316, 8,
+		10, 10, 10, 12, 10, 319, 9, 10, 1, 10, 1, 10, 5, 10, 323, 8, 10, 10, 10,
+		12, 10, 326, 9, 10, 1, 10, 1, 10, 3, 10, 330, 8, 10, 1, 10, 1, 10, 1, 10,
+		1, 10, 1, 10, 3, 10, 337, 8, 10, 1, 10, 1, 10, 3, 10, 341, 8, 10, 1, 11,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 352, 8,
+		11, 10, 11, 12, 11, 355, 9, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 368, 8, 11, 10, 11, 12, 11, 371,
+		9, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 391,
+		8, 11, 10, 11, 12, 11, 394, 9, 11, 1, 11, 1, 11, 3, 11, 398, 8, 11, 1,
+		12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 418, 8, 12, 1,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 1, 13, 5, 13, 433, 8, 13, 10, 13, 12, 13, 436, 9, 13, 1, 13, 1,
+		13, 1, 13, 3, 13, 441, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15,
+		1, 15, 1, 15, 5, 15, 451, 8, 15, 10, 15, 12, 15, 454, 9, 15, 3, 15, 456,
+		8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 5, 17, 466,
+		8, 17, 10, 17, 12, 17, 469, 9, 17, 1, 17, 1, 17, 1, 18, 1, 18, 3, 18, 475,
+		8, 18, 1, 18, 1, 18, 1, 19, 1, 19, 3, 19, 481, 8, 19, 1, 20, 1, 20, 3,
+		20, 485, 8, 20, 1, 21, 1, 21, 1, 21, 5, 21, 490, 8, 21, 10, 21, 12, 21,
+		493, 9, 21, 1, 22, 3, 22, 496, 8, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
+		1, 22, 3, 22, 504, 8, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
+		24, 1, 24, 3, 24, 514, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
+		1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1,
+		26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28,
+		542, 8, 28, 1, 28, 5, 28, 545, 8, 28, 10, 28, 12, 28, 548, 9, 28, 1, 29,
+		1, 29, 1, 29, 1, 29, 3, 29, 554, 8, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1,
+		31, 1, 31, 1, 31, 1, 31, 3, 31, 564, 8, 31, 1, 31, 1, 31, 1, 32, 1, 32,
+		1, 32, 1, 32, 3, 32, 572, 8, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1,
+		32, 1, 32, 1, 32, 1, 32, 3, 32, 583, 8, 32, 1, 33, 1, 33, 1, 33, 1, 33,
+		3, 33, 589, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		33, 1, 33, 3, 33, 600, 8, 33, 1, 34, 1, 34, 1, 34, 5, 34, 605, 8, 34, 10,
+		34, 12, 34, 608, 9, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35,
+		616, 8, 35, 1, 35, 3, 35, 619, 8, 35, 1, 36, 1, 36, 1, 36, 3, 36, 624,
+		8, 36, 1, 37, 1, 37, 1, 37, 3, 37, 629, 8, 37, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 1, 38, 3, 38, 638, 8, 38, 1, 38, 1, 38, 1, 38, 1, 38,
+		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 5, 38, 649, 8, 38, 10, 38, 12, 38, 652,
+		9, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 5, 39, 660, 8, 39, 10,
+		39, 12, 39, 663, 9, 39, 1, 39, 1, 39, 3, 39, 667, 8, 39, 1, 40, 1, 40,
+		1, 40, 1, 40, 1, 40, 3, 40, 674, 8, 40, 1, 41, 1, 41, 1, 41, 1, 41, 5,
+		41, 680, 8, 41, 10, 41, 12, 41, 683, 9, 41, 1, 41, 1, 41, 1, 41, 1, 41,
+		3, 41, 689, 8, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 5, 41, 696, 8, 41,
+		10, 41, 12, 41, 699, 9, 41, 3, 41, 701, 8, 41, 1, 41, 1, 41, 3, 41, 705,
+		8, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 5, 41, 712, 8, 41, 10, 41, 12,
+		41, 715, 9, 41, 3, 41, 717, 8, 41, 1, 41, 1, 41, 3, 41, 721, 8, 41, 1,
+		42, 1, 42, 1, 42, 3, 42, 726, 8, 42, 1, 42, 1, 42, 1, 42, 3, 42, 731, 8,
+		42, 1, 42, 3, 42, 734, 8, 42, 3, 42, 736, 8, 42, 1, 43, 1, 43, 1, 43, 1,
+		43, 1, 43, 3, 43, 743, 8, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 5, 43,
+		750, 8, 43, 10, 43, 12, 43, 753, 9, 43, 1, 44, 1, 44, 3, 44, 757, 8, 44,
+		1, 44, 3, 44, 760, 8, 44, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 766, 8, 44,
+		1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 772, 8, 44, 1, 44, 3, 44, 775, 8, 44,
+		3, 44, 777, 8, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 5, 45, 784, 8, 45,
+		10, 45, 12, 45, 787, 9, 45, 3, 45, 789, 8, 45, 1, 45, 1, 45, 1, 46, 1,
+		46, 1, 46, 3, 46, 796, 8, 46, 1, 46, 1, 46, 3, 46, 800, 8, 46, 1, 46, 1,
+		46, 3, 46, 804, 8, 46, 3, 46, 806, 8, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1,
+		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 3, 47, 829, 8, 47, 1, 47, 1,
+		47, 1, 47, 1, 47, 3, 47, 835, 8, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
+		5, 47, 842, 8, 47, 10, 47, 12, 47, 845, 9, 47, 1, 48, 1, 48, 1, 48, 1,
+		48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 855, 8, 48, 1, 49, 1, 49, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 864, 8, 50, 1, 51, 1, 51, 1, 51, 1,
+		51, 1, 52, 1, 52, 1, 53, 1, 53, 3, 53, 874, 8, 53, 1, 54, 1, 54, 1, 54,
+		1, 54, 1, 54, 1, 54, 5, 54, 882, 8, 54, 10, 54, 12, 54, 885, 9, 54, 3,
+		54, 887, 8, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55,
+		3, 55, 897, 8, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 904, 8, 55,
+		1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 911, 8, 55, 3, 55, 913, 8, 55,
+		1, 56, 1, 56, 1, 56, 1, 56, 5, 56, 919, 8, 56, 10, 56, 12, 56, 922, 9,
+		56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57,
+		1, 57, 1, 57, 3, 57, 936, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
+		57, 1, 57, 1, 57, 5, 57, 946, 8, 57, 10, 57, 12, 57, 949, 9, 57, 1, 57,
+		1, 57, 3, 57, 953, 8, 57, 1, 58, 1, 58, 3, 58, 957, 8, 58, 1, 59, 1, 59,
+		1, 59, 1, 59, 3, 59, 963, 8, 59, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1,
+		61, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64,
+		1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 3, 65, 986, 8, 65, 1, 65, 3, 65, 989,
+		8, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 5, 66, 999,
+		8, 66, 10, 66, 12, 66, 1002, 9, 66, 1, 66, 1, 66, 3, 66, 1006, 8, 66, 1,
+		66, 1, 66, 1, 67, 1, 67, 3, 67, 1012, 8, 67, 1, 67, 1, 67, 1, 67, 1, 67,
+		3, 67, 1018, 8, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 3,
+		68, 1027, 8, 68, 1, 69, 1, 69, 1, 69, 5, 69, 1032, 8, 69, 10, 69, 12, 69,
+		1035, 9, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 3, 70, 1043, 8,
+		70, 1, 70, 1, 70, 3, 70, 1047, 8, 70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71,
+		3, 71, 1054, 8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 3, 72, 1061, 8,
+		72, 1, 73, 1, 73, 1, 73, 5, 73, 1066, 8, 73, 10, 73, 12, 73, 1069, 9, 73,
+		1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 5, 74, 1077, 8, 74, 10, 74, 12,
+		74, 1080, 9, 74, 1, 74, 1, 74, 3, 74, 1084, 8, 74, 1, 74, 1, 74, 1, 74,
+		1, 74, 3, 74, 1090, 8, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 5,
+		75, 1098, 8, 75, 10, 75, 12, 75, 1101, 9, 75, 1, 75, 3, 75, 1104, 8, 75,
+		3, 75, 1106, 8, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 5, 76, 1114,
+		8, 76, 10, 76, 12, 76, 1117, 9, 76, 1, 76, 1, 76, 3, 76, 1121, 8, 76, 1,
+		77, 1, 77, 3, 77, 1125, 8, 77, 1, 77, 1, 77, 3, 77, 1129, 8, 77, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 3, 78, 1137, 8, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 3, 78, 1143, 8, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 3, 78, 1153, 8, 78, 3, 78, 1155, 8, 78, 1, 79, 1, 79, 1,
+		79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80,
+		1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 3,
+		82, 1180, 8, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83,
+		1, 83, 3, 83, 1191, 8, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1,
+		85, 1, 85, 1, 85, 3, 85, 1202, 8, 85, 1, 85, 1, 85, 3, 85, 1206, 8, 85,
+		1, 86, 1, 86, 1, 86, 1, 86, 3, 86, 1212, 8, 86, 1, 87, 1, 87, 1, 87, 5,
+		87, 1217, 8, 87, 10, 87, 12, 87, 1220, 9, 87, 1, 88, 1, 88, 1, 88, 5, 88,
+		1225, 8, 88, 10, 88, 12, 88, 1228, 9, 88, 1, 89, 1, 89, 3, 89, 1232, 8,
+		89, 1, 90, 1, 90, 1, 90, 3, 90, 1237, 8, 90, 1, 90, 1, 90, 1, 90, 3, 90,
+		1242, 8, 90, 1, 91, 1, 91, 3, 91, 1246, 8, 91, 1, 92, 1, 92, 1, 93, 1,
+		93, 1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 1256, 8, 93, 1, 93, 1, 93, 1, 93,
+		3, 93, 1261, 8, 93, 1, 94, 1, 94, 1, 94, 3, 94, 1266, 8, 94, 1, 95, 1,
+		95, 1, 95, 0, 2, 86, 94, 96, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
+		24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
+		60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94,
+		96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124,
+		126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154,
+		156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184,
+		186, 188, 190, 0, 16, 2, 0, 136, 136, 138, 138, 2, 0, 24, 24, 138, 138,
+		1, 0, 88, 89, 2, 0, 119, 119, 129, 129, 1, 0, 126, 127, 1, 0, 120, 125,
+		1, 0, 35, 36, 2, 0, 4, 4, 33, 33, 2, 0, 36, 36, 83, 83, 1, 0, 25, 26, 2,
+		0, 65, 65, 120, 120, 2, 0, 4, 4, 65, 65, 1, 0, 99, 101, 2, 0, 67, 69, 73,
+		118, 1, 0, 136, 137, 2, 0, 24, 26, 136, 138, 1383, 0, 195, 1, 0, 0, 0,
+		2, 205, 1, 0, 0, 0, 4, 221, 1, 0, 0, 0, 6, 226, 1, 0, 0, 0, 8, 228, 1,
+		0, 0, 0, 10, 236, 1, 0, 0, 0, 12, 259, 1, 0, 0, 0, 14, 261, 1, 0, 0, 0,
+		16, 265, 1, 0, 0, 0, 18, 295, 1, 0, 0, 0, 20, 307, 1, 0, 0, 0, 22, 397,
+		1, 0, 0, 0, 24, 417, 1, 0, 0, 0, 26, 440, 1, 0, 0, 0, 28, 442, 1, 0, 0,
+		0, 30, 455, 1, 0, 0, 0, 32, 457, 1, 0, 0, 0, 34, 461, 1, 0, 0, 0, 36, 472,
+		1, 0, 0, 0, 38, 480, 1, 0, 0, 0, 40, 484, 1, 0, 0, 0, 42, 486, 1, 0, 0,
+		0, 44, 503, 1, 0, 0, 0, 46, 505, 1, 0, 0, 0, 48, 511, 1, 0, 0, 0, 50, 523,
+		1, 0, 0, 0, 52, 529, 1, 0, 0, 0, 54, 533, 1, 0, 0, 0, 56, 537, 1, 0, 0,
+		0, 58, 553, 1, 0, 0, 0, 60, 555, 1, 0, 0, 0, 62, 559, 1, 0, 0, 0, 64, 582,
+		1, 0, 0, 0, 66, 599, 1, 0, 0, 0, 68, 601, 1, 0, 0, 0, 70, 618, 1, 0, 0,
+		0, 72, 620, 1, 0, 0, 0, 74, 628, 1, 0, 0, 0, 76, 630, 1, 0, 0, 0, 78, 653,
+		1, 0, 0, 0, 80, 668, 1, 0, 0, 0, 82, 675, 1, 0, 0, 0, 84, 735, 1, 0, 0,
+		0, 86, 737, 1, 0, 0, 0, 88, 776, 1, 0, 0, 0, 90, 778, 1, 0, 0, 0, 92, 805,
+		1, 0, 0, 0, 94, 807, 1, 0, 0, 0, 96, 854, 1, 0, 0, 0, 98, 856, 1, 0, 0,
+		0, 100, 863, 1, 0, 0, 0, 102, 865, 1, 0, 0, 0, 104, 869, 1, 0, 0, 0, 106,
+		871, 1, 0, 0, 0, 108, 875, 1, 0, 0, 0, 110, 912, 1, 0, 0, 0, 112, 914,
+		1, 0, 0, 0, 114, 952, 1, 0, 0, 0, 116, 956, 1, 0, 0, 0, 118, 962, 1, 0,
+		0, 0, 120, 964, 1, 0, 0, 0, 122, 967, 1, 0, 0, 0, 124, 970, 1, 0, 0, 0,
+		126, 973, 1, 0, 0, 0, 128, 978, 1, 0, 0, 0, 130, 983, 1, 0, 0, 0, 132,
+		992, 1, 0, 0, 0, 134, 1017, 1, 0, 0, 0, 136, 1019, 1, 0, 0, 0, 138, 1028,
+		1, 0, 0, 0, 140, 1036, 1, 0, 0, 0, 142, 1048, 1, 0, 0, 0, 144, 1055, 1,
+		0, 0, 0, 146, 1062, 1, 0, 0, 0, 148, 1070, 1, 0, 0, 0, 150, 1105, 1, 0,
+		0, 0, 152, 1107, 1, 0, 0, 0, 154, 1122, 1, 0, 0, 0, 156, 1154, 1, 0, 0,
+		0, 158, 1156, 1, 0, 0, 0, 160, 1162, 1, 0, 0, 0, 162, 1169, 1, 0, 0, 0,
+		164, 1171, 1, 0, 0, 0, 166, 1181, 1, 0, 0, 0, 168, 1192, 1, 0, 0, 0, 170,
+		1196, 1, 0, 0, 0, 172, 1211, 1, 0, 0, 0, 174, 1213, 1, 0, 0, 0, 176, 1221,
+		1, 0, 0, 0, 178, 1231, 1, 0, 0, 0, 180, 1241, 1, 0, 0, 0, 182, 1245, 1,
+		0, 0, 0, 184, 1247, 1, 0, 0, 0, 186, 1260, 1, 0, 0, 0, 188, 1265, 1, 0,
+		0, 0, 190, 1267, 1, 0, 0, 0, 192, 194, 3, 2, 1, 0, 193, 192, 1, 0, 0, 0,
+		194, 197, 1, 0, 0, 0, 195, 193, 1, 0, 0, 0, 195, 196, 1, 0, 0, 0, 196,
+		198, 1, 0, 0, 0, 197, 195, 1, 0, 0, 0, 198, 199, 5, 0, 0, 1, 199, 1, 1,
+		0, 0, 0, 200, 206, 3, 4, 2, 0, 201, 206, 3, 6, 3, 0, 202, 206, 3, 8, 4,
+		0, 203, 206, 3, 10, 5, 0, 204, 206, 3, 12, 6, 0, 205, 200, 1, 0, 0, 0,
+		205, 201, 1, 0, 0, 0, 205, 202, 1, 0, 0, 0, 205, 203, 1, 0, 0, 0, 205,
+		204, 1, 0, 0, 0, 206, 208, 1, 0, 0, 0, 207, 209, 5, 132, 0, 0, 208, 207,
+		1, 0, 0, 0, 208, 209, 1, 0, 0, 0, 209, 3, 1, 0, 0, 0, 210, 222, 3, 14,
+		7, 0, 211, 222, 3, 16, 8, 0, 212, 222, 3, 18, 9, 0, 213, 222, 3, 20, 10,
+		0, 214, 222, 3, 22, 11, 0, 215, 222, 3, 24, 12, 0, 216, 222, 3, 26, 13,
+		0, 217, 222, 3, 48, 24, 0, 218, 222, 3, 50, 25, 0, 219, 222, 3, 52, 26,
+		0, 220, 222, 3, 54, 27, 0, 221, 210, 1, 0, 0, 0, 221, 211, 1, 0, 0, 0,
+		221, 212, 1, 0, 0, 0, 221, 213, 1, 0, 0, 0, 221, 214, 1, 0, 0, 0, 221,
+		215, 1, 0, 0, 0, 221, 216, 1, 0, 0, 0, 221, 217, 1, 0, 0, 0, 221, 218,
+		1, 0, 0, 0, 221, 219, 1, 0, 0, 0, 221, 220, 1, 0, 0, 0, 222, 5, 1, 0, 0,
+		0, 223, 227, 3, 76, 38, 0, 224, 227, 3, 78, 39, 0, 225, 227, 3, 80, 40,
+		0, 226, 223, 1, 0, 0, 0, 226, 224, 1, 0, 0, 0, 226, 225, 1, 0, 0, 0, 227,
+		7, 1, 0, 0, 0, 228, 229, 3, 82, 41, 0, 229, 9, 1, 0, 0, 0, 230, 237, 3,
+		118, 59, 0, 231, 237, 3, 56, 28, 0, 232, 237, 3, 60, 30, 0, 233, 237, 3,
+		62, 31, 0, 234, 237, 3, 64, 32, 0, 235, 237, 3, 66, 33, 0, 236, 230, 1,
+		0, 0, 0, 236, 231, 1, 0, 0, 0, 236, 232, 1, 0, 0, 0, 236, 233, 1, 0, 0,
+		0, 236, 234, 1, 0, 0, 0, 236, 235, 1, 0, 0, 0, 237, 11, 1, 0, 0, 0, 238,
+		260, 3, 120, 60, 0, 239, 260, 3, 122, 61, 0, 240, 260, 3, 124, 62, 0, 241,
+		260, 3, 126, 63, 0, 242, 260, 3, 128, 64, 0, 243, 260, 3, 130, 65, 0, 244,
+		260, 3, 132, 66, 0, 245, 260, 3, 136, 68, 0, 246, 260, 3, 140, 70, 0, 247,
+		260, 3, 142, 71, 0, 248, 260, 3, 144, 72, 0, 249, 260, 3, 148, 74, 0, 250,
+		260, 3, 152, 76, 0, 251, 260, 3, 154, 77, 0, 252, 260, 3, 156, 78, 0, 253,
+		260, 3, 158, 79, 0, 254, 260, 3, 160, 80, 0, 255, 260, 3, 170, 85, 0, 256,
+		260, 3, 168, 84, 0, 257, 260, 3, 164, 82, 0, 258, 260, 3, 166, 83, 0, 259,
+		238, 1, 0, 0, 0, 259, 239, 1, 0, 0, 0, 259, 240, 1, 0, 0, 0, 259, 241,
+		1, 0, 0, 0, 259, 242, 1, 0, 0, 0, 259, 243, 1, 0, 0, 0, 259, 244, 1, 0,
+		0, 0, 259, 245, 1, 0, 0, 0, 259, 246, 1, 0, 0, 0, 259, 247, 1, 0, 0, 0,
+		259, 248, 1, 0, 0, 0, 259, 249, 1, 0, 0, 0, 259, 250, 1, 0, 0, 0, 259,
+		251, 1, 0, 0, 0, 259, 252, 1, 0, 0, 0, 259, 253, 1, 0, 0, 0, 259, 254,
+		1, 0, 0, 0, 259, 255, 1, 0, 0, 0, 259, 256, 1, 0, 0, 0, 259, 257, 1, 0,
+		0, 0, 259, 258, 1, 0, 0, 0, 260, 13, 1, 0, 0, 0, 261, 262, 5, 17, 0, 0,
+		262, 263, 5, 19, 0, 0, 263, 264, 3, 182, 91, 0, 264, 15, 1, 0, 0, 0, 265,
+		266, 5, 17, 0, 0, 266, 267, 5, 18, 0, 0, 267, 268, 3, 180, 90, 0, 268,
+		269, 5, 133, 0, 0, 269, 274, 3, 42, 21, 0, 270, 271, 5, 131, 0, 0, 271,
+		273, 3, 42, 21, 0, 272, 270, 1, 0, 0, 0, 273, 276, 1, 0, 0, 0, 274, 272,
+		1, 0, 0, 0, 274, 275, 1, 0, 0, 0, 275, 281, 1, 0, 0, 0, 276, 274, 1, 0,
+		0, 0, 277, 278, 5, 131, 0, 0, 278, 280, 3, 46, 23, 0, 279, 277, 1, 0, 0,
+		0, 280, 283, 1, 0, 0, 0, 281, 279, 1, 0, 0, 0, 281, 282, 1, 0, 0, 0, 282,
+		284, 1, 0, 0, 0, 283, 281, 1, 0, 0, 0, 284, 292, 5, 134, 0, 0, 285, 286,
+		5, 34, 0, 0, 286, 287, 5, 7, 0, 0, 287, 291, 3, 110, 55, 0, 288, 289, 5,
+		71, 0, 0, 289, 291, 3, 34, 17, 0, 290, 285, 1, 0, 0, 0, 290, 288, 1, 0,
+		0, 0, 291, 294, 1, 0, 0, 0, 292, 290, 1, 0, 0, 0, 292, 293, 1, 0, 0, 0,
+		293, 17, 1, 0, 0, 0, 294, 292, 1, 0, 0, 0, 295, 296, 5, 17, 0, 0, 296,
+		297, 5, 18, 0, 0, 297, 298, 3, 180, 90, 0, 298, 299, 5, 80, 0, 0, 299,
+		300, 5, 81, 0, 0, 300, 305, 3, 180, 90, 0, 301, 302, 5, 82, 0, 0, 302,
+		303, 5, 27, 0, 0, 303, 304, 5, 72, 0, 0, 304, 306, 5, 136, 0, 0, 305, 301,
+		1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 19, 1, 0, 0, 0, 307, 308, 5, 17,
+		0, 0, 308, 309, 5, 116, 0, 0, 309, 310, 5, 18, 0, 0, 310, 329, 3, 180,
+		90, 0, 311, 312, 5, 133, 0, 0, 312, 317, 3, 42, 21, 0, 313, 314, 5, 131,
+		0, 0, 314, 316, 3, 42, 21, 0, 315, 313, 1, 0, 0, 0, 316, 319, 1, 0, 0,
+		0, 317, 315, 1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318, 324, 1, 0, 0, 0, 319,
+		317, 1, 0, 0, 0, 320, 321, 5, 131, 0, 0, 321, 323, 3, 46, 23, 0, 322, 320,
+		1, 0, 0, 0, 323, 326, 1, 0, 0, 0, 324, 322, 1, 0, 0, 0, 324, 325, 1, 0,
+		0, 0, 325, 327, 1, 0, 0, 0, 326, 324, 1, 0, 0, 0, 327, 328, 5, 134, 0,
+		0, 328, 330, 1, 0, 0, 0, 329, 311, 1, 0, 0, 0, 329, 330, 1, 0, 0, 0, 330,
+		331, 1, 0, 0, 0, 331, 332, 5, 117, 0, 0, 332, 333, 5, 138, 0, 0, 333, 336,
+		5, 118, 0, 0, 334, 337, 5, 138, 0, 0, 335, 337, 3, 182, 91, 0, 336, 334,
+		1, 0, 0, 0, 336, 335, 1, 0, 0, 0, 337, 340, 1, 0, 0, 0, 338, 339, 5, 71,
+		0, 0, 339, 341, 3, 34, 17, 0, 340, 338, 1, 0, 0, 0, 340, 341, 1, 0, 0,
+		0, 341, 21, 1, 0, 0, 0, 342, 343, 5, 70, 0, 0, 343, 344, 5, 18, 0, 0, 344,
+		345, 3, 180, 90, 0, 345, 346, 5, 15, 0, 0, 346, 347, 5, 78, 0, 0, 347,
+		348, 5, 133, 0, 0, 348, 353, 3, 28, 14, 0, 349, 350, 5, 131, 0, 0, 350,
+		352, 3, 28, 14, 0, 351, 349, 1, 0, 0, 0, 352, 355, 1, 0, 0, 0, 353, 351,
+		1, 0, 0, 0, 353, 354, 1, 0, 0, 0, 354, 356, 1, 0, 0, 0, 355, 353, 1, 0,
+		0, 0, 356, 357, 5, 134, 0, 0, 357, 398, 1, 0, 0, 0, 358, 359, 5, 70, 0,
+		0, 359, 360, 5, 18, 0, 0, 360, 361, 3, 180, 90, 0, 361, 362, 5, 79, 0,
+		0, 362, 363, 5, 78, 0, 0, 363, 364, 5, 133, 0, 0, 364, 369, 3, 30, 15,
+		0, 365, 366, 5, 131, 0, 0, 366, 368, 3, 30, 15, 0, 367, 365, 1, 0, 0, 0,
+		368, 371, 1, 0, 0, 0, 369, 367, 1, 0, 0, 0, 369, 370, 1, 0, 0, 0, 370,
+		372, 1, 0, 0, 0, 371, 369, 1, 0, 0, 0, 372, 373, 5, 134, 0, 0, 373, 398,
+		1, 0, 0, 0, 374, 375, 5, 70, 0, 0, 375, 376, 5, 18, 0, 0, 376, 377, 3,
+		180, 90, 0, 377, 378, 5, 20, 0, 0, 378, 379, 5, 34, 0, 0, 379, 380, 3,
+		182, 91, 0, 380, 398, 1, 0, 0, 0, 381, 382, 5, 70, 0, 0, 382, 383, 5, 18,
+		0, 0, 383, 384, 3, 180, 90, 0, 384, 385, 5, 20, 0, 0, 385, 386, 5, 34,
+		0, 0, 386, 387, 5, 133, 0, 0, 387, 392, 3, 32, 16, 0, 388, 389, 5, 131,
+		0, 0, 389, 391, 3, 32, 16, 0, 390, 388, 1, 0, 0, 0, 391, 394, 1, 0, 0,
+		0, 392, 390, 1, 0, 0, 0, 392, 393, 1, 0, 0, 0, 393, 395, 1, 0, 0, 0, 394,
+		392, 1, 0, 0, 0, 395, 396, 5, 134, 0, 0, 396, 398, 1, 0, 0, 0, 397, 342,
+		1, 0, 0, 0, 397, 358, 1, 0, 0, 0, 397, 374, 1, 0, 0, 0, 397, 381, 1, 0,
+		0, 0, 398, 23, 1, 0, 0, 0, 399, 400, 5, 102, 0, 0, 400, 401, 5, 18, 0,
+		0, 401, 402, 3, 180, 90, 0, 402, 403, 5, 65, 0, 0, 403, 404, 5, 82, 0,
+		0, 404, 405, 5, 27, 0, 0, 405, 406, 5, 72, 0, 0, 406, 407, 5, 136, 0, 0,
+		407, 418, 1, 0, 0, 0, 408, 409, 5, 102, 0, 0, 409, 410, 5, 18, 0, 0, 410,
+		411, 3, 180, 90, 0, 411, 412, 5, 65, 0, 0, 412, 413, 5, 58, 0, 0, 413,
+		414, 5, 27, 0, 0, 414, 415, 5, 72, 0, 0, 415, 416, 7, 0, 0, 0, 416, 418,
+		1, 0, 0, 0, 417, 399, 1, 0, 0, 0, 417, 408, 1, 0, 0, 0, 418, 25, 1, 0,
+		0, 0, 419, 420, 5, 85, 0, 0, 420, 421, 5, 33, 0, 0, 421, 422, 5, 18, 0,
+		0, 422, 423, 3, 180, 90, 0, 423, 424, 5, 87, 0, 0, 424, 425, 7, 1, 0, 0,
+		425, 441, 1, 0, 0, 0, 426, 427, 5, 85, 0, 0, 427, 428, 5, 33, 0, 0, 428,
+		429, 5, 86, 0, 0, 429, 434, 3, 182, 91, 0, 430, 431, 5, 130, 0, 0, 431,
+		433, 3, 182, 91, 0, 432, 430, 1, 0, 0, 0, 433, 436, 1, 0, 0, 0, 434, 432,
+		1, 0, 0, 0, 434, 435, 1, 0, 0, 0, 435, 437, 1, 0, 0, 0, 436, 434, 1, 0,
+		0, 0, 437, 438, 5, 87, 0, 0, 438, 439, 7, 1, 0, 0, 439, 441, 1, 0, 0, 0,
+		440, 419, 1, 0, 0, 0, 440, 426, 1, 0, 0, 0, 441, 27, 1, 0, 0, 0, 442, 443,
+		3, 30, 15, 0, 443, 444, 5, 120, 0, 0, 444, 445, 3, 40, 20, 0, 445, 29,
+		1, 0, 0, 0, 446, 456, 5, 138, 0, 0, 447, 452, 3, 182, 91, 0, 448, 449,
+		5, 130, 0, 0, 449, 451, 3, 182, 91, 0, 450, 448, 1, 0, 0, 0, 451, 454,
+		1, 0, 0, 0, 452, 450, 1, 0, 0, 0, 452, 453, 1, 0, 0, 0, 453, 456, 1, 0,
+		0, 0, 454, 452, 1, 0, 0, 0, 455, 446, 1, 0, 0, 0, 455, 447, 1, 0, 0, 0,
+		456, 31, 1, 0, 0, 0, 457, 458, 3, 182, 91, 0, 458, 459, 5, 120, 0, 0, 459,
+		460, 3, 188, 94, 0, 460, 33, 1, 0, 0, 0, 461, 462, 5, 133, 0, 0, 462, 467,
+		3, 36, 18, 0, 463, 464, 5, 131, 0, 0, 464, 466, 3, 36, 18, 0, 465, 463,
+		1, 0, 0, 0, 466, 469, 1, 0, 0, 0, 467, 465, 1, 0, 0, 0, 467, 468, 1, 0,
+		0, 0, 468, 470, 1, 0, 0, 0, 469, 467, 1, 0, 0, 0, 470, 471, 5, 134, 0,
+		0, 471, 35, 1, 0, 0, 0, 472, 474, 3, 38, 19, 0, 473, 475, 5, 120, 0, 0,
+		474, 473, 1, 0, 0, 0, 474, 475, 1, 0, 0, 0, 475, 476, 1, 0, 0, 0, 476,
+		477, 3, 40, 20, 0, 477, 37, 1, 0, 0, 0, 478, 481, 3, 182, 91, 0, 479, 481,
+		5, 24, 0, 0, 480, 478, 1, 0, 0, 0, 480, 479, 1, 0, 0, 0, 481, 39, 1, 0,
+		0, 0, 482, 485, 3, 188, 94, 0, 483, 485, 3, 182, 91, 0, 484, 482, 1, 0,
+		0, 0, 484, 483, 1, 0, 0, 0, 485, 41, 1, 0, 0, 0, 486, 487, 3, 182, 91,
+		0, 487, 491, 3, 186, 93, 0, 488, 490, 3, 44, 22, 0, 489, 488, 1, 0, 0,
+		0, 490, 493, 1, 0, 0, 0, 491, 489, 1, 0, 0, 0, 491, 492, 1, 0, 0, 0, 492,
+		43, 1, 0, 0, 0, 493, 491, 1, 0, 0, 0, 494, 496, 5, 23, 0, 0, 495, 494,
+		1, 0, 0, 0, 495, 496, 1, 0, 0, 0, 496, 497, 1, 0, 0, 0, 497, 504, 5, 24,
+		0, 0, 498, 499, 5, 21, 0, 0, 499, 504, 5, 22, 0, 0, 500, 504, 5, 49, 0,
+		0, 501, 502, 5, 50, 0, 0, 502, 504, 3, 190, 95, 0, 503, 495, 1, 0, 0, 0,
+		503, 498, 1, 0, 0, 0, 503, 500, 1, 0, 0, 0, 503, 501, 1, 0, 0, 0, 504,
+		45, 1, 0, 0, 0, 505, 506, 5, 21, 0, 0, 506, 507, 5, 22, 0, 0, 507, 508,
+		5, 133, 0, 0, 508, 509, 3, 174, 87, 0, 509, 510, 5, 134, 0, 0, 510, 47,
+		1, 0, 0, 0, 511, 513, 5, 17, 0, 0, 512, 514, 5, 49, 0, 0, 513, 512, 1,
+		0, 0, 0, 513, 514, 1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515, 516, 5, 51, 0,
+		0, 516, 517, 3, 182, 91, 0, 517, 518, 5, 33, 0, 0, 518, 519, 3, 180, 90,
+		0, 519, 520, 5, 133, 0, 0, 520, 521, 3, 174, 87, 0, 521, 522, 5, 134, 0,
+		0, 522, 49, 1, 0, 0, 0, 523, 524, 5, 20, 0, 0, 524, 525, 5, 51, 0, 0, 525,
+		526, 3, 182, 91, 0, 526, 527, 5, 33, 0, 0, 527, 528, 3, 180, 90, 0, 528,
+		51, 1, 0, 0, 0, 529, 530, 5, 20, 0, 0, 530, 531, 5, 18, 0, 0, 531, 532,
+		3, 180, 90, 0, 532, 53, 1, 0, 0, 0, 533, 534, 5, 20, 0, 0, 534, 535, 5,
+		19, 0, 0, 535, 536, 3, 182, 91, 0, 536, 55, 1, 0, 0, 0, 537, 538, 5, 17,
+		0, 0, 538, 539, 5, 88, 0, 0, 539, 541, 3, 182, 91, 0, 540, 542, 5, 71,
+		0, 0, 541, 540, 1, 0, 0, 0, 541, 542, 1, 0, 0, 0, 542, 546, 1, 0, 0, 0,
+		543, 545, 3, 58, 29, 0, 544, 543, 1, 0, 0, 0, 545, 548, 1, 0, 0, 0, 546,
+		544, 1, 0, 0, 0, 546, 547, 1, 0, 0, 0, 547, 57, 1, 0, 0, 0, 548, 546, 1,
+		0, 0, 0, 549, 550, 5, 90, 0, 0, 550, 554, 5, 138, 0, 0, 551, 554, 5, 91,
+		0, 0, 552, 554, 5, 92, 0, 0, 553, 549, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0,
+		553, 552, 1, 0, 0, 0, 554, 59, 1, 0, 0, 0, 555, 556, 5, 17, 0, 0, 556,
+		557, 5, 89, 0, 0, 557, 558, 3, 182, 91, 0, 558, 61, 1, 0, 0, 0, 559, 560,
+		5, 20, 0, 0, 560, 563, 7, 2, 0, 0, 561, 562, 5, 96, 0, 0, 562, 564, 5,
+		97, 0, 0, 563, 561, 1, 0, 0, 0, 563, 564, 1, 0, 0, 0, 564, 565, 1, 0, 0,
+		0, 565, 566, 3, 182, 91, 0, 566, 63, 1, 0, 0, 0, 567, 568, 5, 93, 0, 0,
+		568, 569, 3, 68, 34, 0, 569, 571, 5, 33, 0, 0, 570, 572, 5, 18, 0, 0, 571,
+		570, 1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 573, 1, 0, 0, 0, 573, 574,
+		3, 72, 36, 0, 574, 575, 5, 65, 0, 0, 575, 576, 3, 174, 87, 0, 576, 583,
+		1, 0, 0, 0, 577, 578, 5, 93, 0, 0, 578, 579, 3, 174, 87, 0, 579, 580, 5,
+		65, 0, 0, 580, 581, 3, 174, 87, 0, 581, 583, 1, 0, 0, 0, 582, 567, 1, 0,
+		0, 0, 582, 577, 1, 0, 0, 0, 583, 65, 1, 0, 0, 0, 584, 585, 5, 94, 0, 0,
+		585, 586, 3, 68, 34, 0, 586, 588, 5, 33, 0, 0, 587, 589, 5, 18, 0, 0, 588,
+		587, 1, 0, 0, 0, 588, 589, 1, 0, 0, 0, 589, 590, 1, 0, 0, 0, 590, 591,
+		3, 72, 36, 0, 591, 592, 5, 4, 0, 0, 592, 593, 3, 174, 87, 0, 593, 600,
+		1, 0, 0, 0, 594, 595, 5, 94, 0, 0, 595, 596, 3, 174, 87, 0, 596, 597, 5,
+		4, 0, 0, 597, 598, 3, 174, 87, 0, 598, 600, 1, 0, 0, 0, 599, 584, 1, 0,
+		0, 0, 599, 594, 1, 0, 0, 0, 600, 67, 1, 0, 0, 0, 601, 606, 3, 70, 35, 0,
+		602, 603, 5, 131, 0, 0, 603, 605, 3, 70, 35, 0, 604, 602, 1, 0, 0, 0, 605,
+		608, 1, 0, 0, 0, 606, 604, 1, 0, 0, 0, 606, 607, 1, 0, 0, 0, 607, 69, 1,
+		0, 0, 0, 608, 606, 1, 0, 0, 0, 609, 619, 5, 3, 0, 0, 610, 619, 5, 11, 0,
+		0, 611, 619, 5, 14, 0, 0, 612, 619, 5, 16, 0, 0, 613, 615, 5, 66, 0, 0,
+		614, 616, 5, 95, 0, 0, 615, 614, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616,
+		619, 1, 0, 0, 0, 617, 619, 3, 182, 91, 0, 618, 609, 1, 0, 0, 0, 618, 610,
+		1, 0, 0, 0, 618, 611, 1, 0, 0, 0, 618, 612, 1, 0, 0, 0, 618, 613, 1, 0,
+		0, 0, 618, 617, 1, 0, 0, 0, 619, 71, 1, 0, 0, 0, 620, 623, 3, 74, 37, 0,
+		621, 622, 5, 130, 0, 0, 622, 624, 3, 74, 37, 0, 623, 621, 1, 0, 0, 0, 623,
+		624, 1, 0, 0, 0, 624, 73, 1, 0, 0, 0, 625, 629, 3, 182, 91, 0, 626, 629,
+		5, 50, 0, 0, 627, 629, 5, 119, 0, 0, 628, 625, 1, 0, 0, 0, 628, 626, 1,
+		0, 0, 0, 628, 627, 1, 0, 0, 0, 629, 75, 1, 0, 0, 0, 630, 631, 5, 11, 0,
+		0, 631, 632, 5, 12, 0, 0, 632, 637, 3, 180, 90, 0, 633, 634, 5, 133, 0,
+		0, 634, 635, 3, 174, 87, 0, 635, 636, 5, 134, 0, 0, 636, 638, 1, 0, 0,
+		0, 637, 633, 1, 0, 0, 0, 637, 638, 1, 0, 0, 0, 638, 639, 1, 0, 0, 0, 639,
+		640, 5, 13, 0, 0, 640, 641, 5, 133, 0, 0, 641, 642, 3, 176, 88, 0, 642,
+		650, 5, 134, 0, 0, 643, 644, 5, 131, 0, 0, 644, 645, 5, 133, 0, 0, 645,
+		646, 3, 176, 88, 0, 646, 647, 5, 134, 0, 0, 647, 649, 1, 0, 0, 0, 648,
+		643, 1, 0, 0, 0, 649, 652, 1, 0, 0, 0, 650, 648, 1, 0, 0, 0, 650, 651,
+		1, 0, 0, 0, 651, 77, 1, 0, 0, 0, 652, 650, 1, 0, 0, 0, 653, 654, 5, 14,
+		0, 0, 654, 655, 3, 180, 90, 0, 655, 656, 5, 15, 0, 0, 656, 661, 3, 102,
+		51, 0, 657, 658, 5, 131, 0, 0, 658, 660, 3, 102, 51, 0, 659, 657, 1, 0,
+		0, 0, 660, 663, 1, 0, 0, 0, 661, 659, 1, 0, 0, 0, 661, 662, 1, 0, 0, 0,
+		662, 666, 1, 0, 0, 0, 663, 661, 1, 0, 0, 0, 664, 665, 5, 5, 0, 0, 665,
+		667, 3, 94, 47, 0, 666, 664, 1, 0, 0, 0, 666, 667, 1, 0, 0, 0, 667, 79,
+		1, 0, 0, 0, 668, 669, 5, 16, 0, 0, 669, 670, 5, 4, 0, 0, 670, 673, 3, 180,
+		90, 0, 671, 672, 5, 5, 0, 0, 672, 674, 3, 94, 47, 0, 673, 671, 1, 0, 0,
+		0, 673, 674, 1, 0, 0, 0, 674, 81, 1, 0, 0, 0, 675, 676, 5, 3, 0, 0, 676,
+		681, 3, 84, 42, 0, 677, 678, 5, 131, 0, 0, 678, 680, 3, 84, 42, 0, 679,
+		677, 1, 0, 0, 0, 680, 683, 1, 0, 0, 0, 681, 679, 1, 0, 0, 0, 681, 682,
+		1, 0, 0, 0, 682, 684, 1, 0, 0, 0, 683, 681, 1, 0, 0, 0, 684, 685, 5, 4,
+		0, 0, 685, 688, 3, 86, 43, 0, 686, 687, 5, 5, 0, 0, 687, 689, 3, 94, 47,
+		0, 688, 686, 1, 0, 0, 0, 688, 689, 1, 0, 0, 0, 689, 700, 1, 0, 0, 0, 690,
+		691, 5, 6, 0, 0, 691, 692, 5, 7, 0, 0, 692, 697, 3, 104, 52, 0, 693, 694,
+		5, 131, 0, 0, 694, 696, 3, 104, 52, 0, 695, 693, 1, 0, 0, 0, 696, 699,
+		1, 0, 0, 0, 697, 695, 1, 0, 0, 0, 697, 698, 1, 0, 0, 0, 698, 701, 1, 0,
+		0, 0, 699, 697, 1, 0, 0, 0, 700, 690, 1, 0, 0, 0, 700, 701, 1, 0, 0, 0,
+		701, 704, 1, 0, 0, 0, 702, 703, 5, 8, 0, 0, 703, 705, 3, 94, 47, 0, 704,
+		702, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 716, 1, 0, 0, 0, 706, 707,
+		5, 9, 0, 0, 707, 708, 5, 7, 0, 0, 708, 713, 3, 106, 53, 0, 709, 710, 5,
+		131, 0, 0, 710, 712, 3, 106, 53, 0, 711, 709, 1, 0, 0, 0, 712, 715, 1,
+		0, 0, 0, 713, 711, 1, 0, 0, 0, 713, 714, 1, 0, 0, 0, 714, 717, 1, 0, 0,
+		0, 715, 713, 1, 0, 0, 0, 716, 706, 1, 0, 0, 0, 716, 717, 1, 0, 0, 0, 717,
+		720, 1, 0, 0, 0, 718, 719, 5, 10, 0, 0, 719, 721, 5, 136, 0, 0, 720, 718,
+		1, 0, 0, 0, 720, 721, 1, 0, 0, 0, 721, 83, 1, 0, 0, 0, 722, 723, 3, 180,
+		90, 0, 723, 724, 5, 130, 0, 0, 724, 726, 1, 0, 0, 0, 725, 722, 1, 0, 0,
+		0, 725, 726, 1, 0, 0, 0, 726, 727, 1, 0, 0, 0, 727, 736, 5, 119, 0, 0,
+		728, 733, 3, 94, 47, 0, 729, 731, 5, 27, 0, 0, 730, 729, 1, 0, 0, 0, 730,
+		731, 1, 0, 0, 0, 731, 732, 1, 0, 0, 0, 732, 734, 3, 182, 91, 0, 733, 730,
+		1, 0, 0, 0, 733, 734, 1, 0, 0, 0, 734, 736, 1, 0, 0, 0, 735, 725, 1, 0,
+		0, 0, 735, 728, 1, 0, 0, 0, 736, 85, 1, 0, 0, 0, 737, 738, 6, 43, -1, 0,
+		738, 739, 3, 88, 44, 0, 739, 751, 1, 0, 0, 0, 740, 742, 10, 1, 0, 0, 741,
+		743, 3, 92, 46, 0, 742, 741, 1, 0, 0, 0, 742, 743, 1, 0, 0, 0, 743, 744,
+		1, 0, 0, 0, 744, 745, 5, 32, 0, 0, 745, 746, 3, 88, 44, 0, 746, 747, 5,
+		33, 0, 0, 747, 748, 3, 94, 47, 0, 748, 750, 1, 0, 0, 0, 749, 740, 1, 0,
+		0, 0, 750, 753, 1, 0, 0, 0, 751, 749, 1, 0, 0, 0, 751, 752, 1, 0, 0, 0,
+		752, 87, 1, 0, 0, 0, 753, 751, 1, 0, 0, 0, 754, 759, 3, 180, 90, 0, 755,
+		757, 5, 27, 0, 0, 756, 755, 1, 0, 0, 0, 756, 757, 1, 0, 0, 0, 757, 758,
+		1, 0, 0, 0, 758, 760, 3, 182, 91, 0, 759, 756, 1, 0, 0, 0, 759, 760, 1,
+		0, 0, 0, 760, 777, 1, 0, 0, 0, 761, 762, 5, 133, 0, 0, 762, 763, 3, 82,
+		41, 0, 763, 765, 5, 134, 0, 0, 764, 766, 5, 27, 0, 0, 765, 764, 1, 0, 0,
+		0, 765, 766, 1, 0, 0, 0, 766, 767, 1, 0, 0, 0, 767, 768, 3, 182, 91, 0,
+		768, 777, 1, 0, 0, 0, 769, 774, 3, 90, 45, 0, 770, 772, 5, 27, 0, 0, 771,
+		770, 1, 0, 0, 0, 771, 772, 1, 0, 0, 0, 772, 773, 1, 0, 0, 0, 773, 775,
+		3, 182, 91, 0, 774, 771, 1, 0, 0, 0, 774, 775, 1, 0, 0, 0, 775, 777, 1,
+		0, 0, 0, 776, 754, 1, 0, 0, 0, 776, 761, 1, 0, 0, 0, 776, 769, 1, 0, 0,
+		0, 777, 89, 1, 0, 0, 0, 778, 779, 3, 182, 91, 0, 779, 788, 5, 133, 0, 0,
+		780, 785, 3, 188, 94, 0, 781, 782, 5, 131, 0, 0, 782, 784, 3, 188, 94,
+		0, 783, 781, 1, 0, 0, 0, 784, 787, 1, 0, 0, 0, 785, 783, 1, 0, 0, 0, 785,
+		786, 1, 0, 0, 0, 786, 789, 1, 0, 0, 0, 787, 785, 1, 0, 0, 0, 788, 780,
+		1, 0, 0, 0, 788, 789, 1, 0, 0, 0, 789, 790, 1, 0, 0, 0, 790, 791, 5, 134,
+		0, 0, 791, 91, 1, 0, 0, 0, 792, 806, 5, 37, 0, 0, 793, 795, 5, 38, 0, 0,
+		794, 796, 5, 41, 0, 0, 795, 794, 1, 0, 0, 0, 795, 796, 1, 0, 0, 0, 796,
+		806, 1, 0, 0, 0, 797, 799, 5, 39, 0, 0, 798, 800, 5, 41, 0, 0, 799, 798,
+		1, 0, 0, 0, 799, 800, 1, 0, 0, 0, 800, 806, 1, 0, 0, 0, 801, 803, 5, 40,
+		0, 0, 802, 804, 5, 41, 0, 0, 803, 802, 1, 0, 0, 0, 803, 804, 1, 0, 0, 0,
+		804, 806, 1, 0, 0, 0, 805, 792, 1, 0, 0, 0, 805, 793, 1, 0, 0, 0, 805,
+		797, 1, 0, 0, 0, 805, 801, 1, 0, 0, 0, 806, 93, 1, 0, 0, 0, 807, 808, 6,
+		47, -1, 0, 808, 809, 3, 96, 48, 0, 809, 843, 1, 0, 0, 0, 810, 811, 10,
+		7, 0, 0, 811, 812, 7, 3, 0, 0, 812, 842, 3, 94, 47, 8, 813, 814, 10, 6,
+		0, 0, 814, 815, 7, 4, 0, 0, 815, 842, 3, 94, 47, 7, 816, 817, 10, 5, 0,
+		0, 817, 818, 3, 98, 49, 0, 818, 819, 3, 94, 47, 6, 819, 842, 1, 0, 0, 0,
+		820, 821, 10, 4, 0, 0, 821, 822, 5, 30, 0, 0, 822, 842, 3, 94, 47, 5, 823,
+		824, 10, 3, 0, 0, 824, 825, 5, 31, 0, 0, 825, 842, 3, 94, 47, 4, 826, 828,
+		10, 2, 0, 0, 827, 829, 5, 23, 0, 0, 828, 827, 1, 0, 0, 0, 828, 829, 1,
+		0, 0, 0, 829, 830, 1, 0, 0, 0, 830, 831, 5, 28, 0, 0, 831, 842, 3, 94,
+		47, 3, 832, 834, 10, 1, 0, 0, 833, 835, 5, 23, 0, 0, 834, 833, 1, 0, 0,
+		0, 834, 835, 1, 0, 0, 0, 835, 836, 1, 0, 0, 0, 836, 837, 5, 29, 0, 0, 837,
+		838, 5, 133, 0, 0, 838, 839, 3, 176, 88, 0, 839, 840, 5, 134, 0, 0, 840,
+		842, 1, 0, 0, 0, 841, 810, 1, 0, 0, 0, 841, 813, 1, 0, 0, 0, 841, 816,
+		1, 0, 0, 0, 841, 820, 1, 0, 0, 0, 841, 823, 1, 0, 0, 0, 841, 826, 1, 0,
+		0, 0, 841, 832, 1, 0, 0, 0, 842, 845, 1, 0, 0, 0, 843, 841, 1, 0, 0, 0,
+		843, 844, 1, 0, 0, 0, 844, 95, 1, 0, 0, 0, 845, 843, 1, 0, 0, 0, 846, 855,
+		3, 190, 95, 0, 847, 855, 3, 100, 50, 0, 848, 855, 3, 108, 54, 0, 849, 850,
+		5, 133, 0, 0, 850, 851, 3, 94, 47, 0, 851, 852, 5, 134, 0, 0, 852, 855,
+		1, 0, 0, 0, 853, 855, 5, 139, 0, 0, 854, 846, 1, 0, 0, 0, 854, 847, 1,
+		0, 0, 0, 854, 848, 1, 0, 0, 0, 854, 849, 1, 0, 0, 0, 854, 853, 1, 0, 0,
+		0, 855, 97, 1, 0, 0, 0, 856, 857, 7, 5, 0, 0, 857, 99, 1, 0, 0, 0, 858,
+		864, 3, 182, 91, 0, 859, 860, 3, 182, 91, 0, 860, 861, 5, 130, 0, 0, 861,
+		862, 3, 182, 91, 0, 862, 864, 1, 0, 0, 0, 863, 858, 1, 0, 0, 0, 863, 859,
+		1, 0, 0, 0, 864, 101, 1, 0, 0, 0, 865, 866, 3, 182, 91, 0, 866, 867, 5,
+		120, 0, 0, 867, 868, 3, 94, 47, 0, 868, 103, 1, 0, 0, 0, 869, 870, 3, 94,
+		47, 0, 870, 105, 1, 0, 0, 0, 871, 873, 3, 94, 47, 0, 872, 874, 7, 6, 0,
+		0, 873, 872, 1, 0, 0, 0, 873, 874, 1, 0, 0, 0, 874, 107, 1, 0, 0, 0, 875,
+		876, 3, 182, 91, 0, 876, 886, 5, 133, 0, 0, 877, 887, 5, 119, 0, 0, 878,
+		883, 3, 94, 47, 0, 879, 880, 5, 131, 0, 0, 880, 882, 3, 94, 47, 0, 881,
+		879, 1, 0, 0, 0, 882, 885, 1, 0, 0, 0, 883, 881, 1, 0, 0, 0, 883, 884,
+		1, 0, 0, 0, 884, 887, 1, 0, 0, 0, 885, 883, 1, 0, 0, 0, 886, 877, 1, 0,
+		0, 0, 886, 878, 1, 0, 0, 0, 886, 887, 1, 0, 0, 0, 887, 888, 1, 0, 0, 0,
+		888, 889, 5, 134, 0, 0, 889, 109, 1, 0, 0, 0, 890, 891, 5, 63, 0, 0, 891,
+		892, 5, 133, 0, 0, 892, 893, 3, 174, 87, 0, 893, 896, 5, 134, 0, 0, 894,
+		895, 5, 74, 0, 0, 895, 897, 5, 136, 0, 0, 896, 894, 1, 0, 0, 0, 896, 897,
+		1, 0, 0, 0, 897, 913, 1, 0, 0, 0, 898, 899, 5, 64, 0, 0, 899, 900, 5, 133,
+		0, 0, 900, 901, 3, 174, 87, 0, 901, 903, 5, 134, 0, 0, 902, 904, 3, 112,
+		56, 0, 903, 902, 1, 0, 0, 0, 903, 904, 1, 0, 0, 0, 904, 913, 1, 0, 0, 0,
+		905, 906, 5, 73, 0, 0, 906, 907, 5, 133, 0, 0, 907, 908, 3, 174, 87, 0,
+		908, 910, 5, 134, 0, 0, 909, 911, 3, 112, 56, 0, 910, 909, 1, 0, 0, 0,
+		910, 911, 1, 0, 0, 0, 911, 913, 1, 0, 0, 0, 912, 890, 1, 0, 0, 0, 912,
+		898, 1, 0, 0, 0, 912, 905, 1, 0, 0, 0, 913, 111, 1, 0, 0, 0, 914, 915,
+		5, 133, 0, 0, 915, 920, 3, 114, 57, 0, 916, 917, 5, 131, 0, 0, 917, 919,
+		3, 114, 57, 0, 918, 916, 1, 0, 0, 0, 919, 922, 1, 0, 0, 0, 920, 918, 1,
+		0, 0, 0, 920, 921, 1, 0, 0, 0, 921, 923, 1, 0, 0, 0, 922, 920, 1, 0, 0,
+		0, 923, 924, 5, 134, 0, 0, 924, 113, 1, 0, 0, 0, 925, 926, 5, 34, 0, 0,
+		926, 927, 3, 182, 91, 0, 927, 928, 5, 13, 0, 0, 928, 929, 5, 75, 0, 0,
+		929, 935, 5, 76, 0, 0, 930, 931, 5, 133, 0, 0, 931, 932, 3, 116, 58, 0,
+		932, 933, 5, 134, 0, 0, 933, 936, 1, 0, 0, 0, 934, 936, 3, 116, 58, 0,
+		935, 930, 1, 0, 0, 0, 935, 934, 1, 0, 0, 0, 936, 953, 1, 0, 0, 0, 937,
+		938, 5, 34, 0, 0, 938, 939, 3, 182, 91, 0, 939, 940, 5, 13, 0, 0, 940,
+		941, 5, 29, 0, 0, 941, 942, 5, 133, 0, 0, 942, 947, 3, 188, 94, 0, 943,
+		944, 5, 131, 0, 0, 944, 946, 3, 188, 94, 0, 945, 943, 1, 0, 0, 0, 946,
+		949, 1, 0, 0, 0, 947, 945, 1, 0, 0, 0, 947, 948, 1, 0, 0, 0, 948, 950,
+		1, 0, 0, 0, 949, 947, 1, 0, 0, 0, 950, 951, 5, 134, 0, 0, 951, 953, 1,
+		0, 0, 0, 952, 925, 1, 0, 0, 0, 952, 937, 1, 0, 0, 0, 953, 115, 1, 0, 0,
+		0, 954, 957, 5, 77, 0, 0, 955, 957, 3, 188, 94, 0, 956, 954, 1, 0, 0, 0,
+		956, 955, 1, 0, 0, 0, 957, 117, 1, 0, 0, 0, 958, 959, 5, 59, 0, 0, 959,
+		963, 5, 60, 0, 0, 960, 963, 5, 61, 0, 0, 961, 963, 5, 62, 0, 0, 962, 958,
+		1, 0, 0, 0, 962, 960, 1, 0, 0, 0, 962, 961, 1, 0, 0, 0, 963, 119, 1, 0,
+		0, 0, 964, 965, 5, 42, 0, 0, 965, 966, 3, 182, 91, 0, 966, 121, 1, 0, 0,
+		0, 967, 968, 5, 43, 0, 0, 968, 969, 5, 44, 0, 0, 969, 123, 1, 0, 0, 0,
+		970, 971, 5, 43, 0, 0, 971, 972, 5, 45, 0, 0, 972, 125, 1, 0, 0, 0, 973,
+		974, 5, 43, 0, 0, 974, 975, 5, 52, 0, 0, 975, 976, 7, 7, 0, 0, 976, 977,
+		3, 180, 90, 0, 977, 127, 1, 0, 0, 0, 978, 979, 5, 43, 0, 0, 979, 980, 5,
+		17, 0, 0, 980, 981, 5, 18, 0, 0, 981, 982, 3, 180, 90, 0, 982, 129, 1,
+		0, 0, 0, 983, 985, 7, 8, 0, 0, 984, 986, 5, 18, 0, 0, 985, 984, 1, 0, 0,
+		0, 985, 986, 1, 0, 0, 0, 986, 988, 1, 0, 0, 0, 987, 989, 5, 84, 0, 0, 988,
+		987, 1, 0, 0, 0, 988, 989, 1, 0, 0, 0, 989, 990, 1, 0, 0, 0, 990, 991,
+		3, 180, 90, 0, 991, 131, 1, 0, 0, 0, 992, 1005, 5, 46, 0, 0, 993, 1006,
+		5, 47, 0, 0, 994, 995, 5, 133, 0, 0, 995, 1000, 3, 134, 67, 0, 996, 997,
+		5, 131, 0, 0, 997, 999, 3, 134, 67, 0, 998, 996, 1, 0, 0, 0, 999, 1002,
+		1, 0, 0, 0, 1000, 998, 1, 0, 0, 0, 1000, 1001, 1, 0, 0, 0, 1001, 1003,
+		1, 0, 0, 0, 1002, 1000, 1, 0, 0, 0, 1003, 1004, 5, 134, 0, 0, 1004, 1006,
+		1, 0, 0, 0, 1005, 993, 1, 0, 0, 0, 1005, 994, 1, 0, 0, 0, 1005, 1006, 1,
+		0, 0, 0, 1006, 1007, 1, 0, 0, 0, 1007, 1008, 3, 82, 41, 0, 1008, 133, 1,
+		0, 0, 0, 1009, 1011, 5, 47, 0, 0, 1010, 1012, 7, 9, 0, 0, 1011, 1010, 1,
+		0, 0, 0, 1011, 1012, 1, 0, 0, 0, 1012, 1018, 1, 0, 0, 0, 1013, 1014, 5,
+		118, 0, 0, 1014, 1018, 3, 182, 91, 0, 1015, 1018, 5, 48, 0, 0, 1016, 1018,
+		3, 182, 91, 0, 1017, 1009, 1, 0, 0, 0, 1017, 1013, 1, 0, 0, 0, 1017, 1015,
+		1, 0, 0, 0, 1017, 1016, 1, 0, 0, 0, 1018, 135, 1, 0, 0, 0, 1019, 1020,
+		5, 47, 0, 0, 1020, 1021, 5, 18, 0, 0, 1021, 1026, 3, 180, 90, 0, 1022,
+		1023, 5, 133, 0, 0, 1023, 1024, 3, 138, 69, 0, 1024, 1025, 5, 134, 0, 0,
+		1025, 1027, 1, 0, 0, 0, 1026, 1022, 1, 0, 0, 0, 1026, 1027, 1, 0, 0, 0,
+		1027, 137, 1, 0, 0, 0, 1028, 1033, 3, 182, 91, 0, 1029, 1030, 5, 131, 0,
+		0, 1030, 1032, 3, 182, 91, 0, 1031, 1029, 1, 0, 0, 0, 1032, 1035, 1, 0,
+		0, 0, 1033, 1031, 1, 0, 0, 0, 1033, 1034, 1, 0, 0, 0, 1034, 139, 1, 0,
+		0, 0, 1035, 1033, 1, 0, 0, 0, 1036, 1042, 5, 15, 0, 0, 1037, 1038, 5, 68,
+		0, 0, 1038, 1043, 5, 69, 0, 0, 1039, 1040, 3, 146, 73, 0, 1040, 1041, 7,
+		10, 0, 0, 1041, 1043, 1, 0, 0, 0, 1042, 1037, 1, 0, 0, 0, 1042, 1039, 1,
+		0, 0, 0, 1043, 1046, 1, 0, 0, 0, 1044, 1047, 5, 50, 0, 0, 1045, 1047, 3,
+		172, 86, 0, 1046, 1044, 1, 0, 0, 0, 1046, 1045, 1, 0, 0, 0, 1047, 141,
+		1, 0, 0, 0, 1048, 1053, 5, 43, 0, 0, 1049, 1050, 5, 68, 0, 0, 1050, 1054,
+		5, 69, 0, 0, 1051, 1054, 5, 66, 0, 0, 1052, 1054, 3, 146, 73, 0, 1053,
+		1049, 1, 0, 0, 0, 1053, 1051, 1, 0, 0, 0, 1053, 1052, 1, 0, 0, 0, 1054,
+		143, 1, 0, 0, 0, 1055, 1060, 5, 67, 0, 0, 1056, 1057, 5, 68, 0, 0, 1057,
+		1061, 5, 69, 0, 0, 1058, 1061, 5, 66, 0, 0, 1059, 1061, 3, 146, 73, 0,
+		1060, 1056, 1, 0, 0, 0, 1060, 1058, 1, 0, 0, 0, 1060, 1059, 1, 0, 0, 0,
+		1061, 145, 1, 0, 0, 0, 1062, 1067, 3, 182, 91, 0, 1063, 1064, 5, 130, 0,
+		0, 1064, 1066, 3, 182, 91, 0, 1065, 1063, 1, 0, 0, 0, 1066, 1069, 1, 0,
+		0, 0, 1067, 1065, 1, 0, 0, 0, 1067, 1068, 1, 0, 0, 0, 1068, 147, 1, 0,
+		0, 0, 1069, 1067, 1, 0, 0, 0, 1070, 1071, 5, 110, 0, 0, 1071, 1083, 3,
+		182, 91, 0, 1072, 1073, 5, 133, 0, 0, 1073, 1078, 3, 150, 75, 0, 1074,
+		1075, 5, 131, 0, 0, 1075, 1077, 3, 150, 75, 0, 1076, 1074, 1, 0, 0, 0,
+		1077, 1080, 1, 0, 0, 0, 1078, 1076, 1, 0, 0, 0, 1078, 1079, 1, 0, 0, 0,
+		1079, 1081, 1, 0, 0, 0, 1080, 1078, 1, 0, 0, 0, 1081, 1082, 5, 134, 0,
+		0, 1082, 1084, 1, 0, 0, 0, 1083, 1072, 1, 0, 0, 0, 1083, 1084, 1, 0, 0,
+		0, 1084, 1085, 1, 0, 0, 0, 1085, 1089, 5, 27, 0, 0, 1086, 1090, 3, 8, 4,
+		0, 1087, 1090, 3, 6, 3, 0, 1088, 1090, 3, 4, 2, 0, 1089, 1086, 1, 0, 0,
+		0, 1089, 1087, 1, 0, 0, 0, 1089, 1088, 1, 0, 0, 0, 1090, 149, 1, 0, 0,
+		0, 1091, 1106, 3, 186, 93, 0, 1092, 1103, 3, 182, 91, 0, 1093, 1094, 5,
+		133, 0, 0, 1094, 1099, 5, 136, 0, 0, 1095, 1096, 5, 131, 0, 0, 1096, 1098,
+		5, 136, 0, 0, 1097, 1095, 1, 0, 0, 0, 1098, 1101, 1, 0, 0, 0, 1099, 1097,
+		1, 0, 0, 0, 1099, 1100, 1, 0, 0, 0, 1100, 1102, 1, 0, 0, 0, 1101, 1099,
+		1, 0, 0, 0, 1102, 1104, 5, 134, 0, 0, 1103, 1093, 1, 0, 0, 0, 1103, 1104,
+		1, 0, 0, 0, 1104, 1106, 1, 0, 0, 0, 1105, 1091, 1, 0, 0, 0, 1105, 1092,
+		1, 0, 0, 0, 1106, 151, 1, 0, 0, 0, 1107, 1108, 5, 111, 0, 0, 1108, 1120,
+		3, 182, 91, 0, 1109, 1110, 5, 133, 0, 0, 1110, 1115, 3, 188, 94, 0, 1111,
+		1112, 5, 131, 0, 0, 1112, 1114, 3, 188, 94, 0, 1113, 1111, 1, 0, 0, 0,
+		1114, 1117, 1, 0, 0, 0, 1115, 1113, 1, 0, 0, 0, 1115, 1116, 1, 0, 0, 0,
+		1116, 1118, 1, 0, 0, 0, 1117, 1115, 1, 0, 0, 0, 1118, 1119, 5, 134, 0,
+		0, 1119, 1121, 1, 0, 0, 0, 1120, 1109, 1, 0, 0, 0, 1120, 1121, 1, 0, 0,
+		0, 1121, 153, 1, 0, 0, 0, 1122, 1124, 5, 112, 0, 0, 1123, 1125, 5, 110,
+		0, 0, 1124, 1123, 1, 0, 0, 0, 1124, 1125, 1, 0, 0, 0, 1125, 1128, 1, 0,
+		0, 0, 1126, 1129, 5, 66, 0, 0, 1127, 1129, 3, 182, 91, 0, 1128, 1126, 1,
+		0, 0, 0, 1128, 1127, 1, 0, 0, 0, 1129, 155, 1, 0, 0, 0, 1130, 1131, 5,
+		113, 0, 0, 1131, 1136, 3, 180, 90, 0, 1132, 1133, 5, 133, 0, 0, 1133, 1134,
+		3, 174, 87, 0, 1134, 1135, 5, 134, 0, 0, 1135, 1137, 1, 0, 0, 0, 1136,
+		1132, 1, 0, 0, 0, 1136, 1137, 1, 0, 0, 0, 1137, 1138, 1, 0, 0, 0, 1138,
+		1139, 7, 11, 0, 0, 1139, 1142, 5, 138, 0, 0, 1140, 1141, 5, 71, 0, 0, 1141,
+		1143, 3, 34, 17, 0, 1142, 1140, 1, 0, 0, 0, 1142, 1143, 1, 0, 0, 0, 1143,
+		1155, 1, 0, 0, 0, 1144, 1145, 5, 113, 0, 0, 1145, 1146, 5, 133, 0, 0, 1146,
+		1147, 3, 82, 41, 0, 1147, 1148, 5, 134, 0, 0, 1148, 1149, 7, 11, 0, 0,
+		1149, 1152, 5, 138, 0, 0, 1150, 1151, 5, 71, 0, 0, 1151, 1153, 3, 34, 17,
+		0, 1152, 1150, 1, 0, 0, 0, 1152, 1153, 1, 0, 0, 0, 1153, 1155, 1, 0, 0,
+		0, 1154, 1130, 1, 0, 0, 0, 1154, 1144, 1, 0, 0, 0, 1155, 157, 1, 0, 0,
+		0, 1156, 1157, 5, 114, 0, 0, 1157, 1158, 5, 18, 0, 0, 1158, 1159, 3, 180,
+		90, 0, 1159, 1160, 5, 65, 0, 0, 1160, 1161, 3, 162, 81, 0, 1161, 159, 1,
+		0, 0, 0, 1162, 1163, 5, 115, 0, 0, 1163, 1164, 5, 18, 0, 0, 1164, 1165,
+		3, 180, 90, 0, 1165, 1166, 5, 4, 0, 0, 1166, 1167, 3, 162, 81, 0, 1167,
+		1168, 5, 138, 0, 0, 1168, 161, 1, 0, 0, 0, 1169, 1170, 3, 182, 91, 0, 1170,
+		163, 1, 0, 0, 0, 1171, 1172, 5, 103, 0, 0, 1172, 1173, 5, 19, 0, 0, 1173,
+		1174, 3, 182, 91, 0, 1174, 1175, 5, 65, 0, 0, 1175, 1179, 5, 138, 0, 0,
+		1176, 1177, 5, 104, 0, 0, 1177, 1178, 5, 4, 0, 0, 1178, 1180, 5, 138, 0,
+		0, 1179, 1176, 1, 0, 0, 0, 1179, 1180, 1, 0, 0, 0, 1180, 165, 1, 0, 0,
+		0, 1181, 1182, 5, 102, 0, 0, 1182, 1183, 5, 19, 0, 0, 1183, 1184, 3, 182,
+		91, 0, 1184, 1185, 5, 4, 0, 0, 1185, 1190, 5, 138, 0, 0, 1186, 1187, 5,
+		27, 0, 0, 1187, 1188, 5, 72, 0, 0, 1188, 1189, 5, 82, 0, 0, 1189, 1191,
+		5, 136, 0, 0, 1190, 1186, 1, 0, 0, 0, 1190, 1191, 1, 0, 0, 0, 1191, 167,
+		1, 0, 0, 0, 1192, 1193, 5, 98, 0, 0, 1193, 1194, 7, 12, 0, 0, 1194, 1195,
+		5, 136, 0, 0, 1195, 169, 1, 0, 0, 0, 1196, 1197, 5, 105, 0, 0, 1197, 1201,
+		3, 180, 90, 0, 1198, 1199, 5, 106, 0, 0, 1199, 1200, 5, 136, 0, 0, 1200,
+		1202, 5, 107, 0, 0, 1201, 1198, 1, 0, 0, 0, 1201, 1202, 1, 0, 0, 0, 1202,
+		1205, 1, 0, 0, 0, 1203, 1204, 5, 108, 0, 0, 1204, 1206, 5, 109, 0, 0, 1205,
+		1203, 1, 0, 0, 0, 1205, 1206, 1, 0, 0, 0, 1206, 171, 1, 0, 0, 0, 1207,
+		1212, 3, 188, 94, 0, 1208, 1212, 3, 182, 91, 0, 1209, 1212, 5, 33, 0, 0,
+		1210, 1212, 5, 18, 0, 0, 1211, 1207, 1, 0, 0, 0, 1211, 1208, 1, 0, 0, 0,
+		1211, 1209, 1, 0, 0, 0, 1211, 1210, 1, 0, 0, 0, 1212, 173, 1, 0, 0, 0,
+		1213, 1218, 3, 182, 91, 0, 1214, 1215, 5, 131, 0, 0, 1215, 1217, 3, 182,
+		91, 0, 1216, 1214, 1, 0, 0, 0, 1217, 1220, 1, 0, 0, 0, 1218, 1216, 1, 0,
+		0, 0, 1218, 1219, 1, 0, 0, 0, 1219, 175, 1, 0, 0, 0, 1220, 1218, 1, 0,
+		0, 0, 1221, 1226, 3, 178, 89, 0, 1222, 1223, 5, 131, 0, 0, 1223, 1225,
+		3, 178, 89, 0, 1224, 1222, 1, 0, 0, 0, 1225, 1228, 1, 0, 0, 0, 1226, 1224,
+		1, 0, 0, 0, 1226, 1227, 1, 0, 0, 0, 1227, 177, 1, 0, 0, 0, 1228, 1226,
+		1, 0, 0, 0, 1229, 1232, 3, 190, 95, 0, 1230, 1232, 5, 139, 0, 0, 1231,
+		1229, 1, 0, 0, 0, 1231, 1230, 1, 0, 0, 0, 1232, 179, 1, 0, 0, 0, 1233,
+		1236, 3, 182, 91, 0, 1234, 1235, 5, 130, 0, 0, 1235, 1237, 3, 182, 91,
+		0, 1236, 1234, 1, 0, 0, 0, 1236, 1237, 1, 0, 0, 0, 1237, 1242, 1, 0, 0,
+		0, 1238, 1239, 5, 50, 0, 0, 1239, 1240, 5, 130, 0, 0, 1240, 1242, 3, 182,
+		91, 0, 1241, 1233, 1, 0, 0, 0, 1241, 1238, 1, 0, 0, 0, 1242, 181, 1, 0,
+		0, 0, 1243, 1246, 5, 135, 0, 0, 1244, 1246, 3, 184, 92, 0, 1245, 1243,
+		1, 0, 0, 0, 1245, 1244, 1, 0, 0, 0, 1246, 183, 1, 0, 0, 0, 1247, 1248,
+		7, 13, 0, 0, 1248, 185, 1, 0, 0, 0, 1249, 1261, 5, 53, 0, 0, 1250, 1261,
+		5, 54, 0, 0, 1251, 1255, 5, 55, 0, 0, 1252, 1253, 5, 133, 0, 0, 1253, 1254,
+		5, 136, 0, 0, 1254, 1256, 5, 134, 0, 0, 1255, 1252, 1, 0, 0, 0, 1255, 1256,
+		1, 0, 0, 0, 1256, 1261, 1, 0, 0, 0, 1257, 1261, 5, 56, 0, 0, 1258, 1261,
+		5, 57, 0, 0, 1259, 1261, 5, 58, 0, 0, 1260, 1249, 1, 0, 0, 0, 1260, 1250,
+		1, 0, 0, 0, 1260, 1251, 1, 0, 0, 0, 1260, 1257, 1, 0, 0, 0, 1260, 1258,
+		1, 0, 0, 0, 1260, 1259, 1, 0, 0, 0, 1261, 187, 1, 0, 0, 0, 1262, 1266,
+		3, 190, 95, 0, 1263, 1264, 7, 4, 0, 0, 1264, 1266, 7, 14, 0, 0, 1265, 1262,
+		1, 0, 0, 0, 1265, 1263, 1, 0, 0, 0, 1266, 189, 1, 0, 0, 0, 1267, 1268,
+		7, 15, 0, 0, 1268, 191, 1, 0, 0, 0, 138, 195, 205, 208, 221, 226, 236,
+		259, 274, 281, 290, 292, 305, 317, 324, 329, 336, 340, 353, 369, 392, 397,
+		417, 434, 440, 452, 455, 467, 474, 480, 484, 491, 495, 503, 513, 541, 546,
+		553, 563, 571, 582, 588, 599, 606, 615, 618, 623, 628, 637, 650, 661, 666,
+		673, 681, 688, 697, 700, 704, 713, 716, 720, 725, 730, 733, 735, 742, 751,
+		756, 759, 765, 771, 774, 776, 785, 788, 795, 799, 803, 805, 828, 834, 841,
+		843, 854, 863, 873, 883, 886, 896, 903, 910, 912, 920, 935, 947, 952, 956,
+		962, 985, 988, 1000, 1005, 1011, 1017, 1026, 1033, 1042, 1046, 1053, 1060,
+		1067, 1078, 1083, 1089, 1099, 1103, 1105, 1115, 1120, 1124, 1128, 1136,
+		1142, 1152, 1154, 1179, 1190, 1201, 1205, 1211, 1218, 1226, 1231, 1236,
+		1241, 1245, 1255, 1260, 1265,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -417,27 +789,82 @@ const (
 	MiniQLParserROLLBACK            = 62
 	MiniQLParserHASH                = 63
 	MiniQLParserRANGE               = 64
-	MiniQLParserASTERISK            = 65
-	MiniQLParserEQUAL               = 66
-	MiniQLParserNOT_EQUAL           = 67
-	MiniQLParserGREATER             = 68
-	MiniQLParserGREATER_EQUAL       = 69
-	MiniQLParserLESS                = 70
-	MiniQLParserLESS_EQUAL          = 71
-	MiniQLParserPLUS                = 72
-	MiniQLParserMINUS               = 73
-	MiniQLParserMULTIPLY            = 74
-	MiniQLParserDIVIDE              = 75
-	MiniQLParserDOT                 = 76
-	MiniQLParserCOMMA               = 77
-	MiniQLParserSEMICOLON           = 78
-	MiniQLParserLEFT_PAREN          = 79
-	MiniQLParserRIGHT_PAREN         = 80
-	MiniQLParserIDENTIFIER          = 81
-	MiniQLParserINTEGER_LITERAL     = 82
-	MiniQLParserFLOAT_LITERAL       = 83
-	MiniQLParserSTRING_LITERAL      = 84
-	MiniQLParserWS                  = 85
+	MiniQLParserTO                  = 65
+	MiniQLParserALL                 = 66
+	MiniQLParserRESET               = 67
+	MiniQLParserTIME                = 68
+	MiniQLParserZONE                = 69
+	MiniQLParserALTER               = 70
+	MiniQLParserWITH                = 71
+	MiniQLParserOF                  = 72
+	MiniQLParserLIST                = 73
+	MiniQLParserPARTITIONS          = 74
+	MiniQLParserLESS_KW             = 75
+	MiniQLParserTHAN                = 76
+	MiniQLParserMAXVALUE            = 77
+	MiniQLParserTBLPROPERTIES       = 78
+	MiniQLParserUNSET               = 79
+	MiniQLParserSHALLOW             = 80
+	MiniQLParserCLONE               = 81
+	MiniQLParserVERSION             = 82
+	MiniQLParserDESCRIBE            = 83
+	MiniQLParserEXTENDED            = 84
+	MiniQLParserCOMMENT             = 85
+	MiniQLParserCOLUMN              = 86
+	MiniQLParserIS                  = 87
+	MiniQLParserUSER                = 88
+	MiniQLParserROLE                = 89
+	MiniQLParserPASSWORD            = 90
+	MiniQLParserSUPERUSER           = 91
+	MiniQLParserNOSUPERUSER         = 92
+	MiniQLParserGRANT               = 93
+	MiniQLParserREVOKE              = 94
+	MiniQLParserPRIVILEGES          = 95
+	MiniQLParserIF                  = 96
+	MiniQLParserEXISTS              = 97
+	MiniQLParserKILL                = 98
+	MiniQLParserQUERY               = 99
+	MiniQLParserSESSION             = 100
+	MiniQLParserCONNECTION          = 101
+	MiniQLParserRESTORE             = 102
+	MiniQLParserBACKUP              = 103
+	MiniQLParserINCREMENTAL         = 104
+	MiniQLParserVACUUM              = 105
+	MiniQLParserRETAIN              = 106
+	MiniQLParserHOURS               = 107
+	MiniQLParserDRY                 = 108
+	MiniQLParserRUN                 = 109
+	MiniQLParserPREPARE             = 110
+	MiniQLParserEXECUTE             = 111
+	MiniQLParserDEALLOCATE          = 112
+	MiniQLParserCOPY                = 113
+	MiniQLParserEXPORT              = 114
+	MiniQLParserIMPORT              = 115
+	MiniQLParserEXTERNAL            = 116
+	MiniQLParserLOCATION            = 117
+	MiniQLParserFORMAT              = 118
+	MiniQLParserASTERISK            = 119
+	MiniQLParserEQUAL               = 120
+	MiniQLParserNOT_EQUAL           = 121
+	MiniQLParserGREATER             = 122
+	MiniQLParserGREATER_EQUAL       = 123
+	MiniQLParserLESS                = 124
+	MiniQLParserLESS_EQUAL          = 125
+	MiniQLParserPLUS                = 126
+	MiniQLParserMINUS               = 127
+	MiniQLParserMULTIPLY            = 128
+	MiniQLParserDIVIDE              = 129
+	MiniQLParserDOT                 = 130
+	MiniQLParserCOMMA               = 131
+	MiniQLParserSEMICOLON           = 132
+	MiniQLParserLEFT_PAREN          = 133
+	MiniQLParserRIGHT_PAREN         = 134
+	MiniQLParserIDENTIFIER          = 135
+	MiniQLParserINTEGER_LITERAL     = 136
+	MiniQLParserFLOAT_LITERAL       = 137
+	MiniQLParserSTRING_LITERAL      = 138
+	MiniQLParserPARAM               = 139
+	MiniQLParserWS                  = 140
 )
 
 // MiniQLParser rules.
@@ -451,44 +878,93 @@ const (
 	MiniQLParserRULE_utilityStatement     = 6
 	MiniQLParserRULE_createDatabase       = 7
 	MiniQLParserRULE_createTable          = 8
-	MiniQLParserRULE_columnDef            = 9
-	MiniQLParserRULE_columnConstraint     = 10
-	MiniQLParserRULE_tableConstraint      = 11
-	MiniQLParserRULE_createIndex          = 12
-	MiniQLParserRULE_dropIndex            = 13
-	MiniQLParserRULE_dropTable            = 14
-	MiniQLParserRULE_dropDatabase         = 15
-	MiniQLParserRULE_insertStatement      = 16
-	MiniQLParserRULE_updateStatement      = 17
-	MiniQLParserRULE_deleteStatement      = 18
-	MiniQLParserRULE_selectStatement      = 19
-	MiniQLParserRULE_selectItem           = 20
-	MiniQLParserRULE_tableReference       = 21
-	MiniQLParserRULE_tableReferenceAtom   = 22
-	MiniQLParserRULE_joinType             = 23
-	MiniQLParserRULE_expression           = 24
-	MiniQLParserRULE_primaryExpr          = 25
-	MiniQLParserRULE_comparisonOperator   = 26
-	MiniQLParserRULE_columnRef            = 27
-	MiniQLParserRULE_updateAssignment     = 28
-	MiniQLParserRULE_groupByItem          = 29
-	MiniQLParserRULE_orderByItem          = 30
-	MiniQLParserRULE_functionCall         = 31
-	MiniQLParserRULE_partitionMethod      = 32
-	MiniQLParserRULE_transactionStatement = 33
-	MiniQLParserRULE_useStatement         = 34
-	MiniQLParserRULE_showDatabases        = 35
-	MiniQLParserRULE_showTables           = 36
-	MiniQLParserRULE_showIndexes          = 37
-	MiniQLParserRULE_explainStatement     = 38
-	MiniQLParserRULE_analyzeStatement     = 39
-	MiniQLParserRULE_columnList           = 40
-	MiniQLParserRULE_identifierList       = 41
-	MiniQLParserRULE_valueList            = 42
-	MiniQLParserRULE_tableName            = 43
-	MiniQLParserRULE_identifier           = 44
-	MiniQLParserRULE_dataType             = 45
-	MiniQLParserRULE_literal              = 46
+	MiniQLParserRULE_cloneTable           = 9
+	MiniQLParserRULE_createExternalTable  = 10
+	MiniQLParserRULE_alterTable           = 11
+	MiniQLParserRULE_restoreTable         = 12
+	MiniQLParserRULE_commentStatement     = 13
+	MiniQLParserRULE_tableProperty        = 14
+	MiniQLParserRULE_propertyName         = 15
+	MiniQLParserRULE_partitionValue       = 16
+	MiniQLParserRULE_optionList           = 17
+	MiniQLParserRULE_option               = 18
+	MiniQLParserRULE_optionName           = 19
+	MiniQLParserRULE_optionValue          = 20
+	MiniQLParserRULE_columnDef            = 21
+	MiniQLParserRULE_columnConstraint     = 22
+	MiniQLParserRULE_tableConstraint      = 23
+	MiniQLParserRULE_createIndex          = 24
+	MiniQLParserRULE_dropIndex            = 25
+	MiniQLParserRULE_dropTable            = 26
+	MiniQLParserRULE_dropDatabase         = 27
+	MiniQLParserRULE_createUser           = 28
+	MiniQLParserRULE_userOption           = 29
+	MiniQLParserRULE_createRole           = 30
+	MiniQLParserRULE_dropRole             = 31
+	MiniQLParserRULE_grantStatement       = 32
+	MiniQLParserRULE_revokeStatement      = 33
+	MiniQLParserRULE_privilegeList        = 34
+	MiniQLParserRULE_privilege            = 35
+	MiniQLParserRULE_grantObject          = 36
+	MiniQLParserRULE_grantObjectPart      = 37
+	MiniQLParserRULE_insertStatement      = 38
+	MiniQLParserRULE_updateStatement      = 39
+	MiniQLParserRULE_deleteStatement      = 40
+	MiniQLParserRULE_selectStatement      = 41
+	MiniQLParserRULE_selectItem           = 42
+	MiniQLParserRULE_tableReference       = 43
+	MiniQLParserRULE_tableReferenceAtom   = 44
+	MiniQLParserRULE_tableFunction        = 45
+	MiniQLParserRULE_joinType             = 46
+	MiniQLParserRULE_expression           = 47
+	MiniQLParserRULE_primaryExpr          = 48
+	MiniQLParserRULE_comparisonOperator   = 49
+	MiniQLParserRULE_columnRef            = 50
+	MiniQLParserRULE_updateAssignment     = 51
+	MiniQLParserRULE_groupByItem          = 52
+	MiniQLParserRULE_orderByItem          = 53
+	MiniQLParserRULE_functionCall         = 54
+	MiniQLParserRULE_partitionMethod      = 55
+	MiniQLParserRULE_partitionDefinitions = 56
+	MiniQLParserRULE_partitionDefinition  = 57
+	MiniQLParserRULE_partitionBound       = 58
+	MiniQLParserRULE_transactionStatement = 59
+	MiniQLParserRULE_useStatement         = 60
+	MiniQLParserRULE_showDatabases        = 61
+	MiniQLParserRULE_showTables           = 62
+	MiniQLParserRULE_showIndexes          = 63
+	MiniQLParserRULE_showCreateTable      = 64
+	MiniQLParserRULE_describeTable        = 65
+	MiniQLParserRULE_explainStatement     = 66
+	MiniQLParserRULE_explainOption        = 67
+	MiniQLParserRULE_analyzeStatement     = 68
+	MiniQLParserRULE_columnList           = 69
+	MiniQLParserRULE_setStatement         = 70
+	MiniQLParserRULE_showVariable         = 71
+	MiniQLParserRULE_resetStatement       = 72
+	MiniQLParserRULE_variableName         = 73
+	MiniQLParserRULE_prepareStatement     = 74
+	MiniQLParserRULE_parameterType        = 75
+	MiniQLParserRULE_executeStatement     = 76
+	MiniQLParserRULE_deallocateStatement  = 77
+	MiniQLParserRULE_copyStatement        = 78
+	MiniQLParserRULE_exportTable          = 79
+	MiniQLParserRULE_importTable          = 80
+	MiniQLParserRULE_tableFormat          = 81
+	MiniQLParserRULE_backupDatabase       = 82
+	MiniQLParserRULE_restoreDatabase      = 83
+	MiniQLParserRULE_killStatement        = 84
+	MiniQLParserRULE_vacuumStatement      = 85
+	MiniQLParserRULE_setValue             = 86
+	MiniQLParserRULE_identifierList       = 87
+	MiniQLParserRULE_valueList            = 88
+	MiniQLParserRULE_valueItem            = 89
+	MiniQLParserRULE_tableName            = 90
+	MiniQLParserRULE_identifier           = 91
+	MiniQLParserRULE_nonReservedKeyword   = 92
+	MiniQLParserRULE_dataType             = 93
+	MiniQLParserRULE_signedLiteral        = 94
+	MiniQLParserRULE_literal              = 95
 )
 
 // IParseContext is an interface to support dynamic dispatch.
@@ -608,20 +1084,20 @@ func (p *MiniQLParser) Parse() (localctx IParseContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(97)
+	p.SetState(195)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&7494214080317835272) != 0 {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&7494214149037344776) != 0) || ((int64((_la-67)) & ^0x3f) == 0 && ((int64(1)<<(_la-67))&554534166659081) != 0) {
 		{
-			p.SetState(94)
+			p.SetState(192)
 			p.SqlStatement()
 		}
 
-		p.SetState(99)
+		p.SetState(197)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -629,7 +1105,7 @@ func (p *MiniQLParser) Parse() (localctx IParseContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(100)
+		p.SetState(198)
 		p.Match(MiniQLParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -809,48 +1285,47 @@ func (p *MiniQLParser) SqlStatement() (localctx ISqlStatementContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(107)
+	p.SetState(205)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserCREATE, MiniQLParserDROP:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(102)
+			p.SetState(200)
 			p.DdlStatement()
 		}
 
-	case MiniQLParserINSERT, MiniQLParserUPDATE, MiniQLParserDELETE:
+	case 2:
 		{
-			p.SetState(103)
+			p.SetState(201)
 			p.DmlStatement()
 		}
 
-	case MiniQLParserSELECT:
+	case 3:
 		{
-			p.SetState(104)
+			p.SetState(202)
 			p.DqlStatement()
 		}
 
-	case MiniQLParserSTART, MiniQLParserCOMMIT, MiniQLParserROLLBACK:
+	case 4:
 		{
-			p.SetState(105)
+			p.SetState(203)
 			p.DclStatement()
 		}
 
-	case MiniQLParserUSE, MiniQLParserSHOW, MiniQLParserEXPLAIN, MiniQLParserANALYZE:
+	case 5:
 		{
-			p.SetState(106)
+			p.SetState(204)
 			p.UtilityStatement()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
-	p.SetState(110)
+	p.SetState(208)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -859,7 +1334,7 @@ func (p *MiniQLParser) SqlStatement() (localctx ISqlStatementContext) {
 
 	if _la == MiniQLParserSEMICOLON {
 		{
-			p.SetState(109)
+			p.SetState(207)
 			p.Match(MiniQLParserSEMICOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -892,6 +1367,11 @@ type IDdlStatementContext interface {
 	// Getter signatures
 	CreateDatabase() ICreateDatabaseContext
 	CreateTable() ICreateTableContext
+	CloneTable() ICloneTableContext
+	CreateExternalTable() ICreateExternalTableContext
+	AlterTable() IAlterTableContext
+	RestoreTable() IRestoreTableContext
+	CommentStatement() ICommentStatementContext
 	CreateIndex() ICreateIndexContext
 	DropIndex() IDropIndexContext
 	DropTable() IDropTableContext
@@ -965,6 +1445,86 @@ func (s *DdlStatementContext) CreateTable() ICreateTableContext {
 	return t.(ICreateTableContext)
 }
 
+func (s *DdlStatementContext) CloneTable() ICloneTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICloneTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICloneTableContext)
+}
+
+func (s *DdlStatementContext) CreateExternalTable() ICreateExternalTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateExternalTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateExternalTableContext)
+}
+
+func (s *DdlStatementContext) AlterTable() IAlterTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterTableContext)
+}
+
+func (s *DdlStatementContext) RestoreTable() IRestoreTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRestoreTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRestoreTableContext)
+}
+
+func (s *DdlStatementContext) CommentStatement() ICommentStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICommentStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICommentStatementContext)
+}
+
 func (s *DdlStatementContext) CreateIndex() ICreateIndexContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1050,7 +1610,7 @@ func (s *DdlStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *MiniQLParser) DdlStatement() (localctx IDdlStatementContext) {
 	localctx = NewDdlStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 4, MiniQLParserRULE_ddlStatement)
-	p.SetState(118)
+	p.SetState(221)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1060,42 +1620,77 @@ func (p *MiniQLParser) DdlStatement() (localctx IDdlStatementContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(112)
+			p.SetState(210)
 			p.CreateDatabase()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(113)
+			p.SetState(211)
 			p.CreateTable()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(114)
-			p.CreateIndex()
+			p.SetState(212)
+			p.CloneTable()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(115)
-			p.DropIndex()
+			p.SetState(213)
+			p.CreateExternalTable()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(116)
-			p.DropTable()
+			p.SetState(214)
+			p.AlterTable()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(117)
+			p.SetState(215)
+			p.RestoreTable()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(216)
+			p.CommentStatement()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(217)
+			p.CreateIndex()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(218)
+			p.DropIndex()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(219)
+			p.DropTable()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(220)
 			p.DropDatabase()
 		}
 
@@ -1233,7 +1828,7 @@ func (s *DmlStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *MiniQLParser) DmlStatement() (localctx IDmlStatementContext) {
 	localctx = NewDmlStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, MiniQLParserRULE_dmlStatement)
-	p.SetState(123)
+	p.SetState(226)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1243,21 +1838,21 @@ func (p *MiniQLParser) DmlStatement() (localctx IDmlStatementContext) {
 	case MiniQLParserINSERT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(120)
+			p.SetState(223)
 			p.InsertStatement()
 		}
 
 	case MiniQLParserUPDATE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(121)
+			p.SetState(224)
 			p.UpdateStatement()
 		}
 
 	case MiniQLParserDELETE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(122)
+			p.SetState(225)
 			p.DeleteStatement()
 		}
 
@@ -1364,7 +1959,7 @@ func (p *MiniQLParser) DqlStatement() (localctx IDqlStatementContext) {
 	p.EnterRule(localctx, 8, MiniQLParserRULE_dqlStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(125)
+		p.SetState(228)
 		p.SelectStatement()
 	}
 
@@ -1390,6 +1985,11 @@ type IDclStatementContext interface {
 
 	// Getter signatures
 	TransactionStatement() ITransactionStatementContext
+	CreateUser() ICreateUserContext
+	CreateRole() ICreateRoleContext
+	DropRole() IDropRoleContext
+	GrantStatement() IGrantStatementContext
+	RevokeStatement() IRevokeStatementContext
 
 	// IsDclStatementContext differentiates from other interfaces.
 	IsDclStatementContext()
@@ -1443,6 +2043,86 @@ func (s *DclStatementContext) TransactionStatement() ITransactionStatementContex
 	return t.(ITransactionStatementContext)
 }
 
+func (s *DclStatementContext) CreateUser() ICreateUserContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateUserContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateUserContext)
+}
+
+func (s *DclStatementContext) CreateRole() ICreateRoleContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateRoleContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateRoleContext)
+}
+
+func (s *DclStatementContext) DropRole() IDropRoleContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropRoleContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropRoleContext)
+}
+
+func (s *DclStatementContext) GrantStatement() IGrantStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantStatementContext)
+}
+
+func (s *DclStatementContext) RevokeStatement() IRevokeStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRevokeStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRevokeStatementContext)
+}
+
 func (s *DclStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1464,10 +2144,57 @@ func (s *DclStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *MiniQLParser) DclStatement() (localctx IDclStatementContext) {
 	localctx = NewDclStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 10, MiniQLParserRULE_dclStatement)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(127)
-		p.TransactionStatement()
+	p.SetState(236)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(230)
+			p.TransactionStatement()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(231)
+			p.CreateUser()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(232)
+			p.CreateRole()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(233)
+			p.DropRole()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(234)
+			p.GrantStatement()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(235)
+			p.RevokeStatement()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -1495,8 +2222,23 @@ type IUtilityStatementContext interface {
 	ShowDatabases() IShowDatabasesContext
 	ShowTables() IShowTablesContext
 	ShowIndexes() IShowIndexesContext
+	ShowCreateTable() IShowCreateTableContext
+	DescribeTable() IDescribeTableContext
 	ExplainStatement() IExplainStatementContext
 	AnalyzeStatement() IAnalyzeStatementContext
+	SetStatement() ISetStatementContext
+	ShowVariable() IShowVariableContext
+	ResetStatement() IResetStatementContext
+	PrepareStatement() IPrepareStatementContext
+	ExecuteStatement() IExecuteStatementContext
+	DeallocateStatement() IDeallocateStatementContext
+	CopyStatement() ICopyStatementContext
+	ExportTable() IExportTableContext
+	ImportTable() IImportTableContext
+	VacuumStatement() IVacuumStatementContext
+	KillStatement() IKillStatementContext
+	BackupDatabase() IBackupDatabaseContext
+	RestoreDatabase() IRestoreDatabaseContext
 
 	// IsUtilityStatementContext differentiates from other interfaces.
 	IsUtilityStatementContext()
@@ -1598,6 +2340,38 @@ func (s *UtilityStatementContext) ShowIndexes() IShowIndexesContext {
 	return t.(IShowIndexesContext)
 }
 
+func (s *UtilityStatementContext) ShowCreateTable() IShowCreateTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowCreateTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowCreateTableContext)
+}
+
+func (s *UtilityStatementContext) DescribeTable() IDescribeTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDescribeTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDescribeTableContext)
+}
+
 func (s *UtilityStatementContext) ExplainStatement() IExplainStatementContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1630,11 +2404,219 @@ func (s *UtilityStatementContext) AnalyzeStatement() IAnalyzeStatementContext {
 	return t.(IAnalyzeStatementContext)
 }
 
-func (s *UtilityStatementContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *UtilityStatementContext) SetStatement() ISetStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetStatementContext)
 }
 
-func (s *UtilityStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UtilityStatementContext) ShowVariable() IShowVariableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowVariableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowVariableContext)
+}
+
+func (s *UtilityStatementContext) ResetStatement() IResetStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IResetStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IResetStatementContext)
+}
+
+func (s *UtilityStatementContext) PrepareStatement() IPrepareStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrepareStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrepareStatementContext)
+}
+
+func (s *UtilityStatementContext) ExecuteStatement() IExecuteStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExecuteStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExecuteStatementContext)
+}
+
+func (s *UtilityStatementContext) DeallocateStatement() IDeallocateStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeallocateStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeallocateStatementContext)
+}
+
+func (s *UtilityStatementContext) CopyStatement() ICopyStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICopyStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICopyStatementContext)
+}
+
+func (s *UtilityStatementContext) ExportTable() IExportTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExportTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExportTableContext)
+}
+
+func (s *UtilityStatementContext) ImportTable() IImportTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportTableContext)
+}
+
+func (s *UtilityStatementContext) VacuumStatement() IVacuumStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVacuumStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVacuumStatementContext)
+}
+
+func (s *UtilityStatementContext) KillStatement() IKillStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IKillStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKillStatementContext)
+}
+
+func (s *UtilityStatementContext) BackupDatabase() IBackupDatabaseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBackupDatabaseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBackupDatabaseContext)
+}
+
+func (s *UtilityStatementContext) RestoreDatabase() IRestoreDatabaseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRestoreDatabaseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRestoreDatabaseContext)
+}
+
+func (s *UtilityStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UtilityStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
@@ -1651,55 +2633,160 @@ func (s *UtilityStatementContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 func (p *MiniQLParser) UtilityStatement() (localctx IUtilityStatementContext) {
 	localctx = NewUtilityStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 12, MiniQLParserRULE_utilityStatement)
-	p.SetState(135)
+	p.SetState(259)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(129)
+			p.SetState(238)
 			p.UseStatement()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(130)
+			p.SetState(239)
 			p.ShowDatabases()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(131)
+			p.SetState(240)
 			p.ShowTables()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(132)
+			p.SetState(241)
 			p.ShowIndexes()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(133)
-			p.ExplainStatement()
+			p.SetState(242)
+			p.ShowCreateTable()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(134)
+			p.SetState(243)
+			p.DescribeTable()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(244)
+			p.ExplainStatement()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(245)
 			p.AnalyzeStatement()
 		}
 
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(246)
+			p.SetStatement()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(247)
+			p.ShowVariable()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(248)
+			p.ResetStatement()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(249)
+			p.PrepareStatement()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(250)
+			p.ExecuteStatement()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(251)
+			p.DeallocateStatement()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(252)
+			p.CopyStatement()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(253)
+			p.ExportTable()
+		}
+
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(254)
+			p.ImportTable()
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(255)
+			p.VacuumStatement()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(256)
+			p.KillStatement()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(257)
+			p.BackupDatabase()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(258)
+			p.RestoreDatabase()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -1812,7 +2899,7 @@ func (p *MiniQLParser) CreateDatabase() (localctx ICreateDatabaseContext) {
 	p.EnterRule(localctx, 14, MiniQLParserRULE_createDatabase)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(137)
+		p.SetState(261)
 		p.Match(MiniQLParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1820,7 +2907,7 @@ func (p *MiniQLParser) CreateDatabase() (localctx ICreateDatabaseContext) {
 		}
 	}
 	{
-		p.SetState(138)
+		p.SetState(262)
 		p.Match(MiniQLParserDATABASE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1828,7 +2915,7 @@ func (p *MiniQLParser) CreateDatabase() (localctx ICreateDatabaseContext) {
 		}
 	}
 	{
-		p.SetState(139)
+		p.SetState(263)
 		p.Identifier()
 	}
 
@@ -1864,9 +2951,16 @@ type ICreateTableContext interface {
 	COMMA(i int) antlr.TerminalNode
 	AllTableConstraint() []ITableConstraintContext
 	TableConstraint(i int) ITableConstraintContext
-	PARTITION() antlr.TerminalNode
-	BY() antlr.TerminalNode
-	PartitionMethod() IPartitionMethodContext
+	AllPARTITION() []antlr.TerminalNode
+	PARTITION(i int) antlr.TerminalNode
+	AllBY() []antlr.TerminalNode
+	BY(i int) antlr.TerminalNode
+	AllPartitionMethod() []IPartitionMethodContext
+	PartitionMethod(i int) IPartitionMethodContext
+	AllWITH() []antlr.TerminalNode
+	WITH(i int) antlr.TerminalNode
+	AllOptionList() []IOptionListContext
+	OptionList(i int) IOptionListContext
 
 	// IsCreateTableContext differentiates from other interfaces.
 	IsCreateTableContext()
@@ -2026,20 +3120,53 @@ func (s *CreateTableContext) TableConstraint(i int) ITableConstraintContext {
 	return t.(ITableConstraintContext)
 }
 
-func (s *CreateTableContext) PARTITION() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserPARTITION, 0)
+func (s *CreateTableContext) AllPARTITION() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserPARTITION)
+}
+
+func (s *CreateTableContext) PARTITION(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARTITION, i)
 }
 
-func (s *CreateTableContext) BY() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserBY, 0)
+func (s *CreateTableContext) AllBY() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserBY)
+}
+
+func (s *CreateTableContext) BY(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserBY, i)
+}
+
+func (s *CreateTableContext) AllPartitionMethod() []IPartitionMethodContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPartitionMethodContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPartitionMethodContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPartitionMethodContext); ok {
+			tst[i] = t.(IPartitionMethodContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *CreateTableContext) PartitionMethod() IPartitionMethodContext {
+func (s *CreateTableContext) PartitionMethod(i int) IPartitionMethodContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IPartitionMethodContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -2050,11 +3177,60 @@ func (s *CreateTableContext) PartitionMethod() IPartitionMethodContext {
 	return t.(IPartitionMethodContext)
 }
 
-func (s *CreateTableContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CreateTableContext) AllWITH() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserWITH)
 }
 
-func (s *CreateTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateTableContext) WITH(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWITH, i)
+}
+
+func (s *CreateTableContext) AllOptionList() []IOptionListContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOptionListContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IOptionListContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOptionListContext); ok {
+			tst[i] = t.(IOptionListContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateTableContext) OptionList(i int) IOptionListContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionListContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptionListContext)
+}
+
+func (s *CreateTableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
@@ -2077,7 +3253,7 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(141)
+		p.SetState(265)
 		p.Match(MiniQLParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2085,7 +3261,7 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 		}
 	}
 	{
-		p.SetState(142)
+		p.SetState(266)
 		p.Match(MiniQLParserTABLE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2093,11 +3269,11 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 		}
 	}
 	{
-		p.SetState(143)
+		p.SetState(267)
 		p.TableName()
 	}
 	{
-		p.SetState(144)
+		p.SetState(268)
 		p.Match(MiniQLParserLEFT_PAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2105,22 +3281,22 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 		}
 	}
 	{
-		p.SetState(145)
+		p.SetState(269)
 		p.ColumnDef()
 	}
-	p.SetState(150)
+	p.SetState(274)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(146)
+				p.SetState(270)
 				p.Match(MiniQLParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -2128,22 +3304,22 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 				}
 			}
 			{
-				p.SetState(147)
+				p.SetState(271)
 				p.ColumnDef()
 			}
 
 		}
-		p.SetState(152)
+		p.SetState(276)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(157)
+	p.SetState(281)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2152,7 +3328,7 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 
 	for _la == MiniQLParserCOMMA {
 		{
-			p.SetState(153)
+			p.SetState(277)
 			p.Match(MiniQLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2160,11 +3336,11 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 			}
 		}
 		{
-			p.SetState(154)
+			p.SetState(278)
 			p.TableConstraint()
 		}
 
-		p.SetState(159)
+		p.SetState(283)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2172,42 +3348,75 @@ func (p *MiniQLParser) CreateTable() (localctx ICreateTableContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(160)
+		p.SetState(284)
 		p.Match(MiniQLParserRIGHT_PAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(164)
+	p.SetState(292)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == MiniQLParserPARTITION {
-		{
-			p.SetState(161)
-			p.Match(MiniQLParserPARTITION)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	for _la == MiniQLParserPARTITION || _la == MiniQLParserWITH {
+		p.SetState(290)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-		{
-			p.SetState(162)
-			p.Match(MiniQLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+
+		switch p.GetTokenStream().LA(1) {
+		case MiniQLParserPARTITION:
+			{
+				p.SetState(285)
+				p.Match(MiniQLParserPARTITION)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
-		}
-		{
-			p.SetState(163)
-			p.PartitionMethod()
+			{
+				p.SetState(286)
+				p.Match(MiniQLParserBY)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(287)
+				p.PartitionMethod()
+			}
+
+		case MiniQLParserWITH:
+			{
+				p.SetState(288)
+				p.Match(MiniQLParserWITH)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(289)
+				p.OptionList()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
+		p.SetState(294)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -2223,101 +3432,83 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IColumnDefContext is an interface to support dynamic dispatch.
-type IColumnDefContext interface {
+// ICloneTableContext is an interface to support dynamic dispatch.
+type ICloneTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Identifier() IIdentifierContext
-	DataType() IDataTypeContext
-	AllColumnConstraint() []IColumnConstraintContext
-	ColumnConstraint(i int) IColumnConstraintContext
+	CREATE() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	AllTableName() []ITableNameContext
+	TableName(i int) ITableNameContext
+	SHALLOW() antlr.TerminalNode
+	CLONE() antlr.TerminalNode
+	VERSION() antlr.TerminalNode
+	AS() antlr.TerminalNode
+	OF() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
 
-	// IsColumnDefContext differentiates from other interfaces.
-	IsColumnDefContext()
+	// IsCloneTableContext differentiates from other interfaces.
+	IsCloneTableContext()
 }
 
-type ColumnDefContext struct {
+type CloneTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyColumnDefContext() *ColumnDefContext {
-	var p = new(ColumnDefContext)
+func NewEmptyCloneTableContext() *CloneTableContext {
+	var p = new(CloneTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnDef
+	p.RuleIndex = MiniQLParserRULE_cloneTable
 	return p
 }
 
-func InitEmptyColumnDefContext(p *ColumnDefContext) {
+func InitEmptyCloneTableContext(p *CloneTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnDef
+	p.RuleIndex = MiniQLParserRULE_cloneTable
 }
 
-func (*ColumnDefContext) IsColumnDefContext() {}
+func (*CloneTableContext) IsCloneTableContext() {}
 
-func NewColumnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnDefContext {
-	var p = new(ColumnDefContext)
+func NewCloneTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CloneTableContext {
+	var p = new(CloneTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_columnDef
+	p.RuleIndex = MiniQLParserRULE_cloneTable
 
 	return p
 }
 
-func (s *ColumnDefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ColumnDefContext) Identifier() IIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *CloneTableContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentifierContext)
+func (s *CloneTableContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
 }
 
-func (s *ColumnDefContext) DataType() IDataTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDataTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IDataTypeContext)
+func (s *CloneTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *ColumnDefContext) AllColumnConstraint() []IColumnConstraintContext {
+func (s *CloneTableContext) AllTableName() []ITableNameContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IColumnConstraintContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IColumnConstraintContext, len)
+	tst := make([]ITableNameContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IColumnConstraintContext); ok {
-			tst[i] = t.(IColumnConstraintContext)
+		if t, ok := ctx.(ITableNameContext); ok {
+			tst[i] = t.(ITableNameContext)
 			i++
 		}
 	}
@@ -2325,11 +3516,11 @@ func (s *ColumnDefContext) AllColumnConstraint() []IColumnConstraintContext {
 	return tst
 }
 
-func (s *ColumnDefContext) ColumnConstraint(i int) IColumnConstraintContext {
+func (s *CloneTableContext) TableName(i int) ITableNameContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IColumnConstraintContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -2342,60 +3533,138 @@ func (s *ColumnDefContext) ColumnConstraint(i int) IColumnConstraintContext {
 		return nil
 	}
 
-	return t.(IColumnConstraintContext)
+	return t.(ITableNameContext)
 }
 
-func (s *ColumnDefContext) GetRuleContext() antlr.RuleContext {
+func (s *CloneTableContext) SHALLOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHALLOW, 0)
+}
+
+func (s *CloneTableContext) CLONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCLONE, 0)
+}
+
+func (s *CloneTableContext) VERSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVERSION, 0)
+}
+
+func (s *CloneTableContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *CloneTableContext) OF() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserOF, 0)
+}
+
+func (s *CloneTableContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *CloneTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ColumnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CloneTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ColumnDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CloneTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitColumnDef(s)
+		return t.VisitCloneTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ColumnDef() (localctx IColumnDefContext) {
-	localctx = NewColumnDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, MiniQLParserRULE_columnDef)
+func (p *MiniQLParser) CloneTable() (localctx ICloneTableContext) {
+	localctx = NewCloneTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, MiniQLParserRULE_cloneTable)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(166)
-		p.Identifier()
+		p.SetState(295)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 	{
-		p.SetState(167)
-		p.DataType()
+		p.SetState(296)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(297)
+		p.TableName()
+	}
+	{
+		p.SetState(298)
+		p.Match(MiniQLParserSHALLOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(299)
+		p.Match(MiniQLParserCLONE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(300)
+		p.TableName()
 	}
-	p.SetState(171)
+	p.SetState(305)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1688849887526912) != 0 {
+	if _la == MiniQLParserVERSION {
 		{
-			p.SetState(168)
-			p.ColumnConstraint()
+			p.SetState(301)
+			p.Match(MiniQLParserVERSION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-		p.SetState(173)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(302)
+			p.Match(MiniQLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		_la = p.GetTokenStream().LA(1)
+		{
+			p.SetState(303)
+			p.Match(MiniQLParserOF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(304)
+			p.Match(MiniQLParserINTEGER_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	}
 
 errorExit:
@@ -2411,86 +3680,118 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IColumnConstraintContext is an interface to support dynamic dispatch.
-type IColumnConstraintContext interface {
+// ICreateExternalTableContext is an interface to support dynamic dispatch.
+type ICreateExternalTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NULL() antlr.TerminalNode
-	NOT() antlr.TerminalNode
-	PRIMARY() antlr.TerminalNode
-	KEY() antlr.TerminalNode
-	UNIQUE() antlr.TerminalNode
-	DEFAULT() antlr.TerminalNode
-	Literal() ILiteralContext
+	CREATE() antlr.TerminalNode
+	EXTERNAL() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	LOCATION() antlr.TerminalNode
+	AllSTRING_LITERAL() []antlr.TerminalNode
+	STRING_LITERAL(i int) antlr.TerminalNode
+	FORMAT() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	LEFT_PAREN() antlr.TerminalNode
+	AllColumnDef() []IColumnDefContext
+	ColumnDef(i int) IColumnDefContext
+	RIGHT_PAREN() antlr.TerminalNode
+	WITH() antlr.TerminalNode
+	OptionList() IOptionListContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	AllTableConstraint() []ITableConstraintContext
+	TableConstraint(i int) ITableConstraintContext
 
-	// IsColumnConstraintContext differentiates from other interfaces.
-	IsColumnConstraintContext()
+	// IsCreateExternalTableContext differentiates from other interfaces.
+	IsCreateExternalTableContext()
 }
 
-type ColumnConstraintContext struct {
+type CreateExternalTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyColumnConstraintContext() *ColumnConstraintContext {
-	var p = new(ColumnConstraintContext)
+func NewEmptyCreateExternalTableContext() *CreateExternalTableContext {
+	var p = new(CreateExternalTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnConstraint
+	p.RuleIndex = MiniQLParserRULE_createExternalTable
 	return p
 }
 
-func InitEmptyColumnConstraintContext(p *ColumnConstraintContext) {
+func InitEmptyCreateExternalTableContext(p *CreateExternalTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnConstraint
+	p.RuleIndex = MiniQLParserRULE_createExternalTable
 }
 
-func (*ColumnConstraintContext) IsColumnConstraintContext() {}
+func (*CreateExternalTableContext) IsCreateExternalTableContext() {}
 
-func NewColumnConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnConstraintContext {
-	var p = new(ColumnConstraintContext)
+func NewCreateExternalTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateExternalTableContext {
+	var p = new(CreateExternalTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_columnConstraint
+	p.RuleIndex = MiniQLParserRULE_createExternalTable
 
 	return p
 }
 
-func (s *ColumnConstraintContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateExternalTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ColumnConstraintContext) NULL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserNULL, 0)
+func (s *CreateExternalTableContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
 }
 
-func (s *ColumnConstraintContext) NOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserNOT, 0)
+func (s *CreateExternalTableContext) EXTERNAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXTERNAL, 0)
 }
 
-func (s *ColumnConstraintContext) PRIMARY() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserPRIMARY, 0)
+func (s *CreateExternalTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *ColumnConstraintContext) KEY() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserKEY, 0)
+func (s *CreateExternalTableContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
 }
 
-func (s *ColumnConstraintContext) UNIQUE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserUNIQUE, 0)
+func (s *CreateExternalTableContext) LOCATION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLOCATION, 0)
 }
 
-func (s *ColumnConstraintContext) DEFAULT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDEFAULT, 0)
+func (s *CreateExternalTableContext) AllSTRING_LITERAL() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserSTRING_LITERAL)
 }
 
-func (s *ColumnConstraintContext) Literal() ILiteralContext {
+func (s *CreateExternalTableContext) STRING_LITERAL(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, i)
+}
+
+func (s *CreateExternalTableContext) FORMAT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFORMAT, 0)
+}
+
+func (s *CreateExternalTableContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2500,117 +3801,347 @@ func (s *ColumnConstraintContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *ColumnConstraintContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateExternalTableContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *CreateExternalTableContext) AllColumnDef() []IColumnDefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IColumnDefContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IColumnDefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IColumnDefContext); ok {
+			tst[i] = t.(IColumnDefContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateExternalTableContext) ColumnDef(i int) IColumnDefContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IColumnDefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnDefContext)
+}
+
+func (s *CreateExternalTableContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *CreateExternalTableContext) WITH() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWITH, 0)
+}
+
+func (s *CreateExternalTableContext) OptionList() IOptionListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptionListContext)
+}
+
+func (s *CreateExternalTableContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *CreateExternalTableContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *CreateExternalTableContext) AllTableConstraint() []ITableConstraintContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITableConstraintContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITableConstraintContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITableConstraintContext); ok {
+			tst[i] = t.(ITableConstraintContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateExternalTableContext) TableConstraint(i int) ITableConstraintContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableConstraintContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableConstraintContext)
+}
+
+func (s *CreateExternalTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ColumnConstraintContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateExternalTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ColumnConstraintContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateExternalTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitColumnConstraint(s)
+		return t.VisitCreateExternalTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ColumnConstraint() (localctx IColumnConstraintContext) {
-	localctx = NewColumnConstraintContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, MiniQLParserRULE_columnConstraint)
+func (p *MiniQLParser) CreateExternalTable() (localctx ICreateExternalTableContext) {
+	localctx = NewCreateExternalTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, MiniQLParserRULE_createExternalTable)
 	var _la int
 
-	p.SetState(183)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(307)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(308)
+		p.Match(MiniQLParserEXTERNAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(309)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(310)
+		p.TableName()
+	}
+	p.SetState(329)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserNOT, MiniQLParserNULL:
-		p.EnterOuterAlt(localctx, 1)
-		p.SetState(175)
+	if _la == MiniQLParserLEFT_PAREN {
+		{
+			p.SetState(311)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(312)
+			p.ColumnDef()
+		}
+		p.SetState(317)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(313)
+					p.Match(MiniQLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(314)
+					p.ColumnDef()
+				}
+
+			}
+			p.SetState(319)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(324)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == MiniQLParserNOT {
+		for _la == MiniQLParserCOMMA {
 			{
-				p.SetState(174)
-				p.Match(MiniQLParserNOT)
+				p.SetState(320)
+				p.Match(MiniQLParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-
-		}
-		{
-			p.SetState(177)
-			p.Match(MiniQLParserNULL)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			{
+				p.SetState(321)
+				p.TableConstraint()
 			}
-		}
 
-	case MiniQLParserPRIMARY:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(178)
-			p.Match(MiniQLParserPRIMARY)
+			p.SetState(326)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
+			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(179)
-			p.Match(MiniQLParserKEY)
+			p.SetState(327)
+			p.Match(MiniQLParserRIGHT_PAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case MiniQLParserUNIQUE:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(180)
-			p.Match(MiniQLParserUNIQUE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	}
+	{
+		p.SetState(331)
+		p.Match(MiniQLParserLOCATION)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(332)
+		p.Match(MiniQLParserSTRING_LITERAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(333)
+		p.Match(MiniQLParserFORMAT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(336)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	case MiniQLParserDEFAULT:
-		p.EnterOuterAlt(localctx, 4)
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserSTRING_LITERAL:
 		{
-			p.SetState(181)
-			p.Match(MiniQLParserDEFAULT)
+			p.SetState(334)
+			p.Match(MiniQLParserSTRING_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
 		{
-			p.SetState(182)
-			p.Literal()
+			p.SetState(335)
+			p.Identifier()
 		}
 
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
+	p.SetState(340)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserWITH {
+		{
+			p.SetState(338)
+			p.Match(MiniQLParserWITH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(339)
+			p.OptionList()
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -2625,72 +4156,82 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITableConstraintContext is an interface to support dynamic dispatch.
-type ITableConstraintContext interface {
+// IAlterTableContext is an interface to support dynamic dispatch.
+type IAlterTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PRIMARY() antlr.TerminalNode
-	KEY() antlr.TerminalNode
+	ALTER() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	SET() antlr.TerminalNode
+	TBLPROPERTIES() antlr.TerminalNode
 	LEFT_PAREN() antlr.TerminalNode
-	IdentifierList() IIdentifierListContext
+	AllTableProperty() []ITablePropertyContext
+	TableProperty(i int) ITablePropertyContext
 	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	UNSET() antlr.TerminalNode
+	AllPropertyName() []IPropertyNameContext
+	PropertyName(i int) IPropertyNameContext
+	DROP() antlr.TerminalNode
+	PARTITION() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	AllPartitionValue() []IPartitionValueContext
+	PartitionValue(i int) IPartitionValueContext
 
-	// IsTableConstraintContext differentiates from other interfaces.
-	IsTableConstraintContext()
+	// IsAlterTableContext differentiates from other interfaces.
+	IsAlterTableContext()
 }
 
-type TableConstraintContext struct {
+type AlterTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTableConstraintContext() *TableConstraintContext {
-	var p = new(TableConstraintContext)
+func NewEmptyAlterTableContext() *AlterTableContext {
+	var p = new(AlterTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableConstraint
+	p.RuleIndex = MiniQLParserRULE_alterTable
 	return p
 }
 
-func InitEmptyTableConstraintContext(p *TableConstraintContext) {
+func InitEmptyAlterTableContext(p *AlterTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableConstraint
+	p.RuleIndex = MiniQLParserRULE_alterTable
 }
 
-func (*TableConstraintContext) IsTableConstraintContext() {}
+func (*AlterTableContext) IsAlterTableContext() {}
 
-func NewTableConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableConstraintContext {
-	var p = new(TableConstraintContext)
+func NewAlterTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterTableContext {
+	var p = new(AlterTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_tableConstraint
+	p.RuleIndex = MiniQLParserRULE_alterTable
 
 	return p
 }
 
-func (s *TableConstraintContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TableConstraintContext) PRIMARY() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserPRIMARY, 0)
-}
+func (s *AlterTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TableConstraintContext) KEY() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserKEY, 0)
+func (s *AlterTableContext) ALTER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserALTER, 0)
 }
 
-func (s *TableConstraintContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+func (s *AlterTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *TableConstraintContext) IdentifierList() IIdentifierListContext {
+func (s *AlterTableContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierListContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2700,153 +4241,109 @@ func (s *TableConstraintContext) IdentifierList() IIdentifierListContext {
 		return nil
 	}
 
-	return t.(IIdentifierListContext)
-}
-
-func (s *TableConstraintContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+	return t.(ITableNameContext)
 }
 
-func (s *TableConstraintContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *AlterTableContext) SET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSET, 0)
 }
 
-func (s *TableConstraintContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *AlterTableContext) TBLPROPERTIES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTBLPROPERTIES, 0)
 }
 
-func (s *TableConstraintContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitTableConstraint(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *AlterTableContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
 }
 
-func (p *MiniQLParser) TableConstraint() (localctx ITableConstraintContext) {
-	localctx = NewTableConstraintContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, MiniQLParserRULE_tableConstraint)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(185)
-		p.Match(MiniQLParserPRIMARY)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(186)
-		p.Match(MiniQLParserKEY)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(187)
-		p.Match(MiniQLParserLEFT_PAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *AlterTableContext) AllTableProperty() []ITablePropertyContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITablePropertyContext); ok {
+			len++
 		}
 	}
-	{
-		p.SetState(188)
-		p.IdentifierList()
-	}
-	{
-		p.SetState(189)
-		p.Match(MiniQLParserRIGHT_PAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	tst := make([]ITablePropertyContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITablePropertyContext); ok {
+			tst[i] = t.(ITablePropertyContext)
+			i++
 		}
 	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
+	return tst
 }
 
-// ICreateIndexContext is an interface to support dynamic dispatch.
-type ICreateIndexContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *AlterTableContext) TableProperty(i int) ITablePropertyContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITablePropertyContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-	// Getter signatures
-	CREATE() antlr.TerminalNode
-	INDEX() antlr.TerminalNode
-	Identifier() IIdentifierContext
-	ON() antlr.TerminalNode
-	TableName() ITableNameContext
-	LEFT_PAREN() antlr.TerminalNode
-	IdentifierList() IIdentifierListContext
-	RIGHT_PAREN() antlr.TerminalNode
-	UNIQUE() antlr.TerminalNode
+	if t == nil {
+		return nil
+	}
 
-	// IsCreateIndexContext differentiates from other interfaces.
-	IsCreateIndexContext()
+	return t.(ITablePropertyContext)
 }
 
-type CreateIndexContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *AlterTableContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
 }
 
-func NewEmptyCreateIndexContext() *CreateIndexContext {
-	var p = new(CreateIndexContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_createIndex
-	return p
+func (s *AlterTableContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
 }
 
-func InitEmptyCreateIndexContext(p *CreateIndexContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_createIndex
+func (s *AlterTableContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
 }
 
-func (*CreateIndexContext) IsCreateIndexContext() {}
-
-func NewCreateIndexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateIndexContext {
-	var p = new(CreateIndexContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_createIndex
-
-	return p
+func (s *AlterTableContext) UNSET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUNSET, 0)
 }
 
-func (s *CreateIndexContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterTableContext) AllPropertyName() []IPropertyNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPropertyNameContext); ok {
+			len++
+		}
+	}
 
-func (s *CreateIndexContext) CREATE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCREATE, 0)
-}
+	tst := make([]IPropertyNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPropertyNameContext); ok {
+			tst[i] = t.(IPropertyNameContext)
+			i++
+		}
+	}
 
-func (s *CreateIndexContext) INDEX() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINDEX, 0)
+	return tst
 }
 
-func (s *CreateIndexContext) Identifier() IIdentifierContext {
+func (s *AlterTableContext) PropertyName(i int) IPropertyNameContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IPropertyNameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -2854,17 +4351,21 @@ func (s *CreateIndexContext) Identifier() IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
+	return t.(IPropertyNameContext)
 }
 
-func (s *CreateIndexContext) ON() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserON, 0)
+func (s *AlterTableContext) DROP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDROP, 0)
 }
 
-func (s *CreateIndexContext) TableName() ITableNameContext {
+func (s *AlterTableContext) PARTITION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARTITION, 0)
+}
+
+func (s *AlterTableContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2874,292 +4375,386 @@ func (s *CreateIndexContext) TableName() ITableNameContext {
 		return nil
 	}
 
-	return t.(ITableNameContext)
-}
-
-func (s *CreateIndexContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+	return t.(IIdentifierContext)
 }
 
-func (s *CreateIndexContext) IdentifierList() IIdentifierListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *AlterTableContext) AllPartitionValue() []IPartitionValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPartitionValueContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IPartitionValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPartitionValueContext); ok {
+			tst[i] = t.(IPartitionValueContext)
+			i++
+		}
 	}
 
-	return t.(IIdentifierListContext)
+	return tst
 }
 
-func (s *CreateIndexContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
-}
+func (s *AlterTableContext) PartitionValue(i int) IPartitionValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPartitionValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-func (s *CreateIndexContext) UNIQUE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserUNIQUE, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionValueContext)
 }
 
-func (s *CreateIndexContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateIndexContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateIndexContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitCreateIndex(s)
+		return t.VisitAlterTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) CreateIndex() (localctx ICreateIndexContext) {
-	localctx = NewCreateIndexContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, MiniQLParserRULE_createIndex)
+func (p *MiniQLParser) AlterTable() (localctx IAlterTableContext) {
+	localctx = NewAlterTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, MiniQLParserRULE_alterTable)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(191)
-		p.Match(MiniQLParserCREATE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(193)
+	p.SetState(397)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == MiniQLParserUNIQUE {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(192)
-			p.Match(MiniQLParserUNIQUE)
+			p.SetState(342)
+			p.Match(MiniQLParserALTER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	}
-	{
-		p.SetState(195)
-		p.Match(MiniQLParserINDEX)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(343)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(196)
-		p.Identifier()
-	}
-	{
-		p.SetState(197)
-		p.Match(MiniQLParserON)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(344)
+			p.TableName()
 		}
-	}
-	{
-		p.SetState(198)
-		p.TableName()
-	}
-	{
-		p.SetState(199)
-		p.Match(MiniQLParserLEFT_PAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(345)
+			p.Match(MiniQLParserSET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(200)
-		p.IdentifierList()
-	}
-	{
-		p.SetState(201)
-		p.Match(MiniQLParserRIGHT_PAREN)
+		{
+			p.SetState(346)
+			p.Match(MiniQLParserTBLPROPERTIES)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(347)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(348)
+			p.TableProperty()
+		}
+		p.SetState(353)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IDropIndexContext is an interface to support dynamic dispatch.
-type IDropIndexContext interface {
-	antlr.ParserRuleContext
+		_la = p.GetTokenStream().LA(1)
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(349)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(350)
+				p.TableProperty()
+			}
 
-	// Getter signatures
-	DROP() antlr.TerminalNode
-	INDEX() antlr.TerminalNode
-	Identifier() IIdentifierContext
-	ON() antlr.TerminalNode
-	TableName() ITableNameContext
+			p.SetState(355)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(356)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
 
-	// IsDropIndexContext differentiates from other interfaces.
-	IsDropIndexContext()
-}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(358)
+			p.Match(MiniQLParserALTER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(359)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(360)
+			p.TableName()
+		}
+		{
+			p.SetState(361)
+			p.Match(MiniQLParserUNSET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(362)
+			p.Match(MiniQLParserTBLPROPERTIES)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(363)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(364)
+			p.PropertyName()
+		}
+		p.SetState(369)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-type DropIndexContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(365)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(366)
+				p.PropertyName()
+			}
 
-func NewEmptyDropIndexContext() *DropIndexContext {
-	var p = new(DropIndexContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropIndex
-	return p
-}
+			p.SetState(371)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(372)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
 
-func InitEmptyDropIndexContext(p *DropIndexContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropIndex
-}
-
-func (*DropIndexContext) IsDropIndexContext() {}
-
-func NewDropIndexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropIndexContext {
-	var p = new(DropIndexContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_dropIndex
-
-	return p
-}
-
-func (s *DropIndexContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DropIndexContext) DROP() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDROP, 0)
-}
-
-func (s *DropIndexContext) INDEX() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINDEX, 0)
-}
-
-func (s *DropIndexContext) Identifier() IIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(374)
+			p.Match(MiniQLParserALTER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentifierContext)
-}
-
-func (s *DropIndexContext) ON() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserON, 0)
-}
-
-func (s *DropIndexContext) TableName() ITableNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		{
+			p.SetState(375)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(376)
+			p.TableName()
+		}
+		{
+			p.SetState(377)
+			p.Match(MiniQLParserDROP)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(378)
+			p.Match(MiniQLParserPARTITION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(379)
+			p.Identifier()
 		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITableNameContext)
-}
-
-func (s *DropIndexContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *DropIndexContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *DropIndexContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitDropIndex(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
 
-func (p *MiniQLParser) DropIndex() (localctx IDropIndexContext) {
-	localctx = NewDropIndexContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, MiniQLParserRULE_dropIndex)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(203)
-		p.Match(MiniQLParserDROP)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(381)
+			p.Match(MiniQLParserALTER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(204)
-		p.Match(MiniQLParserINDEX)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(382)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(205)
-		p.Identifier()
-	}
-	{
-		p.SetState(206)
-		p.Match(MiniQLParserON)
+		{
+			p.SetState(383)
+			p.TableName()
+		}
+		{
+			p.SetState(384)
+			p.Match(MiniQLParserDROP)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(385)
+			p.Match(MiniQLParserPARTITION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(386)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(387)
+			p.PartitionValue()
+		}
+		p.SetState(392)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	{
-		p.SetState(207)
-		p.TableName()
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(388)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(389)
+				p.PartitionValue()
+			}
+
+			p.SetState(394)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(395)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -3175,63 +4770,70 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDropTableContext is an interface to support dynamic dispatch.
-type IDropTableContext interface {
+// IRestoreTableContext is an interface to support dynamic dispatch.
+type IRestoreTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DROP() antlr.TerminalNode
+	RESTORE() antlr.TerminalNode
 	TABLE() antlr.TerminalNode
 	TableName() ITableNameContext
+	TO() antlr.TerminalNode
+	VERSION() antlr.TerminalNode
+	AS() antlr.TerminalNode
+	OF() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	TIMESTAMP_TYPE() antlr.TerminalNode
+	STRING_LITERAL() antlr.TerminalNode
 
-	// IsDropTableContext differentiates from other interfaces.
-	IsDropTableContext()
+	// IsRestoreTableContext differentiates from other interfaces.
+	IsRestoreTableContext()
 }
 
-type DropTableContext struct {
+type RestoreTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropTableContext() *DropTableContext {
-	var p = new(DropTableContext)
+func NewEmptyRestoreTableContext() *RestoreTableContext {
+	var p = new(RestoreTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropTable
+	p.RuleIndex = MiniQLParserRULE_restoreTable
 	return p
 }
 
-func InitEmptyDropTableContext(p *DropTableContext) {
+func InitEmptyRestoreTableContext(p *RestoreTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropTable
+	p.RuleIndex = MiniQLParserRULE_restoreTable
 }
 
-func (*DropTableContext) IsDropTableContext() {}
+func (*RestoreTableContext) IsRestoreTableContext() {}
 
-func NewDropTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropTableContext {
-	var p = new(DropTableContext)
+func NewRestoreTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RestoreTableContext {
+	var p = new(RestoreTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_dropTable
+	p.RuleIndex = MiniQLParserRULE_restoreTable
 
 	return p
 }
 
-func (s *DropTableContext) GetParser() antlr.Parser { return s.parser }
+func (s *RestoreTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DropTableContext) DROP() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDROP, 0)
+func (s *RestoreTableContext) RESTORE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRESTORE, 0)
 }
 
-func (s *DropTableContext) TABLE() antlr.TerminalNode {
+func (s *RestoreTableContext) TABLE() antlr.TerminalNode {
 	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *DropTableContext) TableName() ITableNameContext {
+func (s *RestoreTableContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ITableNameContext); ok {
@@ -3247,47 +4849,195 @@ func (s *DropTableContext) TableName() ITableNameContext {
 	return t.(ITableNameContext)
 }
 
-func (s *DropTableContext) GetRuleContext() antlr.RuleContext {
+func (s *RestoreTableContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
+}
+
+func (s *RestoreTableContext) VERSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVERSION, 0)
+}
+
+func (s *RestoreTableContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *RestoreTableContext) OF() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserOF, 0)
+}
+
+func (s *RestoreTableContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *RestoreTableContext) TIMESTAMP_TYPE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTIMESTAMP_TYPE, 0)
+}
+
+func (s *RestoreTableContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
+}
+
+func (s *RestoreTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DropTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RestoreTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DropTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RestoreTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitDropTable(s)
+		return t.VisitRestoreTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) DropTable() (localctx IDropTableContext) {
-	localctx = NewDropTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, MiniQLParserRULE_dropTable)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(209)
-		p.Match(MiniQLParserDROP)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(210)
-		p.Match(MiniQLParserTABLE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+func (p *MiniQLParser) RestoreTable() (localctx IRestoreTableContext) {
+	localctx = NewRestoreTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, MiniQLParserRULE_restoreTable)
+	var _la int
+
+	p.SetState(417)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(211)
-		p.TableName()
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(399)
+			p.Match(MiniQLParserRESTORE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(400)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(401)
+			p.TableName()
+		}
+		{
+			p.SetState(402)
+			p.Match(MiniQLParserTO)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(403)
+			p.Match(MiniQLParserVERSION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(404)
+			p.Match(MiniQLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(405)
+			p.Match(MiniQLParserOF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(406)
+			p.Match(MiniQLParserINTEGER_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(408)
+			p.Match(MiniQLParserRESTORE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(409)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(410)
+			p.TableName()
+		}
+		{
+			p.SetState(411)
+			p.Match(MiniQLParserTO)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(412)
+			p.Match(MiniQLParserTIMESTAMP_TYPE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(413)
+			p.Match(MiniQLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(414)
+			p.Match(MiniQLParserOF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(415)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserINTEGER_LITERAL || _la == MiniQLParserSTRING_LITERAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -3303,66 +5053,79 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDropDatabaseContext is an interface to support dynamic dispatch.
-type IDropDatabaseContext interface {
+// ICommentStatementContext is an interface to support dynamic dispatch.
+type ICommentStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DROP() antlr.TerminalNode
-	DATABASE() antlr.TerminalNode
-	Identifier() IIdentifierContext
+	COMMENT() antlr.TerminalNode
+	ON() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	IS() antlr.TerminalNode
+	STRING_LITERAL() antlr.TerminalNode
+	NULL() antlr.TerminalNode
+	COLUMN() antlr.TerminalNode
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
 
-	// IsDropDatabaseContext differentiates from other interfaces.
-	IsDropDatabaseContext()
+	// IsCommentStatementContext differentiates from other interfaces.
+	IsCommentStatementContext()
 }
 
-type DropDatabaseContext struct {
+type CommentStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropDatabaseContext() *DropDatabaseContext {
-	var p = new(DropDatabaseContext)
+func NewEmptyCommentStatementContext() *CommentStatementContext {
+	var p = new(CommentStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropDatabase
+	p.RuleIndex = MiniQLParserRULE_commentStatement
 	return p
 }
 
-func InitEmptyDropDatabaseContext(p *DropDatabaseContext) {
+func InitEmptyCommentStatementContext(p *CommentStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_dropDatabase
+	p.RuleIndex = MiniQLParserRULE_commentStatement
 }
 
-func (*DropDatabaseContext) IsDropDatabaseContext() {}
+func (*CommentStatementContext) IsCommentStatementContext() {}
 
-func NewDropDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseContext {
-	var p = new(DropDatabaseContext)
+func NewCommentStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommentStatementContext {
+	var p = new(CommentStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_dropDatabase
+	p.RuleIndex = MiniQLParserRULE_commentStatement
 
 	return p
 }
 
-func (s *DropDatabaseContext) GetParser() antlr.Parser { return s.parser }
+func (s *CommentStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DropDatabaseContext) DROP() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDROP, 0)
+func (s *CommentStatementContext) COMMENT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMENT, 0)
 }
 
-func (s *DropDatabaseContext) DATABASE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDATABASE, 0)
+func (s *CommentStatementContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
 }
 
-func (s *DropDatabaseContext) Identifier() IIdentifierContext {
+func (s *CommentStatementContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *CommentStatementContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3372,190 +5135,303 @@ func (s *DropDatabaseContext) Identifier() IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
+	return t.(ITableNameContext)
 }
 
-func (s *DropDatabaseContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CommentStatementContext) IS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIS, 0)
 }
 
-func (s *DropDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *CommentStatementContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
 }
 
-func (s *DropDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitDropDatabase(s)
+func (s *CommentStatementContext) NULL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNULL, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *CommentStatementContext) COLUMN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOLUMN, 0)
 }
 
-func (p *MiniQLParser) DropDatabase() (localctx IDropDatabaseContext) {
-	localctx = NewDropDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, MiniQLParserRULE_dropDatabase)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(213)
-		p.Match(MiniQLParserDROP)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *CommentStatementContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
 		}
 	}
-	{
-		p.SetState(214)
-		p.Match(MiniQLParserDATABASE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
 		}
 	}
-	{
-		p.SetState(215)
-		p.Identifier()
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
+	return tst
 }
 
-// IInsertStatementContext is an interface to support dynamic dispatch.
-type IInsertStatementContext interface {
-	antlr.ParserRuleContext
+func (s *CommentStatementContext) Identifier(i int) IIdentifierContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	if t == nil {
+		return nil
+	}
 
-	// Getter signatures
-	INSERT() antlr.TerminalNode
-	INTO() antlr.TerminalNode
-	TableName() ITableNameContext
-	VALUES() antlr.TerminalNode
-	AllLEFT_PAREN() []antlr.TerminalNode
-	LEFT_PAREN(i int) antlr.TerminalNode
-	AllValueList() []IValueListContext
-	ValueList(i int) IValueListContext
-	AllRIGHT_PAREN() []antlr.TerminalNode
-	RIGHT_PAREN(i int) antlr.TerminalNode
-	IdentifierList() IIdentifierListContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	return t.(IIdentifierContext)
+}
 
-	// IsInsertStatementContext differentiates from other interfaces.
-	IsInsertStatementContext()
+func (s *CommentStatementContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserDOT)
 }
 
-type InsertStatementContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *CommentStatementContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, i)
 }
 
-func NewEmptyInsertStatementContext() *InsertStatementContext {
-	var p = new(InsertStatementContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_insertStatement
-	return p
+func (s *CommentStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func InitEmptyInsertStatementContext(p *InsertStatementContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_insertStatement
+func (s *CommentStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (*InsertStatementContext) IsInsertStatementContext() {}
+func (s *CommentStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitCommentStatement(s)
 
-func NewInsertStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InsertStatementContext {
-	var p = new(InsertStatementContext)
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (p *MiniQLParser) CommentStatement() (localctx ICommentStatementContext) {
+	localctx = NewCommentStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, MiniQLParserRULE_commentStatement)
+	var _la int
 
-	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_insertStatement
+	p.SetState(440)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	return p
-}
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(419)
+			p.Match(MiniQLParserCOMMENT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(420)
+			p.Match(MiniQLParserON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(421)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(422)
+			p.TableName()
+		}
+		{
+			p.SetState(423)
+			p.Match(MiniQLParserIS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(424)
+			_la = p.GetTokenStream().LA(1)
 
-func (s *InsertStatementContext) GetParser() antlr.Parser { return s.parser }
+			if !(_la == MiniQLParserNULL || _la == MiniQLParserSTRING_LITERAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
 
-func (s *InsertStatementContext) INSERT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINSERT, 0)
-}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(426)
+			p.Match(MiniQLParserCOMMENT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(427)
+			p.Match(MiniQLParserON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(428)
+			p.Match(MiniQLParserCOLUMN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(429)
+			p.Identifier()
+		}
+		p.SetState(434)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-func (s *InsertStatementContext) INTO() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINTO, 0)
-}
+		for _la == MiniQLParserDOT {
+			{
+				p.SetState(430)
+				p.Match(MiniQLParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(431)
+				p.Identifier()
+			}
 
-func (s *InsertStatementContext) TableName() ITableNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			p.SetState(436)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(437)
+			p.Match(MiniQLParserIS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(438)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserNULL || _la == MiniQLParserSTRING_LITERAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
-	if t == nil {
-		return nil
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	return t.(ITableNameContext)
+// ITablePropertyContext is an interface to support dynamic dispatch.
+type ITablePropertyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PropertyName() IPropertyNameContext
+	EQUAL() antlr.TerminalNode
+	OptionValue() IOptionValueContext
+
+	// IsTablePropertyContext differentiates from other interfaces.
+	IsTablePropertyContext()
 }
 
-func (s *InsertStatementContext) VALUES() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserVALUES, 0)
+type TablePropertyContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *InsertStatementContext) AllLEFT_PAREN() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserLEFT_PAREN)
+func NewEmptyTablePropertyContext() *TablePropertyContext {
+	var p = new(TablePropertyContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableProperty
+	return p
 }
 
-func (s *InsertStatementContext) LEFT_PAREN(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, i)
+func InitEmptyTablePropertyContext(p *TablePropertyContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableProperty
 }
 
-func (s *InsertStatementContext) AllValueList() []IValueListContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IValueListContext); ok {
-			len++
-		}
-	}
+func (*TablePropertyContext) IsTablePropertyContext() {}
 
-	tst := make([]IValueListContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IValueListContext); ok {
-			tst[i] = t.(IValueListContext)
-			i++
-		}
-	}
+func NewTablePropertyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TablePropertyContext {
+	var p = new(TablePropertyContext)
 
-	return tst
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_tableProperty
+
+	return p
 }
 
-func (s *InsertStatementContext) ValueList(i int) IValueListContext {
+func (s *TablePropertyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TablePropertyContext) PropertyName() IPropertyNameContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IValueListContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IPropertyNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3563,21 +5439,17 @@ func (s *InsertStatementContext) ValueList(i int) IValueListContext {
 		return nil
 	}
 
-	return t.(IValueListContext)
-}
-
-func (s *InsertStatementContext) AllRIGHT_PAREN() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserRIGHT_PAREN)
+	return t.(IPropertyNameContext)
 }
 
-func (s *InsertStatementContext) RIGHT_PAREN(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, i)
+func (s *TablePropertyContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
 }
 
-func (s *InsertStatementContext) IdentifierList() IIdentifierListContext {
+func (s *TablePropertyContext) OptionValue() IOptionValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierListContext); ok {
+		if _, ok := ctx.(IOptionValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3587,269 +5459,129 @@ func (s *InsertStatementContext) IdentifierList() IIdentifierListContext {
 		return nil
 	}
 
-	return t.(IIdentifierListContext)
-}
-
-func (s *InsertStatementContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
-}
-
-func (s *InsertStatementContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+	return t.(IOptionValueContext)
 }
 
-func (s *InsertStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *TablePropertyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InsertStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TablePropertyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InsertStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TablePropertyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitInsertStatement(s)
+		return t.VisitTableProperty(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) InsertStatement() (localctx IInsertStatementContext) {
-	localctx = NewInsertStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, MiniQLParserRULE_insertStatement)
-	var _la int
-
+func (p *MiniQLParser) TableProperty() (localctx ITablePropertyContext) {
+	localctx = NewTablePropertyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, MiniQLParserRULE_tableProperty)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
-		p.Match(MiniQLParserINSERT)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(442)
+		p.PropertyName()
 	}
 	{
-		p.SetState(218)
-		p.Match(MiniQLParserINTO)
+		p.SetState(443)
+		p.Match(MiniQLParserEQUAL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(219)
-		p.TableName()
+		p.SetState(444)
+		p.OptionValue()
 	}
-	p.SetState(224)
-	p.GetErrorHandler().Sync(p)
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	if _la == MiniQLParserLEFT_PAREN {
-		{
-			p.SetState(220)
-			p.Match(MiniQLParserLEFT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(221)
-			p.IdentifierList()
-		}
-		{
-			p.SetState(222)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
-	{
-		p.SetState(226)
-		p.Match(MiniQLParserVALUES)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(227)
-		p.Match(MiniQLParserLEFT_PAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(228)
-		p.ValueList()
-	}
-	{
-		p.SetState(229)
-		p.Match(MiniQLParserRIGHT_PAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(237)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == MiniQLParserCOMMA {
-		{
-			p.SetState(230)
-			p.Match(MiniQLParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(231)
-			p.Match(MiniQLParserLEFT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(232)
-			p.ValueList()
-		}
-		{
-			p.SetState(233)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(239)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IUpdateStatementContext is an interface to support dynamic dispatch.
-type IUpdateStatementContext interface {
+// IPropertyNameContext is an interface to support dynamic dispatch.
+type IPropertyNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	UPDATE() antlr.TerminalNode
-	TableName() ITableNameContext
-	SET() antlr.TerminalNode
-	AllUpdateAssignment() []IUpdateAssignmentContext
-	UpdateAssignment(i int) IUpdateAssignmentContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
-	WHERE() antlr.TerminalNode
-	Expression() IExpressionContext
+	STRING_LITERAL() antlr.TerminalNode
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
 
-	// IsUpdateStatementContext differentiates from other interfaces.
-	IsUpdateStatementContext()
+	// IsPropertyNameContext differentiates from other interfaces.
+	IsPropertyNameContext()
 }
 
-type UpdateStatementContext struct {
+type PropertyNameContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUpdateStatementContext() *UpdateStatementContext {
-	var p = new(UpdateStatementContext)
+func NewEmptyPropertyNameContext() *PropertyNameContext {
+	var p = new(PropertyNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_updateStatement
+	p.RuleIndex = MiniQLParserRULE_propertyName
 	return p
 }
 
-func InitEmptyUpdateStatementContext(p *UpdateStatementContext) {
+func InitEmptyPropertyNameContext(p *PropertyNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_updateStatement
+	p.RuleIndex = MiniQLParserRULE_propertyName
 }
 
-func (*UpdateStatementContext) IsUpdateStatementContext() {}
+func (*PropertyNameContext) IsPropertyNameContext() {}
 
-func NewUpdateStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UpdateStatementContext {
-	var p = new(UpdateStatementContext)
+func NewPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyNameContext {
+	var p = new(PropertyNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_updateStatement
+	p.RuleIndex = MiniQLParserRULE_propertyName
 
 	return p
 }
 
-func (s *UpdateStatementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *UpdateStatementContext) UPDATE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserUPDATE, 0)
-}
-
-func (s *UpdateStatementContext) TableName() ITableNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITableNameContext)
-}
+func (s *PropertyNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UpdateStatementContext) SET() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSET, 0)
+func (s *PropertyNameContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
 }
 
-func (s *UpdateStatementContext) AllUpdateAssignment() []IUpdateAssignmentContext {
+func (s *PropertyNameContext) AllIdentifier() []IIdentifierContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IUpdateAssignmentContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IUpdateAssignmentContext, len)
+	tst := make([]IIdentifierContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IUpdateAssignmentContext); ok {
-			tst[i] = t.(IUpdateAssignmentContext)
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
 			i++
 		}
 	}
@@ -3857,11 +5589,11 @@ func (s *UpdateStatementContext) AllUpdateAssignment() []IUpdateAssignmentContex
 	return tst
 }
 
-func (s *UpdateStatementContext) UpdateAssignment(i int) IUpdateAssignmentContext {
+func (s *PropertyNameContext) Identifier(i int) IIdentifierContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUpdateAssignmentContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3874,135 +5606,97 @@ func (s *UpdateStatementContext) UpdateAssignment(i int) IUpdateAssignmentContex
 		return nil
 	}
 
-	return t.(IUpdateAssignmentContext)
-}
-
-func (s *UpdateStatementContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
-}
-
-func (s *UpdateStatementContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+	return t.(IIdentifierContext)
 }
 
-func (s *UpdateStatementContext) WHERE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserWHERE, 0)
+func (s *PropertyNameContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserDOT)
 }
 
-func (s *UpdateStatementContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
+func (s *PropertyNameContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, i)
 }
 
-func (s *UpdateStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *PropertyNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UpdateStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UpdateStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitUpdateStatement(s)
+		return t.VisitPropertyName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) UpdateStatement() (localctx IUpdateStatementContext) {
-	localctx = NewUpdateStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, MiniQLParserRULE_updateStatement)
+func (p *MiniQLParser) PropertyName() (localctx IPropertyNameContext) {
+	localctx = NewPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, MiniQLParserRULE_propertyName)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(240)
-		p.Match(MiniQLParserUPDATE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(241)
-		p.TableName()
-	}
-	{
-		p.SetState(242)
-		p.Match(MiniQLParserSET)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(243)
-		p.UpdateAssignment()
-	}
-	p.SetState(248)
+	p.SetState(455)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == MiniQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(244)
-			p.Match(MiniQLParserCOMMA)
+			p.SetState(446)
+			p.Match(MiniQLParserSTRING_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(245)
-			p.UpdateAssignment()
+			p.SetState(447)
+			p.Identifier()
 		}
-
-		p.SetState(250)
+		p.SetState(452)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(253)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == MiniQLParserWHERE {
-		{
-			p.SetState(251)
-			p.Match(MiniQLParserWHERE)
+		for _la == MiniQLParserDOT {
+			{
+				p.SetState(448)
+				p.Match(MiniQLParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(449)
+				p.Identifier()
+			}
+
+			p.SetState(454)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
-		{
-			p.SetState(252)
-			p.expression(0)
+			_la = p.GetTokenStream().LA(1)
 		}
 
-	}
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
 
 errorExit:
 	if p.HasError() {
@@ -4017,68 +5711,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDeleteStatementContext is an interface to support dynamic dispatch.
-type IDeleteStatementContext interface {
+// IPartitionValueContext is an interface to support dynamic dispatch.
+type IPartitionValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DELETE() antlr.TerminalNode
-	FROM() antlr.TerminalNode
-	TableName() ITableNameContext
-	WHERE() antlr.TerminalNode
-	Expression() IExpressionContext
+	Identifier() IIdentifierContext
+	EQUAL() antlr.TerminalNode
+	SignedLiteral() ISignedLiteralContext
 
-	// IsDeleteStatementContext differentiates from other interfaces.
-	IsDeleteStatementContext()
+	// IsPartitionValueContext differentiates from other interfaces.
+	IsPartitionValueContext()
 }
 
-type DeleteStatementContext struct {
+type PartitionValueContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDeleteStatementContext() *DeleteStatementContext {
-	var p = new(DeleteStatementContext)
+func NewEmptyPartitionValueContext() *PartitionValueContext {
+	var p = new(PartitionValueContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_deleteStatement
+	p.RuleIndex = MiniQLParserRULE_partitionValue
 	return p
 }
 
-func InitEmptyDeleteStatementContext(p *DeleteStatementContext) {
+func InitEmptyPartitionValueContext(p *PartitionValueContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_deleteStatement
+	p.RuleIndex = MiniQLParserRULE_partitionValue
 }
 
-func (*DeleteStatementContext) IsDeleteStatementContext() {}
+func (*PartitionValueContext) IsPartitionValueContext() {}
 
-func NewDeleteStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStatementContext {
-	var p = new(DeleteStatementContext)
+func NewPartitionValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionValueContext {
+	var p = new(PartitionValueContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_deleteStatement
+	p.RuleIndex = MiniQLParserRULE_partitionValue
 
 	return p
 }
 
-func (s *DeleteStatementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DeleteStatementContext) DELETE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDELETE, 0)
-}
-
-func (s *DeleteStatementContext) FROM() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserFROM, 0)
-}
+func (s *PartitionValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DeleteStatementContext) TableName() ITableNameContext {
+func (s *PartitionValueContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4088,17 +5772,17 @@ func (s *DeleteStatementContext) TableName() ITableNameContext {
 		return nil
 	}
 
-	return t.(ITableNameContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *DeleteStatementContext) WHERE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserWHERE, 0)
+func (s *PartitionValueContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
 }
 
-func (s *DeleteStatementContext) Expression() IExpressionContext {
+func (s *PartitionValueContext) SignedLiteral() ISignedLiteralContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4108,74 +5792,46 @@ func (s *DeleteStatementContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ISignedLiteralContext)
 }
 
-func (s *DeleteStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *PartitionValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DeleteStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PartitionValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DeleteStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PartitionValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitDeleteStatement(s)
+		return t.VisitPartitionValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) DeleteStatement() (localctx IDeleteStatementContext) {
-	localctx = NewDeleteStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, MiniQLParserRULE_deleteStatement)
-	var _la int
-
+func (p *MiniQLParser) PartitionValue() (localctx IPartitionValueContext) {
+	localctx = NewPartitionValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, MiniQLParserRULE_partitionValue)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(255)
-		p.Match(MiniQLParserDELETE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(457)
+		p.Identifier()
 	}
 	{
-		p.SetState(256)
-		p.Match(MiniQLParserFROM)
+		p.SetState(458)
+		p.Match(MiniQLParserEQUAL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(257)
-		p.TableName()
-	}
-	p.SetState(260)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == MiniQLParserWHERE {
-		{
-			p.SetState(258)
-			p.Match(MiniQLParserWHERE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(259)
-			p.expression(0)
-		}
-
+		p.SetState(459)
+		p.SignedLiteral()
 	}
 
 errorExit:
@@ -4191,90 +5847,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISelectStatementContext is an interface to support dynamic dispatch.
-type ISelectStatementContext interface {
+// IOptionListContext is an interface to support dynamic dispatch.
+type IOptionListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SELECT() antlr.TerminalNode
-	AllSelectItem() []ISelectItemContext
-	SelectItem(i int) ISelectItemContext
-	FROM() antlr.TerminalNode
-	TableReference() ITableReferenceContext
+	LEFT_PAREN() antlr.TerminalNode
+	AllOption() []IOptionContext
+	Option(i int) IOptionContext
+	RIGHT_PAREN() antlr.TerminalNode
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
-	WHERE() antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	GROUP() antlr.TerminalNode
-	AllBY() []antlr.TerminalNode
-	BY(i int) antlr.TerminalNode
-	AllGroupByItem() []IGroupByItemContext
-	GroupByItem(i int) IGroupByItemContext
-	HAVING() antlr.TerminalNode
-	ORDER() antlr.TerminalNode
-	AllOrderByItem() []IOrderByItemContext
-	OrderByItem(i int) IOrderByItemContext
-	LIMIT() antlr.TerminalNode
-	INTEGER_LITERAL() antlr.TerminalNode
 
-	// IsSelectStatementContext differentiates from other interfaces.
-	IsSelectStatementContext()
+	// IsOptionListContext differentiates from other interfaces.
+	IsOptionListContext()
 }
 
-type SelectStatementContext struct {
+type OptionListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectStatementContext() *SelectStatementContext {
-	var p = new(SelectStatementContext)
+func NewEmptyOptionListContext() *OptionListContext {
+	var p = new(OptionListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_selectStatement
+	p.RuleIndex = MiniQLParserRULE_optionList
 	return p
 }
 
-func InitEmptySelectStatementContext(p *SelectStatementContext) {
+func InitEmptyOptionListContext(p *OptionListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_selectStatement
+	p.RuleIndex = MiniQLParserRULE_optionList
 }
 
-func (*SelectStatementContext) IsSelectStatementContext() {}
+func (*OptionListContext) IsOptionListContext() {}
 
-func NewSelectStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStatementContext {
-	var p = new(SelectStatementContext)
+func NewOptionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionListContext {
+	var p = new(OptionListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_selectStatement
+	p.RuleIndex = MiniQLParserRULE_optionList
 
 	return p
 }
 
-func (s *SelectStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptionListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectStatementContext) SELECT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSELECT, 0)
+func (s *OptionListContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
 }
 
-func (s *SelectStatementContext) AllSelectItem() []ISelectItemContext {
+func (s *OptionListContext) AllOption() []IOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ISelectItemContext); ok {
+		if _, ok := ctx.(IOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ISelectItemContext, len)
+	tst := make([]IOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ISelectItemContext); ok {
-			tst[i] = t.(ISelectItemContext)
+		if t, ok := ctx.(IOptionContext); ok {
+			tst[i] = t.(IOptionContext)
 			i++
 		}
 	}
@@ -4282,11 +5923,11 @@ func (s *SelectStatementContext) AllSelectItem() []ISelectItemContext {
 	return tst
 }
 
-func (s *SelectStatementContext) SelectItem(i int) ISelectItemContext {
+func (s *OptionListContext) Option(i int) IOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectItemContext); ok {
+		if _, ok := ctx.(IOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -4299,174 +5940,177 @@ func (s *SelectStatementContext) SelectItem(i int) ISelectItemContext {
 		return nil
 	}
 
-	return t.(ISelectItemContext)
-}
-
-func (s *SelectStatementContext) FROM() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserFROM, 0)
+	return t.(IOptionContext)
 }
 
-func (s *SelectStatementContext) TableReference() ITableReferenceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableReferenceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITableReferenceContext)
+func (s *OptionListContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
 }
 
-func (s *SelectStatementContext) AllCOMMA() []antlr.TerminalNode {
+func (s *OptionListContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(MiniQLParserCOMMA)
 }
 
-func (s *SelectStatementContext) COMMA(i int) antlr.TerminalNode {
+func (s *OptionListContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(MiniQLParserCOMMA, i)
 }
 
-func (s *SelectStatementContext) WHERE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserWHERE, 0)
+func (s *OptionListContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *SelectStatementContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
+func (s *OptionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OptionListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitOptionList(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
+}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+func (p *MiniQLParser) OptionList() (localctx IOptionListContext) {
+	localctx = NewOptionListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, MiniQLParserRULE_optionList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(461)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
+	{
+		p.SetState(462)
+		p.Option()
+	}
+	p.SetState(467)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	return tst
-}
-
-func (s *SelectStatementContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(463)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			j++
 		}
-	}
+		{
+			p.SetState(464)
+			p.Option()
+		}
 
-	if t == nil {
-		return nil
+		p.SetState(469)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(470)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
-	return t.(IExpressionContext)
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *SelectStatementContext) GROUP() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserGROUP, 0)
+// IOptionContext is an interface to support dynamic dispatch.
+type IOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	OptionName() IOptionNameContext
+	OptionValue() IOptionValueContext
+	EQUAL() antlr.TerminalNode
+
+	// IsOptionContext differentiates from other interfaces.
+	IsOptionContext()
 }
 
-func (s *SelectStatementContext) AllBY() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserBY)
+type OptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *SelectStatementContext) BY(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserBY, i)
+func NewEmptyOptionContext() *OptionContext {
+	var p = new(OptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_option
+	return p
 }
 
-func (s *SelectStatementContext) AllGroupByItem() []IGroupByItemContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IGroupByItemContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IGroupByItemContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IGroupByItemContext); ok {
-			tst[i] = t.(IGroupByItemContext)
-			i++
-		}
-	}
-
-	return tst
+func InitEmptyOptionContext(p *OptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_option
 }
 
-func (s *SelectStatementContext) GroupByItem(i int) IGroupByItemContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByItemContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+func (*OptionContext) IsOptionContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionContext {
+	var p = new(OptionContext)
 
-	return t.(IGroupByItemContext)
-}
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-func (s *SelectStatementContext) HAVING() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserHAVING, 0)
-}
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_option
 
-func (s *SelectStatementContext) ORDER() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserORDER, 0)
+	return p
 }
 
-func (s *SelectStatementContext) AllOrderByItem() []IOrderByItemContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IOrderByItemContext); ok {
-			len++
+func (s *OptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OptionContext) OptionName() IOptionNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IOrderByItemContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IOrderByItemContext); ok {
-			tst[i] = t.(IOrderByItemContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IOptionNameContext)
 }
 
-func (s *SelectStatementContext) OrderByItem(i int) IOrderByItemContext {
+func (s *OptionContext) OptionValue() IOptionValueContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrderByItemContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IOptionValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4474,279 +6118,194 @@ func (s *SelectStatementContext) OrderByItem(i int) IOrderByItemContext {
 		return nil
 	}
 
-	return t.(IOrderByItemContext)
-}
-
-func (s *SelectStatementContext) LIMIT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLIMIT, 0)
+	return t.(IOptionValueContext)
 }
 
-func (s *SelectStatementContext) INTEGER_LITERAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+func (s *OptionContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
 }
 
-func (s *SelectStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitSelectStatement(s)
+		return t.VisitOption(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) SelectStatement() (localctx ISelectStatementContext) {
-	localctx = NewSelectStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, MiniQLParserRULE_selectStatement)
+func (p *MiniQLParser) Option() (localctx IOptionContext) {
+	localctx = NewOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, MiniQLParserRULE_option)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(262)
-		p.Match(MiniQLParserSELECT)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(263)
-		p.SelectItem()
+		p.SetState(472)
+		p.OptionName()
 	}
-	p.SetState(268)
+	p.SetState(474)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == MiniQLParserCOMMA {
+	if _la == MiniQLParserEQUAL {
 		{
-			p.SetState(264)
-			p.Match(MiniQLParserCOMMA)
+			p.SetState(473)
+			p.Match(MiniQLParserEQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(265)
-			p.SelectItem()
-		}
 
-		p.SetState(270)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(271)
-		p.Match(MiniQLParserFROM)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
 	}
 	{
-		p.SetState(272)
-		p.tableReference(0)
+		p.SetState(476)
+		p.OptionValue()
 	}
-	p.SetState(275)
-	p.GetErrorHandler().Sync(p)
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	if _la == MiniQLParserWHERE {
-		{
-			p.SetState(273)
-			p.Match(MiniQLParserWHERE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(274)
-			p.expression(0)
+// IOptionNameContext is an interface to support dynamic dispatch.
+type IOptionNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	NULL() antlr.TerminalNode
+
+	// IsOptionNameContext differentiates from other interfaces.
+	IsOptionNameContext()
+}
+
+type OptionNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOptionNameContext() *OptionNameContext {
+	var p = new(OptionNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_optionName
+	return p
+}
+
+func InitEmptyOptionNameContext(p *OptionNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_optionName
+}
+
+func (*OptionNameContext) IsOptionNameContext() {}
+
+func NewOptionNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionNameContext {
+	var p = new(OptionNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_optionName
+
+	return p
+}
+
+func (s *OptionNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OptionNameContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *OptionNameContext) NULL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNULL, 0)
+}
+
+func (s *OptionNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OptionNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OptionNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitOptionName(s)
 
+	default:
+		return t.VisitChildren(s)
 	}
-	p.SetState(287)
+}
+
+func (p *MiniQLParser) OptionName() (localctx IOptionNameContext) {
+	localctx = NewOptionNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, MiniQLParserRULE_optionName)
+	p.SetState(480)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == MiniQLParserGROUP {
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(277)
-			p.Match(MiniQLParserGROUP)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(478)
+			p.Identifier()
 		}
+
+	case MiniQLParserNULL:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(278)
-			p.Match(MiniQLParserBY)
+			p.SetState(479)
+			p.Match(MiniQLParserNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(279)
-			p.GroupByItem()
-		}
-		p.SetState(284)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == MiniQLParserCOMMA {
-			{
-				p.SetState(280)
-				p.Match(MiniQLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(281)
-				p.GroupByItem()
-			}
-
-			p.SetState(286)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
 
-	}
-	p.SetState(291)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == MiniQLParserHAVING {
-		{
-			p.SetState(289)
-			p.Match(MiniQLParserHAVING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(290)
-			p.expression(0)
-		}
-
-	}
-	p.SetState(303)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == MiniQLParserORDER {
-		{
-			p.SetState(293)
-			p.Match(MiniQLParserORDER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(294)
-			p.Match(MiniQLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(295)
-			p.OrderByItem()
-		}
-		p.SetState(300)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == MiniQLParserCOMMA {
-			{
-				p.SetState(296)
-				p.Match(MiniQLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(297)
-				p.OrderByItem()
-			}
-
-			p.SetState(302)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
-	}
-	p.SetState(307)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == MiniQLParserLIMIT {
-		{
-			p.SetState(305)
-			p.Match(MiniQLParserLIMIT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(306)
-			p.Match(MiniQLParserINTEGER_LITERAL)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
 
 errorExit:
 	if p.HasError() {
@@ -4761,86 +6320,73 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISelectItemContext is an interface to support dynamic dispatch.
-type ISelectItemContext interface {
+// IOptionValueContext is an interface to support dynamic dispatch.
+type IOptionValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsSelectItemContext differentiates from other interfaces.
-	IsSelectItemContext()
+
+	// Getter signatures
+	SignedLiteral() ISignedLiteralContext
+	Identifier() IIdentifierContext
+
+	// IsOptionValueContext differentiates from other interfaces.
+	IsOptionValueContext()
 }
 
-type SelectItemContext struct {
+type OptionValueContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectItemContext() *SelectItemContext {
-	var p = new(SelectItemContext)
+func NewEmptyOptionValueContext() *OptionValueContext {
+	var p = new(OptionValueContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_selectItem
+	p.RuleIndex = MiniQLParserRULE_optionValue
 	return p
 }
 
-func InitEmptySelectItemContext(p *SelectItemContext) {
+func InitEmptyOptionValueContext(p *OptionValueContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_selectItem
+	p.RuleIndex = MiniQLParserRULE_optionValue
 }
 
-func (*SelectItemContext) IsSelectItemContext() {}
+func (*OptionValueContext) IsOptionValueContext() {}
 
-func NewSelectItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectItemContext {
-	var p = new(SelectItemContext)
+func NewOptionValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionValueContext {
+	var p = new(OptionValueContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_selectItem
+	p.RuleIndex = MiniQLParserRULE_optionValue
 
 	return p
 }
 
-func (s *SelectItemContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SelectItemContext) CopyAll(ctx *SelectItemContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *SelectItemContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *SelectItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type SelectAllContext struct {
-	SelectItemContext
-}
-
-func NewSelectAllContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectAllContext {
-	var p = new(SelectAllContext)
-
-	InitEmptySelectItemContext(&p.SelectItemContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*SelectItemContext))
+func (s *OptionValueContext) GetParser() antlr.Parser { return s.parser }
 
-	return p
-}
+func (s *OptionValueContext) SignedLiteral() ISignedLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *SelectAllContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *SelectAllContext) ASTERISK() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserASTERISK, 0)
+	return t.(ISignedLiteralContext)
 }
 
-func (s *SelectAllContext) TableName() ITableNameContext {
+func (s *OptionValueContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4850,45 +6396,122 @@ func (s *SelectAllContext) TableName() ITableNameContext {
 		return nil
 	}
 
-	return t.(ITableNameContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *SelectAllContext) DOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDOT, 0)
+func (s *OptionValueContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *SelectAllContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OptionValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitSelectAll(s)
+		return t.VisitOptionValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type SelectExprContext struct {
-	SelectItemContext
+func (p *MiniQLParser) OptionValue() (localctx IOptionValueContext) {
+	localctx = NewOptionValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, MiniQLParserRULE_optionValue)
+	p.SetState(484)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserPLUS, MiniQLParserMINUS, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(482)
+			p.SignedLiteral()
+		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(483)
+			p.Identifier()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewSelectExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectExprContext {
-	var p = new(SelectExprContext)
+// IColumnDefContext is an interface to support dynamic dispatch.
+type IColumnDefContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptySelectItemContext(&p.SelectItemContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*SelectItemContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	DataType() IDataTypeContext
+	AllColumnConstraint() []IColumnConstraintContext
+	ColumnConstraint(i int) IColumnConstraintContext
+
+	// IsColumnDefContext differentiates from other interfaces.
+	IsColumnDefContext()
+}
+
+type ColumnDefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyColumnDefContext() *ColumnDefContext {
+	var p = new(ColumnDefContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnDef
 	return p
 }
 
-func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyColumnDefContext(p *ColumnDefContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnDef
 }
 
-func (s *SelectExprContext) Expression() IExpressionContext {
+func (*ColumnDefContext) IsColumnDefContext() {}
+
+func NewColumnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnDefContext {
+	var p = new(ColumnDefContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_columnDef
+
+	return p
+}
+
+func (s *ColumnDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ColumnDefContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4898,13 +6521,13 @@ func (s *SelectExprContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *SelectExprContext) Identifier() IIdentifierContext {
+func (s *ColumnDefContext) DataType() IDataTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
+		if _, ok := ctx.(IDataTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4914,111 +6537,101 @@ func (s *SelectExprContext) Identifier() IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
-}
-
-func (s *SelectExprContext) AS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserAS, 0)
+	return t.(IDataTypeContext)
 }
 
-func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ColumnDefContext) AllColumnConstraint() []IColumnConstraintContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IColumnConstraintContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IColumnConstraintContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IColumnConstraintContext); ok {
+			tst[i] = t.(IColumnConstraintContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ColumnDefContext) ColumnConstraint(i int) IColumnConstraintContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IColumnConstraintContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnConstraintContext)
+}
+
+func (s *ColumnDefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ColumnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ColumnDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitSelectExpr(s)
+		return t.VisitColumnDef(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) SelectItem() (localctx ISelectItemContext) {
-	localctx = NewSelectItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, MiniQLParserRULE_selectItem)
+func (p *MiniQLParser) ColumnDef() (localctx IColumnDefContext) {
+	localctx = NewColumnDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, MiniQLParserRULE_columnDef)
 	var _la int
 
-	p.SetState(322)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(486)
+		p.Identifier()
+	}
+	{
+		p.SetState(487)
+		p.DataType()
+	}
+	p.SetState(491)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewSelectAllContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		p.SetState(312)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserIDENTIFIER {
-			{
-				p.SetState(309)
-				p.TableName()
-			}
-			{
-				p.SetState(310)
-				p.Match(MiniQLParserDOT)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1688849887526912) != 0 {
 		{
-			p.SetState(314)
-			p.Match(MiniQLParserASTERISK)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(488)
+			p.ColumnConstraint()
 		}
 
-	case 2:
-		localctx = NewSelectExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(315)
-			p.expression(0)
-		}
-		p.SetState(320)
+		p.SetState(493)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserAS || _la == MiniQLParserIDENTIFIER {
-			p.SetState(317)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			if _la == MiniQLParserAS {
-				{
-					p.SetState(316)
-					p.Match(MiniQLParserAS)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			}
-			{
-				p.SetState(319)
-				p.Identifier()
-			}
-
-		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -5034,117 +6647,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITableReferenceContext is an interface to support dynamic dispatch.
-type ITableReferenceContext interface {
+// IColumnConstraintContext is an interface to support dynamic dispatch.
+type IColumnConstraintContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TableReferenceAtom() ITableReferenceAtomContext
-	TableReference() ITableReferenceContext
-	JOIN() antlr.TerminalNode
-	ON() antlr.TerminalNode
-	Expression() IExpressionContext
-	JoinType() IJoinTypeContext
+	NULL() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	PRIMARY() antlr.TerminalNode
+	KEY() antlr.TerminalNode
+	UNIQUE() antlr.TerminalNode
+	DEFAULT() antlr.TerminalNode
+	Literal() ILiteralContext
 
-	// IsTableReferenceContext differentiates from other interfaces.
-	IsTableReferenceContext()
+	// IsColumnConstraintContext differentiates from other interfaces.
+	IsColumnConstraintContext()
 }
 
-type TableReferenceContext struct {
+type ColumnConstraintContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTableReferenceContext() *TableReferenceContext {
-	var p = new(TableReferenceContext)
+func NewEmptyColumnConstraintContext() *ColumnConstraintContext {
+	var p = new(ColumnConstraintContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableReference
+	p.RuleIndex = MiniQLParserRULE_columnConstraint
 	return p
 }
 
-func InitEmptyTableReferenceContext(p *TableReferenceContext) {
+func InitEmptyColumnConstraintContext(p *ColumnConstraintContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableReference
+	p.RuleIndex = MiniQLParserRULE_columnConstraint
 }
 
-func (*TableReferenceContext) IsTableReferenceContext() {}
+func (*ColumnConstraintContext) IsColumnConstraintContext() {}
 
-func NewTableReferenceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableReferenceContext {
-	var p = new(TableReferenceContext)
+func NewColumnConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnConstraintContext {
+	var p = new(ColumnConstraintContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_tableReference
+	p.RuleIndex = MiniQLParserRULE_columnConstraint
 
 	return p
 }
 
-func (s *TableReferenceContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TableReferenceContext) TableReferenceAtom() ITableReferenceAtomContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableReferenceAtomContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ColumnConstraintContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ITableReferenceAtomContext)
+func (s *ColumnConstraintContext) NULL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNULL, 0)
 }
 
-func (s *TableReferenceContext) TableReference() ITableReferenceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableReferenceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITableReferenceContext)
+func (s *ColumnConstraintContext) NOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOT, 0)
 }
 
-func (s *TableReferenceContext) JOIN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserJOIN, 0)
+func (s *ColumnConstraintContext) PRIMARY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPRIMARY, 0)
 }
 
-func (s *TableReferenceContext) ON() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserON, 0)
+func (s *ColumnConstraintContext) KEY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserKEY, 0)
 }
 
-func (s *TableReferenceContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ColumnConstraintContext) UNIQUE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUNIQUE, 0)
+}
 
-	return t.(IExpressionContext)
+func (s *ColumnConstraintContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEFAULT, 0)
 }
 
-func (s *TableReferenceContext) JoinType() IJoinTypeContext {
+func (s *ColumnConstraintContext) Literal() ILiteralContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJoinTypeContext); ok {
+		if _, ok := ctx.(ILiteralContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5154,123 +6736,116 @@ func (s *TableReferenceContext) JoinType() IJoinTypeContext {
 		return nil
 	}
 
-	return t.(IJoinTypeContext)
+	return t.(ILiteralContext)
 }
 
-func (s *TableReferenceContext) GetRuleContext() antlr.RuleContext {
+func (s *ColumnConstraintContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TableReferenceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ColumnConstraintContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TableReferenceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ColumnConstraintContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitTableReference(s)
+		return t.VisitColumnConstraint(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) TableReference() (localctx ITableReferenceContext) {
-	return p.tableReference(0)
-}
-
-func (p *MiniQLParser) tableReference(_p int) (localctx ITableReferenceContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-
-	_parentState := p.GetState()
-	localctx = NewTableReferenceContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx ITableReferenceContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 42
-	p.EnterRecursionRule(localctx, 42, MiniQLParserRULE_tableReference, _p)
+func (p *MiniQLParser) ColumnConstraint() (localctx IColumnConstraintContext) {
+	localctx = NewColumnConstraintContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, MiniQLParserRULE_columnConstraint)
 	var _la int
 
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(325)
-		p.TableReferenceAtom()
-	}
-
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(338)
+	p.SetState(503)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 31, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			localctx = NewTableReferenceContext(p, _parentctx, _parentState)
-			p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_tableReference)
-			p.SetState(327)
-
-			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
-				goto errorExit
-			}
-			p.SetState(329)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
 
-			if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2061584302080) != 0 {
-				{
-					p.SetState(328)
-					p.JoinType()
-				}
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserNOT, MiniQLParserNULL:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(495)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-			}
+		if _la == MiniQLParserNOT {
 			{
-				p.SetState(331)
-				p.Match(MiniQLParserJOIN)
+				p.SetState(494)
+				p.Match(MiniQLParserNOT)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			{
-				p.SetState(332)
-				p.TableReferenceAtom()
+
+		}
+		{
+			p.SetState(497)
+			p.Match(MiniQLParserNULL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			{
-				p.SetState(333)
-				p.Match(MiniQLParserON)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
+		}
+
+	case MiniQLParserPRIMARY:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(498)
+			p.Match(MiniQLParserPRIMARY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			{
-				p.SetState(334)
-				p.expression(0)
+		}
+		{
+			p.SetState(499)
+			p.Match(MiniQLParserKEY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
+		}
 
+	case MiniQLParserUNIQUE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(500)
+			p.Match(MiniQLParserUNIQUE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(340)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+
+	case MiniQLParserDEFAULT:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(501)
+			p.Match(MiniQLParserDEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 31, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(502)
+			p.Literal()
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5281,87 +6856,77 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITableReferenceAtomContext is an interface to support dynamic dispatch.
-type ITableReferenceAtomContext interface {
+// ITableConstraintContext is an interface to support dynamic dispatch.
+type ITableConstraintContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsTableReferenceAtomContext differentiates from other interfaces.
-	IsTableReferenceAtomContext()
+
+	// Getter signatures
+	PRIMARY() antlr.TerminalNode
+	KEY() antlr.TerminalNode
+	LEFT_PAREN() antlr.TerminalNode
+	IdentifierList() IIdentifierListContext
+	RIGHT_PAREN() antlr.TerminalNode
+
+	// IsTableConstraintContext differentiates from other interfaces.
+	IsTableConstraintContext()
 }
 
-type TableReferenceAtomContext struct {
+type TableConstraintContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTableReferenceAtomContext() *TableReferenceAtomContext {
-	var p = new(TableReferenceAtomContext)
+func NewEmptyTableConstraintContext() *TableConstraintContext {
+	var p = new(TableConstraintContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+	p.RuleIndex = MiniQLParserRULE_tableConstraint
 	return p
 }
 
-func InitEmptyTableReferenceAtomContext(p *TableReferenceAtomContext) {
+func InitEmptyTableConstraintContext(p *TableConstraintContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+	p.RuleIndex = MiniQLParserRULE_tableConstraint
 }
 
-func (*TableReferenceAtomContext) IsTableReferenceAtomContext() {}
+func (*TableConstraintContext) IsTableConstraintContext() {}
 
-func NewTableReferenceAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableReferenceAtomContext {
-	var p = new(TableReferenceAtomContext)
+func NewTableConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableConstraintContext {
+	var p = new(TableConstraintContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+	p.RuleIndex = MiniQLParserRULE_tableConstraint
 
 	return p
 }
 
-func (s *TableReferenceAtomContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TableReferenceAtomContext) CopyAll(ctx *TableReferenceAtomContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *TableReferenceAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *TableReferenceAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *TableConstraintContext) GetParser() antlr.Parser { return s.parser }
 
-type TableRefBaseContext struct {
-	TableReferenceAtomContext
+func (s *TableConstraintContext) PRIMARY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPRIMARY, 0)
 }
 
-func NewTableRefBaseContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TableRefBaseContext {
-	var p = new(TableRefBaseContext)
-
-	InitEmptyTableReferenceAtomContext(&p.TableReferenceAtomContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*TableReferenceAtomContext))
-
-	return p
+func (s *TableConstraintContext) KEY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserKEY, 0)
 }
 
-func (s *TableRefBaseContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *TableConstraintContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
 }
 
-func (s *TableRefBaseContext) TableName() ITableNameContext {
+func (s *TableConstraintContext) IdentifierList() IIdentifierListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
+		if _, ok := ctx.(IIdentifierListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5371,210 +6936,70 @@ func (s *TableRefBaseContext) TableName() ITableNameContext {
 		return nil
 	}
 
-	return t.(ITableNameContext)
+	return t.(IIdentifierListContext)
 }
 
-func (s *TableRefBaseContext) Identifier() IIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *TableConstraintContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
 
-	return t.(IIdentifierContext)
+func (s *TableConstraintContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *TableRefBaseContext) AS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserAS, 0)
+func (s *TableConstraintContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TableRefBaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TableConstraintContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitTableRefBase(s)
+		return t.VisitTableConstraint(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type TableRefSubqueryContext struct {
-	TableReferenceAtomContext
-}
-
-func NewTableRefSubqueryContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TableRefSubqueryContext {
-	var p = new(TableRefSubqueryContext)
-
-	InitEmptyTableReferenceAtomContext(&p.TableReferenceAtomContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*TableReferenceAtomContext))
-
-	return p
-}
-
-func (s *TableRefSubqueryContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *TableRefSubqueryContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
-}
-
-func (s *TableRefSubqueryContext) SelectStatement() ISelectStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (p *MiniQLParser) TableConstraint() (localctx ITableConstraintContext) {
+	localctx = NewTableConstraintContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, MiniQLParserRULE_tableConstraint)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(505)
+		p.Match(MiniQLParserPRIMARY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	if t == nil {
-		return nil
+	{
+		p.SetState(506)
+		p.Match(MiniQLParserKEY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-
-	return t.(ISelectStatementContext)
-}
-
-func (s *TableRefSubqueryContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
-}
-
-func (s *TableRefSubqueryContext) Identifier() IIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	{
+		p.SetState(507)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentifierContext)
-}
-
-func (s *TableRefSubqueryContext) AS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserAS, 0)
-}
-
-func (s *TableRefSubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitTableRefSubquery(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *MiniQLParser) TableReferenceAtom() (localctx ITableReferenceAtomContext) {
-	localctx = NewTableReferenceAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, MiniQLParserRULE_tableReferenceAtom)
-	var _la int
-
-	p.SetState(356)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(508)
+		p.IdentifierList()
 	}
-
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserIDENTIFIER:
-		localctx = NewTableRefBaseContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(341)
-			p.TableName()
-		}
-		p.SetState(346)
-		p.GetErrorHandler().Sync(p)
-
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
-			p.SetState(343)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			if _la == MiniQLParserAS {
-				{
-					p.SetState(342)
-					p.Match(MiniQLParserAS)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			}
-			{
-				p.SetState(345)
-				p.Identifier()
-			}
-
-		} else if p.HasError() { // JIM
-			goto errorExit
-		}
-
-	case MiniQLParserLEFT_PAREN:
-		localctx = NewTableRefSubqueryContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(348)
-			p.Match(MiniQLParserLEFT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(349)
-			p.SelectStatement()
-		}
-		{
-			p.SetState(350)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(352)
-		p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(509)
+		p.Match(MiniQLParserRIGHT_PAREN)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserAS {
-			{
-				p.SetState(351)
-				p.Match(MiniQLParserAS)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		{
-			p.SetState(354)
-			p.Identifier()
-		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -5590,207 +7015,225 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinTypeContext is an interface to support dynamic dispatch.
-type IJoinTypeContext interface {
+// ICreateIndexContext is an interface to support dynamic dispatch.
+type ICreateIndexContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	INNER() antlr.TerminalNode
-	LEFT() antlr.TerminalNode
-	OUTER() antlr.TerminalNode
-	RIGHT() antlr.TerminalNode
-	FULL() antlr.TerminalNode
+	CREATE() antlr.TerminalNode
+	INDEX() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	ON() antlr.TerminalNode
+	TableName() ITableNameContext
+	LEFT_PAREN() antlr.TerminalNode
+	IdentifierList() IIdentifierListContext
+	RIGHT_PAREN() antlr.TerminalNode
+	UNIQUE() antlr.TerminalNode
 
-	// IsJoinTypeContext differentiates from other interfaces.
-	IsJoinTypeContext()
+	// IsCreateIndexContext differentiates from other interfaces.
+	IsCreateIndexContext()
 }
 
-type JoinTypeContext struct {
+type CreateIndexContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJoinTypeContext() *JoinTypeContext {
-	var p = new(JoinTypeContext)
+func NewEmptyCreateIndexContext() *CreateIndexContext {
+	var p = new(CreateIndexContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_joinType
+	p.RuleIndex = MiniQLParserRULE_createIndex
 	return p
 }
 
-func InitEmptyJoinTypeContext(p *JoinTypeContext) {
+func InitEmptyCreateIndexContext(p *CreateIndexContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_joinType
+	p.RuleIndex = MiniQLParserRULE_createIndex
 }
 
-func (*JoinTypeContext) IsJoinTypeContext() {}
+func (*CreateIndexContext) IsCreateIndexContext() {}
 
-func NewJoinTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTypeContext {
-	var p = new(JoinTypeContext)
+func NewCreateIndexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateIndexContext {
+	var p = new(CreateIndexContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_joinType
+	p.RuleIndex = MiniQLParserRULE_createIndex
 
 	return p
 }
 
-func (s *JoinTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateIndexContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinTypeContext) INNER() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINNER, 0)
+func (s *CreateIndexContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
 }
 
-func (s *JoinTypeContext) LEFT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT, 0)
+func (s *CreateIndexContext) INDEX() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINDEX, 0)
 }
 
-func (s *JoinTypeContext) OUTER() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserOUTER, 0)
+func (s *CreateIndexContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
-func (s *JoinTypeContext) RIGHT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT, 0)
+func (s *CreateIndexContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
 }
 
-func (s *JoinTypeContext) FULL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserFULL, 0)
+func (s *CreateIndexContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
 }
 
-func (s *JoinTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateIndexContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *CreateIndexContext) IdentifierList() IIdentifierListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *CreateIndexContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *CreateIndexContext) UNIQUE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUNIQUE, 0)
+}
+
+func (s *CreateIndexContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateIndexContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateIndexContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitJoinType(s)
+		return t.VisitCreateIndex(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) JoinType() (localctx IJoinTypeContext) {
-	localctx = NewJoinTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, MiniQLParserRULE_joinType)
+func (p *MiniQLParser) CreateIndex() (localctx ICreateIndexContext) {
+	localctx = NewCreateIndexContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, MiniQLParserRULE_createIndex)
 	var _la int
 
-	p.SetState(371)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(511)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(513)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserINNER:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == MiniQLParserUNIQUE {
 		{
-			p.SetState(358)
-			p.Match(MiniQLParserINNER)
+			p.SetState(512)
+			p.Match(MiniQLParserUNIQUE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case MiniQLParserLEFT:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(359)
-			p.Match(MiniQLParserLEFT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(361)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(515)
+		p.Match(MiniQLParserINDEX)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserOUTER {
-			{
-				p.SetState(360)
-				p.Match(MiniQLParserOUTER)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-
-	case MiniQLParserRIGHT:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(363)
-			p.Match(MiniQLParserRIGHT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(365)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(516)
+		p.Identifier()
+	}
+	{
+		p.SetState(517)
+		p.Match(MiniQLParserON)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserOUTER {
-			{
-				p.SetState(364)
-				p.Match(MiniQLParserOUTER)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-
-	case MiniQLParserFULL:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(367)
-			p.Match(MiniQLParserFULL)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(369)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(518)
+		p.TableName()
+	}
+	{
+		p.SetState(519)
+		p.Match(MiniQLParserLEFT_PAREN)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == MiniQLParserOUTER {
-			{
-				p.SetState(368)
-				p.Match(MiniQLParserOUTER)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
+	}
+	{
+		p.SetState(520)
+		p.IdentifierList()
+	}
+	{
+		p.SetState(521)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -5806,82 +7249,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IDropIndexContext is an interface to support dynamic dispatch.
+type IDropIndexContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+
+	// Getter signatures
+	DROP() antlr.TerminalNode
+	INDEX() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	ON() antlr.TerminalNode
+	TableName() ITableNameContext
+
+	// IsDropIndexContext differentiates from other interfaces.
+	IsDropIndexContext()
 }
 
-type ExpressionContext struct {
+type DropIndexContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyDropIndexContext() *DropIndexContext {
+	var p = new(DropIndexContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_expression
+	p.RuleIndex = MiniQLParserRULE_dropIndex
 	return p
 }
 
-func InitEmptyExpressionContext(p *ExpressionContext) {
+func InitEmptyDropIndexContext(p *DropIndexContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_expression
+	p.RuleIndex = MiniQLParserRULE_dropIndex
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*DropIndexContext) IsDropIndexContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewDropIndexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropIndexContext {
+	var p = new(DropIndexContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_expression
+	p.RuleIndex = MiniQLParserRULE_dropIndex
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionContext) CopyAll(ctx *ExpressionContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *DropIndexContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *DropIndexContext) DROP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDROP, 0)
 }
 
-type PrimaryExpressionContext struct {
-	ExpressionContext
+func (s *DropIndexContext) INDEX() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINDEX, 0)
 }
 
-func NewPrimaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryExpressionContext {
-	var p = new(PrimaryExpressionContext)
+func (s *DropIndexContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IIdentifierContext)
 }
 
-func (s *PrimaryExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *DropIndexContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
 }
 
-func (s *PrimaryExpressionContext) PrimaryExpr() IPrimaryExprContext {
+func (s *DropIndexContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimaryExprContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5891,141 +7340,139 @@ func (s *PrimaryExpressionContext) PrimaryExpr() IPrimaryExprContext {
 		return nil
 	}
 
-	return t.(IPrimaryExprContext)
+	return t.(ITableNameContext)
 }
 
-func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropIndexContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropIndexContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropIndexContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitPrimaryExpression(s)
+		return t.VisitDropIndex(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type OrExpressionContext struct {
-	ExpressionContext
-}
-
-func NewOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExpressionContext {
-	var p = new(OrExpressionContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
-}
-
-func (s *OrExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *OrExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+func (p *MiniQLParser) DropIndex() (localctx IDropIndexContext) {
+	localctx = NewDropIndexContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, MiniQLParserRULE_dropIndex)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(523)
+		p.Match(MiniQLParserDROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	return tst
-}
-
-func (s *OrExpressionContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+	{
+		p.SetState(524)
+		p.Match(MiniQLParserINDEX)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	if t == nil {
-		return nil
+	{
+		p.SetState(525)
+		p.Identifier()
+	}
+	{
+		p.SetState(526)
+		p.Match(MiniQLParserON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(527)
+		p.TableName()
 	}
 
-	return t.(IExpressionContext)
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *OrExpressionContext) OR() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserOR, 0)
+// IDropTableContext is an interface to support dynamic dispatch.
+type IDropTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DROP() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+
+	// IsDropTableContext differentiates from other interfaces.
+	IsDropTableContext()
 }
 
-func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitOrExpression(s)
+type DropTableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func NewEmptyDropTableContext() *DropTableContext {
+	var p = new(DropTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropTable
+	return p
 }
 
-type AndExpressionContext struct {
-	ExpressionContext
+func InitEmptyDropTableContext(p *DropTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropTable
 }
 
-func NewAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExpressionContext {
-	var p = new(AndExpressionContext)
+func (*DropTableContext) IsDropTableContext() {}
+
+func NewDropTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropTableContext {
+	var p = new(DropTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	p.RuleIndex = MiniQLParserRULE_dropTable
 
 	return p
 }
 
-func (s *AndExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *AndExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
+func (s *DropTableContext) GetParser() antlr.Parser { return s.parser }
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
+func (s *DropTableContext) DROP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDROP, 0)
+}
 
-	return tst
+func (s *DropTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *AndExpressionContext) Expression(i int) IExpressionContext {
+func (s *DropTableContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -6033,69 +7480,125 @@ func (s *AndExpressionContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITableNameContext)
 }
 
-func (s *AndExpressionContext) AND() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserAND, 0)
+func (s *DropTableContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitAndExpression(s)
+		return t.VisitDropTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type InExpressionContext struct {
-	ExpressionContext
+func (p *MiniQLParser) DropTable() (localctx IDropTableContext) {
+	localctx = NewDropTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, MiniQLParserRULE_dropTable)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(529)
+		p.Match(MiniQLParserDROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(530)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(531)
+		p.TableName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewInExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InExpressionContext {
-	var p = new(InExpressionContext)
+// IDropDatabaseContext is an interface to support dynamic dispatch.
+type IDropDatabaseContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DROP() antlr.TerminalNode
+	DATABASE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+
+	// IsDropDatabaseContext differentiates from other interfaces.
+	IsDropDatabaseContext()
+}
+
+type DropDatabaseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyDropDatabaseContext() *DropDatabaseContext {
+	var p = new(DropDatabaseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropDatabase
 	return p
 }
 
-func (s *InExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyDropDatabaseContext(p *DropDatabaseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropDatabase
 }
 
-func (s *InExpressionContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (*DropDatabaseContext) IsDropDatabaseContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewDropDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseContext {
+	var p = new(DropDatabaseContext)
 
-	return t.(IExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_dropDatabase
+
+	return p
 }
 
-func (s *InExpressionContext) IN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserIN, 0)
+func (s *DropDatabaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropDatabaseContext) DROP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDROP, 0)
 }
 
-func (s *InExpressionContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+func (s *DropDatabaseContext) DATABASE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDATABASE, 0)
 }
 
-func (s *InExpressionContext) ValueList() IValueListContext {
+func (s *DropDatabaseContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IValueListContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6105,136 +7608,158 @@ func (s *InExpressionContext) ValueList() IValueListContext {
 		return nil
 	}
 
-	return t.(IValueListContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *InExpressionContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+func (s *DropDatabaseContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *InExpressionContext) NOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserNOT, 0)
-}
+func (s *DropDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-func (s *InExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitInExpression(s)
+		return t.VisitDropDatabase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AdditiveExpressionContext struct {
-	ExpressionContext
+func (p *MiniQLParser) DropDatabase() (localctx IDropDatabaseContext) {
+	localctx = NewDropDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, MiniQLParserRULE_dropDatabase)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(533)
+		p.Match(MiniQLParserDROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(534)
+		p.Match(MiniQLParserDATABASE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(535)
+		p.Identifier()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExpressionContext {
-	var p = new(AdditiveExpressionContext)
+// ICreateUserContext is an interface to support dynamic dispatch.
+type ICreateUserContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return p
-}
+	// Getter signatures
+	CREATE() antlr.TerminalNode
+	USER() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	WITH() antlr.TerminalNode
+	AllUserOption() []IUserOptionContext
+	UserOption(i int) IUserOptionContext
 
-func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	// IsCreateUserContext differentiates from other interfaces.
+	IsCreateUserContext()
 }
 
-func (s *AdditiveExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
+type CreateUserContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
+func NewEmptyCreateUserContext() *CreateUserContext {
+	var p = new(CreateUserContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_createUser
+	return p
+}
 
-	return tst
+func InitEmptyCreateUserContext(p *CreateUserContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_createUser
 }
 
-func (s *AdditiveExpressionContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+func (*CreateUserContext) IsCreateUserContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewCreateUserContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateUserContext {
+	var p = new(CreateUserContext)
 
-	return t.(IExpressionContext)
-}
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-func (s *AdditiveExpressionContext) PLUS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserPLUS, 0)
-}
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_createUser
 
-func (s *AdditiveExpressionContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserMINUS, 0)
+	return p
 }
 
-func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitAdditiveExpression(s)
+func (s *CreateUserContext) GetParser() antlr.Parser { return s.parser }
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *CreateUserContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
 }
 
-type LikeExpressionContext struct {
-	ExpressionContext
+func (s *CreateUserContext) USER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUSER, 0)
 }
 
-func NewLikeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeExpressionContext {
-	var p = new(LikeExpressionContext)
+func (s *CreateUserContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IIdentifierContext)
 }
 
-func (s *LikeExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CreateUserContext) WITH() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWITH, 0)
 }
 
-func (s *LikeExpressionContext) AllExpression() []IExpressionContext {
+func (s *CreateUserContext) AllUserOption() []IUserOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IUserOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
+	tst := make([]IUserOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
+		if t, ok := ctx.(IUserOptionContext); ok {
+			tst[i] = t.(IUserOptionContext)
 			i++
 		}
 	}
@@ -6242,11 +7767,11 @@ func (s *LikeExpressionContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *LikeExpressionContext) Expression(i int) IExpressionContext {
+func (s *CreateUserContext) UserOption(i int) IUserOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IUserOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -6259,161 +7784,319 @@ func (s *LikeExpressionContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IUserOptionContext)
 }
 
-func (s *LikeExpressionContext) LIKE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLIKE, 0)
+func (s *CreateUserContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *LikeExpressionContext) NOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserNOT, 0)
+func (s *CreateUserContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LikeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateUserContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitLikeExpression(s)
+		return t.VisitCreateUser(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ComparisonExpressionContext struct {
-	ExpressionContext
-}
-
-func NewComparisonExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ComparisonExpressionContext {
-	var p = new(ComparisonExpressionContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
-}
-
-func (s *ComparisonExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (p *MiniQLParser) CreateUser() (localctx ICreateUserContext) {
+	localctx = NewCreateUserContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, MiniQLParserRULE_createUser)
+	var _la int
 
-func (s *ComparisonExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(537)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+	{
+		p.SetState(538)
+		p.Match(MiniQLParserUSER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
+	{
+		p.SetState(539)
+		p.Identifier()
+	}
+	p.SetState(541)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	return tst
-}
-
-func (s *ComparisonExpressionContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
+	if _la == MiniQLParserWITH {
+		{
+			p.SetState(540)
+			p.Match(MiniQLParserWITH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			j++
 		}
-	}
 
-	if t == nil {
-		return nil
 	}
+	p.SetState(546)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	return t.(IExpressionContext)
-}
+	for (int64((_la-90)) & ^0x3f) == 0 && ((int64(1)<<(_la-90))&7) != 0 {
+		{
+			p.SetState(543)
+			p.UserOption()
+		}
 
-func (s *ComparisonExpressionContext) ComparisonOperator() IComparisonOperatorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IComparisonOperatorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		p.SetState(548)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
-	if t == nil {
-		return nil
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-
-	return t.(IComparisonOperatorContext)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *ComparisonExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitComparisonExpression(s)
+// IUserOptionContext is an interface to support dynamic dispatch.
+type IUserOptionContext interface {
+	antlr.ParserRuleContext
 
-	default:
-		return t.VisitChildren(s)
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PASSWORD() antlr.TerminalNode
+	STRING_LITERAL() antlr.TerminalNode
+	SUPERUSER() antlr.TerminalNode
+	NOSUPERUSER() antlr.TerminalNode
+
+	// IsUserOptionContext differentiates from other interfaces.
+	IsUserOptionContext()
 }
 
-type MultiplicativeExpressionContext struct {
-	ExpressionContext
+type UserOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicativeExpressionContext {
-	var p = new(MultiplicativeExpressionContext)
+func NewEmptyUserOptionContext() *UserOptionContext {
+	var p = new(UserOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_userOption
+	return p
+}
+
+func InitEmptyUserOptionContext(p *UserOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_userOption
+}
+
+func (*UserOptionContext) IsUserOptionContext() {}
+
+func NewUserOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UserOptionContext {
+	var p = new(UserOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	p.RuleIndex = MiniQLParserRULE_userOption
 
 	return p
 }
 
-func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *UserOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UserOptionContext) PASSWORD() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPASSWORD, 0)
+}
+
+func (s *UserOptionContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
+}
+
+func (s *UserOptionContext) SUPERUSER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSUPERUSER, 0)
+}
+
+func (s *UserOptionContext) NOSUPERUSER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOSUPERUSER, 0)
+}
+
+func (s *UserOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MultiplicativeExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
+func (s *UserOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UserOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitUserOption(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
+}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+func (p *MiniQLParser) UserOption() (localctx IUserOptionContext) {
+	localctx = NewUserOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, MiniQLParserRULE_userOption)
+	p.SetState(553)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserPASSWORD:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(549)
+			p.Match(MiniQLParserPASSWORD)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(550)
+			p.Match(MiniQLParserSTRING_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserSUPERUSER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(551)
+			p.Match(MiniQLParserSUPERUSER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserNOSUPERUSER:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(552)
+			p.Match(MiniQLParserNOSUPERUSER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
-	return tst
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *MultiplicativeExpressionContext) Expression(i int) IExpressionContext {
+// ICreateRoleContext is an interface to support dynamic dispatch.
+type ICreateRoleContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CREATE() antlr.TerminalNode
+	ROLE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+
+	// IsCreateRoleContext differentiates from other interfaces.
+	IsCreateRoleContext()
+}
+
+type CreateRoleContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateRoleContext() *CreateRoleContext {
+	var p = new(CreateRoleContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_createRole
+	return p
+}
+
+func InitEmptyCreateRoleContext(p *CreateRoleContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_createRole
+}
+
+func (*CreateRoleContext) IsCreateRoleContext() {}
+
+func NewCreateRoleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateRoleContext {
+	var p = new(CreateRoleContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_createRole
+
+	return p
+}
+
+func (s *CreateRoleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateRoleContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
+}
+
+func (s *CreateRoleContext) ROLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserROLE, 0)
+}
+
+func (s *CreateRoleContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -6421,299 +8104,10164 @@ func (s *MultiplicativeExpressionContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *MultiplicativeExpressionContext) ASTERISK() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserASTERISK, 0)
+func (s *CreateRoleContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *MultiplicativeExpressionContext) DIVIDE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDIVIDE, 0)
+func (s *CreateRoleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateRoleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitMultiplicativeExpression(s)
+		return t.VisitCreateRole(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
-}
-
-func (p *MiniQLParser) expression(_p int) (localctx IExpressionContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-
-	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 48
-	p.EnterRecursionRule(localctx, 48, MiniQLParserRULE_expression, _p)
-	var _la int
-
-	var _alt int
-
+func (p *MiniQLParser) CreateRole() (localctx ICreateRoleContext) {
+	localctx = NewCreateRoleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, MiniQLParserRULE_createRole)
 	p.EnterOuterAlt(localctx, 1)
-	localctx = NewPrimaryExpressionContext(p, localctx)
-	p.SetParserRuleContext(localctx)
-	_prevctx = localctx
-
 	{
-		p.SetState(374)
-		p.PrimaryExpr()
+		p.SetState(555)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(409)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(556)
+		p.Match(MiniQLParserROLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(557)
+		p.Identifier()
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext())
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(407)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewMultiplicativeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(376)
+// IDropRoleContext is an interface to support dynamic dispatch.
+type IDropRoleContext interface {
+	antlr.ParserRuleContext
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(377)
-					_la = p.GetTokenStream().LA(1)
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-					if !(_la == MiniQLParserASTERISK || _la == MiniQLParserDIVIDE) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(378)
-					p.expression(8)
-				}
+	// Getter signatures
+	DROP() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	USER() antlr.TerminalNode
+	ROLE() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
 
-			case 2:
+	// IsDropRoleContext differentiates from other interfaces.
+	IsDropRoleContext()
+}
+
+type DropRoleContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropRoleContext() *DropRoleContext {
+	var p = new(DropRoleContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropRole
+	return p
+}
+
+func InitEmptyDropRoleContext(p *DropRoleContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_dropRole
+}
+
+func (*DropRoleContext) IsDropRoleContext() {}
+
+func NewDropRoleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropRoleContext {
+	var p = new(DropRoleContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_dropRole
+
+	return p
+}
+
+func (s *DropRoleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropRoleContext) DROP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDROP, 0)
+}
+
+func (s *DropRoleContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *DropRoleContext) USER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUSER, 0)
+}
+
+func (s *DropRoleContext) ROLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserROLE, 0)
+}
+
+func (s *DropRoleContext) IF() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIF, 0)
+}
+
+func (s *DropRoleContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXISTS, 0)
+}
+
+func (s *DropRoleContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropRoleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropRoleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitDropRole(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) DropRole() (localctx IDropRoleContext) {
+	localctx = NewDropRoleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, MiniQLParserRULE_dropRole)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(559)
+		p.Match(MiniQLParserDROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(560)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == MiniQLParserUSER || _la == MiniQLParserROLE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(563)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(561)
+			p.Match(MiniQLParserIF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(562)
+			p.Match(MiniQLParserEXISTS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(565)
+		p.Identifier()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGrantStatementContext is an interface to support dynamic dispatch.
+type IGrantStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	GRANT() antlr.TerminalNode
+	PrivilegeList() IPrivilegeListContext
+	ON() antlr.TerminalNode
+	GrantObject() IGrantObjectContext
+	TO() antlr.TerminalNode
+	AllIdentifierList() []IIdentifierListContext
+	IdentifierList(i int) IIdentifierListContext
+	TABLE() antlr.TerminalNode
+
+	// IsGrantStatementContext differentiates from other interfaces.
+	IsGrantStatementContext()
+}
+
+type GrantStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantStatementContext() *GrantStatementContext {
+	var p = new(GrantStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantStatement
+	return p
+}
+
+func InitEmptyGrantStatementContext(p *GrantStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantStatement
+}
+
+func (*GrantStatementContext) IsGrantStatementContext() {}
+
+func NewGrantStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantStatementContext {
+	var p = new(GrantStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_grantStatement
+
+	return p
+}
+
+func (s *GrantStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantStatementContext) GRANT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserGRANT, 0)
+}
+
+func (s *GrantStatementContext) PrivilegeList() IPrivilegeListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrivilegeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeListContext)
+}
+
+func (s *GrantStatementContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
+}
+
+func (s *GrantStatementContext) GrantObject() IGrantObjectContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantObjectContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantObjectContext)
+}
+
+func (s *GrantStatementContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
+}
+
+func (s *GrantStatementContext) AllIdentifierList() []IIdentifierListContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierListContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierListContext); ok {
+			tst[i] = t.(IIdentifierListContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrantStatementContext) IdentifierList(i int) IIdentifierListContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *GrantStatementContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *GrantStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitGrantStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) GrantStatement() (localctx IGrantStatementContext) {
+	localctx = NewGrantStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, MiniQLParserRULE_grantStatement)
+	var _la int
+
+	p.SetState(582)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(567)
+			p.Match(MiniQLParserGRANT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(568)
+			p.PrivilegeList()
+		}
+		{
+			p.SetState(569)
+			p.Match(MiniQLParserON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(571)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserTABLE {
+			{
+				p.SetState(570)
+				p.Match(MiniQLParserTABLE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(573)
+			p.GrantObject()
+		}
+		{
+			p.SetState(574)
+			p.Match(MiniQLParserTO)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(575)
+			p.IdentifierList()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(577)
+			p.Match(MiniQLParserGRANT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(578)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(579)
+			p.Match(MiniQLParserTO)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(580)
+			p.IdentifierList()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRevokeStatementContext is an interface to support dynamic dispatch.
+type IRevokeStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	REVOKE() antlr.TerminalNode
+	PrivilegeList() IPrivilegeListContext
+	ON() antlr.TerminalNode
+	GrantObject() IGrantObjectContext
+	FROM() antlr.TerminalNode
+	AllIdentifierList() []IIdentifierListContext
+	IdentifierList(i int) IIdentifierListContext
+	TABLE() antlr.TerminalNode
+
+	// IsRevokeStatementContext differentiates from other interfaces.
+	IsRevokeStatementContext()
+}
+
+type RevokeStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRevokeStatementContext() *RevokeStatementContext {
+	var p = new(RevokeStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_revokeStatement
+	return p
+}
+
+func InitEmptyRevokeStatementContext(p *RevokeStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_revokeStatement
+}
+
+func (*RevokeStatementContext) IsRevokeStatementContext() {}
+
+func NewRevokeStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeStatementContext {
+	var p = new(RevokeStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_revokeStatement
+
+	return p
+}
+
+func (s *RevokeStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RevokeStatementContext) REVOKE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserREVOKE, 0)
+}
+
+func (s *RevokeStatementContext) PrivilegeList() IPrivilegeListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrivilegeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeListContext)
+}
+
+func (s *RevokeStatementContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
+}
+
+func (s *RevokeStatementContext) GrantObject() IGrantObjectContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantObjectContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantObjectContext)
+}
+
+func (s *RevokeStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *RevokeStatementContext) AllIdentifierList() []IIdentifierListContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierListContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierListContext); ok {
+			tst[i] = t.(IIdentifierListContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RevokeStatementContext) IdentifierList(i int) IIdentifierListContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *RevokeStatementContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *RevokeStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RevokeStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RevokeStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitRevokeStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) RevokeStatement() (localctx IRevokeStatementContext) {
+	localctx = NewRevokeStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, MiniQLParserRULE_revokeStatement)
+	var _la int
+
+	p.SetState(599)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(584)
+			p.Match(MiniQLParserREVOKE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(585)
+			p.PrivilegeList()
+		}
+		{
+			p.SetState(586)
+			p.Match(MiniQLParserON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(588)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserTABLE {
+			{
+				p.SetState(587)
+				p.Match(MiniQLParserTABLE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(590)
+			p.GrantObject()
+		}
+		{
+			p.SetState(591)
+			p.Match(MiniQLParserFROM)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(592)
+			p.IdentifierList()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(594)
+			p.Match(MiniQLParserREVOKE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(595)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(596)
+			p.Match(MiniQLParserFROM)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(597)
+			p.IdentifierList()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPrivilegeListContext is an interface to support dynamic dispatch.
+type IPrivilegeListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllPrivilege() []IPrivilegeContext
+	Privilege(i int) IPrivilegeContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsPrivilegeListContext differentiates from other interfaces.
+	IsPrivilegeListContext()
+}
+
+type PrivilegeListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrivilegeListContext() *PrivilegeListContext {
+	var p = new(PrivilegeListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_privilegeList
+	return p
+}
+
+func InitEmptyPrivilegeListContext(p *PrivilegeListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_privilegeList
+}
+
+func (*PrivilegeListContext) IsPrivilegeListContext() {}
+
+func NewPrivilegeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeListContext {
+	var p = new(PrivilegeListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_privilegeList
+
+	return p
+}
+
+func (s *PrivilegeListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrivilegeListContext) AllPrivilege() []IPrivilegeContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPrivilegeContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPrivilegeContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPrivilegeContext); ok {
+			tst[i] = t.(IPrivilegeContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *PrivilegeListContext) Privilege(i int) IPrivilegeContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrivilegeContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeContext)
+}
+
+func (s *PrivilegeListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *PrivilegeListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *PrivilegeListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrivilegeListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrivilegeListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPrivilegeList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PrivilegeList() (localctx IPrivilegeListContext) {
+	localctx = NewPrivilegeListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, MiniQLParserRULE_privilegeList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(601)
+		p.Privilege()
+	}
+	p.SetState(606)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(602)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(603)
+			p.Privilege()
+		}
+
+		p.SetState(608)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPrivilegeContext is an interface to support dynamic dispatch.
+type IPrivilegeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SELECT() antlr.TerminalNode
+	INSERT() antlr.TerminalNode
+	UPDATE() antlr.TerminalNode
+	DELETE() antlr.TerminalNode
+	ALL() antlr.TerminalNode
+	PRIVILEGES() antlr.TerminalNode
+	Identifier() IIdentifierContext
+
+	// IsPrivilegeContext differentiates from other interfaces.
+	IsPrivilegeContext()
+}
+
+type PrivilegeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrivilegeContext() *PrivilegeContext {
+	var p = new(PrivilegeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_privilege
+	return p
+}
+
+func InitEmptyPrivilegeContext(p *PrivilegeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_privilege
+}
+
+func (*PrivilegeContext) IsPrivilegeContext() {}
+
+func NewPrivilegeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeContext {
+	var p = new(PrivilegeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_privilege
+
+	return p
+}
+
+func (s *PrivilegeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrivilegeContext) SELECT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSELECT, 0)
+}
+
+func (s *PrivilegeContext) INSERT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINSERT, 0)
+}
+
+func (s *PrivilegeContext) UPDATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUPDATE, 0)
+}
+
+func (s *PrivilegeContext) DELETE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDELETE, 0)
+}
+
+func (s *PrivilegeContext) ALL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserALL, 0)
+}
+
+func (s *PrivilegeContext) PRIVILEGES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPRIVILEGES, 0)
+}
+
+func (s *PrivilegeContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *PrivilegeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrivilegeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrivilegeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPrivilege(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) Privilege() (localctx IPrivilegeContext) {
+	localctx = NewPrivilegeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, MiniQLParserRULE_privilege)
+	var _la int
+
+	p.SetState(618)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserSELECT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(609)
+			p.Match(MiniQLParserSELECT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserINSERT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(610)
+			p.Match(MiniQLParserINSERT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserUPDATE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(611)
+			p.Match(MiniQLParserUPDATE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserDELETE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(612)
+			p.Match(MiniQLParserDELETE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserALL:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(613)
+			p.Match(MiniQLParserALL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(615)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserPRIVILEGES {
+			{
+				p.SetState(614)
+				p.Match(MiniQLParserPRIVILEGES)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(617)
+			p.Identifier()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGrantObjectContext is an interface to support dynamic dispatch.
+type IGrantObjectContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllGrantObjectPart() []IGrantObjectPartContext
+	GrantObjectPart(i int) IGrantObjectPartContext
+	DOT() antlr.TerminalNode
+
+	// IsGrantObjectContext differentiates from other interfaces.
+	IsGrantObjectContext()
+}
+
+type GrantObjectContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantObjectContext() *GrantObjectContext {
+	var p = new(GrantObjectContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantObject
+	return p
+}
+
+func InitEmptyGrantObjectContext(p *GrantObjectContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantObject
+}
+
+func (*GrantObjectContext) IsGrantObjectContext() {}
+
+func NewGrantObjectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantObjectContext {
+	var p = new(GrantObjectContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_grantObject
+
+	return p
+}
+
+func (s *GrantObjectContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantObjectContext) AllGrantObjectPart() []IGrantObjectPartContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGrantObjectPartContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGrantObjectPartContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGrantObjectPartContext); ok {
+			tst[i] = t.(IGrantObjectPartContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrantObjectContext) GrantObjectPart(i int) IGrantObjectPartContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantObjectPartContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantObjectPartContext)
+}
+
+func (s *GrantObjectContext) DOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, 0)
+}
+
+func (s *GrantObjectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantObjectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantObjectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitGrantObject(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) GrantObject() (localctx IGrantObjectContext) {
+	localctx = NewGrantObjectContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, MiniQLParserRULE_grantObject)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(620)
+		p.GrantObjectPart()
+	}
+	p.SetState(623)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserDOT {
+		{
+			p.SetState(621)
+			p.Match(MiniQLParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(622)
+			p.GrantObjectPart()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGrantObjectPartContext is an interface to support dynamic dispatch.
+type IGrantObjectPartContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	DEFAULT() antlr.TerminalNode
+	ASTERISK() antlr.TerminalNode
+
+	// IsGrantObjectPartContext differentiates from other interfaces.
+	IsGrantObjectPartContext()
+}
+
+type GrantObjectPartContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantObjectPartContext() *GrantObjectPartContext {
+	var p = new(GrantObjectPartContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantObjectPart
+	return p
+}
+
+func InitEmptyGrantObjectPartContext(p *GrantObjectPartContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_grantObjectPart
+}
+
+func (*GrantObjectPartContext) IsGrantObjectPartContext() {}
+
+func NewGrantObjectPartContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantObjectPartContext {
+	var p = new(GrantObjectPartContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_grantObjectPart
+
+	return p
+}
+
+func (s *GrantObjectPartContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantObjectPartContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *GrantObjectPartContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEFAULT, 0)
+}
+
+func (s *GrantObjectPartContext) ASTERISK() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserASTERISK, 0)
+}
+
+func (s *GrantObjectPartContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantObjectPartContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantObjectPartContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitGrantObjectPart(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) GrantObjectPart() (localctx IGrantObjectPartContext) {
+	localctx = NewGrantObjectPartContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, MiniQLParserRULE_grantObjectPart)
+	p.SetState(628)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(625)
+			p.Identifier()
+		}
+
+	case MiniQLParserDEFAULT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(626)
+			p.Match(MiniQLParserDEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserASTERISK:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(627)
+			p.Match(MiniQLParserASTERISK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IInsertStatementContext is an interface to support dynamic dispatch.
+type IInsertStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	INSERT() antlr.TerminalNode
+	INTO() antlr.TerminalNode
+	TableName() ITableNameContext
+	VALUES() antlr.TerminalNode
+	AllLEFT_PAREN() []antlr.TerminalNode
+	LEFT_PAREN(i int) antlr.TerminalNode
+	AllValueList() []IValueListContext
+	ValueList(i int) IValueListContext
+	AllRIGHT_PAREN() []antlr.TerminalNode
+	RIGHT_PAREN(i int) antlr.TerminalNode
+	IdentifierList() IIdentifierListContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsInsertStatementContext differentiates from other interfaces.
+	IsInsertStatementContext()
+}
+
+type InsertStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyInsertStatementContext() *InsertStatementContext {
+	var p = new(InsertStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_insertStatement
+	return p
+}
+
+func InitEmptyInsertStatementContext(p *InsertStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_insertStatement
+}
+
+func (*InsertStatementContext) IsInsertStatementContext() {}
+
+func NewInsertStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InsertStatementContext {
+	var p = new(InsertStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_insertStatement
+
+	return p
+}
+
+func (s *InsertStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *InsertStatementContext) INSERT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINSERT, 0)
+}
+
+func (s *InsertStatementContext) INTO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTO, 0)
+}
+
+func (s *InsertStatementContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *InsertStatementContext) VALUES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVALUES, 0)
+}
+
+func (s *InsertStatementContext) AllLEFT_PAREN() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserLEFT_PAREN)
+}
+
+func (s *InsertStatementContext) LEFT_PAREN(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, i)
+}
+
+func (s *InsertStatementContext) AllValueList() []IValueListContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IValueListContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IValueListContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IValueListContext); ok {
+			tst[i] = t.(IValueListContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *InsertStatementContext) ValueList(i int) IValueListContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IValueListContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IValueListContext)
+}
+
+func (s *InsertStatementContext) AllRIGHT_PAREN() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserRIGHT_PAREN)
+}
+
+func (s *InsertStatementContext) RIGHT_PAREN(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, i)
+}
+
+func (s *InsertStatementContext) IdentifierList() IIdentifierListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *InsertStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *InsertStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *InsertStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InsertStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *InsertStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitInsertStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) InsertStatement() (localctx IInsertStatementContext) {
+	localctx = NewInsertStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, MiniQLParserRULE_insertStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(630)
+		p.Match(MiniQLParserINSERT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(631)
+		p.Match(MiniQLParserINTO)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(632)
+		p.TableName()
+	}
+	p.SetState(637)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserLEFT_PAREN {
+		{
+			p.SetState(633)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(634)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(635)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(639)
+		p.Match(MiniQLParserVALUES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(640)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(641)
+		p.ValueList()
+	}
+	{
+		p.SetState(642)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(650)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(643)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(644)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(645)
+			p.ValueList()
+		}
+		{
+			p.SetState(646)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(652)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUpdateStatementContext is an interface to support dynamic dispatch.
+type IUpdateStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	UPDATE() antlr.TerminalNode
+	TableName() ITableNameContext
+	SET() antlr.TerminalNode
+	AllUpdateAssignment() []IUpdateAssignmentContext
+	UpdateAssignment(i int) IUpdateAssignmentContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	WHERE() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsUpdateStatementContext differentiates from other interfaces.
+	IsUpdateStatementContext()
+}
+
+type UpdateStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUpdateStatementContext() *UpdateStatementContext {
+	var p = new(UpdateStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_updateStatement
+	return p
+}
+
+func InitEmptyUpdateStatementContext(p *UpdateStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_updateStatement
+}
+
+func (*UpdateStatementContext) IsUpdateStatementContext() {}
+
+func NewUpdateStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UpdateStatementContext {
+	var p = new(UpdateStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_updateStatement
+
+	return p
+}
+
+func (s *UpdateStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UpdateStatementContext) UPDATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUPDATE, 0)
+}
+
+func (s *UpdateStatementContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *UpdateStatementContext) SET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSET, 0)
+}
+
+func (s *UpdateStatementContext) AllUpdateAssignment() []IUpdateAssignmentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUpdateAssignmentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IUpdateAssignmentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUpdateAssignmentContext); ok {
+			tst[i] = t.(IUpdateAssignmentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *UpdateStatementContext) UpdateAssignment(i int) IUpdateAssignmentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUpdateAssignmentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUpdateAssignmentContext)
+}
+
+func (s *UpdateStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *UpdateStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *UpdateStatementContext) WHERE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWHERE, 0)
+}
+
+func (s *UpdateStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *UpdateStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UpdateStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UpdateStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitUpdateStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) UpdateStatement() (localctx IUpdateStatementContext) {
+	localctx = NewUpdateStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, MiniQLParserRULE_updateStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(653)
+		p.Match(MiniQLParserUPDATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(654)
+		p.TableName()
+	}
+	{
+		p.SetState(655)
+		p.Match(MiniQLParserSET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(656)
+		p.UpdateAssignment()
+	}
+	p.SetState(661)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(657)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(658)
+			p.UpdateAssignment()
+		}
+
+		p.SetState(663)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(666)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserWHERE {
+		{
+			p.SetState(664)
+			p.Match(MiniQLParserWHERE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(665)
+			p.expression(0)
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDeleteStatementContext is an interface to support dynamic dispatch.
+type IDeleteStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DELETE() antlr.TerminalNode
+	FROM() antlr.TerminalNode
+	TableName() ITableNameContext
+	WHERE() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsDeleteStatementContext differentiates from other interfaces.
+	IsDeleteStatementContext()
+}
+
+type DeleteStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDeleteStatementContext() *DeleteStatementContext {
+	var p = new(DeleteStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_deleteStatement
+	return p
+}
+
+func InitEmptyDeleteStatementContext(p *DeleteStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_deleteStatement
+}
+
+func (*DeleteStatementContext) IsDeleteStatementContext() {}
+
+func NewDeleteStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStatementContext {
+	var p = new(DeleteStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_deleteStatement
+
+	return p
+}
+
+func (s *DeleteStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DeleteStatementContext) DELETE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDELETE, 0)
+}
+
+func (s *DeleteStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *DeleteStatementContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *DeleteStatementContext) WHERE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWHERE, 0)
+}
+
+func (s *DeleteStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *DeleteStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DeleteStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DeleteStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitDeleteStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) DeleteStatement() (localctx IDeleteStatementContext) {
+	localctx = NewDeleteStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, MiniQLParserRULE_deleteStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(668)
+		p.Match(MiniQLParserDELETE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(669)
+		p.Match(MiniQLParserFROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(670)
+		p.TableName()
+	}
+	p.SetState(673)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserWHERE {
+		{
+			p.SetState(671)
+			p.Match(MiniQLParserWHERE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(672)
+			p.expression(0)
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISelectStatementContext is an interface to support dynamic dispatch.
+type ISelectStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SELECT() antlr.TerminalNode
+	AllSelectItem() []ISelectItemContext
+	SelectItem(i int) ISelectItemContext
+	FROM() antlr.TerminalNode
+	TableReference() ITableReferenceContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	WHERE() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	GROUP() antlr.TerminalNode
+	AllBY() []antlr.TerminalNode
+	BY(i int) antlr.TerminalNode
+	AllGroupByItem() []IGroupByItemContext
+	GroupByItem(i int) IGroupByItemContext
+	HAVING() antlr.TerminalNode
+	ORDER() antlr.TerminalNode
+	AllOrderByItem() []IOrderByItemContext
+	OrderByItem(i int) IOrderByItemContext
+	LIMIT() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+
+	// IsSelectStatementContext differentiates from other interfaces.
+	IsSelectStatementContext()
+}
+
+type SelectStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectStatementContext() *SelectStatementContext {
+	var p = new(SelectStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_selectStatement
+	return p
+}
+
+func InitEmptySelectStatementContext(p *SelectStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_selectStatement
+}
+
+func (*SelectStatementContext) IsSelectStatementContext() {}
+
+func NewSelectStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStatementContext {
+	var p = new(SelectStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_selectStatement
+
+	return p
+}
+
+func (s *SelectStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectStatementContext) SELECT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSELECT, 0)
+}
+
+func (s *SelectStatementContext) AllSelectItem() []ISelectItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISelectItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISelectItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISelectItemContext); ok {
+			tst[i] = t.(ISelectItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) SelectItem(i int) ISelectItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectItemContext)
+}
+
+func (s *SelectStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *SelectStatementContext) TableReference() ITableReferenceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableReferenceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableReferenceContext)
+}
+
+func (s *SelectStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *SelectStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *SelectStatementContext) WHERE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWHERE, 0)
+}
+
+func (s *SelectStatementContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SelectStatementContext) GROUP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserGROUP, 0)
+}
+
+func (s *SelectStatementContext) AllBY() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserBY)
+}
+
+func (s *SelectStatementContext) BY(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserBY, i)
+}
+
+func (s *SelectStatementContext) AllGroupByItem() []IGroupByItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupByItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGroupByItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupByItemContext); ok {
+			tst[i] = t.(IGroupByItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) GroupByItem(i int) IGroupByItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByItemContext)
+}
+
+func (s *SelectStatementContext) HAVING() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserHAVING, 0)
+}
+
+func (s *SelectStatementContext) ORDER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserORDER, 0)
+}
+
+func (s *SelectStatementContext) AllOrderByItem() []IOrderByItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOrderByItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IOrderByItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOrderByItemContext); ok {
+			tst[i] = t.(IOrderByItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) OrderByItem(i int) IOrderByItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByItemContext)
+}
+
+func (s *SelectStatementContext) LIMIT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLIMIT, 0)
+}
+
+func (s *SelectStatementContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *SelectStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitSelectStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) SelectStatement() (localctx ISelectStatementContext) {
+	localctx = NewSelectStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, MiniQLParserRULE_selectStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(675)
+		p.Match(MiniQLParserSELECT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(676)
+		p.SelectItem()
+	}
+	p.SetState(681)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(677)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(678)
+			p.SelectItem()
+		}
+
+		p.SetState(683)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(684)
+		p.Match(MiniQLParserFROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(685)
+		p.tableReference(0)
+	}
+	p.SetState(688)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserWHERE {
+		{
+			p.SetState(686)
+			p.Match(MiniQLParserWHERE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(687)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(700)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserGROUP {
+		{
+			p.SetState(690)
+			p.Match(MiniQLParserGROUP)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(691)
+			p.Match(MiniQLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(692)
+			p.GroupByItem()
+		}
+		p.SetState(697)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(693)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(694)
+				p.GroupByItem()
+			}
+
+			p.SetState(699)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	p.SetState(704)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserHAVING {
+		{
+			p.SetState(702)
+			p.Match(MiniQLParserHAVING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(703)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(716)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserORDER {
+		{
+			p.SetState(706)
+			p.Match(MiniQLParserORDER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(707)
+			p.Match(MiniQLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(708)
+			p.OrderByItem()
+		}
+		p.SetState(713)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(709)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(710)
+				p.OrderByItem()
+			}
+
+			p.SetState(715)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	p.SetState(720)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserLIMIT {
+		{
+			p.SetState(718)
+			p.Match(MiniQLParserLIMIT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(719)
+			p.Match(MiniQLParserINTEGER_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISelectItemContext is an interface to support dynamic dispatch.
+type ISelectItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsSelectItemContext differentiates from other interfaces.
+	IsSelectItemContext()
+}
+
+type SelectItemContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectItemContext() *SelectItemContext {
+	var p = new(SelectItemContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_selectItem
+	return p
+}
+
+func InitEmptySelectItemContext(p *SelectItemContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_selectItem
+}
+
+func (*SelectItemContext) IsSelectItemContext() {}
+
+func NewSelectItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectItemContext {
+	var p = new(SelectItemContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_selectItem
+
+	return p
+}
+
+func (s *SelectItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectItemContext) CopyAll(ctx *SelectItemContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *SelectItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type SelectAllContext struct {
+	SelectItemContext
+}
+
+func NewSelectAllContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectAllContext {
+	var p = new(SelectAllContext)
+
+	InitEmptySelectItemContext(&p.SelectItemContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*SelectItemContext))
+
+	return p
+}
+
+func (s *SelectAllContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectAllContext) ASTERISK() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserASTERISK, 0)
+}
+
+func (s *SelectAllContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *SelectAllContext) DOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, 0)
+}
+
+func (s *SelectAllContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitSelectAll(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SelectExprContext struct {
+	SelectItemContext
+}
+
+func NewSelectExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectExprContext {
+	var p = new(SelectExprContext)
+
+	InitEmptySelectItemContext(&p.SelectItemContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*SelectItemContext))
+
+	return p
+}
+
+func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectExprContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SelectExprContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *SelectExprContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitSelectExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) SelectItem() (localctx ISelectItemContext) {
+	localctx = NewSelectItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, MiniQLParserRULE_selectItem)
+	var _la int
+
+	p.SetState(735)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 63, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewSelectAllContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(725)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if ((int64((_la-50)) & ^0x3f) == 0 && ((int64(1)<<(_la-50))&-7471103) != 0) || ((int64((_la-114)) & ^0x3f) == 0 && ((int64(1)<<(_la-114))&2097183) != 0) {
+			{
+				p.SetState(722)
+				p.TableName()
+			}
+			{
+				p.SetState(723)
+				p.Match(MiniQLParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(727)
+			p.Match(MiniQLParserASTERISK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		localctx = NewSelectExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(728)
+			p.expression(0)
+		}
+		p.SetState(733)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if ((int64((_la-27)) & ^0x3f) == 0 && ((int64(1)<<(_la-27))&-62672162783231) != 0) || ((int64((_la-91)) & ^0x3f) == 0 && ((int64(1)<<(_la-91))&17592454479871) != 0) {
+			p.SetState(730)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == MiniQLParserAS {
+				{
+					p.SetState(729)
+					p.Match(MiniQLParserAS)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(732)
+				p.Identifier()
+			}
+
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITableReferenceContext is an interface to support dynamic dispatch.
+type ITableReferenceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TableReferenceAtom() ITableReferenceAtomContext
+	TableReference() ITableReferenceContext
+	JOIN() antlr.TerminalNode
+	ON() antlr.TerminalNode
+	Expression() IExpressionContext
+	JoinType() IJoinTypeContext
+
+	// IsTableReferenceContext differentiates from other interfaces.
+	IsTableReferenceContext()
+}
+
+type TableReferenceContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTableReferenceContext() *TableReferenceContext {
+	var p = new(TableReferenceContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableReference
+	return p
+}
+
+func InitEmptyTableReferenceContext(p *TableReferenceContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableReference
+}
+
+func (*TableReferenceContext) IsTableReferenceContext() {}
+
+func NewTableReferenceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableReferenceContext {
+	var p = new(TableReferenceContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_tableReference
+
+	return p
+}
+
+func (s *TableReferenceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TableReferenceContext) TableReferenceAtom() ITableReferenceAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableReferenceAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableReferenceAtomContext)
+}
+
+func (s *TableReferenceContext) TableReference() ITableReferenceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableReferenceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableReferenceContext)
+}
+
+func (s *TableReferenceContext) JOIN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserJOIN, 0)
+}
+
+func (s *TableReferenceContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
+}
+
+func (s *TableReferenceContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *TableReferenceContext) JoinType() IJoinTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJoinTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoinTypeContext)
+}
+
+func (s *TableReferenceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableReferenceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TableReferenceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTableReference(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) TableReference() (localctx ITableReferenceContext) {
+	return p.tableReference(0)
+}
+
+func (p *MiniQLParser) tableReference(_p int) (localctx ITableReferenceContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewTableReferenceContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx ITableReferenceContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 86
+	p.EnterRecursionRule(localctx, 86, MiniQLParserRULE_tableReference, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(738)
+		p.TableReferenceAtom()
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(751)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			localctx = NewTableReferenceContext(p, _parentctx, _parentState)
+			p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_tableReference)
+			p.SetState(740)
+
+			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+				goto errorExit
+			}
+			p.SetState(742)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2061584302080) != 0 {
+				{
+					p.SetState(741)
+					p.JoinType()
+				}
+
+			}
+			{
+				p.SetState(744)
+				p.Match(MiniQLParserJOIN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(745)
+				p.TableReferenceAtom()
+			}
+			{
+				p.SetState(746)
+				p.Match(MiniQLParserON)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(747)
+				p.expression(0)
+			}
+
+		}
+		p.SetState(753)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITableReferenceAtomContext is an interface to support dynamic dispatch.
+type ITableReferenceAtomContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsTableReferenceAtomContext differentiates from other interfaces.
+	IsTableReferenceAtomContext()
+}
+
+type TableReferenceAtomContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTableReferenceAtomContext() *TableReferenceAtomContext {
+	var p = new(TableReferenceAtomContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+	return p
+}
+
+func InitEmptyTableReferenceAtomContext(p *TableReferenceAtomContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+}
+
+func (*TableReferenceAtomContext) IsTableReferenceAtomContext() {}
+
+func NewTableReferenceAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableReferenceAtomContext {
+	var p = new(TableReferenceAtomContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_tableReferenceAtom
+
+	return p
+}
+
+func (s *TableReferenceAtomContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TableReferenceAtomContext) CopyAll(ctx *TableReferenceAtomContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *TableReferenceAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableReferenceAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type TableRefFunctionContext struct {
+	TableReferenceAtomContext
+}
+
+func NewTableRefFunctionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TableRefFunctionContext {
+	var p = new(TableRefFunctionContext)
+
+	InitEmptyTableReferenceAtomContext(&p.TableReferenceAtomContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*TableReferenceAtomContext))
+
+	return p
+}
+
+func (s *TableRefFunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableRefFunctionContext) TableFunction() ITableFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableFunctionContext)
+}
+
+func (s *TableRefFunctionContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *TableRefFunctionContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *TableRefFunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTableRefFunction(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TableRefBaseContext struct {
+	TableReferenceAtomContext
+}
+
+func NewTableRefBaseContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TableRefBaseContext {
+	var p = new(TableRefBaseContext)
+
+	InitEmptyTableReferenceAtomContext(&p.TableReferenceAtomContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*TableReferenceAtomContext))
+
+	return p
+}
+
+func (s *TableRefBaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableRefBaseContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *TableRefBaseContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *TableRefBaseContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *TableRefBaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTableRefBase(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TableRefSubqueryContext struct {
+	TableReferenceAtomContext
+}
+
+func NewTableRefSubqueryContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TableRefSubqueryContext {
+	var p = new(TableRefSubqueryContext)
+
+	InitEmptyTableReferenceAtomContext(&p.TableReferenceAtomContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*TableReferenceAtomContext))
+
+	return p
+}
+
+func (s *TableRefSubqueryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableRefSubqueryContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *TableRefSubqueryContext) SelectStatement() ISelectStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectStatementContext)
+}
+
+func (s *TableRefSubqueryContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *TableRefSubqueryContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *TableRefSubqueryContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *TableRefSubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTableRefSubquery(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) TableReferenceAtom() (localctx ITableReferenceAtomContext) {
+	localctx = NewTableReferenceAtomContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, MiniQLParserRULE_tableReferenceAtom)
+	var _la int
+
+	p.SetState(776)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 71, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewTableRefBaseContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(754)
+			p.TableName()
+		}
+		p.SetState(759)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
+			p.SetState(756)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == MiniQLParserAS {
+				{
+					p.SetState(755)
+					p.Match(MiniQLParserAS)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(758)
+				p.Identifier()
+			}
+
+		} else if p.HasError() { // JIM
+			goto errorExit
+		}
+
+	case 2:
+		localctx = NewTableRefSubqueryContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(761)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(762)
+			p.SelectStatement()
+		}
+		{
+			p.SetState(763)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(765)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserAS {
+			{
+				p.SetState(764)
+				p.Match(MiniQLParserAS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(767)
+			p.Identifier()
+		}
+
+	case 3:
+		localctx = NewTableRefFunctionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(769)
+			p.TableFunction()
+		}
+		p.SetState(774)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 70, p.GetParserRuleContext()) == 1 {
+			p.SetState(771)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == MiniQLParserAS {
+				{
+					p.SetState(770)
+					p.Match(MiniQLParserAS)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(773)
+				p.Identifier()
+			}
+
+		} else if p.HasError() { // JIM
+			goto errorExit
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITableFunctionContext is an interface to support dynamic dispatch.
+type ITableFunctionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	LEFT_PAREN() antlr.TerminalNode
+	RIGHT_PAREN() antlr.TerminalNode
+	AllSignedLiteral() []ISignedLiteralContext
+	SignedLiteral(i int) ISignedLiteralContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsTableFunctionContext differentiates from other interfaces.
+	IsTableFunctionContext()
+}
+
+type TableFunctionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTableFunctionContext() *TableFunctionContext {
+	var p = new(TableFunctionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableFunction
+	return p
+}
+
+func InitEmptyTableFunctionContext(p *TableFunctionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_tableFunction
+}
+
+func (*TableFunctionContext) IsTableFunctionContext() {}
+
+func NewTableFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableFunctionContext {
+	var p = new(TableFunctionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_tableFunction
+
+	return p
+}
+
+func (s *TableFunctionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TableFunctionContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *TableFunctionContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *TableFunctionContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *TableFunctionContext) AllSignedLiteral() []ISignedLiteralContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISignedLiteralContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISignedLiteralContext); ok {
+			tst[i] = t.(ISignedLiteralContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TableFunctionContext) SignedLiteral(i int) ISignedLiteralContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedLiteralContext)
+}
+
+func (s *TableFunctionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *TableFunctionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *TableFunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TableFunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TableFunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTableFunction(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) TableFunction() (localctx ITableFunctionContext) {
+	localctx = NewTableFunctionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, MiniQLParserRULE_tableFunction)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(778)
+		p.Identifier()
+	}
+	{
+		p.SetState(779)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(788)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&117440512) != 0) || ((int64((_la-126)) & ^0x3f) == 0 && ((int64(1)<<(_la-126))&7171) != 0) {
+		{
+			p.SetState(780)
+			p.SignedLiteral()
+		}
+		p.SetState(785)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(781)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(782)
+				p.SignedLiteral()
+			}
+
+			p.SetState(787)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(790)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IJoinTypeContext is an interface to support dynamic dispatch.
+type IJoinTypeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	INNER() antlr.TerminalNode
+	LEFT() antlr.TerminalNode
+	OUTER() antlr.TerminalNode
+	RIGHT() antlr.TerminalNode
+	FULL() antlr.TerminalNode
+
+	// IsJoinTypeContext differentiates from other interfaces.
+	IsJoinTypeContext()
+}
+
+type JoinTypeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoinTypeContext() *JoinTypeContext {
+	var p = new(JoinTypeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_joinType
+	return p
+}
+
+func InitEmptyJoinTypeContext(p *JoinTypeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_joinType
+}
+
+func (*JoinTypeContext) IsJoinTypeContext() {}
+
+func NewJoinTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTypeContext {
+	var p = new(JoinTypeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_joinType
+
+	return p
+}
+
+func (s *JoinTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *JoinTypeContext) INNER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINNER, 0)
+}
+
+func (s *JoinTypeContext) LEFT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT, 0)
+}
+
+func (s *JoinTypeContext) OUTER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserOUTER, 0)
+}
+
+func (s *JoinTypeContext) RIGHT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT, 0)
+}
+
+func (s *JoinTypeContext) FULL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFULL, 0)
+}
+
+func (s *JoinTypeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JoinTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *JoinTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitJoinType(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) JoinType() (localctx IJoinTypeContext) {
+	localctx = NewJoinTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, MiniQLParserRULE_joinType)
+	var _la int
+
+	p.SetState(805)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserINNER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(792)
+			p.Match(MiniQLParserINNER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserLEFT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(793)
+			p.Match(MiniQLParserLEFT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(795)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserOUTER {
+			{
+				p.SetState(794)
+				p.Match(MiniQLParserOUTER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case MiniQLParserRIGHT:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(797)
+			p.Match(MiniQLParserRIGHT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(799)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserOUTER {
+			{
+				p.SetState(798)
+				p.Match(MiniQLParserOUTER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case MiniQLParserFULL:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(801)
+			p.Match(MiniQLParserFULL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(803)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserOUTER {
+			{
+				p.SetState(802)
+				p.Match(MiniQLParserOUTER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_expression
+	return p
+}
+
+func InitEmptyExpressionContext(p *ExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_expression
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) CopyAll(ctx *ExpressionContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PrimaryExpressionContext struct {
+	ExpressionContext
+}
+
+func NewPrimaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryExpressionContext {
+	var p = new(PrimaryExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *PrimaryExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryExpressionContext) PrimaryExpr() IPrimaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimaryExprContext)
+}
+
+func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPrimaryExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type OrExpressionContext struct {
+	ExpressionContext
+}
+
+func NewOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExpressionContext {
+	var p = new(OrExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *OrExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OrExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *OrExpressionContext) OR() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserOR, 0)
+}
+
+func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitOrExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AndExpressionContext struct {
+	ExpressionContext
+}
+
+func NewAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExpressionContext {
+	var p = new(AndExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AndExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AndExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AndExpressionContext) AND() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAND, 0)
+}
+
+func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitAndExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type InExpressionContext struct {
+	ExpressionContext
+}
+
+func NewInExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InExpressionContext {
+	var p = new(InExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *InExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *InExpressionContext) IN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIN, 0)
+}
+
+func (s *InExpressionContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *InExpressionContext) ValueList() IValueListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IValueListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IValueListContext)
+}
+
+func (s *InExpressionContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *InExpressionContext) NOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOT, 0)
+}
+
+func (s *InExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitInExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AdditiveExpressionContext struct {
+	ExpressionContext
+}
+
+func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExpressionContext {
+	var p = new(AdditiveExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AdditiveExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AdditiveExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AdditiveExpressionContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPLUS, 0)
+}
+
+func (s *AdditiveExpressionContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserMINUS, 0)
+}
+
+func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitAdditiveExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LikeExpressionContext struct {
+	ExpressionContext
+}
+
+func NewLikeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeExpressionContext {
+	var p = new(LikeExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *LikeExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LikeExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *LikeExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *LikeExpressionContext) LIKE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLIKE, 0)
+}
+
+func (s *LikeExpressionContext) NOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOT, 0)
+}
+
+func (s *LikeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitLikeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ComparisonExpressionContext struct {
+	ExpressionContext
+}
+
+func NewComparisonExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ComparisonExpressionContext {
+	var p = new(ComparisonExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ComparisonExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ComparisonExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ComparisonExpressionContext) ComparisonOperator() IComparisonOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IComparisonOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComparisonOperatorContext)
+}
+
+func (s *ComparisonExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitComparisonExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MultiplicativeExpressionContext struct {
+	ExpressionContext
+}
+
+func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicativeExpressionContext {
+	var p = new(MultiplicativeExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MultiplicativeExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiplicativeExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MultiplicativeExpressionContext) ASTERISK() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserASTERISK, 0)
+}
+
+func (s *MultiplicativeExpressionContext) DIVIDE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDIVIDE, 0)
+}
+
+func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitMultiplicativeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *MiniQLParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 94
+	p.EnterRecursionRule(localctx, 94, MiniQLParserRULE_expression, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	localctx = NewPrimaryExpressionContext(p, localctx)
+	p.SetParserRuleContext(localctx)
+	_prevctx = localctx
+
+	{
+		p.SetState(808)
+		p.PrimaryExpr()
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(843)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 81, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(841)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewMultiplicativeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(810)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(811)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == MiniQLParserASTERISK || _la == MiniQLParserDIVIDE) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(812)
+					p.expression(8)
+				}
+
+			case 2:
 				localctx = NewAdditiveExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(379)
+				p.SetState(813)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(814)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == MiniQLParserPLUS || _la == MiniQLParserMINUS) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(815)
+					p.expression(7)
+				}
+
+			case 3:
+				localctx = NewComparisonExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(816)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(817)
+					p.ComparisonOperator()
+				}
+				{
+					p.SetState(818)
+					p.expression(6)
+				}
+
+			case 4:
+				localctx = NewAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(820)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(821)
+					p.Match(MiniQLParserAND)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(822)
+					p.expression(5)
+				}
+
+			case 5:
+				localctx = NewOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(823)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(824)
+					p.Match(MiniQLParserOR)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(825)
+					p.expression(4)
+				}
+
+			case 6:
+				localctx = NewLikeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(826)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+					goto errorExit
+				}
+				p.SetState(828)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == MiniQLParserNOT {
+					{
+						p.SetState(827)
+						p.Match(MiniQLParserNOT)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(830)
+					p.Match(MiniQLParserLIKE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(831)
+					p.expression(3)
+				}
+
+			case 7:
+				localctx = NewInExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
+				p.SetState(832)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+					goto errorExit
+				}
+				p.SetState(834)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == MiniQLParserNOT {
+					{
+						p.SetState(833)
+						p.Match(MiniQLParserNOT)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(836)
+					p.Match(MiniQLParserIN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(837)
+					p.Match(MiniQLParserLEFT_PAREN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(838)
+					p.ValueList()
+				}
+				{
+					p.SetState(839)
+					p.Match(MiniQLParserRIGHT_PAREN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case antlr.ATNInvalidAltNumber:
+				goto errorExit
+			}
+
+		}
+		p.SetState(845)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 81, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPrimaryExprContext is an interface to support dynamic dispatch.
+type IPrimaryExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsPrimaryExprContext differentiates from other interfaces.
+	IsPrimaryExprContext()
+}
+
+type PrimaryExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrimaryExprContext() *PrimaryExprContext {
+	var p = new(PrimaryExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_primaryExpr
+	return p
+}
+
+func InitEmptyPrimaryExprContext(p *PrimaryExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_primaryExpr
+}
+
+func (*PrimaryExprContext) IsPrimaryExprContext() {}
+
+func NewPrimaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryExprContext {
+	var p = new(PrimaryExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_primaryExpr
+
+	return p
+}
+
+func (s *PrimaryExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrimaryExprContext) CopyAll(ctx *PrimaryExprContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *PrimaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type ParameterExprContext struct {
+	PrimaryExprContext
+}
+
+func NewParameterExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParameterExprContext {
+	var p = new(ParameterExprContext)
+
+	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PrimaryExprContext))
+
+	return p
+}
+
+func (s *ParameterExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParameterExprContext) PARAM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARAM, 0)
+}
+
+func (s *ParameterExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitParameterExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LiteralExprContext struct {
+	PrimaryExprContext
+}
+
+func NewLiteralExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExprContext {
+	var p = new(LiteralExprContext)
+
+	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PrimaryExprContext))
+
+	return p
+}
+
+func (s *LiteralExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LiteralExprContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *LiteralExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitLiteralExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type FunctionCallExprContext struct {
+	PrimaryExprContext
+}
+
+func NewFunctionCallExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionCallExprContext {
+	var p = new(FunctionCallExprContext)
+
+	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PrimaryExprContext))
+
+	return p
+}
+
+func (s *FunctionCallExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionCallExprContext) FunctionCall() IFunctionCallContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *FunctionCallExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitFunctionCallExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ColumnRefExprContext struct {
+	PrimaryExprContext
+}
+
+func NewColumnRefExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ColumnRefExprContext {
+	var p = new(ColumnRefExprContext)
+
+	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PrimaryExprContext))
+
+	return p
+}
+
+func (s *ColumnRefExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ColumnRefExprContext) ColumnRef() IColumnRefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IColumnRefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnRefContext)
+}
+
+func (s *ColumnRefExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitColumnRefExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ParenExprContext struct {
+	PrimaryExprContext
+}
+
+func NewParenExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenExprContext {
+	var p = new(ParenExprContext)
+
+	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*PrimaryExprContext))
+
+	return p
+}
+
+func (s *ParenExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParenExprContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *ParenExprContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ParenExprContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *ParenExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitParenExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PrimaryExpr() (localctx IPrimaryExprContext) {
+	localctx = NewPrimaryExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, MiniQLParserRULE_primaryExpr)
+	p.SetState(854)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 82, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewLiteralExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(846)
+			p.Literal()
+		}
+
+	case 2:
+		localctx = NewColumnRefExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(847)
+			p.ColumnRef()
+		}
+
+	case 3:
+		localctx = NewFunctionCallExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(848)
+			p.FunctionCall()
+		}
+
+	case 4:
+		localctx = NewParenExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(849)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(850)
+			p.expression(0)
+		}
+		{
+			p.SetState(851)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 5:
+		localctx = NewParameterExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(853)
+			p.Match(MiniQLParserPARAM)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IComparisonOperatorContext is an interface to support dynamic dispatch.
+type IComparisonOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EQUAL() antlr.TerminalNode
+	NOT_EQUAL() antlr.TerminalNode
+	GREATER() antlr.TerminalNode
+	GREATER_EQUAL() antlr.TerminalNode
+	LESS() antlr.TerminalNode
+	LESS_EQUAL() antlr.TerminalNode
+
+	// IsComparisonOperatorContext differentiates from other interfaces.
+	IsComparisonOperatorContext()
+}
+
+type ComparisonOperatorContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+	return p
+}
+
+func InitEmptyComparisonOperatorContext(p *ComparisonOperatorContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+}
+
+func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+
+func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+
+	return p
+}
+
+func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComparisonOperatorContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
+}
+
+func (s *ComparisonOperatorContext) NOT_EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOT_EQUAL, 0)
+}
+
+func (s *ComparisonOperatorContext) GREATER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserGREATER, 0)
+}
+
+func (s *ComparisonOperatorContext) GREATER_EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserGREATER_EQUAL, 0)
+}
+
+func (s *ComparisonOperatorContext) LESS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLESS, 0)
+}
+
+func (s *ComparisonOperatorContext) LESS_EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLESS_EQUAL, 0)
+}
+
+func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComparisonOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitComparisonOperator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ComparisonOperator() (localctx IComparisonOperatorContext) {
+	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, MiniQLParserRULE_comparisonOperator)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(856)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-120)) & ^0x3f) == 0 && ((int64(1)<<(_la-120))&63) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IColumnRefContext is an interface to support dynamic dispatch.
+type IColumnRefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	DOT() antlr.TerminalNode
+
+	// IsColumnRefContext differentiates from other interfaces.
+	IsColumnRefContext()
+}
+
+type ColumnRefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyColumnRefContext() *ColumnRefContext {
+	var p = new(ColumnRefContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnRef
+	return p
+}
+
+func InitEmptyColumnRefContext(p *ColumnRefContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnRef
+}
+
+func (*ColumnRefContext) IsColumnRefContext() {}
+
+func NewColumnRefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnRefContext {
+	var p = new(ColumnRefContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_columnRef
+
+	return p
+}
+
+func (s *ColumnRefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ColumnRefContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ColumnRefContext) Identifier(i int) IIdentifierContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *ColumnRefContext) DOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, 0)
+}
+
+func (s *ColumnRefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ColumnRefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ColumnRefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitColumnRef(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ColumnRef() (localctx IColumnRefContext) {
+	localctx = NewColumnRefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, MiniQLParserRULE_columnRef)
+	p.SetState(863)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 83, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(858)
+			p.Identifier()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(859)
+			p.Identifier()
+		}
+		{
+			p.SetState(860)
+			p.Match(MiniQLParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(861)
+			p.Identifier()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUpdateAssignmentContext is an interface to support dynamic dispatch.
+type IUpdateAssignmentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	EQUAL() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsUpdateAssignmentContext differentiates from other interfaces.
+	IsUpdateAssignmentContext()
+}
+
+type UpdateAssignmentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUpdateAssignmentContext() *UpdateAssignmentContext {
+	var p = new(UpdateAssignmentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_updateAssignment
+	return p
+}
+
+func InitEmptyUpdateAssignmentContext(p *UpdateAssignmentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_updateAssignment
+}
+
+func (*UpdateAssignmentContext) IsUpdateAssignmentContext() {}
+
+func NewUpdateAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UpdateAssignmentContext {
+	var p = new(UpdateAssignmentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_updateAssignment
+
+	return p
+}
+
+func (s *UpdateAssignmentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UpdateAssignmentContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *UpdateAssignmentContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
+}
+
+func (s *UpdateAssignmentContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *UpdateAssignmentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UpdateAssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UpdateAssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitUpdateAssignment(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) UpdateAssignment() (localctx IUpdateAssignmentContext) {
+	localctx = NewUpdateAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, MiniQLParserRULE_updateAssignment)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(865)
+		p.Identifier()
+	}
+	{
+		p.SetState(866)
+		p.Match(MiniQLParserEQUAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(867)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGroupByItemContext is an interface to support dynamic dispatch.
+type IGroupByItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expression() IExpressionContext
+
+	// IsGroupByItemContext differentiates from other interfaces.
+	IsGroupByItemContext()
+}
+
+type GroupByItemContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupByItemContext() *GroupByItemContext {
+	var p = new(GroupByItemContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_groupByItem
+	return p
+}
+
+func InitEmptyGroupByItemContext(p *GroupByItemContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_groupByItem
+}
+
+func (*GroupByItemContext) IsGroupByItemContext() {}
+
+func NewGroupByItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByItemContext {
+	var p = new(GroupByItemContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_groupByItem
+
+	return p
+}
+
+func (s *GroupByItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByItemContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *GroupByItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupByItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupByItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitGroupByItem(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) GroupByItem() (localctx IGroupByItemContext) {
+	localctx = NewGroupByItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, MiniQLParserRULE_groupByItem)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(869)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IOrderByItemContext is an interface to support dynamic dispatch.
+type IOrderByItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expression() IExpressionContext
+	ASC() antlr.TerminalNode
+	DESC() antlr.TerminalNode
+
+	// IsOrderByItemContext differentiates from other interfaces.
+	IsOrderByItemContext()
+}
+
+type OrderByItemContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrderByItemContext() *OrderByItemContext {
+	var p = new(OrderByItemContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_orderByItem
+	return p
+}
+
+func InitEmptyOrderByItemContext(p *OrderByItemContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_orderByItem
+}
+
+func (*OrderByItemContext) IsOrderByItemContext() {}
+
+func NewOrderByItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByItemContext {
+	var p = new(OrderByItemContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_orderByItem
+
+	return p
+}
+
+func (s *OrderByItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrderByItemContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *OrderByItemContext) ASC() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserASC, 0)
+}
+
+func (s *OrderByItemContext) DESC() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDESC, 0)
+}
+
+func (s *OrderByItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrderByItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OrderByItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitOrderByItem(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) OrderByItem() (localctx IOrderByItemContext) {
+	localctx = NewOrderByItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, MiniQLParserRULE_orderByItem)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(871)
+		p.expression(0)
+	}
+	p.SetState(873)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 84, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(872)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserASC || _la == MiniQLParserDESC) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFunctionCallContext is an interface to support dynamic dispatch.
+type IFunctionCallContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	LEFT_PAREN() antlr.TerminalNode
+	RIGHT_PAREN() antlr.TerminalNode
+	ASTERISK() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsFunctionCallContext differentiates from other interfaces.
+	IsFunctionCallContext()
+}
+
+type FunctionCallContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionCallContext() *FunctionCallContext {
+	var p = new(FunctionCallContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_functionCall
+	return p
+}
+
+func InitEmptyFunctionCallContext(p *FunctionCallContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_functionCall
+}
+
+func (*FunctionCallContext) IsFunctionCallContext() {}
+
+func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
+	var p = new(FunctionCallContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_functionCall
+
+	return p
+}
+
+func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionCallContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *FunctionCallContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *FunctionCallContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *FunctionCallContext) ASTERISK() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserASTERISK, 0)
+}
+
+func (s *FunctionCallContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionCallContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *FunctionCallContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *FunctionCallContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitFunctionCall(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) FunctionCall() (localctx IFunctionCallContext) {
+	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, MiniQLParserRULE_functionCall)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(875)
+		p.Identifier()
+	}
+	{
+		p.SetState(876)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(886)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserASTERISK:
+		{
+			p.SetState(877)
+			p.Match(MiniQLParserASTERISK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserLEFT_PAREN, MiniQLParserIDENTIFIER, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL, MiniQLParserPARAM:
+		{
+			p.SetState(878)
+			p.expression(0)
+		}
+		p.SetState(883)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(879)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(880)
+				p.expression(0)
+			}
+
+			p.SetState(885)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case MiniQLParserRIGHT_PAREN:
+
+	default:
+	}
+	{
+		p.SetState(888)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPartitionMethodContext is an interface to support dynamic dispatch.
+type IPartitionMethodContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	HASH() antlr.TerminalNode
+	LEFT_PAREN() antlr.TerminalNode
+	IdentifierList() IIdentifierListContext
+	RIGHT_PAREN() antlr.TerminalNode
+	PARTITIONS() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	RANGE() antlr.TerminalNode
+	PartitionDefinitions() IPartitionDefinitionsContext
+	LIST() antlr.TerminalNode
+
+	// IsPartitionMethodContext differentiates from other interfaces.
+	IsPartitionMethodContext()
+}
+
+type PartitionMethodContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPartitionMethodContext() *PartitionMethodContext {
+	var p = new(PartitionMethodContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionMethod
+	return p
+}
+
+func InitEmptyPartitionMethodContext(p *PartitionMethodContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionMethod
+}
+
+func (*PartitionMethodContext) IsPartitionMethodContext() {}
+
+func NewPartitionMethodContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionMethodContext {
+	var p = new(PartitionMethodContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_partitionMethod
+
+	return p
+}
+
+func (s *PartitionMethodContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PartitionMethodContext) HASH() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserHASH, 0)
+}
+
+func (s *PartitionMethodContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *PartitionMethodContext) IdentifierList() IIdentifierListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *PartitionMethodContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *PartitionMethodContext) PARTITIONS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARTITIONS, 0)
+}
+
+func (s *PartitionMethodContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *PartitionMethodContext) RANGE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRANGE, 0)
+}
+
+func (s *PartitionMethodContext) PartitionDefinitions() IPartitionDefinitionsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPartitionDefinitionsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionDefinitionsContext)
+}
+
+func (s *PartitionMethodContext) LIST() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLIST, 0)
+}
+
+func (s *PartitionMethodContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PartitionMethodContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PartitionMethodContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPartitionMethod(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PartitionMethod() (localctx IPartitionMethodContext) {
+	localctx = NewPartitionMethodContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, MiniQLParserRULE_partitionMethod)
+	var _la int
+
+	p.SetState(912)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserHASH:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(890)
+			p.Match(MiniQLParserHASH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(891)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(892)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(893)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(896)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserPARTITIONS {
+			{
+				p.SetState(894)
+				p.Match(MiniQLParserPARTITIONS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(895)
+				p.Match(MiniQLParserINTEGER_LITERAL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	case MiniQLParserRANGE:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(898)
+			p.Match(MiniQLParserRANGE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(899)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(900)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(901)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(903)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserLEFT_PAREN {
+			{
+				p.SetState(902)
+				p.PartitionDefinitions()
+			}
+
+		}
+
+	case MiniQLParserLIST:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(905)
+			p.Match(MiniQLParserLIST)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(906)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(907)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(908)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(910)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserLEFT_PAREN {
+			{
+				p.SetState(909)
+				p.PartitionDefinitions()
+			}
+
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPartitionDefinitionsContext is an interface to support dynamic dispatch.
+type IPartitionDefinitionsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LEFT_PAREN() antlr.TerminalNode
+	AllPartitionDefinition() []IPartitionDefinitionContext
+	PartitionDefinition(i int) IPartitionDefinitionContext
+	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsPartitionDefinitionsContext differentiates from other interfaces.
+	IsPartitionDefinitionsContext()
+}
+
+type PartitionDefinitionsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPartitionDefinitionsContext() *PartitionDefinitionsContext {
+	var p = new(PartitionDefinitionsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionDefinitions
+	return p
+}
+
+func InitEmptyPartitionDefinitionsContext(p *PartitionDefinitionsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionDefinitions
+}
+
+func (*PartitionDefinitionsContext) IsPartitionDefinitionsContext() {}
+
+func NewPartitionDefinitionsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionDefinitionsContext {
+	var p = new(PartitionDefinitionsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_partitionDefinitions
+
+	return p
+}
+
+func (s *PartitionDefinitionsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PartitionDefinitionsContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *PartitionDefinitionsContext) AllPartitionDefinition() []IPartitionDefinitionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPartitionDefinitionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IPartitionDefinitionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPartitionDefinitionContext); ok {
+			tst[i] = t.(IPartitionDefinitionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *PartitionDefinitionsContext) PartitionDefinition(i int) IPartitionDefinitionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPartitionDefinitionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionDefinitionContext)
+}
+
+func (s *PartitionDefinitionsContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *PartitionDefinitionsContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *PartitionDefinitionsContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *PartitionDefinitionsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PartitionDefinitionsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PartitionDefinitionsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPartitionDefinitions(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PartitionDefinitions() (localctx IPartitionDefinitionsContext) {
+	localctx = NewPartitionDefinitionsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, MiniQLParserRULE_partitionDefinitions)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(914)
+		p.Match(MiniQLParserLEFT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(915)
+		p.PartitionDefinition()
+	}
+	p.SetState(920)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(916)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(917)
+			p.PartitionDefinition()
+		}
+
+		p.SetState(922)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(923)
+		p.Match(MiniQLParserRIGHT_PAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPartitionDefinitionContext is an interface to support dynamic dispatch.
+type IPartitionDefinitionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PARTITION() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	VALUES() antlr.TerminalNode
+	LESS_KW() antlr.TerminalNode
+	THAN() antlr.TerminalNode
+	LEFT_PAREN() antlr.TerminalNode
+	PartitionBound() IPartitionBoundContext
+	RIGHT_PAREN() antlr.TerminalNode
+	IN() antlr.TerminalNode
+	AllSignedLiteral() []ISignedLiteralContext
+	SignedLiteral(i int) ISignedLiteralContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsPartitionDefinitionContext differentiates from other interfaces.
+	IsPartitionDefinitionContext()
+}
+
+type PartitionDefinitionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPartitionDefinitionContext() *PartitionDefinitionContext {
+	var p = new(PartitionDefinitionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionDefinition
+	return p
+}
+
+func InitEmptyPartitionDefinitionContext(p *PartitionDefinitionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionDefinition
+}
+
+func (*PartitionDefinitionContext) IsPartitionDefinitionContext() {}
+
+func NewPartitionDefinitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionDefinitionContext {
+	var p = new(PartitionDefinitionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_partitionDefinition
+
+	return p
+}
+
+func (s *PartitionDefinitionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PartitionDefinitionContext) PARTITION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARTITION, 0)
+}
+
+func (s *PartitionDefinitionContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *PartitionDefinitionContext) VALUES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVALUES, 0)
+}
+
+func (s *PartitionDefinitionContext) LESS_KW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLESS_KW, 0)
+}
+
+func (s *PartitionDefinitionContext) THAN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTHAN, 0)
+}
+
+func (s *PartitionDefinitionContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *PartitionDefinitionContext) PartitionBound() IPartitionBoundContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPartitionBoundContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionBoundContext)
+}
+
+func (s *PartitionDefinitionContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *PartitionDefinitionContext) IN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIN, 0)
+}
+
+func (s *PartitionDefinitionContext) AllSignedLiteral() []ISignedLiteralContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISignedLiteralContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISignedLiteralContext); ok {
+			tst[i] = t.(ISignedLiteralContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *PartitionDefinitionContext) SignedLiteral(i int) ISignedLiteralContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedLiteralContext)
+}
+
+func (s *PartitionDefinitionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *PartitionDefinitionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *PartitionDefinitionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PartitionDefinitionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PartitionDefinitionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPartitionDefinition(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PartitionDefinition() (localctx IPartitionDefinitionContext) {
+	localctx = NewPartitionDefinitionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, MiniQLParserRULE_partitionDefinition)
+	var _la int
+
+	p.SetState(952)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(925)
+			p.Match(MiniQLParserPARTITION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(926)
+			p.Identifier()
+		}
+		{
+			p.SetState(927)
+			p.Match(MiniQLParserVALUES)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(928)
+			p.Match(MiniQLParserLESS_KW)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(929)
+			p.Match(MiniQLParserTHAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(935)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case MiniQLParserLEFT_PAREN:
+			{
+				p.SetState(930)
+				p.Match(MiniQLParserLEFT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(931)
+				p.PartitionBound()
+			}
+			{
+				p.SetState(932)
+				p.Match(MiniQLParserRIGHT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserMAXVALUE, MiniQLParserPLUS, MiniQLParserMINUS, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+			{
+				p.SetState(934)
+				p.PartitionBound()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(937)
+			p.Match(MiniQLParserPARTITION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(938)
+			p.Identifier()
+		}
+		{
+			p.SetState(939)
+			p.Match(MiniQLParserVALUES)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(940)
+			p.Match(MiniQLParserIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(941)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(942)
+			p.SignedLiteral()
+		}
+		p.SetState(947)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(943)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(944)
+				p.SignedLiteral()
+			}
+
+			p.SetState(949)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(950)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPartitionBoundContext is an interface to support dynamic dispatch.
+type IPartitionBoundContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MAXVALUE() antlr.TerminalNode
+	SignedLiteral() ISignedLiteralContext
+
+	// IsPartitionBoundContext differentiates from other interfaces.
+	IsPartitionBoundContext()
+}
+
+type PartitionBoundContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPartitionBoundContext() *PartitionBoundContext {
+	var p = new(PartitionBoundContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionBound
+	return p
+}
+
+func InitEmptyPartitionBoundContext(p *PartitionBoundContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_partitionBound
+}
+
+func (*PartitionBoundContext) IsPartitionBoundContext() {}
+
+func NewPartitionBoundContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionBoundContext {
+	var p = new(PartitionBoundContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_partitionBound
+
+	return p
+}
+
+func (s *PartitionBoundContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PartitionBoundContext) MAXVALUE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserMAXVALUE, 0)
+}
+
+func (s *PartitionBoundContext) SignedLiteral() ISignedLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedLiteralContext)
+}
+
+func (s *PartitionBoundContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PartitionBoundContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PartitionBoundContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPartitionBound(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) PartitionBound() (localctx IPartitionBoundContext) {
+	localctx = NewPartitionBoundContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, MiniQLParserRULE_partitionBound)
+	p.SetState(956)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserMAXVALUE:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(954)
+			p.Match(MiniQLParserMAXVALUE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserPLUS, MiniQLParserMINUS, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(955)
+			p.SignedLiteral()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITransactionStatementContext is an interface to support dynamic dispatch.
+type ITransactionStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	START() antlr.TerminalNode
+	TRANSACTION() antlr.TerminalNode
+	COMMIT() antlr.TerminalNode
+	ROLLBACK() antlr.TerminalNode
+
+	// IsTransactionStatementContext differentiates from other interfaces.
+	IsTransactionStatementContext()
+}
+
+type TransactionStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTransactionStatementContext() *TransactionStatementContext {
+	var p = new(TransactionStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_transactionStatement
+	return p
+}
+
+func InitEmptyTransactionStatementContext(p *TransactionStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_transactionStatement
+}
+
+func (*TransactionStatementContext) IsTransactionStatementContext() {}
+
+func NewTransactionStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionStatementContext {
+	var p = new(TransactionStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_transactionStatement
+
+	return p
+}
+
+func (s *TransactionStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TransactionStatementContext) START() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTART, 0)
+}
+
+func (s *TransactionStatementContext) TRANSACTION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTRANSACTION, 0)
+}
+
+func (s *TransactionStatementContext) COMMIT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMIT, 0)
+}
+
+func (s *TransactionStatementContext) ROLLBACK() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserROLLBACK, 0)
+}
+
+func (s *TransactionStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TransactionStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TransactionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitTransactionStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) TransactionStatement() (localctx ITransactionStatementContext) {
+	localctx = NewTransactionStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, MiniQLParserRULE_transactionStatement)
+	p.SetState(962)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserSTART:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(958)
+			p.Match(MiniQLParserSTART)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(959)
+			p.Match(MiniQLParserTRANSACTION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserCOMMIT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(960)
+			p.Match(MiniQLParserCOMMIT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserROLLBACK:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(961)
+			p.Match(MiniQLParserROLLBACK)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUseStatementContext is an interface to support dynamic dispatch.
+type IUseStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	USE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+
+	// IsUseStatementContext differentiates from other interfaces.
+	IsUseStatementContext()
+}
+
+type UseStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUseStatementContext() *UseStatementContext {
+	var p = new(UseStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_useStatement
+	return p
+}
+
+func InitEmptyUseStatementContext(p *UseStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_useStatement
+}
+
+func (*UseStatementContext) IsUseStatementContext() {}
+
+func NewUseStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStatementContext {
+	var p = new(UseStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_useStatement
+
+	return p
+}
+
+func (s *UseStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UseStatementContext) USE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUSE, 0)
+}
+
+func (s *UseStatementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *UseStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UseStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UseStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitUseStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) UseStatement() (localctx IUseStatementContext) {
+	localctx = NewUseStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, MiniQLParserRULE_useStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(964)
+		p.Match(MiniQLParserUSE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(965)
+		p.Identifier()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowDatabasesContext is an interface to support dynamic dispatch.
+type IShowDatabasesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SHOW() antlr.TerminalNode
+	DATABASES() antlr.TerminalNode
+
+	// IsShowDatabasesContext differentiates from other interfaces.
+	IsShowDatabasesContext()
+}
+
+type ShowDatabasesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowDatabasesContext() *ShowDatabasesContext {
+	var p = new(ShowDatabasesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showDatabases
+	return p
+}
+
+func InitEmptyShowDatabasesContext(p *ShowDatabasesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showDatabases
+}
+
+func (*ShowDatabasesContext) IsShowDatabasesContext() {}
+
+func NewShowDatabasesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabasesContext {
+	var p = new(ShowDatabasesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_showDatabases
+
+	return p
+}
+
+func (s *ShowDatabasesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowDatabasesContext) SHOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHOW, 0)
+}
+
+func (s *ShowDatabasesContext) DATABASES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDATABASES, 0)
+}
+
+func (s *ShowDatabasesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowDatabasesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowDatabasesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitShowDatabases(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ShowDatabases() (localctx IShowDatabasesContext) {
+	localctx = NewShowDatabasesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, MiniQLParserRULE_showDatabases)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(967)
+		p.Match(MiniQLParserSHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(968)
+		p.Match(MiniQLParserDATABASES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowTablesContext is an interface to support dynamic dispatch.
+type IShowTablesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SHOW() antlr.TerminalNode
+	TABLES() antlr.TerminalNode
+
+	// IsShowTablesContext differentiates from other interfaces.
+	IsShowTablesContext()
+}
+
+type ShowTablesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowTablesContext() *ShowTablesContext {
+	var p = new(ShowTablesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showTables
+	return p
+}
+
+func InitEmptyShowTablesContext(p *ShowTablesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showTables
+}
+
+func (*ShowTablesContext) IsShowTablesContext() {}
+
+func NewShowTablesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTablesContext {
+	var p = new(ShowTablesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_showTables
+
+	return p
+}
+
+func (s *ShowTablesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTablesContext) SHOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHOW, 0)
+}
+
+func (s *ShowTablesContext) TABLES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLES, 0)
+}
+
+func (s *ShowTablesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowTablesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowTablesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitShowTables(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ShowTables() (localctx IShowTablesContext) {
+	localctx = NewShowTablesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, MiniQLParserRULE_showTables)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(970)
+		p.Match(MiniQLParserSHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(971)
+		p.Match(MiniQLParserTABLES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowIndexesContext is an interface to support dynamic dispatch.
+type IShowIndexesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SHOW() antlr.TerminalNode
+	INDEXES() antlr.TerminalNode
+	TableName() ITableNameContext
+	ON() antlr.TerminalNode
+	FROM() antlr.TerminalNode
+
+	// IsShowIndexesContext differentiates from other interfaces.
+	IsShowIndexesContext()
+}
+
+type ShowIndexesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowIndexesContext() *ShowIndexesContext {
+	var p = new(ShowIndexesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showIndexes
+	return p
+}
+
+func InitEmptyShowIndexesContext(p *ShowIndexesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showIndexes
+}
+
+func (*ShowIndexesContext) IsShowIndexesContext() {}
+
+func NewShowIndexesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowIndexesContext {
+	var p = new(ShowIndexesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_showIndexes
+
+	return p
+}
+
+func (s *ShowIndexesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowIndexesContext) SHOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHOW, 0)
+}
+
+func (s *ShowIndexesContext) INDEXES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINDEXES, 0)
+}
+
+func (s *ShowIndexesContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *ShowIndexesContext) ON() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserON, 0)
+}
+
+func (s *ShowIndexesContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *ShowIndexesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowIndexesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowIndexesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitShowIndexes(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ShowIndexes() (localctx IShowIndexesContext) {
+	localctx = NewShowIndexesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, MiniQLParserRULE_showIndexes)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(973)
+		p.Match(MiniQLParserSHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(974)
+		p.Match(MiniQLParserINDEXES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(975)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == MiniQLParserFROM || _la == MiniQLParserON) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(976)
+		p.TableName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowCreateTableContext is an interface to support dynamic dispatch.
+type IShowCreateTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SHOW() antlr.TerminalNode
+	CREATE() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+
+	// IsShowCreateTableContext differentiates from other interfaces.
+	IsShowCreateTableContext()
+}
+
+type ShowCreateTableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowCreateTableContext() *ShowCreateTableContext {
+	var p = new(ShowCreateTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showCreateTable
+	return p
+}
+
+func InitEmptyShowCreateTableContext(p *ShowCreateTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showCreateTable
+}
+
+func (*ShowCreateTableContext) IsShowCreateTableContext() {}
+
+func NewShowCreateTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowCreateTableContext {
+	var p = new(ShowCreateTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_showCreateTable
+
+	return p
+}
+
+func (s *ShowCreateTableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowCreateTableContext) SHOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHOW, 0)
+}
+
+func (s *ShowCreateTableContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCREATE, 0)
+}
+
+func (s *ShowCreateTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *ShowCreateTableContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *ShowCreateTableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowCreateTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowCreateTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitShowCreateTable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ShowCreateTable() (localctx IShowCreateTableContext) {
+	localctx = NewShowCreateTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, MiniQLParserRULE_showCreateTable)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(978)
+		p.Match(MiniQLParserSHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(979)
+		p.Match(MiniQLParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(980)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(981)
+		p.TableName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDescribeTableContext is an interface to support dynamic dispatch.
+type IDescribeTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TableName() ITableNameContext
+	DESCRIBE() antlr.TerminalNode
+	DESC() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	EXTENDED() antlr.TerminalNode
+
+	// IsDescribeTableContext differentiates from other interfaces.
+	IsDescribeTableContext()
+}
+
+type DescribeTableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDescribeTableContext() *DescribeTableContext {
+	var p = new(DescribeTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_describeTable
+	return p
+}
+
+func InitEmptyDescribeTableContext(p *DescribeTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_describeTable
+}
+
+func (*DescribeTableContext) IsDescribeTableContext() {}
+
+func NewDescribeTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DescribeTableContext {
+	var p = new(DescribeTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_describeTable
+
+	return p
+}
+
+func (s *DescribeTableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DescribeTableContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *DescribeTableContext) DESCRIBE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDESCRIBE, 0)
+}
+
+func (s *DescribeTableContext) DESC() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDESC, 0)
+}
+
+func (s *DescribeTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *DescribeTableContext) EXTENDED() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXTENDED, 0)
+}
+
+func (s *DescribeTableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DescribeTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DescribeTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitDescribeTable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) DescribeTable() (localctx IDescribeTableContext) {
+	localctx = NewDescribeTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, MiniQLParserRULE_describeTable)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(983)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == MiniQLParserDESC || _la == MiniQLParserDESCRIBE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(985)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserTABLE {
+		{
+			p.SetState(984)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	p.SetState(988)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 98, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(987)
+			p.Match(MiniQLParserEXTENDED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(990)
+		p.TableName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExplainStatementContext is an interface to support dynamic dispatch.
+type IExplainStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EXPLAIN() antlr.TerminalNode
+	SelectStatement() ISelectStatementContext
+	ANALYZE() antlr.TerminalNode
+	LEFT_PAREN() antlr.TerminalNode
+	AllExplainOption() []IExplainOptionContext
+	ExplainOption(i int) IExplainOptionContext
+	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsExplainStatementContext differentiates from other interfaces.
+	IsExplainStatementContext()
+}
+
+type ExplainStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExplainStatementContext() *ExplainStatementContext {
+	var p = new(ExplainStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_explainStatement
+	return p
+}
+
+func InitEmptyExplainStatementContext(p *ExplainStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_explainStatement
+}
+
+func (*ExplainStatementContext) IsExplainStatementContext() {}
+
+func NewExplainStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExplainStatementContext {
+	var p = new(ExplainStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_explainStatement
+
+	return p
+}
+
+func (s *ExplainStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExplainStatementContext) EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXPLAIN, 0)
+}
+
+func (s *ExplainStatementContext) SelectStatement() ISelectStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectStatementContext)
+}
+
+func (s *ExplainStatementContext) ANALYZE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserANALYZE, 0)
+}
+
+func (s *ExplainStatementContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *ExplainStatementContext) AllExplainOption() []IExplainOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExplainOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExplainOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExplainOptionContext); ok {
+			tst[i] = t.(IExplainOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExplainStatementContext) ExplainOption(i int) IExplainOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExplainOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExplainOptionContext)
+}
+
+func (s *ExplainStatementContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *ExplainStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *ExplainStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *ExplainStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExplainStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExplainStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitExplainStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ExplainStatement() (localctx IExplainStatementContext) {
+	localctx = NewExplainStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, MiniQLParserRULE_explainStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(992)
+		p.Match(MiniQLParserEXPLAIN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1005)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserANALYZE:
+		{
+			p.SetState(993)
+			p.Match(MiniQLParserANALYZE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserLEFT_PAREN:
+		{
+			p.SetState(994)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(995)
+			p.ExplainOption()
+		}
+		p.SetState(1000)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(996)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(997)
+				p.ExplainOption()
+			}
+
+			p.SetState(1002)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1003)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserSELECT:
+
+	default:
+	}
+	{
+		p.SetState(1007)
+		p.SelectStatement()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExplainOptionContext is an interface to support dynamic dispatch.
+type IExplainOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ANALYZE() antlr.TerminalNode
+	TRUE() antlr.TerminalNode
+	FALSE() antlr.TerminalNode
+	FORMAT() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	VERBOSE() antlr.TerminalNode
+
+	// IsExplainOptionContext differentiates from other interfaces.
+	IsExplainOptionContext()
+}
+
+type ExplainOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExplainOptionContext() *ExplainOptionContext {
+	var p = new(ExplainOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_explainOption
+	return p
+}
+
+func InitEmptyExplainOptionContext(p *ExplainOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_explainOption
+}
+
+func (*ExplainOptionContext) IsExplainOptionContext() {}
+
+func NewExplainOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExplainOptionContext {
+	var p = new(ExplainOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_explainOption
+
+	return p
+}
+
+func (s *ExplainOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExplainOptionContext) ANALYZE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserANALYZE, 0)
+}
+
+func (s *ExplainOptionContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTRUE, 0)
+}
+
+func (s *ExplainOptionContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFALSE, 0)
+}
+
+func (s *ExplainOptionContext) FORMAT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFORMAT, 0)
+}
+
+func (s *ExplainOptionContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *ExplainOptionContext) VERBOSE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVERBOSE, 0)
+}
+
+func (s *ExplainOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExplainOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExplainOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitExplainOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ExplainOption() (localctx IExplainOptionContext) {
+	localctx = NewExplainOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, MiniQLParserRULE_explainOption)
+	var _la int
+
+	p.SetState(1017)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 102, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1009)
+			p.Match(MiniQLParserANALYZE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(1011)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == MiniQLParserTRUE || _la == MiniQLParserFALSE {
+			{
+				p.SetState(1010)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == MiniQLParserTRUE || _la == MiniQLParserFALSE) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1013)
+			p.Match(MiniQLParserFORMAT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1014)
+			p.Identifier()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1015)
+			p.Match(MiniQLParserVERBOSE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1016)
+			p.Identifier()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAnalyzeStatementContext is an interface to support dynamic dispatch.
+type IAnalyzeStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ANALYZE() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	LEFT_PAREN() antlr.TerminalNode
+	ColumnList() IColumnListContext
+	RIGHT_PAREN() antlr.TerminalNode
+
+	// IsAnalyzeStatementContext differentiates from other interfaces.
+	IsAnalyzeStatementContext()
+}
+
+type AnalyzeStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAnalyzeStatementContext() *AnalyzeStatementContext {
+	var p = new(AnalyzeStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+	return p
+}
+
+func InitEmptyAnalyzeStatementContext(p *AnalyzeStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+}
+
+func (*AnalyzeStatementContext) IsAnalyzeStatementContext() {}
+
+func NewAnalyzeStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnalyzeStatementContext {
+	var p = new(AnalyzeStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+
+	return p
+}
+
+func (s *AnalyzeStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AnalyzeStatementContext) ANALYZE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserANALYZE, 0)
+}
+
+func (s *AnalyzeStatementContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *AnalyzeStatementContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
+}
+
+func (s *AnalyzeStatementContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *AnalyzeStatementContext) ColumnList() IColumnListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IColumnListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnListContext)
+}
+
+func (s *AnalyzeStatementContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
+
+func (s *AnalyzeStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AnalyzeStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AnalyzeStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitAnalyzeStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) AnalyzeStatement() (localctx IAnalyzeStatementContext) {
+	localctx = NewAnalyzeStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, MiniQLParserRULE_analyzeStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1019)
+		p.Match(MiniQLParserANALYZE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1020)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1021)
+		p.TableName()
+	}
+	p.SetState(1026)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserLEFT_PAREN {
+		{
+			p.SetState(1022)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1023)
+			p.ColumnList()
+		}
+		{
+			p.SetState(1024)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IColumnListContext is an interface to support dynamic dispatch.
+type IColumnListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsColumnListContext differentiates from other interfaces.
+	IsColumnListContext()
+}
+
+type ColumnListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyColumnListContext() *ColumnListContext {
+	var p = new(ColumnListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnList
+	return p
+}
+
+func InitEmptyColumnListContext(p *ColumnListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_columnList
+}
+
+func (*ColumnListContext) IsColumnListContext() {}
+
+func NewColumnListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnListContext {
+	var p = new(ColumnListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_columnList
+
+	return p
+}
+
+func (s *ColumnListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ColumnListContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ColumnListContext) Identifier(i int) IIdentifierContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *ColumnListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *ColumnListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *ColumnListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ColumnListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ColumnListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitColumnList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ColumnList() (localctx IColumnListContext) {
+	localctx = NewColumnListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, MiniQLParserRULE_columnList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1028)
+		p.Identifier()
+	}
+	p.SetState(1033)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(1029)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1030)
+			p.Identifier()
+		}
+
+		p.SetState(1035)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISetStatementContext is an interface to support dynamic dispatch.
+type ISetStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SET() antlr.TerminalNode
+	TIME() antlr.TerminalNode
+	ZONE() antlr.TerminalNode
+	VariableName() IVariableNameContext
+	DEFAULT() antlr.TerminalNode
+	SetValue() ISetValueContext
+	EQUAL() antlr.TerminalNode
+	TO() antlr.TerminalNode
+
+	// IsSetStatementContext differentiates from other interfaces.
+	IsSetStatementContext()
+}
+
+type SetStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySetStatementContext() *SetStatementContext {
+	var p = new(SetStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_setStatement
+	return p
+}
+
+func InitEmptySetStatementContext(p *SetStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_setStatement
+}
+
+func (*SetStatementContext) IsSetStatementContext() {}
+
+func NewSetStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetStatementContext {
+	var p = new(SetStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_setStatement
+
+	return p
+}
+
+func (s *SetStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SetStatementContext) SET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSET, 0)
+}
+
+func (s *SetStatementContext) TIME() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTIME, 0)
+}
+
+func (s *SetStatementContext) ZONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserZONE, 0)
+}
+
+func (s *SetStatementContext) VariableName() IVariableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVariableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableNameContext)
+}
+
+func (s *SetStatementContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEFAULT, 0)
+}
+
+func (s *SetStatementContext) SetValue() ISetValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetValueContext)
+}
+
+func (s *SetStatementContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEQUAL, 0)
+}
+
+func (s *SetStatementContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
+}
+
+func (s *SetStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SetStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SetStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitSetStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) SetStatement() (localctx ISetStatementContext) {
+	localctx = NewSetStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, MiniQLParserRULE_setStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1036)
+		p.Match(MiniQLParserSET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1042)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 105, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(1037)
+			p.Match(MiniQLParserTIME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1038)
+			p.Match(MiniQLParserZONE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(1039)
+			p.VariableName()
+		}
+		{
+			p.SetState(1040)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserTO || _la == MiniQLParserEQUAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.SetState(1046)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserDEFAULT:
+		{
+			p.SetState(1044)
+			p.Match(MiniQLParserDEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case MiniQLParserTABLE, MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserON, MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserPLUS, MiniQLParserMINUS, MiniQLParserIDENTIFIER, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		{
+			p.SetState(1045)
+			p.SetValue()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowVariableContext is an interface to support dynamic dispatch.
+type IShowVariableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SHOW() antlr.TerminalNode
+	TIME() antlr.TerminalNode
+	ZONE() antlr.TerminalNode
+	ALL() antlr.TerminalNode
+	VariableName() IVariableNameContext
+
+	// IsShowVariableContext differentiates from other interfaces.
+	IsShowVariableContext()
+}
+
+type ShowVariableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowVariableContext() *ShowVariableContext {
+	var p = new(ShowVariableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showVariable
+	return p
+}
+
+func InitEmptyShowVariableContext(p *ShowVariableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_showVariable
+}
+
+func (*ShowVariableContext) IsShowVariableContext() {}
+
+func NewShowVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowVariableContext {
+	var p = new(ShowVariableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_showVariable
+
+	return p
+}
+
+func (s *ShowVariableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowVariableContext) SHOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHOW, 0)
+}
+
+func (s *ShowVariableContext) TIME() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTIME, 0)
+}
+
+func (s *ShowVariableContext) ZONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserZONE, 0)
+}
+
+func (s *ShowVariableContext) ALL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserALL, 0)
+}
+
+func (s *ShowVariableContext) VariableName() IVariableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVariableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableNameContext)
+}
+
+func (s *ShowVariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitShowVariable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ShowVariable() (localctx IShowVariableContext) {
+	localctx = NewShowVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, MiniQLParserRULE_showVariable)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1048)
+		p.Match(MiniQLParserSHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1053)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 107, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(1049)
+			p.Match(MiniQLParserTIME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1050)
+			p.Match(MiniQLParserZONE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(1051)
+			p.Match(MiniQLParserALL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		{
+			p.SetState(1052)
+			p.VariableName()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IResetStatementContext is an interface to support dynamic dispatch.
+type IResetStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	RESET() antlr.TerminalNode
+	TIME() antlr.TerminalNode
+	ZONE() antlr.TerminalNode
+	ALL() antlr.TerminalNode
+	VariableName() IVariableNameContext
+
+	// IsResetStatementContext differentiates from other interfaces.
+	IsResetStatementContext()
+}
+
+type ResetStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyResetStatementContext() *ResetStatementContext {
+	var p = new(ResetStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_resetStatement
+	return p
+}
+
+func InitEmptyResetStatementContext(p *ResetStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_resetStatement
+}
+
+func (*ResetStatementContext) IsResetStatementContext() {}
+
+func NewResetStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ResetStatementContext {
+	var p = new(ResetStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_resetStatement
+
+	return p
+}
+
+func (s *ResetStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ResetStatementContext) RESET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRESET, 0)
+}
+
+func (s *ResetStatementContext) TIME() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTIME, 0)
+}
+
+func (s *ResetStatementContext) ZONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserZONE, 0)
+}
+
+func (s *ResetStatementContext) ALL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserALL, 0)
+}
+
+func (s *ResetStatementContext) VariableName() IVariableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVariableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableNameContext)
+}
+
+func (s *ResetStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ResetStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ResetStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitResetStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) ResetStatement() (localctx IResetStatementContext) {
+	localctx = NewResetStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, MiniQLParserRULE_resetStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1055)
+		p.Match(MiniQLParserRESET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1060)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 108, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(1056)
+			p.Match(MiniQLParserTIME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1057)
+			p.Match(MiniQLParserZONE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(1058)
+			p.Match(MiniQLParserALL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		{
+			p.SetState(1059)
+			p.VariableName()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IVariableNameContext is an interface to support dynamic dispatch.
+type IVariableNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
+
+	// IsVariableNameContext differentiates from other interfaces.
+	IsVariableNameContext()
+}
+
+type VariableNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVariableNameContext() *VariableNameContext {
+	var p = new(VariableNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_variableName
+	return p
+}
+
+func InitEmptyVariableNameContext(p *VariableNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_variableName
+}
+
+func (*VariableNameContext) IsVariableNameContext() {}
+
+func NewVariableNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableNameContext {
+	var p = new(VariableNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_variableName
+
+	return p
+}
+
+func (s *VariableNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VariableNameContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *VariableNameContext) Identifier(i int) IIdentifierContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *VariableNameContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserDOT)
+}
+
+func (s *VariableNameContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, i)
+}
+
+func (s *VariableNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VariableNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitVariableName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) VariableName() (localctx IVariableNameContext) {
+	localctx = NewVariableNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, MiniQLParserRULE_variableName)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1062)
+		p.Identifier()
+	}
+	p.SetState(1067)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserDOT {
+		{
+			p.SetState(1063)
+			p.Match(MiniQLParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1064)
+			p.Identifier()
+		}
+
+		p.SetState(1069)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPrepareStatementContext is an interface to support dynamic dispatch.
+type IPrepareStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PREPARE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	AS() antlr.TerminalNode
+	DqlStatement() IDqlStatementContext
+	DmlStatement() IDmlStatementContext
+	DdlStatement() IDdlStatementContext
+	LEFT_PAREN() antlr.TerminalNode
+	AllParameterType() []IParameterTypeContext
+	ParameterType(i int) IParameterTypeContext
+	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsPrepareStatementContext differentiates from other interfaces.
+	IsPrepareStatementContext()
+}
+
+type PrepareStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrepareStatementContext() *PrepareStatementContext {
+	var p = new(PrepareStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_prepareStatement
+	return p
+}
+
+func InitEmptyPrepareStatementContext(p *PrepareStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_prepareStatement
+}
+
+func (*PrepareStatementContext) IsPrepareStatementContext() {}
+
+func NewPrepareStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrepareStatementContext {
+	var p = new(PrepareStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_prepareStatement
+
+	return p
+}
+
+func (s *PrepareStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrepareStatementContext) PREPARE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPREPARE, 0)
+}
+
+func (s *PrepareStatementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *PrepareStatementContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *PrepareStatementContext) DqlStatement() IDqlStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDqlStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDqlStatementContext)
+}
+
+func (s *PrepareStatementContext) DmlStatement() IDmlStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDmlStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDmlStatementContext)
+}
+
+func (s *PrepareStatementContext) DdlStatement() IDdlStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDdlStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDdlStatementContext)
+}
+
+func (s *PrepareStatementContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(380)
-					_la = p.GetTokenStream().LA(1)
+func (s *PrepareStatementContext) AllParameterType() []IParameterTypeContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IParameterTypeContext); ok {
+			len++
+		}
+	}
 
-					if !(_la == MiniQLParserPLUS || _la == MiniQLParserMINUS) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(381)
-					p.expression(7)
-				}
+	tst := make([]IParameterTypeContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IParameterTypeContext); ok {
+			tst[i] = t.(IParameterTypeContext)
+			i++
+		}
+	}
 
-			case 3:
-				localctx = NewComparisonExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(382)
+	return tst
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(383)
-					p.ComparisonOperator()
-				}
-				{
-					p.SetState(384)
-					p.expression(6)
-				}
+func (s *PrepareStatementContext) ParameterType(i int) IParameterTypeContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParameterTypeContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-			case 4:
-				localctx = NewAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(386)
+	if t == nil {
+		return nil
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(387)
-					p.Match(MiniQLParserAND)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(388)
-					p.expression(5)
-				}
+	return t.(IParameterTypeContext)
+}
 
-			case 5:
-				localctx = NewOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(389)
+func (s *PrepareStatementContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(390)
-					p.Match(MiniQLParserOR)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(391)
-					p.expression(4)
-				}
+func (s *PrepareStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
 
-			case 6:
-				localctx = NewLikeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(392)
+func (s *PrepareStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
-				}
-				p.SetState(394)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
+func (s *PrepareStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				if _la == MiniQLParserNOT {
-					{
-						p.SetState(393)
-						p.Match(MiniQLParserNOT)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
+func (s *PrepareStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-				}
-				{
-					p.SetState(396)
-					p.Match(MiniQLParserLIKE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(397)
-					p.expression(3)
-				}
+func (s *PrepareStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitPrepareStatement(s)
 
-			case 7:
-				localctx = NewInExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, MiniQLParserRULE_expression)
-				p.SetState(398)
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
-					goto errorExit
-				}
-				p.SetState(400)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
+func (p *MiniQLParser) PrepareStatement() (localctx IPrepareStatementContext) {
+	localctx = NewPrepareStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, MiniQLParserRULE_prepareStatement)
+	var _la int
 
-				if _la == MiniQLParserNOT {
-					{
-						p.SetState(399)
-						p.Match(MiniQLParserNOT)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1070)
+		p.Match(MiniQLParserPREPARE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1071)
+		p.Identifier()
+	}
+	p.SetState(1083)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
+	if _la == MiniQLParserLEFT_PAREN {
+		{
+			p.SetState(1072)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1073)
+			p.ParameterType()
+		}
+		p.SetState(1078)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(1074)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
 				}
-				{
-					p.SetState(402)
-					p.Match(MiniQLParserIN)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(403)
-					p.Match(MiniQLParserLEFT_PAREN)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(404)
-					p.ValueList()
-				}
-				{
-					p.SetState(405)
-					p.Match(MiniQLParserRIGHT_PAREN)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
+			}
+			{
+				p.SetState(1075)
+				p.ParameterType()
+			}
 
-			case antlr.ATNInvalidAltNumber:
+			p.SetState(1080)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
 				goto errorExit
 			}
-
+			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(411)
-		p.GetErrorHandler().Sync(p)
+		{
+			p.SetState(1081)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(1085)
+		p.Match(MiniQLParserAS)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+	}
+	p.SetState(1089)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserSELECT:
+		{
+			p.SetState(1086)
+			p.DqlStatement()
+		}
+
+	case MiniQLParserINSERT, MiniQLParserUPDATE, MiniQLParserDELETE:
+		{
+			p.SetState(1087)
+			p.DmlStatement()
+		}
+
+	case MiniQLParserCREATE, MiniQLParserDROP, MiniQLParserALTER, MiniQLParserCOMMENT, MiniQLParserRESTORE:
+		{
+			p.SetState(1088)
+			p.DdlStatement()
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -6724,87 +18272,68 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPrimaryExprContext is an interface to support dynamic dispatch.
-type IPrimaryExprContext interface {
+// IParameterTypeContext is an interface to support dynamic dispatch.
+type IParameterTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsPrimaryExprContext differentiates from other interfaces.
-	IsPrimaryExprContext()
+
+	// Getter signatures
+	DataType() IDataTypeContext
+	Identifier() IIdentifierContext
+	LEFT_PAREN() antlr.TerminalNode
+	AllINTEGER_LITERAL() []antlr.TerminalNode
+	INTEGER_LITERAL(i int) antlr.TerminalNode
+	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsParameterTypeContext differentiates from other interfaces.
+	IsParameterTypeContext()
 }
 
-type PrimaryExprContext struct {
+type ParameterTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrimaryExprContext() *PrimaryExprContext {
-	var p = new(PrimaryExprContext)
+func NewEmptyParameterTypeContext() *ParameterTypeContext {
+	var p = new(ParameterTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_primaryExpr
+	p.RuleIndex = MiniQLParserRULE_parameterType
 	return p
 }
 
-func InitEmptyPrimaryExprContext(p *PrimaryExprContext) {
+func InitEmptyParameterTypeContext(p *ParameterTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_primaryExpr
+	p.RuleIndex = MiniQLParserRULE_parameterType
 }
 
-func (*PrimaryExprContext) IsPrimaryExprContext() {}
+func (*ParameterTypeContext) IsParameterTypeContext() {}
 
-func NewPrimaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryExprContext {
-	var p = new(PrimaryExprContext)
+func NewParameterTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterTypeContext {
+	var p = new(ParameterTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_primaryExpr
-
-	return p
-}
-
-func (s *PrimaryExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PrimaryExprContext) CopyAll(ctx *PrimaryExprContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *PrimaryExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *PrimaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type LiteralExprContext struct {
-	PrimaryExprContext
-}
-
-func NewLiteralExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExprContext {
-	var p = new(LiteralExprContext)
-
-	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PrimaryExprContext))
+	p.RuleIndex = MiniQLParserRULE_parameterType
 
 	return p
 }
 
-func (s *LiteralExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ParameterTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LiteralExprContext) Literal() ILiteralContext {
+func (s *ParameterTypeContext) DataType() IDataTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(IDataTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6814,41 +18343,13 @@ func (s *LiteralExprContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
-}
-
-func (s *LiteralExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitLiteralExpr(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type FunctionCallExprContext struct {
-	PrimaryExprContext
-}
-
-func NewFunctionCallExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionCallExprContext {
-	var p = new(FunctionCallExprContext)
-
-	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PrimaryExprContext))
-
-	return p
-}
-
-func (s *FunctionCallExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IDataTypeContext)
 }
 
-func (s *FunctionCallExprContext) FunctionCall() IFunctionCallContext {
+func (s *ParameterTypeContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionCallContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6858,174 +18359,145 @@ func (s *FunctionCallExprContext) FunctionCall() IFunctionCallContext {
 		return nil
 	}
 
-	return t.(IFunctionCallContext)
-}
-
-func (s *FunctionCallExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitFunctionCallExpr(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type ColumnRefExprContext struct {
-	PrimaryExprContext
+	return t.(IIdentifierContext)
 }
 
-func NewColumnRefExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ColumnRefExprContext {
-	var p = new(ColumnRefExprContext)
-
-	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PrimaryExprContext))
-
-	return p
+func (s *ParameterTypeContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
 }
 
-func (s *ColumnRefExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ParameterTypeContext) AllINTEGER_LITERAL() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserINTEGER_LITERAL)
 }
 
-func (s *ColumnRefExprContext) ColumnRef() IColumnRefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IColumnRefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IColumnRefContext)
+func (s *ParameterTypeContext) INTEGER_LITERAL(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, i)
 }
 
-func (s *ColumnRefExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitColumnRefExpr(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *ParameterTypeContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
 }
 
-type ParenExprContext struct {
-	PrimaryExprContext
+func (s *ParameterTypeContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
 }
 
-func NewParenExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenExprContext {
-	var p = new(ParenExprContext)
-
-	InitEmptyPrimaryExprContext(&p.PrimaryExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PrimaryExprContext))
-
-	return p
+func (s *ParameterTypeContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
 }
 
-func (s *ParenExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ParameterTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParenExprContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
-}
-
-func (s *ParenExprContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
-
-func (s *ParenExprContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+func (s *ParameterTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParenExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ParameterTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitParenExpr(s)
+		return t.VisitParameterType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) PrimaryExpr() (localctx IPrimaryExprContext) {
-	localctx = NewPrimaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, MiniQLParserRULE_primaryExpr)
-	p.SetState(419)
+func (p *MiniQLParser) ParameterType() (localctx IParameterTypeContext) {
+	localctx = NewParameterTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, MiniQLParserRULE_parameterType)
+	var _la int
+
+	p.SetState(1105)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 44, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewLiteralExprContext(p, localctx)
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserINT_TYPE, MiniQLParserINTEGER_TYPE, MiniQLParserVARCHAR_TYPE, MiniQLParserBOOLEAN_TYPE, MiniQLParserDOUBLE_TYPE, MiniQLParserTIMESTAMP_TYPE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(412)
-			p.Literal()
+			p.SetState(1091)
+			p.DataType()
 		}
 
-	case 2:
-		localctx = NewColumnRefExprContext(p, localctx)
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(413)
-			p.ColumnRef()
+			p.SetState(1092)
+			p.Identifier()
 		}
-
-	case 3:
-		localctx = NewFunctionCallExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(414)
-			p.FunctionCall()
+		p.SetState(1103)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-	case 4:
-		localctx = NewParenExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(415)
-			p.Match(MiniQLParserLEFT_PAREN)
+		if _la == MiniQLParserLEFT_PAREN {
+			{
+				p.SetState(1093)
+				p.Match(MiniQLParserLEFT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1094)
+				p.Match(MiniQLParserINTEGER_LITERAL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(1099)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
-		{
-			p.SetState(416)
-			p.expression(0)
-		}
-		{
-			p.SetState(417)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == MiniQLParserCOMMA {
+				{
+					p.SetState(1095)
+					p.Match(MiniQLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(1096)
+					p.Match(MiniQLParserINTEGER_LITERAL)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(1101)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(1102)
+				p.Match(MiniQLParserRIGHT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
 		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -7042,115 +18514,229 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IComparisonOperatorContext is an interface to support dynamic dispatch.
-type IComparisonOperatorContext interface {
+// IExecuteStatementContext is an interface to support dynamic dispatch.
+type IExecuteStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EQUAL() antlr.TerminalNode
-	NOT_EQUAL() antlr.TerminalNode
-	GREATER() antlr.TerminalNode
-	GREATER_EQUAL() antlr.TerminalNode
-	LESS() antlr.TerminalNode
-	LESS_EQUAL() antlr.TerminalNode
+	EXECUTE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	LEFT_PAREN() antlr.TerminalNode
+	AllSignedLiteral() []ISignedLiteralContext
+	SignedLiteral(i int) ISignedLiteralContext
+	RIGHT_PAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsComparisonOperatorContext differentiates from other interfaces.
-	IsComparisonOperatorContext()
+	// IsExecuteStatementContext differentiates from other interfaces.
+	IsExecuteStatementContext()
 }
 
-type ComparisonOperatorContext struct {
+type ExecuteStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewEmptyExecuteStatementContext() *ExecuteStatementContext {
+	var p = new(ExecuteStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+	p.RuleIndex = MiniQLParserRULE_executeStatement
 	return p
 }
 
-func InitEmptyComparisonOperatorContext(p *ComparisonOperatorContext) {
+func InitEmptyExecuteStatementContext(p *ExecuteStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+	p.RuleIndex = MiniQLParserRULE_executeStatement
 }
 
-func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+func (*ExecuteStatementContext) IsExecuteStatementContext() {}
 
-func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewExecuteStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExecuteStatementContext {
+	var p = new(ExecuteStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_comparisonOperator
+	p.RuleIndex = MiniQLParserRULE_executeStatement
 
 	return p
 }
 
-func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExecuteStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ComparisonOperatorContext) EQUAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserEQUAL, 0)
+func (s *ExecuteStatementContext) EXECUTE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXECUTE, 0)
 }
 
-func (s *ComparisonOperatorContext) NOT_EQUAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserNOT_EQUAL, 0)
+func (s *ExecuteStatementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
-func (s *ComparisonOperatorContext) GREATER() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserGREATER, 0)
+func (s *ExecuteStatementContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
 }
 
-func (s *ComparisonOperatorContext) GREATER_EQUAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserGREATER_EQUAL, 0)
+func (s *ExecuteStatementContext) AllSignedLiteral() []ISignedLiteralContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISignedLiteralContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISignedLiteralContext); ok {
+			tst[i] = t.(ISignedLiteralContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *ComparisonOperatorContext) LESS() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLESS, 0)
+func (s *ExecuteStatementContext) SignedLiteral(i int) ISignedLiteralContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedLiteralContext)
 }
 
-func (s *ComparisonOperatorContext) LESS_EQUAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLESS_EQUAL, 0)
+func (s *ExecuteStatementContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
 }
 
-func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *ExecuteStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *ExecuteStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *ExecuteStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExecuteStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ComparisonOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExecuteStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitComparisonOperator(s)
+		return t.VisitExecuteStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ComparisonOperator() (localctx IComparisonOperatorContext) {
-	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, MiniQLParserRULE_comparisonOperator)
+func (p *MiniQLParser) ExecuteStatement() (localctx IExecuteStatementContext) {
+	localctx = NewExecuteStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, MiniQLParserRULE_executeStatement)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(421)
+		p.SetState(1107)
+		p.Match(MiniQLParserEXECUTE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1108)
+		p.Identifier()
+	}
+	p.SetState(1120)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserLEFT_PAREN {
+		{
+			p.SetState(1109)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1110)
+			p.SignedLiteral()
+		}
+		p.SetState(1115)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&63) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		for _la == MiniQLParserCOMMA {
+			{
+				p.SetState(1111)
+				p.Match(MiniQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1112)
+				p.SignedLiteral()
+			}
+
+			p.SetState(1117)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1118)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
 	}
 
 errorExit:
@@ -7166,85 +18752,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IColumnRefContext is an interface to support dynamic dispatch.
-type IColumnRefContext interface {
+// IDeallocateStatementContext is an interface to support dynamic dispatch.
+type IDeallocateStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIdentifier() []IIdentifierContext
-	Identifier(i int) IIdentifierContext
-	DOT() antlr.TerminalNode
+	DEALLOCATE() antlr.TerminalNode
+	ALL() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	PREPARE() antlr.TerminalNode
 
-	// IsColumnRefContext differentiates from other interfaces.
-	IsColumnRefContext()
+	// IsDeallocateStatementContext differentiates from other interfaces.
+	IsDeallocateStatementContext()
 }
 
-type ColumnRefContext struct {
+type DeallocateStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyColumnRefContext() *ColumnRefContext {
-	var p = new(ColumnRefContext)
+func NewEmptyDeallocateStatementContext() *DeallocateStatementContext {
+	var p = new(DeallocateStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnRef
+	p.RuleIndex = MiniQLParserRULE_deallocateStatement
 	return p
 }
 
-func InitEmptyColumnRefContext(p *ColumnRefContext) {
+func InitEmptyDeallocateStatementContext(p *DeallocateStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnRef
+	p.RuleIndex = MiniQLParserRULE_deallocateStatement
 }
 
-func (*ColumnRefContext) IsColumnRefContext() {}
+func (*DeallocateStatementContext) IsDeallocateStatementContext() {}
 
-func NewColumnRefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnRefContext {
-	var p = new(ColumnRefContext)
+func NewDeallocateStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeallocateStatementContext {
+	var p = new(DeallocateStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_columnRef
+	p.RuleIndex = MiniQLParserRULE_deallocateStatement
 
 	return p
 }
 
-func (s *ColumnRefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ColumnRefContext) AllIdentifier() []IIdentifierContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			len++
-		}
-	}
+func (s *DeallocateStatementContext) GetParser() antlr.Parser { return s.parser }
 
-	tst := make([]IIdentifierContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IIdentifierContext); ok {
-			tst[i] = t.(IIdentifierContext)
-			i++
-		}
-	}
+func (s *DeallocateStatementContext) DEALLOCATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEALLOCATE, 0)
+}
 
-	return tst
+func (s *DeallocateStatementContext) ALL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserALL, 0)
 }
 
-func (s *ColumnRefContext) Identifier(i int) IIdentifierContext {
+func (s *DeallocateStatementContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentifierContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7255,65 +18825,81 @@ func (s *ColumnRefContext) Identifier(i int) IIdentifierContext {
 	return t.(IIdentifierContext)
 }
 
-func (s *ColumnRefContext) DOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDOT, 0)
+func (s *DeallocateStatementContext) PREPARE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPREPARE, 0)
 }
 
-func (s *ColumnRefContext) GetRuleContext() antlr.RuleContext {
+func (s *DeallocateStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ColumnRefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeallocateStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ColumnRefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DeallocateStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitColumnRef(s)
+		return t.VisitDeallocateStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ColumnRef() (localctx IColumnRefContext) {
-	localctx = NewColumnRefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, MiniQLParserRULE_columnRef)
-	p.SetState(428)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+func (p *MiniQLParser) DeallocateStatement() (localctx IDeallocateStatementContext) {
+	localctx = NewDeallocateStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, MiniQLParserRULE_deallocateStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1122)
+		p.Match(MiniQLParserDEALLOCATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
+	p.SetState(1124)
+	p.GetErrorHandler().Sync(p)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 118, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(423)
-			p.Identifier()
+			p.SetState(1123)
+			p.Match(MiniQLParserPREPARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(424)
-			p.Identifier()
-		}
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	p.SetState(1128)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserALL:
 		{
-			p.SetState(425)
-			p.Match(MiniQLParserDOT)
+			p.SetState(1126)
+			p.Match(MiniQLParserALL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
 		{
-			p.SetState(426)
+			p.SetState(1127)
 			p.Identifier()
 		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -7330,58 +18916,70 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUpdateAssignmentContext is an interface to support dynamic dispatch.
-type IUpdateAssignmentContext interface {
+// ICopyStatementContext is an interface to support dynamic dispatch.
+type ICopyStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Identifier() IIdentifierContext
-	EQUAL() antlr.TerminalNode
-	Expression() IExpressionContext
+	COPY() antlr.TerminalNode
+	TableName() ITableNameContext
+	STRING_LITERAL() antlr.TerminalNode
+	FROM() antlr.TerminalNode
+	TO() antlr.TerminalNode
+	LEFT_PAREN() antlr.TerminalNode
+	IdentifierList() IIdentifierListContext
+	RIGHT_PAREN() antlr.TerminalNode
+	WITH() antlr.TerminalNode
+	OptionList() IOptionListContext
+	SelectStatement() ISelectStatementContext
 
-	// IsUpdateAssignmentContext differentiates from other interfaces.
-	IsUpdateAssignmentContext()
+	// IsCopyStatementContext differentiates from other interfaces.
+	IsCopyStatementContext()
 }
 
-type UpdateAssignmentContext struct {
+type CopyStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUpdateAssignmentContext() *UpdateAssignmentContext {
-	var p = new(UpdateAssignmentContext)
+func NewEmptyCopyStatementContext() *CopyStatementContext {
+	var p = new(CopyStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_updateAssignment
+	p.RuleIndex = MiniQLParserRULE_copyStatement
 	return p
 }
 
-func InitEmptyUpdateAssignmentContext(p *UpdateAssignmentContext) {
+func InitEmptyCopyStatementContext(p *CopyStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_updateAssignment
+	p.RuleIndex = MiniQLParserRULE_copyStatement
 }
 
-func (*UpdateAssignmentContext) IsUpdateAssignmentContext() {}
+func (*CopyStatementContext) IsCopyStatementContext() {}
 
-func NewUpdateAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UpdateAssignmentContext {
-	var p = new(UpdateAssignmentContext)
+func NewCopyStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CopyStatementContext {
+	var p = new(CopyStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_updateAssignment
+	p.RuleIndex = MiniQLParserRULE_copyStatement
 
 	return p
 }
 
-func (s *UpdateAssignmentContext) GetParser() antlr.Parser { return s.parser }
+func (s *CopyStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UpdateAssignmentContext) Identifier() IIdentifierContext {
+func (s *CopyStatementContext) COPY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOPY, 0)
+}
+
+func (s *CopyStatementContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7391,17 +18989,29 @@ func (s *UpdateAssignmentContext) Identifier() IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
+	return t.(ITableNameContext)
 }
 
-func (s *UpdateAssignmentContext) EQUAL() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserEQUAL, 0)
+func (s *CopyStatementContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
 }
 
-func (s *UpdateAssignmentContext) Expression() IExpressionContext {
+func (s *CopyStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *CopyStatementContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
+}
+
+func (s *CopyStatementContext) LEFT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+}
+
+func (s *CopyStatementContext) IdentifierList() IIdentifierListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IIdentifierListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7411,148 +19021,239 @@ func (s *UpdateAssignmentContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IIdentifierListContext)
 }
 
-func (s *UpdateAssignmentContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CopyStatementContext) RIGHT_PAREN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
 }
 
-func (s *UpdateAssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *CopyStatementContext) WITH() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserWITH, 0)
 }
 
-func (s *UpdateAssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitUpdateAssignment(s)
+func (s *CopyStatementContext) OptionList() IOptionListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IOptionListContext)
 }
 
-func (p *MiniQLParser) UpdateAssignment() (localctx IUpdateAssignmentContext) {
-	localctx = NewUpdateAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, MiniQLParserRULE_updateAssignment)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(430)
-		p.Identifier()
-	}
-	{
-		p.SetState(431)
-		p.Match(MiniQLParserEQUAL)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *CopyStatementContext) SelectStatement() ISelectStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
-	{
-		p.SetState(432)
-		p.expression(0)
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IGroupByItemContext is an interface to support dynamic dispatch.
-type IGroupByItemContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	Expression() IExpressionContext
 
-	// IsGroupByItemContext differentiates from other interfaces.
-	IsGroupByItemContext()
+	return t.(ISelectStatementContext)
 }
 
-type GroupByItemContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *CopyStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func NewEmptyGroupByItemContext() *GroupByItemContext {
-	var p = new(GroupByItemContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_groupByItem
-	return p
+func (s *CopyStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func InitEmptyGroupByItemContext(p *GroupByItemContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_groupByItem
-}
+func (s *CopyStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitCopyStatement(s)
 
-func (*GroupByItemContext) IsGroupByItemContext() {}
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-func NewGroupByItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByItemContext {
-	var p = new(GroupByItemContext)
+func (p *MiniQLParser) CopyStatement() (localctx ICopyStatementContext) {
+	localctx = NewCopyStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, MiniQLParserRULE_copyStatement)
+	var _la int
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+	p.SetState(1154)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_groupByItem
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 123, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1130)
+			p.Match(MiniQLParserCOPY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1131)
+			p.TableName()
+		}
+		p.SetState(1136)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-	return p
-}
+		if _la == MiniQLParserLEFT_PAREN {
+			{
+				p.SetState(1132)
+				p.Match(MiniQLParserLEFT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1133)
+				p.IdentifierList()
+			}
+			{
+				p.SetState(1134)
+				p.Match(MiniQLParserRIGHT_PAREN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func (s *GroupByItemContext) GetParser() antlr.Parser { return s.parser }
+		}
+		{
+			p.SetState(1138)
+			_la = p.GetTokenStream().LA(1)
 
-func (s *GroupByItemContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if !(_la == MiniQLParserFROM || _la == MiniQLParserTO) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
-	}
+		{
+			p.SetState(1139)
+			p.Match(MiniQLParserSTRING_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(1142)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-	if t == nil {
-		return nil
-	}
+		if _la == MiniQLParserWITH {
+			{
+				p.SetState(1140)
+				p.Match(MiniQLParserWITH)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1141)
+				p.OptionList()
+			}
 
-	return t.(IExpressionContext)
-}
+		}
 
-func (s *GroupByItemContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1144)
+			p.Match(MiniQLParserCOPY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1145)
+			p.Match(MiniQLParserLEFT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1146)
+			p.SelectStatement()
+		}
+		{
+			p.SetState(1147)
+			p.Match(MiniQLParserRIGHT_PAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1148)
+			_la = p.GetTokenStream().LA(1)
 
-func (s *GroupByItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+			if !(_la == MiniQLParserFROM || _la == MiniQLParserTO) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(1149)
+			p.Match(MiniQLParserSTRING_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(1152)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-func (s *GroupByItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitGroupByItem(s)
+		if _la == MiniQLParserWITH {
+			{
+				p.SetState(1150)
+				p.Match(MiniQLParserWITH)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1151)
+				p.OptionList()
+			}
 
-	default:
-		return t.VisitChildren(s)
-	}
-}
+		}
 
-func (p *MiniQLParser) GroupByItem() (localctx IGroupByItemContext) {
-	localctx = NewGroupByItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, MiniQLParserRULE_groupByItem)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(434)
-		p.expression(0)
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -7568,58 +19269,68 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOrderByItemContext is an interface to support dynamic dispatch.
-type IOrderByItemContext interface {
+// IExportTableContext is an interface to support dynamic dispatch.
+type IExportTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Expression() IExpressionContext
-	ASC() antlr.TerminalNode
-	DESC() antlr.TerminalNode
+	EXPORT() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	TO() antlr.TerminalNode
+	TableFormat() ITableFormatContext
 
-	// IsOrderByItemContext differentiates from other interfaces.
-	IsOrderByItemContext()
+	// IsExportTableContext differentiates from other interfaces.
+	IsExportTableContext()
 }
 
-type OrderByItemContext struct {
+type ExportTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOrderByItemContext() *OrderByItemContext {
-	var p = new(OrderByItemContext)
+func NewEmptyExportTableContext() *ExportTableContext {
+	var p = new(ExportTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_orderByItem
+	p.RuleIndex = MiniQLParserRULE_exportTable
 	return p
 }
 
-func InitEmptyOrderByItemContext(p *OrderByItemContext) {
+func InitEmptyExportTableContext(p *ExportTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_orderByItem
+	p.RuleIndex = MiniQLParserRULE_exportTable
 }
 
-func (*OrderByItemContext) IsOrderByItemContext() {}
+func (*ExportTableContext) IsExportTableContext() {}
 
-func NewOrderByItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByItemContext {
-	var p = new(OrderByItemContext)
+func NewExportTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExportTableContext {
+	var p = new(ExportTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_orderByItem
+	p.RuleIndex = MiniQLParserRULE_exportTable
 
 	return p
 }
 
-func (s *OrderByItemContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExportTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderByItemContext) Expression() IExpressionContext {
+func (s *ExportTableContext) EXPORT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXPORT, 0)
+}
+
+func (s *ExportTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *ExportTableContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7629,65 +19340,82 @@ func (s *OrderByItemContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITableNameContext)
 }
 
-func (s *OrderByItemContext) ASC() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserASC, 0)
+func (s *ExportTableContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
 }
 
-func (s *OrderByItemContext) DESC() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDESC, 0)
+func (s *ExportTableContext) TableFormat() ITableFormatContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableFormatContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableFormatContext)
 }
 
-func (s *OrderByItemContext) GetRuleContext() antlr.RuleContext {
+func (s *ExportTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrderByItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExportTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrderByItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExportTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitOrderByItem(s)
+		return t.VisitExportTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) OrderByItem() (localctx IOrderByItemContext) {
-	localctx = NewOrderByItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, MiniQLParserRULE_orderByItem)
-	var _la int
-
+func (p *MiniQLParser) ExportTable() (localctx IExportTableContext) {
+	localctx = NewExportTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, MiniQLParserRULE_exportTable)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(436)
-		p.expression(0)
+		p.SetState(1156)
+		p.Match(MiniQLParserEXPORT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(438)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(1157)
+		p.Match(MiniQLParserTABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == MiniQLParserASC || _la == MiniQLParserDESC {
-		{
-			p.SetState(437)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == MiniQLParserASC || _la == MiniQLParserDESC) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+	{
+		p.SetState(1158)
+		p.TableName()
+	}
+	{
+		p.SetState(1159)
+		p.Match(MiniQLParserTO)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
+	}
+	{
+		p.SetState(1160)
+		p.TableFormat()
 	}
 
 errorExit:
@@ -7703,63 +19431,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionCallContext is an interface to support dynamic dispatch.
-type IFunctionCallContext interface {
+// IImportTableContext is an interface to support dynamic dispatch.
+type IImportTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Identifier() IIdentifierContext
-	LEFT_PAREN() antlr.TerminalNode
-	RIGHT_PAREN() antlr.TerminalNode
-	ASTERISK() antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	IMPORT() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
+	TableName() ITableNameContext
+	FROM() antlr.TerminalNode
+	TableFormat() ITableFormatContext
+	STRING_LITERAL() antlr.TerminalNode
 
-	// IsFunctionCallContext differentiates from other interfaces.
-	IsFunctionCallContext()
+	// IsImportTableContext differentiates from other interfaces.
+	IsImportTableContext()
 }
 
-type FunctionCallContext struct {
+type ImportTableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallContext() *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewEmptyImportTableContext() *ImportTableContext {
+	var p = new(ImportTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_functionCall
+	p.RuleIndex = MiniQLParserRULE_importTable
 	return p
 }
 
-func InitEmptyFunctionCallContext(p *FunctionCallContext) {
+func InitEmptyImportTableContext(p *ImportTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_functionCall
+	p.RuleIndex = MiniQLParserRULE_importTable
 }
 
-func (*FunctionCallContext) IsFunctionCallContext() {}
+func (*ImportTableContext) IsImportTableContext() {}
 
-func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewImportTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportTableContext {
+	var p = new(ImportTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_functionCall
+	p.RuleIndex = MiniQLParserRULE_importTable
 
 	return p
 }
 
-func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *ImportTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallContext) Identifier() IIdentifierContext {
+func (s *ImportTableContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIMPORT, 0)
+}
+
+func (s *ImportTableContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
+}
+
+func (s *ImportTableContext) TableName() ITableNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
+		if _, ok := ctx.(ITableNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7769,52 +19503,19 @@ func (s *FunctionCallContext) Identifier() IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
-}
-
-func (s *FunctionCallContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
-}
-
-func (s *FunctionCallContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
-}
-
-func (s *FunctionCallContext) ASTERISK() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserASTERISK, 0)
+	return t.(ITableNameContext)
 }
 
-func (s *FunctionCallContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *ImportTableContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
 }
 
-func (s *FunctionCallContext) Expression(i int) IExpressionContext {
+func (s *ImportTableContext) TableFormat() ITableFormatContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITableFormatContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7822,110 +19523,70 @@ func (s *FunctionCallContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
-}
-
-func (s *FunctionCallContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
+	return t.(ITableFormatContext)
 }
 
-func (s *FunctionCallContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+func (s *ImportTableContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
 }
 
-func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+func (s *ImportTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ImportTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ImportTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitFunctionCall(s)
+		return t.VisitImportTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) FunctionCall() (localctx IFunctionCallContext) {
-	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, MiniQLParserRULE_functionCall)
-	var _la int
-
+func (p *MiniQLParser) ImportTable() (localctx IImportTableContext) {
+	localctx = NewImportTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, MiniQLParserRULE_importTable)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(440)
-		p.Identifier()
-	}
-	{
-		p.SetState(441)
-		p.Match(MiniQLParserLEFT_PAREN)
+		p.SetState(1162)
+		p.Match(MiniQLParserIMPORT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	p.SetState(451)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserASTERISK:
-		{
-			p.SetState(442)
-			p.Match(MiniQLParserASTERISK)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserLEFT_PAREN, MiniQLParserIDENTIFIER, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
-		{
-			p.SetState(443)
-			p.expression(0)
-		}
-		p.SetState(448)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(1163)
+		p.Match(MiniQLParserTABLE)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == MiniQLParserCOMMA {
-			{
-				p.SetState(444)
-				p.Match(MiniQLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(445)
-				p.expression(0)
-			}
-
-			p.SetState(450)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1164)
+		p.TableName()
+	}
+	{
+		p.SetState(1165)
+		p.Match(MiniQLParserFROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case MiniQLParserRIGHT_PAREN:
-
-	default:
 	}
 	{
-		p.SetState(453)
-		p.Match(MiniQLParserRIGHT_PAREN)
+		p.SetState(1166)
+		p.TableFormat()
+	}
+	{
+		p.SetState(1167)
+		p.Match(MiniQLParserSTRING_LITERAL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -7945,68 +19606,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPartitionMethodContext is an interface to support dynamic dispatch.
-type IPartitionMethodContext interface {
+// ITableFormatContext is an interface to support dynamic dispatch.
+type ITableFormatContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	HASH() antlr.TerminalNode
-	LEFT_PAREN() antlr.TerminalNode
-	IdentifierList() IIdentifierListContext
-	RIGHT_PAREN() antlr.TerminalNode
-	RANGE() antlr.TerminalNode
+	Identifier() IIdentifierContext
 
-	// IsPartitionMethodContext differentiates from other interfaces.
-	IsPartitionMethodContext()
+	// IsTableFormatContext differentiates from other interfaces.
+	IsTableFormatContext()
 }
 
-type PartitionMethodContext struct {
+type TableFormatContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPartitionMethodContext() *PartitionMethodContext {
-	var p = new(PartitionMethodContext)
+func NewEmptyTableFormatContext() *TableFormatContext {
+	var p = new(TableFormatContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_partitionMethod
+	p.RuleIndex = MiniQLParserRULE_tableFormat
 	return p
 }
 
-func InitEmptyPartitionMethodContext(p *PartitionMethodContext) {
+func InitEmptyTableFormatContext(p *TableFormatContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_partitionMethod
+	p.RuleIndex = MiniQLParserRULE_tableFormat
 }
 
-func (*PartitionMethodContext) IsPartitionMethodContext() {}
+func (*TableFormatContext) IsTableFormatContext() {}
 
-func NewPartitionMethodContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionMethodContext {
-	var p = new(PartitionMethodContext)
+func NewTableFormatContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableFormatContext {
+	var p = new(TableFormatContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_partitionMethod
+	p.RuleIndex = MiniQLParserRULE_tableFormat
 
 	return p
 }
 
-func (s *PartitionMethodContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PartitionMethodContext) HASH() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserHASH, 0)
-}
-
-func (s *PartitionMethodContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
-}
+func (s *TableFormatContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PartitionMethodContext) IdentifierList() IIdentifierListContext {
+func (s *TableFormatContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierListContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8016,110 +19665,34 @@ func (s *PartitionMethodContext) IdentifierList() IIdentifierListContext {
 		return nil
 	}
 
-	return t.(IIdentifierListContext)
-}
-
-func (s *PartitionMethodContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
-}
-
-func (s *PartitionMethodContext) RANGE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRANGE, 0)
+	return t.(IIdentifierContext)
 }
 
-func (s *PartitionMethodContext) GetRuleContext() antlr.RuleContext {
+func (s *TableFormatContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PartitionMethodContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TableFormatContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PartitionMethodContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TableFormatContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitPartitionMethod(s)
+		return t.VisitTableFormat(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) PartitionMethod() (localctx IPartitionMethodContext) {
-	localctx = NewPartitionMethodContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, MiniQLParserRULE_partitionMethod)
-	p.SetState(465)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserHASH:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(455)
-			p.Match(MiniQLParserHASH)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(456)
-			p.Match(MiniQLParserLEFT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(457)
-			p.IdentifierList()
-		}
-		{
-			p.SetState(458)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case MiniQLParserRANGE:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(460)
-			p.Match(MiniQLParserRANGE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(461)
-			p.Match(MiniQLParserLEFT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(462)
-			p.IdentifierList()
-		}
-		{
-			p.SetState(463)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+func (p *MiniQLParser) TableFormat() (localctx ITableFormatContext) {
+	localctx = NewTableFormatContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, MiniQLParserRULE_tableFormat)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1169)
+		p.Identifier()
 	}
 
 errorExit:
@@ -8135,143 +19708,196 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITransactionStatementContext is an interface to support dynamic dispatch.
-type ITransactionStatementContext interface {
+// IBackupDatabaseContext is an interface to support dynamic dispatch.
+type IBackupDatabaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	START() antlr.TerminalNode
-	TRANSACTION() antlr.TerminalNode
-	COMMIT() antlr.TerminalNode
-	ROLLBACK() antlr.TerminalNode
+	BACKUP() antlr.TerminalNode
+	DATABASE() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	TO() antlr.TerminalNode
+	AllSTRING_LITERAL() []antlr.TerminalNode
+	STRING_LITERAL(i int) antlr.TerminalNode
+	INCREMENTAL() antlr.TerminalNode
+	FROM() antlr.TerminalNode
 
-	// IsTransactionStatementContext differentiates from other interfaces.
-	IsTransactionStatementContext()
+	// IsBackupDatabaseContext differentiates from other interfaces.
+	IsBackupDatabaseContext()
 }
 
-type TransactionStatementContext struct {
+type BackupDatabaseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTransactionStatementContext() *TransactionStatementContext {
-	var p = new(TransactionStatementContext)
+func NewEmptyBackupDatabaseContext() *BackupDatabaseContext {
+	var p = new(BackupDatabaseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_transactionStatement
+	p.RuleIndex = MiniQLParserRULE_backupDatabase
 	return p
 }
 
-func InitEmptyTransactionStatementContext(p *TransactionStatementContext) {
+func InitEmptyBackupDatabaseContext(p *BackupDatabaseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_transactionStatement
+	p.RuleIndex = MiniQLParserRULE_backupDatabase
 }
 
-func (*TransactionStatementContext) IsTransactionStatementContext() {}
+func (*BackupDatabaseContext) IsBackupDatabaseContext() {}
 
-func NewTransactionStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionStatementContext {
-	var p = new(TransactionStatementContext)
+func NewBackupDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BackupDatabaseContext {
+	var p = new(BackupDatabaseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_transactionStatement
+	p.RuleIndex = MiniQLParserRULE_backupDatabase
 
 	return p
 }
 
-func (s *TransactionStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *BackupDatabaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TransactionStatementContext) START() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSTART, 0)
+func (s *BackupDatabaseContext) BACKUP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserBACKUP, 0)
 }
 
-func (s *TransactionStatementContext) TRANSACTION() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserTRANSACTION, 0)
+func (s *BackupDatabaseContext) DATABASE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDATABASE, 0)
 }
 
-func (s *TransactionStatementContext) COMMIT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMIT, 0)
+func (s *BackupDatabaseContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
-func (s *TransactionStatementContext) ROLLBACK() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserROLLBACK, 0)
+func (s *BackupDatabaseContext) TO() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTO, 0)
 }
 
-func (s *TransactionStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *BackupDatabaseContext) AllSTRING_LITERAL() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserSTRING_LITERAL)
+}
+
+func (s *BackupDatabaseContext) STRING_LITERAL(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, i)
+}
+
+func (s *BackupDatabaseContext) INCREMENTAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINCREMENTAL, 0)
+}
+
+func (s *BackupDatabaseContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *BackupDatabaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TransactionStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BackupDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TransactionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BackupDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitTransactionStatement(s)
+		return t.VisitBackupDatabase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) TransactionStatement() (localctx ITransactionStatementContext) {
-	localctx = NewTransactionStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, MiniQLParserRULE_transactionStatement)
-	p.SetState(471)
+func (p *MiniQLParser) BackupDatabase() (localctx IBackupDatabaseContext) {
+	localctx = NewBackupDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 164, MiniQLParserRULE_backupDatabase)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1171)
+		p.Match(MiniQLParserBACKUP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1172)
+		p.Match(MiniQLParserDATABASE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1173)
+		p.Identifier()
+	}
+	{
+		p.SetState(1174)
+		p.Match(MiniQLParserTO)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1175)
+		p.Match(MiniQLParserSTRING_LITERAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1179)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case MiniQLParserSTART:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(467)
-			p.Match(MiniQLParserSTART)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	if _la == MiniQLParserINCREMENTAL {
 		{
-			p.SetState(468)
-			p.Match(MiniQLParserTRANSACTION)
+			p.SetState(1176)
+			p.Match(MiniQLParserINCREMENTAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case MiniQLParserCOMMIT:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(469)
-			p.Match(MiniQLParserCOMMIT)
+			p.SetState(1177)
+			p.Match(MiniQLParserFROM)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case MiniQLParserROLLBACK:
-		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(470)
-			p.Match(MiniQLParserROLLBACK)
+			p.SetState(1178)
+			p.Match(MiniQLParserSTRING_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -8287,58 +19913,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUseStatementContext is an interface to support dynamic dispatch.
-type IUseStatementContext interface {
+// IRestoreDatabaseContext is an interface to support dynamic dispatch.
+type IRestoreDatabaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	USE() antlr.TerminalNode
+	RESTORE() antlr.TerminalNode
+	DATABASE() antlr.TerminalNode
 	Identifier() IIdentifierContext
+	FROM() antlr.TerminalNode
+	STRING_LITERAL() antlr.TerminalNode
+	AS() antlr.TerminalNode
+	OF() antlr.TerminalNode
+	VERSION() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
 
-	// IsUseStatementContext differentiates from other interfaces.
-	IsUseStatementContext()
+	// IsRestoreDatabaseContext differentiates from other interfaces.
+	IsRestoreDatabaseContext()
 }
 
-type UseStatementContext struct {
+type RestoreDatabaseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUseStatementContext() *UseStatementContext {
-	var p = new(UseStatementContext)
+func NewEmptyRestoreDatabaseContext() *RestoreDatabaseContext {
+	var p = new(RestoreDatabaseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_useStatement
+	p.RuleIndex = MiniQLParserRULE_restoreDatabase
 	return p
 }
 
-func InitEmptyUseStatementContext(p *UseStatementContext) {
+func InitEmptyRestoreDatabaseContext(p *RestoreDatabaseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_useStatement
+	p.RuleIndex = MiniQLParserRULE_restoreDatabase
 }
 
-func (*UseStatementContext) IsUseStatementContext() {}
+func (*RestoreDatabaseContext) IsRestoreDatabaseContext() {}
 
-func NewUseStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStatementContext {
-	var p = new(UseStatementContext)
+func NewRestoreDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RestoreDatabaseContext {
+	var p = new(RestoreDatabaseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_useStatement
+	p.RuleIndex = MiniQLParserRULE_restoreDatabase
 
 	return p
 }
 
-func (s *UseStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *RestoreDatabaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UseStatementContext) USE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserUSE, 0)
+func (s *RestoreDatabaseContext) RESTORE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRESTORE, 0)
 }
 
-func (s *UseStatementContext) Identifier() IIdentifierContext {
+func (s *RestoreDatabaseContext) DATABASE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDATABASE, 0)
+}
+
+func (s *RestoreDatabaseContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentifierContext); ok {
@@ -8354,40 +19991,132 @@ func (s *UseStatementContext) Identifier() IIdentifierContext {
 	return t.(IIdentifierContext)
 }
 
-func (s *UseStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *RestoreDatabaseContext) FROM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFROM, 0)
+}
+
+func (s *RestoreDatabaseContext) STRING_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSTRING_LITERAL, 0)
+}
+
+func (s *RestoreDatabaseContext) AS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserAS, 0)
+}
+
+func (s *RestoreDatabaseContext) OF() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserOF, 0)
+}
+
+func (s *RestoreDatabaseContext) VERSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVERSION, 0)
+}
+
+func (s *RestoreDatabaseContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *RestoreDatabaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UseStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RestoreDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UseStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RestoreDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitUseStatement(s)
+		return t.VisitRestoreDatabase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) UseStatement() (localctx IUseStatementContext) {
-	localctx = NewUseStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, MiniQLParserRULE_useStatement)
+func (p *MiniQLParser) RestoreDatabase() (localctx IRestoreDatabaseContext) {
+	localctx = NewRestoreDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 166, MiniQLParserRULE_restoreDatabase)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(473)
-		p.Match(MiniQLParserUSE)
+		p.SetState(1181)
+		p.Match(MiniQLParserRESTORE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1182)
+		p.Match(MiniQLParserDATABASE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(474)
+		p.SetState(1183)
 		p.Identifier()
 	}
+	{
+		p.SetState(1184)
+		p.Match(MiniQLParserFROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1185)
+		p.Match(MiniQLParserSTRING_LITERAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1190)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserAS {
+		{
+			p.SetState(1186)
+			p.Match(MiniQLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1187)
+			p.Match(MiniQLParserOF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1188)
+			p.Match(MiniQLParserVERSION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1189)
+			p.Match(MiniQLParserINTEGER_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -8402,94 +20131,122 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowDatabasesContext is an interface to support dynamic dispatch.
-type IShowDatabasesContext interface {
+// IKillStatementContext is an interface to support dynamic dispatch.
+type IKillStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SHOW() antlr.TerminalNode
-	DATABASES() antlr.TerminalNode
+	KILL() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	QUERY() antlr.TerminalNode
+	SESSION() antlr.TerminalNode
+	CONNECTION() antlr.TerminalNode
 
-	// IsShowDatabasesContext differentiates from other interfaces.
-	IsShowDatabasesContext()
+	// IsKillStatementContext differentiates from other interfaces.
+	IsKillStatementContext()
 }
 
-type ShowDatabasesContext struct {
+type KillStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowDatabasesContext() *ShowDatabasesContext {
-	var p = new(ShowDatabasesContext)
+func NewEmptyKillStatementContext() *KillStatementContext {
+	var p = new(KillStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showDatabases
+	p.RuleIndex = MiniQLParserRULE_killStatement
 	return p
 }
 
-func InitEmptyShowDatabasesContext(p *ShowDatabasesContext) {
+func InitEmptyKillStatementContext(p *KillStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showDatabases
+	p.RuleIndex = MiniQLParserRULE_killStatement
 }
 
-func (*ShowDatabasesContext) IsShowDatabasesContext() {}
+func (*KillStatementContext) IsKillStatementContext() {}
 
-func NewShowDatabasesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabasesContext {
-	var p = new(ShowDatabasesContext)
+func NewKillStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KillStatementContext {
+	var p = new(KillStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_showDatabases
+	p.RuleIndex = MiniQLParserRULE_killStatement
 
 	return p
 }
 
-func (s *ShowDatabasesContext) GetParser() antlr.Parser { return s.parser }
+func (s *KillStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowDatabasesContext) SHOW() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSHOW, 0)
+func (s *KillStatementContext) KILL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserKILL, 0)
 }
 
-func (s *ShowDatabasesContext) DATABASES() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDATABASES, 0)
+func (s *KillStatementContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
 }
 
-func (s *ShowDatabasesContext) GetRuleContext() antlr.RuleContext {
+func (s *KillStatementContext) QUERY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserQUERY, 0)
+}
+
+func (s *KillStatementContext) SESSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSESSION, 0)
+}
+
+func (s *KillStatementContext) CONNECTION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCONNECTION, 0)
+}
+
+func (s *KillStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowDatabasesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *KillStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowDatabasesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *KillStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitShowDatabases(s)
+		return t.VisitKillStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ShowDatabases() (localctx IShowDatabasesContext) {
-	localctx = NewShowDatabasesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, MiniQLParserRULE_showDatabases)
+func (p *MiniQLParser) KillStatement() (localctx IKillStatementContext) {
+	localctx = NewKillStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, MiniQLParserRULE_killStatement)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(476)
-		p.Match(MiniQLParserSHOW)
+		p.SetState(1192)
+		p.Match(MiniQLParserKILL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(477)
-		p.Match(MiniQLParserDATABASES)
+		p.SetState(1193)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-99)) & ^0x3f) == 0 && ((int64(1)<<(_la-99))&7) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(1194)
+		p.Match(MiniQLParserINTEGER_LITERAL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -8509,98 +20266,193 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowTablesContext is an interface to support dynamic dispatch.
-type IShowTablesContext interface {
+// IVacuumStatementContext is an interface to support dynamic dispatch.
+type IVacuumStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SHOW() antlr.TerminalNode
-	TABLES() antlr.TerminalNode
+	VACUUM() antlr.TerminalNode
+	TableName() ITableNameContext
+	RETAIN() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	HOURS() antlr.TerminalNode
+	DRY() antlr.TerminalNode
+	RUN() antlr.TerminalNode
 
-	// IsShowTablesContext differentiates from other interfaces.
-	IsShowTablesContext()
+	// IsVacuumStatementContext differentiates from other interfaces.
+	IsVacuumStatementContext()
 }
 
-type ShowTablesContext struct {
+type VacuumStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTablesContext() *ShowTablesContext {
-	var p = new(ShowTablesContext)
+func NewEmptyVacuumStatementContext() *VacuumStatementContext {
+	var p = new(VacuumStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showTables
+	p.RuleIndex = MiniQLParserRULE_vacuumStatement
 	return p
 }
 
-func InitEmptyShowTablesContext(p *ShowTablesContext) {
+func InitEmptyVacuumStatementContext(p *VacuumStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showTables
+	p.RuleIndex = MiniQLParserRULE_vacuumStatement
 }
 
-func (*ShowTablesContext) IsShowTablesContext() {}
+func (*VacuumStatementContext) IsVacuumStatementContext() {}
 
-func NewShowTablesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTablesContext {
-	var p = new(ShowTablesContext)
+func NewVacuumStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VacuumStatementContext {
+	var p = new(VacuumStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_showTables
+	p.RuleIndex = MiniQLParserRULE_vacuumStatement
 
 	return p
 }
 
-func (s *ShowTablesContext) GetParser() antlr.Parser { return s.parser }
+func (s *VacuumStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTablesContext) SHOW() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSHOW, 0)
+func (s *VacuumStatementContext) VACUUM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVACUUM, 0)
 }
 
-func (s *ShowTablesContext) TABLES() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserTABLES, 0)
+func (s *VacuumStatementContext) TableName() ITableNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableNameContext)
 }
 
-func (s *ShowTablesContext) GetRuleContext() antlr.RuleContext {
+func (s *VacuumStatementContext) RETAIN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRETAIN, 0)
+}
+
+func (s *VacuumStatementContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *VacuumStatementContext) HOURS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserHOURS, 0)
+}
+
+func (s *VacuumStatementContext) DRY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDRY, 0)
+}
+
+func (s *VacuumStatementContext) RUN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRUN, 0)
+}
+
+func (s *VacuumStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTablesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *VacuumStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTablesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *VacuumStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitShowTables(s)
+		return t.VisitVacuumStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
-}
+}
+
+func (p *MiniQLParser) VacuumStatement() (localctx IVacuumStatementContext) {
+	localctx = NewVacuumStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 170, MiniQLParserRULE_vacuumStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1196)
+		p.Match(MiniQLParserVACUUM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1197)
+		p.TableName()
+	}
+	p.SetState(1201)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == MiniQLParserRETAIN {
+		{
+			p.SetState(1198)
+			p.Match(MiniQLParserRETAIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1199)
+			p.Match(MiniQLParserINTEGER_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1200)
+			p.Match(MiniQLParserHOURS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	p.SetState(1205)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-func (p *MiniQLParser) ShowTables() (localctx IShowTablesContext) {
-	localctx = NewShowTablesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, MiniQLParserRULE_showTables)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(479)
-		p.Match(MiniQLParserSHOW)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	if _la == MiniQLParserDRY {
+		{
+			p.SetState(1203)
+			p.Match(MiniQLParserDRY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(480)
-		p.Match(MiniQLParserTABLES)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(1204)
+			p.Match(MiniQLParserRUN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
 	}
 
 errorExit:
@@ -8616,68 +20468,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowIndexesContext is an interface to support dynamic dispatch.
-type IShowIndexesContext interface {
+// ISetValueContext is an interface to support dynamic dispatch.
+type ISetValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SHOW() antlr.TerminalNode
-	INDEXES() antlr.TerminalNode
-	TableName() ITableNameContext
+	SignedLiteral() ISignedLiteralContext
+	Identifier() IIdentifierContext
 	ON() antlr.TerminalNode
-	FROM() antlr.TerminalNode
+	TABLE() antlr.TerminalNode
 
-	// IsShowIndexesContext differentiates from other interfaces.
-	IsShowIndexesContext()
+	// IsSetValueContext differentiates from other interfaces.
+	IsSetValueContext()
 }
 
-type ShowIndexesContext struct {
+type SetValueContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowIndexesContext() *ShowIndexesContext {
-	var p = new(ShowIndexesContext)
+func NewEmptySetValueContext() *SetValueContext {
+	var p = new(SetValueContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showIndexes
+	p.RuleIndex = MiniQLParserRULE_setValue
 	return p
 }
 
-func InitEmptyShowIndexesContext(p *ShowIndexesContext) {
+func InitEmptySetValueContext(p *SetValueContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_showIndexes
+	p.RuleIndex = MiniQLParserRULE_setValue
 }
 
-func (*ShowIndexesContext) IsShowIndexesContext() {}
+func (*SetValueContext) IsSetValueContext() {}
 
-func NewShowIndexesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowIndexesContext {
-	var p = new(ShowIndexesContext)
+func NewSetValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetValueContext {
+	var p = new(SetValueContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_showIndexes
+	p.RuleIndex = MiniQLParserRULE_setValue
 
 	return p
 }
 
-func (s *ShowIndexesContext) GetParser() antlr.Parser { return s.parser }
+func (s *SetValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowIndexesContext) SHOW() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserSHOW, 0)
-}
+func (s *SetValueContext) SignedLiteral() ISignedLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISignedLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ShowIndexesContext) INDEXES() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserINDEXES, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedLiteralContext)
 }
 
-func (s *ShowIndexesContext) TableName() ITableNameContext {
+func (s *SetValueContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8687,71 +20546,84 @@ func (s *ShowIndexesContext) TableName() ITableNameContext {
 		return nil
 	}
 
-	return t.(ITableNameContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *ShowIndexesContext) ON() antlr.TerminalNode {
+func (s *SetValueContext) ON() antlr.TerminalNode {
 	return s.GetToken(MiniQLParserON, 0)
 }
 
-func (s *ShowIndexesContext) FROM() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserFROM, 0)
+func (s *SetValueContext) TABLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTABLE, 0)
 }
 
-func (s *ShowIndexesContext) GetRuleContext() antlr.RuleContext {
+func (s *SetValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowIndexesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SetValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowIndexesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SetValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitShowIndexes(s)
+		return t.VisitSetValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ShowIndexes() (localctx IShowIndexesContext) {
-	localctx = NewShowIndexesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, MiniQLParserRULE_showIndexes)
-	var _la int
+func (p *MiniQLParser) SetValue() (localctx ISetValueContext) {
+	localctx = NewSetValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 172, MiniQLParserRULE_setValue)
+	p.SetState(1211)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(482)
-		p.Match(MiniQLParserSHOW)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserPLUS, MiniQLParserMINUS, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1207)
+			p.SignedLiteral()
 		}
-	}
-	{
-		p.SetState(483)
-		p.Match(MiniQLParserINDEXES)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1208)
+			p.Identifier()
 		}
-	}
-	{
-		p.SetState(484)
-		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == MiniQLParserFROM || _la == MiniQLParserON) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	case MiniQLParserON:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1209)
+			p.Match(MiniQLParserON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(485)
-		p.TableName()
+
+	case MiniQLParserTABLE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1210)
+			p.Match(MiniQLParserTABLE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -8767,63 +20639,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExplainStatementContext is an interface to support dynamic dispatch.
-type IExplainStatementContext interface {
+// IIdentifierListContext is an interface to support dynamic dispatch.
+type IIdentifierListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EXPLAIN() antlr.TerminalNode
-	SelectStatement() ISelectStatementContext
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsExplainStatementContext differentiates from other interfaces.
-	IsExplainStatementContext()
+	// IsIdentifierListContext differentiates from other interfaces.
+	IsIdentifierListContext()
 }
 
-type ExplainStatementContext struct {
+type IdentifierListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExplainStatementContext() *ExplainStatementContext {
-	var p = new(ExplainStatementContext)
+func NewEmptyIdentifierListContext() *IdentifierListContext {
+	var p = new(IdentifierListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_explainStatement
+	p.RuleIndex = MiniQLParserRULE_identifierList
 	return p
 }
 
-func InitEmptyExplainStatementContext(p *ExplainStatementContext) {
+func InitEmptyIdentifierListContext(p *IdentifierListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_explainStatement
+	p.RuleIndex = MiniQLParserRULE_identifierList
 }
 
-func (*ExplainStatementContext) IsExplainStatementContext() {}
+func (*IdentifierListContext) IsIdentifierListContext() {}
 
-func NewExplainStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExplainStatementContext {
-	var p = new(ExplainStatementContext)
+func NewIdentifierListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierListContext {
+	var p = new(IdentifierListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_explainStatement
+	p.RuleIndex = MiniQLParserRULE_identifierList
 
 	return p
 }
 
-func (s *ExplainStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *IdentifierListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExplainStatementContext) EXPLAIN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserEXPLAIN, 0)
+func (s *IdentifierListContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *ExplainStatementContext) SelectStatement() ISelectStatementContext {
+func (s *IdentifierListContext) Identifier(i int) IIdentifierContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8831,42 +20726,72 @@ func (s *ExplainStatementContext) SelectStatement() ISelectStatementContext {
 		return nil
 	}
 
-	return t.(ISelectStatementContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *ExplainStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
+}
+
+func (s *IdentifierListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *IdentifierListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExplainStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExplainStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitExplainStatement(s)
+		return t.VisitIdentifierList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ExplainStatement() (localctx IExplainStatementContext) {
-	localctx = NewExplainStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, MiniQLParserRULE_explainStatement)
+func (p *MiniQLParser) IdentifierList() (localctx IIdentifierListContext) {
+	localctx = NewIdentifierListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 174, MiniQLParserRULE_identifierList)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(487)
-		p.Match(MiniQLParserEXPLAIN)
+		p.SetState(1213)
+		p.Identifier()
+	}
+	p.SetState(1218)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == MiniQLParserCOMMA {
+		{
+			p.SetState(1214)
+			p.Match(MiniQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1215)
+			p.Identifier()
+		}
+
+		p.SetState(1220)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	{
-		p.SetState(488)
-		p.SelectStatement()
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -8882,91 +20807,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAnalyzeStatementContext is an interface to support dynamic dispatch.
-type IAnalyzeStatementContext interface {
+// IValueListContext is an interface to support dynamic dispatch.
+type IValueListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ANALYZE() antlr.TerminalNode
-	TABLE() antlr.TerminalNode
-	TableName() ITableNameContext
-	LEFT_PAREN() antlr.TerminalNode
-	ColumnList() IColumnListContext
-	RIGHT_PAREN() antlr.TerminalNode
+	AllValueItem() []IValueItemContext
+	ValueItem(i int) IValueItemContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsAnalyzeStatementContext differentiates from other interfaces.
-	IsAnalyzeStatementContext()
+	// IsValueListContext differentiates from other interfaces.
+	IsValueListContext()
 }
 
-type AnalyzeStatementContext struct {
+type ValueListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAnalyzeStatementContext() *AnalyzeStatementContext {
-	var p = new(AnalyzeStatementContext)
+func NewEmptyValueListContext() *ValueListContext {
+	var p = new(ValueListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+	p.RuleIndex = MiniQLParserRULE_valueList
 	return p
 }
 
-func InitEmptyAnalyzeStatementContext(p *AnalyzeStatementContext) {
+func InitEmptyValueListContext(p *ValueListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+	p.RuleIndex = MiniQLParserRULE_valueList
 }
 
-func (*AnalyzeStatementContext) IsAnalyzeStatementContext() {}
+func (*ValueListContext) IsValueListContext() {}
 
-func NewAnalyzeStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnalyzeStatementContext {
-	var p = new(AnalyzeStatementContext)
+func NewValueListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ValueListContext {
+	var p = new(ValueListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_analyzeStatement
+	p.RuleIndex = MiniQLParserRULE_valueList
 
 	return p
 }
 
-func (s *AnalyzeStatementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AnalyzeStatementContext) ANALYZE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserANALYZE, 0)
-}
-
-func (s *AnalyzeStatementContext) TABLE() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserTABLE, 0)
-}
+func (s *ValueListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AnalyzeStatementContext) TableName() ITableNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITableNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *ValueListContext) AllValueItem() []IValueItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IValueItemContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IValueItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IValueItemContext); ok {
+			tst[i] = t.(IValueItemContext)
+			i++
+		}
 	}
 
-	return t.(ITableNameContext)
-}
-
-func (s *AnalyzeStatementContext) LEFT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserLEFT_PAREN, 0)
+	return tst
 }
 
-func (s *AnalyzeStatementContext) ColumnList() IColumnListContext {
+func (s *ValueListContext) ValueItem(i int) IValueItemContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IColumnListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IValueItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8974,86 +20894,72 @@ func (s *AnalyzeStatementContext) ColumnList() IColumnListContext {
 		return nil
 	}
 
-	return t.(IColumnListContext)
+	return t.(IValueItemContext)
 }
 
-func (s *AnalyzeStatementContext) RIGHT_PAREN() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserRIGHT_PAREN, 0)
+func (s *ValueListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(MiniQLParserCOMMA)
 }
 
-func (s *AnalyzeStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *ValueListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMA, i)
+}
+
+func (s *ValueListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AnalyzeStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AnalyzeStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitAnalyzeStatement(s)
+		return t.VisitValueList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) AnalyzeStatement() (localctx IAnalyzeStatementContext) {
-	localctx = NewAnalyzeStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, MiniQLParserRULE_analyzeStatement)
+func (p *MiniQLParser) ValueList() (localctx IValueListContext) {
+	localctx = NewValueListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 176, MiniQLParserRULE_valueList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(490)
-		p.Match(MiniQLParserANALYZE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(491)
-		p.Match(MiniQLParserTABLE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(492)
-		p.TableName()
+		p.SetState(1221)
+		p.ValueItem()
 	}
-	p.SetState(497)
+	p.SetState(1226)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == MiniQLParserLEFT_PAREN {
+	for _la == MiniQLParserCOMMA {
 		{
-			p.SetState(493)
-			p.Match(MiniQLParserLEFT_PAREN)
+			p.SetState(1222)
+			p.Match(MiniQLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(494)
-			p.ColumnList()
-		}
-		{
-			p.SetState(495)
-			p.Match(MiniQLParserRIGHT_PAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(1223)
+			p.ValueItem()
 		}
 
+		p.SetState(1228)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -9069,86 +20975,59 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IColumnListContext is an interface to support dynamic dispatch.
-type IColumnListContext interface {
+// IValueItemContext is an interface to support dynamic dispatch.
+type IValueItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIdentifier() []IIdentifierContext
-	Identifier(i int) IIdentifierContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	Literal() ILiteralContext
+	PARAM() antlr.TerminalNode
 
-	// IsColumnListContext differentiates from other interfaces.
-	IsColumnListContext()
+	// IsValueItemContext differentiates from other interfaces.
+	IsValueItemContext()
 }
 
-type ColumnListContext struct {
+type ValueItemContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyColumnListContext() *ColumnListContext {
-	var p = new(ColumnListContext)
+func NewEmptyValueItemContext() *ValueItemContext {
+	var p = new(ValueItemContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnList
+	p.RuleIndex = MiniQLParserRULE_valueItem
 	return p
 }
 
-func InitEmptyColumnListContext(p *ColumnListContext) {
+func InitEmptyValueItemContext(p *ValueItemContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_columnList
+	p.RuleIndex = MiniQLParserRULE_valueItem
 }
 
-func (*ColumnListContext) IsColumnListContext() {}
+func (*ValueItemContext) IsValueItemContext() {}
 
-func NewColumnListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnListContext {
-	var p = new(ColumnListContext)
+func NewValueItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ValueItemContext {
+	var p = new(ValueItemContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_columnList
+	p.RuleIndex = MiniQLParserRULE_valueItem
 
 	return p
 }
 
-func (s *ColumnListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ColumnListContext) AllIdentifier() []IIdentifierContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IIdentifierContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IIdentifierContext); ok {
-			tst[i] = t.(IIdentifierContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *ValueItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ColumnListContext) Identifier(i int) IIdentifierContext {
+func (s *ValueItemContext) Literal() ILiteralContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -9156,72 +21035,62 @@ func (s *ColumnListContext) Identifier(i int) IIdentifierContext {
 		return nil
 	}
 
-	return t.(IIdentifierContext)
-}
-
-func (s *ColumnListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
+	return t.(ILiteralContext)
 }
 
-func (s *ColumnListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+func (s *ValueItemContext) PARAM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARAM, 0)
 }
 
-func (s *ColumnListContext) GetRuleContext() antlr.RuleContext {
+func (s *ValueItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ColumnListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ValueItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ColumnListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ValueItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitColumnList(s)
+		return t.VisitValueItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ColumnList() (localctx IColumnListContext) {
-	localctx = NewColumnListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, MiniQLParserRULE_columnList)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(499)
-		p.Identifier()
-	}
-	p.SetState(504)
+func (p *MiniQLParser) ValueItem() (localctx IValueItemContext) {
+	localctx = NewValueItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 178, MiniQLParserRULE_valueItem)
+	p.SetState(1231)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == MiniQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(500)
-			p.Match(MiniQLParserCOMMA)
+			p.SetState(1229)
+			p.Literal()
+		}
+
+	case MiniQLParserPARAM:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1230)
+			p.Match(MiniQLParserPARAM)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(501)
-			p.Identifier()
-		}
 
-		p.SetState(506)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -9237,8 +21106,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IIdentifierListContext is an interface to support dynamic dispatch.
-type IIdentifierListContext interface {
+// ITableNameContext is an interface to support dynamic dispatch.
+type ITableNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -9247,46 +21116,46 @@ type IIdentifierListContext interface {
 	// Getter signatures
 	AllIdentifier() []IIdentifierContext
 	Identifier(i int) IIdentifierContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	DOT() antlr.TerminalNode
+	DEFAULT() antlr.TerminalNode
 
-	// IsIdentifierListContext differentiates from other interfaces.
-	IsIdentifierListContext()
+	// IsTableNameContext differentiates from other interfaces.
+	IsTableNameContext()
 }
 
-type IdentifierListContext struct {
+type TableNameContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIdentifierListContext() *IdentifierListContext {
-	var p = new(IdentifierListContext)
+func NewEmptyTableNameContext() *TableNameContext {
+	var p = new(TableNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_identifierList
+	p.RuleIndex = MiniQLParserRULE_tableName
 	return p
 }
 
-func InitEmptyIdentifierListContext(p *IdentifierListContext) {
+func InitEmptyTableNameContext(p *TableNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_identifierList
+	p.RuleIndex = MiniQLParserRULE_tableName
 }
 
-func (*IdentifierListContext) IsIdentifierListContext() {}
+func (*TableNameContext) IsTableNameContext() {}
 
-func NewIdentifierListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierListContext {
-	var p = new(IdentifierListContext)
+func NewTableNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableNameContext {
+	var p = new(TableNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_identifierList
+	p.RuleIndex = MiniQLParserRULE_tableName
 
 	return p
 }
 
-func (s *IdentifierListContext) GetParser() antlr.Parser { return s.parser }
+func (s *TableNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IdentifierListContext) AllIdentifier() []IIdentifierContext {
+func (s *TableNameContext) AllIdentifier() []IIdentifierContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -9307,7 +21176,7 @@ func (s *IdentifierListContext) AllIdentifier() []IIdentifierContext {
 	return tst
 }
 
-func (s *IdentifierListContext) Identifier(i int) IIdentifierContext {
+func (s *TableNameContext) Identifier(i int) IIdentifierContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -9327,69 +21196,95 @@ func (s *IdentifierListContext) Identifier(i int) IIdentifierContext {
 	return t.(IIdentifierContext)
 }
 
-func (s *IdentifierListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
+func (s *TableNameContext) DOT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDOT, 0)
 }
 
-func (s *IdentifierListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+func (s *TableNameContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEFAULT, 0)
 }
 
-func (s *IdentifierListContext) GetRuleContext() antlr.RuleContext {
+func (s *TableNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TableNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IdentifierListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TableNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitIdentifierList(s)
+		return t.VisitTableName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) IdentifierList() (localctx IIdentifierListContext) {
-	localctx = NewIdentifierListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, MiniQLParserRULE_identifierList)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(507)
-		p.Identifier()
-	}
-	p.SetState(512)
+func (p *MiniQLParser) TableName() (localctx ITableNameContext) {
+	localctx = NewTableNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 180, MiniQLParserRULE_tableName)
+	p.SetState(1241)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == MiniQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT, MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(508)
-			p.Match(MiniQLParserCOMMA)
+			p.SetState(1233)
+			p.Identifier()
+		}
+		p.SetState(1236)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 132, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(1234)
+				p.Match(MiniQLParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1235)
+				p.Identifier()
+			}
+
+		} else if p.HasError() { // JIM
+			goto errorExit
+		}
+
+	case MiniQLParserDEFAULT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1238)
+			p.Match(MiniQLParserDEFAULT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(509)
+			p.SetState(1239)
+			p.Match(MiniQLParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1240)
 			p.Identifier()
 		}
 
-		p.SetState(514)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -9405,86 +21300,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IValueListContext is an interface to support dynamic dispatch.
-type IValueListContext interface {
+// IIdentifierContext is an interface to support dynamic dispatch.
+type IIdentifierContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllLiteral() []ILiteralContext
-	Literal(i int) ILiteralContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	NonReservedKeyword() INonReservedKeywordContext
 
-	// IsValueListContext differentiates from other interfaces.
-	IsValueListContext()
+	// IsIdentifierContext differentiates from other interfaces.
+	IsIdentifierContext()
 }
 
-type ValueListContext struct {
+type IdentifierContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyValueListContext() *ValueListContext {
-	var p = new(ValueListContext)
+func NewEmptyIdentifierContext() *IdentifierContext {
+	var p = new(IdentifierContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_valueList
+	p.RuleIndex = MiniQLParserRULE_identifier
 	return p
 }
 
-func InitEmptyValueListContext(p *ValueListContext) {
+func InitEmptyIdentifierContext(p *IdentifierContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_valueList
+	p.RuleIndex = MiniQLParserRULE_identifier
 }
 
-func (*ValueListContext) IsValueListContext() {}
+func (*IdentifierContext) IsIdentifierContext() {}
 
-func NewValueListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ValueListContext {
-	var p = new(ValueListContext)
+func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
+	var p = new(IdentifierContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_valueList
+	p.RuleIndex = MiniQLParserRULE_identifier
 
 	return p
 }
 
-func (s *ValueListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ValueListContext) AllLiteral() []ILiteralContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ILiteralContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ILiteralContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ILiteralContext); ok {
-			tst[i] = t.(ILiteralContext)
-			i++
-		}
-	}
+func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *IdentifierContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIDENTIFIER, 0)
 }
 
-func (s *ValueListContext) Literal(i int) ILiteralContext {
+func (s *IdentifierContext) NonReservedKeyword() INonReservedKeywordContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(INonReservedKeywordContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -9492,72 +21364,58 @@ func (s *ValueListContext) Literal(i int) ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
-}
-
-func (s *ValueListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(MiniQLParserCOMMA)
-}
-
-func (s *ValueListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(MiniQLParserCOMMA, i)
+	return t.(INonReservedKeywordContext)
 }
 
-func (s *ValueListContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitValueList(s)
+		return t.VisitIdentifier(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) ValueList() (localctx IValueListContext) {
-	localctx = NewValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, MiniQLParserRULE_valueList)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(515)
-		p.Literal()
-	}
-	p.SetState(520)
+func (p *MiniQLParser) Identifier() (localctx IIdentifierContext) {
+	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 182, MiniQLParserRULE_identifier)
+	p.SetState(1245)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == MiniQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(516)
-			p.Match(MiniQLParserCOMMA)
+			p.SetState(1243)
+			p.Match(MiniQLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+
+	case MiniQLParserRESET, MiniQLParserTIME, MiniQLParserZONE, MiniQLParserLIST, MiniQLParserPARTITIONS, MiniQLParserLESS_KW, MiniQLParserTHAN, MiniQLParserMAXVALUE, MiniQLParserTBLPROPERTIES, MiniQLParserUNSET, MiniQLParserSHALLOW, MiniQLParserCLONE, MiniQLParserVERSION, MiniQLParserDESCRIBE, MiniQLParserEXTENDED, MiniQLParserCOMMENT, MiniQLParserCOLUMN, MiniQLParserIS, MiniQLParserUSER, MiniQLParserROLE, MiniQLParserPASSWORD, MiniQLParserSUPERUSER, MiniQLParserNOSUPERUSER, MiniQLParserGRANT, MiniQLParserREVOKE, MiniQLParserPRIVILEGES, MiniQLParserIF, MiniQLParserEXISTS, MiniQLParserKILL, MiniQLParserQUERY, MiniQLParserSESSION, MiniQLParserCONNECTION, MiniQLParserRESTORE, MiniQLParserBACKUP, MiniQLParserINCREMENTAL, MiniQLParserVACUUM, MiniQLParserRETAIN, MiniQLParserHOURS, MiniQLParserDRY, MiniQLParserRUN, MiniQLParserPREPARE, MiniQLParserEXECUTE, MiniQLParserDEALLOCATE, MiniQLParserCOPY, MiniQLParserEXPORT, MiniQLParserIMPORT, MiniQLParserEXTERNAL, MiniQLParserLOCATION, MiniQLParserFORMAT:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(517)
-			p.Literal()
+			p.SetState(1244)
+			p.NonReservedKeyword()
 		}
 
-		p.SetState(522)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -9573,237 +21431,329 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITableNameContext is an interface to support dynamic dispatch.
-type ITableNameContext interface {
+// INonReservedKeywordContext is an interface to support dynamic dispatch.
+type INonReservedKeywordContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIdentifier() []IIdentifierContext
-	Identifier(i int) IIdentifierContext
-	DOT() antlr.TerminalNode
-
-	// IsTableNameContext differentiates from other interfaces.
-	IsTableNameContext()
-}
-
-type TableNameContext struct {
+	RESET() antlr.TerminalNode
+	TIME() antlr.TerminalNode
+	ZONE() antlr.TerminalNode
+	LIST() antlr.TerminalNode
+	PARTITIONS() antlr.TerminalNode
+	LESS_KW() antlr.TerminalNode
+	THAN() antlr.TerminalNode
+	MAXVALUE() antlr.TerminalNode
+	TBLPROPERTIES() antlr.TerminalNode
+	UNSET() antlr.TerminalNode
+	SHALLOW() antlr.TerminalNode
+	CLONE() antlr.TerminalNode
+	VERSION() antlr.TerminalNode
+	DESCRIBE() antlr.TerminalNode
+	EXTENDED() antlr.TerminalNode
+	COMMENT() antlr.TerminalNode
+	COLUMN() antlr.TerminalNode
+	IS() antlr.TerminalNode
+	USER() antlr.TerminalNode
+	ROLE() antlr.TerminalNode
+	PASSWORD() antlr.TerminalNode
+	SUPERUSER() antlr.TerminalNode
+	NOSUPERUSER() antlr.TerminalNode
+	GRANT() antlr.TerminalNode
+	REVOKE() antlr.TerminalNode
+	PRIVILEGES() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
+	KILL() antlr.TerminalNode
+	QUERY() antlr.TerminalNode
+	SESSION() antlr.TerminalNode
+	CONNECTION() antlr.TerminalNode
+	RESTORE() antlr.TerminalNode
+	BACKUP() antlr.TerminalNode
+	INCREMENTAL() antlr.TerminalNode
+	VACUUM() antlr.TerminalNode
+	RETAIN() antlr.TerminalNode
+	HOURS() antlr.TerminalNode
+	DRY() antlr.TerminalNode
+	RUN() antlr.TerminalNode
+	PREPARE() antlr.TerminalNode
+	EXECUTE() antlr.TerminalNode
+	DEALLOCATE() antlr.TerminalNode
+	COPY() antlr.TerminalNode
+	EXPORT() antlr.TerminalNode
+	IMPORT() antlr.TerminalNode
+	EXTERNAL() antlr.TerminalNode
+	LOCATION() antlr.TerminalNode
+	FORMAT() antlr.TerminalNode
+
+	// IsNonReservedKeywordContext differentiates from other interfaces.
+	IsNonReservedKeywordContext()
+}
+
+type NonReservedKeywordContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTableNameContext() *TableNameContext {
-	var p = new(TableNameContext)
+func NewEmptyNonReservedKeywordContext() *NonReservedKeywordContext {
+	var p = new(NonReservedKeywordContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableName
+	p.RuleIndex = MiniQLParserRULE_nonReservedKeyword
 	return p
 }
 
-func InitEmptyTableNameContext(p *TableNameContext) {
+func InitEmptyNonReservedKeywordContext(p *NonReservedKeywordContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_tableName
+	p.RuleIndex = MiniQLParserRULE_nonReservedKeyword
 }
 
-func (*TableNameContext) IsTableNameContext() {}
+func (*NonReservedKeywordContext) IsNonReservedKeywordContext() {}
 
-func NewTableNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TableNameContext {
-	var p = new(TableNameContext)
+func NewNonReservedKeywordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NonReservedKeywordContext {
+	var p = new(NonReservedKeywordContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_tableName
+	p.RuleIndex = MiniQLParserRULE_nonReservedKeyword
 
 	return p
 }
 
-func (s *TableNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *NonReservedKeywordContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TableNameContext) AllIdentifier() []IIdentifierContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			len++
-		}
-	}
+func (s *NonReservedKeywordContext) RESET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRESET, 0)
+}
 
-	tst := make([]IIdentifierContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IIdentifierContext); ok {
-			tst[i] = t.(IIdentifierContext)
-			i++
-		}
-	}
+func (s *NonReservedKeywordContext) TIME() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTIME, 0)
+}
+
+func (s *NonReservedKeywordContext) ZONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserZONE, 0)
+}
+
+func (s *NonReservedKeywordContext) LIST() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLIST, 0)
+}
+
+func (s *NonReservedKeywordContext) PARTITIONS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPARTITIONS, 0)
+}
+
+func (s *NonReservedKeywordContext) LESS_KW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLESS_KW, 0)
+}
+
+func (s *NonReservedKeywordContext) THAN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTHAN, 0)
+}
+
+func (s *NonReservedKeywordContext) MAXVALUE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserMAXVALUE, 0)
+}
+
+func (s *NonReservedKeywordContext) TBLPROPERTIES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserTBLPROPERTIES, 0)
+}
+
+func (s *NonReservedKeywordContext) UNSET() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUNSET, 0)
+}
+
+func (s *NonReservedKeywordContext) SHALLOW() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSHALLOW, 0)
+}
+
+func (s *NonReservedKeywordContext) CLONE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCLONE, 0)
+}
+
+func (s *NonReservedKeywordContext) VERSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVERSION, 0)
+}
+
+func (s *NonReservedKeywordContext) DESCRIBE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDESCRIBE, 0)
+}
+
+func (s *NonReservedKeywordContext) EXTENDED() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXTENDED, 0)
+}
+
+func (s *NonReservedKeywordContext) COMMENT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOMMENT, 0)
+}
+
+func (s *NonReservedKeywordContext) COLUMN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOLUMN, 0)
+}
+
+func (s *NonReservedKeywordContext) IS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIS, 0)
+}
+
+func (s *NonReservedKeywordContext) USER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserUSER, 0)
+}
+
+func (s *NonReservedKeywordContext) ROLE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserROLE, 0)
+}
 
-	return tst
+func (s *NonReservedKeywordContext) PASSWORD() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPASSWORD, 0)
 }
 
-func (s *TableNameContext) Identifier(i int) IIdentifierContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+func (s *NonReservedKeywordContext) SUPERUSER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSUPERUSER, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *NonReservedKeywordContext) NOSUPERUSER() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserNOSUPERUSER, 0)
+}
 
-	return t.(IIdentifierContext)
+func (s *NonReservedKeywordContext) GRANT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserGRANT, 0)
 }
 
-func (s *TableNameContext) DOT() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserDOT, 0)
+func (s *NonReservedKeywordContext) REVOKE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserREVOKE, 0)
 }
 
-func (s *TableNameContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *NonReservedKeywordContext) PRIVILEGES() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPRIVILEGES, 0)
 }
 
-func (s *TableNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *NonReservedKeywordContext) IF() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIF, 0)
 }
 
-func (s *TableNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case MiniQLVisitor:
-		return t.VisitTableName(s)
+func (s *NonReservedKeywordContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXISTS, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *NonReservedKeywordContext) KILL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserKILL, 0)
 }
 
-func (p *MiniQLParser) TableName() (localctx ITableNameContext) {
-	localctx = NewTableNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, MiniQLParserRULE_tableName)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(523)
-		p.Identifier()
-	}
-	p.SetState(526)
-	p.GetErrorHandler().Sync(p)
+func (s *NonReservedKeywordContext) QUERY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserQUERY, 0)
+}
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(524)
-			p.Match(MiniQLParserDOT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(525)
-			p.Identifier()
-		}
+func (s *NonReservedKeywordContext) SESSION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserSESSION, 0)
+}
 
-	} else if p.HasError() { // JIM
-		goto errorExit
-	}
+func (s *NonReservedKeywordContext) CONNECTION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCONNECTION, 0)
+}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
+func (s *NonReservedKeywordContext) RESTORE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRESTORE, 0)
 }
 
-// IIdentifierContext is an interface to support dynamic dispatch.
-type IIdentifierContext interface {
-	antlr.ParserRuleContext
+func (s *NonReservedKeywordContext) BACKUP() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserBACKUP, 0)
+}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *NonReservedKeywordContext) INCREMENTAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINCREMENTAL, 0)
+}
 
-	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
+func (s *NonReservedKeywordContext) VACUUM() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserVACUUM, 0)
+}
 
-	// IsIdentifierContext differentiates from other interfaces.
-	IsIdentifierContext()
+func (s *NonReservedKeywordContext) RETAIN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRETAIN, 0)
 }
 
-type IdentifierContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *NonReservedKeywordContext) HOURS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserHOURS, 0)
 }
 
-func NewEmptyIdentifierContext() *IdentifierContext {
-	var p = new(IdentifierContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_identifier
-	return p
+func (s *NonReservedKeywordContext) DRY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDRY, 0)
 }
 
-func InitEmptyIdentifierContext(p *IdentifierContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = MiniQLParserRULE_identifier
+func (s *NonReservedKeywordContext) RUN() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserRUN, 0)
 }
 
-func (*IdentifierContext) IsIdentifierContext() {}
+func (s *NonReservedKeywordContext) PREPARE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPREPARE, 0)
+}
 
-func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
-	var p = new(IdentifierContext)
+func (s *NonReservedKeywordContext) EXECUTE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXECUTE, 0)
+}
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (s *NonReservedKeywordContext) DEALLOCATE() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserDEALLOCATE, 0)
+}
 
-	p.parser = parser
-	p.RuleIndex = MiniQLParserRULE_identifier
+func (s *NonReservedKeywordContext) COPY() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserCOPY, 0)
+}
 
-	return p
+func (s *NonReservedKeywordContext) EXPORT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXPORT, 0)
 }
 
-func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
+func (s *NonReservedKeywordContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserIMPORT, 0)
+}
 
-func (s *IdentifierContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(MiniQLParserIDENTIFIER, 0)
+func (s *NonReservedKeywordContext) EXTERNAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserEXTERNAL, 0)
 }
 
-func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
+func (s *NonReservedKeywordContext) LOCATION() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserLOCATION, 0)
+}
+
+func (s *NonReservedKeywordContext) FORMAT() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFORMAT, 0)
+}
+
+func (s *NonReservedKeywordContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NonReservedKeywordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NonReservedKeywordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case MiniQLVisitor:
-		return t.VisitIdentifier(s)
+		return t.VisitNonReservedKeyword(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *MiniQLParser) Identifier() (localctx IIdentifierContext) {
-	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, MiniQLParserRULE_identifier)
+func (p *MiniQLParser) NonReservedKeyword() (localctx INonReservedKeywordContext) {
+	localctx = NewNonReservedKeywordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 184, MiniQLParserRULE_nonReservedKeyword)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
-		p.Match(MiniQLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(1247)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-67)) & ^0x3f) == 0 && ((int64(1)<<(_la-67))&4503599627370439) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
 
@@ -9930,10 +21880,10 @@ func (s *DataTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	localctx = NewDataTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, MiniQLParserRULE_dataType)
+	p.EnterRule(localctx, 186, MiniQLParserRULE_dataType)
 	var _la int
 
-	p.SetState(541)
+	p.SetState(1260)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9943,7 +21893,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserINT_TYPE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(530)
+			p.SetState(1249)
 			p.Match(MiniQLParserINT_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9954,7 +21904,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserINTEGER_TYPE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(531)
+			p.SetState(1250)
 			p.Match(MiniQLParserINTEGER_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9965,14 +21915,14 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserVARCHAR_TYPE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(532)
+			p.SetState(1251)
 			p.Match(MiniQLParserVARCHAR_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(536)
+		p.SetState(1255)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9981,7 +21931,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 
 		if _la == MiniQLParserLEFT_PAREN {
 			{
-				p.SetState(533)
+				p.SetState(1252)
 				p.Match(MiniQLParserLEFT_PAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -9989,7 +21939,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 				}
 			}
 			{
-				p.SetState(534)
+				p.SetState(1253)
 				p.Match(MiniQLParserINTEGER_LITERAL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -9997,7 +21947,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 				}
 			}
 			{
-				p.SetState(535)
+				p.SetState(1254)
 				p.Match(MiniQLParserRIGHT_PAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -10010,7 +21960,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserBOOLEAN_TYPE:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(538)
+			p.SetState(1257)
 			p.Match(MiniQLParserBOOLEAN_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10021,7 +21971,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserDOUBLE_TYPE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(539)
+			p.SetState(1258)
 			p.Match(MiniQLParserDOUBLE_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10032,7 +21982,7 @@ func (p *MiniQLParser) DataType() (localctx IDataTypeContext) {
 	case MiniQLParserTIMESTAMP_TYPE:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(540)
+			p.SetState(1259)
 			p.Match(MiniQLParserTIMESTAMP_TYPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10058,6 +22008,168 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// ISignedLiteralContext is an interface to support dynamic dispatch.
+type ISignedLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Literal() ILiteralContext
+	PLUS() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	FLOAT_LITERAL() antlr.TerminalNode
+
+	// IsSignedLiteralContext differentiates from other interfaces.
+	IsSignedLiteralContext()
+}
+
+type SignedLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySignedLiteralContext() *SignedLiteralContext {
+	var p = new(SignedLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_signedLiteral
+	return p
+}
+
+func InitEmptySignedLiteralContext(p *SignedLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = MiniQLParserRULE_signedLiteral
+}
+
+func (*SignedLiteralContext) IsSignedLiteralContext() {}
+
+func NewSignedLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SignedLiteralContext {
+	var p = new(SignedLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = MiniQLParserRULE_signedLiteral
+
+	return p
+}
+
+func (s *SignedLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SignedLiteralContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *SignedLiteralContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserPLUS, 0)
+}
+
+func (s *SignedLiteralContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserMINUS, 0)
+}
+
+func (s *SignedLiteralContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserINTEGER_LITERAL, 0)
+}
+
+func (s *SignedLiteralContext) FLOAT_LITERAL() antlr.TerminalNode {
+	return s.GetToken(MiniQLParserFLOAT_LITERAL, 0)
+}
+
+func (s *SignedLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SignedLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SignedLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case MiniQLVisitor:
+		return t.VisitSignedLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *MiniQLParser) SignedLiteral() (localctx ISignedLiteralContext) {
+	localctx = NewSignedLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 188, MiniQLParserRULE_signedLiteral)
+	var _la int
+
+	p.SetState(1265)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case MiniQLParserNULL, MiniQLParserTRUE, MiniQLParserFALSE, MiniQLParserINTEGER_LITERAL, MiniQLParserFLOAT_LITERAL, MiniQLParserSTRING_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1262)
+			p.Literal()
+		}
+
+	case MiniQLParserPLUS, MiniQLParserMINUS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1263)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserPLUS || _la == MiniQLParserMINUS) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(1264)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == MiniQLParserINTEGER_LITERAL || _la == MiniQLParserFLOAT_LITERAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // ILiteralContext is an interface to support dynamic dispatch.
 type ILiteralContext interface {
 	antlr.ParserRuleContext
@@ -10153,15 +22265,15 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *MiniQLParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, MiniQLParserRULE_literal)
+	p.EnterRule(localctx, 190, MiniQLParserRULE_literal)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(543)
+		p.SetState(1267)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-24)) & ^0x3f) == 0 && ((int64(1)<<(_la-24))&2017612633061982215) != 0) {
+		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&117440512) != 0) || ((int64((_la-136)) & ^0x3f) == 0 && ((int64(1)<<(_la-136))&7) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -10184,14 +22296,14 @@ errorExit:
 
 func (p *MiniQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 21:
+	case 43:
 		var t *TableReferenceContext = nil
 		if localctx != nil {
 			t = localctx.(*TableReferenceContext)
 		}
 		return p.TableReference_Sempred(t, predIndex)
 
-	case 24:
+	case 47:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
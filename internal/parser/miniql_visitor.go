@@ -34,6 +34,42 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#createTable.
 	VisitCreateTable(ctx *CreateTableContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#cloneTable.
+	VisitCloneTable(ctx *CloneTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#createExternalTable.
+	VisitCreateExternalTable(ctx *CreateExternalTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#alterTable.
+	VisitAlterTable(ctx *AlterTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#restoreTable.
+	VisitRestoreTable(ctx *RestoreTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#commentStatement.
+	VisitCommentStatement(ctx *CommentStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#tableProperty.
+	VisitTableProperty(ctx *TablePropertyContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#propertyName.
+	VisitPropertyName(ctx *PropertyNameContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#partitionValue.
+	VisitPartitionValue(ctx *PartitionValueContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#optionList.
+	VisitOptionList(ctx *OptionListContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#option.
+	VisitOption(ctx *OptionContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#optionName.
+	VisitOptionName(ctx *OptionNameContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#optionValue.
+	VisitOptionValue(ctx *OptionValueContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#columnDef.
 	VisitColumnDef(ctx *ColumnDefContext) interface{}
 
@@ -55,6 +91,36 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#dropDatabase.
 	VisitDropDatabase(ctx *DropDatabaseContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#createUser.
+	VisitCreateUser(ctx *CreateUserContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#userOption.
+	VisitUserOption(ctx *UserOptionContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#createRole.
+	VisitCreateRole(ctx *CreateRoleContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#dropRole.
+	VisitDropRole(ctx *DropRoleContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#grantStatement.
+	VisitGrantStatement(ctx *GrantStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#revokeStatement.
+	VisitRevokeStatement(ctx *RevokeStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#privilegeList.
+	VisitPrivilegeList(ctx *PrivilegeListContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#privilege.
+	VisitPrivilege(ctx *PrivilegeContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#grantObject.
+	VisitGrantObject(ctx *GrantObjectContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#grantObjectPart.
+	VisitGrantObjectPart(ctx *GrantObjectPartContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#insertStatement.
 	VisitInsertStatement(ctx *InsertStatementContext) interface{}
 
@@ -82,6 +148,12 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#tableRefSubquery.
 	VisitTableRefSubquery(ctx *TableRefSubqueryContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#tableRefFunction.
+	VisitTableRefFunction(ctx *TableRefFunctionContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#tableFunction.
+	VisitTableFunction(ctx *TableFunctionContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#joinType.
 	VisitJoinType(ctx *JoinTypeContext) interface{}
 
@@ -121,6 +193,9 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#parenExpr.
 	VisitParenExpr(ctx *ParenExprContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#parameterExpr.
+	VisitParameterExpr(ctx *ParameterExprContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#comparisonOperator.
 	VisitComparisonOperator(ctx *ComparisonOperatorContext) interface{}
 
@@ -142,6 +217,15 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#partitionMethod.
 	VisitPartitionMethod(ctx *PartitionMethodContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#partitionDefinitions.
+	VisitPartitionDefinitions(ctx *PartitionDefinitionsContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#partitionDefinition.
+	VisitPartitionDefinition(ctx *PartitionDefinitionContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#partitionBound.
+	VisitPartitionBound(ctx *PartitionBoundContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#transactionStatement.
 	VisitTransactionStatement(ctx *TransactionStatementContext) interface{}
 
@@ -157,30 +241,99 @@ type MiniQLVisitor interface {
 	// Visit a parse tree produced by MiniQLParser#showIndexes.
 	VisitShowIndexes(ctx *ShowIndexesContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#showCreateTable.
+	VisitShowCreateTable(ctx *ShowCreateTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#describeTable.
+	VisitDescribeTable(ctx *DescribeTableContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#explainStatement.
 	VisitExplainStatement(ctx *ExplainStatementContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#explainOption.
+	VisitExplainOption(ctx *ExplainOptionContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#analyzeStatement.
 	VisitAnalyzeStatement(ctx *AnalyzeStatementContext) interface{}
 
 	// Visit a parse tree produced by MiniQLParser#columnList.
 	VisitColumnList(ctx *ColumnListContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#setStatement.
+	VisitSetStatement(ctx *SetStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#showVariable.
+	VisitShowVariable(ctx *ShowVariableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#resetStatement.
+	VisitResetStatement(ctx *ResetStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#variableName.
+	VisitVariableName(ctx *VariableNameContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#prepareStatement.
+	VisitPrepareStatement(ctx *PrepareStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#parameterType.
+	VisitParameterType(ctx *ParameterTypeContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#executeStatement.
+	VisitExecuteStatement(ctx *ExecuteStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#deallocateStatement.
+	VisitDeallocateStatement(ctx *DeallocateStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#copyStatement.
+	VisitCopyStatement(ctx *CopyStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#exportTable.
+	VisitExportTable(ctx *ExportTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#importTable.
+	VisitImportTable(ctx *ImportTableContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#tableFormat.
+	VisitTableFormat(ctx *TableFormatContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#backupDatabase.
+	VisitBackupDatabase(ctx *BackupDatabaseContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#restoreDatabase.
+	VisitRestoreDatabase(ctx *RestoreDatabaseContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#killStatement.
+	VisitKillStatement(ctx *KillStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#vacuumStatement.
+	VisitVacuumStatement(ctx *VacuumStatementContext) interface{}
+
+	// Visit a parse tree produced by MiniQLParser#setValue.
+	VisitSetValue(ctx *SetValueContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#identifierList.
 	VisitIdentifierList(ctx *IdentifierListContext) interface{}
 
 	// Visit a parse tree produced by MiniQLParser#valueList.
 	VisitValueList(ctx *ValueListContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#valueItem.
+	VisitValueItem(ctx *ValueItemContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#tableName.
 	VisitTableName(ctx *TableNameContext) interface{}
 
 	// Visit a parse tree produced by MiniQLParser#identifier.
 	VisitIdentifier(ctx *IdentifierContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#nonReservedKeyword.
+	VisitNonReservedKeyword(ctx *NonReservedKeywordContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#dataType.
 	VisitDataType(ctx *DataTypeContext) interface{}
 
+	// Visit a parse tree produced by MiniQLParser#signedLiteral.
+	VisitSignedLiteral(ctx *SignedLiteralContext) interface{}
+
 	// Visit a parse tree produced by MiniQLParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 }
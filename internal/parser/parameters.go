@@ -0,0 +1,94 @@
+package parser
+
+import "fmt"
+
+// 预处理语句参数
+//
+// MiniQL.g4 中的 PARAM 词法单元 ($n) 只能出现在表达式位置 (WHERE/HAVING/ON 条件、SELECT 列表达式、
+// ORDER BY、INSERT VALUES 和 UPDATE SET 的值)，访问器在 PREPARE 的语句体中把它们转换为 Parameter 节点。
+
+// 参数类型 (PREPARE name (type, ...) 中声明的类型)
+const (
+	ParameterTypeInt       = "INT"
+	ParameterTypeFloat     = "FLOAT"
+	ParameterTypeVarchar   = "VARCHAR"
+	ParameterTypeBoolean   = "BOOLEAN"
+	ParameterTypeDate      = "DATE"
+	ParameterTypeTimestamp = "TIMESTAMP"
+)
+
+// parameterTypes 支持的参数类型名及其规范名
+var parameterTypes = map[string]string{
+	"INT": ParameterTypeInt, "INTEGER": ParameterTypeInt, "BIGINT": ParameterTypeInt,
+	"SMALLINT": ParameterTypeInt, "TINYINT": ParameterTypeInt,
+	"FLOAT": ParameterTypeFloat, "DOUBLE": ParameterTypeFloat, "REAL": ParameterTypeFloat,
+	"DECIMAL": ParameterTypeFloat, "NUMERIC": ParameterTypeFloat,
+	"VARCHAR": ParameterTypeVarchar, "CHAR": ParameterTypeVarchar,
+	"TEXT": ParameterTypeVarchar, "STRING": ParameterTypeVarchar,
+	"BOOL": ParameterTypeBoolean, "BOOLEAN": ParameterTypeBoolean,
+	"DATE": ParameterTypeDate, "TIMESTAMP": ParameterTypeTimestamp,
+}
+
+// rewriteExpr 复制表达式树，fn 返回非 nil 时用返回值替换对应的叶子节点
+func rewriteExpr(node Node, fn func(Node) Node) Node {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *BinaryExpr:
+		c := *n
+		c.Left, c.Right = rewriteExpr(n.Left, fn), rewriteExpr(n.Right, fn)
+		return &c
+	case *ComparisonExpr:
+		c := *n
+		c.Left, c.Right = rewriteExpr(n.Left, fn), rewriteExpr(n.Right, fn)
+		return &c
+	case *LogicalExpr:
+		c := *n
+		c.Left, c.Right = rewriteExpr(n.Left, fn), rewriteExpr(n.Right, fn)
+		return &c
+	case *FunctionCall:
+		c := *n
+		c.Args = make([]Node, len(n.Args))
+		for i, arg := range n.Args {
+			c.Args[i] = rewriteExpr(arg, fn)
+		}
+		return &c
+	case *InExpr:
+		c := *n
+		c.Left = rewriteExpr(n.Left, fn)
+		c.Values = make([]Node, len(n.Values))
+		for i, value := range n.Values {
+			c.Values[i] = rewriteExpr(value, fn)
+		}
+		return &c
+	}
+	if replaced := fn(node); replaced != nil {
+		return replaced
+	}
+	return node
+}
+
+// SubstituteParameters 返回把表达式中的参数替换为 fn 返回值的副本，原表达式不变
+func SubstituteParameters(expr Node, fn func(*Parameter) Node) Node {
+	return rewriteExpr(expr, func(node Node) Node {
+		if param, ok := node.(*Parameter); ok {
+			return fn(param)
+		}
+		return nil
+	})
+}
+
+// NewLiteral 创建字面量节点，value 为 int64/float64/string/bool
+func NewLiteral(value interface{}) (Node, error) {
+	switch v := value.(type) {
+	case int64:
+		return &IntegerLiteral{BaseNode: BaseNode{nodeType: IntegerLiteralNode}, Value: v}, nil
+	case float64:
+		return &FloatLiteral{BaseNode: BaseNode{nodeType: FloatLiteralNode}, Value: v}, nil
+	case string:
+		return &StringLiteral{BaseNode: BaseNode{nodeType: StringLiteralNode}, Value: v}, nil
+	case bool:
+		return &BooleanLiteral{BaseNode: BaseNode{nodeType: BooleanLiteralNode}, Value: v}, nil
+	}
+	return nil, fmt.Errorf("unsupported literal value %v (%T)", value, value)
+}
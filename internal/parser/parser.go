@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/antlr4-go/antlr/v4"
@@ -13,12 +14,33 @@ import (
 // MiniQLVisitorImpl 是 MiniQL 的访问器实现
 type MiniQLVisitorImpl struct {
 	BaseMiniQLVisitor
+	syntax     *syntaxErrorListener // 词法和语法分析中记录的错误
+	err        error                // 访问过程中发现的第一个错误
+	parameters []*Parameter         // PREPARE 语句体中的参数占位符，为 nil 时不在 PREPARE 语句体中
+}
+
+// syntaxErrorListener 记录词法和语法分析中的第一个错误
+// 早期的语句依赖 ANTLR 的错误恢复，语法错误不会中断解析；新增的语句在访问时通过 syntaxError 检查
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	err error
+}
+
+// SyntaxError 记录第一个错误，忽略 DiagnosticErrorListener 报告的歧义诊断（没有出错的词法单元和异常）
+func (l *syntaxErrorListener) SyntaxError(_ antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	if l.err != nil || (offendingSymbol == nil && e == nil) {
+		return
+	}
+	if token, ok := offendingSymbol.(antlr.Token); ok && token.GetTokenType() == MiniQLParserPARAM {
+		msg = fmt.Sprintf("parameter %s: parameters are only supported in expressions", token.GetText())
+	}
+	l.err = fmt.Errorf("syntax error at line %d:%d: %s", line, column, msg)
 }
 
 // Parse 是对外主要接口，传入 SQL 字符串返回 AST 节点
 func Parse(sql string) (Node, error) {
 	logger.WithComponent("parser").Debug("Starting SQL parsing",
-		zap.String("sql", sql),
+		zap.String("sql", RedactPasswords(sql)),
 		zap.Int("sql_length", len(sql)))
 
 	start := time.Now()
@@ -29,6 +51,8 @@ func Parse(sql string) (Node, error) {
 	lexer := NewMiniQLLexer(input)
 	lexer.RemoveErrorListeners()
 	lexer.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
+	syntax := &syntaxErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer.AddErrorListener(syntax)
 	logger.WithComponent("parser").Debug("Lexer created successfully",
 		zap.Duration("lexer_creation_time", time.Since(lexerStart)))
 
@@ -38,6 +62,7 @@ func Parse(sql string) (Node, error) {
 	parser := NewMiniQLParser(stream)
 	parser.RemoveErrorListeners()
 	parser.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
+	parser.AddErrorListener(syntax)
 	logger.WithComponent("parser").Debug("Parser created successfully",
 		zap.Duration("parser_creation_time", time.Since(parserStart)))
 
@@ -48,8 +73,14 @@ func Parse(sql string) (Node, error) {
 		zap.Duration("parse_tree_generation_time", time.Since(parseTreeStart)))
 
 	visitorStart := time.Now()
-	visitor := &MiniQLVisitorImpl{}
+	visitor := &MiniQLVisitorImpl{syntax: syntax}
 	result := visitor.Visit(parseTree)
+	if visitor.err != nil {
+		logger.WithComponent("parser").Debug("Failed to parse SQL",
+			zap.Error(visitor.err),
+			zap.Duration("duration", time.Since(start)))
+		return nil, visitor.err
+	}
 	if result == nil {
 		logger.WithComponent("parser").Error("Failed to parse SQL - visitor returned nil",
 			zap.String("sql", sql),
@@ -83,6 +114,22 @@ func (v *MiniQLVisitorImpl) Visit(tree antlr.ParseTree) interface{} {
 	return tree.Accept(v)
 }
 
+// fail 记录访问过程中的错误并返回 nil，Parse 返回记录的错误
+func (v *MiniQLVisitorImpl) fail(err error) interface{} {
+	if v.err == nil {
+		v.err = err
+	}
+	return nil
+}
+
+// syntaxError 返回词法和语法分析中记录的第一个错误
+func (v *MiniQLVisitorImpl) syntaxError() error {
+	if v.syntax == nil {
+		return nil
+	}
+	return v.syntax.err
+}
+
 // VisitParse 访问根语法规则 parse
 func (v *MiniQLVisitorImpl) VisitParse(ctx *ParseContext) interface{} {
 	if len(ctx.AllSqlStatement()) > 0 {
@@ -153,6 +200,21 @@ func (v *MiniQLVisitorImpl) VisitDdlStatement(ctx *DdlStatementContext) interfac
 	if ctx.CreateTable() != nil {
 		return v.Visit(ctx.CreateTable())
 	}
+	if ctx.CloneTable() != nil {
+		return v.Visit(ctx.CloneTable())
+	}
+	if ctx.CreateExternalTable() != nil {
+		return v.Visit(ctx.CreateExternalTable())
+	}
+	if ctx.AlterTable() != nil {
+		return v.Visit(ctx.AlterTable())
+	}
+	if ctx.RestoreTable() != nil {
+		return v.Visit(ctx.RestoreTable())
+	}
+	if ctx.CommentStatement() != nil {
+		return v.Visit(ctx.CommentStatement())
+	}
 	if ctx.CreateIndex() != nil {
 		return v.Visit(ctx.CreateIndex())
 	}
@@ -168,11 +230,26 @@ func (v *MiniQLVisitorImpl) VisitDdlStatement(ctx *DdlStatementContext) interfac
 	return nil
 }
 
-// VisitDclStatement 处理 DCL 语句（事务）
+// VisitDclStatement 处理 DCL 语句（事务、用户和权限）
 func (v *MiniQLVisitorImpl) VisitDclStatement(ctx *DclStatementContext) interface{} {
 	if ctx.TransactionStatement() != nil {
 		return v.Visit(ctx.TransactionStatement())
 	}
+	if ctx.CreateUser() != nil {
+		return v.Visit(ctx.CreateUser())
+	}
+	if ctx.CreateRole() != nil {
+		return v.Visit(ctx.CreateRole())
+	}
+	if ctx.DropRole() != nil {
+		return v.Visit(ctx.DropRole())
+	}
+	if ctx.GrantStatement() != nil {
+		return v.Visit(ctx.GrantStatement())
+	}
+	if ctx.RevokeStatement() != nil {
+		return v.Visit(ctx.RevokeStatement())
+	}
 	return nil
 }
 
@@ -190,12 +267,57 @@ func (v *MiniQLVisitorImpl) VisitUtilityStatement(ctx *UtilityStatementContext)
 	if ctx.ShowIndexes() != nil {
 		return v.Visit(ctx.ShowIndexes())
 	}
+	if ctx.ShowCreateTable() != nil {
+		return v.Visit(ctx.ShowCreateTable())
+	}
+	if ctx.DescribeTable() != nil {
+		return v.Visit(ctx.DescribeTable())
+	}
 	if ctx.ExplainStatement() != nil {
 		return v.Visit(ctx.ExplainStatement())
 	}
 	if ctx.AnalyzeStatement() != nil {
 		return v.Visit(ctx.AnalyzeStatement())
 	}
+	if ctx.SetStatement() != nil {
+		return v.Visit(ctx.SetStatement())
+	}
+	if ctx.ShowVariable() != nil {
+		return v.Visit(ctx.ShowVariable())
+	}
+	if ctx.ResetStatement() != nil {
+		return v.Visit(ctx.ResetStatement())
+	}
+	if ctx.PrepareStatement() != nil {
+		return v.Visit(ctx.PrepareStatement())
+	}
+	if ctx.ExecuteStatement() != nil {
+		return v.Visit(ctx.ExecuteStatement())
+	}
+	if ctx.DeallocateStatement() != nil {
+		return v.Visit(ctx.DeallocateStatement())
+	}
+	if ctx.CopyStatement() != nil {
+		return v.Visit(ctx.CopyStatement())
+	}
+	if ctx.ExportTable() != nil {
+		return v.Visit(ctx.ExportTable())
+	}
+	if ctx.ImportTable() != nil {
+		return v.Visit(ctx.ImportTable())
+	}
+	if ctx.VacuumStatement() != nil {
+		return v.Visit(ctx.VacuumStatement())
+	}
+	if ctx.KillStatement() != nil {
+		return v.Visit(ctx.KillStatement())
+	}
+	if ctx.BackupDatabase() != nil {
+		return v.Visit(ctx.BackupDatabase())
+	}
+	if ctx.RestoreDatabase() != nil {
+		return v.Visit(ctx.RestoreDatabase())
+	}
 	return nil
 }
 
@@ -267,6 +389,33 @@ func (v *MiniQLVisitorImpl) VisitCreateTable(ctx *CreateTableContext) interface{
 		}
 	}
 
+	// 处理 PARTITION BY 分区子句和 WITH 选项子句，两种子句顺序任意，各自最多出现一次
+	if len(ctx.AllPartitionMethod()) > 0 || len(ctx.AllOptionList()) > 0 {
+		if err := v.syntaxError(); err != nil {
+			return v.fail(err)
+		}
+	}
+	if len(ctx.AllPartitionMethod()) > 1 {
+		return v.fail(fmt.Errorf("syntax error: duplicate PARTITION BY clause"))
+	}
+	if len(ctx.AllOptionList()) > 1 {
+		return v.fail(fmt.Errorf("syntax error: duplicate WITH clause"))
+	}
+	if ctx.PartitionMethod(0) != nil {
+		partition, ok := v.Visit(ctx.PartitionMethod(0)).(*PartitionMethod)
+		if !ok {
+			return nil
+		}
+		stmt.Partition = partition
+	}
+	if ctx.OptionList(0) != nil {
+		options, err := v.optionList(ctx.OptionList(0), true)
+		if err != nil {
+			return v.fail(err)
+		}
+		stmt.Options = options
+	}
+
 	logger.WithComponent("parser").Info("CREATE TABLE statement parsed successfully",
 		zap.String("table_name", stmt.Table),
 		zap.Int("column_count", len(stmt.Columns)),
@@ -314,6 +463,11 @@ func (v *MiniQLVisitorImpl) VisitColumnConstraint(ctx *ColumnConstraintContext)
 		constraint.Type = PrimaryKeyConstraint
 	} else if ctx.NOT() != nil && ctx.NULL() != nil {
 		constraint.Type = NotNullConstraint
+	} else if ctx.UNIQUE() != nil {
+		constraint.Type = UniqueConstraint
+	} else if ctx.DEFAULT() != nil && ctx.Literal() != nil {
+		constraint.Type = DefaultConstraint
+		constraint.Value = ctx.Literal().GetText()
 	}
 
 	return constraint
@@ -664,6 +818,7 @@ func (v *MiniQLVisitorImpl) VisitSelectStatement(ctx *SelectStatementContext) in
 				stmt.Joins = t.Joins
 				stmt.FromAlias = t.Alias
 				stmt.FromSubquery = t.Subquery // 支持 FROM 子查询
+				stmt.FromFunction = t.Function
 			}
 		}
 	}
@@ -878,6 +1033,7 @@ func (v *MiniQLVisitorImpl) VisitTableReference(ctx *TableReferenceContext) inte
 		if leftRef, ok := result.(*TableRef); ok {
 			baseRef.Table = leftRef.Table
 			baseRef.Alias = leftRef.Alias
+			baseRef.Function = leftRef.Function
 			baseRef.Joins = leftRef.Joins
 		}
 	}
@@ -899,6 +1055,9 @@ func (v *MiniQLVisitorImpl) VisitTableReference(ctx *TableReferenceContext) inte
 	// 获取右表信息
 	if result := v.Visit(ctx.TableReferenceAtom()); result != nil {
 		if rightRef, ok := result.(*TableRef); ok {
+			if rightRef.Function != nil {
+				return v.fail(tableFunctionPlacementError(rightRef.Function))
+			}
 			join.Right = rightRef
 		}
 	}
@@ -928,6 +1087,9 @@ func (v *MiniQLVisitorImpl) VisitTableRefSubquery(ctx *TableRefSubqueryContext)
 	if ctx.SelectStatement() != nil {
 		if result := v.Visit(ctx.SelectStatement()); result != nil {
 			if subquery, ok := result.(*SelectStmt); ok {
+				if subquery.FromFunction != nil {
+					return v.fail(tableFunctionPlacementError(subquery.FromFunction))
+				}
 				tableRef.Subquery = subquery
 			}
 		}
@@ -948,6 +1110,50 @@ func (v *MiniQLVisitorImpl) VisitTableRefSubquery(ctx *TableRefSubqueryContext)
 	return tableRef
 }
 
+// tableFunctions 支持在 FROM 子句中使用的表函数
+var tableFunctions = map[string]bool{
+	TableFunctionTableChanges: true,
+}
+
+// VisitTableRefFunction 访问表函数引用，如 FROM table_changes('db.t', 10, 20) [AS] alias
+// 表函数的结果按同名的表引用处理，参数记录在 TableRef.Function 中
+func (v *MiniQLVisitorImpl) VisitTableRefFunction(ctx *TableRefFunctionContext) interface{} {
+	fn, ok := v.Visit(ctx.TableFunction()).(*TableFunction)
+	if !ok {
+		return nil
+	}
+	tableRef := &TableRef{
+		BaseNode: BaseNode{nodeType: TableRefNode},
+		Table:    fn.Name,
+		Function: fn,
+	}
+	if ctx.Identifier() != nil {
+		tableRef.Alias = ctx.Identifier().GetText()
+	}
+	return tableRef
+}
+
+// VisitTableFunction 访问表函数调用，参数只能是字面量
+func (v *MiniQLVisitorImpl) VisitTableFunction(ctx *TableFunctionContext) interface{} {
+	fn := &TableFunction{Name: strings.ToLower(ctx.Identifier().GetText())}
+	if !tableFunctions[fn.Name] {
+		return v.fail(fmt.Errorf("syntax error: unknown table function '%s'", fn.Name))
+	}
+	for _, arg := range ctx.AllSignedLiteral() {
+		value, err := signedLiteralValue(arg)
+		if err != nil {
+			return v.fail(err)
+		}
+		fn.Args = append(fn.Args, value)
+	}
+	return fn
+}
+
+// tableFunctionPlacementError 表函数只能直接出现在 SELECT 的 FROM 子句中，不能用于 JOIN 或子查询
+func tableFunctionPlacementError(fn *TableFunction) error {
+	return fmt.Errorf("syntax error: table function %s is only supported in the FROM clause of a SELECT", fn.Name)
+}
+
 // VisitJoinType 访问连接类型
 func (v *MiniQLVisitorImpl) VisitJoinType(ctx *JoinTypeContext) interface{} {
 	if ctx.INNER() != nil {
@@ -1110,6 +1316,28 @@ func (v *MiniQLVisitorImpl) VisitFunctionCallExpr(ctx *FunctionCallExprContext)
 	return nil
 }
 
+// VisitParameterExpr 访问参数占位符表达式 $n
+func (v *MiniQLVisitorImpl) VisitParameterExpr(ctx *ParameterExprContext) interface{} {
+	return v.parameter(ctx.PARAM())
+}
+
+// parameter 创建参数占位符节点，参数只能出现在 PREPARE 的语句体中
+// 出错时仍返回节点，避免上层表达式访问 nil，错误由 Parse 返回
+func (v *MiniQLVisitorImpl) parameter(token antlr.TerminalNode) Node {
+	text := token.GetText()
+	index, err := strconv.Atoi(text[1:])
+	param := &Parameter{BaseNode: BaseNode{nodeType: ParameterNode}, Index: index}
+	switch {
+	case err != nil || index < 1:
+		v.fail(fmt.Errorf("invalid parameter %s", text))
+	case v.parameters == nil:
+		v.fail(fmt.Errorf("parameter %s: parameters are only supported in PREPARE statements", text))
+	default:
+		v.parameters = append(v.parameters, param)
+	}
+	return param
+}
+
 // VisitParenExpr 访问括号表达式
 func (v *MiniQLVisitorImpl) VisitParenExpr(ctx *ParenExprContext) interface{} {
 	// 对于括号表达式，我们直接返回内部表达式的结果
@@ -1251,6 +1479,12 @@ func (v *MiniQLVisitorImpl) VisitFunctionCall(ctx *FunctionCallContext) interfac
 }
 
 // VisitPartitionMethod 访问分区方法节点
+//
+//	HASH (col, ...) [PARTITIONS n]
+//	RANGE (col) (PARTITION p VALUES LESS THAN (value | MAXVALUE), ...)
+//	LIST (col, ...) [(PARTITION p VALUES IN (value, ...), ...)]
+//
+// LIST 不带分区定义时按列值分区 (每个不同的值一个分区)
 func (v *MiniQLVisitorImpl) VisitPartitionMethod(ctx *PartitionMethodContext) interface{} {
 	if ctx == nil {
 		return nil
@@ -1262,10 +1496,13 @@ func (v *MiniQLVisitorImpl) VisitPartitionMethod(ctx *PartitionMethodContext) in
 	}
 
 	// 处理分区类型
-	if ctx.HASH() != nil {
+	switch {
+	case ctx.HASH() != nil:
 		partition.Type = "HASH"
-	} else if ctx.RANGE() != nil {
+	case ctx.RANGE() != nil:
 		partition.Type = "RANGE"
+	case ctx.LIST() != nil:
+		partition.Type = "LIST"
 	}
 
 	// 处理分区键列
@@ -1277,16 +1514,262 @@ func (v *MiniQLVisitorImpl) VisitPartitionMethod(ctx *PartitionMethodContext) in
 		}
 	}
 
-	// TODO 处理分区数量（仅用于 HASH 分区）
-	/*if ctx.INTEGER_LITERAL() != nil {
-		if num, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64); err == nil {
+	switch partition.Type {
+	case "HASH":
+		// 处理分区数量
+		if ctx.INTEGER_LITERAL() != nil {
+			num, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+			if err != nil || num <= 0 {
+				return v.fail(fmt.Errorf("syntax error: PARTITIONS must be a positive integer"))
+			}
 			partition.PartitionNum = int(num)
 		}
-	}*/
+		return partition
+	case "RANGE":
+		if len(partition.Columns) != 1 {
+			return v.fail(fmt.Errorf("RANGE partitioning requires exactly one column"))
+		}
+		if ctx.PartitionDefinitions() == nil {
+			return v.fail(fmt.Errorf("syntax error: RANGE partitioning requires partition definitions"))
+		}
+	case "LIST":
+		if ctx.PartitionDefinitions() == nil {
+			return partition
+		}
+		if len(partition.Columns) != 1 {
+			return v.fail(fmt.Errorf("LIST partitions with explicit values require exactly one column"))
+		}
+	}
 
+	defs, err := v.partitionDefinitions(ctx.PartitionDefinitions(), partition.Type)
+	if err != nil {
+		return v.fail(err)
+	}
+	partition.Partitions = defs
 	return partition
 }
 
+// partitionDefinitions 返回显式分区定义，RANGE 分区使用 VALUES LESS THAN，LIST 分区使用 VALUES IN
+func (v *MiniQLVisitorImpl) partitionDefinitions(ctx IPartitionDefinitionsContext, partitionType string) ([]*PartitionDefinition, error) {
+	var defs []*PartitionDefinition
+	names := make(map[string]bool)
+	for _, defCtx := range ctx.AllPartitionDefinition() {
+		name := defCtx.Identifier().GetText()
+		if names[strings.ToLower(name)] {
+			return nil, fmt.Errorf("syntax error: duplicate partition name '%s'", name)
+		}
+		names[strings.ToLower(name)] = true
+
+		def := &PartitionDefinition{Name: name}
+		if partitionType == "RANGE" {
+			if defCtx.LESS_KW() == nil {
+				return nil, fmt.Errorf("syntax error: RANGE partition '%s' requires VALUES LESS THAN", name)
+			}
+			if len(defs) > 0 && defs[len(defs)-1].MaxValue {
+				return nil, fmt.Errorf("syntax error: MAXVALUE can only be used in the last partition definition")
+			}
+			bound := defCtx.PartitionBound()
+			if bound.MAXVALUE() != nil {
+				def.MaxValue = true
+			} else {
+				value, err := signedLiteralValue(bound.SignedLiteral())
+				if err != nil {
+					return nil, err
+				}
+				if value == nil {
+					return nil, fmt.Errorf("syntax error: partition bound of '%s' cannot be NULL", name)
+				}
+				def.LessThan = value
+			}
+		} else {
+			if defCtx.IN() == nil {
+				return nil, fmt.Errorf("syntax error: LIST partition '%s' requires VALUES IN", name)
+			}
+			for _, lit := range defCtx.AllSignedLiteral() {
+				value, err := signedLiteralValue(lit)
+				if err != nil {
+					return nil, err
+				}
+				def.Values = append(def.Values, value)
+			}
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// VisitCloneTable 访问 SHALLOW CLONE 语句节点
+//
+//	CREATE TABLE t2 SHALLOW CLONE t1 [VERSION AS OF n]
+func (v *MiniQLVisitorImpl) VisitCloneTable(ctx *CloneTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &CloneTableStmt{
+		BaseNode: BaseNode{nodeType: CloneTableNode},
+		Table:    v.Visit(ctx.TableName(0)).(string),
+		Source:   v.Visit(ctx.TableName(1)).(string),
+		Version:  -1,
+	}
+	if ctx.INTEGER_LITERAL() != nil {
+		version, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+		if err != nil {
+			return v.fail(fmt.Errorf("syntax error: invalid version '%s'", ctx.INTEGER_LITERAL().GetText()))
+		}
+		stmt.Version = version
+	}
+
+	logger.WithComponent("parser").Debug("SHALLOW CLONE statement parsed successfully",
+		zap.String("table", stmt.Table),
+		zap.String("source", stmt.Source))
+
+	return stmt
+}
+
+// VisitCreateExternalTable 访问 CREATE EXTERNAL TABLE 语句节点
+//
+//	CREATE EXTERNAL TABLE t [(col type, ...)] LOCATION 'path' FORMAT parquet|csv [WITH (name = value, ...)]
+//
+// 省略列定义时由执行器根据数据文件推断表结构
+func (v *MiniQLVisitorImpl) VisitCreateExternalTable(ctx *CreateExternalTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &CreateTableStmt{
+		BaseNode: BaseNode{nodeType: CreateTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+	}
+	for _, colDef := range ctx.AllColumnDef() {
+		if col, ok := v.Visit(colDef).(*ColumnDef); ok {
+			stmt.Columns = append(stmt.Columns, col)
+		}
+	}
+	for _, constraint := range ctx.AllTableConstraint() {
+		if tc, ok := v.Visit(constraint).(*Constraint); ok {
+			stmt.Constraints = append(stmt.Constraints, tc)
+		}
+	}
+
+	external := &ExternalTable{
+		Location: unquoteString(ctx.STRING_LITERAL(0).GetText()),
+	}
+	if ctx.Identifier() != nil {
+		external.Format = ctx.Identifier().GetText()
+	} else {
+		external.Format = unquoteString(ctx.STRING_LITERAL(1).GetText())
+	}
+	external.Format = strings.ToLower(external.Format)
+	if ctx.OptionList() != nil {
+		options, err := v.optionList(ctx.OptionList(), true)
+		if err != nil {
+			return v.fail(err)
+		}
+		external.Options = options
+	}
+	stmt.External = external
+
+	logger.WithComponent("parser").Debug("CREATE EXTERNAL TABLE statement parsed successfully",
+		zap.String("table", stmt.Table),
+		zap.String("location", external.Location),
+		zap.String("format", external.Format))
+
+	return stmt
+}
+
+// VisitAlterTable 访问 ALTER TABLE 语句节点
+//
+//	ALTER TABLE t SET TBLPROPERTIES ('name' = value, ...)
+//	ALTER TABLE t UNSET TBLPROPERTIES ('name', ...)
+//	ALTER TABLE t DROP PARTITION p
+//	ALTER TABLE t DROP PARTITION (col = value, ...)
+func (v *MiniQLVisitorImpl) VisitAlterTable(ctx *AlterTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &AlterTableStmt{
+		BaseNode: BaseNode{nodeType: AlterTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+	}
+
+	switch {
+	case ctx.SET() != nil:
+		// 属性名大小写保持不变，值按字面量转为字符串
+		stmt.Action = AlterTableSetTableProperties
+		stmt.Properties = make(map[string]string)
+		for _, prop := range ctx.AllTableProperty() {
+			name := propertyName(prop.PropertyName())
+			if _, exists := stmt.Properties[name]; exists {
+				return v.fail(fmt.Errorf("syntax error: duplicate property '%s'", name))
+			}
+			value, err := optionValue(prop.OptionValue())
+			if err != nil {
+				return v.fail(err)
+			}
+			if value == nil {
+				return v.fail(fmt.Errorf("syntax error: property '%s' cannot be NULL", name))
+			}
+			stmt.Properties[name] = fmt.Sprint(value)
+		}
+	case ctx.UNSET() != nil:
+		stmt.Action = AlterTableUnsetTableProperties
+		for _, name := range ctx.AllPropertyName() {
+			stmt.PropertyNames = append(stmt.PropertyNames, propertyName(name))
+		}
+	default:
+		// 按分区名 (RANGE/LIST 显式分区) 或按分区列值 (LIST 列值分区) 指定分区
+		stmt.Action = AlterTableDropPartition
+		if ctx.Identifier() != nil {
+			stmt.PartitionName = ctx.Identifier().GetText()
+			break
+		}
+		stmt.PartitionValues = make(map[string]interface{})
+		for _, pv := range ctx.AllPartitionValue() {
+			column := pv.Identifier().GetText()
+			if _, exists := stmt.PartitionValues[column]; exists {
+				return v.fail(fmt.Errorf("syntax error: duplicate partition column '%s'", column))
+			}
+			value, err := signedLiteralValue(pv.SignedLiteral())
+			if err != nil {
+				return v.fail(err)
+			}
+			stmt.PartitionValues[column] = value
+		}
+	}
+
+	logger.WithComponent("parser").Debug("ALTER TABLE statement parsed successfully",
+		zap.String("table", stmt.Table),
+		zap.String("action", stmt.Action))
+
+	return stmt
+}
+
+// optionList 返回 (name = value, ...) 选项列表，选项名统一转为小写
+// requireEquals 为 true 时不允许省略等号
+func (v *MiniQLVisitorImpl) optionList(ctx IOptionListContext, requireEquals bool) (map[string]string, error) {
+	options := make(map[string]string)
+	for _, opt := range ctx.AllOption() {
+		name := strings.ToLower(opt.OptionName().GetText())
+		if _, exists := options[name]; exists {
+			return nil, fmt.Errorf("syntax error: duplicate option '%s'", name)
+		}
+		if opt.EQUAL() == nil && requireEquals {
+			return nil, fmt.Errorf("syntax error: expected '=' near '%s'", opt.OptionValue().GetText())
+		}
+		value, err := optionValue(opt.OptionValue())
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, fmt.Errorf("syntax error: option '%s' cannot be NULL", name)
+		}
+		options[name] = fmt.Sprint(value)
+	}
+	return options, nil
+}
+
 // VisitTransactionStatement 访问事务语句节点
 func (v *MiniQLVisitorImpl) VisitTransactionStatement(ctx *TransactionStatementContext) interface{} {
 	if ctx == nil {
@@ -1314,6 +1797,123 @@ func (v *MiniQLVisitorImpl) VisitTransactionStatement(ctx *TransactionStatementC
 	return stmt
 }
 
+// VisitCreateUser 访问 CREATE USER 语句节点
+//
+//	CREATE USER name [WITH] PASSWORD 'secret' [SUPERUSER | NOSUPERUSER]
+func (v *MiniQLVisitorImpl) VisitCreateUser(ctx *CreateUserContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &CreateRoleStmt{
+		BaseNode: BaseNode{nodeType: CreateRoleNode},
+		Name:     ctx.Identifier().GetText(),
+		Login:    true,
+	}
+	for _, opt := range ctx.AllUserOption() {
+		switch {
+		case opt.PASSWORD() != nil:
+			stmt.Password = unquoteString(opt.STRING_LITERAL().GetText())
+		case opt.SUPERUSER() != nil:
+			stmt.Superuser = true
+		case opt.NOSUPERUSER() != nil:
+			stmt.Superuser = false
+		}
+	}
+	if stmt.Password == "" {
+		return v.fail(fmt.Errorf("syntax error: CREATE USER %s requires PASSWORD '...'", stmt.Name))
+	}
+	return stmt
+}
+
+// VisitCreateRole 访问 CREATE ROLE 语句节点
+func (v *MiniQLVisitorImpl) VisitCreateRole(ctx *CreateRoleContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	return &CreateRoleStmt{
+		BaseNode: BaseNode{nodeType: CreateRoleNode},
+		Name:     ctx.Identifier().GetText(),
+	}
+}
+
+// VisitDropRole 访问 DROP USER / DROP ROLE [IF EXISTS] 语句节点
+func (v *MiniQLVisitorImpl) VisitDropRole(ctx *DropRoleContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	return &DropRoleStmt{
+		BaseNode: BaseNode{nodeType: DropRoleNode},
+		Name:     ctx.Identifier().GetText(),
+		Login:    ctx.USER() != nil,
+		IfExists: ctx.EXISTS() != nil,
+	}
+}
+
+// VisitGrantStatement 访问 GRANT 语句节点
+//
+//	GRANT {privilege [, ...] | ALL [PRIVILEGES]} ON [TABLE] {db.table | db.* | *.* | table} TO grantee [, ...]
+//	GRANT role [, ...] TO user [, ...]
+func (v *MiniQLVisitorImpl) VisitGrantStatement(ctx *GrantStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt, err := v.grant(ctx.PrivilegeList(), ctx.GrantObject(), ctx.AllIdentifierList())
+	if err != nil {
+		return v.fail(err)
+	}
+	return stmt
+}
+
+// VisitRevokeStatement 访问 REVOKE 语句节点，语法与 GRANT 相同，TO 换成 FROM
+func (v *MiniQLVisitorImpl) VisitRevokeStatement(ctx *RevokeStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt, err := v.grant(ctx.PrivilegeList(), ctx.GrantObject(), ctx.AllIdentifierList())
+	if err != nil {
+		return v.fail(err)
+	}
+	stmt.Revoke = true
+	return stmt
+}
+
+// grant 构建 GRANT / REVOKE 语句节点
+// 授予权限时 names 只有被授予者列表；授予角色时依次为角色列表和被授予者列表
+func (v *MiniQLVisitorImpl) grant(privileges IPrivilegeListContext, object IGrantObjectContext, names []IIdentifierListContext) (*GrantStmt, error) {
+	stmt := &GrantStmt{BaseNode: BaseNode{nodeType: GrantNode}}
+	if privileges != nil {
+		for _, p := range privileges.AllPrivilege() {
+			// ALL PRIVILEGES 取第一个关键字
+			privilege := strings.ToUpper(p.GetStart().GetText())
+			switch privilege {
+			case "SELECT", "INSERT", "UPDATE", "DELETE", "ALL":
+				stmt.Privileges = append(stmt.Privileges, privilege)
+			default:
+				return nil, fmt.Errorf("syntax error: unknown privilege '%s'", p.GetText())
+			}
+		}
+		parts := object.AllGrantObjectPart()
+		if len(parts) == 1 {
+			stmt.Table = parts[0].GetText()
+		} else {
+			stmt.Database, stmt.Table = parts[0].GetText(), parts[1].GetText()
+			if stmt.Database == "*" && stmt.Table != "*" {
+				return nil, fmt.Errorf("syntax error: invalid privilege object '*.%s'", stmt.Table)
+			}
+		}
+	} else {
+		stmt.Roles = v.Visit(names[0]).([]string)
+		names = names[1:]
+	}
+	stmt.Grantees = v.Visit(names[0]).([]string)
+	return stmt, nil
+}
+
 // VisitUseStatement 访问 USE 语句节点
 func (v *MiniQLVisitorImpl) VisitUseStatement(ctx *UseStatementContext) interface{} {
 	if ctx == nil {
@@ -1404,6 +2004,35 @@ func (v *MiniQLVisitorImpl) VisitShowIndexes(ctx *ShowIndexesContext) interface{
 	return stmt
 }
 
+// VisitShowCreateTable 访问 SHOW CREATE TABLE 语句节点
+func (v *MiniQLVisitorImpl) VisitShowCreateTable(ctx *ShowCreateTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	return &ShowCreateTableStmt{
+		BaseNode: BaseNode{nodeType: ShowCreateTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+	}
+}
+
+// VisitDescribeTable 访问 DESCRIBE 语句节点
+//
+//	DESCRIBE | DESC [TABLE] [EXTENDED] t
+//
+// 表名恰好为 extended 时按表名处理
+func (v *MiniQLVisitorImpl) VisitDescribeTable(ctx *DescribeTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	return &DescribeStmt{
+		BaseNode: BaseNode{nodeType: DescribeNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+		Extended: ctx.EXTENDED() != nil,
+	}
+}
+
 // VisitExplainStatement 访问 EXPLAIN 语句节点
 func (v *MiniQLVisitorImpl) VisitExplainStatement(ctx *ExplainStatementContext) interface{} {
 	if ctx == nil {
@@ -1413,6 +2042,29 @@ func (v *MiniQLVisitorImpl) VisitExplainStatement(ctx *ExplainStatementContext)
 	// 创建 EXPLAIN 语句节点
 	stmt := &ExplainStmt{
 		BaseNode: BaseNode{nodeType: ExplainNode},
+		Format:   ExplainFormatText,
+	}
+
+	// EXPLAIN ANALYZE 和 EXPLAIN (ANALYZE [TRUE | FALSE], FORMAT {TEXT | JSON})
+	if ctx.ANALYZE() != nil || len(ctx.AllExplainOption()) > 0 {
+		if err := v.syntaxError(); err != nil {
+			return v.fail(err)
+		}
+	}
+	stmt.Analyze = ctx.ANALYZE() != nil
+	for _, opt := range ctx.AllExplainOption() {
+		switch {
+		case opt.ANALYZE() != nil:
+			stmt.Analyze = opt.FALSE() == nil
+		case opt.FORMAT() != nil:
+			format := opt.Identifier().GetText()
+			stmt.Format = strings.ToUpper(format)
+			if stmt.Format != ExplainFormatText && stmt.Format != ExplainFormatJSON {
+				return v.fail(fmt.Errorf("unsupported EXPLAIN format: %s", format))
+			}
+		default:
+			return v.fail(fmt.Errorf("unrecognized EXPLAIN option: %s", opt.GetText()))
+		}
 	}
 
 	// 获取要解释的查询语句
@@ -1480,6 +2132,394 @@ func (v *MiniQLVisitorImpl) VisitColumnList(ctx *ColumnListContext) interface{}
 	return columns
 }
 
+// VisitSetStatement 访问 SET 语句节点
+//
+//	SET name {= | TO} {value | DEFAULT}
+//	SET TIME ZONE {value | DEFAULT}
+func (v *MiniQLVisitorImpl) VisitSetStatement(ctx *SetStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	// SET TIME ZONE value 等价于 SET timezone = value
+	name := "timezone"
+	if ctx.VariableName() != nil {
+		name = v.Visit(ctx.VariableName()).(string)
+	}
+
+	// 不带引号的 DEFAULT 等价于 RESET
+	if ctx.DEFAULT() != nil {
+		return &ResetStmt{BaseNode: BaseNode{nodeType: ResetNode}, Variable: name}
+	}
+	value, err := v.setValue(ctx.SetValue())
+	if err != nil {
+		return v.fail(err)
+	}
+
+	logger.WithComponent("parser").Debug("SET statement parsed successfully",
+		zap.String("variable", name))
+
+	return &SetStmt{
+		BaseNode: BaseNode{nodeType: SetNode},
+		Variable: name,
+		Value:    value,
+	}
+}
+
+// VisitShowVariable 访问 SHOW 会话变量语句节点
+//
+//	SHOW name
+//	SHOW ALL
+//	SHOW TIME ZONE
+func (v *MiniQLVisitorImpl) VisitShowVariable(ctx *ShowVariableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &ShowVariableStmt{BaseNode: BaseNode{nodeType: ShowVariableNode}}
+	switch {
+	case ctx.TIME() != nil:
+		stmt.Variable = "timezone"
+	case ctx.ALL() != nil:
+		stmt.All = true
+	default:
+		stmt.Variable = v.Visit(ctx.VariableName()).(string)
+	}
+	return stmt
+}
+
+// VisitResetStatement 访问 RESET 语句节点
+//
+//	RESET name
+//	RESET ALL
+//	RESET TIME ZONE
+func (v *MiniQLVisitorImpl) VisitResetStatement(ctx *ResetStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &ResetStmt{BaseNode: BaseNode{nodeType: ResetNode}}
+	switch {
+	case ctx.TIME() != nil:
+		stmt.Variable = "timezone"
+	case ctx.ALL() != nil:
+		stmt.All = true
+	default:
+		stmt.Variable = v.Visit(ctx.VariableName()).(string)
+	}
+	return stmt
+}
+
+// VisitVariableName 访问会话变量名，变量名不区分大小写，统一转为小写
+func (v *MiniQLVisitorImpl) VisitVariableName(ctx *VariableNameContext) interface{} {
+	parts := make([]string, 0, len(ctx.AllIdentifier()))
+	for _, id := range ctx.AllIdentifier() {
+		parts = append(parts, v.Visit(id).(string))
+	}
+	return strings.ToLower(strings.Join(parts, "."))
+}
+
+// VisitPrepareStatement 访问 PREPARE 语句节点
+//
+//	PREPARE name [(type, ...)] AS {SELECT | INSERT | UPDATE | DELETE} ... $1 ...
+func (v *MiniQLVisitorImpl) VisitPrepareStatement(ctx *PrepareStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	var declared []string
+	for _, typeCtx := range ctx.AllParameterType() {
+		typeName := typeCtx.GetStart().GetText()
+		dataType, ok := parameterTypes[strings.ToUpper(typeName)]
+		if !ok {
+			return v.fail(fmt.Errorf("unsupported parameter type: %s", typeName))
+		}
+		declared = append(declared, dataType)
+	}
+
+	var body antlr.ParserRuleContext
+	switch {
+	case ctx.DqlStatement() != nil:
+		body = ctx.DqlStatement()
+	case ctx.DmlStatement() != nil:
+		body = ctx.DmlStatement()
+	default:
+		body = ctx.DdlStatement()
+	}
+
+	// 访问语句体时收集参数占位符
+	v.parameters = make([]*Parameter, 0)
+	result := v.Visit(body)
+	params := v.parameters
+	v.parameters = nil
+	if v.err != nil {
+		return nil
+	}
+	switch result.(type) {
+	case *SelectStmt, *InsertStmt, *UpdateStmt, *DeleteStmt:
+	default:
+		return v.fail(fmt.Errorf("only SELECT, INSERT, UPDATE and DELETE statements can be prepared"))
+	}
+
+	// 参数个数为最大的序号和声明的类型个数中的较大者，未声明类型的参数类型为空
+	count := len(declared)
+	for _, param := range params {
+		count = max(count, param.Index)
+	}
+	types := make([]string, count)
+	copy(types, declared)
+	for _, param := range params {
+		param.DataType = types[param.Index-1]
+	}
+
+	// 语句原文用作计划缓存的键
+	start, stop := body.GetStart(), body.GetStop()
+	stmt := &PrepareStmt{
+		BaseNode:   BaseNode{nodeType: PrepareNode},
+		Name:       strings.ToLower(ctx.Identifier().GetText()),
+		ParamTypes: types,
+		Statement:  result.(Node),
+		Query:      start.GetInputStream().GetText(start.GetStart(), stop.GetStop()),
+	}
+
+	logger.WithComponent("parser").Debug("PREPARE statement parsed successfully",
+		zap.String("name", stmt.Name),
+		zap.Int("parameters", len(types)))
+
+	return stmt
+}
+
+// VisitExecuteStatement 访问 EXECUTE 语句节点
+//
+//	EXECUTE name [(value, ...)]
+func (v *MiniQLVisitorImpl) VisitExecuteStatement(ctx *ExecuteStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &ExecuteStmt{
+		BaseNode: BaseNode{nodeType: ExecuteNode},
+		Name:     strings.ToLower(ctx.Identifier().GetText()),
+	}
+	for _, lit := range ctx.AllSignedLiteral() {
+		value, err := signedLiteralValue(lit)
+		if err != nil {
+			return v.fail(err)
+		}
+		stmt.Args = append(stmt.Args, value)
+	}
+	return stmt
+}
+
+// VisitDeallocateStatement 访问 DEALLOCATE 语句节点
+//
+//	DEALLOCATE [PREPARE] {name | ALL}
+func (v *MiniQLVisitorImpl) VisitDeallocateStatement(ctx *DeallocateStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &DeallocateStmt{BaseNode: BaseNode{nodeType: DeallocateNode}}
+	if ctx.ALL() != nil {
+		stmt.All = true
+	} else {
+		stmt.Name = strings.ToLower(ctx.Identifier().GetText())
+	}
+	return stmt
+}
+
+// VisitCopyStatement 访问 COPY 语句节点
+//
+//	COPY t [(col, ...)] FROM 'path' [WITH (format csv, header true, ...)]
+//	COPY t TO 'path' [WITH (...)]
+//	COPY (SELECT ...) TO 'path' [WITH (...)]
+func (v *MiniQLVisitorImpl) VisitCopyStatement(ctx *CopyStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &CopyStmt{
+		BaseNode: BaseNode{nodeType: CopyNode},
+		Path:     unquoteString(ctx.STRING_LITERAL().GetText()),
+	}
+	if ctx.SelectStatement() != nil {
+		query, ok := v.Visit(ctx.SelectStatement()).(*SelectStmt)
+		if !ok {
+			return nil
+		}
+		stmt.Query = query
+	} else {
+		stmt.Table = v.Visit(ctx.TableName()).(string)
+		if ctx.IdentifierList() != nil {
+			stmt.Columns = v.Visit(ctx.IdentifierList()).([]string)
+		}
+	}
+
+	if ctx.FROM() != nil {
+		if stmt.Query != nil {
+			return v.fail(fmt.Errorf("syntax error: COPY (query) only supports TO"))
+		}
+		stmt.Direction = CopyFrom
+	} else {
+		if len(stmt.Columns) > 0 {
+			return v.fail(fmt.Errorf("syntax error: use COPY (SELECT ...) TO to export selected columns"))
+		}
+		stmt.Direction = CopyTo
+		if stmt.Query == nil {
+			// COPY t TO 等价于导出 SELECT * FROM t
+			stmt.Query = &SelectStmt{
+				BaseNode: BaseNode{nodeType: SelectNode},
+				All:      true,
+				From:     stmt.Table,
+			}
+		}
+	}
+
+	if ctx.OptionList() != nil {
+		// 同时接受 PostgreSQL 风格的 (format csv, header true)
+		options, err := v.optionList(ctx.OptionList(), false)
+		if err != nil {
+			return v.fail(err)
+		}
+		stmt.Options = options
+	}
+	return stmt
+}
+
+// VisitExportTable 访问 EXPORT TABLE 语句节点
+//
+//	EXPORT TABLE t TO DELTA
+func (v *MiniQLVisitorImpl) VisitExportTable(ctx *ExportTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	format, err := tableFormat(ctx.TableFormat())
+	if err != nil {
+		return v.fail(err)
+	}
+	return &ExportTableStmt{
+		BaseNode: BaseNode{nodeType: ExportTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+		Format:   format,
+	}
+}
+
+// VisitImportTable 访问 IMPORT TABLE 语句节点
+//
+//	IMPORT TABLE t FROM DELTA 'path'
+func (v *MiniQLVisitorImpl) VisitImportTable(ctx *ImportTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	format, err := tableFormat(ctx.TableFormat())
+	if err != nil {
+		return v.fail(err)
+	}
+	return &ImportTableStmt{
+		BaseNode: BaseNode{nodeType: ImportTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+		Format:   format,
+		Path:     unquoteString(ctx.STRING_LITERAL().GetText()),
+	}
+}
+
+// VisitBackupDatabase 访问 BACKUP DATABASE 语句节点
+//
+//	BACKUP DATABASE db TO 'dir' [INCREMENTAL FROM 'previous_dir']
+func (v *MiniQLVisitorImpl) VisitBackupDatabase(ctx *BackupDatabaseContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &BackupDatabaseStmt{
+		BaseNode: BaseNode{nodeType: BackupDatabaseNode},
+		Database: ctx.Identifier().GetText(),
+		Path:     unquoteString(ctx.STRING_LITERAL(0).GetText()),
+	}
+	if ctx.INCREMENTAL() != nil {
+		stmt.IncrementalFrom = unquoteString(ctx.STRING_LITERAL(1).GetText())
+	}
+	return stmt
+}
+
+// VisitRestoreDatabase 访问 RESTORE DATABASE 语句节点
+//
+//	RESTORE DATABASE db FROM 'dir' [AS OF VERSION n]
+func (v *MiniQLVisitorImpl) VisitRestoreDatabase(ctx *RestoreDatabaseContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &RestoreDatabaseStmt{
+		BaseNode: BaseNode{nodeType: RestoreDatabaseNode},
+		Database: ctx.Identifier().GetText(),
+		Path:     unquoteString(ctx.STRING_LITERAL().GetText()),
+		Version:  -1,
+	}
+	if ctx.INTEGER_LITERAL() != nil {
+		version, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+		if err != nil {
+			return v.fail(fmt.Errorf("syntax error: invalid version '%s'", ctx.INTEGER_LITERAL().GetText()))
+		}
+		stmt.Version = version
+	}
+	return stmt
+}
+
+// VisitKillStatement 访问 KILL 语句节点
+//
+//	KILL QUERY query_id
+//	KILL {SESSION | CONNECTION} session_id
+func (v *MiniQLVisitorImpl) VisitKillStatement(ctx *KillStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	id, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+	if err != nil {
+		return v.fail(fmt.Errorf("syntax error: invalid integer '%s'", ctx.INTEGER_LITERAL().GetText()))
+	}
+	return &KillStmt{
+		BaseNode: BaseNode{nodeType: KillNode},
+		Session:  ctx.QUERY() == nil,
+		ID:       id,
+	}
+}
+
+// VisitVacuumStatement 访问 VACUUM 语句节点
+//
+//	VACUUM t [RETAIN n HOURS] [DRY RUN]
+func (v *MiniQLVisitorImpl) VisitVacuumStatement(ctx *VacuumStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &VacuumStmt{
+		BaseNode:    BaseNode{nodeType: VacuumNode},
+		Table:       v.Visit(ctx.TableName()).(string),
+		RetainHours: -1,
+		DryRun:      ctx.DRY() != nil,
+	}
+	if ctx.RETAIN() != nil {
+		hours, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+		if err != nil {
+			return v.fail(fmt.Errorf("syntax error: invalid integer '%s'", ctx.INTEGER_LITERAL().GetText()))
+		}
+		stmt.RetainHours = hours
+	}
+	return stmt
+}
+
+// setValue 返回 SET 语句的值：int64/float64/string/bool/nil，不带引号的单词按字符串返回
+func (v *MiniQLVisitorImpl) setValue(ctx ISetValueContext) (interface{}, error) {
+	if ctx.SignedLiteral() != nil {
+		return signedLiteralValue(ctx.SignedLiteral())
+	}
+	return ctx.GetText(), nil
+}
+
 // VisitIdentifierList 访问标识符列表
 func (v *MiniQLVisitorImpl) VisitIdentifierList(ctx *IdentifierListContext) interface{} {
 	identifiers := make([]string, 0)
@@ -1501,11 +2541,11 @@ func (v *MiniQLVisitorImpl) VisitValueList(ctx *ValueListContext) interface{} {
 	}
 
 	// 直接构建值节点列表，无需中间对象
-	values := make([]Node, 0, len(ctx.AllLiteral()))
+	values := make([]Node, 0, len(ctx.AllValueItem()))
 
-	// 遍历所有字面量
-	for _, literalCtx := range ctx.AllLiteral() {
-		if result := v.Visit(literalCtx); result != nil {
+	// 遍历所有字面量和参数占位符
+	for _, itemCtx := range ctx.AllValueItem() {
+		if result := v.Visit(itemCtx); result != nil {
 			// 确保返回的是 Node 类型
 			if node, ok := result.(Node); ok {
 				values = append(values, node)
@@ -1516,6 +2556,17 @@ func (v *MiniQLVisitorImpl) VisitValueList(ctx *ValueListContext) interface{} {
 	return values
 }
 
+// VisitValueItem 访问值列表中的字面量或参数占位符
+func (v *MiniQLVisitorImpl) VisitValueItem(ctx *ValueItemContext) interface{} {
+	switch {
+	case ctx.PARAM() != nil:
+		return v.parameter(ctx.PARAM())
+	case ctx.Literal() != nil:
+		return v.Visit(ctx.Literal())
+	}
+	return nil
+}
+
 // VisitTableName 访问表名节点，支持 database.table 格式
 func (v *MiniQLVisitorImpl) VisitTableName(ctx *TableNameContext) interface{} {
 	if ctx == nil {
@@ -1527,6 +2578,11 @@ func (v *MiniQLVisitorImpl) VisitTableName(ctx *TableNameContext) interface{} {
 		return nil
 	}
 
+	// default.table
+	if ctx.DEFAULT() != nil {
+		return ctx.DEFAULT().GetText() + "." + v.Visit(identifiers[0]).(string)
+	}
+
 	if len(identifiers) == 1 {
 		// 单个标识符：table
 		return v.Visit(identifiers[0])
@@ -1617,7 +2673,7 @@ func (v *MiniQLVisitorImpl) VisitLiteral(ctx *LiteralContext) interface{} {
 				zap.String("text", text))
 			return nil
 		}
-		value := text[1 : len(text)-1]
+		value := unquoteString(text)
 		return &StringLiteral{
 			BaseNode: BaseNode{nodeType: StringLiteralNode},
 			Value:    value,
@@ -1644,3 +2700,117 @@ func (v *MiniQLVisitorImpl) VisitLiteral(ctx *LiteralContext) interface{} {
 		return nil
 	}
 }
+
+// signedLiteralValue 返回字面量的 Go 值：int64/float64/string/bool，NULL 返回 nil
+func signedLiteralValue(ctx ISignedLiteralContext) (interface{}, error) {
+	text := ctx.GetText()
+	if ctx.Literal() != nil {
+		lit := ctx.Literal()
+		switch {
+		case lit.STRING_LITERAL() != nil:
+			return unquoteString(text), nil
+		case lit.TRUE() != nil:
+			return true, nil
+		case lit.FALSE() != nil:
+			return false, nil
+		case lit.NULL() != nil:
+			return nil, nil
+		}
+	}
+	if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return v, nil
+	}
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("syntax error: invalid number '%s'", text)
+	}
+	return v, nil
+}
+
+// optionValue 返回选项值：字面量按 signedLiteralValue 转换，不带引号的单词按字符串返回
+func optionValue(ctx IOptionValueContext) (interface{}, error) {
+	if ctx.SignedLiteral() != nil {
+		return signedLiteralValue(ctx.SignedLiteral())
+	}
+	return ctx.GetText(), nil
+}
+
+// VisitRestoreTable 访问 RESTORE TABLE 语句节点
+//
+//	RESTORE TABLE t TO VERSION AS OF n
+//	RESTORE TABLE t TO TIMESTAMP AS OF 'yyyy-mm-dd hh:mm:ss' | unix_millis
+func (v *MiniQLVisitorImpl) VisitRestoreTable(ctx *RestoreTableContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &RestoreTableStmt{
+		BaseNode: BaseNode{nodeType: RestoreTableNode},
+		Table:    v.Visit(ctx.TableName()).(string),
+	}
+	if ctx.STRING_LITERAL() != nil {
+		stmt.Timestamp = unquoteString(ctx.STRING_LITERAL().GetText())
+		return stmt
+	}
+	value, err := strconv.ParseInt(ctx.INTEGER_LITERAL().GetText(), 10, 64)
+	if err != nil {
+		return v.fail(fmt.Errorf("syntax error: invalid integer '%s'", ctx.INTEGER_LITERAL().GetText()))
+	}
+	if ctx.TIMESTAMP_TYPE() != nil {
+		stmt.Timestamp = value
+	} else {
+		stmt.Version = value
+	}
+	return stmt
+}
+
+// VisitCommentStatement 访问 COMMENT ON 语句节点
+//
+//	COMMENT ON TABLE t IS 'text' | NULL
+//	COMMENT ON COLUMN t.col IS 'text' | NULL
+func (v *MiniQLVisitorImpl) VisitCommentStatement(ctx *CommentStatementContext) interface{} {
+	if err := v.syntaxError(); err != nil {
+		return v.fail(err)
+	}
+
+	stmt := &CommentStmt{BaseNode: BaseNode{nodeType: CommentNode}}
+	if ctx.TableName() != nil {
+		stmt.Table = v.Visit(ctx.TableName()).(string)
+	} else {
+		parts := make([]string, 0, len(ctx.AllIdentifier()))
+		for _, id := range ctx.AllIdentifier() {
+			parts = append(parts, id.GetText())
+		}
+		if len(parts) < 2 {
+			return v.fail(fmt.Errorf("syntax error: COMMENT ON COLUMN expects table.column, got '%s'", parts[0]))
+		}
+		stmt.Table = strings.Join(parts[:len(parts)-1], ".")
+		stmt.Column = parts[len(parts)-1]
+	}
+	if ctx.STRING_LITERAL() != nil {
+		stmt.Comment = unquoteString(ctx.STRING_LITERAL().GetText())
+	}
+	return stmt
+}
+
+// tableFormat 返回 EXPORT / IMPORT 的表格式名称（大写）
+func tableFormat(ctx ITableFormatContext) (string, error) {
+	format := ctx.GetText()
+	if !strings.EqualFold(format, TableFormatDelta) {
+		return "", fmt.Errorf("syntax error: unsupported table format '%s' (supported: DELTA)", format)
+	}
+	return TableFormatDelta, nil
+}
+
+// propertyName 返回表属性名：'name' 或 name.part，大小写保持不变
+func propertyName(ctx IPropertyNameContext) string {
+	if ctx.STRING_LITERAL() != nil {
+		return unquoteString(ctx.STRING_LITERAL().GetText())
+	}
+	return ctx.GetText()
+}
+
+// unquoteString 去除字符串字面量两端的单引号，两个连续的单引号表示一个单引号
+func unquoteString(text string) string {
+	return strings.ReplaceAll(text[1:len(text)-1], "''", "'")
+}
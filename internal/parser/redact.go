@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// redactedPassword 替换密码字面量的文本
+const redactedPassword = "'********'"
+
+// RedactPasswords 将 SQL 中 PASSWORD 之后的字符串字面量替换为 '********'
+// 用于 sys.running_queries 和日志等展示语句原文的地方；无法切分且含有 PASSWORD 的 SQL 整体隐藏
+func RedactPasswords(sql string) string {
+	if !strings.Contains(strings.ToUpper(sql), "PASSWORD") {
+		return sql
+	}
+	lexer := NewMiniQLLexer(antlr.NewInputStream(sql))
+	lexer.RemoveErrorListeners()
+	syntax := &syntaxErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer.AddErrorListener(syntax)
+	tokens := lexer.GetAllTokens()
+	if syntax.err != nil {
+		return "<redacted>"
+	}
+
+	// 词法单元的位置按字符 (rune) 计算
+	runes := []rune(sql)
+	var sb strings.Builder
+	last := 0
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i].GetTokenType() != MiniQLLexerPASSWORD || tokens[i+1].GetTokenType() != MiniQLLexerSTRING_LITERAL {
+			continue
+		}
+		sb.WriteString(string(runes[last:tokens[i+1].GetStart()]))
+		sb.WriteString(redactedPassword)
+		last = tokens[i+1].GetStop() + 1
+	}
+	if last == 0 {
+		return sql
+	}
+	sb.WriteString(string(runes[last:]))
+	return sb.String()
+}
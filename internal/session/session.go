@@ -6,16 +6,62 @@ import (
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
 	"go.uber.org/zap"
 )
 
 // Session 表示一个数据库会话
 type Session struct {
 	ID           int64                  // 会话ID
+	User         string                 // 登录用户，未启用认证时为空
 	CurrentDB    string                 // 当前数据库
 	CreatedAt    time.Time              // 创建时间
 	LastAccessAt time.Time              // 最后访问时间
 	Variables    map[string]interface{} // 会话变量
+
+	prepared  map[string]*parser.PrepareStmt // PREPARE 创建的预处理语句，按名称索引
+	planCache *optimizer.PlanCache           // 预处理语句的计划缓存
+}
+
+// PreparedStatement 返回名为 name 的预处理语句
+func (s *Session) PreparedStatement(name string) (*parser.PrepareStmt, bool) {
+	stmt, ok := s.prepared[name]
+	return stmt, ok
+}
+
+// AddPreparedStatement 保存预处理语句，同名语句已存在时返回 false
+func (s *Session) AddPreparedStatement(stmt *parser.PrepareStmt) bool {
+	if _, exists := s.prepared[stmt.Name]; exists {
+		return false
+	}
+	if s.prepared == nil {
+		s.prepared = make(map[string]*parser.PrepareStmt)
+	}
+	s.prepared[stmt.Name] = stmt
+	return true
+}
+
+// RemovePreparedStatement 删除预处理语句，不存在时返回 false
+func (s *Session) RemovePreparedStatement(name string) bool {
+	if _, exists := s.prepared[name]; !exists {
+		return false
+	}
+	delete(s.prepared, name)
+	return true
+}
+
+// ClearPreparedStatements 删除会话的全部预处理语句
+func (s *Session) ClearPreparedStatements() {
+	s.prepared = nil
+}
+
+// PlanCache 返回会话的计划缓存，第一次使用时创建
+func (s *Session) PlanCache() *optimizer.PlanCache {
+	if s.planCache == nil {
+		s.planCache = optimizer.NewPlanCache(optimizer.DefaultPlanCacheSize)
+	}
+	return s.planCache
 }
 
 // SessionManager 会话管理器
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/yyun543/minidb/internal/delta"
+)
+
+// commitUserKey context 中提交用户的键
+type commitUserKey struct{}
+
+// WithCommitUser 返回携带提交用户的 context，引擎在该 context 下提交的 Delta Log 条目在 UserID 字段记录该用户
+// 用户随每次调用传递，不同会话的语句可以并发提交；后台任务 (写缓冲定时刷写、维护任务) 不携带用户
+func WithCommitUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, commitUserKey{}, user)
+}
+
+// CommitUser 获取 context 中的提交用户，未设置时为空
+func CommitUser(ctx context.Context) string {
+	if ctx != nil {
+		if user, ok := ctx.Value(commitUserKey{}).(string); ok {
+			return user
+		}
+	}
+	return ""
+}
+
+// commitLog 返回以 ctx 中的提交用户身份提交的 Delta Log
+func (pe *ParquetEngine) commitLog(ctx context.Context) delta.LogInterface {
+	return pe.deltaLog.WithUser(CommitUser(ctx))
+}
@@ -0,0 +1,355 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// 变更数据流 (Change Data Feed)
+//
+// TableChanges 回放表在 [start, end] 版本区间内的 Delta Log，生成行级变更：
+//   - 只加入普通数据文件的提交: 文件中的行为 insert
+//   - 其余数据变更提交 (Merge-on-Read delta 文件、DROP PARTITION、RESTORE 等): 对比该版本前后的表内容，
+//     消失的行为 delete (UPDATE 时为 update_preimage)，新出现的行为 insert (UPDATE 时为 update_postimage)
+//   - dataChange=false 的提交 (统计信息补写、compaction、Z-order) 只重排或补充元数据，不产生变更
+//
+// 每个 delta 文件版本需要读取前后两个快照，适合增量同步这类版本区间较小的场景。
+
+// 变更类型
+const (
+	ChangeTypeInsert          = "insert"
+	ChangeTypeUpdatePreimage  = "update_preimage"
+	ChangeTypeUpdatePostimage = "update_postimage"
+	ChangeTypeDelete          = "delete"
+)
+
+// 变更数据的元数据列
+const (
+	ChangeTypeColumn      = "_change_type"
+	CommitVersionColumn   = "_commit_version"
+	CommitTimestampColumn = "_commit_timestamp" // 提交时间 (Unix 毫秒)
+)
+
+// ChangeDataSchema 返回变更数据的结构：表的列加上 _change_type / _commit_version / _commit_timestamp
+func ChangeDataSchema(tableSchema *arrow.Schema) *arrow.Schema {
+	fields := make([]arrow.Field, 0, tableSchema.NumFields()+3)
+	for _, field := range tableSchema.Fields() {
+		field.Nullable = true
+		fields = append(fields, field)
+	}
+	fields = append(fields,
+		arrow.Field{Name: ChangeTypeColumn, Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: CommitVersionColumn, Type: arrow.PrimitiveTypes.Int64},
+		arrow.Field{Name: CommitTimestampColumn, Type: arrow.PrimitiveTypes.Int64},
+	)
+	return arrow.NewSchema(fields, nil)
+}
+
+// TableChanges 返回表在 [startVersion, endVersion] 版本区间内的行级变更，endVersion < 0 表示到最新版本
+// 结果按提交版本升序排列，结构见 ChangeDataSchema
+func (pe *ParquetEngine) TableChanges(ctx context.Context, db, table string, startVersion, endVersion int64) ([]arrow.Record, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+	schema, err := pe.GetTableSchema(db, table)
+	if err != nil {
+		return nil, err
+	}
+	if pe.externalSpec(db, table) != nil {
+		return nil, fmt.Errorf("change data feed is not available for external table %s", tableID)
+	}
+	// 缓冲数据刷写后才有对应的版本
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return nil, err
+	}
+
+	if endVersion < 0 {
+		endVersion = pe.deltaLog.GetLatestVersion()
+	}
+	if startVersion < 0 || endVersion < startVersion {
+		return nil, fmt.Errorf("invalid version range [%d, %d] for table_changes", startVersion, endVersion)
+	}
+	// 起始状态之前的历史已被 checkpoint 压缩且旧日志已清理时无法计算变更 (同时按需加载保留的旧日志)
+	if _, err := pe.deltaLog.GetSnapshot(tableID, max(startVersion-1, 0)); err != nil {
+		return nil, err
+	}
+
+	schema = arrow.NewSchema(schema.Fields(), nil)
+	outSchema := ChangeDataSchema(schema)
+	var results []arrow.Record
+	for _, commit := range groupByVersion(pe.deltaLog.GetEntriesByTable(tableID), startVersion, endVersion) {
+		if err := ctx.Err(); err != nil {
+			releaseRecords(results)
+			return nil, err
+		}
+
+		var changes []arrow.Record
+		if onlyInserts(commit) {
+			changes, err = pe.insertedRows(commit, schema, outSchema)
+		} else {
+			changes, err = pe.changedRows(tableID, commit, schema, outSchema)
+		}
+		if err != nil {
+			releaseRecords(results)
+			return nil, fmt.Errorf("failed to read changes of version %d: %w", commit[0].Version, err)
+		}
+		results = append(results, changes...)
+	}
+
+	logger.Info("Table changes computed",
+		zap.String("table", tableID),
+		zap.Int64("start_version", startVersion),
+		zap.Int64("end_version", endVersion),
+		zap.Int("batches", len(results)))
+	return results, nil
+}
+
+// groupByVersion 按提交版本分组 [start, end] 区间内的数据变更日志 (一个版本可以包含多条日志，如 RESTORE)
+func groupByVersion(entries []delta.LogEntry, start, end int64) [][]delta.LogEntry {
+	var commits [][]delta.LogEntry
+	for _, entry := range entries {
+		if entry.Version < start || entry.Version > end || !entry.DataChange || entry.Operation == delta.OpMetadata {
+			continue
+		}
+		if n := len(commits); n > 0 && commits[n-1][0].Version == entry.Version {
+			commits[n-1] = append(commits[n-1], entry)
+			continue
+		}
+		commits = append(commits, []delta.LogEntry{entry})
+	}
+	return commits
+}
+
+// onlyInserts 提交是否只加入了普通数据文件
+func onlyInserts(commit []delta.LogEntry) bool {
+	for _, entry := range commit {
+		if entry.Operation != delta.OpAdd || entry.IsDelta {
+			return false
+		}
+	}
+	return true
+}
+
+// insertedRows 只加入普通数据文件的提交：文件中的全部行都是新插入的
+func (pe *ParquetEngine) insertedRows(commit []delta.LogEntry, schema, outSchema *arrow.Schema) ([]arrow.Record, error) {
+	files := make([]delta.FileInfo, len(commit))
+	for i, entry := range commit {
+		files[i] = delta.FileInfo{Path: entry.FilePath}
+	}
+	records, err := pe.readFiles(files, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseRecords(records)
+
+	changes := make([]arrow.Record, 0, len(records))
+	for _, rec := range records {
+		changes = append(changes, withChangeColumns(rec, outSchema, ChangeTypeInsert, commit[0]))
+	}
+	return changes, nil
+}
+
+// changedRows 对比提交前后的表内容 (应用 Merge-on-Read delta 文件后)，得到删除和更新的行
+func (pe *ParquetEngine) changedRows(tableID string, commit []delta.LogEntry, schema, outSchema *arrow.Schema) ([]arrow.Record, error) {
+	entry := commit[0]
+	before, err := pe.deltaLog.GetSnapshot(tableID, entry.Version-1)
+	if err != nil {
+		return nil, err
+	}
+	if !touchesData(commit, before) {
+		// 删除表时的标记条目等不对应任何数据文件
+		return nil, nil
+	}
+	after, err := pe.deltaLog.GetSnapshot(tableID, entry.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeRows, err := pe.readFiles(before.Files, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseRecords(beforeRows)
+	afterRows, err := pe.readFiles(after.Files, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseRecords(afterRows)
+
+	removedType, addedType := ChangeTypeDelete, ChangeTypeInsert
+	if entry.DeltaType == "update" {
+		removedType, addedType = ChangeTypeUpdatePreimage, ChangeTypeUpdatePostimage
+	}
+
+	var changes []arrow.Record
+	for _, diff := range []struct {
+		rows       []rowRef
+		changeType string
+	}{
+		{rows: subtractRows(beforeRows, afterRows), changeType: removedType},
+		{rows: subtractRows(afterRows, beforeRows), changeType: addedType},
+	} {
+		if len(diff.rows) == 0 {
+			continue
+		}
+		data, err := gatherRows(diff.rows)
+		if err != nil {
+			releaseRecords(changes)
+			return nil, err
+		}
+		changes = append(changes, withChangeColumns(data, outSchema, diff.changeType, entry))
+		data.Release()
+	}
+	return changes, nil
+}
+
+// touchesData 提交是否加入了文件，或移除了提交前快照中的文件
+func touchesData(commit []delta.LogEntry, before *delta.Snapshot) bool {
+	existing := make(map[string]bool, len(before.Files))
+	for _, file := range before.Files {
+		existing[file.Path] = true
+	}
+	for _, entry := range commit {
+		if entry.Operation == delta.OpAdd || existing[entry.FilePath] {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshotIterator 读取快照中的文件；存在 Merge-on-Read delta 文件时把 delta 应用到基础文件上
+func (pe *ParquetEngine) snapshotIterator(files []delta.FileInfo, filters []Filter) (RecordIterator, error) {
+	baseFiles := make([]delta.FileInfo, 0, len(files))
+	deltaFiles := make([]delta.FileInfo, 0)
+	for _, file := range files {
+		if file.IsDelta {
+			deltaFiles = append(deltaFiles, file)
+		} else {
+			baseFiles = append(baseFiles, file)
+		}
+	}
+	if len(deltaFiles) > 0 {
+		return NewMergeOnReadIterator(pe.ParquetStore(), baseFiles, deltaFiles, filters)
+	}
+	return NewParquetIterator(pe.ParquetStore(), baseFiles, filters)
+}
+
+// readFiles 读取文件中的全部行，并按列名对齐到表结构
+func (pe *ParquetEngine) readFiles(files []delta.FileInfo, schema *arrow.Schema) ([]arrow.Record, error) {
+	iter, err := pe.snapshotIterator(files, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var records []arrow.Record
+	for iter.Next() {
+		rec := iter.Record()
+		if rec == nil || rec.NumRows() == 0 {
+			continue
+		}
+		conformed, err := conformRecord(rec, schema, nil)
+		if err != nil {
+			releaseRecords(records)
+			return nil, err
+		}
+		records = append(records, conformed)
+	}
+	if err := iter.Err(); err != nil {
+		releaseRecords(records)
+		return nil, err
+	}
+	return records, nil
+}
+
+// rowRef 指向记录中的一行
+type rowRef struct {
+	record arrow.Record
+	row    int
+}
+
+// rowKey 行内容的比较键
+func rowKey(rec arrow.Record, row int) string {
+	var sb strings.Builder
+	for i, col := range rec.Columns() {
+		if i > 0 {
+			sb.WriteByte(0x1f)
+		}
+		if col.IsNull(row) {
+			sb.WriteByte(0)
+			continue
+		}
+		sb.WriteString(col.ValueStr(row))
+	}
+	return sb.String()
+}
+
+// subtractRows 按多重集合语义返回在 from 中但不在 minus 中的行 (保持 from 中的顺序)
+func subtractRows(from, minus []arrow.Record) []rowRef {
+	counts := make(map[string]int)
+	for _, rec := range minus {
+		for row := 0; row < int(rec.NumRows()); row++ {
+			counts[rowKey(rec, row)]++
+		}
+	}
+	var rows []rowRef
+	for _, rec := range from {
+		for row := 0; row < int(rec.NumRows()); row++ {
+			key := rowKey(rec, row)
+			if counts[key] > 0 {
+				counts[key]--
+				continue
+			}
+			rows = append(rows, rowRef{record: rec, row: row})
+		}
+	}
+	return rows
+}
+
+// gatherRows 把若干行拼接为一个记录
+func gatherRows(rows []rowRef) (arrow.Record, error) {
+	slices := make([]arrow.Record, len(rows))
+	for i, ref := range rows {
+		slices[i] = ref.record.NewSlice(int64(ref.row), int64(ref.row+1))
+	}
+	defer releaseRecords(slices)
+	return concatRecords(slices)
+}
+
+// withChangeColumns 在数据记录后追加 _change_type / _commit_version / _commit_timestamp 列
+func withChangeColumns(data arrow.Record, outSchema *arrow.Schema, changeType string, entry delta.LogEntry) arrow.Record {
+	rows := int(data.NumRows())
+	typeBuilder := array.NewStringBuilder(memory.DefaultAllocator)
+	versionBuilder := array.NewInt64Builder(memory.DefaultAllocator)
+	tsBuilder := array.NewInt64Builder(memory.DefaultAllocator)
+	defer typeBuilder.Release()
+	defer versionBuilder.Release()
+	defer tsBuilder.Release()
+	for i := 0; i < rows; i++ {
+		typeBuilder.Append(changeType)
+		versionBuilder.Append(entry.Version)
+		tsBuilder.Append(entry.Timestamp)
+	}
+
+	extra := []arrow.Array{typeBuilder.NewArray(), versionBuilder.NewArray(), tsBuilder.NewArray()}
+	defer func() {
+		for _, col := range extra {
+			col.Release()
+		}
+	}()
+	columns := append(append([]arrow.Array(nil), data.Columns()...), extra...)
+	return array.NewRecord(outSchema, columns, data.NumRows())
+}
+
+// releaseRecords 释放一组记录
+func releaseRecords(records []arrow.Record) {
+	for _, rec := range records {
+		rec.Release()
+	}
+}
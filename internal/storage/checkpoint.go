@@ -3,201 +3,465 @@ package storage
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/apache/arrow/go/v18/arrow"
-	"github.com/apache/arrow/go/v18/arrow/array"
-	"github.com/apache/arrow/go/v18/arrow/memory"
 	"github.com/yyun543/minidb/internal/delta"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/objectstore"
 	"github.com/yyun543/minidb/internal/parquet"
 	"go.uber.org/zap"
 )
 
+// DefaultLogRetention 被 checkpoint 覆盖的日志文件默认保留 7 天
+// 保留期内的旧日志仍可用于排查问题，过期后由下一次 checkpoint 清理
+const DefaultLogRetention = 7 * 24 * time.Hour
+
+const (
+	checkpointFilePrefix   = "_checkpoint."
+	checkpointMarkerPrefix = "_last_checkpoint."
+	expiredLogMarkerPrefix = "_expired_log." // 记录表已清理的日志文件的最大版本
+)
+
+// WithCheckpointInterval 设置每张表累计多少条日志后创建 checkpoint，<= 0 表示不自动创建
+func WithCheckpointInterval(interval int) EngineOption {
+	return func(pe *ParquetEngine) {
+		pe.checkpointInterval = interval
+	}
+}
+
+// WithLogRetention 设置被 checkpoint 覆盖的日志文件和旧 checkpoint 的保留时长
+// 为 0 时 checkpoint 创建后立即清理其覆盖的日志
+func WithLogRetention(retention time.Duration) EngineOption {
+	return func(pe *ParquetEngine) {
+		pe.logRetention = retention
+	}
+}
+
 // CreateCheckpoint 创建checkpoint并序列化到Parquet文件
 // 实现架构文档建议 (lines 748-764):
-// 1. 序列化snapshot到Parquet文件
+// 1. 将表在 version 及之前的日志压缩为最少条目，按 sys.delta_log 格式写入Parquet文件
 // 2. 写入_last_checkpoint标记文件
-// 3. 可选：清理旧的Delta Log文件
+// 3. 按保留策略清理已被覆盖的Delta Log文件和旧checkpoint
 func (pe *ParquetEngine) CreateCheckpoint(tableID string, version int64) error {
+	pe.checkpointMu.Lock()
+	defer pe.checkpointMu.Unlock()
+
+	// checkpoint 异步创建，可能乱序到达；标记文件只能前进，否则恢复时会缺少已清理的日志
+	if current, err := pe.checkpointMarkerVersion(tableID); err == nil && current >= version {
+		logger.Debug("Skipping checkpoint older than the latest one",
+			zap.String("table", tableID),
+			zap.Int64("version", version),
+			zap.Int64("latest", current))
+		return nil
+	}
+
 	logger.Info("Creating checkpoint for table",
 		zap.String("table", tableID),
 		zap.Int64("version", version))
 
-	// 获取快照
-	snapshot, err := pe.deltaLog.GetSnapshot(tableID, version)
-	if err != nil {
-		return fmt.Errorf("failed to get snapshot: %w", err)
-	}
-
-	// 创建checkpoints目录
-	checkpointDir := filepath.Join(pe.basePath, "sys", "delta_log", "checkpoints")
-	if err := os.MkdirAll(checkpointDir, 0755); err != nil {
-		return fmt.Errorf("failed to create checkpoint directory: %w", err)
-	}
+	entries := delta.CompactEntries(tableID, version, pe.deltaLog.GetEntriesByTable(tableID))
 
 	// 生成checkpoint文件路径
 	checkpointPath := pe.getCheckpointPath(tableID, version)
 
-	// 序列化snapshot到Parquet
-	if err := pe.serializeCheckpoint(snapshot, checkpointPath); err != nil {
+	// 序列化压缩后的日志到Parquet
+	if err := pe.serializeCheckpoint(entries, checkpointPath); err != nil {
 		return fmt.Errorf("failed to serialize checkpoint: %w", err)
 	}
 
-	// 写入_last_checkpoint标记文件
+	// 写入_last_checkpoint标记文件，之后恢复才会使用该checkpoint
 	if err := pe.writeCheckpointMarker(tableID, version); err != nil {
-		logger.Warn("Failed to write checkpoint marker",
-			zap.String("table", tableID),
-			zap.Int64("version", version),
-			zap.Error(err))
+		return fmt.Errorf("failed to write checkpoint marker: %w", err)
 	}
 
 	logger.Info("Checkpoint created successfully",
 		zap.String("table", tableID),
 		zap.Int64("version", version),
 		zap.String("path", checkpointPath),
-		zap.Int("file_count", len(snapshot.Files)))
+		zap.Int("entry_count", len(entries)))
 
+	pe.expireDeltaLog(tableID, version)
 	return nil
 }
 
-// serializeCheckpoint 序列化snapshot到Parquet文件
-func (pe *ParquetEngine) serializeCheckpoint(snapshot *delta.Snapshot, path string) error {
-	// 创建Arrow Schema用于checkpoint
-	schema := arrow.NewSchema([]arrow.Field{
-		{Name: "file_path", Type: arrow.BinaryTypes.String},
-		{Name: "size", Type: arrow.PrimitiveTypes.Int64},
-		{Name: "row_count", Type: arrow.PrimitiveTypes.Int64},
-		{Name: "added_at", Type: arrow.PrimitiveTypes.Int64},
-		{Name: "is_delta", Type: arrow.FixedWidthTypes.Boolean},
-		{Name: "delta_type", Type: arrow.BinaryTypes.String},
-	}, nil)
-
-	// 构建Record
-	pool := memory.NewGoAllocator()
-	builder := array.NewRecordBuilder(pool, schema)
-	defer builder.Release()
-
-	for _, file := range snapshot.Files {
-		builder.Field(0).(*array.StringBuilder).Append(file.Path)
-		builder.Field(1).(*array.Int64Builder).Append(file.Size)
-		builder.Field(2).(*array.Int64Builder).Append(file.RowCount)
-		builder.Field(3).(*array.Int64Builder).Append(file.AddedAt)
-		builder.Field(4).(*array.BooleanBuilder).Append(file.IsDelta)
-		builder.Field(5).(*array.StringBuilder).Append(file.DeltaType)
-	}
-
-	record := builder.NewRecord()
+// serializeCheckpoint 将压缩后的日志条目写入Parquet文件 (与 sys.delta_log 相同的列)
+func (pe *ParquetEngine) serializeCheckpoint(entries []delta.LogEntry, path string) error {
+	record := deltaLogRecord(entries)
 	defer record.Release()
 
 	// 写入Parquet文件（使用带fsync的writer）
-	_, err := parquet.WriteArrowBatch(path, record)
-	if err != nil {
+	if _, err := parquet.WriteArrowBatchTo(pe.ParquetStore(), path, record); err != nil {
 		return fmt.Errorf("failed to write checkpoint parquet: %w", err)
 	}
 
 	logger.Info("Checkpoint parquet file written",
 		zap.String("path", path),
-		zap.Int("file_count", len(snapshot.Files)))
+		zap.Int("entry_count", len(entries)))
 
 	return nil
 }
 
 // writeCheckpointMarker 写入_last_checkpoint标记文件
 func (pe *ParquetEngine) writeCheckpointMarker(tableID string, version int64) error {
-	checkpointDir := filepath.Join(pe.basePath, "sys", "delta_log", "checkpoints")
-	markerPath := filepath.Join(checkpointDir, fmt.Sprintf("_last_checkpoint.%s", tableID))
+	checkpointDir := pe.checkpointDir()
+	markerPath := filepath.Join(checkpointDir, checkpointMarkerPrefix+tableID)
 
 	// 写入版本号
 	content := fmt.Sprintf("%d", version)
-	if err := os.WriteFile(markerPath, []byte(content), 0644); err != nil {
+	if err := pe.objectStore.Put(pe.objectKey(markerPath), []byte(content)); err != nil {
 		return fmt.Errorf("failed to write marker file: %w", err)
 	}
 
 	// Sync目录确保元数据持久化
-	dir, err := os.Open(checkpointDir)
-	if err == nil {
-		dir.Sync()
-		dir.Close()
+	if _, ok := pe.objectStore.(*objectstore.LocalStore); ok {
+		if dir, err := os.Open(checkpointDir); err == nil {
+			dir.Sync()
+			dir.Close()
+		}
 	}
 
 	return nil
 }
 
-// LoadLatestCheckpoint 加载最新的checkpoint
-func (pe *ParquetEngine) LoadLatestCheckpoint(tableID string) (*delta.Snapshot, error) {
-	checkpointDir := filepath.Join(pe.basePath, "sys", "delta_log", "checkpoints")
-	markerPath := filepath.Join(checkpointDir, fmt.Sprintf("_last_checkpoint.%s", tableID))
-
-	// 读取_last_checkpoint标记文件
-	data, err := os.ReadFile(markerPath)
+// checkpointMarkerVersion 读取表最新checkpoint的版本号，没有checkpoint时返回 0
+func (pe *ParquetEngine) checkpointMarkerVersion(tableID string) (int64, error) {
+	markerKey := pe.objectKey(filepath.Join(pe.checkpointDir(), checkpointMarkerPrefix+tableID))
+	if exists, err := pe.objectStore.Exists(markerKey); err != nil {
+		return 0, fmt.Errorf("failed to check checkpoint marker: %w", err)
+	} else if !exists {
+		return 0, nil
+	}
+	data, err := pe.objectStore.Get(markerKey)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil // 没有checkpoint
-		}
-		return nil, fmt.Errorf("failed to read checkpoint marker: %w", err)
+		return 0, fmt.Errorf("failed to read checkpoint marker: %w", err)
 	}
 
 	var version int64
 	if _, err := fmt.Sscanf(string(data), "%d", &version); err != nil {
-		return nil, fmt.Errorf("failed to parse checkpoint version: %w", err)
+		return 0, fmt.Errorf("failed to parse checkpoint version: %w", err)
 	}
+	return version, nil
+}
 
-	// 加载checkpoint文件
-	checkpointPath := pe.getCheckpointPath(tableID, version)
-	return pe.loadCheckpointFile(tableID, version, checkpointPath)
+// LoadLatestCheckpoint 加载最新的checkpoint，没有可用的checkpoint时返回 nil
+func (pe *ParquetEngine) LoadLatestCheckpoint(tableID string) (*delta.Snapshot, error) {
+	version, entries, err := pe.loadCheckpointEntries(tableID)
+	if err != nil || entries == nil {
+		return nil, err
+	}
+	return delta.SnapshotFromEntries(tableID, version, entries), nil
 }
 
-// loadCheckpointFile 从Parquet文件加载checkpoint
-func (pe *ParquetEngine) loadCheckpointFile(tableID string, version int64, path string) (*delta.Snapshot, error) {
-	// 检查文件是否存在
-	if _, err := os.Stat(path); os.IsNotExist(err) {
+// loadCheckpointEntries 读取表最新checkpoint中的日志条目
+// 早期版本的checkpoint只记录文件列表 (没有schema和统计信息)，无法用于恢复，返回 nil
+func (pe *ParquetEngine) loadCheckpointEntries(tableID string) (int64, []delta.LogEntry, error) {
+	version, err := pe.checkpointMarkerVersion(tableID)
+	if err != nil || version == 0 {
+		return 0, nil, err
+	}
+	entries, err := pe.readCheckpointEntries(tableID, version)
+	if err != nil || entries == nil {
+		return 0, nil, err
+	}
+	return version, entries, nil
+}
+
+// readCheckpointEntries 读取表在 version 处的checkpoint文件，文件不存在或为旧格式时返回 nil
+func (pe *ParquetEngine) readCheckpointEntries(tableID string, version int64) ([]delta.LogEntry, error) {
+	checkpointPath := pe.getCheckpointPath(tableID, version)
+	if exists, err := pe.objectStore.Exists(pe.objectKey(checkpointPath)); err != nil || !exists {
 		return nil, nil // checkpoint文件不存在
 	}
 
-	// 读取Parquet文件
-	record, err := parquet.ReadParquetFile(path, nil)
+	record, err := parquet.ReadParquetFileFrom(pe.ParquetStore(), checkpointPath, nil, 1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read checkpoint file: %w", err)
 	}
 	defer record.Release()
 
-	// 解析文件信息
-	files := make([]delta.FileInfo, 0, int(record.NumRows()))
+	if !isDeltaLogLayout(record.Schema()) {
+		logger.Info("Ignoring checkpoint written in the legacy file-list format",
+			zap.String("table", tableID),
+			zap.Int64("version", version))
+		return nil, nil
+	}
 
+	entries := make([]delta.LogEntry, 0, int(record.NumRows()))
 	for i := 0; i < int(record.NumRows()); i++ {
-		file := delta.FileInfo{
-			Path:       record.Column(0).(*array.String).Value(i),
-			Size:       record.Column(1).(*array.Int64).Value(i),
-			RowCount:   record.Column(2).(*array.Int64).Value(i),
-			AddedAt:    record.Column(3).(*array.Int64).Value(i),
-			IsDelta:    record.Column(4).(*array.Boolean).Value(i),
-			DeltaType:  record.Column(5).(*array.String).Value(i),
-			MinValues:  make(map[string]interface{}),
-			MaxValues:  make(map[string]interface{}),
-			NullCounts: make(map[string]int64),
+		entries = append(entries, pe.parseDeltaLogEntry(record, i))
+	}
+
+	logger.Info("Checkpoint loaded successfully",
+		zap.String("table", tableID),
+		zap.Int64("version", version),
+		zap.Int("entry_count", len(entries)))
+
+	return entries, nil
+}
+
+// isDeltaLogLayout 检查checkpoint文件的列是否与 sys.delta_log 一致
+// 较早版本写入的文件缺少末尾新增的列 (如 partition_values)，只比较文件中存在的列
+func isDeltaLogLayout(schema *arrow.Schema) bool {
+	expected := createDeltaLogSchema()
+	if schema.NumFields() < deltaLogRequiredColumns || schema.NumFields() > expected.NumFields() {
+		return false
+	}
+	for i, field := range schema.Fields() {
+		if field.Name != expected.Field(i).Name || !arrow.TypeEqual(field.Type, expected.Field(i).Type) {
+			return false
 		}
+	}
+	return true
+}
+
+// loadAllCheckpoints 加载所有表最新的checkpoint，返回每张表的checkpoint版本和压缩后的日志条目
+func (pe *ParquetEngine) loadAllCheckpoints() (map[string]int64, []delta.LogEntry) {
+	versions := make(map[string]int64)
+	entries := make([]delta.LogEntry, 0)
 
-		files = append(files, file)
+	dirKey := pe.objectKey(pe.checkpointDir())
+	keys, err := pe.objectStore.List(dirKey)
+	if err != nil {
+		logger.Info("No checkpoints found", zap.Error(err))
+		return versions, entries
 	}
 
-	snapshot := &delta.Snapshot{
-		Version: version,
-		TableID: tableID,
-		Files:   files,
+	for _, key := range keys {
+		name := path.Base(key)
+		if path.Dir(key) != dirKey || !strings.HasPrefix(name, checkpointMarkerPrefix) {
+			continue
+		}
+		tableID := strings.TrimPrefix(name, checkpointMarkerPrefix)
+
+		version, tableEntries, err := pe.loadCheckpointEntries(tableID)
+		if err != nil {
+			// checkpoint 不可读时回退为重放该表的全部日志
+			logger.Warn("Failed to load checkpoint, replaying full log",
+				zap.String("table", tableID),
+				zap.Error(err))
+			continue
+		}
+		if tableEntries == nil {
+			continue
+		}
+		versions[tableID] = version
+		entries = append(entries, tableEntries...)
 	}
 
-	logger.Info("Checkpoint loaded successfully",
-		zap.String("table", tableID),
-		zap.Int64("version", version),
-		zap.Int("file_count", len(files)))
+	return versions, entries
+}
+
+// expireDeltaLog 按保留策略删除已被 version 处checkpoint覆盖的日志文件和更早的checkpoint
+// 删除日志前先记录被删除的最大版本，之后加载历史时不会使用更早的起点
+// 旧版本生成的日志文件名不含版本号，无法判断是否被覆盖，保持不动
+func (pe *ParquetEngine) expireDeltaLog(tableID string, version int64) {
+	cutoff := time.Now().Add(-pe.logRetention)
+	expired := func(key string) bool {
+		info, err := pe.objectStore.Stat(key)
+		return err == nil && !time.Unix(info.ModifiedTime, 0).After(cutoff)
+	}
+
+	logDirKey := pe.objectKey(filepath.Join(pe.basePath, "sys", "delta_log", "data"))
+	logKeys, err := pe.objectStore.List(logDirKey)
+	if err != nil {
+		logger.Warn("Failed to list Delta Log files for expiry", zap.Error(err))
+		return
+	}
+	var (
+		expiredLogs []string
+		expiredUpTo int64
+	)
+	for _, key := range logKeys {
+		if path.Dir(key) != logDirKey {
+			continue
+		}
+		entryVersion, entryTable, ok := parseDeltaLogFileName(key)
+		if !ok || entryTable != tableID || entryVersion > version || !expired(key) {
+			continue
+		}
+		expiredLogs = append(expiredLogs, key)
+		expiredUpTo = max(expiredUpTo, entryVersion)
+	}
+	if len(expiredLogs) > 0 {
+		if err := pe.writeExpiredLogMarker(tableID, expiredUpTo); err != nil {
+			logger.Warn("Failed to record expired Delta Log version, keeping log files",
+				zap.String("table", tableID),
+				zap.Error(err))
+			return
+		}
+	}
+	removedLogs := 0
+	for _, key := range expiredLogs {
+		if err := pe.objectStore.Delete(key); err != nil {
+			logger.Warn("Failed to delete expired Delta Log file", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		removedLogs++
+	}
+
+	checkpointVersions, err := pe.checkpointVersions(tableID)
+	if err != nil {
+		logger.Warn("Failed to list checkpoints for expiry", zap.Error(err))
+		return
+	}
+	removedCheckpoints := 0
+	for _, checkpointVersion := range checkpointVersions {
+		key := pe.objectKey(pe.getCheckpointPath(tableID, checkpointVersion))
+		if checkpointVersion >= version || !expired(key) {
+			continue
+		}
+		if err := pe.objectStore.Delete(key); err != nil {
+			logger.Warn("Failed to delete expired checkpoint", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		removedCheckpoints++
+	}
+
+	if removedLogs > 0 || removedCheckpoints > 0 {
+		logger.Info("Expired Delta Log files covered by checkpoint",
+			zap.String("table", tableID),
+			zap.Int64("checkpoint_version", version),
+			zap.Int("log_files", removedLogs),
+			zap.Int("checkpoints", removedCheckpoints))
+	}
+}
+
+// checkpointVersions 列出表仍保留的checkpoint文件的版本号 (升序)
+func (pe *ParquetEngine) checkpointVersions(tableID string) ([]int64, error) {
+	dirKey := pe.objectKey(pe.checkpointDir())
+	keys, err := pe.objectStore.List(dirKey)
+	if err != nil {
+		return nil, err
+	}
+	prefix := checkpointFilePrefix + tableID + "."
+	var versions []int64
+	for _, key := range keys {
+		name := path.Base(key)
+		if path.Dir(key) != dirKey || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		version, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".parquet"), 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// writeExpiredLogMarker 记录表已清理的日志文件的最大版本，标记只前进
+func (pe *ParquetEngine) writeExpiredLogMarker(tableID string, version int64) error {
+	current, err := pe.expiredLogVersion(tableID)
+	if err != nil {
+		return err
+	}
+	if current >= version {
+		return nil
+	}
+	markerKey := pe.objectKey(filepath.Join(pe.checkpointDir(), expiredLogMarkerPrefix+tableID))
+	return pe.objectStore.Put(markerKey, []byte(strconv.FormatInt(version, 10)))
+}
+
+// expiredLogVersion 返回表已清理的日志文件的最大版本，没有清理过时返回 0
+func (pe *ParquetEngine) expiredLogVersion(tableID string) (int64, error) {
+	markerKey := pe.objectKey(filepath.Join(pe.checkpointDir(), expiredLogMarkerPrefix+tableID))
+	if exists, err := pe.objectStore.Exists(markerKey); err != nil {
+		return 0, fmt.Errorf("failed to check expired log marker: %w", err)
+	} else if !exists {
+		return 0, nil
+	}
+	data, err := pe.objectStore.Get(markerKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read expired log marker: %w", err)
+	}
+	version, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse expired log marker: %w", err)
+	}
+	return version, nil
+}
+
+// loadTableHistory 读取表在 compactedVersion (启动时加载的checkpoint) 及之前仍保留的历史日志
+//
+// 被清理的日志都不晚于 _expired_log 标记的版本，所以从不早于该版本的最旧checkpoint (没有清理过日志时从头开始)
+// 加上其后保留的日志文件，可以重建起点之后的每个版本。返回的 availableFrom 为历史起点，
+// 找不到比 compactedVersion 更早的起点时返回 compactedVersion
+func (pe *ParquetEngine) loadTableHistory(tableID string, compactedVersion int64) ([]delta.LogEntry, int64, error) {
+	expiredUpTo, err := pe.expiredLogVersion(tableID)
+	if err != nil {
+		return nil, compactedVersion, err
+	}
+	checkpoints, err := pe.checkpointVersions(tableID)
+	if err != nil {
+		return nil, compactedVersion, fmt.Errorf("failed to list checkpoints: %w", err)
+	}
+
+	var bases []int64
+	if expiredUpTo == 0 {
+		bases = append(bases, 0)
+	}
+	for _, version := range checkpoints {
+		if version >= expiredUpTo && version < compactedVersion {
+			bases = append(bases, version)
+		}
+	}
+
+	for _, base := range bases {
+		var entries []delta.LogEntry
+		if base > 0 {
+			if entries, err = pe.readCheckpointEntries(tableID, base); err != nil || entries == nil {
+				// 不可用的checkpoint，尝试下一个起点
+				continue
+			}
+		}
+		logs, err := pe.readTableLogs(tableID, base, compactedVersion)
+		if err != nil {
+			return nil, compactedVersion, err
+		}
+		return append(entries, logs...), base, nil
+	}
+	return nil, compactedVersion, nil
+}
+
+// readTableLogs 读取表在 (after, upTo] 版本区间内的日志文件
+func (pe *ParquetEngine) readTableLogs(tableID string, after, upTo int64) ([]delta.LogEntry, error) {
+	files, err := pe.scanParquetFiles(filepath.Join(pe.basePath, "sys", "delta_log", "data"))
+	if err != nil {
+		return nil, err
+	}
+	var entries []delta.LogEntry
+	for _, filePath := range files {
+		// 旧版本文件名不含版本号，读取后再按表和版本过滤
+		if version, table, ok := parseDeltaLogFileName(filePath); ok && (table != tableID || version <= after || version > upTo) {
+			continue
+		}
+		fileEntries, err := pe.readDeltaLogEntriesFromFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Delta Log file %s: %w", filePath, err)
+		}
+		for _, entry := range fileEntries {
+			if entry.TableID == tableID && entry.Version > after && entry.Version <= upTo {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries, nil
+}
 
-	return snapshot, nil
+// checkpointDir checkpoint文件所在目录
+func (pe *ParquetEngine) checkpointDir() string {
+	return filepath.Join(pe.basePath, "sys", "delta_log", "checkpoints")
 }
 
 // getCheckpointPath 获取checkpoint文件路径
 func (pe *ParquetEngine) getCheckpointPath(tableID string, version int64) string {
-	checkpointDir := filepath.Join(pe.basePath, "sys", "delta_log", "checkpoints")
-	filename := fmt.Sprintf("_checkpoint.%s.%020d.parquet", tableID, version)
-	return filepath.Join(checkpointDir, filename)
+	filename := fmt.Sprintf("%s%s.%020d.parquet", checkpointFilePrefix, tableID, version)
+	return filepath.Join(pe.checkpointDir(), filename)
 }
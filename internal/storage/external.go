@@ -0,0 +1,793 @@
+package storage
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/compute"
+	arrowcsv "github.com/apache/arrow/go/v18/arrow/csv"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/apache/arrow/go/v18/parquet/file"
+	"github.com/apache/arrow/go/v18/parquet/pqarrow"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/parquet"
+	"go.uber.org/zap"
+)
+
+// 外部表 (CREATE EXTERNAL TABLE ... LOCATION ... FORMAT ...)
+//
+// 外部表的数据文件由外部作业 (如 Spark) 写入和维护，MiniDB 只负责查询：
+// 表定义和 LOCATION / FORMAT 保存在 Schema 元数据中，数据文件不进入 Delta Log，
+// 每次扫描时重新发现 LOCATION 下的文件。Parquet 文件使用 footer 中的 min/max 统计做文件级裁剪，
+// 读出的记录统一转换为表 Schema (按列名匹配，缺失列为 NULL，类型不同时转换)。
+
+// 外部表文件格式
+const (
+	ExternalFormatParquet = "parquet"
+	ExternalFormatCSV     = "csv"
+)
+
+// 外部表选项 (CSV)
+const (
+	ExternalOptionHeader    = "header"
+	ExternalOptionDelimiter = "delimiter"
+)
+
+// externalTableMetadataKey 外部表定义在 Schema 元数据中的键
+const externalTableMetadataKey = "minidb.external"
+
+// externalInferSampleRows CSV 推断列类型时采样的行数
+const externalInferSampleRows = 1000
+
+// externalCSVChunkSize CSV 文件每个 Arrow 记录的行数
+const externalCSVChunkSize = 64 * 1024
+
+// ExternalTableSpec 外部表定义
+type ExternalTableSpec struct {
+	Location string            `json:"location"`          // 数据目录 (或单个文件) 的绝对路径
+	Format   string            `json:"format"`            // parquet / csv
+	Options  map[string]string `json:"options,omitempty"` // 格式选项 (CSV: header, delimiter)
+}
+
+// Header CSV 文件是否带表头 (默认 true)
+func (s *ExternalTableSpec) Header() bool {
+	v, ok := s.Options[ExternalOptionHeader]
+	if !ok {
+		return true
+	}
+	header, _ := strconv.ParseBool(v)
+	return header
+}
+
+// Delimiter CSV 字段分隔符 (默认逗号)
+func (s *ExternalTableSpec) Delimiter() rune {
+	v, ok := s.Options[ExternalOptionDelimiter]
+	if !ok {
+		return ','
+	}
+	if v == `\t` {
+		return '\t'
+	}
+	r, _ := utf8.DecodeRuneInString(v)
+	return r
+}
+
+// NormalizeExternalSpec 校验外部表定义：格式必须受支持，选项必须合法，LOCATION 转换为绝对路径且必须存在
+func NormalizeExternalSpec(spec *ExternalTableSpec) (*ExternalTableSpec, error) {
+	format := strings.ToLower(spec.Format)
+	if format != ExternalFormatParquet && format != ExternalFormatCSV {
+		return nil, fmt.Errorf("unsupported external table format '%s' (supported: parquet, csv)", spec.Format)
+	}
+
+	options := make(map[string]string)
+	for name, value := range spec.Options {
+		name = strings.ToLower(name)
+		switch {
+		case format == ExternalFormatCSV && name == ExternalOptionHeader:
+			if _, err := strconv.ParseBool(value); err != nil {
+				return nil, fmt.Errorf("invalid value for option '%s': %s", name, value)
+			}
+		case format == ExternalFormatCSV && name == ExternalOptionDelimiter:
+			if value != `\t` && utf8.RuneCountInString(value) != 1 {
+				return nil, fmt.Errorf("delimiter must be a single character, got '%s'", value)
+			}
+		default:
+			return nil, fmt.Errorf("unknown option '%s' for %s external table", name, format)
+		}
+		options[name] = value
+	}
+
+	location, err := filepath.Abs(spec.Location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location '%s': %w", spec.Location, err)
+	}
+	if _, err := os.Stat(location); err != nil {
+		return nil, fmt.Errorf("location '%s' does not exist", spec.Location)
+	}
+
+	normalized := &ExternalTableSpec{Location: location, Format: format}
+	if len(options) > 0 {
+		normalized.Options = options
+	}
+	return normalized, nil
+}
+
+// AttachExternalSpec 校验外部表定义并返回带有外部表元数据的新 Schema
+func AttachExternalSpec(schema *arrow.Schema, spec *ExternalTableSpec) (*arrow.Schema, error) {
+	if spec == nil {
+		return schema, nil
+	}
+	normalized, err := NormalizeExternalSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode external table spec: %w", err)
+	}
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	md := schema.Metadata()
+	for i, key := range md.Keys() {
+		if key == externalTableMetadataKey {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, md.Values()[i])
+	}
+	keys = append(keys, externalTableMetadataKey)
+	values = append(values, string(data))
+
+	metadata := arrow.NewMetadata(keys, values)
+	return arrow.NewSchema(schema.Fields(), &metadata), nil
+}
+
+// ExternalSpecFromSchema 从表 Schema 元数据中读取外部表定义，普通表返回 nil
+func ExternalSpecFromSchema(schema *arrow.Schema) *ExternalTableSpec {
+	if schema == nil {
+		return nil
+	}
+	md := schema.Metadata()
+	idx := md.FindKey(externalTableMetadataKey)
+	if idx < 0 {
+		return nil
+	}
+	spec := &ExternalTableSpec{}
+	if err := json.Unmarshal([]byte(md.Values()[idx]), spec); err != nil {
+		return nil
+	}
+	return spec
+}
+
+// DiscoverExternalFiles 列出外部表 LOCATION 下的数据文件 (按路径排序)
+// 递归遍历子目录，跳过以 '_' 或 '.' 开头的文件和目录 (如 Spark 的 _SUCCESS、.crc 和 _temporary)
+func DiscoverExternalFiles(spec *ExternalTableSpec) ([]string, error) {
+	info, err := os.Stat(spec.Location)
+	if err != nil {
+		return nil, fmt.Errorf("location '%s' is not accessible: %w", spec.Location, err)
+	}
+	if !info.IsDir() {
+		return []string{spec.Location}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(spec.Location, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if path != spec.Location && (strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".")) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && externalFileMatches(spec.Format, name) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list location '%s': %w", spec.Location, err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// externalFileMatches 按扩展名判断文件是否属于外部表格式
+func externalFileMatches(format, name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	switch format {
+	case ExternalFormatParquet:
+		return ext == ".parquet"
+	case ExternalFormatCSV:
+		return ext == ".csv" || ext == ".tsv" || ext == ".txt"
+	}
+	return false
+}
+
+// InferExternalSchema 从 LOCATION 下的第一个数据文件推断表结构
+// Parquet 读取 footer 中的 schema；CSV 使用表头作为列名 (无表头时为 c1, c2, ...) 并采样推断列类型
+func InferExternalSchema(spec *ExternalTableSpec) (*arrow.Schema, error) {
+	normalized, err := NormalizeExternalSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	files, err := DiscoverExternalFiles(normalized)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("cannot infer schema: no %s files found in '%s'", normalized.Format, spec.Location)
+	}
+
+	var schema *arrow.Schema
+	if normalized.Format == ExternalFormatParquet {
+		schema, err = inferParquetSchema(files[0])
+	} else {
+		schema, err = inferCSVSchema(files[0], normalized)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot infer schema from %s: %w", files[0], err)
+	}
+
+	logger.Info("Inferred external table schema",
+		zap.String("location", normalized.Location),
+		zap.String("file", files[0]),
+		zap.Int("columns", schema.NumFields()))
+	return schema, nil
+}
+
+// inferParquetSchema 读取 Parquet footer 中的 schema，并映射为 MiniDB 支持的列类型
+func inferParquetSchema(path string) (*arrow.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader, err := file.NewParquetReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parquet footer: %w", err)
+	}
+	defer reader.Close()
+
+	md := reader.MetaData()
+	fileSchema, err := pqarrow.FromParquet(md.Schema, nil, md.KeyValueMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert parquet schema: %w", err)
+	}
+
+	fields := make([]arrow.Field, 0, fileSchema.NumFields())
+	for _, field := range fileSchema.Fields() {
+		fields = append(fields, arrow.Field{Name: field.Name, Type: externalColumnType(field.Type), Nullable: true})
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// externalColumnType 将文件中的 Arrow 类型映射为表列类型：整数为 INT，浮点数为 FLOAT，
+// 布尔为 BOOLEAN，其余类型 (字符串、日期时间、decimal 等) 按 VARCHAR 处理
+func externalColumnType(dt arrow.DataType) arrow.DataType {
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return arrow.PrimitiveTypes.Int64
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return arrow.PrimitiveTypes.Float64
+	case arrow.BOOL:
+		return arrow.FixedWidthTypes.Boolean
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// inferCSVSchema 采样 CSV 文件的前若干行推断列类型 (INT -> FLOAT -> BOOLEAN -> VARCHAR)，空值不参与推断
+func inferCSVSchema(path string, spec *ExternalTableSpec) (*arrow.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.Comma = spec.Delimiter()
+	reader.FieldsPerRecord = -1
+
+	var names []string
+	if spec.Header() {
+		if names, err = reader.Read(); err != nil {
+			return nil, fmt.Errorf("failed to read header: %w", err)
+		}
+	}
+
+	// 每列的候选类型，依次退化
+	const (
+		candidateInt = iota
+		candidateFloat
+		candidateBool
+		candidateString
+	)
+	var candidates []int
+	seen := make([]bool, len(names))
+	for n := 0; n < externalInferSampleRows; n++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for len(candidates) < len(record) {
+			candidates = append(candidates, candidateInt)
+			seen = append(seen, false)
+		}
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+			seen[i] = true
+			if candidates[i] == candidateInt {
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					candidates[i] = candidateFloat
+				}
+			}
+			if candidates[i] == candidateFloat {
+				if _, err := strconv.ParseFloat(value, 64); err != nil {
+					candidates[i] = candidateBool
+				}
+			}
+			if candidates[i] == candidateBool {
+				if _, err := strconv.ParseBool(value); err != nil {
+					candidates[i] = candidateString
+				}
+			}
+		}
+	}
+
+	count := len(names)
+	if len(candidates) > count {
+		count = len(candidates)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("file is empty")
+	}
+
+	fields := make([]arrow.Field, count)
+	for i := range fields {
+		name := fmt.Sprintf("c%d", i+1)
+		if i < len(names) && strings.TrimSpace(names[i]) != "" {
+			name = strings.TrimSpace(names[i])
+		}
+		dt := arrow.DataType(arrow.BinaryTypes.String)
+		if i < len(candidates) && seen[i] {
+			switch candidates[i] {
+			case candidateInt:
+				dt = arrow.PrimitiveTypes.Int64
+			case candidateFloat:
+				dt = arrow.PrimitiveTypes.Float64
+			case candidateBool:
+				dt = arrow.FixedWidthTypes.Boolean
+			}
+		}
+		fields[i] = arrow.Field{Name: name, Type: dt, Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// externalStatsCache 外部 Parquet 文件的 footer 统计缓存，文件大小或修改时间变化后失效
+type externalStatsCache struct {
+	mu      sync.Mutex
+	entries map[string]externalStatsEntry
+}
+
+type externalStatsEntry struct {
+	size    int64
+	modTime time.Time
+	stats   *delta.FileStats
+}
+
+// get 返回文件的 footer 统计信息，缓存未命中时读取 footer
+func (c *externalStatsCache) get(path string, info os.FileInfo) (*delta.FileStats, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[path]
+	c.mu.Unlock()
+	if ok && entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
+		return entry.stats, nil
+	}
+
+	stats, err := parquet.ReadFooterStats(nil, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]externalStatsEntry)
+	}
+	c.entries[path] = externalStatsEntry{size: info.Size(), modTime: info.ModTime(), stats: stats}
+	c.mu.Unlock()
+	return stats, nil
+}
+
+// externalSpec 返回表的外部表定义，普通表和系统表返回 nil
+// 系统表直接返回：sys.delta_log 的持久化回调可能在持有 pe.mu 时触发写入
+func (pe *ParquetEngine) externalSpec(db, table string) *ExternalTableSpec {
+	if db == "sys" {
+		return nil
+	}
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return ExternalSpecFromSchema(pe.schemas[fmt.Sprintf("%s.%s", db, table)])
+}
+
+// checkWritable 外部表只读，拒绝写入、更新和删除
+func (pe *ParquetEngine) checkWritable(db, table string) error {
+	if pe.externalSpec(db, table) != nil {
+		return fmt.Errorf("table %s.%s is an external table and is read-only", db, table)
+	}
+	return nil
+}
+
+// scanExternal 扫描外部表：发现 LOCATION 下的文件，Parquet 文件先按 footer 统计裁剪，
+// 读出的记录统一转换为表 Schema
+func (pe *ParquetEngine) scanExternal(ctx context.Context, db, table string, spec *ExternalTableSpec, filters []Filter) (RecordIterator, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+	schema, err := pe.GetTableSchema(db, table)
+	if err != nil {
+		return nil, err
+	}
+	schema = arrow.NewSchema(schema.Fields(), nil)
+
+	paths, err := DiscoverExternalFiles(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Format == ExternalFormatCSV {
+		logger.Info("Files selected for external scan",
+			zap.String("table", tableID),
+			zap.Int("total", len(paths)))
+		return &externalCSVIterator{paths: paths, spec: spec, schema: schema, filters: toParquetFilters(filters)}, nil
+	}
+
+	files := make([]delta.FileInfo, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			// 文件在发现后被外部作业删除
+			continue
+		}
+		fileInfo := delta.FileInfo{Path: path, Size: info.Size()}
+		stats, err := pe.externalStats.get(path, info)
+		if err != nil {
+			logger.Warn("Failed to read parquet footer statistics",
+				zap.String("file", path),
+				zap.Error(err))
+		} else {
+			fileInfo.RowCount = stats.RowCount
+			fileInfo.MinValues, fileInfo.MaxValues = externalStatsValues(stats, schema)
+			fileInfo.NullCounts = stats.NullCounts
+		}
+		files = append(files, fileInfo)
+	}
+
+	// 查询条件和 context 中的裁剪条件都只用于跳过文件
+	pruneFilters := append(append([]Filter(nil), filters...), PartitionFilters(ctx)...)
+	selected := pe.filterFilesByStats(files, pruneFilters)
+	recordScanFiles(ctx, len(files), len(files), selected)
+
+	logger.Info("Files selected for external scan",
+		zap.String("table", tableID),
+		zap.Int("total", len(files)),
+		zap.Int("selected", len(selected)))
+
+	var iter *ParquetIterator
+	if parallelism := ScanParallelism(ctx); parallelism > 1 {
+		iter, err = NewParallelParquetIterator(nil, selected, nil, parallelism)
+	} else {
+		iter, err = NewParquetIterator(nil, selected, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &externalRecordIterator{inner: iter, schema: schema, filters: toParquetFilters(filters)}, nil
+}
+
+// externalStatsValues 只保留类型与表列一致的 min/max 统计 (整数统一为 int64，浮点数统一为 float64)，
+// 按 VARCHAR 读取的日期时间等列的统计值无法与查询条件比较，不参与裁剪
+func externalStatsValues(stats *delta.FileStats, schema *arrow.Schema) (map[string]interface{}, map[string]interface{}) {
+	minValues := make(map[string]interface{})
+	maxValues := make(map[string]interface{})
+	for name, min := range stats.MinValues {
+		idx := schemaFieldIndex(schema, name)
+		if idx < 0 {
+			continue
+		}
+		field := schema.Field(idx)
+		minValue, ok1 := externalStatsValue(min, field.Type)
+		maxValue, ok2 := externalStatsValue(stats.MaxValues[name], field.Type)
+		if ok1 && ok2 {
+			minValues[field.Name] = minValue
+			maxValues[field.Name] = maxValue
+		}
+	}
+	return minValues, maxValues
+}
+
+// externalStatsValue 将 footer 统计值转换为表列类型对应的值
+func externalStatsValue(value interface{}, dt arrow.DataType) (interface{}, bool) {
+	switch dt.ID() {
+	case arrow.INT64:
+		return toInt64(value)
+	case arrow.FLOAT64:
+		switch v := value.(type) {
+		case float64:
+			return v, true
+		case float32:
+			return float64(v), true
+		}
+	case arrow.BOOL:
+		v, ok := value.(bool)
+		return v, ok
+	case arrow.STRING:
+		v, ok := value.(string)
+		return v, ok
+	}
+	return nil, false
+}
+
+// externalRecordIterator 将外部 Parquet 文件的记录转换为表 Schema，并应用查询条件
+type externalRecordIterator struct {
+	inner   RecordIterator
+	schema  *arrow.Schema
+	filters []parquet.Filter
+	current arrow.Record
+	err     error
+}
+
+func (it *externalRecordIterator) Next() bool {
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	for it.inner.Next() {
+		record, err := conformRecord(it.inner.Record(), it.schema, it.filters)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if record.NumRows() == 0 {
+			record.Release()
+			continue
+		}
+		it.current = record
+		return true
+	}
+	it.err = it.inner.Err()
+	return false
+}
+
+func (it *externalRecordIterator) Record() arrow.Record {
+	return it.current
+}
+
+func (it *externalRecordIterator) Err() error {
+	return it.err
+}
+
+func (it *externalRecordIterator) Close() error {
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	return it.inner.Close()
+}
+
+// externalCSVIterator 逐个读取外部 CSV 文件，按列名 (有表头时) 或位置 (无表头时) 对应到表列
+type externalCSVIterator struct {
+	paths   []string
+	spec    *ExternalTableSpec
+	schema  *arrow.Schema
+	filters []parquet.Filter
+
+	pos     int
+	file    *os.File
+	reader  *arrowcsv.Reader
+	current arrow.Record
+	err     error
+}
+
+func (it *externalCSVIterator) Next() bool {
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	for {
+		if it.reader == nil {
+			if it.pos >= len(it.paths) {
+				return false
+			}
+			path := it.paths[it.pos]
+			it.pos++
+			if err := it.open(path); err != nil {
+				it.err = fmt.Errorf("%s: %w", path, err)
+				return false
+			}
+			if it.reader == nil {
+				continue
+			}
+		}
+
+		if !it.reader.Next() {
+			err := it.reader.Err()
+			path := it.file.Name()
+			it.closeFile()
+			if err != nil && err != io.EOF {
+				it.err = fmt.Errorf("%s: %w", path, err)
+				return false
+			}
+			continue
+		}
+
+		record, err := conformRecord(it.reader.Record(), it.schema, it.filters)
+		if err != nil {
+			it.err = fmt.Errorf("%s: %w", it.file.Name(), err)
+			return false
+		}
+		if record.NumRows() == 0 {
+			record.Release()
+			continue
+		}
+		it.current = record
+		return true
+	}
+}
+
+// open 打开 CSV 文件并创建 Arrow CSV reader；有表头时按表头构造文件 Schema (表中不存在的列按字符串读取后丢弃)
+// 空文件不创建 reader
+func (it *externalCSVIterator) open(path string) error {
+	fileSchema := it.schema
+	if it.spec.Header() {
+		header, err := readCSVHeader(path, it.spec.Delimiter())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fields := make([]arrow.Field, len(header))
+		for i, name := range header {
+			fields[i] = arrow.Field{Name: name, Type: arrow.BinaryTypes.String, Nullable: true}
+			if idx := schemaFieldIndex(it.schema, name); idx >= 0 {
+				fields[i].Type = it.schema.Field(idx).Type
+			}
+		}
+		fileSchema = arrow.NewSchema(fields, nil)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	it.file = f
+	it.reader = arrowcsv.NewReader(f, fileSchema,
+		arrowcsv.WithComma(it.spec.Delimiter()),
+		arrowcsv.WithHeader(it.spec.Header()),
+		arrowcsv.WithNullReader(true, ""),
+		arrowcsv.WithChunk(externalCSVChunkSize))
+	return nil
+}
+
+func (it *externalCSVIterator) closeFile() {
+	if it.reader != nil {
+		it.reader.Release()
+		it.reader = nil
+	}
+	if it.file != nil {
+		it.file.Close()
+		it.file = nil
+	}
+}
+
+func (it *externalCSVIterator) Record() arrow.Record {
+	return it.current
+}
+
+func (it *externalCSVIterator) Err() error {
+	return it.err
+}
+
+func (it *externalCSVIterator) Close() error {
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	it.closeFile()
+	return nil
+}
+
+// readCSVHeader 读取 CSV 文件的表头行
+func readCSVHeader(path string, delimiter rune) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader := csv.NewReader(f)
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	return header, nil
+}
+
+// schemaFieldIndex 按列名查找字段 (不区分大小写)，不存在时返回 -1
+func schemaFieldIndex(schema *arrow.Schema, name string) int {
+	for i, field := range schema.Fields() {
+		if strings.EqualFold(field.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// conformRecord 将文件记录转换为表 Schema：按列名匹配，文件中缺失的列为 NULL，类型不同时做安全转换，
+// 最后应用查询条件。返回的记录由调用方释放
+func conformRecord(record arrow.Record, schema *arrow.Schema, filters []parquet.Filter) (arrow.Record, error) {
+	rows := record.NumRows()
+	columns := make([]arrow.Array, schema.NumFields())
+	defer func() {
+		for _, col := range columns {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for i, field := range schema.Fields() {
+		idx := schemaFieldIndex(record.Schema(), field.Name)
+		if idx < 0 {
+			columns[i] = array.MakeArrayOfNull(memory.DefaultAllocator, field.Type, int(rows))
+			continue
+		}
+		col := record.Column(idx)
+		if arrow.TypeEqual(col.DataType(), field.Type) {
+			col.Retain()
+			columns[i] = col
+			continue
+		}
+		cast, err := compute.CastArray(context.Background(), col, compute.SafeCastOptions(field.Type))
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert column '%s' from %s to %s: %w", field.Name, col.DataType(), field.Type, err)
+		}
+		columns[i] = cast
+	}
+
+	conformed := array.NewRecord(schema, columns, rows)
+	if len(filters) == 0 {
+		return conformed, nil
+	}
+	defer conformed.Release()
+	return parquet.ApplyFilters(conformed, filters)
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MaintainedTables 返回需要后台维护 (compaction / checkpoint / vacuum / analyze) 的表，
+// 即现存的普通表 ("db.table")，不包括系统表和外部表
+func (pe *ParquetEngine) MaintainedTables() []string {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+
+	tables := make([]string, 0, len(pe.schemas))
+	for tableID, schema := range pe.schemas {
+		if strings.HasPrefix(tableID, "sys.") || ExternalSpecFromSchema(schema) != nil {
+			continue
+		}
+		tables = append(tables, tableID)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// TableDataDir 返回表数据文件所在目录，compaction 和 Z-Order 重写的文件写入该目录
+func (pe *ParquetEngine) TableDataDir(db, table string) string {
+	return filepath.Join(pe.basePath, db, table, "data")
+}
+
+// CheckpointTable 为表的最新版本创建 checkpoint
+// 返回 checkpoint 覆盖的版本；自上次 checkpoint 以来没有新日志时 created 为 false
+func (pe *ParquetEngine) CheckpointTable(db, table string) (version int64, created bool, err error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+	if err := pe.checkWritable(db, table); err != nil {
+		return 0, false, err
+	}
+
+	for _, entry := range pe.deltaLog.GetEntriesByTable(tableID) {
+		if entry.Version > version {
+			version = entry.Version
+		}
+	}
+	if version == 0 {
+		return 0, false, fmt.Errorf("table not found: %s", tableID)
+	}
+
+	current, err := pe.checkpointMarkerVersion(tableID)
+	if err != nil {
+		return 0, false, err
+	}
+	if current >= version {
+		return current, false, nil
+	}
+	if err := pe.CreateCheckpoint(tableID, version); err != nil {
+		return 0, false, err
+	}
+	return version, true, nil
+}
@@ -57,7 +57,7 @@ func (pe *ParquetEngine) UpdateMergeOnRead(ctx context.Context, db, table string
 	fileName := fmt.Sprintf("delta-update-%d-%s.parquet", time.Now().UnixNano(), uuid.New().String()[:8])
 	deltaPath := filepath.Join(pe.basePath, db, table, "deltas", fileName)
 
-	stats, err := parquet.WriteArrowBatch(deltaPath, record)
+	stats, err := parquet.WriteArrowBatchTo(pe.ParquetStore(), deltaPath, record)
 	if err != nil {
 		return 0, fmt.Errorf("failed to write delta file: %w", err)
 	}
@@ -72,7 +72,7 @@ func (pe *ParquetEngine) UpdateMergeOnRead(ctx context.Context, db, table string
 		DeltaType: "update",
 	}
 
-	if err := pe.deltaLog.AppendAdd(tableID, parquetFile); err != nil {
+	if err := pe.commitLog(ctx).AppendAdd(tableID, parquetFile); err != nil {
 		return 0, fmt.Errorf("failed to append to delta log: %w", err)
 	}
 
@@ -114,7 +114,7 @@ func (pe *ParquetEngine) DeleteMergeOnRead(ctx context.Context, db, table string
 	fileName := fmt.Sprintf("delta-delete-%d-%s.parquet", time.Now().UnixNano(), uuid.New().String()[:8])
 	deltaPath := filepath.Join(pe.basePath, db, table, "deltas", fileName)
 
-	stats, err := parquet.WriteArrowBatch(deltaPath, record)
+	stats, err := parquet.WriteArrowBatchTo(pe.ParquetStore(), deltaPath, record)
 	if err != nil {
 		return 0, fmt.Errorf("failed to write delta file: %w", err)
 	}
@@ -129,7 +129,7 @@ func (pe *ParquetEngine) DeleteMergeOnRead(ctx context.Context, db, table string
 		DeltaType: "delete",
 	}
 
-	if err := pe.deltaLog.AppendAdd(tableID, parquetFile); err != nil {
+	if err := pe.commitLog(ctx).AppendAdd(tableID, parquetFile); err != nil {
 		return 0, fmt.Errorf("failed to append to delta log: %w", err)
 	}
 
@@ -252,9 +252,10 @@ func (pe *ParquetEngine) estimateAffectedRows(ctx context.Context, tableID strin
 
 // MergeOnReadIterator implements iterator with delta file merging
 type MergeOnReadIterator struct {
-	baseIterator    RecordIterator   // Iterator for old base files (affected by deltas)
-	newBaseIterator RecordIterator   // Iterator for new base files (immune to deltas)
-	baseFiles       []delta.FileInfo // Track old base files for timestamp filtering
+	store           parquet.ObjectStore // Object store holding base and delta files
+	baseIterator    RecordIterator      // Iterator for old base files (affected by deltas)
+	newBaseIterator RecordIterator      // Iterator for new base files (immune to deltas)
+	baseFiles       []delta.FileInfo    // Track old base files for timestamp filtering
 	deltaFiles      []delta.FileInfo
 	currentRecord   arrow.Record
 	processingOld   bool // true if processing old files, false if processing new files
@@ -262,7 +263,7 @@ type MergeOnReadIterator struct {
 }
 
 // NewMergeOnReadIterator creates a new merge-on-read iterator
-func NewMergeOnReadIterator(baseFiles, deltaFiles []delta.FileInfo, filters []Filter) (RecordIterator, error) {
+func NewMergeOnReadIterator(store parquet.ObjectStore, baseFiles, deltaFiles []delta.FileInfo, filters []Filter) (RecordIterator, error) {
 	// Find the MINIMUM delta timestamp - any file added after ANY delta should be immune
 	// Delta files should ONLY apply to files that existed BEFORE the delta was created
 	// This ensures correct Merge-on-Read semantics
@@ -298,7 +299,7 @@ func NewMergeOnReadIterator(baseFiles, deltaFiles []delta.FileInfo, filters []Fi
 	var oldIterator RecordIterator
 	var err error
 	if len(oldBaseFiles) > 0 {
-		oldIterator, err = NewParquetIterator(oldBaseFiles, filters)
+		oldIterator, err = NewParquetIterator(store, oldBaseFiles, filters)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create old base iterator: %w", err)
 		}
@@ -307,7 +308,7 @@ func NewMergeOnReadIterator(baseFiles, deltaFiles []delta.FileInfo, filters []Fi
 	// Create base iterator for new files (will NOT have deltas applied)
 	var newIterator RecordIterator
 	if len(newBaseFiles) > 0 {
-		newIterator, err = NewParquetIterator(newBaseFiles, filters)
+		newIterator, err = NewParquetIterator(store, newBaseFiles, filters)
 		if err != nil {
 			if oldIterator != nil {
 				oldIterator.Close()
@@ -324,6 +325,7 @@ func NewMergeOnReadIterator(baseFiles, deltaFiles []delta.FileInfo, filters []Fi
 
 	// Create merge-on-read iterator
 	return &MergeOnReadIterator{
+		store:           store,
 		baseIterator:    oldIterator,
 		newBaseIterator: newIterator,
 		baseFiles:       oldBaseFiles,
@@ -476,7 +478,7 @@ func (m *MergeOnReadIterator) applyDeltas(baseRecord arrow.Record, deltaFiles []
 // readDeltaFile reads a delta file and returns its record
 func (m *MergeOnReadIterator) readDeltaFile(path string) (arrow.Record, error) {
 	// Read delta file without filters (we need the raw metadata)
-	return parquet.ReadParquetFile(path, nil)
+	return parquet.ReadParquetFileFrom(m.store, path, nil, 1)
 }
 
 // getDeltaType extracts delta type from delta record
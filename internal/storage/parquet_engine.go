@@ -2,10 +2,17 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/apache/arrow/go/v18/arrow"
@@ -14,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/yyun543/minidb/internal/delta"
 	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/metrics"
 	"github.com/yyun543/minidb/internal/objectstore"
 	"github.com/yyun543/minidb/internal/parquet"
 	"go.uber.org/zap"
@@ -22,12 +30,23 @@ import (
 // ParquetEngine Parquet 存储引擎实现
 type ParquetEngine struct {
 	basePath          string
-	objectStore       ObjectStore
+	objectStore       objectstore.ConditionalObjectStore // 数据文件、checkpoint 和 Delta Log 所在的对象存储
 	deltaLog          delta.LogInterface
 	schemas           map[string]*arrow.Schema // 表 schema 缓存
 	mu                sync.RWMutex
-	useOptimisticLock bool // 是否使用乐观并发控制
-	maxRetries        int  // 冲突重试次数
+	useOptimisticLock bool                // 是否使用乐观并发控制
+	maxRetries        int                 // 冲突重试次数
+	writeBuffer       *writeBufferManager // 写缓冲 (WAL + group commit)，nil 表示每次写入直接生成 Parquet 文件
+
+	checkpointInterval int           // 每张表累计多少条日志后创建 checkpoint
+	logRetention       time.Duration // 被 checkpoint 覆盖的日志和旧 checkpoint 的保留时长
+	checkpointMu       sync.Mutex    // 串行化 checkpoint 创建和日志过期清理
+	backupMu           sync.RWMutex  // BACKUP 复制数据文件期间持有读锁，VACUUM 删除文件前获取写锁
+
+	externalStats externalStatsCache // 外部表 Parquet 文件的 footer 统计缓存
+
+	opened      atomic.Bool  // Open 成功且尚未 Close
+	recoveryErr atomic.Value // Open 时 Delta Log 恢复失败的错误 (error)，/healthz 报告
 }
 
 // EngineOption 引擎配置选项
@@ -47,20 +66,23 @@ func WithMaxRetries(maxRetries int) EngineOption {
 	}
 }
 
-// NewParquetEngine 创建 Parquet 存储引擎
-func NewParquetEngine(basePath string, opts ...EngineOption) (*ParquetEngine, error) {
-	// 创建本地对象存储
-	objStore, err := objectstore.NewLocalStore(basePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create object store: %w", err)
+// WithObjectStore 使用指定的对象存储 (如 S3 兼容存储) 代替本地文件系统
+// basePath 此时只作为逻辑路径前缀，对象键为相对于 basePath 的路径
+func WithObjectStore(store objectstore.ConditionalObjectStore) EngineOption {
+	return func(pe *ParquetEngine) {
+		pe.objectStore = store
 	}
+}
 
+// NewParquetEngine 创建 Parquet 存储引擎
+func NewParquetEngine(basePath string, opts ...EngineOption) (*ParquetEngine, error) {
 	engine := &ParquetEngine{
-		basePath:          basePath,
-		objectStore:       objStore,
-		schemas:           make(map[string]*arrow.Schema),
-		useOptimisticLock: false, // 默认使用悲观锁（向后兼容）
-		maxRetries:        5,     // 默认重试5次
+		basePath:           basePath,
+		schemas:            make(map[string]*arrow.Schema),
+		useOptimisticLock:  false, // 默认使用悲观锁（向后兼容）
+		maxRetries:         5,     // 默认重试5次
+		checkpointInterval: delta.DefaultCheckpointInterval,
+		logRetention:       DefaultLogRetention,
 	}
 
 	// 应用配置选项
@@ -68,19 +90,62 @@ func NewParquetEngine(basePath string, opts ...EngineOption) (*ParquetEngine, er
 		opt(engine)
 	}
 
+	// 未指定对象存储时创建以 basePath 为根的本地对象存储
+	if engine.objectStore == nil {
+		objStore, err := objectstore.NewLocalStore(basePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create object store: %w", err)
+		}
+		engine.objectStore = objStore
+	}
+
 	// 根据配置选择Delta Log实现
 	if engine.useOptimisticLock {
-		// 使用乐观并发控制的Delta Log
-		// objStore实际是*objectstore.LocalStore，它实现了ConditionalObjectStore接口
-		engine.deltaLog = delta.NewOptimisticDeltaLog(objStore, basePath)
+		// 使用乐观并发控制的Delta Log (版本文件通过条件写入提交，对象存储以 basePath 为根)
+		optimisticLog := delta.NewOptimisticDeltaLog(engine.objectStore, "")
+		engine.deltaLog = optimisticLog
 	} else {
 		// 使用传统的悲观锁Delta Log
-		engine.deltaLog = delta.NewDeltaLog()
+		inMemoryLog := delta.NewDeltaLog()
+		engine.deltaLog = inMemoryLog
 	}
 
 	return engine, nil
 }
 
+// objectKey 将引擎内部使用的文件路径 (basePath/...) 转换为对象存储中的键
+func (pe *ParquetEngine) objectKey(path string) string {
+	rel, err := filepath.Rel(filepath.Clean(pe.basePath), filepath.Clean(path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		// 不在 basePath 之下的路径原样作为键
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// objectPath 将对象键转换回引擎内部使用的文件路径
+func (pe *ParquetEngine) objectPath(key string) string {
+	return filepath.Join(pe.basePath, filepath.FromSlash(key))
+}
+
+// ParquetStore 返回按引擎路径读写 Parquet 文件的对象存储
+func (pe *ParquetEngine) ParquetStore() parquet.ObjectStore {
+	return engineParquetStore{pe: pe}
+}
+
+// engineParquetStore 将引擎文件路径映射为对象键后访问对象存储
+type engineParquetStore struct {
+	pe *ParquetEngine
+}
+
+func (s engineParquetStore) GetWriter(path string) (io.WriteCloser, error) {
+	return s.pe.objectStore.GetWriter(s.pe.objectKey(path))
+}
+
+func (s engineParquetStore) GetReaderAt(path string) (objectstore.ReadAtSeekCloser, error) {
+	return s.pe.objectStore.GetReaderAt(s.pe.objectKey(path))
+}
+
 // Open 打开存储引擎并从磁盘恢复所有库表
 func (pe *ParquetEngine) Open() error {
 	logger.Info("Opening Parquet engine", zap.String("path", pe.basePath))
@@ -90,18 +155,29 @@ func (pe *ParquetEngine) Open() error {
 		logger.Warn("Failed to create system tables", zap.Error(err))
 	}
 
+	// 乐观并发控制的 Delta Log 从对象存储中的版本文件恢复最新版本号
+	if optimisticLog, ok := pe.deltaLog.(*delta.OptimisticDeltaLog); ok {
+		if err := optimisticLog.Bootstrap(); err != nil {
+			logger.Warn("Failed to bootstrap optimistic Delta Log", zap.Error(err))
+		}
+	}
+
 	// 2. 从 sys.delta_log 表恢复 Delta Log 状态到内存
 	if err := pe.recoverDeltaLogFromDisk(); err != nil {
 		logger.Warn("Failed to recover Delta Log, starting fresh", zap.Error(err))
+		pe.recoveryErr.Store(err)
 	}
 
 	// 3. 设置持久化回调（将新 entries 写入 sys.delta_log 表）
 	if inMemoryLog, ok := pe.deltaLog.(*delta.DeltaLog); ok {
-		inMemoryLog.SetPersistenceCallback(pe.persistDeltaLogEntry)
+		inMemoryLog.SetPersistenceCallback(pe.persistDeltaLogEntries)
+		inMemoryLog.SetCheckpointInterval(pe.checkpointInterval)
 		// 设置checkpoint回调（将snapshot序列化到Parquet文件）
 		inMemoryLog.SetCheckpointCallback(func(tableID string, version int64) error {
 			return pe.CreateCheckpoint(tableID, version)
 		})
+		// 时间旅行到 checkpoint 之前的版本时，从保留期内的旧日志重建历史
+		inMemoryLog.SetHistoryLoader(pe.loadTableHistory)
 	}
 
 	// 4. 从 Delta Log 恢复表的 schema
@@ -109,14 +185,37 @@ func (pe *ParquetEngine) Open() error {
 		logger.Warn("Failed to rebuild schemas", zap.Error(err))
 	}
 
+	// 5. 为缺少列统计信息的旧数据文件从 Parquet footer 补写统计
+	pe.backfillAllFileStats()
+
+	// 6. 重放写缓冲 WAL 中尚未刷写的数据，并启动后台刷写
+	if pe.writeBuffer != nil {
+		if err := pe.writeBuffer.recover(); err != nil {
+			return fmt.Errorf("failed to replay write-ahead log: %w", err)
+		}
+		pe.writeBuffer.start()
+	}
+
+	pe.opened.Store(true)
 	logger.Info("Parquet engine opened successfully")
 	return nil
 }
 
+// Health 检查引擎是否可以服务：已打开且 Delta Log 已成功恢复
+func (pe *ParquetEngine) Health() error {
+	if !pe.opened.Load() {
+		return fmt.Errorf("storage engine is not open")
+	}
+	if err, ok := pe.recoveryErr.Load().(error); ok {
+		return fmt.Errorf("delta log recovery failed: %w", err)
+	}
+	return nil
+}
+
 // createSystemTables 创建系统数据库和表
 func (pe *ParquetEngine) createSystemTables() error {
 	// 创建 sys 数据库
-	sysDBPath := filepath.Join(pe.basePath, "sys", ".db")
+	sysDBPath := pe.objectKey(filepath.Join(pe.basePath, "sys", ".db"))
 	if exists, _ := pe.objectStore.Exists(sysDBPath); !exists {
 		if err := pe.objectStore.Put(sysDBPath, []byte{}); err != nil {
 			return fmt.Errorf("failed to create sys database: %w", err)
@@ -125,7 +224,7 @@ func (pe *ParquetEngine) createSystemTables() error {
 	}
 
 	// 创建 sys.delta_log 表的目录标记
-	deltaLogMarker := filepath.Join(pe.basePath, "sys", "delta_log", ".table")
+	deltaLogMarker := pe.objectKey(filepath.Join(pe.basePath, "sys", "delta_log", ".table"))
 	if exists, _ := pe.objectStore.Exists(deltaLogMarker); !exists {
 		if err := pe.objectStore.Put(deltaLogMarker, []byte{}); err != nil {
 			return fmt.Errorf("failed to create delta_log table marker: %w", err)
@@ -136,13 +235,33 @@ func (pe *ParquetEngine) createSystemTables() error {
 	return nil
 }
 
-// persistDeltaLogEntry 持久化单个 Delta Log entry 到 sys.delta_log 表
-func (pe *ParquetEngine) persistDeltaLogEntry(entry *delta.LogEntry) error {
-	// 将 LogEntry 转换为 Arrow Record
-	schema := createDeltaLogSchema()
-	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+// persistDeltaLogEntries 持久化同一版本的 Delta Log entries 到 sys.delta_log 表
+// 文件名包含版本号和表名，恢复时无需读取即可跳过已被 checkpoint 覆盖的日志
+func (pe *ParquetEngine) persistDeltaLogEntries(entries []delta.LogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	record := deltaLogRecord(entries)
+	defer record.Release()
+
+	// 同一版本的多条日志 (如 RESTORE 提交) 写入同一个文件
+	// sys.delta_log 表写入时会被 writeParquetFile 跳过 Delta Log 跟踪，避免递归
+	return pe.writeParquetFile(context.Background(), "sys.delta_log", pe.deltaLogEntryPath(entries[0].Version, entries[0].TableID), record)
+}
+
+// deltaLogRecord 将日志条目转换为 sys.delta_log 格式的 Arrow Record (checkpoint 文件使用相同格式)
+func deltaLogRecord(entries []delta.LogEntry) arrow.Record {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, createDeltaLogSchema())
 	defer builder.Release()
 
+	for i := range entries {
+		appendDeltaLogRow(builder, &entries[i])
+	}
+	return builder.NewRecord()
+}
+
+// appendDeltaLogRow 追加一条日志到 Record 构建器
+func appendDeltaLogRow(builder *array.RecordBuilder, entry *delta.LogEntry) {
 	// 填充字段
 	builder.Field(0).(*array.Int64Builder).Append(entry.Version)
 	builder.Field(1).(*array.Int64Builder).Append(entry.Timestamp)
@@ -155,10 +274,11 @@ func (pe *ParquetEngine) persistDeltaLogEntry(entry *delta.LogEntry) error {
 		builder.Field(4).(*array.StringBuilder).Append(entry.FilePath)
 		builder.Field(5).(*array.Int64Builder).Append(entry.FileSize)
 		builder.Field(6).(*array.Int64Builder).Append(entry.RowCount)
-		// TODO: Serialize MinValues, MaxValues, NullCounts as JSON
-		builder.Field(7).(*array.StringBuilder).Append("") // min_values
-		builder.Field(8).(*array.StringBuilder).Append("") // max_values
-		builder.Field(9).(*array.StringBuilder).Append("") // null_counts
+		// 列统计信息带类型标签序列化，重启后文件级裁剪仍然可用
+		minValues, maxValues, nullCounts := encodeFileStats(entry)
+		builder.Field(7).(*array.StringBuilder).Append(minValues)  // min_values
+		builder.Field(8).(*array.StringBuilder).Append(maxValues)  // max_values
+		builder.Field(9).(*array.StringBuilder).Append(nullCounts) // null_counts
 		builder.Field(10).(*array.BooleanBuilder).Append(entry.DataChange)
 		builder.Field(11).AppendNull()                                   // deletion_timestamp
 		builder.Field(12).AppendNull()                                   // schema_json
@@ -166,6 +286,12 @@ func (pe *ParquetEngine) persistDeltaLogEntry(entry *delta.LogEntry) error {
 		builder.Field(14).AppendNull()                                   // index_operation
 		builder.Field(15).(*array.BooleanBuilder).Append(entry.IsDelta)  // is_delta
 		builder.Field(16).(*array.StringBuilder).Append(entry.DeltaType) // delta_type
+		appendPartitionValues(builder.Field(17).(*array.StringBuilder), entry)
+		if entry.AddedAt > 0 {
+			builder.Field(18).(*array.Int64Builder).Append(entry.AddedAt)
+		} else {
+			builder.Field(18).AppendNull() // added_at: 与提交时间相同
+		}
 
 	case delta.OpRemove:
 		builder.Field(4).(*array.StringBuilder).Append(entry.FilePath)
@@ -178,6 +304,8 @@ func (pe *ParquetEngine) persistDeltaLogEntry(entry *delta.LogEntry) error {
 		builder.Field(14).AppendNull() // index_operation
 		builder.Field(15).AppendNull() // is_delta
 		builder.Field(16).AppendNull() // delta_type
+		builder.Field(17).AppendNull() // partition_values
+		builder.Field(18).AppendNull() // added_at
 
 	case delta.OpMetadata:
 		for i := 4; i < 12; i++ {
@@ -197,14 +325,74 @@ func (pe *ParquetEngine) persistDeltaLogEntry(entry *delta.LogEntry) error {
 		builder.Field(14).(*array.StringBuilder).Append(entry.IndexOperation)
 		builder.Field(15).AppendNull() // is_delta
 		builder.Field(16).AppendNull() // delta_type
+		builder.Field(17).AppendNull() // partition_values
+		builder.Field(18).AppendNull() // added_at
 	}
 
-	record := builder.NewRecord()
-	defer record.Release()
+	// 审计字段: 提交用户
+	if entry.UserID != "" {
+		builder.Field(19).(*array.StringBuilder).Append(entry.UserID)
+	} else {
+		builder.Field(19).AppendNull()
+	}
+	if entry.WALWatermark > 0 {
+		builder.Field(20).(*array.Int64Builder).Append(entry.WALWatermark)
+	} else {
+		builder.Field(20).AppendNull() // wal_watermark: 不是写缓冲刷写的提交
+	}
+}
+
+// appendPartitionValues 以 JSON 写入 ADD entry 的分区值，非分区表的文件写入空值
+func appendPartitionValues(builder *array.StringBuilder, entry *delta.LogEntry) {
+	if len(entry.PartitionValues) == 0 {
+		builder.AppendNull()
+		return
+	}
+	data, err := json.Marshal(entry.PartitionValues)
+	if err != nil {
+		logger.Warn("Failed to encode partition values", zap.String("file", entry.FilePath), zap.Error(err))
+		builder.AppendNull()
+		return
+	}
+	builder.Append(string(data))
+}
+
+// deltaLogEntryPath sys.delta_log 中单条日志的文件路径: <20 位版本号>.<表名>.parquet
+func (pe *ParquetEngine) deltaLogEntryPath(version int64, tableID string) string {
+	filename := fmt.Sprintf("%020d.%s.parquet", version, tableID)
+	return filepath.Join(pe.basePath, "sys", "delta_log", "data", filename)
+}
 
-	// 调用 Write 方法，但注意不要递归
-	// sys.delta_log 表写入时会被 Write() 跳过 Delta Log 跟踪
-	return pe.Write(context.Background(), "sys", "delta_log", record)
+// parseDeltaLogFileName 从日志文件名解析版本号和表名，旧版本生成的文件名返回 false
+func parseDeltaLogFileName(filePath string) (int64, string, bool) {
+	name := strings.TrimSuffix(filepath.Base(filePath), ".parquet")
+	versionPart, tableID, ok := strings.Cut(name, ".")
+	if !ok || len(versionPart) != 20 || tableID == "" {
+		return 0, "", false
+	}
+	version, err := strconv.ParseInt(versionPart, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return version, tableID, true
+}
+
+// encodeFileStats 序列化 ADD entry 的列统计信息，编码失败时记录日志并写入空值 (仅影响文件裁剪)
+func encodeFileStats(entry *delta.LogEntry) (minValues, maxValues, nullCounts string) {
+	var err error
+	if minValues, err = delta.EncodeStatsValues(entry.MinValues); err != nil {
+		logger.Warn("Failed to encode min values", zap.String("file", entry.FilePath), zap.Error(err))
+		minValues = ""
+	}
+	if maxValues, err = delta.EncodeStatsValues(entry.MaxValues); err != nil {
+		logger.Warn("Failed to encode max values", zap.String("file", entry.FilePath), zap.Error(err))
+		maxValues = ""
+	}
+	if nullCounts, err = delta.EncodeNullCounts(entry.NullCounts); err != nil {
+		logger.Warn("Failed to encode null counts", zap.String("file", entry.FilePath), zap.Error(err))
+		nullCounts = ""
+	}
+	return minValues, maxValues, nullCounts
 }
 
 // createDeltaLogSchema 创建 Delta Log 表的 Schema
@@ -227,33 +415,52 @@ func createDeltaLogSchema() *arrow.Schema {
 		{Name: "index_operation", Type: arrow.BinaryTypes.String, Nullable: true},
 		{Name: "is_delta", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
 		{Name: "delta_type", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "partition_values", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "added_at", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "user_id", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "wal_watermark", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
 	}, nil)
 }
 
+// deltaLogRequiredColumns sys.delta_log 文件至少包含的列数，之后的列为后续版本追加的可选列
+const deltaLogRequiredColumns = 17
+
 // recoverDeltaLogFromDisk 从 sys.delta_log 表恢复 Delta Log 状态
+// 先加载每张表最新的 checkpoint，再只重放 checkpoint 之后的日志文件
 // 直接扫描 Parquet 文件，不使用 Delta Log API (因为 sys.delta_log 不跟踪自己)
 func (pe *ParquetEngine) recoverDeltaLogFromDisk() error {
-	logger.Info("Recovering Delta Log from sys.delta_log Parquet files")
+	logger.Info("Recovering Delta Log from checkpoints and sys.delta_log Parquet files")
 
-	// 直接扫描 sys/delta_log/data 目录中的 Parquet 文件
-	deltaLogDir := filepath.Join(pe.basePath, "sys", "delta_log", "data")
+	// 1. 加载 checkpoint (压缩后的日志条目)
+	checkpointVersions, allEntries := pe.loadAllCheckpoints()
 
-	// 扫描目录中的所有 Parquet 文件 (filepath.Glob 会自动处理目录不存在的情况)
+	// 2. 直接扫描 sys/delta_log/data 目录中的 Parquet 文件 (目录不存在时返回空列表)
+	deltaLogDir := filepath.Join(pe.basePath, "sys", "delta_log", "data")
 	files, err := pe.scanParquetFiles(deltaLogDir)
 	if err != nil {
 		logger.Info("Failed to scan Delta Log directory", zap.Error(err))
-		return nil
+		files = nil
 	}
 
-	if len(files) == 0 {
+	if len(files) == 0 && len(checkpointVersions) == 0 {
 		logger.Info("No Parquet files found in Delta Log directory, starting fresh")
 		return nil
 	}
 
-	allEntries := make([]delta.LogEntry, 0)
+	// covered 判断日志是否已包含在表的 checkpoint 中
+	covered := func(version int64, tableID string) bool {
+		checkpointVersion, ok := checkpointVersions[tableID]
+		return ok && version <= checkpointVersion
+	}
 
-	// 读取所有 Parquet 文件
+	// 3. 读取 checkpoint 之后的日志文件
+	replayedFiles, skippedFiles := 0, 0
 	for _, filePath := range files {
+		if version, tableID, ok := parseDeltaLogFileName(filePath); ok && covered(version, tableID) {
+			skippedFiles++
+			continue
+		}
+
 		entries, err := pe.readDeltaLogEntriesFromFile(filePath)
 		if err != nil {
 			logger.Warn("Failed to read Delta Log file",
@@ -261,18 +468,26 @@ func (pe *ParquetEngine) recoverDeltaLogFromDisk() error {
 				zap.Error(err))
 			continue
 		}
-		allEntries = append(allEntries, entries...)
+		replayedFiles++
+		// 旧版本文件名不含版本号，读取后再按版本过滤
+		for _, entry := range entries {
+			if !covered(entry.Version, entry.TableID) {
+				allEntries = append(allEntries, entry)
+			}
+		}
 	}
 
-	// 使用 RestoreFromEntries 恢复状态
+	// 4. 恢复状态 (按版本排序后重放，同一文件的多条 ADD 记录以最新版本为准)
 	if inMemoryLog, ok := pe.deltaLog.(*delta.DeltaLog); ok {
-		if err := inMemoryLog.RestoreFromEntries(allEntries); err != nil {
+		if err := inMemoryLog.RestoreFromCheckpoints(allEntries, checkpointVersions); err != nil {
 			return fmt.Errorf("failed to restore entries: %w", err)
 		}
 	}
 
 	logger.Info("Delta Log recovered from Parquet files",
-		zap.Int("file_count", len(files)),
+		zap.Int("checkpoint_count", len(checkpointVersions)),
+		zap.Int("replayed_files", replayedFiles),
+		zap.Int("skipped_files", skippedFiles),
 		zap.Int("entry_count", len(allEntries)))
 
 	return nil
@@ -281,11 +496,6 @@ func (pe *ParquetEngine) recoverDeltaLogFromDisk() error {
 // loadDeltaLogFromDisk 从磁盘加载 Delta Log 表数据
 func (pe *ParquetEngine) loadDeltaLogFromDisk(deltaLogDir string) error {
 	// 检查目录是否存在
-	if exists, err := pe.objectStore.Exists(deltaLogDir); err != nil || !exists {
-		return fmt.Errorf("delta log directory does not exist: %s", deltaLogDir)
-	}
-
-	// 扫描目录中的所有 Parquet 文件
 	files, err := pe.scanParquetFiles(deltaLogDir)
 	if err != nil {
 		return fmt.Errorf("failed to scan delta log files: %w", err)
@@ -327,38 +537,19 @@ func (pe *ParquetEngine) scanParquetFiles(dir string) ([]string, error) {
 	logger.Debug("Scanning directory for Parquet files",
 		zap.String("directory", dir))
 
-	// 使用 map 进行去重
-	fileSet := make(map[string]bool)
-
-	// 尝试常见的文件名模式
-	patterns := []string{
-		filepath.Join(dir, "*.parquet"),
-		filepath.Join(dir, "part-*.parquet"),
-		filepath.Join(dir, "delta_log_*.parquet"),
+	// 通过对象存储列出目录，只保留该目录下直接包含的 Parquet 文件
+	dirKey := pe.objectKey(dir)
+	keys, err := pe.objectStore.List(dirKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
 	}
 
-	for _, pattern := range patterns {
-		logger.Debug("Trying glob pattern", zap.String("pattern", pattern))
-		matches, err := filepath.Glob(pattern)
-		if err != nil {
-			logger.Debug("Glob pattern failed", zap.String("pattern", pattern), zap.Error(err))
+	files := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if path.Dir(key) != dirKey || !strings.HasSuffix(key, ".parquet") {
 			continue
 		}
-		logger.Debug("Glob pattern matched",
-			zap.String("pattern", pattern),
-			zap.Int("match_count", len(matches)),
-			zap.Strings("matches", matches))
-
-		// 去重：只添加不存在的文件
-		for _, match := range matches {
-			fileSet[match] = true
-		}
-	}
-
-	// 转换为切片
-	files := make([]string, 0, len(fileSet))
-	for file := range fileSet {
-		files = append(files, file)
+		files = append(files, pe.objectPath(key))
 	}
 
 	logger.Debug("Scan completed",
@@ -372,7 +563,7 @@ func (pe *ParquetEngine) scanParquetFiles(dir string) ([]string, error) {
 // readDeltaLogEntriesFromFile 从 Parquet 文件读取 Delta Log entries
 func (pe *ParquetEngine) readDeltaLogEntriesFromFile(filePath string) ([]delta.LogEntry, error) {
 	// 读取 Parquet 文件
-	record, err := parquet.ReadParquetFile(filePath, nil)
+	record, err := parquet.ReadParquetFileFrom(pe.ParquetStore(), filePath, nil, 1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read parquet file: %w", err)
 	}
@@ -433,6 +624,31 @@ func (pe *ParquetEngine) parseDeltaLogEntry(record arrow.Record, rowIdx int) del
 			if arr, ok := col.(*array.Int64); ok {
 				entry.RowCount = arr.Value(rowIdx)
 			}
+		case "min_values", "max_values":
+			if arr, ok := col.(*array.String); ok {
+				values, err := delta.DecodeStatsValues(arr.Value(rowIdx))
+				if err != nil {
+					logger.Warn("Failed to decode file stats from Delta Log entry",
+						zap.String("column", field.Name),
+						zap.Int64("version", entry.Version),
+						zap.Error(err))
+				} else if field.Name == "min_values" {
+					entry.MinValues = values
+				} else {
+					entry.MaxValues = values
+				}
+			}
+		case "null_counts":
+			if arr, ok := col.(*array.String); ok {
+				counts, err := delta.DecodeNullCounts(arr.Value(rowIdx))
+				if err != nil {
+					logger.Warn("Failed to decode null counts from Delta Log entry",
+						zap.Int64("version", entry.Version),
+						zap.Error(err))
+				} else {
+					entry.NullCounts = counts
+				}
+			}
 		case "data_change":
 			if arr, ok := col.(*array.Boolean); ok {
 				entry.DataChange = arr.Value(rowIdx)
@@ -475,6 +691,29 @@ func (pe *ParquetEngine) parseDeltaLogEntry(record arrow.Record, rowIdx int) del
 			if arr, ok := col.(*array.String); ok {
 				entry.DeltaType = arr.Value(rowIdx)
 			}
+		case "partition_values":
+			if arr, ok := col.(*array.String); ok {
+				values := make(map[string]string)
+				if err := json.Unmarshal([]byte(arr.Value(rowIdx)), &values); err != nil {
+					logger.Warn("Failed to decode partition values from Delta Log entry",
+						zap.Int64("version", entry.Version),
+						zap.Error(err))
+				} else {
+					entry.PartitionValues = values
+				}
+			}
+		case "added_at":
+			if arr, ok := col.(*array.Int64); ok {
+				entry.AddedAt = arr.Value(rowIdx)
+			}
+		case "user_id":
+			if arr, ok := col.(*array.String); ok {
+				entry.UserID = arr.Value(rowIdx)
+			}
+		case "wal_watermark":
+			if arr, ok := col.(*array.Int64); ok {
+				entry.WALWatermark = arr.Value(rowIdx)
+			}
 		}
 	}
 
@@ -536,6 +775,12 @@ func (pe *ParquetEngine) rebuildSchemasFromDeltaLog() error {
 // Close 关闭存储引擎
 func (pe *ParquetEngine) Close() error {
 	logger.Info("Closing Parquet engine")
+	pe.opened.Store(false)
+	if pe.writeBuffer != nil {
+		if err := pe.writeBuffer.close(); err != nil {
+			return fmt.Errorf("failed to flush write buffer: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -543,23 +788,19 @@ func (pe *ParquetEngine) Close() error {
 func (pe *ParquetEngine) CreateDatabase(name string) error {
 	logger.Info("Creating database", zap.String("name", name))
 
-	// 创建数据库目录
 	dbPath := filepath.Join(pe.basePath, name)
 
-	// 先创建目录
-	if err := os.MkdirAll(dbPath, 0755); err != nil {
-		return fmt.Errorf("failed to create database directory: %w", err)
+	// 创建.db标记文件 (本地存储会同时创建数据库目录)
+	if err := pe.objectStore.Put(pe.objectKey(filepath.Join(dbPath, ".db")), []byte{}); err != nil {
+		return fmt.Errorf("failed to create database marker: %w", err)
 	}
 
 	// Sync目录确保元数据持久化（P0改进：目录fsync）
-	if dir, err := os.Open(dbPath); err == nil {
-		dir.Sync()
-		dir.Close()
-	}
-
-	// 创建.db标记文件
-	if err := pe.objectStore.Put(filepath.Join(dbPath, ".db"), []byte{}); err != nil {
-		return fmt.Errorf("failed to create database marker: %w", err)
+	if _, ok := pe.objectStore.(*objectstore.LocalStore); ok {
+		if dir, err := os.Open(dbPath); err == nil {
+			dir.Sync()
+			dir.Close()
+		}
 	}
 
 	return nil
@@ -581,11 +822,16 @@ func (pe *ParquetEngine) ListDatabases() ([]string, error) {
 // DatabaseExists 检查数据库是否存在
 func (pe *ParquetEngine) DatabaseExists(name string) (bool, error) {
 	dbPath := filepath.Join(pe.basePath, name, ".db")
-	return pe.objectStore.Exists(dbPath)
+	return pe.objectStore.Exists(pe.objectKey(dbPath))
 }
 
 // CreateTable 创建表
 func (pe *ParquetEngine) CreateTable(db, table string, schema *arrow.Schema) error {
+	return pe.CreateTableContext(context.Background(), db, table, schema)
+}
+
+// CreateTableContext 创建表，METADATA 日志条目记录 ctx 中的提交用户
+func (pe *ParquetEngine) CreateTableContext(ctx context.Context, db, table string, schema *arrow.Schema) error {
 	pe.mu.Lock()
 	defer pe.mu.Unlock()
 
@@ -596,7 +842,7 @@ func (pe *ParquetEngine) CreateTable(db, table string, schema *arrow.Schema) err
 	pe.schemas[tableID] = schema
 
 	// 追加 METADATA 操作到 Delta Log
-	if err := pe.deltaLog.AppendMetadata(tableID, schema); err != nil {
+	if err := pe.commitLog(ctx).AppendMetadata(tableID, schema); err != nil {
 		return fmt.Errorf("failed to append metadata: %w", err)
 	}
 
@@ -606,6 +852,11 @@ func (pe *ParquetEngine) CreateTable(db, table string, schema *arrow.Schema) err
 
 // DropTable 删除表
 func (pe *ParquetEngine) DropTable(db, table string) error {
+	return pe.DropTableContext(context.Background(), db, table)
+}
+
+// DropTableContext 删除表，REMOVE 日志条目记录 ctx 中的提交用户
+func (pe *ParquetEngine) DropTableContext(ctx context.Context, db, table string) error {
 	pe.mu.Lock()
 	defer pe.mu.Unlock()
 
@@ -615,6 +866,11 @@ func (pe *ParquetEngine) DropTable(db, table string) error {
 	// 删除 schema 缓存
 	delete(pe.schemas, tableID)
 
+	// 丢弃尚未刷写的缓冲数据
+	if pe.writeBuffer != nil {
+		pe.writeBuffer.discard(db, table)
+	}
+
 	// 获取所有文件并标记为删除
 	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
 	if err != nil {
@@ -622,8 +878,9 @@ func (pe *ParquetEngine) DropTable(db, table string) error {
 	}
 
 	// Mark all files as REMOVE
+	commitLog := pe.commitLog(ctx)
 	for _, file := range snapshot.Files {
-		if err := pe.deltaLog.AppendRemove(tableID, file.Path); err != nil {
+		if err := commitLog.AppendRemove(tableID, file.Path); err != nil {
 			logger.Warn("Failed to remove file", zap.String("file", file.Path), zap.Error(err))
 		}
 	}
@@ -632,7 +889,7 @@ func (pe *ParquetEngine) DropTable(db, table string) error {
 	// Write a special REMOVE entry to mark the table as dropped
 	if len(snapshot.Files) == 0 {
 		// Use a special marker path to indicate table deletion
-		if err := pe.deltaLog.AppendRemove(tableID, fmt.Sprintf("_table_dropped_marker_%s", tableID)); err != nil {
+		if err := commitLog.AppendRemove(tableID, fmt.Sprintf("_table_dropped_marker_%s", tableID)); err != nil {
 			logger.Warn("Failed to write table deletion marker", zap.String("table", tableID), zap.Error(err))
 		}
 		logger.Info("Empty table deletion marker written", zap.String("table", tableID))
@@ -687,14 +944,27 @@ func (pe *ParquetEngine) Scan(ctx context.Context, db, table string, filters []F
 	tableID := fmt.Sprintf("%s.%s", db, table)
 	logger.Info("Scanning table", zap.String("table", tableID))
 
-	// 获取最新快照
-	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+	// 外部表在查询时发现数据文件
+	if spec := pe.externalSpec(db, table); spec != nil {
+		iter, err := pe.scanExternal(ctx, db, table, spec, filters)
+		if err != nil {
+			return nil, err
+		}
+		return withContext(ctx, iter), nil
+	}
+
+	// 获取最新快照以及写缓冲中尚未刷写的数据
+	snapshot, buffered, err := pe.latestSnapshot(db, table)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot: %w", err)
 	}
 
-	// 文件级过滤 (Zone Maps)
-	selectedFiles := pe.filterFilesByStats(snapshot.Files, filters)
+	// 分区裁剪 (查询条件和 context 中的分区裁剪条件)，再做文件级过滤 (Zone Maps)
+	partitionFilters := append(append([]Filter(nil), filters...), PartitionFilters(ctx)...)
+	partitionedFiles := pe.prunePartitions(tableID, snapshot.Files, partitionFilters)
+	selectedFiles := pe.filterFilesByStats(partitionedFiles, filters)
+	recordScanFiles(ctx, len(snapshot.Files), len(partitionedFiles), selectedFiles)
+	scanMetricsFrom(ctx).recordBuffered(buffered)
 
 	// Separate base files and delta files
 	baseFiles := make([]delta.FileInfo, 0)
@@ -719,13 +989,47 @@ func (pe *ParquetEngine) Scan(ctx context.Context, db, table string, filters []F
 		zap.Int("base_files", len(baseFiles)),
 		zap.Int("delta_files", len(deltaFiles)))
 
-	// Use Merge-on-Read iterator if there are delta files
+	var iter RecordIterator
 	if len(deltaFiles) > 0 {
-		return NewMergeOnReadIterator(baseFiles, deltaFiles, filters)
+		// Use Merge-on-Read iterator if there are delta files
+		iter, err = NewMergeOnReadIterator(pe.ParquetStore(), baseFiles, deltaFiles, filters)
+	} else if parallelism := ScanParallelism(ctx); parallelism > 1 {
+		// Standard iterator for base files only
+		iter, err = NewParallelParquetIterator(pe.ParquetStore(), baseFiles, filters, parallelism)
+	} else {
+		iter, err = NewParquetIterator(pe.ParquetStore(), baseFiles, filters)
+	}
+	if err != nil {
+		for _, rec := range buffered {
+			rec.Release()
+		}
+		return nil, err
 	}
 
-	// Standard iterator for base files only
-	return NewParquetIterator(baseFiles, filters)
+	// 写缓冲中的数据在文件之后返回
+	return withContext(ctx, withBufferedRecords(iter, buffered, filters)), nil
+}
+
+// latestSnapshot 获取表的最新快照，以及写缓冲中对扫描可见的数据 (已 Retain)
+// 两者在同一把可见性读锁下获取，避免与刷写提交交错导致数据重复或丢失
+func (pe *ParquetEngine) latestSnapshot(db, table string) (*delta.Snapshot, []arrow.Record, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+	var tb *tableWriteBuffer
+	if pe.writeBuffer != nil {
+		tb = pe.writeBuffer.lookup(db, table)
+	}
+	if tb == nil {
+		snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+		return snapshot, nil, err
+	}
+
+	tb.visMu.RLock()
+	defer tb.visMu.RUnlock()
+	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return snapshot, tb.bufferedRecords(), nil
 }
 
 // Write 写入数据
@@ -735,28 +1039,72 @@ func (pe *ParquetEngine) Write(ctx context.Context, db, table string, batch arro
 		zap.String("table", tableID),
 		zap.Int64("rows", batch.NumRows()))
 
-	// 生成 Parquet 文件路径
-	filePath := pe.generateFilePath(db, table)
+	if err := pe.checkWritable(db, table); err != nil {
+		return err
+	}
+
+	// 小批量写入先进入写缓冲 (WAL 持久化后返回)，达到阈值后合并为一个 Parquet 文件
+	if pe.writeBuffer != nil && pe.writeBuffer.buffers(db, batch) {
+		return pe.writeBuffer.append(ctx, db, table, batch)
+	}
 
-	// 写入 Parquet 文件
-	stats, err := parquet.WriteArrowBatch(filePath, batch)
+	// 直接写入新的 Parquet 文件
+	return pe.writeParquetFile(ctx, tableID, pe.generateFilePath(db, table), batch)
+}
+
+// writeParquetFile 写入 Parquet 文件并追加 ADD 日志
+// 分区表的所有分区文件写完后再依次追加 ADD 日志，提交用户取自 ctx
+func (pe *ParquetEngine) writeParquetFile(ctx context.Context, tableID, filePath string, batch arrow.Record) error {
+	files, err := pe.writeDataFiles(tableID, filePath, batch)
 	if err != nil {
-		return fmt.Errorf("failed to write parquet: %w", err)
+		return err
 	}
+	return pe.commitDataFiles(ctx, tableID, files)
+}
 
-	// 追加到 Delta Log
-	// 特殊处理：sys.delta_log 表不跟踪自己，避免无限递归
-	if tableID != "sys.delta_log" {
-		parquetFile := &delta.ParquetFile{
-			Path:     filePath,
-			Size:     stats.FileSize,
-			RowCount: stats.RowCount,
-			Stats:    stats,
+// writeDataFiles 写入一批数据的 Parquet 文件，返回待提交的文件描述
+// 分区表的数据按分区拆分，分别写入 filePath 所在目录下的分区子目录 (文件名不变)；
+// 中途失败时同时返回已经写入的文件，由调用方决定是否清理
+func (pe *ParquetEngine) writeDataFiles(tableID, filePath string, batch arrow.Record) ([]*delta.ParquetFile, error) {
+	partitioner, err := pe.tablePartitioner(tableID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load partition spec: %w", err)
+	}
+	if partitioner == nil {
+		file, err := pe.writeDataFile(tableID, filePath, batch, nil)
+		if err != nil {
+			return nil, err
 		}
+		return []*delta.ParquetFile{file}, nil
+	}
 
-		if err := pe.deltaLog.AppendAdd(tableID, parquetFile); err != nil {
-			return fmt.Errorf("failed to append to delta log: %w", err)
+	parts, err := partitioner.split(batch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, part := range parts {
+			part.record.Release()
 		}
+	}()
+
+	dir, name := filepath.Split(filePath)
+	files := make([]*delta.ParquetFile, 0, len(parts))
+	for _, part := range parts {
+		file, err := pe.writeDataFile(tableID, filepath.Join(dir, filepath.FromSlash(part.dir), name), part.record, part.values)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// writeDataFile 写入单个 Parquet 文件 (遵循表级写入选项)，返回待提交的文件描述
+func (pe *ParquetEngine) writeDataFile(tableID, filePath string, batch arrow.Record, partitionValues map[string]string) (*delta.ParquetFile, error) {
+	stats, err := parquet.WriteArrowBatchWithOptions(pe.ParquetStore(), filePath, batch, pe.tableWriterOptions(tableID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to write parquet: %w", err)
 	}
 
 	logger.Info("Write completed",
@@ -764,9 +1112,64 @@ func (pe *ParquetEngine) Write(ctx context.Context, db, table string, batch arro
 		zap.String("file", filePath),
 		zap.Int64("rows", stats.RowCount))
 
+	return &delta.ParquetFile{
+		Path:            filePath,
+		Size:            stats.FileSize,
+		RowCount:        stats.RowCount,
+		Stats:           stats,
+		PartitionValues: partitionValues,
+	}, nil
+}
+
+// commitDataFiles 为写入的文件追加 ADD 日志
+// 特殊处理：sys.delta_log 表不跟踪自己，避免无限递归
+func (pe *ParquetEngine) commitDataFiles(ctx context.Context, tableID string, files []*delta.ParquetFile) error {
+	if tableID == "sys.delta_log" {
+		return nil
+	}
+	for _, file := range files {
+		err := pe.retryOnConflict(tableID, func() error {
+			return pe.commitLog(ctx).AppendAdd(tableID, file)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to append to delta log: %w", err)
+		}
+	}
 	return nil
 }
 
+// retryOnConflict 执行提交，乐观并发冲突时从对象存储刷新最新版本号后重试，最多 maxRetries 次
+// 只用于不依赖读取快照的追加写入 (blind append)，其他提交在冲突时需要重新计算
+func (pe *ParquetEngine) retryOnConflict(tableID string, commit func() error) error {
+	optimisticLog, _ := pe.deltaLog.(*delta.OptimisticDeltaLog)
+	for attempt := 1; ; attempt++ {
+		err := commit()
+		var conflict *delta.ConflictError
+		if !errors.As(err, &conflict) || optimisticLog == nil || attempt > pe.maxRetries {
+			return err
+		}
+		if err := optimisticLog.Bootstrap(); err != nil {
+			return err
+		}
+		metrics.DeltaCommitRetries.Inc()
+		logger.Warn("Retrying Delta Log commit after version conflict",
+			zap.String("table", tableID),
+			zap.Int64("version", conflict.Version),
+			zap.Int("attempt", attempt))
+	}
+}
+
+// tableWriterOptions 返回表的 Parquet 写入选项 (CREATE TABLE ... WITH (...))，未设置时为 nil
+// 系统表始终使用默认选项：sys.delta_log 的持久化回调可能在持有 pe.mu 时触发写入
+func (pe *ParquetEngine) tableWriterOptions(tableID string) *parquet.WriterOptions {
+	if strings.HasPrefix(tableID, "sys.") {
+		return nil
+	}
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return parquet.WriterOptionsFromSchema(pe.schemas[tableID])
+}
+
 // matchesFilters checks if a row matches all filters
 func (pe *ParquetEngine) matchesFilters(record arrow.Record, rowIdx int, filters []Filter) bool {
 	if len(filters) == 0 {
@@ -1003,12 +1406,25 @@ func (pe *ParquetEngine) toFloat64(v interface{}) float64 {
 // Update 更新数据 (Copy-on-Write)
 // Update 更新数据 (使用 Merge-on-Read)
 func (pe *ParquetEngine) Update(ctx context.Context, db, table string, filters []Filter, updates map[string]interface{}) (int64, error) {
+	if err := pe.checkWritable(db, table); err != nil {
+		return 0, err
+	}
+	// delta 文件只作用于已提交的文件，先刷写缓冲数据
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return 0, err
+	}
 	// 直接使用 Merge-on-Read 实现
 	return pe.UpdateMergeOnRead(ctx, db, table, filters, updates)
 }
 
 // Delete 删除数据 (使用 Merge-on-Read)
 func (pe *ParquetEngine) Delete(ctx context.Context, db, table string, filters []Filter) (int64, error) {
+	if err := pe.checkWritable(db, table); err != nil {
+		return 0, err
+	}
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return 0, err
+	}
 	// 直接使用 Merge-on-Read 实现
 	return pe.DeleteMergeOnRead(ctx, db, table, filters)
 }
@@ -1024,6 +1440,9 @@ func (pe *ParquetEngine) BeginTransaction() (Transaction, error) {
 // GetTableStats 获取表统计信息
 func (pe *ParquetEngine) GetTableStats(db, table string) (*TableStats, error) {
 	tableID := fmt.Sprintf("%s.%s", db, table)
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return nil, err
+	}
 
 	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
 	if err != nil {
@@ -1050,6 +1469,12 @@ func (pe *ParquetEngine) GetDeltaLog() delta.LogInterface {
 	return pe.deltaLog
 }
 
+// SchemaVersion 返回表最近一次 schema 变更的 Delta Log 版本，表不存在时返回 false
+// 预处理语句的计划缓存用它判断缓存的计划是否仍然有效
+func (pe *ParquetEngine) SchemaVersion(db, table string) (int64, bool) {
+	return delta.SchemaVersion(pe.deltaLog, fmt.Sprintf("%s.%s", db, table))
+}
+
 // ScanVersion 时间旅行查询
 func (pe *ParquetEngine) ScanVersion(ctx context.Context, db, table string, version int64, filters []Filter) (RecordIterator, error) {
 	tableID := fmt.Sprintf("%s.%s", db, table)
@@ -1057,6 +1482,15 @@ func (pe *ParquetEngine) ScanVersion(ctx context.Context, db, table string, vers
 		zap.String("table", tableID),
 		zap.Int64("version", version))
 
+	if pe.externalSpec(db, table) != nil {
+		return nil, fmt.Errorf("time travel is not supported on external table %s", tableID)
+	}
+
+	// 缓冲数据刷写后才有对应的版本
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return nil, err
+	}
+
 	// 获取指定版本的快照
 	snapshot, err := pe.deltaLog.GetSnapshot(tableID, version)
 	if err != nil {
@@ -1064,7 +1498,12 @@ func (pe *ParquetEngine) ScanVersion(ctx context.Context, db, table string, vers
 	}
 
 	// 创建迭代器
-	return NewParquetIterator(snapshot.Files, filters)
+	recordScanFiles(ctx, len(snapshot.Files), len(snapshot.Files), snapshot.Files)
+	iter, err := pe.snapshotIterator(snapshot.Files, filters)
+	if err != nil {
+		return nil, err
+	}
+	return withContext(ctx, iter), nil
 }
 
 // Helper methods
@@ -1213,6 +1652,13 @@ func compareValues(val, other interface{}) int {
 		}
 	}
 
+	// 时间比较 (日期/时间戳列的统计值)
+	if v1, ok1 := val.(time.Time); ok1 {
+		if v2, ok2 := other.(time.Time); ok2 {
+			return v1.Compare(v2)
+		}
+	}
+
 	// 不支持的类型，默认返回0
 	return 0
 }
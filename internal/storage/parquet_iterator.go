@@ -1,29 +1,154 @@
 package storage
 
 import (
+	"context"
+	"sync"
+
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/yyun543/minidb/internal/delta"
 	"github.com/yyun543/minidb/internal/parquet"
 )
 
+// scanParallelismKey context 中扫描并行度的键
+type scanParallelismKey struct{}
+
+// WithScanParallelism 返回携带扫描并行度的 context，Scan 据此并行读取文件和 row group
+func WithScanParallelism(ctx context.Context, parallelism int) context.Context {
+	return context.WithValue(ctx, scanParallelismKey{}, parallelism)
+}
+
+// ScanParallelism 获取 context 中的扫描并行度，未设置时为 1
+func ScanParallelism(ctx context.Context) int {
+	if ctx != nil {
+		if n, ok := ctx.Value(scanParallelismKey{}).(int); ok && n > 0 {
+			return n
+		}
+	}
+	return 1
+}
+
+// contextIterator 在每次 Next 前检查 context，查询被取消或超时后停止迭代，Err 返回取消原因
+type contextIterator struct {
+	RecordIterator
+	ctx context.Context
+	err error
+}
+
+// withContext 使迭代器响应 ctx 的取消；ctx 不可取消时原样返回
+func withContext(ctx context.Context, iter RecordIterator) RecordIterator {
+	if ctx == nil || ctx.Done() == nil {
+		return iter
+	}
+	return &contextIterator{RecordIterator: iter, ctx: ctx}
+}
+
+// Next 查询已取消时返回 false
+func (it *contextIterator) Next() bool {
+	if it.ctx.Err() != nil {
+		it.err = context.Cause(it.ctx)
+		return false
+	}
+	return it.RecordIterator.Next()
+}
+
+// Err 返回取消原因或底层迭代器的错误
+func (it *contextIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.RecordIterator.Err()
+}
+
 // ParquetIterator Parquet 文件迭代器
 type ParquetIterator struct {
+	store   parquet.ObjectStore
 	files   []delta.FileInfo
 	filters []Filter
 	current int
 	record  arrow.Record
 	err     error
+
+	// 并行预读：最多同时读取 parallelism 个文件，按文件顺序返回
+	parallelism int
+	results     []chan fileReadResult
+	slots       chan struct{}
+	done        chan struct{}
+	closeOnce   sync.Once
 }
 
-// NewParquetIterator 创建 Parquet 迭代器
-func NewParquetIterator(files []delta.FileInfo, filters []Filter) (*ParquetIterator, error) {
+// fileReadResult 预读结果
+type fileReadResult struct {
+	record arrow.Record
+	err    error
+}
+
+// NewParquetIterator 创建 Parquet 迭代器，store 为 nil 时从本地文件系统读取
+func NewParquetIterator(store parquet.ObjectStore, files []delta.FileInfo, filters []Filter) (*ParquetIterator, error) {
 	return &ParquetIterator{
+		store:   store,
 		files:   files,
 		filters: filters,
 		current: -1,
 	}, nil
 }
 
+// NewParallelParquetIterator 创建并行预读的 Parquet 迭代器
+func NewParallelParquetIterator(store parquet.ObjectStore, files []delta.FileInfo, filters []Filter, parallelism int) (*ParquetIterator, error) {
+	pi, _ := NewParquetIterator(store, files, filters)
+	pi.parallelism = parallelism
+	return pi, nil
+}
+
+// parquetFilters 转换 Filter 类型
+func (pi *ParquetIterator) parquetFilters() []parquet.Filter {
+	return toParquetFilters(pi.filters)
+}
+
+// toParquetFilters 将存储层 Filter 转换为 parquet 包的 Filter
+func toParquetFilters(filters []Filter) []parquet.Filter {
+	parquetFilters := make([]parquet.Filter, len(filters))
+	for i, f := range filters {
+		parquetFilters[i] = parquet.Filter{
+			Column:   f.Column,
+			Operator: f.Operator,
+			Value:    f.Value,
+			Values:   f.Values, // 支持 IN 操作符的多个值
+		}
+	}
+	return parquetFilters
+}
+
+// startPrefetch 启动后台预读，同时在读的文件数不超过并行度
+func (pi *ParquetIterator) startPrefetch() {
+	pi.results = make([]chan fileReadResult, len(pi.files))
+	for i := range pi.results {
+		pi.results[i] = make(chan fileReadResult, 1)
+	}
+	pi.slots = make(chan struct{}, pi.parallelism)
+	pi.done = make(chan struct{})
+
+	filters := pi.parquetFilters()
+	// 文件数多于并行度时按文件并行，否则把剩余并行度用于 row group
+	perFile := pi.parallelism / len(pi.files)
+	if perFile < 1 {
+		perFile = 1
+	}
+
+	go func() {
+		for i, file := range pi.files {
+			select {
+			case pi.slots <- struct{}{}:
+			case <-pi.done:
+				return
+			}
+			go func(i int, path string) {
+				record, err := parquet.ReadParquetFileFrom(pi.store, path, filters, perFile)
+				pi.results[i] <- fileReadResult{record: record, err: err}
+			}(i, file.Path)
+		}
+	}()
+}
+
 // Next 移动到下一条记录
 func (pi *ParquetIterator) Next() bool {
 	// 释放上一条记录
@@ -39,21 +164,25 @@ func (pi *ParquetIterator) Next() bool {
 		return false
 	}
 
-	// 读取当前文件
-	file := pi.files[pi.current]
-
-	// 转换 Filter 类型
-	parquetFilters := make([]parquet.Filter, len(pi.filters))
-	for i, f := range pi.filters {
-		parquetFilters[i] = parquet.Filter{
-			Column:   f.Column,
-			Operator: f.Operator,
-			Value:    f.Value,
-			Values:   f.Values, // 支持 IN 操作符的多个值
+	// 并行预读模式：按顺序等待对应文件的读取结果
+	if pi.parallelism > 1 {
+		if pi.results == nil {
+			pi.startPrefetch()
 		}
+		result := <-pi.results[pi.current]
+		<-pi.slots
+		if result.err != nil {
+			pi.err = result.err
+			return false
+		}
+		pi.record = result.record
+		return true
 	}
 
-	record, err := parquet.ReadParquetFile(file.Path, parquetFilters)
+	// 读取当前文件
+	file := pi.files[pi.current]
+
+	record, err := parquet.ReadParquetFileFrom(pi.store, file.Path, pi.parquetFilters(), 1)
 	if err != nil {
 		pi.err = err
 		return false
@@ -79,5 +208,8 @@ func (pi *ParquetIterator) Close() error {
 		pi.record.Release()
 		pi.record = nil
 	}
+	if pi.done != nil {
+		pi.closeOnce.Do(func() { close(pi.done) })
+	}
 	return nil
 }
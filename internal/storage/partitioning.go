@@ -0,0 +1,1004 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/types"
+	"go.uber.org/zap"
+)
+
+// 物理分区 (Hive 风格目录布局)
+//
+// 分区表的数据文件写入 <db>/<table>/data/<分区目录>/ 下，分区目录由 key=value 组成：
+//   - LIST 列值分区: region=us/year=2024
+//   - LIST 显式分区: region_list=p_america
+//   - RANGE 分区:    id_range=p0
+//   - HASH 分区:     user_id_bucket=3
+//
+// 分区定义以 JSON 保存在表 Arrow Schema 的元数据中，随 Delta Log 的 METADATA 记录持久化；
+// 每条 ADD 日志记录文件所属分区的分区值，Scan 据此按 WHERE 条件裁剪分区。
+
+// 分区类型
+const (
+	PartitionHash  = "HASH"
+	PartitionRange = "RANGE"
+	PartitionList  = "LIST"
+)
+
+// DefaultHashPartitions HASH 分区未指定 PARTITIONS 时的分区数
+const DefaultHashPartitions = 4
+
+// partitionSpecMetadataKey 分区定义保存在表 Arrow Schema 元数据中的键
+const partitionSpecMetadataKey = "minidb.partition.spec"
+
+// hiveDefaultPartition 分区列值为 NULL 时使用的分区值 (与 Hive 一致)
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// maxHashPruningCombinations HASH 分区裁剪时最多计算的键值组合数，超过时不裁剪
+const maxHashPruningCombinations = 64
+
+// PartitionSpec 表的分区定义 (CREATE TABLE ... PARTITION BY ...)
+type PartitionSpec struct {
+	Type       string                `json:"type"`                 // HASH / RANGE / LIST
+	Columns    []string              `json:"columns"`              // 分区键列
+	Buckets    int                   `json:"buckets,omitempty"`    // HASH 分区数
+	Partitions []PartitionDefinition `json:"partitions,omitempty"` // RANGE / LIST 显式分区，LIST 为空时按列值分区
+}
+
+// PartitionDefinition RANGE / LIST 显式分区定义
+// 值统一以字符串保存，使用时按分区列的类型解析
+type PartitionDefinition struct {
+	Name     string   `json:"name"`
+	LessThan *string  `json:"less_than,omitempty"` // RANGE 上界 (不含)，nil 表示 MAXVALUE
+	Values   []string `json:"values,omitempty"`    // LIST 值列表
+}
+
+// FormatPartitionValue 将分区值格式化为分区定义和分区目录中使用的字符串形式
+func FormatPartitionValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return hiveDefaultPartition
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// IsIdentity 是否为 LIST 列值分区 (每个不同的列值组合一个分区)
+func (s *PartitionSpec) IsIdentity() bool {
+	return s.Type == PartitionList && len(s.Partitions) == 0
+}
+
+// labelKey 显式分区和 HASH 分区在目录名与分区值中使用的键
+func (s *PartitionSpec) labelKey() string {
+	switch s.Type {
+	case PartitionHash:
+		return strings.Join(s.Columns, "_") + "_bucket"
+	case PartitionRange:
+		return s.Columns[0] + "_range"
+	default:
+		return s.Columns[0] + "_list"
+	}
+}
+
+// partitionIndex 按名称查找显式分区 (不区分大小写)
+func (s *PartitionSpec) partitionIndex(name string) int {
+	for i, def := range s.Partitions {
+		if strings.EqualFold(def.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AttachPartitionSpec 校验分区定义并返回带有分区元数据的新 Schema
+func AttachPartitionSpec(schema *arrow.Schema, spec *PartitionSpec) (*arrow.Schema, error) {
+	if spec == nil {
+		return schema, nil
+	}
+	normalized, err := normalizePartitionSpec(schema, spec)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode partition spec: %w", err)
+	}
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	md := schema.Metadata()
+	for i, key := range md.Keys() {
+		if key == partitionSpecMetadataKey {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, md.Values()[i])
+	}
+	keys = append(keys, partitionSpecMetadataKey)
+	values = append(values, string(data))
+
+	metadata := arrow.NewMetadata(keys, values)
+	return arrow.NewSchema(schema.Fields(), &metadata), nil
+}
+
+// PartitionSpecFromSchema 从表 Schema 元数据中读取分区定义，未分区时返回 nil
+func PartitionSpecFromSchema(schema *arrow.Schema) *PartitionSpec {
+	if schema == nil {
+		return nil
+	}
+	md := schema.Metadata()
+	idx := md.FindKey(partitionSpecMetadataKey)
+	if idx < 0 {
+		return nil
+	}
+	spec := &PartitionSpec{}
+	if err := json.Unmarshal([]byte(md.Values()[idx]), spec); err != nil {
+		return nil
+	}
+	return spec
+}
+
+// normalizePartitionSpec 校验分区定义：分区列必须存在且类型受支持，分区值必须能按列类型解析，
+// RANGE 上界必须严格递增；分区值统一为规范的字符串形式
+func normalizePartitionSpec(schema *arrow.Schema, spec *PartitionSpec) (*PartitionSpec, error) {
+	out := &PartitionSpec{
+		Type:    strings.ToUpper(spec.Type),
+		Columns: make([]string, len(spec.Columns)),
+		Buckets: spec.Buckets,
+	}
+	if len(spec.Columns) == 0 {
+		return nil, fmt.Errorf("partitioning requires at least one column")
+	}
+	seen := make(map[string]bool)
+	colTypes := make([]arrow.DataType, len(spec.Columns))
+	for i, col := range spec.Columns {
+		field, ok := partitionField(schema, col)
+		if !ok {
+			return nil, fmt.Errorf("partition column '%s' does not exist", col)
+		}
+		if !supportedPartitionType(field.Type) {
+			return nil, fmt.Errorf("partition column '%s' has unsupported type %s", col, field.Type)
+		}
+		if seen[field.Name] {
+			return nil, fmt.Errorf("duplicate partition column '%s'", col)
+		}
+		seen[field.Name] = true
+		out.Columns[i] = field.Name
+		colTypes[i] = field.Type
+	}
+
+	switch out.Type {
+	case PartitionHash:
+		if out.Buckets == 0 {
+			out.Buckets = DefaultHashPartitions
+		}
+		if out.Buckets < 0 {
+			return nil, fmt.Errorf("number of HASH partitions must be positive")
+		}
+		if len(spec.Partitions) > 0 {
+			return nil, fmt.Errorf("HASH partitioning does not take partition definitions")
+		}
+		return out, nil
+	case PartitionRange, PartitionList:
+		out.Buckets = 0
+	default:
+		return nil, fmt.Errorf("unsupported partition type '%s'", spec.Type)
+	}
+
+	if len(spec.Partitions) == 0 {
+		if out.Type == PartitionRange {
+			return nil, fmt.Errorf("RANGE partitioning requires partition definitions")
+		}
+		return out, nil
+	}
+	if len(out.Columns) != 1 {
+		return nil, fmt.Errorf("%s partitions with explicit definitions require exactly one column", out.Type)
+	}
+
+	dt := colTypes[0]
+	names := make(map[string]bool)
+	listValues := make(map[string]string)
+	var prevBound interface{}
+	for i, def := range spec.Partitions {
+		if def.Name == "" {
+			return nil, fmt.Errorf("partition name cannot be empty")
+		}
+		if names[strings.ToLower(def.Name)] {
+			return nil, fmt.Errorf("duplicate partition name '%s'", def.Name)
+		}
+		names[strings.ToLower(def.Name)] = true
+		normalized := PartitionDefinition{Name: def.Name}
+
+		if out.Type == PartitionRange {
+			if def.LessThan == nil {
+				if i != len(spec.Partitions)-1 {
+					return nil, fmt.Errorf("MAXVALUE can only be used in the last partition definition")
+				}
+			} else {
+				bound, err := parsePartitionValue(*def.LessThan, dt)
+				if err != nil {
+					return nil, fmt.Errorf("invalid bound for partition '%s': %w", def.Name, err)
+				}
+				if prevBound != nil && compareValues(bound, prevBound) <= 0 {
+					return nil, fmt.Errorf("VALUES LESS THAN value must be strictly increasing for each partition (partition '%s')", def.Name)
+				}
+				prevBound = bound
+				s := FormatPartitionValue(bound)
+				normalized.LessThan = &s
+			}
+		} else {
+			if len(def.Values) == 0 {
+				return nil, fmt.Errorf("partition '%s' must have at least one value", def.Name)
+			}
+			for _, raw := range def.Values {
+				value, err := parsePartitionValue(raw, dt)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for partition '%s': %w", def.Name, err)
+				}
+				s := FormatPartitionValue(value)
+				if owner, exists := listValues[s]; exists {
+					return nil, fmt.Errorf("value %s appears in both partition '%s' and '%s'", s, owner, def.Name)
+				}
+				listValues[s] = def.Name
+				normalized.Values = append(normalized.Values, s)
+			}
+		}
+		out.Partitions = append(out.Partitions, normalized)
+	}
+	return out, nil
+}
+
+// partitionField 按名称查找分区列 (不区分大小写)
+func partitionField(schema *arrow.Schema, name string) (arrow.Field, bool) {
+	for _, field := range schema.Fields() {
+		if strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+	return arrow.Field{}, false
+}
+
+// supportedPartitionType 分区列支持的类型
+func supportedPartitionType(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.FLOAT32, arrow.FLOAT64, arrow.STRING, arrow.BOOL:
+		return true
+	}
+	return false
+}
+
+// parsePartitionValue 按列类型解析分区值字符串
+func parsePartitionValue(raw string, dt arrow.DataType) (interface{}, error) {
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		v, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid integer", raw)
+		}
+		return v, nil
+	case arrow.FLOAT32, arrow.FLOAT64:
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid number", raw)
+		}
+		return v, nil
+	case arrow.BOOL:
+		v, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid boolean", raw)
+		}
+		return v, nil
+	default:
+		return raw, nil
+	}
+}
+
+// coercePartitionValue 将查询条件中的值转换为分区列类型，无法转换时返回 false
+func coercePartitionValue(value interface{}, dt arrow.DataType) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+	if s, ok := value.(string); ok {
+		v, err := parsePartitionValue(s, dt)
+		return v, err == nil
+	}
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		if v, ok := toInt64(value); ok {
+			return v, true
+		}
+		if f, ok := toFloat64(value); ok && f == float64(int64(f)) {
+			return int64(f), true
+		}
+	case arrow.FLOAT32, arrow.FLOAT64:
+		if v, ok := toFloat64(value); ok {
+			return v, true
+		}
+	case arrow.BOOL:
+		if v, ok := value.(bool); ok {
+			return v, true
+		}
+	case arrow.STRING:
+		return FormatPartitionValue(value), true
+	}
+	return nil, false
+}
+
+// partitionValueFromArray 读取分区列的值，统一为 int64/float64/bool/string，NULL 返回 nil
+func partitionValueFromArray(col arrow.Array, row int) interface{} {
+	if col.IsNull(row) {
+		return nil
+	}
+	switch arr := col.(type) {
+	case *array.Int64:
+		return arr.Value(row)
+	case *array.Int32:
+		return int64(arr.Value(row))
+	case *array.Int16:
+		return int64(arr.Value(row))
+	case *array.Int8:
+		return int64(arr.Value(row))
+	case *array.Float64:
+		return arr.Value(row)
+	case *array.Float32:
+		return float64(arr.Value(row))
+	case *array.Boolean:
+		return arr.Value(row)
+	case *array.String:
+		return arr.Value(row)
+	default:
+		return col.ValueStr(row)
+	}
+}
+
+// partitioner 按分区定义为行计算分区，并根据查询条件裁剪分区
+type partitioner struct {
+	spec    *PartitionSpec
+	types   []arrow.DataType // 分区列类型
+	manager *types.PartitionManager
+	ranges  []types.PartitionRange // RANGE 分区的 [Start, End) 区间
+	lists   [][]interface{}        // LIST 显式分区的值 (已按列类型解析)
+}
+
+// newPartitioner 根据表 Schema 和分区定义创建分区器
+func newPartitioner(schema *arrow.Schema, spec *PartitionSpec) (*partitioner, error) {
+	p := &partitioner{spec: spec, types: make([]arrow.DataType, len(spec.Columns))}
+	for i, col := range spec.Columns {
+		field, ok := partitionField(schema, col)
+		if !ok {
+			return nil, fmt.Errorf("partition column '%s' does not exist", col)
+		}
+		p.types[i] = field.Type
+	}
+
+	strategy := &types.PartitionStrategy{Columns: spec.Columns}
+	switch spec.Type {
+	case PartitionHash:
+		strategy.Type = types.HashPartition
+		strategy.ShardCount = uint32(spec.Buckets)
+	case PartitionRange:
+		strategy.Type = types.RangePartition
+		var start interface{}
+		for i, def := range spec.Partitions {
+			r := types.PartitionRange{Start: start, Shard: uint32(i)}
+			if def.LessThan != nil {
+				bound, err := parsePartitionValue(*def.LessThan, p.types[0])
+				if err != nil {
+					return nil, err
+				}
+				r.End = bound
+				start = bound
+			}
+			strategy.Ranges = append(strategy.Ranges, r)
+		}
+		p.ranges = strategy.Ranges
+	case PartitionList:
+		if spec.IsIdentity() {
+			return p, nil
+		}
+		strategy.Type = types.ListPartition
+		for i, def := range spec.Partitions {
+			values := make([]interface{}, 0, len(def.Values))
+			for _, raw := range def.Values {
+				value, err := parsePartitionValue(raw, p.types[0])
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			strategy.Lists = append(strategy.Lists, types.PartitionList{Values: values, Shard: uint32(i)})
+			p.lists = append(p.lists, values)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported partition type '%s'", spec.Type)
+	}
+	p.manager = types.NewPartitionManager(strategy)
+	return p, nil
+}
+
+// partitionOf 计算一行的分区值 (分区键 -> 分区值，按目录层级排序)
+func (p *partitioner) partitionOf(keyValues []interface{}) ([][2]string, error) {
+	if p.spec.IsIdentity() {
+		labels := make([][2]string, len(p.spec.Columns))
+		for i, col := range p.spec.Columns {
+			labels[i] = [2]string{col, FormatPartitionValue(keyValues[i])}
+		}
+		return labels, nil
+	}
+
+	if p.spec.Type != PartitionHash {
+		if keyValues[0] == nil {
+			return nil, fmt.Errorf("partition column '%s' cannot be NULL", p.spec.Columns[0])
+		}
+	}
+	shard, err := p.manager.GetPartitionID(keyValues)
+	if err != nil {
+		if p.spec.Type == PartitionHash {
+			return nil, err
+		}
+		return nil, fmt.Errorf("table has no partition for value %s of column '%s'",
+			FormatPartitionValue(keyValues[0]), p.spec.Columns[0])
+	}
+	label := strconv.FormatUint(uint64(shard), 10)
+	if p.spec.Type != PartitionHash {
+		label = p.spec.Partitions[shard].Name
+	}
+	return [][2]string{{p.spec.labelKey(), label}}, nil
+}
+
+// partitionBatch 属于同一分区的数据
+type partitionBatch struct {
+	dir    string            // 分区目录 (相对于表的 data 目录)
+	values map[string]string // 分区值，记录在 ADD 日志中
+	record arrow.Record
+}
+
+// split 将一批数据按分区拆分，返回的 Record 由调用方释放
+func (p *partitioner) split(batch arrow.Record) ([]partitionBatch, error) {
+	cols := make([]arrow.Array, len(p.spec.Columns))
+	for i, name := range p.spec.Columns {
+		idx := batch.Schema().FieldIndices(name)
+		if len(idx) == 0 {
+			return nil, fmt.Errorf("partition column '%s' is missing from the written data", name)
+		}
+		cols[i] = batch.Column(idx[0])
+	}
+
+	groups := make(map[string]*partitionBatch)
+	rows := make(map[string][]int)
+	order := make([]string, 0)
+	keyValues := make([]interface{}, len(cols))
+	for row := 0; row < int(batch.NumRows()); row++ {
+		for i, col := range cols {
+			keyValues[i] = partitionValueFromArray(col, row)
+		}
+		labels, err := p.partitionOf(keyValues)
+		if err != nil {
+			return nil, err
+		}
+		dir := partitionDir(labels)
+		if _, ok := groups[dir]; !ok {
+			values := make(map[string]string, len(labels))
+			for _, label := range labels {
+				values[label[0]] = label[1]
+			}
+			groups[dir] = &partitionBatch{dir: dir, values: values}
+			order = append(order, dir)
+		}
+		rows[dir] = append(rows[dir], row)
+	}
+
+	parts := make([]partitionBatch, 0, len(order))
+	for _, dir := range order {
+		part := groups[dir]
+		if len(order) == 1 {
+			batch.Retain()
+			part.record = batch
+		} else {
+			record, err := takeRows(batch, rows[dir])
+			if err != nil {
+				for _, done := range parts {
+					done.record.Release()
+				}
+				return nil, err
+			}
+			part.record = record
+		}
+		parts = append(parts, *part)
+	}
+	return parts, nil
+}
+
+// partitionDir 由分区值生成 Hive 风格的分区目录
+func partitionDir(labels [][2]string) string {
+	segments := make([]string, len(labels))
+	for i, label := range labels {
+		segments[i] = escapePartitionName(label[0]) + "=" + escapePartitionName(label[1])
+	}
+	return path.Join(segments...)
+}
+
+// escapePartitionName 转义分区目录名中的特殊字符 (与 Hive 的 escapePathName 规则一致)
+func escapePartitionName(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c == 0x7f || strings.IndexByte("\"#%'*/:=?\\{[]^", c) >= 0 {
+			fmt.Fprintf(&sb, "%%%02X", c)
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// takeRows 复制指定行组成新的 Record
+func takeRows(batch arrow.Record, rows []int) (arrow.Record, error) {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, batch.Schema())
+	defer builder.Release()
+	for colIdx, col := range batch.Columns() {
+		field := builder.Field(colIdx)
+		field.Reserve(len(rows))
+		for _, row := range rows {
+			if col.IsNull(row) {
+				field.AppendNull()
+				continue
+			}
+			if err := field.AppendValueFromString(col.ValueStr(row)); err != nil {
+				return nil, fmt.Errorf("failed to copy column %s: %w", batch.ColumnName(colIdx), err)
+			}
+		}
+	}
+	return builder.NewRecord(), nil
+}
+
+// mayContain 判断分区值为 values 的文件是否可能包含满足过滤条件的行
+// 无法判断时 (缺少分区值、条件无法转换等) 保守地返回 true
+func (p *partitioner) mayContain(values map[string]string, filters []Filter) bool {
+	if len(values) == 0 || len(filters) == 0 {
+		return true
+	}
+
+	switch {
+	case p.spec.IsIdentity():
+		for _, filter := range filters {
+			idx := p.columnIndex(filter.Column)
+			if idx < 0 {
+				continue
+			}
+			raw, ok := values[p.spec.Columns[idx]]
+			if !ok {
+				return true
+			}
+			if raw == hiveDefaultPartition {
+				// NULL 与任何值比较都不为真
+				return false
+			}
+			value, err := parsePartitionValue(raw, p.types[idx])
+			if err != nil {
+				return true
+			}
+			if !p.valueMatches(value, filter, idx) {
+				return false
+			}
+		}
+		return true
+
+	case p.spec.Type == PartitionHash:
+		bucket, ok := values[p.spec.labelKey()]
+		if !ok {
+			return true
+		}
+		buckets, ok := p.candidateBuckets(filters)
+		return !ok || buckets[bucket]
+
+	default:
+		def := p.spec.partitionIndex(values[p.spec.labelKey()])
+		if def < 0 {
+			return true
+		}
+		columnFilters := make([]Filter, 0, len(filters))
+		for _, filter := range filters {
+			if p.columnIndex(filter.Column) == 0 {
+				columnFilters = append(columnFilters, filter)
+			}
+		}
+		if len(columnFilters) == 0 {
+			return true
+		}
+		if p.spec.Type == PartitionRange {
+			for _, filter := range columnFilters {
+				if !p.rangeOverlaps(p.ranges[def], filter) {
+					return false
+				}
+			}
+			return true
+		}
+		// LIST 显式分区: 至少有一个分区值同时满足该列上的所有条件
+		for _, value := range p.lists[def] {
+			matched := true
+			for _, filter := range columnFilters {
+				if !p.valueMatches(value, filter, 0) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// columnIndex 过滤条件所在的分区列下标，不是分区列时返回 -1
+func (p *partitioner) columnIndex(column string) int {
+	if dot := strings.LastIndex(column, "."); dot >= 0 {
+		column = column[dot+1:]
+	}
+	for i, col := range p.spec.Columns {
+		if strings.EqualFold(col, column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// valueMatches 分区列的值是否满足过滤条件，条件无法判断时返回 true
+func (p *partitioner) valueMatches(value interface{}, filter Filter, idx int) bool {
+	if filter.Operator == "IN" {
+		for _, v := range filter.Values {
+			if fv, ok := coercePartitionValue(v, p.types[idx]); !ok || compareValues(value, fv) == 0 {
+				return true
+			}
+		}
+		return len(filter.Values) == 0
+	}
+
+	fv, ok := coercePartitionValue(filter.Value, p.types[idx])
+	if !ok {
+		return true
+	}
+	cmp := compareValues(value, fv)
+	switch filter.Operator {
+	case "=":
+		return cmp == 0
+	case "!=", "<>":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return true
+	}
+}
+
+// rangeOverlaps RANGE 分区区间 [Start, End) 是否可能包含满足过滤条件的值
+func (p *partitioner) rangeOverlaps(r types.PartitionRange, filter Filter) bool {
+	contains := func(v interface{}) bool {
+		fv, ok := coercePartitionValue(v, p.types[0])
+		if !ok {
+			return true
+		}
+		return (r.Start == nil || compareValues(fv, r.Start) >= 0) && (r.End == nil || compareValues(fv, r.End) < 0)
+	}
+
+	switch filter.Operator {
+	case "=":
+		return contains(filter.Value)
+	case "IN":
+		for _, v := range filter.Values {
+			if contains(v) {
+				return true
+			}
+		}
+		return len(filter.Values) == 0
+	case "<", "<=", ">", ">=":
+	default:
+		return true
+	}
+
+	fv, ok := coercePartitionValue(filter.Value, p.types[0])
+	if !ok {
+		return true
+	}
+	switch filter.Operator {
+	case "<":
+		return r.Start == nil || compareValues(r.Start, fv) < 0
+	case "<=":
+		return r.Start == nil || compareValues(r.Start, fv) <= 0
+	default: // ">", ">="
+		return r.End == nil || compareValues(fv, r.End) < 0
+	}
+}
+
+// candidateBuckets 根据所有 HASH 分区列上的等值/IN 条件计算可能的分区，缺少条件时返回 false
+func (p *partitioner) candidateBuckets(filters []Filter) (map[string]bool, bool) {
+	candidates := make([][]interface{}, len(p.spec.Columns))
+	for _, filter := range filters {
+		idx := p.columnIndex(filter.Column)
+		if idx < 0 || candidates[idx] != nil {
+			continue
+		}
+		var raw []interface{}
+		switch filter.Operator {
+		case "=":
+			raw = []interface{}{filter.Value}
+		case "IN":
+			raw = filter.Values
+		default:
+			continue
+		}
+		values := make([]interface{}, 0, len(raw))
+		for _, v := range raw {
+			fv, ok := coercePartitionValue(v, p.types[idx])
+			if !ok {
+				return nil, false
+			}
+			values = append(values, fv)
+		}
+		candidates[idx] = values
+	}
+
+	combinations := 1
+	for _, values := range candidates {
+		if values == nil {
+			return nil, false
+		}
+		combinations *= len(values)
+		if combinations > maxHashPruningCombinations {
+			return nil, false
+		}
+	}
+
+	buckets := make(map[string]bool)
+	keyValues := make([]interface{}, len(candidates))
+	var walk func(level int)
+	walk = func(level int) {
+		if level == len(candidates) {
+			if shard, err := p.manager.GetPartitionID(keyValues); err == nil {
+				buckets[strconv.FormatUint(uint64(shard), 10)] = true
+			}
+			return
+		}
+		for _, v := range candidates[level] {
+			keyValues[level] = v
+			walk(level + 1)
+		}
+	}
+	walk(0)
+	return buckets, true
+}
+
+// tablePartitioner 返回分区表的分区器，未分区的表返回 nil
+// 系统表不分区：sys.delta_log 的持久化回调可能在持有 pe.mu 时触发写入
+func (pe *ParquetEngine) tablePartitioner(tableID string) (*partitioner, error) {
+	if strings.HasPrefix(tableID, "sys.") {
+		return nil, nil
+	}
+	pe.mu.RLock()
+	schema := pe.schemas[tableID]
+	pe.mu.RUnlock()
+
+	spec := PartitionSpecFromSchema(schema)
+	if spec == nil {
+		return nil, nil
+	}
+	return newPartitioner(schema, spec)
+}
+
+// prunePartitions 按过滤条件跳过不可能包含匹配行的分区文件
+// 存在 UPDATE 的 Delta 文件时不裁剪：更新可能把行移出其所在文件的分区
+func (pe *ParquetEngine) prunePartitions(tableID string, files []delta.FileInfo, filters []Filter) []delta.FileInfo {
+	if len(filters) == 0 {
+		return files
+	}
+	for _, file := range files {
+		if file.IsDelta && file.DeltaType == "update" {
+			return files
+		}
+	}
+	p, err := pe.tablePartitioner(tableID)
+	if err != nil || p == nil {
+		return files
+	}
+
+	selected := make([]delta.FileInfo, 0, len(files))
+	for _, file := range files {
+		if file.IsDelta || p.mayContain(file.PartitionValues, filters) {
+			selected = append(selected, file)
+		}
+	}
+	logger.Debug("Partition pruning applied",
+		zap.String("table", tableID),
+		zap.Int("files", len(files)),
+		zap.Int("selected", len(selected)))
+	return selected
+}
+
+// partitionFiltersKey context 中分区裁剪条件的键
+type partitionFiltersKey struct{}
+
+// WithPartitionFilters 返回携带分区裁剪条件的 context
+// 这些条件只用于跳过分区，不用于过滤行：查询的行过滤仍由执行器完成
+func WithPartitionFilters(ctx context.Context, filters []Filter) context.Context {
+	return context.WithValue(ctx, partitionFiltersKey{}, filters)
+}
+
+// PartitionFilters 获取 context 中的分区裁剪条件
+func PartitionFilters(ctx context.Context) []Filter {
+	if ctx != nil {
+		if filters, ok := ctx.Value(partitionFiltersKey{}).([]Filter); ok {
+			return filters
+		}
+	}
+	return nil
+}
+
+// DropPartition 删除分区表的一个分区 (ALTER TABLE ... DROP PARTITION)，返回移除的数据文件数
+//
+// name 指定 RANGE / LIST 显式分区：分区的数据文件和分区定义一起删除；
+// values 指定 LIST 列值分区的各分区列取值：只删除该分区的数据文件。HASH 分区不支持删除。
+func (pe *ParquetEngine) DropPartition(ctx context.Context, db, table, name string, values map[string]interface{}) (int, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+
+	// 缓冲中的数据可能属于被删除的分区，先刷写
+	if err := pe.FlushWriteBuffer(db, table); err != nil {
+		return 0, err
+	}
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	schema, ok := pe.schemas[tableID]
+	if !ok {
+		return 0, fmt.Errorf("table not found: %s", tableID)
+	}
+	spec := PartitionSpecFromSchema(schema)
+	if spec == nil {
+		return 0, fmt.Errorf("table %s is not partitioned", tableID)
+	}
+	if spec.Type == PartitionHash {
+		return 0, fmt.Errorf("cannot drop partitions of HASH partitioned table %s", tableID)
+	}
+	p, err := newPartitioner(schema, spec)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		match     func(values map[string]string) bool
+		newSchema *arrow.Schema
+	)
+	if name != "" {
+		if spec.IsIdentity() {
+			return 0, fmt.Errorf("table %s is partitioned by column values; use DROP PARTITION (column = value, ...)", tableID)
+		}
+		idx := spec.partitionIndex(name)
+		if idx < 0 {
+			return 0, fmt.Errorf("partition '%s' does not exist in table %s", name, tableID)
+		}
+		if len(spec.Partitions) == 1 {
+			return 0, fmt.Errorf("cannot drop the only partition of table %s; use DROP TABLE instead", tableID)
+		}
+		key, label := spec.labelKey(), spec.Partitions[idx].Name
+		match = func(values map[string]string) bool { return values[key] == label }
+
+		remaining := *spec
+		remaining.Partitions = append(append([]PartitionDefinition(nil), spec.Partitions[:idx]...), spec.Partitions[idx+1:]...)
+		if newSchema, err = AttachPartitionSpec(schema, &remaining); err != nil {
+			return 0, err
+		}
+	} else {
+		if !spec.IsIdentity() {
+			return 0, fmt.Errorf("table %s has named partitions; use DROP PARTITION <name>", tableID)
+		}
+		labels, err := p.identityLabels(values)
+		if err != nil {
+			return 0, err
+		}
+		match = func(values map[string]string) bool {
+			for col, label := range labels {
+				if values[col] != label {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	removed := 0
+	for _, file := range snapshot.Files {
+		if file.IsDelta || len(file.PartitionValues) == 0 || !match(file.PartitionValues) {
+			continue
+		}
+		if err := pe.commitLog(ctx).AppendRemove(tableID, file.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove file %s: %w", file.Path, err)
+		}
+		removed++
+	}
+	if newSchema == nil && removed == 0 {
+		return 0, fmt.Errorf("partition %s does not exist in table %s", partitionDir(p.sortedLabels(values)), tableID)
+	}
+
+	if newSchema != nil {
+		pe.schemas[tableID] = newSchema
+		if err := pe.commitLog(ctx).AppendMetadata(tableID, newSchema); err != nil {
+			return removed, fmt.Errorf("failed to append metadata: %w", err)
+		}
+	}
+
+	logger.Info("Partition dropped",
+		zap.String("table", tableID),
+		zap.String("partition", name),
+		zap.Int("removed_files", removed))
+	return removed, nil
+}
+
+// identityLabels 校验 DROP PARTITION (col = value, ...) 指定的分区列值，返回分区列 -> 分区值
+// 必须为每个分区列指定取值
+func (p *partitioner) identityLabels(values map[string]interface{}) (map[string]string, error) {
+	labels := make(map[string]string, len(values))
+	for col, value := range values {
+		idx := p.columnIndex(col)
+		if idx < 0 {
+			return nil, fmt.Errorf("'%s' is not a partition column", col)
+		}
+		label := hiveDefaultPartition
+		if value != nil {
+			v, ok := coercePartitionValue(value, p.types[idx])
+			if !ok {
+				return nil, fmt.Errorf("invalid value %v for partition column '%s'", value, p.spec.Columns[idx])
+			}
+			label = FormatPartitionValue(v)
+		}
+		labels[p.spec.Columns[idx]] = label
+	}
+	for _, col := range p.spec.Columns {
+		if _, ok := labels[col]; !ok {
+			return nil, fmt.Errorf("missing value for partition column '%s'", col)
+		}
+	}
+	return labels, nil
+}
+
+// sortedLabels 按分区列顺序排列 DROP PARTITION 指定的分区值，用于错误信息
+func (p *partitioner) sortedLabels(values map[string]interface{}) [][2]string {
+	labels := make([][2]string, 0, len(values))
+	for _, col := range p.spec.Columns {
+		for name, value := range values {
+			if strings.EqualFold(name, col) {
+				labels = append(labels, [2]string{col, FormatPartitionValue(value)})
+			}
+		}
+	}
+	return labels
+}
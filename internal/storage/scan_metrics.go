@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/metrics"
+)
+
+// ScanMetrics 表扫描的运行时统计，由 EXPLAIN ANALYZE 通过 context 传给 Scan
+type ScanMetrics struct {
+	FilesConsidered        atomic.Int64 // 快照 (外部表为发现) 的数据文件数
+	FilesPrunedByPartition atomic.Int64 // 分区裁剪跳过的文件数
+	FilesPrunedByStats     atomic.Int64 // 文件级 min/max 统计 (Zone Maps) 跳过的文件数
+	FilesRead              atomic.Int64 // 实际读取的文件数 (含 Merge-on-Read 增量文件)
+	DeltaFilesApplied      atomic.Int64 // 合并的 Merge-on-Read 增量文件数
+	BytesRead              atomic.Int64 // 读取文件的字节数
+	BufferedRows           atomic.Int64 // 写缓冲中尚未刷写为文件的行数
+}
+
+// scanMetricsKey context 中扫描统计的键
+type scanMetricsKey struct{}
+
+// WithScanMetrics 返回携带扫描统计的 context，Scan 把文件选择的结果累加到 metrics
+func WithScanMetrics(ctx context.Context, metrics *ScanMetrics) context.Context {
+	return context.WithValue(ctx, scanMetricsKey{}, metrics)
+}
+
+// scanMetricsFrom 获取 context 中的扫描统计，未设置时返回 nil
+func scanMetricsFrom(ctx context.Context) *ScanMetrics {
+	if ctx == nil {
+		return nil
+	}
+	metrics, _ := ctx.Value(scanMetricsKey{}).(*ScanMetrics)
+	return metrics
+}
+
+// recordScanFiles 将一次文件选择累加到进程级扫描指标 (/metrics) 和 ctx 携带的扫描统计
+func recordScanFiles(ctx context.Context, total, partitioned int, selected []delta.FileInfo) {
+	metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomePrunedByPartition).Add(int64(total - partitioned))
+	metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomePrunedByStats).Add(int64(partitioned - len(selected)))
+	metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomeRead).Add(int64(len(selected)))
+	for _, file := range selected {
+		metrics.ScanBytesRead.Add(file.Size)
+	}
+	scanMetricsFrom(ctx).recordFiles(total, partitioned, selected)
+}
+
+// recordFiles 记录一次文件选择：total 个候选文件经分区裁剪剩 partitioned 个，再经统计信息过滤剩 selected
+func (m *ScanMetrics) recordFiles(total, partitioned int, selected []delta.FileInfo) {
+	if m == nil {
+		return
+	}
+	m.FilesConsidered.Add(int64(total))
+	m.FilesPrunedByPartition.Add(int64(total - partitioned))
+	m.FilesPrunedByStats.Add(int64(partitioned - len(selected)))
+	m.FilesRead.Add(int64(len(selected)))
+	for _, file := range selected {
+		if file.IsDelta {
+			m.DeltaFilesApplied.Add(1)
+		}
+		m.BytesRead.Add(file.Size)
+	}
+}
+
+// recordBuffered 记录扫描返回的写缓冲数据
+func (m *ScanMetrics) recordBuffered(records []arrow.Record) {
+	if m == nil {
+		return
+	}
+	for _, rec := range records {
+		m.BufferedRows.Add(rec.NumRows())
+	}
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/parquet"
+	"go.uber.org/zap"
+)
+
+// BackfillFileStats 为表中缺少列统计信息的数据文件补写统计
+//
+// 早期版本写入 sys.delta_log 时没有序列化 min/max/null_count，重启后这些文件无法参与
+// 文件级裁剪。这里从 Parquet footer 重建统计 (不读取数据页)，并以 dataChange=false 的
+// ADD 记录追加到 Delta Log，之后的快照和重启都会带上统计信息。返回补写的文件数。
+func (pe *ParquetEngine) BackfillFileStats(db, table string) (int, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+
+	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+
+	backfilled := 0
+	for _, file := range snapshot.Files {
+		if file.HasStats() {
+			continue
+		}
+
+		stats, err := parquet.ReadFooterStats(pe.ParquetStore(), file.Path)
+		if err != nil {
+			logger.Warn("Failed to rebuild file stats from parquet footer",
+				zap.String("table", tableID),
+				zap.String("file", file.Path),
+				zap.Error(err))
+			continue
+		}
+		if len(stats.MinValues) == 0 && len(stats.MaxValues) == 0 && len(stats.NullCounts) == 0 {
+			// footer 中也没有统计信息，避免每次启动重复追加空记录
+			continue
+		}
+		stats.FileSize = file.Size
+
+		if err := pe.deltaLog.AppendAdd(tableID, &delta.ParquetFile{
+			Path:      file.Path,
+			Size:      file.Size,
+			RowCount:  file.RowCount,
+			Stats:     stats,
+			IsDelta:   file.IsDelta,
+			DeltaType: file.DeltaType,
+			StatsOnly: true,
+		}); err != nil {
+			return backfilled, fmt.Errorf("failed to append stats for %s: %w", file.Path, err)
+		}
+		backfilled++
+	}
+
+	if backfilled > 0 {
+		logger.Info("Backfilled file stats from parquet footers",
+			zap.String("table", tableID),
+			zap.Int("files", backfilled))
+	}
+	return backfilled, nil
+}
+
+// backfillAllFileStats 启动时为所有用户表补写缺失的文件统计信息
+func (pe *ParquetEngine) backfillAllFileStats() {
+	for _, tableID := range pe.deltaLog.ListTables() {
+		db, table, ok := strings.Cut(tableID, ".")
+		if !ok || db == "sys" {
+			continue
+		}
+		if _, err := pe.BackfillFileStats(db, table); err != nil {
+			logger.Warn("Failed to backfill file stats",
+				zap.String("table", tableID),
+				zap.Error(err))
+		}
+	}
+}
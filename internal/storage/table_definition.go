@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// tableDefinitionMetadataKey 表定义 (列声明、约束、注释、表属性) 在 Schema 元数据中的键
+const tableDefinitionMetadataKey = "minidb.table.definition"
+
+// TableDefinition CREATE TABLE 中声明的、Arrow Schema 无法表达的表定义信息，以及 COMMENT ON / TBLPROPERTIES 设置的内容
+type TableDefinition struct {
+	Columns    []ColumnDefinition `json:"columns,omitempty"`
+	PrimaryKey []string           `json:"primary_key,omitempty"`
+	Comment    string             `json:"comment,omitempty"`
+	Properties map[string]string  `json:"properties,omitempty"`
+}
+
+// ColumnDefinition 列的声明信息
+type ColumnDefinition struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`     // 声明的 SQL 类型，例如 VARCHAR(255)
+	NotNull bool   `json:"not_null,omitempty"` // NOT NULL
+	Unique  bool   `json:"unique,omitempty"`   // UNIQUE
+	Default string `json:"default,omitempty"`  // DEFAULT 字面量原文，空表示没有默认值
+	Comment string `json:"comment,omitempty"`
+}
+
+// Column 返回指定列的声明信息，不存在时返回 nil
+func (d *TableDefinition) Column(name string) *ColumnDefinition {
+	for i := range d.Columns {
+		if d.Columns[i].Name == name {
+			return &d.Columns[i]
+		}
+	}
+	return nil
+}
+
+// IsPrimaryKey 列是否属于主键
+func (d *TableDefinition) IsPrimaryKey(name string) bool {
+	for _, col := range d.PrimaryKey {
+		if col == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AttachTableDefinition 将表定义保存到 Schema 元数据中，随 METADATA 日志条目持久化
+func AttachTableDefinition(schema *arrow.Schema, def *TableDefinition) (*arrow.Schema, error) {
+	if def == nil {
+		return schema, nil
+	}
+	for _, col := range def.Columns {
+		if _, ok := schema.FieldsByName(col.Name); !ok {
+			return nil, fmt.Errorf("column '%s' does not exist", col.Name)
+		}
+	}
+	for _, col := range def.PrimaryKey {
+		if _, ok := schema.FieldsByName(col); !ok {
+			return nil, fmt.Errorf("primary key column '%s' does not exist", col)
+		}
+	}
+	data, err := json.Marshal(def)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode table definition: %w", err)
+	}
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	md := schema.Metadata()
+	for i, key := range md.Keys() {
+		if key == tableDefinitionMetadataKey {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, md.Values()[i])
+	}
+	keys = append(keys, tableDefinitionMetadataKey)
+	values = append(values, string(data))
+
+	metadata := arrow.NewMetadata(keys, values)
+	return arrow.NewSchema(schema.Fields(), &metadata), nil
+}
+
+// TableDefinitionFromSchema 从 Schema 元数据读取表定义
+// 没有保存表定义的表 (例如导入的表) 返回只包含列名的定义，每一列都有对应的 ColumnDefinition
+func TableDefinitionFromSchema(schema *arrow.Schema) *TableDefinition {
+	def := &TableDefinition{}
+	if schema == nil {
+		return def
+	}
+	md := schema.Metadata()
+	if idx := md.FindKey(tableDefinitionMetadataKey); idx >= 0 {
+		if err := json.Unmarshal([]byte(md.Values()[idx]), def); err != nil {
+			def = &TableDefinition{}
+		}
+	}
+
+	columns := make([]ColumnDefinition, 0, schema.NumFields())
+	for _, field := range schema.Fields() {
+		if col := def.Column(field.Name); col != nil {
+			columns = append(columns, *col)
+		} else {
+			columns = append(columns, ColumnDefinition{Name: field.Name})
+		}
+	}
+	def.Columns = columns
+	return def
+}
+
+// UpdateTableDefinition 修改表定义 (注释、表属性) 并写入新的 METADATA 日志条目，返回更新后的 Schema
+func (pe *ParquetEngine) UpdateTableDefinition(ctx context.Context, db, table string, update func(def *TableDefinition) error) (*arrow.Schema, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	schema, ok := pe.schemas[tableID]
+	if !ok {
+		return nil, fmt.Errorf("table not found: %s", tableID)
+	}
+	def := TableDefinitionFromSchema(schema)
+	if err := update(def); err != nil {
+		return nil, err
+	}
+	newSchema, err := AttachTableDefinition(schema, def)
+	if err != nil {
+		return nil, err
+	}
+	if err := pe.commitLog(ctx).AppendMetadata(tableID, newSchema); err != nil {
+		return nil, fmt.Errorf("failed to append metadata: %w", err)
+	}
+	pe.schemas[tableID] = newSchema
+
+	logger.Info("Table definition updated", zap.String("table", tableID))
+	return newSchema, nil
+}
+
+// TableDetail DESCRIBE EXTENDED 展示的表存储信息
+type TableDetail struct {
+	Files        int   // 当前快照中的数据文件数 (不含 Merge-on-Read delta 文件)
+	DeltaFiles   int   // 当前快照中的 Merge-on-Read delta 文件数
+	SizeBytes    int64 // 当前快照中全部文件的总大小
+	Rows         int64 // 数据文件统计的总行数 (不含写缓冲，未扣除 delta 文件删除的行)
+	Version      int64 // 表最近一次提交的版本
+	LastModified int64 // 最近一次提交的时间 (Unix 毫秒)
+}
+
+// TableDetail 返回表当前快照的文件数、大小和版本信息
+func (pe *ParquetEngine) TableDetail(db, table string) (*TableDetail, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+
+	if _, ok := pe.schemas[tableID]; !ok {
+		return nil, fmt.Errorf("table not found: %s", tableID)
+	}
+	snapshot, err := pe.deltaLog.GetSnapshot(tableID, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &TableDetail{}
+	for _, file := range snapshot.Files {
+		if file.IsDelta {
+			detail.DeltaFiles++
+		} else {
+			detail.Files++
+			detail.Rows += file.RowCount
+		}
+		detail.SizeBytes += file.Size
+	}
+	for _, entry := range pe.deltaLog.GetEntriesByTable(tableID) {
+		if entry.Version >= detail.Version {
+			detail.Version = entry.Version
+			detail.LastModified = entry.Timestamp
+		}
+	}
+	return detail, nil
+}
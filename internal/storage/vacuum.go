@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/logger"
+	"go.uber.org/zap"
+)
+
+// DefaultVacuumRetention VACUUM 默认保留期：移除不足 7 天的文件仍可用于时间旅行
+const DefaultVacuumRetention = 7 * 24 * time.Hour
+
+// VacuumResult VACUUM 的执行结果
+type VacuumResult struct {
+	Files        []string // 已删除 (DRY RUN 时为将被删除) 的数据文件
+	BytesDeleted int64    // 这些文件的总大小
+	DryRun       bool
+}
+
+// fileLifecycle 一张表日志中某个文件的状态
+type fileLifecycle struct {
+	live      bool  // 是否在最新快照中
+	removedAt int64 // 最近一次 REMOVE 的时间 (Unix 毫秒)
+}
+
+// VacuumTable 物理删除表不再引用、且移除时间早于保留期的数据文件
+//
+// 文件可能被 SHALLOW CLONE 产生的其他表共享：只要任何一张表 (包括已删除的表) 仍在最新快照中引用该文件，
+// 或者在保留期内才移除它，文件就不会被删除。只处理日志中出现过的文件，不清理目录中的孤立文件
+func (pe *ParquetEngine) VacuumTable(db, table string, retention time.Duration, dryRun bool) (*VacuumResult, error) {
+	tableID := fmt.Sprintf("%s.%s", db, table)
+	if err := pe.checkWritable(db, table); err != nil {
+		return nil, err
+	}
+	if retention < 0 {
+		return nil, fmt.Errorf("vacuum retention must not be negative")
+	}
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	if _, ok := pe.schemas[tableID]; !ok {
+		return nil, fmt.Errorf("table not found: %s", tableID)
+	}
+
+	cutoff := time.Now().Add(-retention).UnixMilli()
+	lifecycles := fileLifecycles(pe.deltaLog.GetAllEntries())
+
+	// 任意一张表仍需要的文件
+	retained := make(map[string]bool)
+	for _, files := range lifecycles {
+		for path, state := range files {
+			if state.live || state.removedAt >= cutoff {
+				retained[path] = true
+			}
+		}
+	}
+
+	result := &VacuumResult{DryRun: dryRun}
+	for path := range lifecycles[tableID] {
+		if retained[path] {
+			continue
+		}
+		info, err := pe.objectStore.Stat(pe.objectKey(path))
+		if err != nil {
+			// 已被之前的 VACUUM 删除
+			continue
+		}
+		result.Files = append(result.Files, path)
+		result.BytesDeleted += info.Size
+	}
+	sort.Strings(result.Files)
+
+	if !dryRun {
+		// 等待正在进行的 BACKUP 复制完成，避免删除它仍需要的文件
+		pe.backupMu.Lock()
+		defer pe.backupMu.Unlock()
+		for _, path := range result.Files {
+			if err := pe.objectStore.Delete(pe.objectKey(path)); err != nil {
+				return nil, fmt.Errorf("failed to delete %s: %w", path, err)
+			}
+		}
+	}
+
+	logger.Info("Table vacuumed",
+		zap.String("table", tableID),
+		zap.Duration("retention", retention),
+		zap.Bool("dry_run", dryRun),
+		zap.Int("files", len(result.Files)),
+		zap.Int64("bytes", result.BytesDeleted))
+	return result, nil
+}
+
+// fileLifecycles 按版本重放日志，得到每张表中每个数据文件的状态
+func fileLifecycles(entries []delta.LogEntry) map[string]map[string]*fileLifecycle {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Version < entries[j].Version })
+
+	tables := make(map[string]map[string]*fileLifecycle)
+	for _, entry := range entries {
+		if entry.Operation != delta.OpAdd && entry.Operation != delta.OpRemove {
+			continue
+		}
+		files, ok := tables[entry.TableID]
+		if !ok {
+			files = make(map[string]*fileLifecycle)
+			tables[entry.TableID] = files
+		}
+		state, ok := files[entry.FilePath]
+		if entry.Operation == delta.OpAdd {
+			if !ok {
+				state = &fileLifecycle{}
+				files[entry.FilePath] = state
+			}
+			state.live = true
+		} else if ok {
+			// 没有对应 ADD 的 REMOVE (例如删除空表的标记) 不代表数据文件
+			state.live = false
+			state.removedAt = entry.Timestamp
+		}
+	}
+	return tables
+}
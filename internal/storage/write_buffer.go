@@ -0,0 +1,823 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/ipc"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/yyun543/minidb/internal/logger"
+	"github.com/yyun543/minidb/internal/parquet"
+	"go.uber.org/zap"
+)
+
+// WriteBufferConfig 写缓冲配置
+// 小批量写入先追加到每张表的 WAL 并缓存在内存中，达到阈值后合并为一个 Parquet 文件和一条 ADD 日志
+type WriteBufferConfig struct {
+	Dir           string        // WAL 目录，默认 {basePath}/_wal (始终位于本地磁盘)
+	MaxRows       int64         // 缓冲行数达到该值时刷写
+	MaxBytes      int64         // 缓冲数据 (WAL 编码后) 达到该字节数时刷写
+	FlushInterval time.Duration // 最早的缓冲数据超过该时间后由后台刷写
+}
+
+// DefaultWriteBufferConfig 默认写缓冲配置
+func DefaultWriteBufferConfig() WriteBufferConfig {
+	return WriteBufferConfig{
+		MaxRows:       100000,
+		MaxBytes:      64 * 1024 * 1024,
+		FlushInterval: 5 * time.Second,
+	}
+}
+
+// WithWriteBuffer 启用写缓冲 (WAL + group commit)
+func WithWriteBuffer(cfg WriteBufferConfig) EngineOption {
+	return func(pe *ParquetEngine) {
+		defaults := DefaultWriteBufferConfig()
+		if cfg.Dir == "" {
+			cfg.Dir = filepath.Join(pe.basePath, "_wal")
+		}
+		if cfg.MaxRows <= 0 {
+			cfg.MaxRows = defaults.MaxRows
+		}
+		if cfg.MaxBytes <= 0 {
+			cfg.MaxBytes = defaults.MaxBytes
+		}
+		if cfg.FlushInterval <= 0 {
+			cfg.FlushInterval = defaults.FlushInterval
+		}
+		pe.writeBuffer = &writeBufferManager{
+			pe:     pe,
+			cfg:    cfg,
+			tables: make(map[string]*tableWriteBuffer),
+		}
+	}
+}
+
+// writeBufferManager 管理所有表的写缓冲
+type writeBufferManager struct {
+	pe  *ParquetEngine
+	cfg WriteBufferConfig
+
+	mu     sync.Mutex
+	tables map[string]*tableWriteBuffer
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// tableWriteBuffer 单表写缓冲
+type tableWriteBuffer struct {
+	db, table string
+	dir       string
+
+	// visMu 保证扫描获取的快照与缓冲数据一致：刷写提交 ADD 并清空 flushing 时持有写锁
+	visMu sync.RWMutex
+
+	mu       sync.Mutex // 保护以下字段
+	records  []arrow.Record
+	flushing []arrow.Record // 正在刷写、尚未提交到 Delta Log 的数据 (对扫描仍然可见)
+	rows     int64
+	bytes    int64
+	firstAt  time.Time
+	active   *walSegment   // 当前追加的 WAL 段
+	pending  []*walSegment // 已轮转、等待刷写完成后删除的 WAL 段
+	nextSeq  int64
+
+	flushMu sync.Mutex // 串行化刷写
+}
+
+// walSegment 一个 WAL 段文件
+// 每帧格式: [payload 长度 uint32][payload CRC32 uint32][payload: 单个 Record 的 Arrow IPC stream]
+type walSegment struct {
+	seq  int64
+	path string
+
+	mu      sync.Mutex // 保护 file 追加和 written
+	file    *os.File
+	written int64 // 已追加的帧数
+
+	syncMu sync.Mutex // group commit：同一时刻只有一个 fsync，其余写入者复用其结果
+	synced int64
+}
+
+// start 启动后台定时刷写
+func (m *writeBufferManager) start() {
+	m.stop = make(chan struct{})
+	interval := m.cfg.FlushInterval / 2
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+				for _, tb := range m.snapshotTables() {
+					if tb.due(m.cfg.FlushInterval) {
+						// 定时刷写不属于任何会话，以空用户提交
+						if err := m.flush(context.Background(), tb); err != nil {
+							logger.Warn("Background write buffer flush failed",
+								zap.String("table", tb.db+"."+tb.table),
+								zap.Error(err))
+						}
+					}
+				}
+			}
+		}
+	}()
+}
+
+// close 停止后台刷写并把所有缓冲数据刷写为 Parquet 文件
+func (m *writeBufferManager) close() error {
+	if m.stop != nil {
+		close(m.stop)
+		m.wg.Wait()
+		m.stop = nil
+	}
+
+	var firstErr error
+	for _, tb := range m.snapshotTables() {
+		if err := m.flush(context.Background(), tb); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		tb.mu.Lock()
+		if tb.active != nil {
+			tb.active.close()
+		}
+		tb.mu.Unlock()
+	}
+	return firstErr
+}
+
+// snapshotTables 返回当前所有表缓冲
+func (m *writeBufferManager) snapshotTables() []*tableWriteBuffer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tables := make([]*tableWriteBuffer, 0, len(m.tables))
+	for _, tb := range m.tables {
+		tables = append(tables, tb)
+	}
+	return tables
+}
+
+// buffers 判断表写入是否经过写缓冲 (系统表不缓冲)
+func (m *writeBufferManager) buffers(db string, batch arrow.Record) bool {
+	return db != "sys" && batch.NumRows() > 0 && batch.NumRows() < m.cfg.MaxRows
+}
+
+// table 获取 (或创建) 表缓冲，WAL 段号从 Delta Log 记录的水位之后开始，
+// 新段不会被误判为已刷写
+func (m *writeBufferManager) table(db, table string) *tableWriteBuffer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tableID := db + "." + table
+	tb, ok := m.tables[tableID]
+	if !ok {
+		tb = &tableWriteBuffer{
+			db:      db,
+			table:   table,
+			dir:     filepath.Join(m.cfg.Dir, db, table),
+			nextSeq: m.flushedWatermark(tableID) + 1,
+		}
+		m.tables[tableID] = tb
+	}
+	return tb
+}
+
+// lookup 获取已存在的表缓冲
+func (m *writeBufferManager) lookup(db, table string) *tableWriteBuffer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tables[db+"."+table]
+}
+
+// append 追加一批数据：写入 WAL 并 fsync (与并发写入者合并) 后返回，达到阈值时同步刷写
+func (m *writeBufferManager) append(ctx context.Context, db, table string, batch arrow.Record) error {
+	frame, err := encodeWALFrame(batch)
+	if err != nil {
+		return err
+	}
+
+	tb := m.table(db, table)
+	for {
+		tb.mu.Lock()
+		// 缓冲中的数据与新数据 schema 不同时 (如 ALTER TABLE 之后) 先刷写
+		if len(tb.records) > 0 && !tb.records[0].Schema().Equal(batch.Schema()) {
+			tb.mu.Unlock()
+			if err := m.flush(ctx, tb); err != nil {
+				return err
+			}
+			continue
+		}
+		break
+	}
+
+	if tb.active == nil {
+		segment, err := openWALSegment(tb.dir, tb.nextSeq)
+		if err != nil {
+			tb.mu.Unlock()
+			return err
+		}
+		tb.active = segment
+		tb.nextSeq++
+	}
+	segment := tb.active
+	n, err := segment.append(frame)
+	if err != nil {
+		tb.mu.Unlock()
+		return err
+	}
+
+	batch.Retain()
+	if len(tb.records) == 0 {
+		tb.firstAt = time.Now()
+	}
+	tb.records = append(tb.records, batch)
+	tb.rows += batch.NumRows()
+	tb.bytes += int64(len(frame))
+	full := tb.rows >= m.cfg.MaxRows || tb.bytes >= m.cfg.MaxBytes
+	tb.mu.Unlock()
+
+	// group commit：在锁外 fsync，并发写入者共享同一次 fsync
+	if err := segment.syncTo(n); err != nil {
+		return err
+	}
+
+	if full {
+		return m.flush(ctx, tb)
+	}
+	return nil
+}
+
+// due 判断表缓冲是否超过刷写间隔
+func (tb *tableWriteBuffer) due(interval time.Duration) bool {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	return len(tb.records) > 0 && time.Since(tb.firstAt) >= interval
+}
+
+// flush 将表缓冲合并为 Parquet 文件，与 WAL 水位在同一个版本中提交 ADD 日志 (提交用户取自 ctx)，
+// 成功后删除对应的 WAL 段
+func (m *writeBufferManager) flush(ctx context.Context, tb *tableWriteBuffer) error {
+	tb.flushMu.Lock()
+	defer tb.flushMu.Unlock()
+
+	// 1. 轮转：取出缓冲数据，后续写入进入新的 WAL 段
+	tb.mu.Lock()
+	if len(tb.records) == 0 {
+		tb.mu.Unlock()
+		return nil
+	}
+	records := tb.records
+	rows := tb.rows
+	tb.flushing = records
+	tb.records = nil
+	tb.rows, tb.bytes = 0, 0
+	if tb.active != nil {
+		tb.pending = append(tb.pending, tb.active)
+		tb.active = nil
+	}
+	segments := append([]*walSegment(nil), tb.pending...)
+	tb.mu.Unlock()
+
+	// 轮转后旧段不会再有追加，刷盘并关闭
+	for _, segment := range segments {
+		segment.close()
+	}
+	watermark := segments[len(segments)-1].seq
+
+	// 2. 合并写入 Parquet (不持有可见性锁，扫描仍能看到 flushing 中的数据)
+	tableID := tb.db + "." + tb.table
+	filePath := m.pe.generateFilePath(tb.db, tb.table)
+	batch, err := concatRecords(records)
+	if err == nil {
+		err = func() error {
+			defer batch.Release()
+			return m.pe.commitFlushedFiles(ctx, tableID, filePath, batch, watermark)
+		}()
+	}
+
+	// 3. 提交后清空 flushing；失败时数据放回缓冲，WAL 段保留待下次刷写
+	tb.visMu.Lock()
+	tb.mu.Lock()
+	tb.flushing = nil
+	if err != nil {
+		tb.records = append(records, tb.records...)
+		tb.rows += rows
+		tb.firstAt = time.Now()
+		tb.mu.Unlock()
+		tb.visMu.Unlock()
+		return fmt.Errorf("failed to flush write buffer for %s: %w", tableID, err)
+	}
+	tb.pending = tb.pending[len(segments):]
+	tb.mu.Unlock()
+	tb.visMu.Unlock()
+
+	for _, rec := range records {
+		rec.Release()
+	}
+	for _, segment := range segments {
+		if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+			logger.Warn("Failed to remove flushed WAL segment", zap.String("path", segment.path), zap.Error(err))
+		}
+	}
+
+	logger.Info("Write buffer flushed",
+		zap.String("table", tableID),
+		zap.String("file", filePath),
+		zap.Int64("rows", rows),
+		zap.Int("batches", len(records)),
+		zap.Int("wal_segments", len(segments)))
+	return nil
+}
+
+// flushTable 刷写指定表的缓冲
+func (m *writeBufferManager) flushTable(db, table string) error {
+	if tb := m.lookup(db, table); tb != nil {
+		return m.flush(context.Background(), tb)
+	}
+	return nil
+}
+
+// discard 丢弃表缓冲及其 WAL (DROP TABLE)
+func (m *writeBufferManager) discard(db, table string) {
+	m.mu.Lock()
+	tb := m.tables[db+"."+table]
+	delete(m.tables, db+"."+table)
+	m.mu.Unlock()
+	if tb == nil {
+		return
+	}
+
+	tb.flushMu.Lock()
+	defer tb.flushMu.Unlock()
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	for _, rec := range tb.records {
+		rec.Release()
+	}
+	tb.records = nil
+	if tb.active != nil {
+		tb.pending = append(tb.pending, tb.active)
+		tb.active = nil
+	}
+	for _, segment := range tb.pending {
+		segment.close()
+	}
+	tb.pending = nil
+	os.RemoveAll(tb.dir)
+}
+
+// bufferedRecords 返回表缓冲中对扫描可见的数据 (已 Retain，调用方负责释放)
+// 调用方需持有 visMu 读锁，保证与快照一致
+func (tb *tableWriteBuffer) bufferedRecords() []arrow.Record {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	records := make([]arrow.Record, 0, len(tb.flushing)+len(tb.records))
+	records = append(records, tb.flushing...)
+	records = append(records, tb.records...)
+	for _, rec := range records {
+		rec.Retain()
+	}
+	return records
+}
+
+// recover 重放 WAL：已刷写 (段号不大于该表 Delta Log 记录的水位) 的段直接删除，其余段的数据恢复到缓冲
+func (m *writeBufferManager) recover() error {
+	dbDirs, err := os.ReadDir(m.cfg.Dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read WAL directory: %w", err)
+	}
+
+	for _, dbDir := range dbDirs {
+		if !dbDir.IsDir() {
+			continue
+		}
+		tableDirs, err := os.ReadDir(filepath.Join(m.cfg.Dir, dbDir.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read WAL directory: %w", err)
+		}
+		for _, tableDir := range tableDirs {
+			if !tableDir.IsDir() {
+				continue
+			}
+			if err := m.recoverTable(dbDir.Name(), tableDir.Name()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recoverTable 重放单表的 WAL 段
+func (m *writeBufferManager) recoverTable(db, table string) error {
+	tableID := db + "." + table
+	dir := filepath.Join(m.cfg.Dir, db, table)
+
+	// 表已删除：WAL 无意义
+	if _, err := m.pe.GetTableSchema(db, table); err != nil {
+		logger.Info("Dropping WAL of missing table", zap.String("table", tableID))
+		return os.RemoveAll(dir)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read WAL directory: %w", err)
+	}
+	var seqs []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".wal") {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(name, ".wal"), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	watermark := m.flushedWatermark(tableID)
+	tb := m.table(db, table)
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	replayed := 0
+	for _, seq := range seqs {
+		path := walSegmentPath(dir, seq)
+		if seq >= tb.nextSeq {
+			tb.nextSeq = seq + 1
+		}
+		if seq <= watermark {
+			// 崩溃发生在 ADD 提交之后、删除 WAL 段之前
+			os.Remove(path)
+			continue
+		}
+
+		records, err := readWALSegment(path)
+		if err != nil {
+			return err
+		}
+		for _, rec := range records {
+			tb.records = append(tb.records, rec)
+			tb.rows += rec.NumRows()
+			replayed++
+		}
+		tb.pending = append(tb.pending, &walSegment{seq: seq, path: path})
+	}
+
+	if len(tb.records) > 0 {
+		tb.firstAt = time.Now()
+		logger.Info("Replayed write-ahead log",
+			zap.String("table", tableID),
+			zap.Int("batches", replayed),
+			zap.Int64("rows", tb.rows),
+			zap.Int("segments", len(tb.pending)))
+	}
+	return nil
+}
+
+// flushedWatermark 返回 Delta Log 中记录的本表已刷写的最大 WAL 段号
+// 水位只由本表的刷写提交写入，SHALLOW CLONE / RESTORE 引用的文件不带水位；
+// 使用完整日志而非快照，compaction 移除刷写文件后水位仍然有效 (checkpoint 把水位保留在 schema METADATA 上)
+func (m *writeBufferManager) flushedWatermark(tableID string) int64 {
+	var watermark int64
+	for _, entry := range m.pe.deltaLog.GetEntriesByTable(tableID) {
+		if entry.WALWatermark > watermark {
+			watermark = entry.WALWatermark
+		}
+	}
+	return watermark
+}
+
+// commitFlushedFiles 写入刷写数据的 Parquet 文件 (分区表按分区拆分)，所有文件在同一个版本中提交并记录 WAL 水位；
+// 失败时删除已写入的文件
+func (pe *ParquetEngine) commitFlushedFiles(ctx context.Context, tableID, filePath string, batch arrow.Record, watermark int64) error {
+	files, err := pe.writeDataFiles(tableID, filePath, batch)
+	if err == nil {
+		for _, file := range files {
+			file.WALWatermark = watermark
+		}
+		err = pe.retryOnConflict(tableID, func() error {
+			_, err := pe.commitLog(ctx).AppendCommit(tableID, files, nil)
+			return err
+		})
+	}
+	if err != nil {
+		for _, file := range files {
+			if rmErr := pe.objectStore.Delete(pe.objectKey(file.Path)); rmErr != nil {
+				logger.Warn("Failed to remove unflushed data file", zap.String("file", file.Path), zap.Error(rmErr))
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// FlushWriteBuffer 将表写缓冲中的数据刷写为 Parquet 文件 (未启用写缓冲时无操作)
+func (pe *ParquetEngine) FlushWriteBuffer(db, table string) error {
+	if pe.writeBuffer == nil {
+		return nil
+	}
+	return pe.writeBuffer.flushTable(db, table)
+}
+
+// walSegmentPath WAL 段文件路径
+func walSegmentPath(dir string, seq int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d.wal", seq))
+}
+
+// openWALSegment 创建新的 WAL 段
+func openWALSegment(dir string, seq int64) (*walSegment, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+	path := walSegmentPath(dir, seq)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open WAL segment: %w", err)
+	}
+	// 目录 fsync，确保新段文件在崩溃后可见
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return &walSegment{seq: seq, path: path, file: file}, nil
+}
+
+// append 追加一帧，返回追加后的帧序号
+func (s *walSegment) append(frame []byte) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return 0, fmt.Errorf("WAL segment %s is closed", s.path)
+	}
+	if _, err := s.file.Write(frame); err != nil {
+		return 0, fmt.Errorf("failed to append to WAL: %w", err)
+	}
+	s.written++
+	return s.written, nil
+}
+
+// syncTo 确保前 n 帧已刷盘；其他写入者的 fsync 已覆盖时直接返回
+func (s *walSegment) syncTo(n int64) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	if s.synced >= n {
+		return nil
+	}
+
+	s.mu.Lock()
+	target := s.written
+	file := s.file
+	s.mu.Unlock()
+	if file == nil {
+		// 段已被刷写关闭，close 时已经 fsync
+		return nil
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync WAL: %w", err)
+	}
+	s.synced = target
+	return nil
+}
+
+// close 刷盘并关闭段文件
+func (s *walSegment) close() {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return
+	}
+	s.file.Sync()
+	s.file.Close()
+	s.file = nil
+	s.synced = s.written
+}
+
+// encodeWALFrame 将 Record 编码为一帧 WAL 数据
+func encodeWALFrame(batch arrow.Record) ([]byte, error) {
+	var payload bytes.Buffer
+	writer := ipc.NewWriter(&payload, ipc.WithSchema(batch.Schema()))
+	if err := writer.Write(batch); err != nil {
+		writer.Close()
+		return nil, fmt.Errorf("failed to encode WAL record: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode WAL record: %w", err)
+	}
+
+	frame := make([]byte, 8+payload.Len())
+	binary.LittleEndian.PutUint32(frame[0:4], uint32(payload.Len()))
+	binary.LittleEndian.PutUint32(frame[4:8], crc32.ChecksumIEEE(payload.Bytes()))
+	copy(frame[8:], payload.Bytes())
+	return frame, nil
+}
+
+// readWALSegment 读取 WAL 段中的所有完整帧
+// 遇到不完整或校验失败的帧 (崩溃时写了一半) 时截断到最后一个完整帧
+func readWALSegment(path string) ([]arrow.Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL segment: %w", err)
+	}
+
+	var records []arrow.Record
+	offset := 0
+	for offset < len(data) {
+		rec, size, err := decodeWALFrame(data[offset:])
+		if err != nil {
+			logger.Warn("Truncating torn WAL segment",
+				zap.String("path", path),
+				zap.Int("offset", offset),
+				zap.Error(err))
+			if terr := os.Truncate(path, int64(offset)); terr != nil {
+				return nil, fmt.Errorf("failed to truncate WAL segment: %w", terr)
+			}
+			break
+		}
+		records = append(records, rec)
+		offset += size
+	}
+	return records, nil
+}
+
+// errTornFrame 不完整的 WAL 帧
+var errTornFrame = errors.New("incomplete WAL frame")
+
+// decodeWALFrame 解码一帧，返回 Record 和帧长度
+func decodeWALFrame(data []byte) (arrow.Record, int, error) {
+	if len(data) < 8 {
+		return nil, 0, errTornFrame
+	}
+	length := int(binary.LittleEndian.Uint32(data[0:4]))
+	checksum := binary.LittleEndian.Uint32(data[4:8])
+	if len(data) < 8+length {
+		return nil, 0, errTornFrame
+	}
+	payload := data[8 : 8+length]
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, 0, fmt.Errorf("WAL frame checksum mismatch")
+	}
+
+	reader, err := ipc.NewReader(bytes.NewReader(payload), ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to decode WAL frame: %w", err)
+	}
+	defer reader.Release()
+	if !reader.Next() {
+		if err := reader.Err(); err != nil && err != io.EOF {
+			return nil, 0, fmt.Errorf("failed to decode WAL frame: %w", err)
+		}
+		return nil, 0, fmt.Errorf("empty WAL frame")
+	}
+	rec := reader.Record()
+	rec.Retain()
+	return rec, 8 + length, nil
+}
+
+// concatRecords 将相同 schema 的多个 Record 合并为一个
+func concatRecords(records []arrow.Record) (arrow.Record, error) {
+	if len(records) == 1 {
+		records[0].Retain()
+		return records[0], nil
+	}
+
+	schema := records[0].Schema()
+	var rows int64
+	for _, rec := range records {
+		rows += rec.NumRows()
+	}
+
+	columns := make([]arrow.Array, schema.NumFields())
+	defer func() {
+		for _, col := range columns {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+	for i := range columns {
+		arrs := make([]arrow.Array, len(records))
+		for j, rec := range records {
+			arrs[j] = rec.Column(i)
+		}
+		col, err := array.Concatenate(arrs, memory.DefaultAllocator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to concatenate buffered records: %w", err)
+		}
+		columns[i] = col
+	}
+	return array.NewRecord(schema, columns, rows), nil
+}
+
+// bufferedRecordIterator 先返回文件中的数据，再返回写缓冲中尚未刷写的数据
+type bufferedRecordIterator struct {
+	inner     RecordIterator
+	records   []arrow.Record
+	filters   []parquet.Filter
+	pos       int
+	innerDone bool
+	current   arrow.Record
+	err       error
+}
+
+// withBufferedRecords 将缓冲数据附加到迭代器末尾 (接管 records 的引用)
+func withBufferedRecords(inner RecordIterator, records []arrow.Record, filters []Filter) RecordIterator {
+	if len(records) == 0 {
+		return inner
+	}
+	return &bufferedRecordIterator{
+		inner:   inner,
+		records: records,
+		filters: toParquetFilters(filters),
+	}
+}
+
+func (it *bufferedRecordIterator) Next() bool {
+	if !it.innerDone {
+		if it.inner.Next() {
+			return true
+		}
+		if err := it.inner.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		it.innerDone = true
+	}
+
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	for it.pos < len(it.records) {
+		rec := it.records[it.pos]
+		it.pos++
+		if len(it.filters) == 0 {
+			rec.Retain()
+			it.current = rec
+			return true
+		}
+		filtered, err := parquet.ApplyFilters(rec, it.filters)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if filtered.NumRows() == 0 {
+			filtered.Release()
+			continue
+		}
+		it.current = filtered
+		return true
+	}
+	return false
+}
+
+func (it *bufferedRecordIterator) Record() arrow.Record {
+	if !it.innerDone {
+		return it.inner.Record()
+	}
+	return it.current
+}
+
+func (it *bufferedRecordIterator) Err() error {
+	return it.err
+}
+
+func (it *bufferedRecordIterator) Close() error {
+	if it.current != nil {
+		it.current.Release()
+		it.current = nil
+	}
+	for _, rec := range it.records {
+		rec.Release()
+	}
+	it.records = nil
+	return it.inner.Close()
+}
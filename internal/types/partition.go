@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"hash/crc32"
+	"strings"
 )
 
 // PartitionInfo 分区信息，为分布式扩展做准备
@@ -32,10 +33,10 @@ type PartitionStrategy struct {
 	Lists      []PartitionList  // 列表分区的值定义
 }
 
-// PartitionRange 范围分区定义
+// PartitionRange 范围分区定义 [Start, End)
 type PartitionRange struct {
-	Start interface{} // 开始值
-	End   interface{} // 结束值
+	Start interface{} // 开始值，nil 表示无下界
+	End   interface{} // 结束值(不含)，nil 表示无上界 (MAXVALUE)
 	Shard uint32      // 分片ID
 }
 
@@ -112,7 +113,7 @@ func (pm *PartitionManager) rangePartition(keyValues []interface{}) (uint32, err
 
 	value := keyValues[0]
 	for _, r := range pm.strategy.Ranges {
-		if pm.compareValue(value, r.Start) >= 0 && pm.compareValue(value, r.End) < 0 {
+		if (r.Start == nil || pm.compareValue(value, r.Start) >= 0) && (r.End == nil || pm.compareValue(value, r.End) < 0) {
 			return r.Shard, nil
 		}
 	}
@@ -153,6 +154,16 @@ func (pm *PartitionManager) compareValue(a, b interface{}) int {
 				return 0
 			}
 		}
+	case float64:
+		if vb, ok := b.(float64); ok {
+			if va < vb {
+				return -1
+			} else if va > vb {
+				return 1
+			} else {
+				return 0
+			}
+		}
 	case string:
 		if vb, ok := b.(string); ok {
 			if va < vb {
@@ -163,8 +174,18 @@ func (pm *PartitionManager) compareValue(a, b interface{}) int {
 				return 0
 			}
 		}
+	case bool:
+		if vb, ok := b.(bool); ok {
+			if va == vb {
+				return 0
+			} else if !va {
+				return -1
+			}
+			return 1
+		}
 	}
-	return 0
+	// 类型不一致时按字符串形式比较，避免误判为相等
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
 }
 
 // ShardInfo 分片信息（为分布式做准备）
@@ -0,0 +1,179 @@
+package minidb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 参数占位符：? 按出现顺序编号，$1、$2 ... 显式编号；同一条语句中不能混用
+// 字符串字面量、带引号的标识符和注释中的 ? 和 $ 不是占位符
+
+// timeLiteralLayout time.Time 参数转换为字符串字面量的格式 (TIMESTAMP 列以该格式存储)
+const timeLiteralLayout = "2006-01-02 15:04:05.999999"
+
+// placeholder 语句中的一个占位符
+type placeholder struct {
+	start, end int // 在语句中的位置 [start, end)
+	index      int // 参数序号，从 1 开始
+}
+
+// scanPlaceholders 找出语句中的占位符
+func scanPlaceholders(query string) ([]placeholder, error) {
+	var found []placeholder
+	positional, numbered := 0, false
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// 引号内的内容，两个连续的引号表示引号本身
+			j := i + 1
+			for ; j < len(query); j++ {
+				if query[j] != c {
+					continue
+				}
+				if j+1 < len(query) && query[j+1] == c {
+					j++
+					continue
+				}
+				break
+			}
+			if j >= len(query) {
+				return nil, fmt.Errorf("minidb: unterminated quoted string in statement")
+			}
+			i = j
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				return found, nil
+			}
+			i += end
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("minidb: unterminated comment in statement")
+			}
+			i += end + 3
+		case c == '?':
+			positional++
+			found = append(found, placeholder{start: i, end: i + 1, index: positional})
+		case c == '$':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				continue
+			}
+			index, err := strconv.Atoi(query[i+1 : j])
+			if err != nil || index < 1 {
+				return nil, fmt.Errorf("minidb: invalid placeholder %s", query[i:j])
+			}
+			numbered = true
+			found = append(found, placeholder{start: i, end: j, index: index})
+			i = j - 1
+		}
+	}
+	if positional > 0 && numbered {
+		return nil, fmt.Errorf("minidb: cannot mix ? and $n placeholders in one statement")
+	}
+	return found, nil
+}
+
+// countPlaceholders 返回语句需要的参数个数
+func countPlaceholders(query string) (int, error) {
+	placeholders, err := scanPlaceholders(query)
+	if err != nil {
+		return 0, err
+	}
+	return placeholderCount(placeholders), nil
+}
+
+// placeholderCount 参数个数为占位符的最大序号
+func placeholderCount(placeholders []placeholder) int {
+	count := 0
+	for _, p := range placeholders {
+		count = max(count, p.index)
+	}
+	return count
+}
+
+// bindArgs 把参数转换为 SQL 字面量替换语句中的占位符
+func bindArgs(query string, args []driver.NamedValue) (string, error) {
+	placeholders, err := scanPlaceholders(query)
+	if err != nil {
+		return "", err
+	}
+	count := placeholderCount(placeholders)
+	if count != len(args) {
+		return "", fmt.Errorf("minidb: statement expects %d arguments, got %d", count, len(args))
+	}
+	if count == 0 {
+		return query, nil
+	}
+
+	literals := make([]string, len(args))
+	for _, arg := range args {
+		if arg.Name != "" {
+			return "", fmt.Errorf("minidb: named argument %q is not supported", arg.Name)
+		}
+		literal, err := sqlLiteral(arg.Value)
+		if err != nil {
+			return "", fmt.Errorf("minidb: argument %d: %w", arg.Ordinal, err)
+		}
+		literals[arg.Ordinal-1] = literal
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, p := range placeholders {
+		sb.WriteString(query[last:p.start])
+		sb.WriteString(literals[p.index-1])
+		last = p.end
+	}
+	sb.WriteString(query[last:])
+	return sb.String(), nil
+}
+
+// sqlLiteral 把 driver.Value 转换为 SQL 字面量
+func sqlLiteral(value driver.Value) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return "", fmt.Errorf("cannot use %v in a statement", v)
+		}
+		literal := strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.Contains(literal, ".") {
+			literal += ".0"
+		}
+		return literal, nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case string:
+		return quoteString(v)
+	case []byte:
+		return quoteString(string(v))
+	case time.Time:
+		return quoteString(v.Format(timeLiteralLayout))
+	default:
+		return "", fmt.Errorf("unsupported argument type %T", value)
+	}
+}
+
+// quoteString 生成单引号字符串字面量
+// SQL 语法的字符串字面量不支持转义引号，包含单引号的值无法作为字面量传递
+func quoteString(s string) (string, error) {
+	if strings.Contains(s, "'") {
+		return "", fmt.Errorf("string values containing a single quote are not supported")
+	}
+	return "'" + s + "'", nil
+}
@@ -0,0 +1,315 @@
+package minidb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DriverName database/sql 中注册的驱动名
+const DriverName = "minidb"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Driver MiniDB 的 database/sql 驱动
+//
+// 数据源名称 (DSN)：
+//
+//	minidb://[user[:password]@]host:port[/database]   连接服务器
+//	/path/to/data 或 file:/path/to/data[?config=minidb.yaml&user=...&password=...]   进程内打开数据目录
+//
+// 参数占位符支持 ? 和 $1、$2 ...，参数在客户端转换为 SQL 字面量。
+// 事务映射为 START TRANSACTION / COMMIT / ROLLBACK 语句；MiniDB 的每条语句单独提交，ROLLBACK 不会撤销已执行的写入。
+type Driver struct{}
+
+// Open 按 DSN 打开一个连接，连接关闭时同时关闭嵌入式数据库
+// sql.Open 使用 OpenConnector，同一个 sql.DB 的连接共享一个嵌入式数据库
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	c, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.Connect(context.Background())
+	if err != nil {
+		c.(*connector).Close()
+		return nil, err
+	}
+	conn.(*driverConn).closeDB = c.(*connector).db
+	return conn, nil
+}
+
+// OpenConnector 解析 DSN，打开嵌入式数据库或检查服务器地址
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	db, err := openDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &connector{db: db, owned: true}, nil
+}
+
+// NewConnector 返回使用已打开的 DB 的 driver.Connector，用于 sql.OpenDB；关闭 sql.DB 不会关闭 db
+func NewConnector(db *DB) driver.Connector {
+	return &connector{db: db}
+}
+
+// openDSN 按 DSN 打开 DB
+func openDSN(dsn string) (*DB, error) {
+	if strings.HasPrefix(dsn, DriverName+"://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("minidb: invalid DSN: %w", err)
+		}
+		if u.Port() == "" {
+			return nil, fmt.Errorf("minidb: DSN %q must include the server port", u.Redacted())
+		}
+		opts := DialOptions{Database: strings.Trim(u.Path, "/")}
+		if u.User != nil {
+			opts.User = u.User.Username()
+			opts.Password, _ = u.User.Password()
+		}
+		return Dial(context.Background(), u.Host, opts)
+	}
+
+	opts := Options{DataDir: dsn}
+	if strings.HasPrefix(dsn, "file:") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("minidb: invalid DSN: %w", err)
+		}
+		opts.DataDir = u.Opaque
+		if opts.DataDir == "" {
+			opts.DataDir = u.Path
+		}
+		query := u.Query()
+		opts.ConfigFile = query.Get("config")
+		opts.User = query.Get("user")
+		opts.Password = query.Get("password")
+	}
+	if opts.DataDir == "" {
+		return nil, fmt.Errorf("minidb: DSN must be a data directory or %s://host:port", DriverName)
+	}
+	return OpenWithOptions(opts)
+}
+
+// connector 为 sql.DB 创建连接
+type connector struct {
+	db    *DB
+	owned bool // 由 DSN 打开，sql.DB 关闭时关闭
+}
+
+// Connect 打开一个新的会话
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &driverConn{conn: conn}, nil
+}
+
+// Driver 返回驱动
+func (c *connector) Driver() driver.Driver {
+	return &Driver{}
+}
+
+// Close 关闭由 DSN 打开的 DB (sql.DB.Close 时调用)
+func (c *connector) Close() error {
+	if c.owned {
+		return c.db.Close()
+	}
+	return nil
+}
+
+// driverConn database/sql 的连接，对应一个会话
+type driverConn struct {
+	conn    *Conn
+	tx      bool
+	closeDB *DB // Driver.Open 打开的连接关闭时一并关闭的 DB
+}
+
+var (
+	_ driver.Conn               = (*driverConn)(nil)
+	_ driver.ConnBeginTx        = (*driverConn)(nil)
+	_ driver.ConnPrepareContext = (*driverConn)(nil)
+	_ driver.ExecerContext      = (*driverConn)(nil)
+	_ driver.QueryerContext     = (*driverConn)(nil)
+	_ driver.Pinger             = (*driverConn)(nil)
+	_ driver.Validator          = (*driverConn)(nil)
+)
+
+// Prepare 创建语句，参数在执行时绑定
+func (c *driverConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext 创建语句，检查占位符
+func (c *driverConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	count, err := countPlaceholders(query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{conn: c, query: query, numInput: count}, nil
+}
+
+// Close 结束会话
+func (c *driverConn) Close() error {
+	err := c.conn.Close()
+	if c.closeDB != nil {
+		if closeErr := c.closeDB.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Begin 开始事务
+func (c *driverConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx 执行 START TRANSACTION；只支持默认的隔离级别
+func (c *driverConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if opts.Isolation != driver.IsolationLevel(sql.LevelDefault) {
+		return nil, fmt.Errorf("minidb: isolation level %s is not supported", sql.IsolationLevel(opts.Isolation))
+	}
+	if opts.ReadOnly {
+		return nil, fmt.Errorf("minidb: read-only transactions are not supported")
+	}
+	if c.tx {
+		return nil, fmt.Errorf("minidb: a transaction is already in progress")
+	}
+	if err := c.conn.Exec(ctx, "START TRANSACTION"); err != nil {
+		return nil, err
+	}
+	c.tx = true
+	return &tx{conn: c}, nil
+}
+
+// ExecContext 绑定参数并执行语句
+func (c *driverConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	bound, err := bindArgs(query, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.conn.Exec(ctx, bound); err != nil {
+		return nil, err
+	}
+	return execResult{}, nil
+}
+
+// QueryContext 绑定参数并执行查询
+func (c *driverConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	bound, err := bindArgs(query, args)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.conn.execute(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(result), nil
+}
+
+// Ping 检查会话是否可用
+func (c *driverConn) Ping(ctx context.Context) error {
+	if !c.IsValid() {
+		return driver.ErrBadConn
+	}
+	return c.conn.Exec(ctx, "SHOW timezone")
+}
+
+// IsValid 连接断开或会话被 KILL 后不再放回连接池
+func (c *driverConn) IsValid() bool {
+	return !c.conn.closed && c.conn.session.alive()
+}
+
+// tx 一个事务
+type tx struct {
+	conn *driverConn
+}
+
+// Commit 执行 COMMIT
+func (t *tx) Commit() error {
+	return t.finish("COMMIT")
+}
+
+// Rollback 执行 ROLLBACK
+func (t *tx) Rollback() error {
+	return t.finish("ROLLBACK")
+}
+
+// finish 结束事务
+func (t *tx) finish(statement string) error {
+	if !t.conn.tx {
+		return sql.ErrTxDone
+	}
+	t.conn.tx = false
+	return t.conn.conn.Exec(context.Background(), statement)
+}
+
+// stmt 一条语句，每次执行时绑定参数
+type stmt struct {
+	conn     *driverConn
+	query    string
+	numInput int
+}
+
+// Close 语句没有服务器端的状态
+func (s *stmt) Close() error {
+	return nil
+}
+
+// NumInput 返回参数个数
+func (s *stmt) NumInput() int {
+	return s.numInput
+}
+
+// Exec 执行语句
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+// Query 执行查询
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+// ExecContext 执行语句
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+// QueryContext 执行查询
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+// namedValues 把位置参数转换为 NamedValue
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+// errNoRowCounts MiniDB 不返回受影响的行数和自增 ID
+var errNoRowCounts = errors.New("minidb: row counts and insert ids are not reported")
+
+// execResult Exec 的结果
+type execResult struct{}
+
+// LastInsertId 不支持
+func (execResult) LastInsertId() (int64, error) {
+	return 0, errNoRowCounts
+}
+
+// RowsAffected 不支持
+func (execResult) RowsAffected() (int64, error) {
+	return 0, errNoRowCounts
+}
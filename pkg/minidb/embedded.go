@@ -0,0 +1,94 @@
+package minidb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/engine"
+	minisession "github.com/yyun543/minidb/internal/session"
+)
+
+// embeddedBackend 进程内的 MiniDB 引擎
+type embeddedBackend struct {
+	engine *engine.Engine
+	user   string // 启用认证时会话的用户
+}
+
+// openEmbedded 打开数据目录，启用认证时先校验用户名和密码
+func openEmbedded(cfg *config.Config, user, password string) (*embeddedBackend, error) {
+	eng, err := engine.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if eng.AccessControl().Enforced() {
+		if user == "" {
+			eng.Close()
+			return nil, fmt.Errorf("minidb: authentication is enabled, a user and password are required")
+		}
+		if err := eng.AccessControl().Authenticate(user, password); err != nil {
+			eng.Close()
+			return nil, fmt.Errorf("minidb: %w", err)
+		}
+	}
+	return &embeddedBackend{engine: eng, user: user}, nil
+}
+
+// connect 创建引擎中的会话，并登记到在线会话 (KILL SESSION 结束会话)
+func (b *embeddedBackend) connect(ctx context.Context) (session, error) {
+	sess := b.engine.Sessions().CreateSession()
+	if b.engine.AccessControl().Enforced() {
+		sess.User = b.user
+	}
+
+	sessCtx, cancel := context.WithCancelCause(context.Background())
+	unregister := b.engine.Queries().RegisterSession(sess, cancel)
+	return &embeddedSession{
+		backend:    b,
+		sess:       sess,
+		ctx:        sessCtx,
+		cancel:     cancel,
+		unregister: unregister,
+	}, nil
+}
+
+// close 关闭引擎
+func (b *embeddedBackend) close() error {
+	return b.engine.Close()
+}
+
+// embeddedSession 引擎中的一个会话
+type embeddedSession struct {
+	backend    *embeddedBackend
+	sess       *minisession.Session
+	ctx        context.Context // 会话的 context，KILL SESSION 时取消
+	cancel     context.CancelCauseFunc
+	unregister func()
+}
+
+// execute 在会话中执行一条语句，会话被 KILL 时同时取消正在执行的语句
+func (s *embeddedSession) execute(ctx context.Context, sql string) (*engine.Result, error) {
+	if !s.alive() {
+		return nil, context.Cause(s.ctx)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	stop := context.AfterFunc(s.ctx, func() { cancel(context.Cause(s.ctx)) })
+	defer stop()
+
+	return s.backend.engine.Execute(ctx, s.sess, sql)
+}
+
+// alive 会话是否仍可使用
+func (s *embeddedSession) alive() bool {
+	return s.ctx.Err() == nil
+}
+
+// close 结束会话
+func (s *embeddedSession) close() error {
+	s.unregister()
+	s.cancel(nil)
+	s.backend.engine.Sessions().DeleteSession(s.sess.ID)
+	return nil
+}
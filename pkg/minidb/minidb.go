@@ -0,0 +1,220 @@
+// Package minidb 是 MiniDB 的 Go 客户端 API
+//
+// 既可以在进程内嵌入运行 MiniDB (Open，直接读写本地数据目录)，也可以连接到 MiniDB 服务器 (Dial)。
+// 两种方式提供相同的接口：
+//
+//   - Arrow 原生的 QueryArrow，按批次返回 array.RecordReader
+//   - database/sql 驱动 (驱动名 "minidb")，见 Driver
+//
+// 嵌入式示例：
+//
+//	db, err := minidb.Open("./minidb_data")
+//	if err != nil { ... }
+//	defer db.Close()
+//	reader, err := db.QueryArrow(ctx, "SELECT id, name FROM users")
+//	if err != nil { ... }
+//	defer reader.Release()
+//	for reader.Next() {
+//		record := reader.Record()
+//		...
+//	}
+package minidb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/engine"
+)
+
+// ErrClosed 在已关闭的 DB 或 Conn 上操作时返回
+var ErrClosed = errors.New("minidb: database is closed")
+
+// Options 打开嵌入式数据库的选项
+type Options struct {
+	// DataDir 数据目录，不为空时覆盖配置文件中的 data_dir
+	DataDir string
+	// ConfigFile 可选的 YAML 配置文件，与 minidb 服务器的配置文件格式相同
+	ConfigFile string
+	// User、Password 配置启用认证 (auth.enabled) 时会话使用的用户；未启用认证时忽略
+	User     string
+	Password string
+}
+
+// DialOptions 连接 MiniDB 服务器的选项
+type DialOptions struct {
+	// User、Password 服务器启用认证时的登录用户，使用 SCRAM-SHA-256，密码不经过网络
+	User     string
+	Password string
+	// Database 连接后切换到的数据库，为空时使用服务器的默认数据库
+	Database string
+}
+
+// DB 一个嵌入式 MiniDB 实例或远程服务器的句柄，可以被多个 goroutine 同时使用
+//
+// DB 上的 QueryArrow、Exec 各自在新的会话中执行；需要会话状态 (USE、SET、PREPARE、事务) 时使用 Conn
+type DB struct {
+	backend backend
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// backend 嵌入式引擎或远程服务器
+type backend interface {
+	// connect 创建一个新的会话
+	connect(ctx context.Context) (session, error)
+	close() error
+}
+
+// session 一个会话 (嵌入式引擎中的会话或一条服务器连接)，不能并发使用
+type session interface {
+	execute(ctx context.Context, sql string) (*engine.Result, error)
+	// alive 会话是否仍可使用 (连接断开、会话被 KILL 后为 false)
+	alive() bool
+	close() error
+}
+
+// Open 在进程内打开数据目录，使用默认配置
+func Open(dataDir string) (*DB, error) {
+	return OpenWithOptions(Options{DataDir: dataDir})
+}
+
+// OpenWithOptions 按选项在进程内打开嵌入式数据库
+// 同一个数据目录在同一时间只能由一个 DB (或一个服务器进程) 打开
+func OpenWithOptions(opts Options) (*DB, error) {
+	cfg := config.Default()
+	if opts.ConfigFile != "" {
+		loaded, err := config.Load(opts.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg = loaded
+	}
+	if opts.DataDir != "" {
+		cfg.DataDir = opts.DataDir
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	backend, err := openEmbedded(cfg, opts.User, opts.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{backend: backend}, nil
+}
+
+// Dial 连接 address (host:port) 上的 MiniDB 服务器，并打开一个会话检查地址和登录信息
+func Dial(ctx context.Context, address string, opts DialOptions) (*DB, error) {
+	backend := &remoteBackend{address: address, opts: opts}
+	sess, err := backend.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sess.close()
+	return &DB{backend: backend}, nil
+}
+
+// Conn 打开一个新的会话
+func (db *DB) Conn(ctx context.Context) (*Conn, error) {
+	db.mu.Lock()
+	closed := db.closed
+	db.mu.Unlock()
+	if closed {
+		return nil, ErrClosed
+	}
+
+	sess, err := db.backend.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{session: sess}, nil
+}
+
+// QueryArrow 在新的会话中执行一条语句，返回按批次读取结果的 reader (用完后调用 Release)
+// 没有结果集的语句 (DDL/DML) 返回空 schema、没有数据的 reader
+func (db *DB) QueryArrow(ctx context.Context, sql string) (array.RecordReader, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.QueryArrow(ctx, sql)
+}
+
+// Exec 在新的会话中执行一条语句，忽略结果集
+func (db *DB) Exec(ctx context.Context, sql string) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return conn.Exec(ctx, sql)
+}
+
+// Close 关闭 DB；嵌入式数据库会刷新写缓冲并关闭数据目录
+func (db *DB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return nil
+	}
+	db.closed = true
+	return db.backend.close()
+}
+
+// Conn 一个会话，会话状态 (当前数据库、会话变量、预处理语句) 在语句之间保留
+// Conn 不能被多个 goroutine 同时使用
+type Conn struct {
+	session session
+	closed  bool
+}
+
+// QueryArrow 执行一条语句，返回按批次读取结果的 reader (用完后调用 Release)
+func (c *Conn) QueryArrow(ctx context.Context, sql string) (array.RecordReader, error) {
+	result, err := c.execute(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Release()
+	return result.RecordReader()
+}
+
+// Exec 执行一条语句，忽略结果集
+func (c *Conn) Exec(ctx context.Context, sql string) error {
+	result, err := c.execute(ctx, sql)
+	if err != nil {
+		return err
+	}
+	result.Release()
+	return nil
+}
+
+// execute 在会话中执行一条语句
+func (c *Conn) execute(ctx context.Context, sql string) (*engine.Result, error) {
+	if c.closed {
+		return nil, ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result, err := c.session.execute(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("minidb: %w", err)
+	}
+	return result, nil
+}
+
+// Close 结束会话
+func (c *Conn) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.session.close()
+}
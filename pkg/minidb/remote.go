@@ -0,0 +1,223 @@
+package minidb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/yyun543/minidb/internal/auth"
+	"github.com/yyun543/minidb/internal/engine"
+	"github.com/yyun543/minidb/internal/executor"
+)
+
+// 服务器文本协议 (cmd/server)：
+//
+//   - 启用认证时服务器先发送 "Username: "，之后按 SCRAM-SHA-256 质询-应答登录
+//   - 欢迎信息之后，服务器在等待每条语句前发送提示符 "minidb> "
+//   - 客户端发送以 ';' 结尾的语句，服务器返回结果文本 (以换行结尾)，出错时以 "Error: " 开头
+//
+// 客户端在登录后设置 result_format = 'arrow'，结果集以一行 Arrow IPC 流返回，保留列的类型
+const (
+	promptText   = "minidb> "
+	loginPrompt  = "Username: "
+	errorPrefix  = "Error: "
+	scramPrefix  = auth.ScramMechanism + " "
+	loginFailure = "Authentication failed"
+)
+
+// remoteBackend 远程 MiniDB 服务器，每个会话一条 TCP 连接
+type remoteBackend struct {
+	address string
+	opts    DialOptions
+}
+
+// connect 建立连接、登录，并设置会话的结果格式和数据库
+func (b *remoteBackend) connect(ctx context.Context) (session, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", b.address)
+	if err != nil {
+		return nil, fmt.Errorf("minidb: %w", err)
+	}
+	s := &remoteSession{conn: conn, reader: bufio.NewReader(conn)}
+
+	// 握手期间遵守 ctx 的截止时间
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if err := s.handshake(b.opts); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+
+	setup := []string{fmt.Sprintf("SET %s = '%s'", executor.ResultFormatVariable, executor.ResultFormatArrow)}
+	if b.opts.Database != "" {
+		setup = append(setup, "USE "+b.opts.Database)
+	}
+	for _, sql := range setup {
+		result, err := s.execute(ctx, sql)
+		if err != nil {
+			s.close()
+			return nil, fmt.Errorf("minidb: %s: %w", sql, err)
+		}
+		result.Release()
+	}
+	return s, nil
+}
+
+// close 远程服务器没有需要释放的资源
+func (b *remoteBackend) close() error {
+	return nil
+}
+
+// remoteSession 一条服务器连接
+type remoteSession struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	broken bool // 连接已断开或协议状态不确定，不能继续使用
+}
+
+// handshake 读取欢迎信息，服务器要求认证时先完成 SCRAM 登录
+func (s *remoteSession) handshake(opts DialOptions) error {
+	text, err := s.readUntil(promptText, loginPrompt)
+	if err != nil {
+		return fmt.Errorf("minidb: reading server greeting: %w", err)
+	}
+	if strings.HasSuffix(text, promptText) {
+		return nil
+	}
+
+	if opts.User == "" {
+		return fmt.Errorf("minidb: server requires authentication, a user and password are required")
+	}
+	client := auth.NewScramClient(opts.User, opts.Password)
+	clientFirst, err := client.First()
+	if err != nil {
+		return err
+	}
+	serverFirst, err := s.exchangeScram(clientFirst)
+	if err != nil {
+		return err
+	}
+	clientFinal, err := client.Final(serverFirst)
+	if err != nil {
+		return fmt.Errorf("minidb: %w", err)
+	}
+	serverFinal, err := s.exchangeScram(clientFinal)
+	if err != nil {
+		return err
+	}
+	if err := client.Verify(serverFinal); err != nil {
+		return fmt.Errorf("minidb: %w", err)
+	}
+
+	if _, err := s.readUntil(promptText); err != nil {
+		return fmt.Errorf("minidb: reading server greeting: %w", err)
+	}
+	return nil
+}
+
+// exchangeScram 发送一条 SCRAM 消息并读取服务器的应答
+func (s *remoteSession) exchangeScram(message string) (string, error) {
+	if _, err := io.WriteString(s.conn, scramPrefix+message+"\n"); err != nil {
+		return "", fmt.Errorf("minidb: %w", err)
+	}
+	line, err := s.reader.ReadString('\n')
+	line = strings.TrimRight(line, "\r\n")
+	if strings.HasPrefix(line, loginFailure) {
+		return "", fmt.Errorf("minidb: authentication failed")
+	}
+	if err != nil {
+		return "", fmt.Errorf("minidb: %w", err)
+	}
+	reply, ok := strings.CutPrefix(line, scramPrefix)
+	if !ok {
+		return "", fmt.Errorf("minidb: unexpected login reply %q", line)
+	}
+	return reply, nil
+}
+
+// execute 发送一条语句并读取结果
+// ctx 取消时关闭连接，服务器检测到客户端断开后取消正在执行的语句
+func (s *remoteSession) execute(ctx context.Context, sql string) (*engine.Result, error) {
+	if s.broken {
+		return nil, fmt.Errorf("connection is closed")
+	}
+	sql = strings.TrimRight(strings.TrimSpace(sql), "; \t\r\n")
+	if sql == "" {
+		return nil, fmt.Errorf("empty statement")
+	}
+	// 服务器按 ';' 切分语句，语句中间 (包括字符串字面量中) 的 ';' 会被截断
+	if strings.Contains(sql, ";") {
+		return nil, fmt.Errorf("statements sent to a server must not contain ';'")
+	}
+
+	stop := context.AfterFunc(ctx, func() { s.conn.Close() })
+	if _, err := io.WriteString(s.conn, sql+";"); err != nil {
+		stop()
+		s.broken = true
+		return nil, err
+	}
+	response, err := s.readUntil(promptText)
+	if !stop() {
+		s.broken = true
+		return nil, ctx.Err()
+	}
+	response = strings.TrimSuffix(response, promptText)
+	response = strings.TrimRight(response, "\r\n")
+	if err != nil {
+		// 会话被 KILL 时服务器先发送错误信息再关闭连接
+		s.broken = true
+		if strings.HasPrefix(response, errorPrefix) {
+			return nil, errors.New(strings.TrimPrefix(response, errorPrefix))
+		}
+		return nil, err
+	}
+
+	switch {
+	case strings.HasPrefix(response, errorPrefix):
+		return nil, errors.New(strings.TrimPrefix(response, errorPrefix))
+	case strings.HasPrefix(response, engine.ArrowPayloadPrefix):
+		return engine.DecodeArrow(response)
+	case response == "OK":
+		return &engine.Result{}, nil
+	default:
+		return &engine.Result{Message: response}, nil
+	}
+}
+
+// readUntil 读取服务器输出，直到以 suffixes 之一结尾
+func (s *remoteSession) readUntil(suffixes ...string) (string, error) {
+	var sb strings.Builder
+	for {
+		chunk, err := s.reader.ReadString(' ')
+		sb.WriteString(chunk)
+		for _, suffix := range suffixes {
+			if strings.HasSuffix(sb.String(), suffix) {
+				return sb.String(), nil
+			}
+		}
+		if err != nil {
+			return sb.String(), err
+		}
+	}
+}
+
+// alive 连接是否仍可使用
+func (s *remoteSession) alive() bool {
+	return !s.broken
+}
+
+// close 通知服务器结束会话并关闭连接
+func (s *remoteSession) close() error {
+	if !s.broken {
+		io.WriteString(s.conn, "quit;")
+	}
+	s.broken = true
+	return s.conn.Close()
+}
@@ -0,0 +1,202 @@
+package minidb
+
+import (
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/yyun543/minidb/internal/engine"
+)
+
+// TIMESTAMP、DATE 列以字符串存储，按这些格式还原为 time.Time (不带时区的值按 UTC 解析)
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+var (
+	int64Type   = reflect.TypeOf(int64(0))
+	float64Type = reflect.TypeOf(float64(0))
+	boolType    = reflect.TypeOf(false)
+	stringType  = reflect.TypeOf("")
+	timeType    = reflect.TypeOf(time.Time{})
+	bytesType   = reflect.TypeOf([]byte(nil))
+)
+
+// rows database/sql 的结果集，按批次逐行读取
+// 值的类型：整数为 int64，浮点数为 float64，BOOLEAN 为 bool，VARCHAR 为 string，TIMESTAMP、DATE 为 time.Time
+type rows struct {
+	result *engine.Result
+	record int // 当前批次
+	row    int // 当前批次中的下一行
+}
+
+var (
+	_ driver.Rows                           = (*rows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*rows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*rows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*rows)(nil)
+)
+
+// newRows 创建结果集，没有结果集的语句返回没有列的结果集
+func newRows(result *engine.Result) *rows {
+	return &rows{result: result}
+}
+
+// Columns 返回列名
+func (r *rows) Columns() []string {
+	if !r.result.HasRows() {
+		return []string{}
+	}
+	return r.result.Columns
+}
+
+// Close 释放结果数据
+func (r *rows) Close() error {
+	r.result.Release()
+	return nil
+}
+
+// Next 读取下一行
+func (r *rows) Next(dest []driver.Value) error {
+	for r.record < len(r.result.Records) {
+		record := r.result.Records[r.record]
+		if r.row >= int(record.NumRows()) {
+			r.record++
+			r.row = 0
+			continue
+		}
+		fields := r.result.Schema.Fields()
+		for i := range dest {
+			dest[i] = columnValue(fields[i], record.Column(i), r.row)
+		}
+		r.row++
+		return nil
+	}
+	return io.EOF
+}
+
+// ColumnTypeDatabaseTypeName 返回列的 SQL 类型名 (不含长度)
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return databaseTypeName(r.result.Schema.Field(index))
+}
+
+// ColumnTypeScanType 返回列的值在 Go 中的类型
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	field := r.result.Schema.Field(index)
+	switch databaseTypeName(field) {
+	case "TIMESTAMP", "DATE":
+		return timeType
+	}
+	switch {
+	case arrow.IsInteger(field.Type.ID()):
+		return int64Type
+	case arrow.IsFloating(field.Type.ID()):
+		return float64Type
+	case field.Type.ID() == arrow.BOOL:
+		return boolType
+	case field.Type.ID() == arrow.BINARY:
+		return bytesType
+	default:
+		return stringType
+	}
+}
+
+// ColumnTypeNullable 返回列是否可为 NULL
+func (r *rows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	return r.result.Schema.Field(index).Nullable, true
+}
+
+// databaseTypeName 列声明的 SQL 类型 (结果列元数据)，没有声明时按 Arrow 类型推断
+func databaseTypeName(field arrow.Field) string {
+	if sqlType, ok := field.Metadata.GetValue(engine.SQLTypeMetadataKey); ok {
+		if idx := strings.Index(sqlType, "("); idx >= 0 {
+			sqlType = sqlType[:idx]
+		}
+		return strings.ToUpper(strings.TrimSpace(sqlType))
+	}
+	switch field.Type.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return "INTEGER"
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return "DOUBLE"
+	case arrow.BOOL:
+		return "BOOLEAN"
+	case arrow.TIMESTAMP:
+		return "TIMESTAMP"
+	case arrow.DATE32, arrow.DATE64:
+		return "DATE"
+	default:
+		return "VARCHAR"
+	}
+}
+
+// columnValue 返回一行的值，NULL 为 nil
+func columnValue(field arrow.Field, column arrow.Array, row int) driver.Value {
+	if column.IsNull(row) {
+		return nil
+	}
+
+	switch col := column.(type) {
+	case *array.Int8:
+		return int64(col.Value(row))
+	case *array.Int16:
+		return int64(col.Value(row))
+	case *array.Int32:
+		return int64(col.Value(row))
+	case *array.Int64:
+		return col.Value(row)
+	case *array.Uint8:
+		return int64(col.Value(row))
+	case *array.Uint16:
+		return int64(col.Value(row))
+	case *array.Uint32:
+		return int64(col.Value(row))
+	case *array.Uint64:
+		return int64(col.Value(row))
+	case *array.Float32:
+		return float64(col.Value(row))
+	case *array.Float64:
+		return col.Value(row)
+	case *array.Boolean:
+		return col.Value(row)
+	case *array.String:
+		value := col.Value(row)
+		switch databaseTypeName(field) {
+		case "TIMESTAMP", "DATE":
+			if t, ok := parseTime(value); ok {
+				return t
+			}
+		}
+		return value
+	case *array.Binary:
+		return append([]byte(nil), col.Value(row)...)
+	case *array.Timestamp:
+		return col.Value(row).ToTime(col.DataType().(*arrow.TimestampType).Unit)
+	case *array.Date32:
+		return col.Value(row).ToTime()
+	case *array.Date64:
+		return col.Value(row).ToTime()
+	default:
+		return column.ValueStr(row)
+	}
+}
+
+// parseTime 按 timeLayouts 解析时间字符串
+func parseTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,397 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/auth"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// setupAccessControlTest 创建启用认证的执行器，返回超级用户 admin 的会话
+func setupAccessControlTest(t *testing.T) (*storage.ParquetEngine, *executor.ExecutorImpl, *auth.Manager, *session.Session) {
+	dir := SetupTestDir(t, "access_control")
+	engine, exec, manager, sess := openAccessControlEngine(t, dir)
+	t.Cleanup(func() { engine.Close() })
+	return engine, exec, manager, sess
+}
+
+// openAccessControlEngine 在 dir 下打开引擎并从 sys 库的系统表加载用户和权限目录，
+// 目录为空时创建超级用户 admin；返回 admin 的会话
+func openAccessControlEngine(t *testing.T, dir string) (*storage.ParquetEngine, *executor.ExecutorImpl, *auth.Manager, *session.Session) {
+	engine, exec, sess := newTestEngine(t, dir)
+	manager, err := auth.NewManager(func(name string, schema *arrow.Schema) auth.Table {
+		return engine.SystemTable(name, schema)
+	})
+	require.NoError(t, err)
+	_, err = manager.Bootstrap("admin", "admin-secret")
+	require.NoError(t, err)
+	manager.SetEnforced(true)
+	exec.SetAccessControl(manager)
+
+	sess.User = "admin"
+	return engine, exec, manager, sess
+}
+
+// userSession 以 user 登录的新会话
+func userSession(user string) *session.Session {
+	return &session.Session{ID: 100, CurrentDB: "default", User: user}
+}
+
+// TestAccessControlParse 用户、角色和 GRANT / REVOKE 语句的解析
+func TestAccessControlParse(t *testing.T) {
+	node, err := parser.Parse("CREATE USER alice WITH PASSWORD 'secret'")
+	require.NoError(t, err)
+	user, ok := node.(*parser.CreateRoleStmt)
+	require.True(t, ok)
+	assert.Equal(t, "alice", user.Name)
+	assert.True(t, user.Login)
+	assert.Equal(t, "secret", user.Password)
+
+	node, err = parser.Parse("CREATE ROLE analyst")
+	require.NoError(t, err)
+	role := node.(*parser.CreateRoleStmt)
+	assert.False(t, role.Login)
+
+	node, err = parser.Parse("GRANT SELECT, INSERT ON sales.orders TO analyst, bob")
+	require.NoError(t, err)
+	grant := node.(*parser.GrantStmt)
+	assert.False(t, grant.Revoke)
+	assert.Equal(t, []string{"SELECT", "INSERT"}, grant.Privileges)
+	assert.Equal(t, "sales", grant.Database)
+	assert.Equal(t, "orders", grant.Table)
+	assert.Equal(t, []string{"analyst", "bob"}, grant.Grantees)
+
+	node, err = parser.Parse("GRANT analyst TO alice")
+	require.NoError(t, err)
+	grant = node.(*parser.GrantStmt)
+	assert.Empty(t, grant.Privileges)
+	assert.Equal(t, []string{"analyst"}, grant.Roles)
+	assert.Equal(t, []string{"alice"}, grant.Grantees)
+
+	node, err = parser.Parse("REVOKE ALL ON sales.* FROM analyst")
+	require.NoError(t, err)
+	grant = node.(*parser.GrantStmt)
+	assert.True(t, grant.Revoke)
+	assert.Equal(t, "*", grant.Table)
+
+	_, err = parser.Parse("CREATE USER alice")
+	assert.Error(t, err, "CREATE USER requires a password")
+}
+
+// TestAccessControlPrivileges 通过角色授予的权限在执行前检查，REVOKE 后立即生效
+func TestAccessControlPrivileges(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+
+	for _, sql := range []string{
+		"CREATE TABLE orders (id INT, amount INT)",
+		"INSERT INTO orders VALUES (1, 100)",
+		"CREATE USER alice PASSWORD 'alice-secret'",
+		"CREATE ROLE analyst",
+		"GRANT SELECT ON default.orders TO analyst",
+		"GRANT analyst TO alice",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+
+	alice := userSession("alice")
+	result, err := execSQL(t, exec, alice, "SELECT id, amount FROM orders")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|100|"}, resultRows(result))
+
+	// 未授予的权限和超级用户操作被拒绝
+	for _, sql := range []string{
+		"INSERT INTO orders VALUES (2, 200)",
+		"DELETE FROM orders WHERE id = 1",
+		"DROP TABLE orders",
+		"DROP DATABASE default",
+		"CREATE USER mallory PASSWORD 'x'",
+		"GRANT ALL ON *.* TO alice",
+	} {
+		_, err := execSQL(t, exec, alice, sql)
+		require.Error(t, err, sql)
+		assert.Contains(t, err.Error(), "permission denied", sql)
+	}
+
+	// 系统表对所有登录用户可见
+	_, err = execSQL(t, exec, alice, "SELECT user_name FROM sys.users")
+	require.NoError(t, err)
+
+	// 未登录的会话不能执行任何语句
+	_, err = execSQL(t, exec, userSession(""), "SELECT id FROM orders")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not authenticated")
+
+	_, err = execSQL(t, exec, admin, "REVOKE SELECT ON default.orders FROM analyst")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, alice, "SELECT id FROM orders")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
+// TestAccessControlSystemTables 用户、角色和权限记录在 sys 系统表中，不暴露凭据
+func TestAccessControlSystemTables(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+
+	for _, sql := range []string{
+		"CREATE USER alice PASSWORD 'alice-secret'",
+		"CREATE ROLE writers",
+		"GRANT writers TO alice",
+		"GRANT ALL ON sales.* TO writers",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+
+	result, err := execSQL(t, exec, admin, "SELECT user_name, superuser FROM sys.users")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	sort.Strings(rows)
+	assert.Equal(t, []string{"admin|true|", "alice|false|"}, rows)
+
+	result, err = execSQL(t, exec, admin, "SELECT role_name, member_name FROM sys.role_members")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"writers|alice|"}, resultRows(result))
+
+	result, err = execSQL(t, exec, admin, "SELECT grantee, db_name, table_name, privilege FROM sys.privileges")
+	require.NoError(t, err)
+	rows = resultRows(result)
+	sort.Strings(rows)
+	assert.Equal(t, []string{
+		"writers|sales|*|DELETE|",
+		"writers|sales|*|INSERT|",
+		"writers|sales|*|SELECT|",
+		"writers|sales|*|UPDATE|",
+	}, rows)
+
+	// 删除角色同时删除成员关系和授权
+	_, err = execSQL(t, exec, admin, "DROP ROLE writers")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec, admin, "SELECT grantee FROM sys.privileges")
+	require.NoError(t, err)
+	assert.Empty(t, resultRows(result))
+
+	// 不能删除当前登录的用户
+	_, err = execSQL(t, exec, admin, "DROP USER admin")
+	assert.Error(t, err)
+}
+
+// TestAccessControlExternalTable 外部表只允许超级用户创建，普通用户不能借 LOCATION 读取系统表的数据文件
+func TestAccessControlExternalTable(t *testing.T) {
+	dir := SetupTestDir(t, "access_control_external")
+	engine, exec, _, admin := openAccessControlEngine(t, dir)
+	t.Cleanup(func() { engine.Close() })
+
+	for _, sql := range []string{
+		"CREATE USER alice PASSWORD 'alice-secret'",
+		"GRANT ALL ON default.* TO alice",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+
+	alice := userSession("alice")
+	location := filepath.Join(dir, "sys", "users", "data")
+	_, err := execSQL(t, exec, alice, "CREATE EXTERNAL TABLE stolen LOCATION '"+location+"' FORMAT parquet")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+
+	_, err = execSQL(t, exec, alice, "SELECT * FROM stolen")
+	require.Error(t, err, "the external table must not have been created")
+
+	// 表级 ALL 仍然允许创建普通表
+	_, err = execSQL(t, exec, alice, "CREATE TABLE notes (id INT)")
+	require.NoError(t, err)
+
+	_, err = execSQL(t, exec, admin, "CREATE EXTERNAL TABLE users_files LOCATION '"+location+"' FORMAT parquet")
+	require.NoError(t, err)
+}
+
+// TestAccessControlPersistence 用户、角色和权限保存在 sys 库的系统表中，重新打开引擎后保留
+func TestAccessControlPersistence(t *testing.T) {
+	dir := SetupTestDir(t, "access_control_persistence")
+	engine, exec, _, admin := openAccessControlEngine(t, dir)
+
+	for _, sql := range []string{
+		"CREATE USER alice PASSWORD 'alice-secret'",
+		"CREATE ROLE analyst",
+		"GRANT SELECT ON default.* TO analyst",
+		"GRANT analyst TO alice",
+		"GRANT INSERT ON default.orders TO alice",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+	require.NoError(t, engine.Close())
+
+	engine, exec, reloaded, admin := openAccessControlEngine(t, dir)
+	defer engine.Close()
+	require.NoError(t, reloaded.Authenticate("admin", "admin-secret"))
+	require.NoError(t, reloaded.Authenticate("alice", "alice-secret"))
+	assert.ErrorIs(t, reloaded.Authenticate("alice", "wrong"), auth.ErrAuthenticationFailed)
+	assert.NoError(t, reloaded.Check("alice", auth.PrivilegeSelect, "default", "orders"), "SELECT through role analyst")
+	assert.NoError(t, reloaded.Check("alice", auth.PrivilegeInsert, "default", "orders"))
+	assert.Error(t, reloaded.Check("alice", auth.PrivilegeDelete, "default", "orders"))
+
+	// 已有用户时不再创建初始超级用户
+	created, err := reloaded.Bootstrap("root", "root-secret")
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	// 目录的修改作为 sys 库系统表的提交记录在 Delta Log 中，记录执行语句的用户
+	rows := sortedRows(t, exec, admin,
+		"SELECT table_name, operation, user_id FROM sys.delta_log WHERE db_name = 'sys' AND table_name = 'users' AND operation = 'ADD'")
+	require.Len(t, rows, 2, "bootstrap and CREATE USER alice")
+	assert.Contains(t, rows, "users|ADD||", "bootstrap runs without a session user")
+	assert.Contains(t, rows, "users|ADD|admin|")
+
+	// 查询 sys.users 不返回凭据
+	result, err := execSQL(t, exec, admin, "SELECT * FROM sys.users")
+	require.NoError(t, err)
+	for _, header := range result.Headers {
+		assert.NotContains(t, []string{"salt", "stored_key", "server_key"}, header)
+	}
+}
+
+// TestAccessControlDeltaLogUser 写操作的 Delta Log 条目记录执行语句的用户
+func TestAccessControlDeltaLogUser(t *testing.T) {
+	engine, exec, _, admin := setupAccessControlTest(t)
+
+	for _, sql := range []string{
+		"CREATE TABLE events (id INT)",
+		"CREATE USER alice PASSWORD 'alice-secret'",
+		"GRANT INSERT ON default.events TO alice",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+	_, err := execSQL(t, exec, userSession("alice"), "INSERT INTO events VALUES (1)")
+	require.NoError(t, err)
+
+	entries := engine.GetDeltaLog().GetEntriesByTable("default.events")
+	require.NotEmpty(t, entries)
+	assert.Equal(t, "admin", entries[0].UserID, "CREATE TABLE by admin")
+	assert.Equal(t, "alice", entries[len(entries)-1].UserID, "INSERT by alice")
+
+	result, err := execSQL(t, exec, admin,
+		"SELECT user_id FROM sys.delta_log WHERE db_name = 'default' AND table_name = 'events' AND operation = 'ADD'")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice|"}, resultRows(result))
+}
+
+// TestAccessControlConcurrentCommitUsers 不同会话并发写入时，每个提交记录各自语句的用户；
+// 不携带用户的写入 (后台刷写、维护任务) 不会记录到并发语句的用户下
+func TestAccessControlConcurrentCommitUsers(t *testing.T) {
+	engine, exec, _, admin := setupAccessControlTest(t)
+
+	users := []string{"alice", "bob", "carol"}
+	for _, sql := range []string{"CREATE TABLE events (id INT)", "CREATE TABLE background (id INT, name VARCHAR)"} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+	for _, user := range users {
+		for _, sql := range []string{
+			fmt.Sprintf("CREATE USER %s PASSWORD '%s-secret'", user, user),
+			fmt.Sprintf("GRANT INSERT ON default.events TO %s", user),
+		} {
+			_, err := execSQL(t, exec, admin, sql)
+			require.NoError(t, err, sql)
+		}
+	}
+
+	const rowsPerUser = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, len(users)*rowsPerUser+rowsPerUser)
+	for i, user := range users {
+		wg.Add(1)
+		go func(id int, sess *session.Session) {
+			defer wg.Done()
+			for j := 0; j < rowsPerUser; j++ {
+				_, err := execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO events VALUES (%d)", id*100+j))
+				errs <- err
+			}
+		}(i, &session.Session{ID: int64(200 + i), CurrentDB: "default", User: user})
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		schema, err := engine.GetTableSchema("default", "background")
+		if err != nil {
+			errs <- err
+			return
+		}
+		for j := 0; j < rowsPerUser; j++ {
+			record := createTestRecord(t, schema, j, 1)
+			errs <- engine.Write(context.Background(), "default", "background", record)
+			record.Release()
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// 每条 INSERT 提交一个 ADD
+	owners := make(map[string]int)
+	for _, entry := range engine.GetDeltaLog().GetEntriesByTable("default.events") {
+		if entry.Operation == delta.OpAdd {
+			owners[entry.UserID]++
+		}
+	}
+	assert.Equal(t, map[string]int{"alice": rowsPerUser, "bob": rowsPerUser, "carol": rowsPerUser}, owners)
+
+	rows := sortedRows(t, exec, admin,
+		"SELECT user_id FROM sys.delta_log WHERE db_name = 'default' AND table_name = 'background' AND operation = 'ADD'")
+	require.Len(t, rows, rowsPerUser)
+	for _, row := range rows {
+		assert.Equal(t, "|", row, "write without a session user")
+	}
+}
+
+// TestScramAuthentication SCRAM-SHA-256 握手：正确的密码通过并互相验证，错误的密码和不存在的用户同样失败
+func TestScramAuthentication(t *testing.T) {
+	_, _, manager, _ := setupAccessControlTest(t)
+	require.NoError(t, manager.CreateUser(context.Background(), "alice", "alice-secret", false))
+
+	handshake := func(user, password string) (string, error) {
+		client := auth.NewScramClient(user, password)
+		server := manager.NewScramServer()
+
+		clientFirst, err := client.First()
+		require.NoError(t, err)
+		serverFirst, err := server.First(clientFirst)
+		require.NoError(t, err)
+		clientFinal, err := client.Final(serverFirst)
+		require.NoError(t, err)
+		serverFinal, err := server.Final(clientFinal)
+		if err != nil {
+			return "", err
+		}
+		if err := client.Verify(serverFinal); err != nil {
+			return "", err
+		}
+		return server.User(), nil
+	}
+
+	user, err := handshake("alice", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user)
+
+	_, err = handshake("alice", "wrong")
+	assert.ErrorIs(t, err, auth.ErrAuthenticationFailed)
+	_, err = handshake("nobody", "alice-secret")
+	assert.ErrorIs(t, err, auth.ErrAuthenticationFailed)
+}
@@ -0,0 +1,301 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// backupSQL 执行 BACKUP 或 RESTORE DATABASE，返回唯一的结果行
+func backupSQL(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string, headers ...string) string {
+	t.Helper()
+	result, err := execSQL(t, exec, sess, sql)
+	require.NoError(t, err, sql)
+	assert.Equal(t, headers, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	return rows[0]
+}
+
+// setupBackupTest 创建 shop 库：orders (带索引) 和 items 两张表
+func setupBackupTest(t *testing.T, name string) (*storage.ParquetEngine, *executor.ExecutorImpl, *session.Session, string) {
+	dir := SetupTestDir(t, name)
+	engine, exec, sess := newTestEngine(t, dir)
+	for _, sql := range []string{
+		"CREATE DATABASE shop",
+		"USE shop",
+		"CREATE TABLE orders (id INT, customer VARCHAR, amount INT)",
+		"CREATE INDEX idx_customer ON orders (customer)",
+		"CREATE TABLE items (sku VARCHAR, qty INT)",
+		"INSERT INTO orders VALUES (1, 'alice', 10), (2, 'bob', 20)",
+		"INSERT INTO items VALUES ('pen', 5)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	return engine, exec, sess, dir
+}
+
+// TestBackupDatabaseParse BACKUP DATABASE 和 RESTORE DATABASE 语句解析
+func TestBackupDatabaseParse(t *testing.T) {
+	node, err := parser.Parse("BACKUP DATABASE shop TO '/backups/full'")
+	require.NoError(t, err)
+	backup, ok := node.(*parser.BackupDatabaseStmt)
+	require.True(t, ok)
+	assert.Equal(t, "shop", backup.Database)
+	assert.Equal(t, "/backups/full", backup.Path)
+	assert.Empty(t, backup.IncrementalFrom)
+
+	node, err = parser.Parse("backup database shop to '/backups/inc1' incremental from '/backups/full';")
+	require.NoError(t, err)
+	assert.Equal(t, "/backups/full", node.(*parser.BackupDatabaseStmt).IncrementalFrom)
+
+	node, err = parser.Parse("RESTORE DATABASE shop2 FROM '/backups/inc1' AS OF VERSION 42")
+	require.NoError(t, err)
+	restore, ok := node.(*parser.RestoreDatabaseStmt)
+	require.True(t, ok)
+	assert.Equal(t, "shop2", restore.Database)
+	assert.Equal(t, "/backups/inc1", restore.Path)
+	assert.Equal(t, int64(42), restore.Version)
+
+	node, err = parser.Parse("RESTORE DATABASE shop FROM '/backups/full'")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), node.(*parser.RestoreDatabaseStmt).Version)
+
+	// RESTORE TABLE 不受影响
+	node, err = parser.Parse("RESTORE TABLE t TO VERSION AS OF 3")
+	require.NoError(t, err)
+	_, ok = node.(*parser.RestoreTableStmt)
+	assert.True(t, ok)
+
+	for _, sql := range []string{
+		"BACKUP DATABASE shop",
+		"BACKUP DATABASE shop TO /backups",
+		"BACKUP DATABASE shop TO '/b' INCREMENTAL '/a'",
+		"RESTORE DATABASE shop FROM '/b' AS OF VERSION 'x'",
+		"RESTORE DATABASE shop TO '/b'",
+	} {
+		_, err := parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestBackupAndRestoreDatabase 完整备份、增量备份，以及恢复到新数据库和历史版本
+func TestBackupAndRestoreDatabase(t *testing.T) {
+	engine, exec, sess, dir := setupBackupTest(t, "backup_restore")
+	defer engine.Close()
+	full := filepath.Join(dir, "backups", "full")
+	inc := filepath.Join(dir, "backups", "inc")
+	backupHeaders := []string{"version", "tables", "files_copied", "bytes_copied", "files_reused"}
+	restoreHeaders := []string{"restored_version", "tables", "files_restored", "bytes_restored"}
+
+	row := backupSQL(t, exec, sess, fmt.Sprintf("BACKUP DATABASE shop TO '%s'", full), backupHeaders...)
+	v1 := engine.GetDeltaLog().GetLatestVersion()
+	assert.True(t, strings.HasPrefix(row, fmt.Sprintf("%d|2|", v1)), row)
+	assert.True(t, strings.HasSuffix(row, "|0|"), "a full backup reuses nothing: %s", row)
+
+	manifest, err := storage.ReadBackupManifest(full)
+	require.NoError(t, err)
+	assert.Equal(t, "shop", manifest.Database)
+	assert.Equal(t, v1, manifest.Version)
+	var dataFiles int
+	for _, file := range manifest.Files {
+		assert.Len(t, file.SHA256, 64)
+		assert.Empty(t, file.Location)
+		if file.Kind == storage.BackupFileData {
+			dataFiles++
+			assert.FileExists(t, filepath.Join(full, file.Path))
+		}
+	}
+	assert.NotZero(t, dataFiles)
+
+	// 备份之后的写入不影响已完成的备份
+	for _, sql := range []string{
+		"INSERT INTO orders VALUES (3, 'carol', 30)",
+		"UPDATE orders SET amount = 25 WHERE id = 2",
+		"DELETE FROM items WHERE sku = 'pen'",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	v2 := engine.GetDeltaLog().GetLatestVersion()
+
+	// 增量备份只复制新文件，其余引用完整备份
+	row = backupSQL(t, exec, sess, fmt.Sprintf("BACKUP DATABASE shop TO '%s' INCREMENTAL FROM '%s'", inc, full), backupHeaders...)
+	assert.True(t, strings.HasPrefix(row, fmt.Sprintf("%d|2|", v2)), row)
+	manifest, err = storage.ReadBackupManifest(inc)
+	require.NoError(t, err)
+	fullDir, err := filepath.Abs(full)
+	require.NoError(t, err)
+	assert.Equal(t, fullDir, manifest.IncrementalFrom)
+	reused, copiedData := 0, 0
+	for _, file := range manifest.Files {
+		if file.Location != "" {
+			reused++
+			assert.Equal(t, fullDir, file.Location)
+			continue
+		}
+		if file.Kind == storage.BackupFileData {
+			copiedData++
+		}
+	}
+	assert.Greater(t, reused, dataFiles, "data files and log files of the full backup are reused")
+	assert.Greater(t, copiedData, 0)
+	assert.True(t, strings.HasSuffix(row, fmt.Sprintf("|%d|", reused)), row)
+
+	// 恢复到新数据库：最新版本
+	row = backupSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_copy FROM '%s'", inc), restoreHeaders...)
+	assert.True(t, strings.HasPrefix(row, fmt.Sprintf("%d|2|", v2)), row)
+	_, err = execSQL(t, exec, sess, "USE shop_copy")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|10|", "2|bob|25|", "3|carol|30|"}, sortedRows(t, exec, sess, "SELECT * FROM orders"))
+	assert.Empty(t, sortedRows(t, exec, sess, "SELECT * FROM items"))
+
+	// 索引随表一起恢复
+	var indexed bool
+	for _, entry := range engine.GetDeltaLog().GetEntriesByTable("shop_copy.orders") {
+		indexed = indexed || strings.Contains(entry.IndexJSON, "idx_customer")
+	}
+	assert.True(t, indexed)
+
+	// 恢复到增量备份中的较早版本
+	row = backupSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_v1 FROM '%s' AS OF VERSION %d", inc, v1), restoreHeaders...)
+	assert.True(t, strings.HasPrefix(row, fmt.Sprintf("%d|2|", v1)), row)
+	_, err = execSQL(t, exec, sess, "USE shop_v1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|10|", "2|bob|20|"}, sortedRows(t, exec, sess, "SELECT * FROM orders"))
+	assert.Equal(t, []string{"pen|5|"}, sortedRows(t, exec, sess, "SELECT * FROM items"))
+
+	// 恢复出的数据库可以正常写入，源数据库不受影响
+	_, err = execSQL(t, exec, sess, "INSERT INTO orders VALUES (9, 'zoe', 90)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "USE shop")
+	require.NoError(t, err)
+	assert.Len(t, sortedRows(t, exec, sess, "SELECT * FROM orders"), 3)
+
+	// 错误
+	for _, sql := range []string{
+		fmt.Sprintf("BACKUP DATABASE shop TO '%s'", full),
+		fmt.Sprintf("BACKUP DATABASE missing TO '%s'", filepath.Join(dir, "backups", "missing")),
+		fmt.Sprintf("BACKUP DATABASE shop TO '%s' INCREMENTAL FROM '%s'", filepath.Join(dir, "backups", "x"), filepath.Join(dir, "nowhere")),
+		fmt.Sprintf("RESTORE DATABASE shop FROM '%s'", full),
+		fmt.Sprintf("RESTORE DATABASE shop_new FROM '%s' AS OF VERSION %d", full, v2),
+		fmt.Sprintf("RESTORE DATABASE shop_new FROM '%s'", filepath.Join(dir, "nowhere")),
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		assert.Error(t, err, sql)
+	}
+	_, err = execSQL(t, exec, sess, "USE shop_new")
+	assert.Error(t, err, "failed restore should not leave a database behind")
+}
+
+// TestRestoreDatabaseAfterDrop 删除数据库后从备份重建同名数据库，重启后仍然可用
+func TestRestoreDatabaseAfterDrop(t *testing.T) {
+	engine, exec, sess, dir := setupBackupTest(t, "restore_after_drop")
+	backupDir := filepath.Join(dir, "backups", "nightly")
+	_, err := execSQL(t, exec, sess, fmt.Sprintf("BACKUP DATABASE shop TO '%s'", backupDir))
+	require.NoError(t, err)
+
+	// 删除之前的写入不在备份中
+	_, err = execSQL(t, exec, sess, "INSERT INTO orders VALUES (3, 'carol', 30)")
+	require.NoError(t, err)
+	for _, sql := range []string{"DROP TABLE orders", "DROP TABLE items", "USE default", "DROP DATABASE shop"} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop FROM '%s'", backupDir))
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "USE shop")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|10|", "2|bob|20|"}, sortedRows(t, exec, sess, "SELECT * FROM orders"))
+
+	require.NoError(t, engine.Close())
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+	_, err = execSQL(t, exec, sess, "USE shop")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|10|", "2|bob|20|"}, sortedRows(t, exec, sess, "SELECT * FROM orders"))
+	assert.Equal(t, []string{"pen|5|"}, sortedRows(t, exec, sess, "SELECT * FROM items"))
+}
+
+// TestRestoreDatabaseChecksum 备份文件损坏时拒绝恢复
+func TestRestoreDatabaseChecksum(t *testing.T) {
+	engine, exec, sess, dir := setupBackupTest(t, "backup_checksum")
+	defer engine.Close()
+	backupDir := filepath.Join(dir, "backups", "full")
+	_, err := execSQL(t, exec, sess, fmt.Sprintf("BACKUP DATABASE shop TO '%s'", backupDir))
+	require.NoError(t, err)
+
+	manifest, err := storage.ReadBackupManifest(backupDir)
+	require.NoError(t, err)
+	var corrupt string
+	for _, file := range manifest.Files {
+		if file.Kind == storage.BackupFileData && file.Table == "orders" {
+			corrupt = filepath.Join(backupDir, file.Path)
+		}
+	}
+	require.NotEmpty(t, corrupt)
+	data, err := os.ReadFile(corrupt)
+	require.NoError(t, err)
+	data[len(data)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(corrupt, data, 0644))
+
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_copy FROM '%s'", backupDir))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+	_, err = execSQL(t, exec, sess, "USE shop_copy")
+	assert.Error(t, err, "failed restore should not leave a database behind")
+
+	// 清单本身被篡改
+	manifest.Files[0].SHA256 = strings.Repeat("0", 64)
+	raw, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(backupDir, storage.BackupManifestFile), raw, 0644))
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_copy FROM '%s'", backupDir))
+	assert.ErrorContains(t, err, "checksum mismatch")
+}
+
+// TestBackupDatabaseCheckpoint checkpoint 之前的历史以压缩形式备份，不能恢复到更早的版本
+func TestBackupDatabaseCheckpoint(t *testing.T) {
+	engine, exec, sess, dir := setupBackupTest(t, "backup_checkpoint")
+	defer engine.Close()
+	before := engine.GetDeltaLog().GetLatestVersion()
+	_, err := execSQL(t, exec, sess, "INSERT INTO orders VALUES (3, 'carol', 30)")
+	require.NoError(t, err)
+	checkpoint, _, err := engine.CheckpointTable("shop", "orders")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO orders VALUES (4, 'dave', 40)")
+	require.NoError(t, err)
+
+	backupDir := filepath.Join(dir, "backups", "full")
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("BACKUP DATABASE shop TO '%s'", backupDir))
+	require.NoError(t, err)
+	manifest, err := storage.ReadBackupManifest(backupDir)
+	require.NoError(t, err)
+	for _, table := range manifest.Tables {
+		if table.Name == "orders" {
+			assert.Equal(t, checkpoint, table.CheckpointVersion)
+		}
+	}
+
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_old FROM '%s' AS OF VERSION %d", backupDir, before))
+	assert.ErrorContains(t, err, "compacted into a checkpoint")
+
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE DATABASE shop_cp FROM '%s' AS OF VERSION %d", backupDir, checkpoint))
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "USE shop_cp")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|10|", "2|bob|20|", "3|carol|30|"}, sortedRows(t, exec, sess, "SELECT * FROM orders"))
+	assert.Equal(t, []string{"pen|5|"}, sortedRows(t, exec, sess, "SELECT * FROM items"))
+}
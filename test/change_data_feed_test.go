@@ -0,0 +1,171 @@
+package test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// tableChanges 执行 table_changes 查询，返回排序后的 "id|name|_change_type|_commit_version" 行
+func tableChanges(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, args string) []string {
+	result, err := execSQL(t, exec, sess,
+		"SELECT id, name, _change_type, _commit_version FROM table_changes("+args+")")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	sort.Strings(rows)
+	return rows
+}
+
+// TestTableChangesParse FROM table_changes(...) 解析为带表函数的 SELECT
+func TestTableChangesParse(t *testing.T) {
+	node, err := parser.Parse("SELECT * FROM table_changes('db.t', 10, 20) WHERE _change_type = 'insert'")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.SelectStmt)
+	require.True(t, ok)
+	require.NotNil(t, stmt.FromFunction)
+	assert.Equal(t, "table_changes", stmt.FromFunction.Name)
+	assert.Equal(t, []interface{}{"db.t", int64(10), int64(20)}, stmt.FromFunction.Args)
+	assert.NotNil(t, stmt.Where)
+
+	node, err = parser.Parse("SELECT id FROM TABLE_CHANGES('t', 3) c")
+	require.NoError(t, err)
+	stmt = node.(*parser.SelectStmt)
+	require.NotNil(t, stmt.FromFunction)
+	assert.Equal(t, []interface{}{"t", int64(3)}, stmt.FromFunction.Args)
+
+	plan, err := optimizer.NewOptimizer().Optimize(stmt)
+	require.NoError(t, err)
+	assert.Contains(t, plan.Explain(""), "table_changes('t', 3)")
+
+	// 普通表不受影响
+	node, err = parser.Parse("SELECT * FROM changes")
+	require.NoError(t, err)
+	assert.Nil(t, node.(*parser.SelectStmt).FromFunction)
+
+	// COPY 导出查询同样支持表函数
+	node, err = parser.Parse("COPY (SELECT * FROM table_changes('t', 0)) TO 'changes.csv'")
+	require.NoError(t, err)
+	assert.NotNil(t, node.(*parser.CopyStmt).Query.FromFunction)
+}
+
+// TestTableChanges 插入、更新、删除产生的行级变更
+func TestTableChanges(t *testing.T) {
+	dir := SetupTestDir(t, "table_changes")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	start := deltaLog.GetLatestVersion() + 1
+
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (1, 'a'), (2, 'b'), (3, 'c')")
+	require.NoError(t, err)
+	insertEnd := deltaLog.GetLatestVersion()
+
+	_, err = execSQL(t, exec, sess, "UPDATE t SET name = 'bb' WHERE id = 2")
+	require.NoError(t, err)
+	updateVersion := deltaLog.GetLatestVersion()
+
+	_, err = execSQL(t, exec, sess, "DELETE FROM t WHERE id = 1")
+	require.NoError(t, err)
+	deleteVersion := deltaLog.GetLatestVersion()
+
+	// 插入
+	rows := tableChanges(t, exec, sess, fmt.Sprintf("'default.t', %d, %d", start, insertEnd))
+	require.Len(t, rows, 3)
+	for i, name := range []string{"a", "b", "c"} {
+		assert.True(t, strings.HasPrefix(rows[i], fmt.Sprintf("%d|%s|insert|", i+1, name)), rows[i])
+	}
+
+	// 更新产生前像和后像，版本号为 UPDATE 的提交版本
+	rows = tableChanges(t, exec, sess, fmt.Sprintf("'t', %d, %d", updateVersion, updateVersion))
+	assert.Equal(t, []string{
+		fmt.Sprintf("2|bb|update_postimage|%d|", updateVersion),
+		fmt.Sprintf("2|b|update_preimage|%d|", updateVersion),
+	}, rows)
+
+	// 删除；省略结束版本时读到最新版本
+	rows = tableChanges(t, exec, sess, fmt.Sprintf("'t', %d", deleteVersion))
+	assert.Equal(t, []string{fmt.Sprintf("1|a|delete|%d|", deleteVersion)}, rows)
+
+	// 全部区间
+	rows = tableChanges(t, exec, sess, fmt.Sprintf("'t', %d", start))
+	assert.Len(t, rows, 6)
+
+	// 结果可以像普通表一样过滤，并带有提交时间
+	result, err := execSQL(t, exec, sess, fmt.Sprintf(
+		"SELECT * FROM table_changes('t', %d) WHERE _change_type = 'delete'", start))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name", "_change_type", "_commit_version", "_commit_timestamp"}, result.Headers)
+	rows = resultRows(result)
+	require.Len(t, rows, 1)
+	parts := strings.Split(rows[0], "|")
+	assert.NotEqual(t, "0", parts[4], "commit timestamp should be set")
+}
+
+// TestTableChangesSkipsCompaction compaction 提交 (dataChange=false) 不产生变更
+func TestTableChangesSkipsCompaction(t *testing.T) {
+	dir := SetupTestDir(t, "table_changes_compaction")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	start := deltaLog.GetLatestVersion() + 1
+	for i := 1; i <= 4; i++ {
+		_, err = execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO t VALUES (%d, 'n%d')", i, i))
+		require.NoError(t, err)
+	}
+	beforeCompaction := deltaLog.GetLatestVersion()
+
+	compactor := optimizer.NewCompactor(&optimizer.CompactionConfig{
+		TargetFileSize:    1024 * 1024,
+		MinFileSize:       1024 * 1024,
+		MaxFilesToCompact: 10,
+	})
+	require.NoError(t, compactor.CompactTable("default.t", engine))
+	require.Greater(t, deltaLog.GetLatestVersion(), beforeCompaction, "compaction should commit")
+
+	// compaction 之后的版本区间没有变更
+	rows := tableChanges(t, exec, sess, fmt.Sprintf("'t', %d", beforeCompaction+1))
+	assert.Empty(t, rows)
+
+	// 完整区间中每行只出现一次
+	rows = tableChanges(t, exec, sess, fmt.Sprintf("'t', %d", start))
+	require.Len(t, rows, 4)
+	for i, row := range rows {
+		assert.True(t, strings.HasPrefix(row, fmt.Sprintf("%d|n%d|insert|", i+1, i+1)), row)
+	}
+}
+
+// TestTableChangesErrors 参数错误和不存在的表
+func TestTableChangesErrors(t *testing.T) {
+	dir := SetupTestDir(t, "table_changes_errors")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT)")
+	require.NoError(t, err)
+
+	for _, sql := range []string{
+		"SELECT * FROM table_changes('missing', 0)",
+		"SELECT * FROM table_changes('t', 5, 2)",
+		"SELECT * FROM table_changes('t', -1)",
+		"SELECT * FROM table_changes('t')",
+		"SELECT * FROM table_changes(1, 2)",
+		"SELECT * FROM table_changes('t', 'x')",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		assert.Error(t, err, sql)
+	}
+}
@@ -0,0 +1,203 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/cli"
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+// setupCLITest 打开嵌入式数据库，返回输出到缓冲区的 Shell
+func setupCLITest(t *testing.T, name string) (*minidb.Conn, *cli.Shell, *bytes.Buffer) {
+	db, err := minidb.Open(SetupTestDir(t, name))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	var out bytes.Buffer
+	return conn, cli.NewShell(conn, &out, &out), &out
+}
+
+// TestCLISplitter 语句以引号和注释之外的 ';' 分隔，可以跨行
+func TestCLISplitter(t *testing.T) {
+	var s cli.Splitter
+	assert.Equal(t, []string{"SELECT 1", "SELECT 2"}, s.Feed("SELECT 1; SELECT 2;"))
+	assert.False(t, s.Pending())
+
+	assert.Empty(t, s.Feed("INSERT INTO t VALUES ('a;"))
+	assert.True(t, s.Pending())
+	assert.Equal(t, []string{"INSERT INTO t VALUES ('a;\nb')"}, s.Feed("b');"))
+
+	assert.Empty(t, s.Feed("SELECT /* ; */ id -- trailing ;"))
+	assert.True(t, s.Pending())
+	assert.Equal(t, []string{"SELECT /* ; */ id -- trailing ;\nFROM t"}, s.Feed("FROM t;"))
+
+	// 只有注释的语句被跳过
+	assert.Empty(t, s.Feed("-- comment"))
+	assert.False(t, s.Pending())
+	assert.Empty(t, s.Feed("/* c */;"))
+
+	s.Feed("SELECT")
+	s.Reset()
+	assert.False(t, s.Pending())
+}
+
+// TestCLIScript 脚本执行：多行语句、元命令、各种输出格式、\i 和遇到错误时停止
+func TestCLIScript(t *testing.T) {
+	_, shell, out := setupCLITest(t, "cli_script")
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	include := filepath.Join(dir, "seed.sql")
+	require.NoError(t, os.WriteFile(include, []byte("INSERT INTO items VALUES (1, 'pen', 1.5);\nINSERT INTO items VALUES (2, 'ink', 3.0);\n"), 0644))
+
+	script := strings.Join([]string{
+		"-- schema",
+		"CREATE DATABASE shop;",
+		"USE shop;",
+		"CREATE TABLE items (",
+		"  id INT, name VARCHAR, price DOUBLE",
+		");",
+		`\i ` + include,
+		"SELECT id, name FROM items",
+	}, "\n")
+	require.NoError(t, shell.Run(ctx, strings.NewReader(script), "script.sql"))
+	assert.Equal(t, "OK\nOK\nOK\nOK\nOK\n", out.String()[:15])
+	assert.Contains(t, out.String(), "| 1               | pen             |")
+	assert.Contains(t, out.String(), "2 rows in set")
+
+	cases := []struct {
+		format string
+		want   string
+	}{
+		{cli.FormatCSV, "id,name\n1,pen\n2,ink\n"},
+		{cli.FormatJSON, "[\n  {\"id\": 1, \"name\": \"pen\"},\n  {\"id\": 2, \"name\": \"ink\"}\n]\n"},
+		{cli.FormatVertical, "*************************** 1. row ***************************\n  id: 1\nname: pen\n" +
+			"*************************** 2. row ***************************\n  id: 2\nname: ink\n2 rows in set\n"},
+	}
+	for _, tc := range cases {
+		out.Reset()
+		shell.Format = tc.format
+		require.NoError(t, shell.Execute(ctx, "SELECT id, name FROM items"))
+		assert.Equal(t, tc.want, out.String(), tc.format)
+	}
+
+	// 元命令
+	out.Reset()
+	shell.Format = cli.FormatTable
+	require.NoError(t, shell.Run(ctx, strings.NewReader("\\x\n\\timing on\nSELECT name FROM items WHERE id = 2;\n\\x\n\\q\nSELECT 1;"), "meta"))
+	assert.Contains(t, out.String(), "Output format is vertical.")
+	assert.Contains(t, out.String(), "name: ink")
+	assert.Contains(t, out.String(), "Time: ")
+	assert.Equal(t, cli.FormatTable, shell.Format)
+	assert.True(t, shell.Timing)
+
+	// 第一个错误停止执行，错误带有位置
+	out.Reset()
+	shell.Timing = false
+	err := shell.Run(ctx, strings.NewReader("SELECT id FROM items;\nSELEC id FROM items;\nDROP TABLE items;"), "bad.sql")
+	assert.ErrorContains(t, err, "bad.sql:2: parsing error")
+	require.NoError(t, shell.Execute(ctx, "SELECT COUNT(*) AS n FROM items"))
+	assert.Contains(t, out.String(), "| 2               |")
+
+	assert.ErrorContains(t, shell.Run(ctx, strings.NewReader(`\format xml`), "fmt"), `unknown format "xml"`)
+	assert.ErrorContains(t, shell.Run(ctx, strings.NewReader(`\nope`), "x"), "unknown command")
+}
+
+// TestCLICompletion 补全关键字、元命令以及系统表中的表名和列名
+func TestCLICompletion(t *testing.T) {
+	conn, _, _ := setupCLITest(t, "cli_completion")
+	ctx := context.Background()
+	for _, sql := range []string{
+		"CREATE DATABASE shop",
+		"USE shop",
+		"CREATE TABLE orders (order_id INT, customer VARCHAR)",
+		"CREATE TABLE order_items (order_id INT, sku VARCHAR)",
+	} {
+		require.NoError(t, conn.Exec(ctx, sql), sql)
+	}
+
+	completer := cli.NewCompleter()
+	start, candidates := completer.Complete("SEL")
+	assert.Equal(t, 0, start)
+	assert.Equal(t, []string{"SELECT"}, candidates)
+	_, candidates = completer.Complete("sel")
+	assert.Equal(t, []string{"select"}, candidates)
+	_, candidates = completer.Complete("SELECT * FROM orde")
+	assert.Equal(t, []string{"order"}, candidates)
+
+	completer.Refresh(ctx, conn)
+	start, candidates = completer.Complete("SELECT * FROM orde")
+	assert.Equal(t, 14, start)
+	assert.Equal(t, []string{"order", "order_id", "order_items", "orders"}, candidates)
+	_, candidates = completer.Complete("SELECT cust")
+	assert.Equal(t, []string{"customer"}, candidates)
+	_, candidates = completer.Complete("SELECT * FROM shop.order_")
+	assert.Equal(t, []string{"shop.order_items"}, candidates)
+
+	start, candidates = completer.Complete(`\ti`)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, []string{`\timing`}, candidates)
+}
+
+// TestCLIHistory 历史合并多行语句、忽略重复并保留最近的条目
+func TestCLIHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+	h := cli.LoadHistory(path, 2)
+	h.Add("SELECT *\n  FROM t;")
+	h.Add("SELECT * FROM t;")
+	h.Add("SHOW TABLES;")
+	h.Add("SHOW DATABASES;")
+	require.NoError(t, h.Save())
+
+	loaded := cli.LoadHistory(path, 10)
+	assert.Equal(t, []string{"SHOW TABLES;", "SHOW DATABASES;"}, loaded.Entries())
+}
+
+// TestCLIBinary 非交互模式：-e、-f 和标准输入，出错时退出码为 1
+func TestCLIBinary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the minidb-cli binary")
+	}
+	binary := filepath.Join(t.TempDir(), "minidb-cli")
+	build := exec.Command("go", "build", "-o", binary, "../cmd/minidb-cli")
+	buildOutput, err := build.CombinedOutput()
+	require.NoError(t, err, string(buildOutput))
+
+	dataDir := SetupTestDir(t, "cli_binary")
+	run := func(stdin string, args ...string) (string, error) {
+		cmd := exec.Command(binary, append([]string{"-data-dir", dataDir}, args...)...)
+		cmd.Stdin = strings.NewReader(stdin)
+		output, err := cmd.CombinedOutput()
+		return string(output), err
+	}
+
+	script := filepath.Join(t.TempDir(), "setup.sql")
+	require.NoError(t, os.WriteFile(script, []byte("CREATE DATABASE ops;\nUSE ops;\nCREATE TABLE jobs (id INT, name VARCHAR);\nINSERT INTO jobs VALUES (1, 'backup');\n"), 0644))
+	output, err := run("", "-f", script)
+	require.NoError(t, err, output)
+
+	output, err = run("", "-database", "ops", "-format", "csv", "-e", "SELECT id, name FROM jobs")
+	require.NoError(t, err, output)
+	assert.Equal(t, "id,name\n1,backup\n", output)
+
+	output, err = run("USE ops;\nSELECT name FROM jobs;\n", "-format", "json")
+	require.NoError(t, err, output)
+	assert.Contains(t, output, `{"name": "backup"}`)
+
+	output, err = run("", "-e", "SELEC 1")
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 1, exitErr.ExitCode())
+	assert.Contains(t, output, "ERROR: -e:1: parsing error")
+}
@@ -257,7 +257,10 @@ func TestCompactionWithDataChange(t *testing.T) {
 		if entry.Version > initialVersion && entry.Operation == delta.OpAdd {
 			// Compaction entries should have dataChange=false
 			foundCompactionEntry = true
-			// Note: In actual implementation, we should verify entry.DataChange == false
+			assert.False(t, entry.DataChange, "compacted file %s should not be a data change", entry.FilePath)
+		}
+		if entry.Version > initialVersion && entry.Operation == delta.OpRemove {
+			assert.False(t, entry.DataChange, "replaced file %s should not be a data change", entry.FilePath)
 		}
 	}
 	assert.True(t, foundCompactionEntry, "Should find compaction entries")
@@ -0,0 +1,258 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/parser"
+)
+
+// writeCopyFile 在目录中写入一个待导入的文本文件
+func writeCopyFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+// TestCopyStatementParse COPY 语句解析
+func TestCopyStatementParse(t *testing.T) {
+	node, err := parser.Parse("COPY people (id, name) FROM '/data/*.csv' WITH (format csv, header true, delimiter ';')")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.CopyStmt)
+	require.True(t, ok, "expected CopyStmt, got %T", node)
+	assert.Equal(t, "people", stmt.Table)
+	assert.Equal(t, []string{"id", "name"}, stmt.Columns)
+	assert.Equal(t, parser.CopyFrom, stmt.Direction)
+	assert.Equal(t, "/data/*.csv", stmt.Path)
+	assert.Equal(t, map[string]string{"format": "csv", "header": "true", "delimiter": ";"}, stmt.Options)
+
+	node, err = parser.Parse("COPY (SELECT id FROM people WHERE id > 1) TO '/tmp/out.parquet' WITH (format = parquet)")
+	require.NoError(t, err)
+	stmt = node.(*parser.CopyStmt)
+	assert.Equal(t, parser.CopyTo, stmt.Direction)
+	require.NotNil(t, stmt.Query)
+	assert.NotNil(t, stmt.Query.Where)
+
+	node, err = parser.Parse("COPY people TO '/tmp/out.csv'")
+	require.NoError(t, err)
+	stmt = node.(*parser.CopyStmt)
+	require.NotNil(t, stmt.Query, "COPY t TO exports SELECT * FROM t")
+
+	for _, bad := range []string{
+		"COPY people FROM /data/x.csv",
+		"COPY (SELECT * FROM people) FROM '/data/x.csv'",
+		"COPY people (id) TO '/tmp/out.csv'",
+		"COPY people FROM '/data/x.csv' WITH (header true, header false)",
+	} {
+		_, err := parser.Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// TestCopyFromCSV CSV 导入: 表头匹配列、NULL、拒绝行报告和 max_errors
+func TestCopyFromCSV(t *testing.T) {
+	dir := SetupTestDir(t, "copy_csv")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE people (id INT, name VARCHAR, score DOUBLE, active BOOLEAN)")
+	require.NoError(t, err)
+
+	input := t.TempDir()
+	writeCopyFile(t, input, "a.csv", "name,id,active,score\nalice,1,true,9.5\n,2,false,8\n")
+	writeCopyFile(t, input, "b.csv", "name,id,active,score\ncarol,x,true,1\ndave,4,true,7\n")
+
+	// 默认不允许拒绝行，导入中止且没有数据写入
+	_, err = execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "*.csv")+"' WITH (format csv, header true)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "b.csv:2")
+	assert.Contains(t, err.Error(), "invalid INT value 'x'")
+	assert.Equal(t, int64(0), countEngineRows(t, engine, "default", "people"))
+
+	result, err := execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "*.csv")+"' WITH (format csv, header true, max_errors 1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rows_loaded", "rows_rejected", "files", "errors"}, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	assert.True(t, strings.HasPrefix(rows[0], "3|1|2|b.csv:2: column 'id': invalid INT value 'x'"), rows[0])
+
+	result, err = execSQL(t, exec, sess, "SELECT id, name, score, active FROM people ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|9.5|true|", "2||8|false|", "4|dave|7|true|"}, resultRows(result))
+
+	// 显式列 + 无表头 + 自定义分隔符，未导入的列为 NULL
+	writeCopyFile(t, input, "c.txt", "10;zed\n11;NULL\n")
+	_, err = execSQL(t, exec, sess, "COPY people (id, name) FROM '"+filepath.Join(input, "c.txt")+"' WITH (format csv, delimiter ';', null 'NULL')")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec, sess, "SELECT id, name FROM people WHERE id >= 10 ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10|zed|", "11||"}, resultRows(result))
+
+	// 模式校验: 表头中的未知列、错误的字段数
+	writeCopyFile(t, input, "bad_header.csv", "id,nickname\n1,x\n")
+	_, err = execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "bad_header.csv")+"' WITH (header true)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column 'nickname' does not exist")
+
+	writeCopyFile(t, input, "short.csv", "1,x\n")
+	_, err = execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "short.csv")+"'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected 4 fields, got 2")
+
+	_, err = execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "none-*.csv")+"'")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "COPY people FROM '"+filepath.Join(input, "a.csv")+"' WITH (compression 'gzip')")
+	assert.Error(t, err, "unknown options are rejected")
+}
+
+// TestCopyFromJSONLines NDJSON 导入按键匹配列，嵌套值保存为 JSON 文本
+func TestCopyFromJSONLines(t *testing.T) {
+	dir := SetupTestDir(t, "copy_json")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE events (id INT, kind VARCHAR, payload VARCHAR)")
+	require.NoError(t, err)
+
+	input := t.TempDir()
+	path := writeCopyFile(t, input, "events.ndjson", strings.Join([]string{
+		`{"id": 1, "kind": "click", "payload": {"x": 1}}`,
+		``,
+		`{"id": 2, "kind": null}`,
+		`{"id": 3, "color": "red"}`,
+		`not json`,
+		`{"id": 4.0, "kind": "view"}`,
+	}, "\n"))
+
+	result, err := execSQL(t, exec, sess, "COPY events FROM '"+path+"' WITH (max_errors 5)")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	assert.True(t, strings.HasPrefix(rows[0], "3|2|1|"), rows[0])
+	assert.Contains(t, rows[0], "events.ndjson:4: unknown column 'color'")
+	assert.Contains(t, rows[0], "events.ndjson:5: invalid JSON")
+
+	result, err = execSQL(t, exec, sess, "SELECT id, kind, payload FROM events ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{`1|click|{"x":1}|`, "2|||", "4|view||"}, resultRows(result))
+}
+
+// TestCopyRoundTrip COPY TO 导出 CSV / NDJSON / Parquet，再导入到新表
+func TestCopyRoundTrip(t *testing.T) {
+	dir := SetupTestDir(t, "copy_roundtrip")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE src (id INT, name VARCHAR, price DOUBLE)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO src VALUES (1, 'apple', 1.5), (2, 'pear, green', 2.25), (3, 'fig', 3.0)")
+	require.NoError(t, err)
+
+	out := t.TempDir()
+	csvPath := filepath.Join(out, "src.csv")
+	result, err := execSQL(t, exec, sess, "COPY src TO '"+csvPath+"' WITH (header true)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3|"}, resultRows(result))
+	data, err := os.ReadFile(csvPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "id,name,price\n")
+	assert.Contains(t, string(data), `2,"pear, green",2.25`)
+
+	jsonPath := filepath.Join(out, "cheap.jsonl")
+	_, err = execSQL(t, exec, sess, "COPY (SELECT id, name FROM src WHERE price < 3) TO '"+jsonPath+"'")
+	require.NoError(t, err)
+	data, err = os.ReadFile(jsonPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.ElementsMatch(t, []string{`{"id":1,"name":"apple"}`, `{"id":2,"name":"pear, green"}`}, lines)
+
+	parquetPath := filepath.Join(out, "src.parquet")
+	_, err = execSQL(t, exec, sess, "COPY src TO '"+parquetPath+"' WITH (format parquet)")
+	require.NoError(t, err)
+
+	// 导入: 按目标文件大小切分为多个数据文件
+	for _, table := range []string{"from_csv", "from_parquet"} {
+		_, err = execSQL(t, exec, sess, "CREATE TABLE "+table+" (id INT, name VARCHAR, price DOUBLE)")
+		require.NoError(t, err)
+	}
+	_, err = execSQL(t, exec, sess, "COPY from_csv FROM '"+csvPath+"' WITH (header true)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COPY from_parquet FROM '"+parquetPath+"' WITH (target_file_size 20)")
+	require.NoError(t, err)
+
+	for _, table := range []string{"from_csv", "from_parquet"} {
+		result, err = execSQL(t, exec, sess, "SELECT id, name, price FROM "+table+" ORDER BY id")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1|apple|1.5|", "2|pear, green|2.25|", "3|fig|3|"}, resultRows(result), table)
+	}
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.from_csv", -1)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Files, 1, "small imports are written as a single file")
+	snapshot, err = engine.GetDeltaLog().GetSnapshot("default.from_parquet", -1)
+	require.NoError(t, err)
+	assert.Greater(t, len(snapshot.Files), 1, "imports are split into target-sized files")
+
+	// Parquet 文件中的列必须存在于目标表
+	_, err = execSQL(t, exec, sess, "CREATE TABLE narrow (id INT)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COPY narrow FROM '"+parquetPath+"'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column 'name' does not exist")
+}
+
+// TestCopyFromIsAtomic COPY 写出的所有数据文件在同一个版本中提交；中止时删除已写出的文件，表中不留下部分数据
+func TestCopyFromIsAtomic(t *testing.T) {
+	dir := SetupTestDir(t, "copy_atomic")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE sales (id INT, region VARCHAR) PARTITION BY LIST (region)")
+	require.NoError(t, err)
+
+	input := t.TempDir()
+	writeCopyFile(t, input, "a.csv", "1,eu\n2,us\n3,eu\n4,us\n")
+	writeCopyFile(t, input, "b.csv", "5,eu\nx,us\n")
+
+	dataFiles := func() []string {
+		var files []string
+		require.NoError(t, filepath.Walk(filepath.Join(dir, "default", "sales"), func(path string, info os.FileInfo, err error) error {
+			if err == nil && strings.HasSuffix(path, ".parquet") {
+				files = append(files, path)
+			}
+			return err
+		}))
+		return files
+	}
+
+	// b.csv 的拒绝行使导入中止: a.csv 已经写出的文件被删除，表中没有数据，也没有新的提交
+	before := engine.GetDeltaLog().GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "COPY sales FROM '"+filepath.Join(input, "*.csv")+"' WITH (target_file_size 1)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no rows were loaded")
+	assert.Equal(t, int64(0), countEngineRows(t, engine, "default", "sales"))
+	assert.Empty(t, dataFiles(), "uncommitted files are removed")
+	assert.Equal(t, before, engine.GetDeltaLog().GetLatestVersion())
+
+	// 成功的导入写出多个文件 (按目标大小和分区拆分)，所有 ADD 属于同一个版本
+	result, err := execSQL(t, exec, sess, "COPY sales FROM '"+filepath.Join(input, "a.csv")+"' WITH (target_file_size 1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4|0|1||"}, resultRows(result))
+
+	var adds []int64
+	for _, entry := range engine.GetDeltaLog().GetEntriesByTable("default.sales") {
+		if entry.Operation == "ADD" {
+			adds = append(adds, entry.Version)
+		}
+	}
+	require.Len(t, adds, 4)
+	for _, version := range adds {
+		assert.Equal(t, adds[0], version, "all files of one COPY are committed in one version")
+	}
+	assert.Len(t, dataFiles(), 4)
+	assert.Equal(t, int64(4), countEngineRows(t, engine, "default", "sales"))
+}
@@ -0,0 +1,320 @@
+package test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// readDeltaCommit 读取 _delta_log 中的一个 JSON 提交，返回每行 action 的类型和内容
+func readDeltaCommit(t *testing.T, location string, version int) []map[string]map[string]interface{} {
+	f, err := os.Open(filepath.Join(location, "_delta_log", fmt.Sprintf("%020d.json", version)))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var actions []map[string]map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1<<20), 1<<20)
+	for scanner.Scan() {
+		var action map[string]map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &action))
+		require.Len(t, action, 1, "each line holds exactly one action")
+		actions = append(actions, action)
+	}
+	require.NoError(t, scanner.Err())
+	return actions
+}
+
+// deltaActionsOf 返回提交中指定类型的 action
+func deltaActionsOf(actions []map[string]map[string]interface{}, kind string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, action := range actions {
+		if a, ok := action[kind]; ok {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// exportDelta 执行 EXPORT TABLE ... TO DELTA，返回 version|files_added|files_removed 和导出目录
+func exportDelta(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, table string) (string, string) {
+	result, err := execSQL(t, exec, sess, "EXPORT TABLE "+table+" TO DELTA")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	parts := strings.Split(rows[0], "|")
+	return strings.Join(parts[:3], "|"), parts[3]
+}
+
+// TestDeltaExportImportParse EXPORT / IMPORT TABLE 语句解析
+func TestDeltaExportImportParse(t *testing.T) {
+	node, err := parser.Parse("EXPORT TABLE sales.orders TO DELTA")
+	require.NoError(t, err)
+	export, ok := node.(*parser.ExportTableStmt)
+	require.True(t, ok, "expected ExportTableStmt, got %T", node)
+	assert.Equal(t, "sales.orders", export.Table)
+	assert.Equal(t, parser.TableFormatDelta, export.Format)
+
+	node, err = parser.Parse("import table orders from delta '/lake/orders'")
+	require.NoError(t, err)
+	imp, ok := node.(*parser.ImportTableStmt)
+	require.True(t, ok, "expected ImportTableStmt, got %T", node)
+	assert.Equal(t, "orders", imp.Table)
+	assert.Equal(t, "/lake/orders", imp.Path)
+
+	for _, bad := range []string{
+		"EXPORT TABLE orders TO ICEBERG",
+		"EXPORT TABLE orders",
+		"IMPORT TABLE orders FROM DELTA",
+		"IMPORT TABLE orders FROM DELTA '/lake' extra",
+	} {
+		_, err := parser.Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// TestExportTableToDelta 导出的提交包含 protocol / metaData / add (带统计信息)，再次导出只写出差异
+func TestExportTableToDelta(t *testing.T) {
+	dir := SetupTestDir(t, "delta_export")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE orders (id INT, customer VARCHAR, amount DOUBLE)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO orders VALUES (1, 'ann', 10.5), (2, 'bob', 20.0), (3, 'cy', 7.25)")
+	require.NoError(t, err)
+
+	// 每个 VALUES 行写成一个数据文件
+	summary, location := exportDelta(t, exec, sess, "orders")
+	assert.Equal(t, "0|3|0", summary)
+
+	actions := readDeltaCommit(t, location, 0)
+	protocol := deltaActionsOf(actions, "protocol")
+	require.Len(t, protocol, 1)
+	assert.EqualValues(t, 1, protocol[0]["minReaderVersion"])
+	assert.EqualValues(t, 2, protocol[0]["minWriterVersion"])
+
+	metaData := deltaActionsOf(actions, "metaData")
+	require.Len(t, metaData, 1)
+	assert.Equal(t, map[string]interface{}{"provider": "parquet", "options": map[string]interface{}{}}, metaData[0]["format"])
+	var schema struct {
+		Type   string `json:"type"`
+		Fields []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(metaData[0]["schemaString"].(string)), &schema))
+	assert.Equal(t, "struct", schema.Type)
+	require.Len(t, schema.Fields, 3)
+	assert.Equal(t, "long", schema.Fields[0].Type)
+	assert.Equal(t, "string", schema.Fields[1].Type)
+	assert.Equal(t, "double", schema.Fields[2].Type)
+
+	adds := deltaActionsOf(actions, "add")
+	require.Len(t, adds, 3)
+	addPaths := make(map[int64]string)
+	for _, add := range adds {
+		addPath := add["path"].(string)
+		assert.False(t, filepath.IsAbs(addPath), "data files are referenced relative to the table root")
+		info, err := os.Stat(filepath.Join(location, filepath.FromSlash(addPath)))
+		require.NoError(t, err)
+		assert.EqualValues(t, info.Size(), add["size"])
+		assert.Equal(t, true, add["dataChange"])
+
+		var stats struct {
+			NumRecords int64                  `json:"numRecords"`
+			MinValues  map[string]interface{} `json:"minValues"`
+			MaxValues  map[string]interface{} `json:"maxValues"`
+			NullCount  map[string]int64       `json:"nullCount"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(add["stats"].(string)), &stats))
+		assert.EqualValues(t, 1, stats.NumRecords)
+		assert.Equal(t, stats.MinValues["id"], stats.MaxValues["id"])
+		assert.EqualValues(t, 0, stats.NullCount["id"])
+		addPaths[int64(stats.MinValues["id"].(float64))] = addPath
+		if stats.MinValues["id"] == float64(1) {
+			assert.Equal(t, "ann", stats.MinValues["customer"])
+			assert.EqualValues(t, 10.5, stats.MaxValues["amount"])
+		}
+	}
+	require.Len(t, addPaths, 3)
+	assert.Len(t, deltaActionsOf(actions, "commitInfo"), 1)
+
+	// 没有变化时不生成新版本
+	summary, _ = exportDelta(t, exec, sess, "orders")
+	assert.Equal(t, "0|0|0", summary)
+	_, err = os.Stat(filepath.Join(location, "_delta_log", fmt.Sprintf("%020d.json", 1)))
+	assert.True(t, os.IsNotExist(err))
+
+	// DELETE (Copy-on-Write) 把剩余的行改写为一个新文件: 下一个版本移除旧文件并加入新文件
+	_, err = execSQL(t, exec, sess, "DELETE FROM orders WHERE id = 2")
+	require.NoError(t, err)
+	summary, _ = exportDelta(t, exec, sess, "orders")
+	assert.Equal(t, "1|1|3", summary)
+	actions = readDeltaCommit(t, location, 1)
+	assert.Empty(t, deltaActionsOf(actions, "protocol"))
+	assert.Empty(t, deltaActionsOf(actions, "metaData"), "metaData is only rewritten when the schema changes")
+	removes := deltaActionsOf(actions, "remove")
+	require.Len(t, removes, 3)
+	removed := make([]string, len(removes))
+	for i, remove := range removes {
+		removed[i] = remove["path"].(string)
+	}
+	assert.ElementsMatch(t, []string{addPaths[1], addPaths[2], addPaths[3]}, removed)
+	require.Len(t, deltaActionsOf(actions, "add"), 1)
+
+	// 导出的 Delta 表可以重新导入
+	result, err := execSQL(t, exec, sess, "IMPORT TABLE orders_copy FROM DELTA '"+location+"'")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|1|1|"}, resultRows(result))
+	result, err = execSQL(t, exec, sess, "SELECT id, customer, amount FROM orders_copy ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|ann|10.5|", "3|cy|7.25|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "EXPORT TABLE missing TO DELTA")
+	assert.Error(t, err)
+}
+
+// TestDeltaExportCheckpoint 每 10 个版本写出 Parquet checkpoint，导入时从 checkpoint 开始回放
+func TestDeltaExportCheckpoint(t *testing.T) {
+	dir := SetupTestDir(t, "delta_checkpoint")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE events (id INT, kind VARCHAR)")
+	require.NoError(t, err)
+
+	var location string
+	for i := 0; i < 12; i++ {
+		_, err = execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO events VALUES (%d, 'k%d')", i, i%3))
+		require.NoError(t, err)
+		var summary string
+		summary, location = exportDelta(t, exec, sess, "events")
+		assert.Equal(t, fmt.Sprintf("%d|1|0", i), summary)
+	}
+
+	logDir := filepath.Join(location, "_delta_log")
+	_, err = os.Stat(filepath.Join(logDir, fmt.Sprintf("%020d.checkpoint.parquet", 10)))
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(logDir, "_last_checkpoint"))
+	require.NoError(t, err)
+	var last struct {
+		Version int64 `json:"version"`
+		Size    int64 `json:"size"`
+	}
+	require.NoError(t, json.Unmarshal(data, &last))
+	assert.EqualValues(t, 10, last.Version)
+	assert.EqualValues(t, 13, last.Size, "protocol + metaData + 11 add actions")
+
+	// 删除 checkpoint 之前的提交，导入只能依赖 checkpoint
+	for v := 0; v <= 10; v++ {
+		require.NoError(t, os.Remove(filepath.Join(logDir, fmt.Sprintf("%020d.json", v))))
+	}
+	result, err := execSQL(t, exec, sess, "IMPORT TABLE events_copy FROM DELTA '"+location+"'")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12|12|11|"}, resultRows(result))
+	result, err = execSQL(t, exec, sess, "SELECT COUNT(*) FROM events_copy")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12|"}, resultRows(result))
+
+	// 导出目录中的后续版本可以继续追加
+	_, err = execSQL(t, exec, sess, "INSERT INTO events VALUES (100, 'late')")
+	require.NoError(t, err)
+	summary, _ := exportDelta(t, exec, sess, "events")
+	assert.Equal(t, "12|1|0", summary)
+}
+
+// writeDeltaLog 写出手工构造的 Delta 提交
+func writeDeltaLog(t *testing.T, location string, version int, actions ...string) {
+	logDir := filepath.Join(location, "_delta_log")
+	require.NoError(t, os.MkdirAll(logDir, 0755))
+	content := strings.Join(actions, "\n") + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(logDir, fmt.Sprintf("%020d.json", version)), []byte(content), 0644))
+}
+
+// TestImportDeltaTable 导入外部作业写出的 Delta 表: 分区列来自 partitionValues，回放 remove，检查协议版本
+func TestImportDeltaTable(t *testing.T) {
+	dir := SetupTestDir(t, "delta_import")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	location := t.TempDir()
+	writeSparkParquet(t, filepath.Join(location, "dt=2024-01-01", "part-00000.parquet"), []int32{1, 2}, []string{"a", "b"})
+	writeSparkParquet(t, filepath.Join(location, "dt=2024-01-02", "part-00000.parquet"), []int32{3}, []string{"c"})
+	writeSparkParquet(t, filepath.Join(location, "dt=2024-01-02", "part-00001.parquet"), []int32{4, 5}, []string{"d", "e"})
+	writeSparkParquet(t, filepath.Join(location, "part-null.parquet"), []int32{6}, []string{"f"})
+
+	schemaString := `{"type":"struct","fields":[` +
+		`{"name":"id","type":"integer","nullable":true,"metadata":{}},` +
+		`{"name":"name","type":"string","nullable":true,"metadata":{}},` +
+		`{"name":"amount","type":"float","nullable":true,"metadata":{}},` +
+		`{"name":"dt","type":"date","nullable":true,"metadata":{}}]}`
+	metaData, err := json.Marshal(map[string]interface{}{"metaData": map[string]interface{}{
+		"id": "3f1d5c9e-0000-4000-8000-000000000001", "format": map[string]interface{}{"provider": "parquet", "options": map[string]string{}},
+		"schemaString": schemaString, "partitionColumns": []string{"dt"}, "configuration": map[string]string{}, "createdTime": 1700000000000,
+	}})
+	require.NoError(t, err)
+	writeDeltaLog(t, location, 0,
+		`{"commitInfo":{"timestamp":1700000000000,"operation":"WRITE"}}`,
+		`{"protocol":{"minReaderVersion":1,"minWriterVersion":2}}`,
+		string(metaData),
+		`{"add":{"path":"dt=2024-01-01/part-00000.parquet","partitionValues":{"dt":"2024-01-01"},"size":1,"modificationTime":1700000000000,"dataChange":true}}`,
+		`{"add":{"path":"dt=2024-01-02/part-00000.parquet","partitionValues":{"dt":"2024-01-02"},"size":1,"modificationTime":1700000000000,"dataChange":true}}`)
+	writeDeltaLog(t, location, 1,
+		`{"remove":{"path":"dt=2024-01-02/part-00000.parquet","deletionTimestamp":1700000001000,"dataChange":true}}`,
+		`{"add":{"path":"dt%3D2024-01-02/part-00001.parquet","partitionValues":{"dt":"2024-01-02"},"size":1,"modificationTime":1700000001000,"dataChange":true}}`,
+		`{"add":{"path":"part-null.parquet","partitionValues":{"dt":null},"size":1,"modificationTime":1700000001000,"dataChange":true}}`)
+
+	result, err := execSQL(t, exec, sess, "IMPORT TABLE lake FROM DELTA '"+location+"'")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5|3|1|"}, resultRows(result))
+
+	result, err = execSQL(t, exec, sess, "SELECT id, name, dt FROM lake ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|a|2024-01-01|", "2|b|2024-01-01|", "4|d|2024-01-02|", "5|e|2024-01-02|", "6|f|(null)|"}, resultRows(result))
+	result, err = execSQL(t, exec, sess, "SELECT SUM(amount) FROM lake WHERE id >= 4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7.5|"}, resultRows(result))
+
+	// 导入的数据由 MiniDB 管理: 删除源 Delta 表不影响查询
+	require.NoError(t, os.RemoveAll(location))
+	result, err = execSQL(t, exec, sess, "SELECT COUNT(*) FROM lake")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "IMPORT TABLE lake FROM DELTA '"+location+"'")
+	assert.Error(t, err)
+
+	// 不支持的读协议 (deletion vectors) 被拒绝，且不会留下表
+	unsupported := t.TempDir()
+	writeDeltaLog(t, unsupported, 0,
+		`{"protocol":{"minReaderVersion":3,"minWriterVersion":7,"readerFeatures":["deletionVectors"],"writerFeatures":["deletionVectors"]}}`,
+		string(metaData))
+	_, err = execSQL(t, exec, sess, "IMPORT TABLE dv FROM DELTA '"+unsupported+"'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deletionVectors")
+	_, err = execSQL(t, exec, sess, "SELECT * FROM dv")
+	assert.Error(t, err)
+
+	// 数据文件缺失时导入失败并删除已创建的表
+	broken := t.TempDir()
+	writeDeltaLog(t, broken, 0,
+		`{"protocol":{"minReaderVersion":1,"minWriterVersion":2}}`,
+		string(metaData),
+		`{"add":{"path":"missing.parquet","partitionValues":{},"size":1,"modificationTime":1700000000000,"dataChange":true}}`)
+	_, err = execSQL(t, exec, sess, "IMPORT TABLE broken FROM DELTA '"+broken+"'")
+	require.Error(t, err)
+	_, err = execSQL(t, exec, sess, "SELECT * FROM broken")
+	assert.Error(t, err)
+}
@@ -0,0 +1,342 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// snapshotPaths 返回快照中排序后的文件路径
+func snapshotPaths(snapshot *delta.Snapshot) []string {
+	paths := make([]string, 0, len(snapshot.Files))
+	for _, f := range snapshot.Files {
+		paths = append(paths, f.Path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// tableLogVersions 列出 sys.delta_log 中属于该表的日志文件版本号
+func tableLogVersions(t *testing.T, dir, tableID string) []int64 {
+	matches, err := filepath.Glob(filepath.Join(dir, "sys", "delta_log", "data", "*."+tableID+".parquet"))
+	require.NoError(t, err)
+	versions := make([]int64, 0, len(matches))
+	for _, match := range matches {
+		version, err := strconv.ParseInt(strings.SplitN(filepath.Base(match), ".", 2)[0], 10, 64)
+		require.NoError(t, err)
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// waitForCheckpoint 等待异步创建的 checkpoint 标记文件到达指定版本
+func waitForCheckpoint(t *testing.T, dir, tableID string, version int64) {
+	marker := filepath.Join(dir, "sys", "delta_log", "checkpoints", "_last_checkpoint."+tableID)
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(marker)
+		return err == nil && string(data) == strconv.FormatInt(version, 10)
+	}, 5*time.Second, 10*time.Millisecond, "checkpoint at version %d", version)
+}
+
+// TestIncrementalSnapshotState 最新快照来自增量状态，历史版本仍可回放
+func TestIncrementalSnapshotState(t *testing.T) {
+	dl := delta.NewDeltaLog()
+	require.NoError(t, dl.AppendMetadata("db.t", createTestSchema()))
+	for _, path := range []string{"a.parquet", "b.parquet"} {
+		require.NoError(t, dl.AppendAdd("db.t", &delta.ParquetFile{Path: path, RowCount: 1}))
+	}
+	require.NoError(t, dl.AppendRemove("db.t", "a.parquet"))
+	require.NoError(t, dl.AppendAdd("db.t", &delta.ParquetFile{Path: "c.parquet", RowCount: 1}))
+	require.NoError(t, dl.AppendAdd("db.other", &delta.ParquetFile{Path: "x.parquet", RowCount: 1}))
+
+	latest, err := dl.GetSnapshot("db.t", -1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), latest.Version)
+	assert.Equal(t, []string{"b.parquet", "c.parquet"}, snapshotPaths(latest))
+	require.NotNil(t, latest.Schema)
+	assert.Equal(t, 2, len(latest.Schema.Fields()))
+
+	v3, err := dl.GetSnapshot("db.t", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.parquet", "b.parquet"}, snapshotPaths(v3))
+	assert.NotNil(t, v3.Schema)
+
+	missing, err := dl.GetSnapshot("db.missing", -1)
+	require.NoError(t, err)
+	assert.Empty(t, missing.Files)
+
+	tables := dl.ListTables()
+	sort.Strings(tables)
+	assert.Equal(t, []string{"db.other", "db.t"}, tables)
+}
+
+// TestCompactEntries 压缩只保留重建当前状态所需的日志条目
+func TestCompactEntries(t *testing.T) {
+	dl := delta.NewDeltaLog()
+	require.NoError(t, dl.AppendMetadata("db.t", createTestSchema()))
+	require.NoError(t, dl.AppendAdd("db.t", &delta.ParquetFile{Path: "a.parquet", WALWatermark: 2}))
+	require.NoError(t, dl.AppendIndexMetadata("db.t", "idx_old", map[string]interface{}{"columns": "id"}))
+	require.NoError(t, dl.AppendIndexMetadata("db.t", "idx_keep", map[string]interface{}{"columns": "value"}))
+	require.NoError(t, dl.RemoveIndexMetadata("db.t", "idx_old"))
+	require.NoError(t, dl.AppendAdd("db.t", &delta.ParquetFile{
+		Path:  "b.parquet",
+		Stats: &delta.FileStats{MinValues: map[string]interface{}{"id": int64(1)}},
+	}))
+	require.NoError(t, dl.AppendRemove("db.t", "a.parquet"))
+	require.NoError(t, dl.AppendAdd("db.t", &delta.ParquetFile{Path: "c.parquet"}))
+
+	compacted := delta.CompactEntries("db.t", 7, dl.GetEntriesByTable("db.t"))
+	summary := make([]string, 0, len(compacted))
+	for _, entry := range compacted {
+		switch {
+		case entry.SchemaJSON != "":
+			summary = append(summary, fmt.Sprintf("%d:schema", entry.Version))
+		case entry.IndexJSON != "":
+			summary = append(summary, fmt.Sprintf("%d:index", entry.Version))
+		default:
+			summary = append(summary, fmt.Sprintf("%d:%s %s", entry.Version, entry.Operation, entry.FilePath))
+		}
+	}
+	// c.parquet (版本 8) 晚于压缩版本，不包含在内
+	assert.Equal(t, []string{"1:schema", "4:index", "6:ADD b.parquet"}, summary)
+	assert.Equal(t, int64(1), compacted[2].MinValues["id"])
+	assert.Contains(t, compacted[1].IndexJSON, "idx_keep")
+	// 刷写文件 a.parquet 已删除，写缓冲的 WAL 水位保留在 schema METADATA 上
+	assert.Equal(t, int64(2), compacted[0].WALWatermark)
+
+	// 已删除的表保留最后一条 REMOVE，恢复时仍识别为已删除
+	require.NoError(t, dl.AppendRemove("db.t", "b.parquet"))
+	require.NoError(t, dl.AppendRemove("db.t", "c.parquet"))
+	require.NoError(t, dl.AppendRemove("db.t", "_table_dropped_marker_db.t"))
+	dropped := delta.CompactEntries("db.t", dl.GetLatestVersion(), dl.GetEntriesByTable("db.t"))
+	last := dropped[len(dropped)-1]
+	assert.Equal(t, delta.OpRemove, last.Operation)
+	assert.Equal(t, "_table_dropped_marker_db.t", last.FilePath)
+}
+
+// TestCheckpointRecoveryReplaysLaterEntries 重启时加载 checkpoint 并只重放之后的日志，被覆盖的日志按保留策略清理
+func TestCheckpointRecoveryReplaysLaterEntries(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_checkpoint_recovery")
+	ctx := context.Background()
+	const tableID = "shop.items"
+
+	engine, err := storage.NewParquetEngine(dir, storage.WithCheckpointInterval(5), storage.WithLogRetention(0))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	require.NoError(t, engine.CreateDatabase("shop"))
+	require.NoError(t, engine.CreateTable("shop", "items", statsTestSchema)) // 版本 1
+
+	for i := 0; i < 12; i++ { // 版本 2-13
+		record := buildStatsRecord(i*10, 10)
+		require.NoError(t, engine.Write(ctx, "shop", "items", record))
+		record.Release()
+	}
+	snapshot, err := engine.GetDeltaLog().GetSnapshot(tableID, -1)
+	require.NoError(t, err)
+	paths := snapshotPaths(snapshot)
+	require.Len(t, paths, 12)
+	for _, path := range paths[:2] { // 版本 14-15，触发 checkpoint
+		require.NoError(t, engine.GetDeltaLog().AppendRemove(tableID, path))
+	}
+	waitForCheckpoint(t, dir, tableID, 15)
+
+	// 保留时长为 0：checkpoint 覆盖的日志文件全部清理
+	require.Eventually(t, func() bool {
+		versions := tableLogVersions(t, dir, tableID)
+		return len(versions) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for i := 12; i < 14; i++ { // 版本 16-17
+		record := buildStatsRecord(i*10, 10)
+		require.NoError(t, engine.Write(ctx, "shop", "items", record))
+		record.Release()
+	}
+	assert.Equal(t, []int64{16, 17}, tableLogVersions(t, dir, tableID))
+	before := snapshotStatsByPath(t, engine, tableID)
+	require.Len(t, before, 12)
+	require.NoError(t, engine.Close())
+
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+
+	dl := reopened.GetDeltaLog()
+	assert.Equal(t, int64(17), dl.GetLatestVersion())
+	after := snapshotStatsByPath(t, reopened, tableID)
+	require.Len(t, after, 12)
+	for path, f := range after {
+		require.Contains(t, before, path)
+		assertStatsBatch(t, f, int(f.MinValues["id"].(int64)), 10)
+	}
+
+	// 压缩后的日志：1 条 schema + 10 个有效文件 + checkpoint 之后的 2 条 ADD
+	assert.Len(t, dl.GetEntriesByTable(tableID), 13)
+
+	schema, err := reopened.GetTableSchema("shop", "items")
+	require.NoError(t, err)
+	assert.Equal(t, len(statsTestSchema.Fields()), len(schema.Fields()))
+
+	checkpoint, err := reopened.LoadLatestCheckpoint(tableID)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, int64(15), checkpoint.Version)
+	assert.Len(t, checkpoint.Files, 10)
+	assert.NotNil(t, checkpoint.Schema)
+
+	// checkpoint 之后的版本仍可时间旅行，更早的历史已被压缩
+	v16, err := dl.GetSnapshot(tableID, 16)
+	require.NoError(t, err)
+	assert.Len(t, v16.Files, 11)
+	_, err = dl.GetSnapshot(tableID, 10)
+	assert.Error(t, err)
+
+	assert.Equal(t, int64(120), countEngineRows(t, reopened, "shop", "items"))
+}
+
+// TestCheckpointRecoverySkipsCoveredLogs 保留期内的旧日志仍在磁盘上，但恢复时不会读取
+func TestCheckpointRecoverySkipsCoveredLogs(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_checkpoint_skip")
+	ctx := context.Background()
+	const tableID = "shop.orders"
+
+	engine, err := storage.NewParquetEngine(dir, storage.WithCheckpointInterval(5))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	require.NoError(t, engine.CreateDatabase("shop"))
+	require.NoError(t, engine.CreateTable("shop", "orders", createTestSchema()))
+	for i := 0; i < 6; i++ { // 版本 2-7，版本 5 触发 checkpoint
+		record := createP0TestRecord(t, createTestSchema(), i*10, 10)
+		require.NoError(t, engine.Write(ctx, "shop", "orders", record))
+		record.Release()
+	}
+	waitForCheckpoint(t, dir, tableID, 5)
+	require.NoError(t, engine.Close())
+
+	// 默认保留策略下被覆盖的日志仍保留
+	versions := tableLogVersions(t, dir, tableID)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, versions)
+
+	// 破坏被 checkpoint 覆盖的日志文件：恢复只重放版本 6、7，不受影响
+	for _, version := range versions[:5] {
+		path := filepath.Join(dir, "sys", "delta_log", "data", fmt.Sprintf("%020d.%s.parquet", version, tableID))
+		require.NoError(t, os.WriteFile(path, []byte("corrupted"), 0644))
+	}
+
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+
+	assert.Equal(t, int64(7), reopened.GetDeltaLog().GetLatestVersion())
+	snapshot, err := reopened.GetDeltaLog().GetSnapshot(tableID, -1)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Files, 6)
+	assert.Equal(t, int64(60), countEngineRows(t, reopened, "shop", "orders"))
+}
+
+// TestHistoryBeforeCheckpointAfterRestart 重启后 checkpoint 之前的版本从保留期内的日志重建，
+// table_changes 和 RESTORE TABLE 与重启前结果一致
+func TestHistoryBeforeCheckpointAfterRestart(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_history_restart")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	created := engine.GetDeltaLog().GetLatestVersion()
+	for i := 1; i <= 12; i++ { // 超过 DefaultCheckpointInterval，触发 checkpoint
+		_, err := execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO t VALUES (%d, 'n%d')", i, i))
+		require.NoError(t, err)
+	}
+	third := created + 3
+	waitForCheckpoint(t, dir, "default.t", created+9)
+	changesBefore := tableChanges(t, exec, sess, fmt.Sprintf("'t', %d, %d", created+1, third))
+	require.Len(t, changesBefore, 3)
+	require.NoError(t, engine.Close())
+
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+	assert.Equal(t, changesBefore, tableChanges(t, exec, sess, fmt.Sprintf("'t', %d, %d", created+1, third)))
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.t", third)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Files, 3)
+
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", third))
+	assert.Equal(t, []string{"1|n1|", "2|n2|", "3|n3|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+}
+
+// TestHistoryAfterExpiredLogs 日志被清理后只能回到清理版本之后仍保留的 checkpoint
+func TestHistoryAfterExpiredLogs(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_history_expired")
+	ctx := context.Background()
+	const tableID = "shop.items"
+	write := func(engine *storage.ParquetEngine, n int) {
+		for i := 0; i < n; i++ {
+			record := buildStatsRecord(i*10, 10)
+			require.NoError(t, engine.Write(ctx, "shop", "items", record))
+			record.Release()
+		}
+	}
+
+	// 保留时长为 0：版本 5 的 checkpoint 清理版本 1-5 的日志
+	engine, err := storage.NewParquetEngine(dir, storage.WithCheckpointInterval(5), storage.WithLogRetention(0))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	require.NoError(t, engine.CreateDatabase("shop"))
+	require.NoError(t, engine.CreateTable("shop", "items", statsTestSchema)) // 版本 1
+	write(engine, 6)                                                         // 版本 2-7
+	waitForCheckpoint(t, dir, tableID, 5)
+	require.Eventually(t, func() bool {
+		versions := tableLogVersions(t, dir, tableID)
+		return len(versions) > 0 && versions[0] == 6
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, engine.Close())
+
+	// 默认保留策略：版本 10 的 checkpoint 之后版本 5 的 checkpoint 和版本 6-10 的日志仍保留
+	engine, err = storage.NewParquetEngine(dir, storage.WithCheckpointInterval(5))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	write(engine, 5) // 版本 8-12
+	waitForCheckpoint(t, dir, tableID, 10)
+	require.NoError(t, engine.Close())
+
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+	dl := reopened.GetDeltaLog()
+
+	for version, files := range map[int64]int{5: 4, 7: 6, 9: 8, 12: 11} {
+		snapshot, err := dl.GetSnapshot(tableID, version)
+		require.NoError(t, err, "version %d", version)
+		assert.Len(t, snapshot.Files, files, "version %d", version)
+	}
+	_, err = dl.GetSnapshot(tableID, 4)
+	assert.ErrorContains(t, err, "has been compacted")
+}
+
+// countEngineRows 扫描表并统计行数
+func countEngineRows(t *testing.T, engine *storage.ParquetEngine, db, table string) int64 {
+	iter, err := engine.Scan(context.Background(), db, table, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	rows := int64(0)
+	for iter.Next() {
+		rows += iter.Record().NumRows()
+	}
+	require.NoError(t, iter.Err())
+	return rows
+}
@@ -0,0 +1,238 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+var statsTestSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "name", Type: arrow.BinaryTypes.String},
+	{Name: "price", Type: arrow.PrimitiveTypes.Float64},
+	{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, Nullable: true},
+}, nil)
+
+var statsTestEpoch = time.Date(2024, 3, 1, 12, 0, 0, 123456000, time.UTC)
+
+// buildStatsRecord 构造 id ∈ [start, start+n) 的批次，最后一行 ts 为 NULL
+func buildStatsRecord(start, n int) arrow.Record {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), statsTestSchema)
+	defer builder.Release()
+	for i := start; i < start+n; i++ {
+		builder.Field(0).(*array.Int64Builder).Append(int64(i))
+		builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("item_%03d", i))
+		builder.Field(2).(*array.Float64Builder).Append(float64(i) + 0.1)
+		if i == start+n-1 {
+			builder.Field(3).AppendNull()
+		} else {
+			ts, _ := arrow.TimestampFromTime(statsTestEpoch.Add(time.Duration(i)*time.Minute), arrow.Microsecond)
+			builder.Field(3).(*array.TimestampBuilder).Append(ts)
+		}
+	}
+	return builder.NewRecord()
+}
+
+// snapshotStatsByPath 以文件路径索引最新快照中的文件
+func snapshotStatsByPath(t *testing.T, engine *storage.ParquetEngine, tableID string) map[string]delta.FileInfo {
+	snapshot, err := engine.GetDeltaLog().GetSnapshot(tableID, -1)
+	require.NoError(t, err)
+	files := make(map[string]delta.FileInfo, len(snapshot.Files))
+	for _, f := range snapshot.Files {
+		files[f.Path] = f
+	}
+	return files
+}
+
+// assertStatsBatch 检查 [start, start+n) 批次文件的统计信息 (精确类型)
+func assertStatsBatch(t *testing.T, f delta.FileInfo, start, n int) {
+	assert.Equal(t, int64(start), f.MinValues["id"])
+	assert.Equal(t, int64(start+n-1), f.MaxValues["id"])
+	assert.Equal(t, fmt.Sprintf("item_%03d", start), f.MinValues["name"])
+	assert.Equal(t, float64(start)+0.1, f.MinValues["price"])
+	assert.Equal(t, float64(start+n-1)+0.1, f.MaxValues["price"])
+	require.IsType(t, time.Time{}, f.MinValues["ts"])
+	assert.True(t, statsTestEpoch.Add(time.Duration(start)*time.Minute).Equal(f.MinValues["ts"].(time.Time)))
+	assert.True(t, statsTestEpoch.Add(time.Duration(start+n-2)*time.Minute).Equal(f.MaxValues["ts"].(time.Time)))
+	assert.Equal(t, int64(1), f.NullCounts["ts"])
+	assert.Equal(t, int64(0), f.NullCounts["id"])
+}
+
+// TestStatsCodecRoundTrip 带类型标签的统计值精确往返
+func TestStatsCodecRoundTrip(t *testing.T) {
+	ts := time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	values := map[string]interface{}{
+		"big":    int64(math.MaxInt64),
+		"i32":    int32(-7),
+		"i16":    int16(300),
+		"i8":     int8(-1),
+		"f64":    0.1,
+		"f32":    float32(1.25),
+		"inf":    math.Inf(1),
+		"s":      "naïve, \"quoted\"",
+		"b":      true,
+		"ts":     ts,
+		"ignore": []int{1},
+	}
+
+	encoded, err := delta.EncodeStatsValues(values)
+	require.NoError(t, err)
+	decoded, err := delta.DecodeStatsValues(encoded)
+	require.NoError(t, err)
+
+	assert.NotContains(t, decoded, "ignore", "unsupported types are skipped")
+	for name, want := range values {
+		if name == "ignore" || name == "ts" {
+			continue
+		}
+		assert.Equal(t, want, decoded[name], "column %s", name)
+	}
+	require.IsType(t, time.Time{}, decoded["ts"])
+	assert.True(t, ts.Equal(decoded["ts"].(time.Time)))
+
+	counts, err := delta.DecodeNullCounts(mustEncodeNullCounts(t, map[string]int64{"a": 3, "b": 0}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 3, "b": 0}, counts)
+
+	// 空统计编码为空字符串，旧版本写入的空字符串解析为 nil
+	empty, err := delta.EncodeStatsValues(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", empty)
+	decoded, err = delta.DecodeStatsValues("")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
+
+func mustEncodeNullCounts(t *testing.T, counts map[string]int64) string {
+	encoded, err := delta.EncodeNullCounts(counts)
+	require.NoError(t, err)
+	return encoded
+}
+
+// TestFileStatsSurviveRestart 文件统计信息持久化到 sys.delta_log，重启后数据跳过仍然有效
+func TestFileStatsSurviveRestart(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_stats_restart")
+	ctx := context.Background()
+
+	engine, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	require.NoError(t, engine.CreateDatabase("shop"))
+	require.NoError(t, engine.CreateTable("shop", "items", statsTestSchema))
+	for _, start := range []int{0, 100, 200} {
+		record := buildStatsRecord(start, 10)
+		require.NoError(t, engine.Write(ctx, "shop", "items", record))
+		record.Release()
+	}
+	before := snapshotStatsByPath(t, engine, "shop.items")
+	require.Len(t, before, 3)
+	require.NoError(t, engine.Close())
+
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+
+	after := snapshotStatsByPath(t, reopened, "shop.items")
+	require.Len(t, after, 3)
+	for path, f := range after {
+		orig, ok := before[path]
+		require.True(t, ok, "file %s missing after restart", path)
+		assert.Equal(t, orig.MinValues["id"], f.MinValues["id"])
+		assert.Equal(t, orig.MaxValues["id"], f.MaxValues["id"])
+		assert.Equal(t, orig.NullCounts, f.NullCounts)
+		start := int(f.MinValues["id"].(int64))
+		assertStatsBatch(t, f, start, 10)
+	}
+
+	// 重启后的快照已经带有统计信息，不需要补写
+	backfilled, err := reopened.BackfillFileStats("shop", "items")
+	require.NoError(t, err)
+	assert.Equal(t, 0, backfilled)
+
+	// 过滤扫描结果正确 (依赖恢复后的统计信息做文件跳过)
+	iter, err := reopened.Scan(ctx, "shop", "items", []storage.Filter{{Column: "id", Operator: "=", Value: int64(105)}})
+	require.NoError(t, err)
+	defer iter.Close()
+	rows := 0
+	for iter.Next() {
+		record := iter.Record()
+		for i := 0; i < int(record.NumRows()); i++ {
+			assert.Equal(t, int64(105), record.Column(0).(*array.Int64).Value(i))
+			rows++
+		}
+	}
+	assert.Equal(t, 1, rows)
+}
+
+// TestBackfillFileStatsFromFooters 没有统计信息的旧文件在启动时从 Parquet footer 补写
+func TestBackfillFileStatsFromFooters(t *testing.T) {
+	dir := SetupTestDir(t, "delta_log_stats_backfill")
+
+	engine, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	require.NoError(t, engine.CreateDatabase("shop"))
+	require.NoError(t, engine.CreateTable("shop", "legacy", statsTestSchema))
+
+	// 模拟旧版本：数据文件已写入，但 ADD 记录不带统计信息
+	paths := make([]string, 0)
+	for i, start := range []int{0, 50} {
+		record := buildStatsRecord(start, 20)
+		path := filepath.Join(dir, "shop", "legacy", "data", fmt.Sprintf("legacy_%d.parquet", i))
+		stats, err := parquet.WriteArrowBatch(path, record)
+		record.Release()
+		require.NoError(t, err)
+		require.NoError(t, engine.GetDeltaLog().AppendAdd("shop.legacy", &delta.ParquetFile{
+			Path:     path,
+			Size:     stats.FileSize,
+			RowCount: stats.RowCount,
+		}))
+		paths = append(paths, path)
+	}
+	for _, f := range snapshotStatsByPath(t, engine, "shop.legacy") {
+		assert.False(t, f.HasStats())
+	}
+	require.NoError(t, engine.Close())
+
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+
+	files := snapshotStatsByPath(t, reopened, "shop.legacy")
+	require.Len(t, files, 2)
+	assertStatsBatch(t, files[paths[0]], 0, 20)
+	assertStatsBatch(t, files[paths[1]], 50, 20)
+
+	// 补写记录不代表数据变更
+	entries := reopened.GetDeltaLog().GetEntriesByTable("shop.legacy")
+	statsOnly := 0
+	for _, entry := range entries {
+		if entry.Operation == delta.OpAdd && !entry.DataChange {
+			statsOnly++
+		}
+	}
+	assert.Equal(t, 2, statsOnly)
+	entryCount := len(entries)
+	require.NoError(t, reopened.Close())
+
+	// 再次启动不会重复补写
+	again, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, again.Open())
+	defer again.Close()
+	assert.Len(t, again.GetDeltaLog().GetEntriesByTable("shop.legacy"), entryCount)
+	assertStatsBatch(t, snapshotStatsByPath(t, again, "shop.legacy")[paths[1]], 50, 20)
+}
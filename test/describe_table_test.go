@@ -0,0 +1,214 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// showCreateTable 返回 SHOW CREATE TABLE 生成的 DDL
+func showCreateTable(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, table string) string {
+	result, err := execSQL(t, exec, sess, "SHOW CREATE TABLE "+table)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"table", "create_statement"}, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	ddl := strings.TrimPrefix(rows[0], table+"|")
+	return strings.TrimSuffix(ddl, "|")
+}
+
+// describeInfo 返回 DESCRIBE EXTENDED 详细信息部分的 名称 -> 值
+func describeInfo(rows []string) map[string]string {
+	info := make(map[string]string)
+	detailed := false
+	for _, row := range rows {
+		parts := strings.Split(row, "|")
+		if parts[0] == "# Detailed Table Information" {
+			detailed = true
+			continue
+		}
+		if detailed {
+			info[parts[0]] = parts[1]
+		}
+	}
+	return info
+}
+
+// TestDescribeTableParse DESCRIBE / SHOW CREATE TABLE / COMMENT ON / TBLPROPERTIES 语句解析
+func TestDescribeTableParse(t *testing.T) {
+	node, err := parser.Parse("DESCRIBE t")
+	require.NoError(t, err)
+	assert.Equal(t, &parser.DescribeStmt{BaseNode: node.(*parser.DescribeStmt).BaseNode, Table: "t"}, node)
+
+	node, err = parser.Parse("desc table extended db.t;")
+	require.NoError(t, err)
+	assert.True(t, node.(*parser.DescribeStmt).Extended)
+	assert.Equal(t, "db.t", node.(*parser.DescribeStmt).Table)
+
+	// 表名恰好为 extended
+	node, err = parser.Parse("DESCRIBE extended")
+	require.NoError(t, err)
+	assert.False(t, node.(*parser.DescribeStmt).Extended)
+	assert.Equal(t, "extended", node.(*parser.DescribeStmt).Table)
+
+	node, err = parser.Parse("SHOW CREATE TABLE db.t")
+	require.NoError(t, err)
+	assert.Equal(t, "db.t", node.(*parser.ShowCreateTableStmt).Table)
+
+	node, err = parser.Parse("COMMENT ON TABLE t IS 'it''s a table'")
+	require.NoError(t, err)
+	comment := node.(*parser.CommentStmt)
+	assert.Equal(t, "t", comment.Table)
+	assert.Empty(t, comment.Column)
+	assert.Equal(t, "it's a table", comment.Comment)
+
+	node, err = parser.Parse("COMMENT ON COLUMN db.t.name IS NULL")
+	require.NoError(t, err)
+	comment = node.(*parser.CommentStmt)
+	assert.Equal(t, "db.t", comment.Table)
+	assert.Equal(t, "name", comment.Column)
+	assert.Empty(t, comment.Comment)
+
+	node, err = parser.Parse("ALTER TABLE t SET TBLPROPERTIES ('owner' = 'qa', retention.days = 7)")
+	require.NoError(t, err)
+	alter := node.(*parser.AlterTableStmt)
+	assert.Equal(t, parser.AlterTableSetTableProperties, alter.Action)
+	assert.Equal(t, map[string]string{"owner": "qa", "retention.days": "7"}, alter.Properties)
+
+	node, err = parser.Parse("ALTER TABLE t UNSET TBLPROPERTIES ('owner', retention.days)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"owner", "retention.days"}, node.(*parser.AlterTableStmt).PropertyNames)
+
+	for _, sql := range []string{
+		"DESCRIBE",
+		"COMMENT ON COLUMN name IS 'x'",
+		"COMMENT ON TABLE t 'x'",
+		"ALTER TABLE t SET TBLPROPERTIES ('a' = NULL)",
+		"ALTER TABLE t SET TBLPROPERTIES ('a' = 1, 'a' = 2)",
+	} {
+		_, err := parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestDescribeTable 列定义、约束、注释以及 EXTENDED 详细信息
+func TestDescribeTable(t *testing.T) {
+	dir := SetupTestDir(t, "describe_table")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess,
+		"CREATE TABLE users (id INT PRIMARY KEY, name VARCHAR(64) NOT NULL DEFAULT 'anon', email VARCHAR UNIQUE, score DOUBLE)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COMMENT ON TABLE users IS 'registered users'")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COMMENT ON COLUMN users.email IS 'login address'")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "ALTER TABLE users SET TBLPROPERTIES ('owner' = 'qa', 'tier' = 'gold')")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO users VALUES (1, 'a', 'a@x', 1.5), (2, 'b', 'b@x', 2.5)")
+	require.NoError(t, err)
+
+	result, err := execSQL(t, exec, sess, "DESCRIBE users")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"column_name", "data_type", "nullable", "key", "default", "comment"}, result.Headers)
+	assert.Equal(t, []string{
+		"id|INTEGER|NO|PRI|(null)|(null)|",
+		"name|VARCHAR(64)|NO|(null)|'anon'|(null)|",
+		"email|VARCHAR|YES|UNI|(null)|login address|",
+		"score|DOUBLE|YES|(null)|(null)|(null)|",
+	}, resultRows(result))
+
+	result, err = execSQL(t, exec, sess, "DESCRIBE EXTENDED users")
+	require.NoError(t, err)
+	info := describeInfo(resultRows(result))
+	assert.Equal(t, "default", info["Database"])
+	assert.Equal(t, "MANAGED", info["Type"])
+	assert.Equal(t, "registered users", info["Comment"])
+	assert.Equal(t, "id", info["Primary Key"])
+	assert.Equal(t, "owner=qa, tier=gold", info["Table Properties"])
+	assert.Equal(t, "2", info["Files"])
+	assert.NotEqual(t, "0", info["Size (bytes)"])
+	assert.Contains(t, info, "Version")
+
+	// 删除注释和属性
+	_, err = execSQL(t, exec, sess, "COMMENT ON COLUMN users.email IS NULL")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "ALTER TABLE users UNSET TBLPROPERTIES ('tier')")
+	require.NoError(t, err)
+
+	for _, sql := range []string{
+		"DESCRIBE missing",
+		"COMMENT ON COLUMN users.missing IS 'x'",
+		"ALTER TABLE users UNSET TBLPROPERTIES ('tier')",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		assert.Error(t, err, sql)
+	}
+
+	// 注释和属性通过 METADATA 日志条目持久化
+	require.NoError(t, engine.Close())
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+
+	result, err = execSQL(t, exec, sess, "DESCRIBE EXTENDED users")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	assert.Equal(t, "email|VARCHAR|YES|UNI|(null)|(null)|", rows[2])
+	info = describeInfo(rows)
+	assert.Equal(t, "registered users", info["Comment"])
+	assert.Equal(t, "owner=qa", info["Table Properties"])
+}
+
+// TestShowCreateTableRoundTrip SHOW CREATE TABLE 生成的 DDL 重新执行后得到相同的表定义
+func TestShowCreateTableRoundTrip(t *testing.T) {
+	dir := SetupTestDir(t, "show_create_table")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, `CREATE TABLE events (
+		id INT NOT NULL,
+		region VARCHAR,
+		amount DOUBLE DEFAULT 0,
+		PRIMARY KEY (id)
+	) PARTITION BY LIST (region) (PARTITION p_eu VALUES IN ('eu', 'uk'), PARTITION p_us VALUES IN ('us'))
+	WITH (compression = 'zstd', row_group_size = 1000)`)
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COMMENT ON TABLE events IS 'sales events, it''s partitioned'")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "COMMENT ON COLUMN events.amount IS 'in EUR'")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "ALTER TABLE events SET TBLPROPERTIES ('owner' = 'finance')")
+	require.NoError(t, err)
+
+	ddl := showCreateTable(t, exec, sess, "events")
+	assert.Equal(t, `CREATE TABLE events (
+  id INTEGER NOT NULL,
+  region VARCHAR,
+  amount DOUBLE DEFAULT 0,
+  PRIMARY KEY (id)
+)
+PARTITION BY LIST (region) (PARTITION p_eu VALUES IN ('eu', 'uk'), PARTITION p_us VALUES IN ('us'))
+WITH (compression = 'zstd', row_group_size = '1000');
+COMMENT ON TABLE events IS 'sales events, it''s partitioned';
+COMMENT ON COLUMN events.amount IS 'in EUR';
+ALTER TABLE events SET TBLPROPERTIES ('owner' = 'finance');`, ddl)
+
+	// 删除后逐条执行 DDL 重建
+	_, err = execSQL(t, exec, sess, "DROP TABLE events")
+	require.NoError(t, err)
+	for _, stmt := range strings.Split(ddl, ";\n") {
+		_, err := execSQL(t, exec, sess, stmt)
+		require.NoError(t, err, stmt)
+	}
+	assert.Equal(t, ddl, showCreateTable(t, exec, sess, "events"))
+
+	// 重建的表按分区写入
+	_, err = execSQL(t, exec, sess, "INSERT INTO events VALUES (1, 'uk', 9.5)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|uk|9.5|"}, sortedRows(t, exec, sess, "SELECT * FROM events"))
+}
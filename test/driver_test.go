@@ -0,0 +1,320 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+// eventRow events 表的一行
+type eventRow struct {
+	ID     int64
+	Score  float64
+	Name   string
+	Active bool
+	At     time.Time
+}
+
+// createEvents 通过 database/sql 建表并用参数插入数据
+func createEvents(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+	_, err := db.ExecContext(ctx, "CREATE TABLE events (id INT, score DOUBLE, name VARCHAR, active BOOLEAN, at TIMESTAMP)")
+	require.NoError(t, err)
+
+	at := time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)
+	_, err = db.ExecContext(ctx, "INSERT INTO events VALUES (?, ?, ?, ?, ?)", 1, 9.5, "what? $1", true, at)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "INSERT INTO events VALUES ($1, $2, $3, $4, $5)", int32(2), float32(1.25), "bob", false, at.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "INSERT INTO events (id, score, active, at) VALUES (3, 0.5, true, '2024-03-02')")
+	require.NoError(t, err)
+}
+
+// checkEvents 按类型读取 events 表并检查列类型
+func checkEvents(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+	rows, err := db.QueryContext(ctx, "SELECT id, score, name, active, at FROM events WHERE id < $1 ORDER BY id", 3)
+	require.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	var typeNames []string
+	for _, ct := range types {
+		typeNames = append(typeNames, ct.DatabaseTypeName())
+	}
+	assert.Equal(t, []string{"INTEGER", "DOUBLE", "VARCHAR", "BOOLEAN", "TIMESTAMP"}, typeNames)
+
+	var got []eventRow
+	for rows.Next() {
+		var row eventRow
+		require.NoError(t, rows.Scan(&row.ID, &row.Score, &row.Name, &row.Active, &row.At))
+		got = append(got, row)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	assert.Equal(t, []eventRow{
+		{ID: 1, Score: 9.5, Name: "what? $1", Active: true, At: time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{ID: 2, Score: 1.25, Name: "bob", Active: false, At: time.Date(2024, 3, 1, 13, 30, 45, 0, time.UTC)},
+	}, got)
+
+	// 只有日期的 TIMESTAMP
+	var at time.Time
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT at FROM events WHERE id = 3").Scan(&at))
+	assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), at)
+
+	// 没有插入的列为 NULL
+	names := make(map[int64]sql.NullString)
+	rows, err = db.QueryContext(ctx, "SELECT id, name FROM events")
+	require.NoError(t, err)
+	for rows.Next() {
+		var id int64
+		var name sql.NullString
+		require.NoError(t, rows.Scan(&id, &name))
+		names[id] = name
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, map[int64]sql.NullString{
+		1: {String: "what? $1", Valid: true},
+		2: {String: "bob", Valid: true},
+		3: {},
+	}, names)
+
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM events WHERE active = ?", true).Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+// TestEmbeddedDriver 进程内打开数据目录，通过 database/sql 读写类型化的值
+func TestEmbeddedDriver(t *testing.T) {
+	dir := SetupTestDir(t, "driver_embedded")
+	db, err := sql.Open(minidb.DriverName, dir)
+	require.NoError(t, err)
+	db.SetMaxOpenConns(2)
+
+	createEvents(t, db)
+	checkEvents(t, db)
+
+	_, err = db.Exec("SELECT * FROM events WHERE id = ?")
+	assert.ErrorContains(t, err, "expects 1 arguments, got 0")
+	_, err = db.Exec("SELECT * FROM events WHERE id = ? AND name = $1", 1, "a")
+	assert.ErrorContains(t, err, "cannot mix")
+	_, err = db.Exec("SELECT * FROM events WHERE name = ?", "it's")
+	assert.ErrorContains(t, err, "single quote")
+	result, err := db.Exec("UPDATE events SET score = 2.0 WHERE id = 2")
+	require.NoError(t, err)
+	_, err = result.RowsAffected()
+	assert.Error(t, err)
+	require.NoError(t, db.Close())
+
+	// 关闭后重新打开，数据已持久化
+	db, err = sql.Open(minidb.DriverName, "file:"+dir)
+	require.NoError(t, err)
+	defer db.Close()
+	var score float64
+	require.NoError(t, db.QueryRow("SELECT score FROM events WHERE id = 2").Scan(&score))
+	assert.Equal(t, 2.0, score)
+}
+
+// TestDriverTransactions 事务映射为 START TRANSACTION / COMMIT / ROLLBACK，会话状态在事务内保留
+func TestDriverTransactions(t *testing.T) {
+	dir := SetupTestDir(t, "driver_transactions")
+	db, err := sql.Open(minidb.DriverName, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	_, err = db.ExecContext(ctx, "CREATE DATABASE shop")
+	require.NoError(t, err)
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "USE shop")
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "CREATE TABLE orders (id INT, amount INT)")
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "INSERT INTO orders VALUES (?, ?)", 1, 100)
+	require.NoError(t, err)
+	var amount int
+	require.NoError(t, tx.QueryRowContext(ctx, "SELECT amount FROM orders WHERE id = 1").Scan(&amount))
+	assert.Equal(t, 100, amount)
+	require.NoError(t, tx.Commit())
+	assert.ErrorIs(t, tx.Rollback(), sql.ErrTxDone)
+
+	tx, err = db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+
+	_, err = db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	assert.ErrorContains(t, err, "not supported")
+	_, err = db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	assert.ErrorContains(t, err, "not supported")
+
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT amount FROM shop.orders WHERE id = 1").Scan(&amount))
+	assert.Equal(t, 100, amount)
+}
+
+// TestQueryArrow Arrow 原生的查询接口：按批次返回带类型的列，Conn 保留会话状态
+func TestQueryArrow(t *testing.T) {
+	db, err := minidb.Open(SetupTestDir(t, "driver_arrow"))
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	for _, sql := range []string{
+		"CREATE DATABASE analytics",
+		"USE analytics",
+		"CREATE TABLE metrics (name VARCHAR, value DOUBLE, at TIMESTAMP)",
+		"INSERT INTO metrics VALUES ('cpu', 0.75, '2024-01-01 00:00:00'), ('mem', 0.5, '2024-01-01 00:01:00')",
+	} {
+		require.NoError(t, conn.Exec(ctx, sql), sql)
+	}
+
+	reader, err := conn.QueryArrow(ctx, "SELECT name, value, at FROM metrics ORDER BY name")
+	require.NoError(t, err)
+	defer reader.Release()
+	schema := reader.Schema()
+	require.Equal(t, 3, schema.NumFields())
+	assert.Equal(t, arrow.BinaryTypes.String, schema.Field(0).Type)
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, schema.Field(1).Type)
+	sqlType, ok := schema.Field(2).Metadata.GetValue("minidb.sql_type")
+	assert.True(t, ok)
+	assert.Equal(t, "TIMESTAMP", sqlType)
+
+	var names []string
+	var values []float64
+	for reader.Next() {
+		record := reader.Record()
+		for i := 0; i < int(record.NumRows()); i++ {
+			names = append(names, record.Column(0).(*array.String).Value(i))
+			values = append(values, record.Column(1).(*array.Float64).Value(i))
+		}
+	}
+	require.NoError(t, reader.Err())
+	assert.Equal(t, []string{"cpu", "mem"}, names)
+	assert.Equal(t, []float64{0.75, 0.5}, values)
+
+	// DDL 返回没有列的 reader；DB 上的调用使用新会话 (当前数据库为 default)
+	reader, err = db.QueryArrow(ctx, "CREATE TABLE t (id INT)")
+	require.NoError(t, err)
+	assert.Equal(t, 0, reader.Schema().NumFields())
+	assert.False(t, reader.Next())
+	reader.Release()
+	reader, err = db.QueryArrow(ctx, "SELECT COUNT(*) AS n FROM analytics.metrics")
+	require.NoError(t, err)
+	require.True(t, reader.Next())
+	assert.Equal(t, int64(2), reader.Record().Column(0).(*array.Int64).Value(0))
+	reader.Release()
+
+	_, err = db.QueryArrow(ctx, "SELEC name FROM analytics.metrics")
+	assert.ErrorContains(t, err, "parsing error")
+	require.NoError(t, conn.Close())
+	assert.ErrorIs(t, conn.Exec(ctx, "SELECT 1"), minidb.ErrClosed)
+}
+
+// startServer 编译并启动 minidb 服务器 (启用认证)，返回监听地址
+func startServer(t *testing.T, dataDir, adminPassword string) string {
+	if testing.Short() {
+		t.Skip("builds and starts the server binary")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	workDir := t.TempDir()
+	binary := filepath.Join(workDir, "minidb-server")
+	build := exec.Command(goTool, "build", "-o", binary, "../cmd/server")
+	output, err := build.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	configFile := filepath.Join(workDir, "minidb.yaml")
+	absDataDir, err := filepath.Abs(dataDir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(configFile, []byte(fmt.Sprintf(
+		"data_dir: %s\nserver:\n  listeners: [\"%s\"]\nauth:\n  enabled: true\n  admin_user: admin\n  admin_password: %s\n",
+		absDataDir, address, adminPassword)), 0o600))
+
+	server := exec.Command(binary, "-config", configFile)
+	server.Dir = workDir
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		server.Process.Kill()
+		server.Wait()
+	})
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err == nil {
+			conn.Close()
+			return address
+		}
+		require.True(t, time.Now().Before(deadline), "server did not start: %v", err)
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// TestRemoteDriver 通过服务器的文本协议 (SCRAM 登录、Arrow 结果格式) 使用同样的接口
+func TestRemoteDriver(t *testing.T) {
+	address := startServer(t, SetupTestDir(t, "driver_remote"), "s3cret")
+	ctx := context.Background()
+
+	_, err := minidb.Dial(ctx, address, minidb.DialOptions{User: "admin", Password: "wrong"})
+	assert.ErrorContains(t, err, "authentication failed")
+	_, err = minidb.Dial(ctx, address, minidb.DialOptions{})
+	assert.ErrorContains(t, err, "requires authentication")
+
+	db, err := sql.Open(minidb.DriverName, "minidb://admin:s3cret@"+address)
+	require.NoError(t, err)
+	defer db.Close()
+	createEvents(t, db)
+	checkEvents(t, db)
+
+	_, err = db.Exec("SELEC * FROM events")
+	assert.ErrorContains(t, err, "parsing error")
+	_, err = db.Exec("SELECT 1; SELECT 2")
+	assert.ErrorContains(t, err, "must not contain ';'")
+
+	// 数据库在 DSN 的路径中
+	_, err = db.Exec("CREATE DATABASE reports")
+	require.NoError(t, err)
+	reportsDB, err := sql.Open(minidb.DriverName, "minidb://admin:s3cret@"+address+"/reports")
+	require.NoError(t, err)
+	defer reportsDB.Close()
+	_, err = reportsDB.Exec("CREATE TABLE daily (day VARCHAR, total INT)")
+	require.NoError(t, err)
+	_, err = reportsDB.Exec("INSERT INTO daily VALUES (?, ?)", "mon", 7)
+	require.NoError(t, err)
+	var total int
+	require.NoError(t, db.QueryRow("SELECT total FROM reports.daily WHERE day = 'mon'").Scan(&total))
+	assert.Equal(t, 7, total)
+
+	remote, err := minidb.Dial(ctx, address, minidb.DialOptions{User: "admin", Password: "s3cret", Database: "reports"})
+	require.NoError(t, err)
+	defer remote.Close()
+	reader, err := remote.QueryArrow(ctx, "SELECT day, total FROM daily")
+	require.NoError(t, err)
+	defer reader.Release()
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, reader.Schema().Field(1).Type)
+	require.True(t, reader.Next())
+	assert.Equal(t, "mon", reader.Record().Column(0).(*array.String).Value(0))
+	assert.False(t, reader.Next())
+}
@@ -0,0 +1,189 @@
+package test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// explainJSONNode EXPLAIN (FORMAT JSON) 输出的计划节点
+type explainJSONNode struct {
+	NodeType      string `json:"node_type"`
+	EstimatedRows *int64 `json:"estimated_rows"`
+	Actual        *struct {
+		Executed   bool  `json:"executed"`
+		Rows       int64 `json:"rows"`
+		Batches    int64 `json:"batches"`
+		PeakMemory int64 `json:"peak_memory_bytes"`
+	} `json:"actual"`
+	Scan *struct {
+		FilesConsidered        int64 `json:"files_considered"`
+		FilesPrunedByPartition int64 `json:"files_pruned_by_partition"`
+		FilesPrunedByStats     int64 `json:"files_pruned_by_stats"`
+		FilesRead              int64 `json:"files_read"`
+		BytesRead              int64 `json:"bytes_read"`
+	} `json:"scan"`
+	Plans []*explainJSONNode `json:"plans"`
+}
+
+// find 深度优先查找指定类型的节点
+func (n *explainJSONNode) find(nodeType string) *explainJSONNode {
+	if n.NodeType == nodeType {
+		return n
+	}
+	for _, child := range n.Plans {
+		if found := child.find(nodeType); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// setupExplainTest 创建按 region 分区的 sales 表，每条 INSERT 写入一个数据文件
+func setupExplainTest(t *testing.T) (*executor.ExecutorImpl, *session.Session) {
+	dir := SetupTestDir(t, "explain_analyze")
+	engine, exec, sess := newTestEngine(t, dir)
+	t.Cleanup(func() { engine.Close() })
+
+	for _, sql := range []string{
+		"CREATE TABLE sales (id INT, region VARCHAR, amount INT) PARTITION BY LIST (region)",
+		"INSERT INTO sales VALUES (1, 'us', 10)",
+		"INSERT INTO sales VALUES (2, 'eu', 20)",
+		"INSERT INTO sales VALUES (3, 'us', 30)",
+		"INSERT INTO sales VALUES (4, 'apac', 40)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	return exec, sess
+}
+
+// explainLines 执行 EXPLAIN 语句，返回输出的各行
+func explainLines(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string) []string {
+	result, err := execSQL(t, exec, sess, sql)
+	require.NoError(t, err, sql)
+	require.Equal(t, []string{executor.ExplainHeader}, result.Headers)
+	var lines []string
+	for _, row := range resultRows(result) {
+		lines = append(lines, strings.TrimSuffix(row, "|"))
+	}
+	return lines
+}
+
+// TestExplainParse EXPLAIN ANALYZE 和 EXPLAIN (选项) 的解析
+func TestExplainParse(t *testing.T) {
+	node, err := parser.Parse("EXPLAIN ANALYZE SELECT id FROM sales WHERE amount > 10")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.ExplainStmt)
+	require.True(t, ok)
+	assert.True(t, stmt.Analyze)
+	assert.Equal(t, parser.ExplainFormatText, stmt.Format)
+	_, ok = stmt.Query.(*parser.SelectStmt)
+	assert.True(t, ok)
+
+	node, err = parser.Parse("EXPLAIN (FORMAT JSON) SELECT id FROM sales")
+	require.NoError(t, err)
+	stmt = node.(*parser.ExplainStmt)
+	assert.False(t, stmt.Analyze)
+	assert.Equal(t, parser.ExplainFormatJSON, stmt.Format)
+
+	node, err = parser.Parse("EXPLAIN (ANALYZE, FORMAT JSON) SELECT id FROM sales;")
+	require.NoError(t, err)
+	stmt = node.(*parser.ExplainStmt)
+	assert.True(t, stmt.Analyze)
+	assert.Equal(t, parser.ExplainFormatJSON, stmt.Format)
+
+	// 不带选项的 EXPLAIN 不执行查询
+	node, err = parser.Parse("EXPLAIN SELECT id FROM sales")
+	require.NoError(t, err)
+	assert.False(t, node.(*parser.ExplainStmt).Analyze)
+
+	for _, sql := range []string{
+		"EXPLAIN (FORMAT XML) SELECT id FROM sales",
+		"EXPLAIN (VERBOSE) SELECT id FROM sales",
+		"EXPLAIN ANALYZE INSERT INTO sales VALUES (5, 'us', 50)",
+	} {
+		_, err := parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestExplainAnalyzeText EXPLAIN ANALYZE 执行查询，输出每个算子的实际行数和扫描的文件统计
+func TestExplainAnalyzeText(t *testing.T) {
+	exec, sess := setupExplainTest(t)
+
+	lines := explainLines(t, exec, sess, "EXPLAIN ANALYZE SELECT id FROM sales WHERE region = 'us'")
+	output := strings.Join(lines, "\n")
+	assert.True(t, strings.HasPrefix(lines[0], "Select"), output)
+	assert.Contains(t, lines[0], "actual rows=2 ", output)
+	assert.Contains(t, output, "TableScan  (estimated rows=4) (actual rows=2 ")
+	assert.Contains(t, output, "Files: 4 considered, 2 pruned by partition, 0 pruned by stats, 2 read")
+	assert.Equal(t, "Rows: 2", lines[len(lines)-2])
+	assert.True(t, strings.HasPrefix(lines[len(lines)-1], "Execution Time: "), output)
+
+	// 不带 ANALYZE 时只输出计划和估计行数，不执行查询
+	lines = explainLines(t, exec, sess, "EXPLAIN SELECT id FROM sales WHERE region = 'us'")
+	output = strings.Join(lines, "\n")
+	assert.Contains(t, output, "TableScan  (estimated rows=4)")
+	assert.Contains(t, output, "Filter  (estimated rows=2)")
+	assert.NotContains(t, output, "actual")
+	assert.NotContains(t, output, "Execution Time")
+}
+
+// TestExplainAnalyzeJSON EXPLAIN (ANALYZE, FORMAT JSON) 输出可解析的计划树
+func TestExplainAnalyzeJSON(t *testing.T) {
+	exec, sess := setupExplainTest(t)
+
+	lines := explainLines(t, exec, sess, "EXPLAIN (ANALYZE, FORMAT JSON) SELECT region, amount FROM sales WHERE region = 'us' ORDER BY amount DESC")
+	require.Len(t, lines, 1)
+
+	var output struct {
+		Plan            *explainJSONNode `json:"plan"`
+		Rows            *int64           `json:"rows"`
+		ExecutionTimeMs *float64         `json:"execution_time_ms"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &output), lines[0])
+	require.NotNil(t, output.Rows)
+	assert.Equal(t, int64(2), *output.Rows)
+	require.NotNil(t, output.ExecutionTimeMs)
+
+	scan := output.Plan.find("TableScan")
+	require.NotNil(t, scan, lines[0])
+	require.NotNil(t, scan.Actual)
+	assert.True(t, scan.Actual.Executed)
+	assert.Equal(t, int64(2), scan.Actual.Rows)
+	assert.Positive(t, scan.Actual.PeakMemory)
+	require.NotNil(t, scan.EstimatedRows)
+	assert.Equal(t, int64(4), *scan.EstimatedRows)
+	require.NotNil(t, scan.Scan)
+	assert.Equal(t, int64(4), scan.Scan.FilesConsidered)
+	assert.Equal(t, int64(2), scan.Scan.FilesPrunedByPartition)
+	assert.Equal(t, int64(2), scan.Scan.FilesRead)
+	assert.Positive(t, scan.Scan.BytesRead)
+
+	// 排序在内存记账器中预留的内存计入 OrderBy 节点
+	order := output.Plan.find("OrderBy")
+	require.NotNil(t, order, lines[0])
+	assert.Equal(t, int64(2), order.Actual.Rows)
+	assert.Positive(t, order.Actual.PeakMemory)
+
+	// 不带 ANALYZE 的 JSON 输出没有运行统计
+	lines = explainLines(t, exec, sess, "EXPLAIN (FORMAT JSON) SELECT id FROM sales")
+	require.Len(t, lines, 1)
+	var plan struct {
+		Plan *explainJSONNode `json:"plan"`
+		Rows *int64           `json:"rows"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &plan))
+	assert.Nil(t, plan.Rows)
+	scan = plan.Plan.find("TableScan")
+	require.NotNil(t, scan)
+	assert.Nil(t, scan.Actual)
+	assert.Nil(t, scan.Scan)
+}
@@ -0,0 +1,210 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// writeSparkParquet 模拟外部作业写出的 Parquet 文件: id 为 int32 (与表的 INT 类型不同)
+func writeSparkParquet(t *testing.T, path string, ids []int32, names []string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "amount", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	for i, id := range ids {
+		builder.Field(0).(*array.Int32Builder).Append(id)
+		builder.Field(1).(*array.StringBuilder).Append(names[i])
+		builder.Field(2).(*array.Float32Builder).Append(float32(id) / 2)
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	_, err := parquet.WriteArrowRecords(nil, path, schema, []arrow.Record{record})
+	require.NoError(t, err)
+}
+
+// countScannedFiles 只带裁剪条件扫描外部表，返回读取的文件数 (每个 Parquet 文件一条记录)
+func countScannedFiles(t *testing.T, engine *storage.ParquetEngine, table string, filters []storage.Filter) int {
+	ctx := storage.WithPartitionFilters(context.Background(), filters)
+	iter, err := engine.Scan(ctx, "default", table, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	files := 0
+	for iter.Next() {
+		files++
+	}
+	require.NoError(t, iter.Err())
+	return files
+}
+
+// TestCreateExternalTableParse CREATE EXTERNAL TABLE 语句解析
+func TestCreateExternalTableParse(t *testing.T) {
+	node, err := parser.Parse("CREATE EXTERNAL TABLE events (id INT, name VARCHAR) LOCATION '/data/events/' FORMAT parquet")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.CreateTableStmt)
+	require.True(t, ok, "expected CreateTableStmt, got %T", node)
+	assert.Equal(t, "events", stmt.Table)
+	require.Len(t, stmt.Columns, 2)
+	require.NotNil(t, stmt.External)
+	assert.Equal(t, "/data/events/", stmt.External.Location)
+	assert.Equal(t, "parquet", stmt.External.Format)
+
+	node, err = parser.Parse("CREATE EXTERNAL TABLE logs LOCATION '/data/logs' FORMAT 'CSV' WITH (header = false, delimiter = '|')")
+	require.NoError(t, err)
+	stmt = node.(*parser.CreateTableStmt)
+	assert.Empty(t, stmt.Columns, "schema is inferred when no column list is given")
+	assert.Equal(t, "csv", stmt.External.Format)
+	assert.Equal(t, map[string]string{"header": "false", "delimiter": "|"}, stmt.External.Options)
+
+	for _, bad := range []string{
+		"CREATE EXTERNAL TABLE t (id INT) FORMAT parquet",
+		"CREATE EXTERNAL TABLE t (id INT) LOCATION '/data'",
+		"CREATE EXTERNAL TABLE t (id INT LOCATION '/data' FORMAT parquet",
+	} {
+		_, err := parser.Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// TestExternalParquetTable 推断 Parquet 表结构、查询时发现文件、footer 统计裁剪、只读和重启恢复
+func TestExternalParquetTable(t *testing.T) {
+	dir := SetupTestDir(t, "external_parquet")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	location := t.TempDir()
+	writeSparkParquet(t, filepath.Join(location, "part-00000.parquet"), []int32{1, 2, 3}, []string{"a", "b", "c"})
+	writeSparkParquet(t, filepath.Join(location, "dt=2024-01-02", "part-00001.parquet"), []int32{10, 11}, []string{"j", "k"})
+	require.NoError(t, os.WriteFile(filepath.Join(location, "_SUCCESS"), nil, 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(location, "_temporary"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(location, "_temporary", "part-99999.parquet"), []byte("partial"), 0644))
+
+	_, err := execSQL(t, exec, sess, "CREATE EXTERNAL TABLE events LOCATION '"+location+"' FORMAT parquet")
+	require.NoError(t, err)
+
+	schema, err := engine.GetTableSchema("default", "events")
+	require.NoError(t, err)
+	require.Equal(t, 3, schema.NumFields())
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, schema.Field(0).Type, "integer columns are inferred as INT")
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, schema.Field(2).Type, "floating point columns are inferred as FLOAT")
+
+	result, err := execSQL(t, exec, sess, "SELECT id, name, amount FROM events ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|a|0.5|", "2|b|1|", "3|c|1.5|", "10|j|5|", "11|k|5.5|"}, resultRows(result))
+
+	// 新文件在查询时被发现
+	writeSparkParquet(t, filepath.Join(location, "part-00002.parquet"), []int32{20}, []string{"t"})
+	result, err = execSQL(t, exec, sess, "SELECT id FROM events WHERE id >= 10 ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10|", "11|", "20|"}, resultRows(result))
+
+	// footer 统计裁剪: id >= 10 只需要读取两个文件
+	assert.Equal(t, 3, countScannedFiles(t, engine, "events", nil))
+	assert.Equal(t, 2, countScannedFiles(t, engine, "events", []storage.Filter{{Column: "id", Operator: ">=", Value: int64(10)}}))
+	assert.Equal(t, 1, countScannedFiles(t, engine, "events", []storage.Filter{{Column: "id", Operator: "=", Value: int64(2)}}))
+	assert.Equal(t, 0, countScannedFiles(t, engine, "events", []storage.Filter{{Column: "id", Operator: ">", Value: int64(100)}}))
+
+	// 外部表只读，且没有数据文件进入 Delta Log
+	_, err = execSQL(t, exec, sess, "INSERT INTO events VALUES (4, 'd', 2.0)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "read-only")
+	_, err = execSQL(t, exec, sess, "DELETE FROM events WHERE id = 1")
+	assert.Error(t, err)
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.events", -1)
+	require.NoError(t, err)
+	assert.Empty(t, snapshot.Files)
+
+	result, err = execSQL(t, exec, sess, "SELECT table_name, table_type, location FROM sys.table_metadata WHERE db_name = 'default'")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"events|EXTERNAL|" + location + "|"}, resultRows(result))
+
+	// 重启后表定义从 Delta Log 恢复
+	require.NoError(t, engine.Close())
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+	result, err = execSQL(t, exec, sess, "SELECT COUNT(*) FROM events")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"6|"}, resultRows(result))
+
+	// DROP TABLE 不删除外部数据文件
+	_, err = execSQL(t, exec, sess, "DROP TABLE events")
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(location, "part-00000.parquet"))
+	assert.NoError(t, err)
+}
+
+// TestExternalTableExplicitSchema 显式列定义按列名匹配文件中的列，文件中缺失的列为 NULL
+func TestExternalTableExplicitSchema(t *testing.T) {
+	dir := SetupTestDir(t, "external_explicit")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	location := t.TempDir()
+	writeSparkParquet(t, filepath.Join(location, "part-00000.parquet"), []int32{1, 2}, []string{"a", "b"})
+
+	_, err := execSQL(t, exec, sess, "CREATE EXTERNAL TABLE slim (name VARCHAR, id INT, note VARCHAR) LOCATION '"+location+"' FORMAT parquet")
+	require.NoError(t, err)
+	result, err := execSQL(t, exec, sess, "SELECT name, id, note FROM slim ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a|1|(null)|", "b|2|(null)|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE missing (id INT) LOCATION '"+filepath.Join(location, "nope")+"' FORMAT parquet")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE bad (id INT) LOCATION '"+location+"' FORMAT orc")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE empty LOCATION '"+t.TempDir()+"' FORMAT parquet")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot infer schema")
+}
+
+// TestExternalCSVTable CSV 外部表: 表头匹配列名、类型推断、无表头和自定义分隔符
+func TestExternalCSVTable(t *testing.T) {
+	dir := SetupTestDir(t, "external_csv")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	location := t.TempDir()
+	writeCopyFile(t, location, "a.csv", "id,city,score,active\n1,Oslo,9.5,true\n2,Lima,,false\n")
+	writeCopyFile(t, location, "b.csv", "city,id,active,score\nRome,3,true,7\n")
+
+	_, err := execSQL(t, exec, sess, "CREATE EXTERNAL TABLE visits LOCATION '"+location+"' FORMAT csv")
+	require.NoError(t, err)
+	schema, err := engine.GetTableSchema("default", "visits")
+	require.NoError(t, err)
+	types := make([]arrow.DataType, schema.NumFields())
+	for i, field := range schema.Fields() {
+		types[i] = field.Type
+	}
+	assert.Equal(t, []arrow.DataType{arrow.PrimitiveTypes.Int64, arrow.BinaryTypes.String, arrow.PrimitiveTypes.Float64, arrow.FixedWidthTypes.Boolean}, types)
+
+	result, err := execSQL(t, exec, sess, "SELECT id, city, active FROM visits ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|Oslo|true|", "2|Lima|false|", "3|Rome|true|"}, resultRows(result))
+	result, err = execSQL(t, exec, sess, "SELECT city FROM visits WHERE id > 1 ORDER BY city")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Lima|", "Rome|"}, resultRows(result))
+
+	raw := t.TempDir()
+	writeCopyFile(t, raw, "data.txt", "1|x\n2|y\n")
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE raw (id INT, tag VARCHAR) LOCATION '"+raw+"' FORMAT csv WITH (header = false, delimiter = '|')")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec, sess, "SELECT id, tag FROM raw ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|x|", "2|y|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE opts (id INT) LOCATION '"+raw+"' FORMAT parquet WITH (header = false)")
+	assert.Error(t, err, "CSV options are rejected for parquet tables")
+}
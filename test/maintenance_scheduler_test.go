@@ -0,0 +1,278 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/maintenance"
+	"github.com/yyun543/minidb/internal/statistics"
+)
+
+// TestConfigParse YAML 配置解析、默认值和校验
+func TestConfigParse(t *testing.T) {
+	cfg, err := config.Parse([]byte(`
+data_dir: /var/lib/minidb
+server:
+  listeners: ["0.0.0.0:7205", "127.0.0.1:7206"]
+  admin_listener: 127.0.0.1:9205
+storage:
+  optimistic_lock: true
+memory:
+  work_mem: 256MB
+  write_buffer_size: 1GiB
+maintenance:
+  jitter: 0.2
+  compaction:
+    interval: 15m
+    min_file_size: 4mb
+  vacuum:
+    enabled: true
+    retention: 48h
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "/var/lib/minidb", cfg.DataDir)
+	assert.Equal(t, []string{"0.0.0.0:7205", "127.0.0.1:7206"}, cfg.Server.Listeners)
+	assert.Equal(t, "127.0.0.1:9205", cfg.Server.AdminListener)
+	assert.True(t, cfg.Storage.OptimisticLock)
+	assert.Equal(t, 256*config.MiB, cfg.Memory.WorkMem)
+	assert.Equal(t, config.GiB, cfg.Memory.WriteBufferSize)
+	assert.Equal(t, 0.2, cfg.Maintenance.Jitter)
+	assert.Equal(t, 15*time.Minute, cfg.Maintenance.Compaction.Interval)
+	assert.Equal(t, 4*config.MiB, cfg.Maintenance.Compaction.MinFileSize)
+	assert.True(t, cfg.Maintenance.Vacuum.Enabled)
+	assert.Equal(t, 48*time.Hour, cfg.Maintenance.Vacuum.Retention)
+
+	// 未出现的字段保留默认值
+	defaults := config.Default()
+	assert.Equal(t, defaults.Maintenance.Compaction.TargetFileSize, cfg.Maintenance.Compaction.TargetFileSize)
+	assert.Equal(t, defaults.Maintenance.AutoAnalyze, cfg.Maintenance.AutoAnalyze)
+	assert.Equal(t, defaults.Memory.WriteBufferRows, cfg.Memory.WriteBufferRows)
+
+	// 空配置即默认配置
+	cfg, err = config.Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, cfg)
+
+	// 从文件加载
+	path := filepath.Join(t.TempDir(), "minidb.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("data_dir: ./data\n"), 0644))
+	cfg, err = config.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "./data", cfg.DataDir)
+
+	for _, content := range []string{
+		"unknown_key: 1",
+		"server:\n  listeners: []",
+		"server:\n  listeners: [\"localhost\"]",
+		"server:\n  admin_listener: localhost",
+		"server:\n  listeners: [\"localhost:7205\"]\n  admin_listener: localhost:7205",
+		"memory:\n  work_mem: lots",
+		"maintenance:\n  jitter: 1.5",
+		"maintenance:\n  compaction:\n    interval: 0s",
+		"maintenance:\n  compaction:\n    interval: soon",
+		"maintenance:\n  backoff:\n    initial: 1h\n    max: 1m",
+		"storage:\n  s3:\n    bucket: minidb",
+		"storage:\n  s3:\n    bucket: minidb\n    endpoint: not-a-url",
+		"storage:\n  s3:\n    endpoint: http://127.0.0.1:9000",
+	} {
+		_, err := config.Parse([]byte(content))
+		assert.Error(t, err, content)
+	}
+	_, err = config.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+// TestMaintenanceSchedulerBackoff 每张表独立调度，失败后按指数退避重试
+func TestMaintenanceSchedulerBackoff(t *testing.T) {
+	tables := []string{"default.t1", "default.t2"}
+	scheduler := maintenance.NewScheduler(maintenance.Options{
+		BackoffInitial: time.Minute,
+		BackoffMax:     3 * time.Minute,
+		HistorySize:    100,
+	}, func() []string { return tables })
+
+	failures := map[string]int{"t1": 4}
+	scheduler.Register("job", 10*time.Minute, func(ctx context.Context, db, table string) (string, error) {
+		if failures[table] > 0 {
+			failures[table]--
+			return "", fmt.Errorf("%s is busy", table)
+		}
+		return "done " + db + "." + table, nil
+	})
+
+	ctx := context.Background()
+	start := time.Now()
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	// 第一次见到的表在一个间隔之后执行
+	assert.Equal(t, 0, scheduler.RunDue(ctx, start))
+	assert.Equal(t, 0, scheduler.RunDue(ctx, at(9)))
+	assert.Equal(t, 2, scheduler.RunDue(ctx, at(10)))
+
+	// t1 的重试等待 1、2、3 (上限)、3 分钟
+	for _, minute := range []int{11, 13, 16} {
+		assert.Equal(t, 0, scheduler.RunDue(ctx, at(minute).Add(-time.Second)))
+		assert.Equal(t, 1, scheduler.RunDue(ctx, at(minute)))
+	}
+
+	history := scheduler.History()
+	require.Len(t, history, 5)
+	assert.Equal(t, "t1", history[0].Table)
+	assert.Equal(t, maintenance.StatusFailed, history[0].Status)
+	assert.Equal(t, "t1 is busy", history[0].Message)
+	assert.Equal(t, "t2", history[1].Table)
+	assert.Equal(t, maintenance.StatusSucceeded, history[1].Status)
+	assert.Equal(t, "done default.t2", history[1].Message)
+	for i, record := range history {
+		assert.Equal(t, int64(i+1), record.ID)
+		assert.Equal(t, "job", record.Job)
+		assert.Equal(t, "default", record.Database)
+		assert.True(t, record.NextRunAt.After(record.StartedAt))
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, []int{history[0].Attempt, history[2].Attempt, history[3].Attempt, history[4].Attempt})
+	assert.WithinDuration(t, at(19), history[4].NextRunAt, time.Second)
+
+	// 成功后恢复正常间隔并重置尝试次数
+	assert.Equal(t, 1, scheduler.RunDue(ctx, at(19)))
+	history = scheduler.History()
+	last := history[len(history)-1]
+	assert.Equal(t, maintenance.StatusSucceeded, last.Status)
+	assert.Equal(t, 5, last.Attempt)
+	assert.Equal(t, 1, scheduler.RunDue(ctx, at(21)), "t2 runs again one interval after its first run")
+
+	// 已删除的表不再调度；panic 记为失败
+	tables = []string{"default.t3"}
+	scheduler.Register("panics", 10*time.Minute, func(ctx context.Context, db, table string) (string, error) {
+		panic("boom")
+	})
+	assert.Equal(t, 0, scheduler.RunDue(ctx, at(30)))
+	assert.Equal(t, 2, scheduler.RunDue(ctx, at(100)))
+	history = scheduler.History()
+	last = history[len(history)-1]
+	assert.Equal(t, "t3", last.Table)
+	assert.Equal(t, maintenance.StatusFailed, last.Status)
+	assert.Contains(t, last.Message, "boom")
+
+	// 执行记录数量受 HistorySize 限制
+	small := maintenance.NewScheduler(maintenance.Options{HistorySize: 2}, func() []string { return []string{"default.t"} })
+	small.Register("job", time.Minute, func(ctx context.Context, db, table string) (string, error) { return "", nil })
+	small.RunDue(ctx, start)
+	for i := 1; i <= 5; i++ {
+		small.RunDue(ctx, start.Add(time.Duration(i)*time.Hour))
+	}
+	history = small.History()
+	require.Len(t, history, 2)
+	assert.Equal(t, int64(5), history[1].ID)
+}
+
+// TestMaintenanceSchedulerStartStop 后台协程按间隔执行任务，Stop 等待正在执行的任务结束
+func TestMaintenanceSchedulerStartStop(t *testing.T) {
+	scheduler := maintenance.NewScheduler(maintenance.Options{
+		Jitter:       0.5,
+		PollInterval: 5 * time.Millisecond,
+	}, func() []string { return []string{"default.t"} })
+
+	var mu sync.Mutex
+	runs := 0
+	scheduler.Register("job", 10*time.Millisecond, func(ctx context.Context, db, table string) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		runs++
+		return "", nil
+	})
+	scheduler.Start()
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return runs >= 3
+	}, 5*time.Second, 5*time.Millisecond)
+	scheduler.Stop()
+	scheduler.Stop()
+
+	mu.Lock()
+	stopped := runs
+	mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, stopped, runs, "no jobs run after Stop")
+	mu.Unlock()
+}
+
+// TestMaintenanceEngineJobs 内置任务作用于存储引擎，执行历史可以通过 sys.maintenance_jobs 查询
+func TestMaintenanceEngineJobs(t *testing.T) {
+	dir := SetupTestDir(t, "maintenance_engine_jobs")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE events (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		_, err = execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO events VALUES (%d, 'e%d')", i, i))
+		require.NoError(t, err)
+	}
+	// Merge-on-Read delta 只作用于之前的文件，compaction 不能把前后的文件合并在一起
+	_, err = execSQL(t, exec, sess, "DELETE FROM events WHERE id = 1")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	for i := 4; i <= 6; i++ {
+		_, err = execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO events VALUES (%d, 'e%d')", i, i))
+		require.NoError(t, err)
+	}
+	_, err = execSQL(t, exec, sess, "CREATE EXTERNAL TABLE ext (id INT) LOCATION '"+t.TempDir()+"' FORMAT PARQUET")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default.events"}, engine.MaintainedTables(), "external tables are not maintained")
+
+	cfg := config.Default().Maintenance
+	cfg.Jitter = 0
+	cfg.Compaction.MinFiles = 2
+	cfg.Vacuum.Enabled = true
+	cfg.Vacuum.Retention = 0
+	statsMgr := statistics.NewStatisticsManager()
+	scheduler := maintenance.NewEngineScheduler(cfg, engine, statsMgr)
+	assert.Equal(t, []string{"compaction", "checkpoint", "vacuum", "analyze"}, scheduler.Jobs())
+	exec.SetMaintenanceJobSource(scheduler)
+
+	ctx := context.Background()
+	now := time.Now()
+	assert.Equal(t, 0, scheduler.RunDue(ctx, now))
+	assert.Equal(t, 4, scheduler.RunDue(ctx, now.Add(25*time.Hour)))
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.events", -1)
+	require.NoError(t, err)
+	dataFiles := 0
+	for _, f := range snapshot.Files {
+		if !f.IsDelta {
+			dataFiles++
+		}
+	}
+	assert.Equal(t, 2, dataFiles, "files before and after the delete are merged separately")
+	assert.Equal(t, []string{"2|e2|", "3|e3|", "4|e4|", "5|e5|", "6|e6|"}, sortedRows(t, exec, sess, "SELECT * FROM events"))
+
+	stats, err := statsMgr.GetTableStatistics("events")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), stats.RowCount)
+
+	result, err := execSQL(t, exec, sess, "SELECT job_type, db_name, table_name, status, attempt, message FROM sys.maintenance_jobs")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 4)
+	assert.Equal(t, "compaction|default|events|SUCCEEDED|1|compacted 6 files into 2|", rows[0])
+	assert.True(t, strings.HasPrefix(rows[1], "checkpoint|default|events|SUCCEEDED|1|checkpoint created at version "), rows[1])
+	assert.True(t, strings.HasPrefix(rows[2], "vacuum|default|events|SUCCEEDED|1|deleted "), rows[2])
+	assert.Equal(t, "analyze|default|events|SUCCEEDED|1|analyzed 5 rows|", rows[3])
+
+	// 没有新日志时 checkpoint 不重复创建
+	version, created, err := engine.CheckpointTable("default", "events")
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Greater(t, version, int64(0))
+}
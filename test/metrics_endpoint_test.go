@@ -0,0 +1,169 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/metrics"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// scrapeMetrics 请求 /metrics 并返回响应内容
+func scrapeMetrics(t *testing.T, server *httptest.Server) string {
+	resp, err := http.Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, metrics.ContentType, resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+// getHealth 请求 /healthz 并返回状态码和响应
+func getHealth(t *testing.T, server *httptest.Server) (int, metrics.HealthStatus) {
+	resp, err := http.Get(server.URL + "/healthz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var status metrics.HealthStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	return resp.StatusCode, status
+}
+
+// TestMetricsEndpoint 查询、扫描和写入反映在 /metrics 的计数器中
+func TestMetricsEndpoint(t *testing.T) {
+	dir := SetupTestDir(t, "metrics_endpoint")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	server := httptest.NewServer(metrics.NewAdminMux([]*metrics.Registry{metrics.Default},
+		metrics.HealthCheck{Name: "storage", Check: engine.Health}))
+	defer server.Close()
+
+	bytesWritten := metrics.ParquetBytesWritten.Value()
+	filesRead := metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomeRead).Value()
+	prunedByPartition := metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomePrunedByPartition).Value()
+
+	for _, sql := range []string{
+		"CREATE TABLE events (id INT, region VARCHAR) PARTITION BY LIST (region)",
+		"INSERT INTO events VALUES (1, 'eu')",
+		"INSERT INTO events VALUES (2, 'us')",
+		"SELECT id FROM events WHERE region = 'eu'",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+
+	assert.Greater(t, metrics.ParquetBytesWritten.Value(), bytesWritten, "inserts write Parquet files")
+	assert.Equal(t, filesRead+1, metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomeRead).Value())
+	assert.Equal(t, prunedByPartition+1, metrics.ScanFilesTotal.WithLabelValues(metrics.ScanOutcomePrunedByPartition).Value())
+
+	metrics.ObserveQuery("  select * from events", 3*time.Millisecond, nil)
+	metrics.ObserveQuery("DELETE FROM missing", time.Millisecond, assert.AnError)
+
+	body := scrapeMetrics(t, server)
+	assert.Contains(t, body, "# TYPE minidb_queries_total counter")
+	assert.Contains(t, body, `minidb_queries_total{statement="DELETE",status="error"}`)
+	assert.Contains(t, body, "# TYPE minidb_query_duration_seconds histogram")
+	assert.Contains(t, body, `minidb_query_duration_seconds_bucket{statement="SELECT",le="0.005"}`)
+	assert.Contains(t, body, `minidb_query_duration_seconds_bucket{statement="SELECT",le="+Inf"}`)
+	assert.Contains(t, body, `minidb_scan_files_total{outcome="pruned_by_partition"}`)
+	assert.Contains(t, body, "minidb_scan_bytes_read_total ")
+	assert.Contains(t, body, "minidb_parquet_bytes_written_total ")
+	assert.Contains(t, body, "minidb_delta_commit_retries_total ")
+	assert.Contains(t, body, "go_goroutines ")
+	assert.Contains(t, body, "go_memstats_alloc_bytes ")
+
+	// 每个指标族只声明一次类型
+	assert.Equal(t, 1, strings.Count(body, "# TYPE minidb_scan_files_total "))
+}
+
+// TestMetricsStatementType 语句类型标签取首关键字，未知语句归为 OTHER
+func TestMetricsStatementType(t *testing.T) {
+	cases := map[string]string{
+		"SELECT 1":                        "SELECT",
+		"  insert into t values (1)":      "INSERT",
+		"(SELECT id FROM t)":              "SELECT",
+		"EXPLAIN ANALYZE SELECT * FROM t": "EXPLAIN",
+		"FROBNICATE everything":           "OTHER",
+		"":                                "OTHER",
+	}
+	for sql, want := range cases {
+		assert.Equal(t, want, metrics.StatementType(sql), sql)
+	}
+}
+
+// TestHealthzReflectsEngineState 引擎打开后 /healthz 返回 200，关闭后返回 503
+func TestHealthzReflectsEngineState(t *testing.T) {
+	dir := SetupTestDir(t, "metrics_healthz")
+	engine, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(metrics.NewAdminMux(nil,
+		metrics.HealthCheck{Name: "storage", Check: engine.Health}))
+	defer server.Close()
+
+	code, status := getHealth(t, server)
+	assert.Equal(t, http.StatusServiceUnavailable, code, "engine is not open yet")
+	assert.Equal(t, "unavailable", status.Status)
+	assert.Contains(t, status.Checks["storage"], "not open")
+
+	require.NoError(t, engine.Open())
+	code, status = getHealth(t, server)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", status.Status)
+	assert.Equal(t, "ok", status.Checks["storage"])
+
+	require.NoError(t, engine.Close())
+	code, _ = getHealth(t, server)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+// TestCommitConflictRetryMetrics 另一个引擎先提交了同一版本时，写入刷新版本号后重试成功并计入重试指标
+func TestCommitConflictRetryMetrics(t *testing.T) {
+	dir := SetupTestDir(t, "metrics_commit_retry")
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+
+	writerA, err := storage.NewParquetEngine(dir, storage.WithOptimisticLock(true))
+	require.NoError(t, err)
+	require.NoError(t, writerA.Open())
+	defer writerA.Close()
+	require.NoError(t, writerA.CreateDatabase("db"))
+	require.NoError(t, writerA.CreateTable("db", "t", schema))
+
+	writerB, err := storage.NewParquetEngine(dir, storage.WithOptimisticLock(true))
+	require.NoError(t, err)
+	require.NoError(t, writerB.Open())
+	defer writerB.Close()
+
+	record := func(id int64) arrow.Record {
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+		defer builder.Release()
+		builder.Field(0).(*array.Int64Builder).Append(id)
+		return builder.NewRecord()
+	}
+	first, second := record(1), record(2)
+	defer first.Release()
+	defer second.Release()
+
+	conflicts := metrics.DeltaCommitConflicts.Value()
+	retries := metrics.DeltaCommitRetries.Value()
+
+	// A 先提交，B 的版本号已经过期
+	require.NoError(t, writerA.Write(context.Background(), "db", "t", first))
+	require.NoError(t, writerB.Write(context.Background(), "db", "t", second))
+
+	assert.Greater(t, metrics.DeltaCommitConflicts.Value(), conflicts)
+	assert.Greater(t, metrics.DeltaCommitRetries.Value(), retries)
+}
@@ -0,0 +1,252 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/executor/operators"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/statistics"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// setupParallelTest 创建包含多个数据文件的 sales 表
+func setupParallelTest(t *testing.T, name string) (*storage.ParquetEngine, *catalog.Catalog, *session.SessionManager) {
+	storageEngine, err := storage.NewParquetEngine(SetupTestDir(t, name))
+	require.NoError(t, err)
+	require.NoError(t, storageEngine.Open())
+	t.Cleanup(func() { storageEngine.Close() })
+
+	cat := catalog.NewCatalog()
+	cat.SetStorageEngine(storageEngine)
+	require.NoError(t, cat.Init())
+
+	sessMgr, err := session.NewSessionManager()
+	require.NoError(t, err)
+	sess := sessMgr.CreateSession()
+	sess.CurrentDB = "default"
+
+	_, err = execSQL(t, executor.NewExecutor(cat), sess, "CREATE TABLE sales (id INT, region VARCHAR, amount INT)")
+	require.NoError(t, err)
+
+	// 分多次写入，产生多个数据文件，其中一个文件超过一个 morsel
+	schema, err := storageEngine.GetTableSchema("default", "sales")
+	require.NoError(t, err)
+	id := 0
+	for _, rows := range []int{500, 1200, operators.MorselRows + 3000, 800} {
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+		for i := 0; i < rows; i++ {
+			builder.Field(0).(*array.Int64Builder).Append(int64((id * 7919) % 100000))
+			builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("region_%02d", id%37))
+			builder.Field(2).(*array.Int64Builder).Append(int64(id % 113))
+			id++
+		}
+		record := builder.NewRecord()
+		require.NoError(t, storageEngine.Write(context.Background(), "default", "sales", record))
+		record.Release()
+		builder.Release()
+	}
+
+	return storageEngine, cat, sessMgr
+}
+
+func TestSetMaxParallelism(t *testing.T) {
+	_, cat, sessMgr := setupParallelTest(t, "set_max_parallelism")
+	exec := executor.NewExecutor(cat)
+	sess := sessMgr.CreateSession()
+	sess.CurrentDB = "default"
+
+	stmt, err := parser.Parse("SET max_parallelism = 8;")
+	require.NoError(t, err)
+	setStmt, ok := stmt.(*parser.SetStmt)
+	require.True(t, ok, "expected *parser.SetStmt, got %T", stmt)
+	assert.Equal(t, "max_parallelism", setStmt.Variable)
+	assert.Equal(t, int64(8), setStmt.Value)
+
+	result, err := execSQL(t, exec, sess, "SET max_parallelism = 8")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"status"}, result.Headers)
+	assert.Equal(t, 8, sess.Variables["max_parallelism"])
+
+	_, err = execSQL(t, exec, sess, "SET MAX_PARALLELISM TO 2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, sess.Variables["max_parallelism"])
+
+	for _, sql := range []string{
+		"SET max_parallelism = 0",
+		"SET max_parallelism = -4",
+		"SET max_parallelism = 'lots'",
+		"SET max_parallelism = 1.5",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		assert.Error(t, err, sql)
+	}
+	assert.Equal(t, 2, sess.Variables["max_parallelism"], "invalid values must not change the setting")
+
+	_, err = parser.Parse("SET max_parallelism")
+	assert.Error(t, err)
+
+	// UPDATE ... SET 不会被当作会话变量赋值
+	stmt, err = parser.Parse("UPDATE sales SET amount = 1 WHERE id = 1")
+	require.NoError(t, err)
+	_, ok = stmt.(*parser.UpdateStmt)
+	assert.True(t, ok)
+}
+
+func TestParallelQueriesMatchSerialResults(t *testing.T) {
+	_, cat, sessMgr := setupParallelTest(t, "parallel_vs_serial")
+	exec := executor.NewExecutor(cat)
+
+	serial := sessMgr.CreateSession()
+	serial.CurrentDB = "default"
+	_, err := execSQL(t, exec, serial, "SET max_parallelism = 1")
+	require.NoError(t, err)
+
+	parallel := sessMgr.CreateSession()
+	parallel.CurrentDB = "default"
+	_, err = execSQL(t, exec, parallel, "SET max_parallelism = 8")
+	require.NoError(t, err)
+
+	queries := []struct {
+		sql     string
+		ordered bool
+	}{
+		{"SELECT id, region, amount FROM sales", false},
+		{"SELECT id, amount FROM sales WHERE amount > 100", false},
+		{"SELECT region, COUNT(*) AS cnt, SUM(amount) AS total, AVG(amount) AS avg_amount, MIN(amount) AS lo, MAX(amount) AS hi FROM sales GROUP BY region", false},
+		{"SELECT COUNT(*) AS cnt, SUM(amount) AS total FROM sales", false},
+		{"SELECT id, amount FROM sales ORDER BY amount DESC, id", true},
+	}
+	for _, q := range queries {
+		t.Run(q.sql, func(t *testing.T) {
+			expected, err := execSQL(t, exec, serial, q.sql)
+			require.NoError(t, err)
+			actual, err := execSQL(t, exec, parallel, q.sql)
+			require.NoError(t, err)
+
+			expectedRows := resultRows(expected)
+			actualRows := resultRows(actual)
+			assert.Equal(t, expected.Headers, actual.Headers)
+			require.NotEmpty(t, expectedRows)
+			if q.ordered {
+				assert.Equal(t, expectedRows, actualRows)
+			} else {
+				assert.ElementsMatch(t, expectedRows, actualRows)
+			}
+		})
+	}
+}
+
+func TestVectorizedParallelPipeline(t *testing.T) {
+	_, cat, sessMgr := setupParallelTest(t, "vectorized_parallel")
+	vexec := executor.NewVectorizedExecutor(cat, statistics.NewStatisticsManager())
+
+	run := func(dop int) []string {
+		sess := sessMgr.CreateSession()
+		sess.CurrentDB = "default"
+		sess.Variables["max_parallelism"] = dop
+
+		// SELECT * FROM sales WHERE amount > 56
+		plan := &optimizer.Plan{
+			Type:       optimizer.SelectPlan,
+			Properties: &optimizer.SelectProperties{All: true},
+			Children: []*optimizer.Plan{{
+				Type: optimizer.FilterPlan,
+				Properties: &optimizer.FilterProperties{Condition: &optimizer.BinaryExpression{
+					Left:     &optimizer.ColumnReference{Column: "amount"},
+					Operator: ">",
+					Right:    &optimizer.LiteralValue{Type: optimizer.LiteralTypeInteger, Value: int64(56)},
+				}},
+				Children: []*optimizer.Plan{{
+					Type:       optimizer.TableScanPlan,
+					Properties: &optimizer.TableScanProperties{Table: "sales"},
+				}},
+			}},
+		}
+		result, err := vexec.Execute(plan, sess)
+		require.NoError(t, err)
+
+		var rows []string
+		for _, batch := range result.Batches {
+			record := batch.ToRecord()
+			for i := 0; i < int(record.NumRows()); i++ {
+				rows = append(rows, fmt.Sprintf("%s|%s", record.Column(0).ValueStr(i), record.Column(2).ValueStr(i)))
+			}
+		}
+		return rows
+	}
+
+	serialRows := run(1)
+	parallelRows := run(8)
+	require.NotEmpty(t, serialRows)
+	// 快照中的文件顺序不固定，只比较结果集合
+	assert.ElementsMatch(t, serialRows, parallelRows)
+}
+
+func TestParallelScanAcrossFiles(t *testing.T) {
+	storageEngine, _, _ := setupParallelTest(t, "parallel_scan_files")
+
+	assert.Equal(t, 1, storage.ScanParallelism(context.Background()))
+	assert.Equal(t, 6, storage.ScanParallelism(storage.WithScanParallelism(context.Background(), 6)))
+
+	scanIDs := func(ctx context.Context) []int64 {
+		iter, err := storageEngine.Scan(ctx, "default", "sales", nil)
+		require.NoError(t, err)
+		defer iter.Close()
+
+		var ids []int64
+		for iter.Next() {
+			col := iter.Record().Column(0).(*array.Int64)
+			for i := 0; i < col.Len(); i++ {
+				ids = append(ids, col.Value(i))
+			}
+		}
+		require.NoError(t, iter.Err())
+		return ids
+	}
+
+	serialIDs := scanIDs(context.Background())
+	parallelIDs := scanIDs(storage.WithScanParallelism(context.Background(), 4))
+	require.Len(t, serialIDs, 500+1200+operators.MorselRows+3000+800)
+	assert.ElementsMatch(t, serialIDs, parallelIDs)
+}
+
+func TestParallelForEachAndMorsels(t *testing.T) {
+	results := make([]int, 100)
+	err := operators.ParallelForEach(len(results), 8, func(i int) error {
+		results[i] = i * i
+		return nil
+	})
+	require.NoError(t, err)
+	for i, v := range results {
+		assert.Equal(t, i*i, v)
+	}
+
+	err = operators.ParallelForEach(10, 4, func(i int) error {
+		if i == 3 || i == 7 {
+			return fmt.Errorf("task %d failed", i)
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "task 3 failed")
+
+	batches := makeSpillBatches(1, operators.MorselRows*2+10)
+	morsels := operators.SplitIntoMorsels(batches)
+	require.Len(t, morsels, 3)
+	var sizes []int
+	for _, m := range morsels {
+		sizes = append(sizes, int(m.Record().NumRows()))
+	}
+	sort.Ints(sizes)
+	assert.Equal(t, []int{10, operators.MorselRows, operators.MorselRows}, sizes)
+}
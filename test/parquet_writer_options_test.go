@@ -0,0 +1,245 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/apache/arrow/go/v18/parquet/compress"
+	"github.com/apache/arrow/go/v18/parquet/file"
+	"github.com/apache/arrow/go/v18/parquet/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parquet"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+const writerOptionsDDL = `CREATE TABLE metrics (id INT, category VARCHAR, reading INT)
+	WITH (compression = 'zstd', row_group_size = 100, dictionary_columns = 'category')`
+
+// writeMetricRows 按表 schema 写入一个 [start, start+n) 的批次
+func writeMetricRows(t *testing.T, engine *storage.ParquetEngine, start, n int) {
+	schema, err := engine.GetTableSchema("default", "metrics")
+	require.NoError(t, err)
+
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	for i := start; i < start+n; i++ {
+		builder.Field(0).(*array.Int64Builder).Append(int64(i))
+		builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("cat_%d", i%3))
+		builder.Field(2).(*array.Int64Builder).Append(int64(i * 10))
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	require.NoError(t, engine.Write(context.Background(), "default", "metrics", record))
+}
+
+// openParquetFooter 通过引擎的对象存储打开数据文件并读取 footer
+func openParquetFooter(t *testing.T, engine *storage.ParquetEngine, path string) *file.Reader {
+	r, err := engine.ParquetStore().GetReaderAt(path)
+	require.NoError(t, err)
+	reader, err := file.NewParquetReader(r)
+	require.NoError(t, err)
+	t.Cleanup(func() { reader.Close() })
+	return reader
+}
+
+// assertFooterFollowsOptions 检查每个 row group 的压缩算法、字典编码和列统计信息
+func assertFooterFollowsOptions(t *testing.T, reader *file.Reader, maxRowGroupRows int64) {
+	require.Greater(t, reader.NumRowGroups(), 0)
+	for rg := 0; rg < reader.NumRowGroups(); rg++ {
+		rgMeta := reader.MetaData().RowGroup(rg)
+		assert.LessOrEqual(t, rgMeta.NumRows(), maxRowGroupRows)
+
+		for col := 0; col < rgMeta.NumColumns(); col++ {
+			chunk, err := rgMeta.ColumnChunk(col)
+			require.NoError(t, err)
+			name := chunk.PathInSchema().String()
+
+			assert.Equal(t, compress.Codecs.Zstd, chunk.Compression(), "column %s", name)
+			assert.Equal(t, name == "category", chunk.HasDictionaryPage(), "dictionary encoding of column %s", name)
+
+			set, err := chunk.StatsSet()
+			require.NoError(t, err)
+			require.True(t, set, "column %s must carry footer statistics", name)
+			stats, err := chunk.Statistics()
+			require.NoError(t, err)
+			assert.True(t, stats.HasMinMax(), "column %s", name)
+			assert.Equal(t, int64(0), stats.NullCount(), "column %s", name)
+		}
+	}
+}
+
+// TestCreateTableWithOptionsParse WITH 子句解析
+func TestCreateTableWithOptionsParse(t *testing.T) {
+	node, err := parser.Parse(writerOptionsDDL)
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.CreateTableStmt)
+	require.True(t, ok, "expected CreateTableStmt, got %T", node)
+	assert.Equal(t, "metrics", stmt.Table)
+	assert.Len(t, stmt.Columns, 3)
+	assert.Equal(t, map[string]string{
+		"compression":        "zstd",
+		"row_group_size":     "100",
+		"dictionary_columns": "category",
+	}, stmt.Options)
+
+	// 没有 WITH 子句时选项为空
+	node, err = parser.Parse("CREATE TABLE plain (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	stmt, ok = node.(*parser.CreateTableStmt)
+	require.True(t, ok)
+	assert.Empty(t, stmt.Options)
+
+	_, err = parser.Parse("CREATE TABLE t (id INT) WITH (compression = 'zstd', compression = 'gzip')")
+	assert.Error(t, err, "duplicate options should be rejected")
+
+	opts, err := parquet.ParseWriterOptions(map[string]string{"compression": "ZSTD", "row_group_size": "64"})
+	require.NoError(t, err)
+	assert.Equal(t, "zstd", opts.Compression)
+	assert.Equal(t, int64(64), opts.RowGroupSize)
+}
+
+// TestCreateTableWithInvalidOptions 非法选项在建表时报错，且不会创建表
+func TestCreateTableWithInvalidOptions(t *testing.T) {
+	dir := SetupTestDir(t, "writer_options_invalid")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	for _, ddl := range []string{
+		"CREATE TABLE bad1 (id INT) WITH (compression = 'lzo')",
+		"CREATE TABLE bad2 (id INT) WITH (row_group_size = 0)",
+		"CREATE TABLE bad3 (id INT) WITH (dictionary_columns = 'missing')",
+		"CREATE TABLE bad4 (id INT) WITH (page_size = 1024)",
+	} {
+		_, err := execSQL(t, exec, sess, ddl)
+		assert.Error(t, err, ddl)
+	}
+
+	exists, err := engine.TableExists("default", "bad1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// TestParquetWriterOptionsHonoredByInserts 写入的数据文件遵循表级选项，选项随表元数据持久化
+func TestParquetWriterOptionsHonoredByInserts(t *testing.T) {
+	dir := SetupTestDir(t, "writer_options_inserts")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess, writerOptionsDDL)
+	require.NoError(t, err)
+
+	writeMetricRows(t, engine, 0, 250)
+	_, err = execSQL(t, exec, sess, "INSERT INTO metrics VALUES (250, 'cat_1', 2500)")
+	require.NoError(t, err)
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.metrics", -1)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Files, 2)
+
+	for _, f := range snapshot.Files {
+		reader := openParquetFooter(t, engine, f.Path)
+		assertFooterFollowsOptions(t, reader, 100)
+		if f.RowCount == 250 {
+			assert.Equal(t, 3, reader.NumRowGroups(), "250 rows with row_group_size=100")
+
+			// footer 中的 min/max 可供其他引擎裁剪
+			chunk, err := reader.MetaData().RowGroup(2).ColumnChunk(0)
+			require.NoError(t, err)
+			stats, err := chunk.Statistics()
+			require.NoError(t, err)
+			idStats := stats.(*metadata.Int64Statistics)
+			assert.Equal(t, int64(200), idStats.Min())
+			assert.Equal(t, int64(249), idStats.Max())
+		}
+	}
+
+	result, err := execSQL(t, exec, sess, "SELECT COUNT(*) AS cnt FROM metrics")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"251|"}, resultRows(result))
+	require.NoError(t, engine.Close())
+
+	// 重启后选项仍然有效
+	reopened, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+
+	schema, err := reopened.GetTableSchema("default", "metrics")
+	require.NoError(t, err)
+	opts := parquet.WriterOptionsFromSchema(schema)
+	require.NotNil(t, opts)
+	assert.Equal(t, "zstd", opts.Compression)
+	assert.Equal(t, int64(100), opts.RowGroupSize)
+	assert.Equal(t, []string{"category"}, opts.DictionaryColumns)
+}
+
+// TestParquetWriterOptionsHonoredByOptimize Compaction 和 Z-Order 重写的文件同样遵循表级选项
+func TestParquetWriterOptionsHonoredByOptimize(t *testing.T) {
+	dir := SetupTestDir(t, "writer_options_optimize")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, writerOptionsDDL)
+	require.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		writeMetricRows(t, engine, i*50, 50)
+	}
+
+	compactor := optimizer.NewCompactor(&optimizer.CompactionConfig{
+		TargetFileSize:    1024 * 1024,
+		MinFileSize:       1024 * 1024,
+		MaxFilesToCompact: 10,
+	})
+	require.NoError(t, compactor.CompactTable("default.metrics", engine))
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.metrics", -1)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Files, 1)
+	reader := openParquetFooter(t, engine, snapshot.Files[0].Path)
+	assertFooterFollowsOptions(t, reader, 100)
+	assert.Equal(t, 3, reader.NumRowGroups(), "300 compacted rows with row_group_size=100")
+
+	zopt := optimizer.NewZOrderOptimizer([]string{"id", "reading"})
+	require.NoError(t, zopt.OptimizeTable("default.metrics", snapshot.Files, engine))
+
+	snapshot, err = engine.GetDeltaLog().GetSnapshot("default.metrics", -1)
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshot.Files)
+	total := int64(0)
+	for _, f := range snapshot.Files {
+		assertFooterFollowsOptions(t, openParquetFooter(t, engine, f.Path), 100)
+		total += f.RowCount
+	}
+	assert.Equal(t, int64(300), total)
+}
+
+// TestParquetWriterDefaultOptions 未指定选项时默认 snappy 压缩并写入列统计信息
+func TestParquetWriterDefaultOptions(t *testing.T) {
+	dir := SetupTestDir(t, "writer_options_default")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE plain (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO plain VALUES (1, 'a')")
+	require.NoError(t, err)
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.plain", -1)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Files, 1)
+
+	reader := openParquetFooter(t, engine, snapshot.Files[0].Path)
+	for col := 0; col < 2; col++ {
+		chunk, err := reader.MetaData().RowGroup(0).ColumnChunk(col)
+		require.NoError(t, err)
+		assert.Equal(t, compress.Codecs.Snappy, chunk.Compression())
+		set, err := chunk.StatsSet()
+		require.NoError(t, err)
+		assert.True(t, set)
+	}
+}
@@ -0,0 +1,272 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/metrics"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/pkg/minidb"
+)
+
+// setupPreparedTest 创建包含三行的 users 表
+func setupPreparedTest(t *testing.T) (*executor.ExecutorImpl, *session.Session) {
+	dir := SetupTestDir(t, "prepared_statements")
+	engine, exec, sess := newTestEngine(t, dir)
+	t.Cleanup(func() { engine.Close() })
+
+	for _, sql := range []string{
+		"CREATE TABLE users (id INT, name VARCHAR, age INT)",
+		"PREPARE add_user (INT, VARCHAR, INT) AS INSERT INTO users VALUES ($1, $2, $3)",
+		"EXECUTE add_user (1, 'alice', 30)",
+		"EXECUTE add_user (2, 'bob', 25)",
+		"EXECUTE add_user ('3', 'carol', 41)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	return exec, sess
+}
+
+// TestPreparedStatementParse PREPARE / EXECUTE / DEALLOCATE 的解析
+func TestPreparedStatementParse(t *testing.T) {
+	node, err := parser.Parse("PREPARE find (INT, VARCHAR(20)) AS SELECT name FROM users WHERE id = $1 AND name <> $2")
+	require.NoError(t, err)
+	prepare, ok := node.(*parser.PrepareStmt)
+	require.True(t, ok)
+	assert.Equal(t, "find", prepare.Name)
+	assert.Equal(t, []string{parser.ParameterTypeInt, parser.ParameterTypeVarchar}, prepare.ParamTypes)
+	assert.Equal(t, "SELECT name FROM users WHERE id = $1 AND name <> $2", prepare.Query)
+
+	where := prepare.Statement.(*parser.SelectStmt).Where.Condition.(*parser.BinaryExpr)
+	first := where.Left.(*parser.BinaryExpr).Right.(*parser.Parameter)
+	assert.Equal(t, 1, first.Index)
+	assert.Equal(t, parser.ParameterTypeInt, first.DataType)
+
+	// 未声明类型的参数，字符串中的 $1 不是参数
+	node, err = parser.Parse("PREPARE upd AS UPDATE users SET name = $2 WHERE name = '$1' AND id = $1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, node.(*parser.PrepareStmt).ParamTypes)
+
+	node, err = parser.Parse("EXECUTE find (1, 'it''s', -2.5, TRUE)")
+	require.NoError(t, err)
+	execute := node.(*parser.ExecuteStmt)
+	assert.Equal(t, "find", execute.Name)
+	assert.Equal(t, []interface{}{int64(1), "it's", -2.5, true}, execute.Args)
+
+	node, err = parser.Parse("DEALLOCATE PREPARE find")
+	require.NoError(t, err)
+	assert.Equal(t, "find", node.(*parser.DeallocateStmt).Name)
+	node, err = parser.Parse("DEALLOCATE ALL")
+	require.NoError(t, err)
+	assert.True(t, node.(*parser.DeallocateStmt).All)
+
+	for sql, want := range map[string]string{
+		"PREPARE p AS SELECT * FROM users LIMIT $1":  "only supported in expressions",
+		"PREPARE p AS CREATE TABLE t (id INT)":       "can be prepared",
+		"PREPARE p (BLOB) AS SELECT * FROM users":    "unsupported parameter type",
+		"PREPARE p AS SELECT * FROM users WHERE a=$": "syntax error",
+	} {
+		_, err := parser.Parse(sql)
+		require.Error(t, err, sql)
+		assert.Contains(t, err.Error(), want, sql)
+	}
+}
+
+// TestPreparedStatementExecution 绑定参数执行查询和 DML，参数按声明的类型转换
+func TestPreparedStatementExecution(t *testing.T) {
+	exec, sess := setupPreparedTest(t)
+
+	_, err := execSQL(t, exec, sess, "PREPARE find (INT) AS SELECT name, age FROM users WHERE id = $1")
+	require.NoError(t, err)
+	for id, want := range map[string]string{"1": "alice|30|", "3": "carol|41|", "'2'": "bob|25|"} {
+		result, err := execSQL(t, exec, sess, "EXECUTE find ("+id+")")
+		require.NoError(t, err)
+		assert.Equal(t, []string{want}, resultRows(result), id)
+	}
+
+	_, err = execSQL(t, exec, sess, "PREPARE older AS SELECT id FROM users WHERE age > $1 AND name <> $2 ORDER BY id")
+	require.NoError(t, err)
+	result, err := execSQL(t, exec, sess, "EXECUTE older (26, 'carol')")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "PREPARE rename (VARCHAR, INT) AS UPDATE users SET name = $1 WHERE id = $2")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "EXECUTE rename ('bobby', 2)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "PREPARE remove AS DELETE FROM users WHERE id = $1")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "EXECUTE remove (1)")
+	require.NoError(t, err)
+
+	result, err = execSQL(t, exec, sess, "SELECT id, name FROM users ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|bobby|", "3|carol|"}, resultRows(result))
+
+	for sql, want := range map[string]string{
+		"EXECUTE find":                        "expected 1, got 0",
+		"EXECUTE find (1, 2)":                 "expected 1, got 2",
+		"EXECUTE find ('abc')":                "cannot use abc",
+		"EXECUTE find (NULL)":                 "NULL values are not supported",
+		"EXECUTE missing (1)":                 "does not exist",
+		"PREPARE find AS SELECT * FROM users": "already exists",
+		"DEALLOCATE missing":                  "does not exist",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.Error(t, err, sql)
+		assert.Contains(t, err.Error(), want, sql)
+	}
+
+	_, err = execSQL(t, exec, sess, "DEALLOCATE find")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "EXECUTE find (1)")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "DEALLOCATE ALL")
+	require.NoError(t, err)
+	_, ok := sess.PreparedStatement("older")
+	assert.False(t, ok)
+}
+
+// TestPlanCacheReuseAndInvalidation 同一条 SQL 复用缓存的计划，表的 schema 变化后重新生成计划
+func TestPlanCacheReuseAndInvalidation(t *testing.T) {
+	exec, sess := setupPreparedTest(t)
+	counter := func(result string) int64 {
+		return metrics.PlanCacheRequests.WithLabelValues(result).Value()
+	}
+	hits, misses, invalidated := counter(metrics.PlanCacheHit), counter(metrics.PlanCacheMiss), counter(metrics.PlanCacheInvalidated)
+
+	_, err := execSQL(t, exec, sess, "PREPARE q1 AS SELECT * FROM users WHERE id = $1")
+	require.NoError(t, err)
+	assert.Equal(t, misses+1, counter(metrics.PlanCacheMiss), "first PREPARE plans the statement")
+
+	// 空白不同的同一条 SQL 使用同一个缓存项
+	_, err = execSQL(t, exec, sess, "PREPARE q2 AS  SELECT *\n  FROM users WHERE id = $1 ;")
+	require.NoError(t, err)
+	result, err := execSQL(t, exec, sess, "EXECUTE q2 (1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|alice|30|"}, resultRows(result))
+	assert.Equal(t, hits+2, counter(metrics.PlanCacheHit))
+	cachedPlans := sess.PlanCache().Len()
+
+	// 删除后以不同的 schema 重建表，缓存的计划失效
+	for _, sql := range []string{
+		"DROP TABLE users",
+		"CREATE TABLE users (id INT, email VARCHAR)",
+		"INSERT INTO users VALUES (1, 'a@example.com')",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	result, err = execSQL(t, exec, sess, "EXECUTE q1 (1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|a@example.com|"}, resultRows(result))
+	assert.Equal(t, invalidated+1, counter(metrics.PlanCacheInvalidated))
+	assert.Equal(t, cachedPlans, sess.PlanCache().Len(), "the invalidated plan is replaced")
+
+	result, err = execSQL(t, exec, sess, "EXECUTE q2 (1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|a@example.com|"}, resultRows(result))
+	assert.Equal(t, hits+3, counter(metrics.PlanCacheHit))
+}
+
+// TestQueryPlanCache 普通的 SELECT/UPDATE/DELETE 语句按规范化的 SQL 复用计划，INSERT 和 DDL 不查找缓存
+func TestQueryPlanCache(t *testing.T) {
+	db, err := minidb.Open(SetupTestDir(t, "query_plan_cache"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	counter := func(result string) int64 {
+		return metrics.PlanCacheRequests.WithLabelValues(result).Value()
+	}
+	lookups := func() int64 {
+		return counter(metrics.PlanCacheHit) + counter(metrics.PlanCacheMiss) + counter(metrics.PlanCacheInvalidated)
+	}
+	countRows := func(sql string) int {
+		reader, err := conn.QueryArrow(ctx, sql)
+		require.NoError(t, err, sql)
+		defer reader.Release()
+		rows := 0
+		for reader.Next() {
+			rows += int(reader.Record().NumRows())
+		}
+		return rows
+	}
+
+	before := lookups()
+	for _, sql := range []string{
+		"CREATE TABLE users (id INT, name VARCHAR)",
+		"INSERT INTO users VALUES (1, 'alice'), (2, 'bob')",
+	} {
+		require.NoError(t, conn.Exec(ctx, sql), sql)
+	}
+	assert.Equal(t, before, lookups(), "INSERT and DDL do not use the plan cache")
+
+	hits, misses := counter(metrics.PlanCacheHit), counter(metrics.PlanCacheMiss)
+	assert.Equal(t, 1, countRows("SELECT * FROM users WHERE id = 1"))
+	assert.Equal(t, misses+1, counter(metrics.PlanCacheMiss))
+	assert.Equal(t, 1, countRows("SELECT *\n  FROM users WHERE id = 1;"))
+	assert.Equal(t, hits+1, counter(metrics.PlanCacheHit), "whitespace differences share the cached plan")
+
+	// 缓存的是计划而不是结果，命中时读取最新的数据
+	require.NoError(t, conn.Exec(ctx, "INSERT INTO users VALUES (1, 'alicia')"))
+	assert.Equal(t, 2, countRows("SELECT * FROM users WHERE id = 1"))
+	assert.Equal(t, hits+2, counter(metrics.PlanCacheHit))
+
+	// 重建表后缓存的计划失效
+	invalidated := counter(metrics.PlanCacheInvalidated)
+	for _, sql := range []string{
+		"DROP TABLE users",
+		"CREATE TABLE users (id INT, email VARCHAR)",
+		"INSERT INTO users VALUES (1, 'a@example.com')",
+	} {
+		require.NoError(t, conn.Exec(ctx, sql), sql)
+	}
+	assert.Equal(t, 1, countRows("SELECT * FROM users WHERE id = 1"))
+	assert.Equal(t, invalidated+1, counter(metrics.PlanCacheInvalidated))
+}
+
+// TestNormalizeSQL 规范化只合并引号外的空白
+func TestNormalizeSQL(t *testing.T) {
+	assert.Equal(t, "SELECT * FROM t WHERE name = 'a  b'",
+		optimizer.NormalizeSQL("  SELECT *\n\tFROM t   WHERE name = 'a  b' ;"))
+	assert.NotEqual(t, optimizer.PlanCacheKey("db1", nil, "SELECT 1"), optimizer.PlanCacheKey("db2", nil, "SELECT 1"))
+	assert.NotEqual(t,
+		optimizer.PlanCacheKey("db", []string{parser.ParameterTypeInt}, "SELECT $1"),
+		optimizer.PlanCacheKey("db", []string{parser.ParameterTypeVarchar}, "SELECT $1"))
+}
+
+// TestPreparedStatementPrivileges EXECUTE 按绑定后的语句检查权限
+func TestPreparedStatementPrivileges(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+	for _, sql := range []string{
+		"CREATE TABLE orders (id INT, amount INT)",
+		"INSERT INTO orders VALUES (1, 100)",
+		"CREATE USER bob WITH PASSWORD 'secret'",
+	} {
+		_, err := execSQL(t, exec, admin, sql)
+		require.NoError(t, err, sql)
+	}
+
+	bob := userSession("bob")
+	_, err := execSQL(t, exec, bob, "PREPARE q AS SELECT amount FROM orders WHERE id = $1")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, bob, "EXECUTE q (1)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+
+	_, err = execSQL(t, exec, admin, "GRANT SELECT ON orders TO bob")
+	require.NoError(t, err)
+	result, err := execSQL(t, exec, bob, "EXECUTE q (1)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"100|"}, resultRows(result))
+}
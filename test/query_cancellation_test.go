@@ -0,0 +1,219 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// execSQLContext 以 ctx 解析、优化并执行一条语句
+func execSQLContext(t *testing.T, ctx context.Context, exec *executor.ExecutorImpl, sess *session.Session, sql string) (*executor.ResultSet, error) {
+	t.Helper()
+	stmt, err := parser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := optimizer.NewOptimizer().OptimizeContext(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return exec.ExecuteContext(ctx, plan, sess)
+}
+
+// setupCancellationTest 创建包含 n 行的 numbers 表
+func setupCancellationTest(t *testing.T, n int) (*executor.ExecutorImpl, *session.Session) {
+	dir := SetupTestDir(t, "query_cancellation")
+	engine, exec, sess := newTestEngine(t, dir)
+	t.Cleanup(func() { engine.Close() })
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE numbers (id INT)")
+	require.NoError(t, err)
+	values := make([]string, n)
+	for i := range values {
+		values[i] = fmt.Sprintf("(%d)", i)
+	}
+	_, err = execSQL(t, exec, sess, "INSERT INTO numbers VALUES "+strings.Join(values, ", "))
+	require.NoError(t, err)
+	return exec, sess
+}
+
+// TestKillParse KILL QUERY / KILL SESSION 语句的解析
+func TestKillParse(t *testing.T) {
+	node, err := parser.Parse("KILL QUERY 42")
+	require.NoError(t, err)
+	kill, ok := node.(*parser.KillStmt)
+	require.True(t, ok)
+	assert.False(t, kill.Session)
+	assert.Equal(t, int64(42), kill.ID)
+
+	for _, sql := range []string{"KILL SESSION 7", "KILL CONNECTION 7"} {
+		node, err = parser.Parse(sql)
+		require.NoError(t, err, sql)
+		kill = node.(*parser.KillStmt)
+		assert.True(t, kill.Session, sql)
+		assert.Equal(t, int64(7), kill.ID, sql)
+	}
+
+	for _, sql := range []string{"KILL 42", "KILL QUERY", "KILL QUERY abc"} {
+		_, err = parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestStatementTimeoutVariable SET statement_timeout 接受时长字符串或毫秒数
+func TestStatementTimeoutVariable(t *testing.T) {
+	exec, sess := setupCancellationTest(t, 1)
+
+	_, err := execSQL(t, exec, sess, "SET statement_timeout = '30s'")
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, executor.StatementTimeout(sess))
+
+	_, err = execSQL(t, exec, sess, "SET statement_timeout = 500")
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, executor.StatementTimeout(sess))
+
+	_, err = execSQL(t, exec, sess, "SET statement_timeout = '-1s'")
+	assert.Error(t, err)
+
+	_, err = execSQL(t, exec, sess, "SET statement_timeout = 0")
+	require.NoError(t, err)
+	assert.Zero(t, executor.StatementTimeout(sess))
+}
+
+// TestStatementTimeoutCancelsQuery 超过 statement_timeout 的语句被取消并返回超时原因
+func TestStatementTimeoutCancelsQuery(t *testing.T) {
+	exec, sess := setupCancellationTest(t, 2000)
+
+	_, err := execSQL(t, exec, sess, "SET statement_timeout = '1ms'")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "SELECT a.id, b.id FROM numbers a, numbers b")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, executor.ErrStatementTimeout)
+
+	// 关闭超时后同一会话可以继续执行
+	_, err = execSQL(t, exec, sess, "SET statement_timeout = 0")
+	require.NoError(t, err)
+	result, err := execSQL(t, exec, sess, "SELECT id FROM numbers WHERE id = 5")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5|"}, resultRows(result))
+}
+
+// TestCanceledContext 已取消的 context 直接返回取消原因
+func TestCanceledContext(t *testing.T) {
+	exec, sess := setupCancellationTest(t, 10)
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(executor.ErrClientDisconnected)
+	_, err := execSQLContext(t, ctx, exec, sess, "SELECT id FROM numbers")
+	assert.ErrorIs(t, err, executor.ErrClientDisconnected)
+}
+
+// TestRunningQueries 执行中的语句出现在 sys.running_queries 中，KILL QUERY / KILL SESSION 取消语句
+func TestRunningQueries(t *testing.T) {
+	exec, sess := setupCancellationTest(t, 1)
+	registry := exec.RunningQueries()
+
+	ctx, finish := registry.Begin(context.Background(), sess, "SELECT query FROM sys.running_queries")
+	result, err := execSQLContext(t, ctx, exec, sess, "SELECT session_id, query FROM sys.running_queries")
+	finish()
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("%d|SELECT query FROM sys.running_queries|", sess.ID)}, resultRows(result))
+	assert.Empty(t, registry.Running(), "finished statements are unregistered")
+
+	// KILL QUERY 取消另一个会话正在执行的语句
+	other := &session.Session{ID: sess.ID + 1, CurrentDB: "default"}
+	otherCtx, otherFinish := registry.Begin(context.Background(), other, "SELECT 1")
+	defer otherFinish()
+	running := registry.Running()
+	require.Len(t, running, 1)
+
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("KILL QUERY %d", running[0].ID))
+	require.NoError(t, err)
+	<-otherCtx.Done()
+	assert.ErrorIs(t, context.Cause(otherCtx), executor.ErrQueryCanceled)
+
+	// KILL SESSION 取消会话的连接
+	sessCtx, cancelSession := context.WithCancelCause(context.Background())
+	defer cancelSession(nil)
+	unregister := registry.RegisterSession(other, cancelSession)
+	defer unregister()
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("KILL SESSION %d", other.ID))
+	require.NoError(t, err)
+	<-sessCtx.Done()
+	assert.ErrorIs(t, context.Cause(sessCtx), executor.ErrSessionTerminated)
+
+	_, err = execSQL(t, exec, sess, "KILL QUERY 9999")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "KILL SESSION 9999")
+	assert.Error(t, err)
+}
+
+// TestKillRequiresOwnership 启用认证时普通用户只能取消自己的语句
+func TestKillRequiresOwnership(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+	_, err := execSQL(t, exec, admin, "CREATE USER alice PASSWORD 'alice-secret'")
+	require.NoError(t, err)
+	registry := exec.RunningQueries()
+
+	adminCtx, adminFinish := registry.Begin(context.Background(), admin, "SELECT 1")
+	defer adminFinish()
+	aliceSess := userSession("alice")
+	aliceCtx, aliceFinish := registry.Begin(context.Background(), aliceSess, "SELECT 2")
+	defer aliceFinish()
+
+	running := registry.Running()
+	require.Len(t, running, 2)
+
+	_, err = execSQL(t, exec, aliceSess, fmt.Sprintf("KILL QUERY %d", running[0].ID))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+	assert.NoError(t, adminCtx.Err())
+
+	_, err = execSQL(t, exec, aliceSess, fmt.Sprintf("KILL QUERY %d", running[1].ID))
+	require.NoError(t, err)
+	assert.ErrorIs(t, context.Cause(aliceCtx), executor.ErrQueryCanceled)
+
+	// 超级用户可以取消任何语句
+	_, err = execSQL(t, exec, admin, fmt.Sprintf("KILL QUERY %d", running[0].ID))
+	require.NoError(t, err)
+	assert.ErrorIs(t, context.Cause(adminCtx), executor.ErrQueryCanceled)
+}
+
+// TestRunningQueriesVisibility 启用认证时普通用户在 sys.running_queries 中只能看到自己的语句，
+// 超级用户可以看到所有语句；语句中的密码不会出现在 sys.running_queries 中
+func TestRunningQueriesVisibility(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+	registry := exec.RunningQueries()
+
+	_, err := execSQL(t, exec, admin, "CREATE USER bob PASSWORD 'bob-secret'")
+	require.NoError(t, err)
+
+	_, adminFinish := registry.Begin(context.Background(), admin, "CREATE USER carol WITH password 'carol-secret'")
+	defer adminFinish()
+	bob := userSession("bob")
+	_, bobFinish := registry.Begin(context.Background(), bob, "SELECT 1")
+	defer bobFinish()
+
+	rows := sortedRows(t, exec, admin, "SELECT user_name, query FROM sys.running_queries")
+	assert.Equal(t, []string{
+		"admin|CREATE USER carol WITH password '********'|",
+		"bob|SELECT 1|",
+	}, rows, "superuser sees every statement with passwords redacted")
+
+	rows = sortedRows(t, exec, bob, "SELECT user_name, query FROM sys.running_queries")
+	assert.Equal(t, []string{"bob|SELECT 1|"}, rows, "regular users see only their own statements")
+
+	assert.Equal(t, "CREATE USER alice PASSWORD '********' SUPERUSER",
+		parser.RedactPasswords("CREATE USER alice PASSWORD 'it''s-secret' SUPERUSER"))
+	assert.Equal(t, "SELECT 'PASSWORD'", parser.RedactPasswords("SELECT 'PASSWORD'"))
+	assert.Equal(t, "<redacted>", parser.RedactPasswords("CREATE USER dave PASSWORD 'unterminated"))
+}
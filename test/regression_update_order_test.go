@@ -8,8 +8,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/yyun543/minidb/internal/catalog"
 	"github.com/yyun543/minidb/internal/executor"
-	"github.com/yyun543/minidb/internal/optimizer"
-	"github.com/yyun543/minidb/internal/parser"
 	"github.com/yyun543/minidb/internal/session"
 	"github.com/yyun543/minidb/internal/storage"
 )
@@ -38,21 +36,6 @@ func setupRegressionTest(t *testing.T, testName string) (*executor.ExecutorImpl,
 	return exec, sess, cleanup
 }
 
-// execSQL 执行SQL语句的辅助函数
-func execSQL(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string) (*executor.ResultSet, error) {
-	t.Helper()
-	stmt, err := parser.Parse(sql)
-	if err != nil {
-		return nil, err
-	}
-	opt := optimizer.NewOptimizer()
-	plan, err := opt.Optimize(stmt)
-	if err != nil {
-		return nil, err
-	}
-	return exec.Execute(plan, sess)
-}
-
 // TestOrderByWithBooleanType 回归测试: ORDER BY 支持 BOOLEAN 类型
 // 问题: ORDER BY 在处理 BOOLEAN 列时会导致服务器崩溃
 // 修复: internal/executor/operators/order_by.go 添加 Boolean 类型支持
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// restoreTable 执行 RESTORE TABLE，返回 restored_version|version|files_restored|files_removed|
+func restoreTable(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string) string {
+	result, err := execSQL(t, exec, sess, sql)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"restored_version", "version", "files_restored", "files_removed"}, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	return rows[0]
+}
+
+// TestRestoreTableParse RESTORE TABLE 语句解析
+func TestRestoreTableParse(t *testing.T) {
+	node, err := parser.Parse("RESTORE TABLE db.t TO VERSION AS OF 12")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.RestoreTableStmt)
+	require.True(t, ok)
+	assert.Equal(t, "db.t", stmt.Table)
+	assert.Equal(t, int64(12), stmt.Version)
+	assert.Nil(t, stmt.Timestamp)
+
+	node, err = parser.Parse("restore table t to timestamp as of '2024-05-01 10:00:00';")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-05-01 10:00:00", node.(*parser.RestoreTableStmt).Timestamp)
+
+	node, err = parser.Parse("RESTORE TABLE t TO TIMESTAMP AS OF 1714557600000")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1714557600000), node.(*parser.RestoreTableStmt).Timestamp)
+
+	for _, sql := range []string{
+		"RESTORE TABLE t",
+		"RESTORE TABLE t TO VERSION 3",
+		"RESTORE TABLE t TO VERSION AS OF 'x'",
+	} {
+		_, err := parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestRestoreTableToVersion 回滚错误导入，历史保持不变
+func TestRestoreTableToVersion(t *testing.T) {
+	dir := SetupTestDir(t, "restore_table_version")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (1, 'a'), (2, 'b')")
+	require.NoError(t, err)
+	good := deltaLog.GetLatestVersion()
+
+	// 错误的导入
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (3, 'bad'), (4, 'bad'), (5, 'bad')")
+	require.NoError(t, err)
+	bad := deltaLog.GetLatestVersion()
+
+	row := restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", good))
+	restoreVersion := deltaLog.GetLatestVersion()
+	assert.Equal(t, fmt.Sprintf("%d|%d|0|3|", good, restoreVersion), row)
+	assert.Equal(t, []string{"1|a|", "2|b|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	// 所有 REMOVE 在同一个新版本中提交
+	var restoreEntries []delta.LogEntry
+	for _, entry := range deltaLog.GetEntriesByTable("default.t") {
+		if entry.Version == restoreVersion {
+			restoreEntries = append(restoreEntries, entry)
+		}
+	}
+	require.Len(t, restoreEntries, 3)
+	for _, entry := range restoreEntries {
+		assert.Equal(t, delta.OpRemove, entry.Operation)
+	}
+
+	// 历史保持不变，可以再回到错误导入之后的版本
+	row = restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE default.t TO VERSION AS OF %d", bad))
+	assert.Equal(t, fmt.Sprintf("%d|%d|3|0|", bad, deltaLog.GetLatestVersion()), row)
+	assert.Len(t, sortedRows(t, exec, sess, "SELECT * FROM t"), 5)
+
+	// 表已处于目标状态时不提交新版本
+	latest := deltaLog.GetLatestVersion()
+	row = restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", bad))
+	assert.Equal(t, fmt.Sprintf("%d|0|0|0|", bad), row)
+	assert.Equal(t, latest, deltaLog.GetLatestVersion())
+
+	// 无效的版本
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", latest+10))
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "RESTORE TABLE missing TO VERSION AS OF 1")
+	assert.Error(t, err)
+}
+
+// TestRestoreTableMergeOnRead 回滚 UPDATE / DELETE，重新加入的文件仍按原顺序应用 delta
+func TestRestoreTableMergeOnRead(t *testing.T) {
+	dir := SetupTestDir(t, "restore_table_mor")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	empty := deltaLog.GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (1, 'a'), (2, 'b'), (3, 'c')")
+	require.NoError(t, err)
+	inserted := deltaLog.GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "UPDATE t SET name = 'bb' WHERE id = 2")
+	require.NoError(t, err)
+	updated := deltaLog.GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "DELETE FROM t WHERE id = 1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|bb|", "3|c|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", inserted))
+	assert.Equal(t, []string{"1|a|", "2|b|", "3|c|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	// 先清空再恢复到 UPDATE 之后：数据文件和 delta 文件都被重新加入
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", empty))
+	assert.Empty(t, sortedRows(t, exec, sess, "SELECT * FROM t"))
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", updated))
+	assert.Equal(t, []string{"1|a|", "2|bb|", "3|c|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	// RESTORE 之后仍可以继续写入
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (4, 'd')")
+	require.NoError(t, err)
+	assert.Len(t, sortedRows(t, exec, sess, "SELECT * FROM t"), 4)
+}
+
+// TestRestoreTableToTimestamp 按时间回滚
+func TestRestoreTableToTimestamp(t *testing.T) {
+	dir := SetupTestDir(t, "restore_table_timestamp")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (1)")
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	ts := time.Now().UnixMilli()
+	time.Sleep(20 * time.Millisecond)
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (2)")
+	require.NoError(t, err)
+
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO TIMESTAMP AS OF %d", ts))
+	assert.Equal(t, []string{"1|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	// 表创建之前没有可恢复的版本
+	_, err = execSQL(t, exec, sess, "RESTORE TABLE t TO TIMESTAMP AS OF '2000-01-01 00:00:00'")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "RESTORE TABLE t TO TIMESTAMP AS OF 'yesterday'")
+	assert.Error(t, err)
+}
+
+// TestRestoreTablePersistence RESTORE 提交在重启后保持，checkpoint 之前的版本从保留的日志恢复
+func TestRestoreTablePersistence(t *testing.T) {
+	dir := SetupTestDir(t, "restore_table_persistence")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	created := engine.GetDeltaLog().GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "INSERT INTO t VALUES (1, 'a'), (2, 'b')")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "UPDATE t SET name = 'x' WHERE id = 1")
+	require.NoError(t, err)
+	updated := engine.GetDeltaLog().GetLatestVersion()
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", created))
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", updated))
+	latest := engine.GetDeltaLog().GetLatestVersion()
+	require.NoError(t, engine.CreateCheckpoint("default.t", latest))
+	require.NoError(t, engine.Close())
+
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+	assert.Equal(t, []string{"1|x|", "2|b|"}, sortedRows(t, exec, sess, "SELECT * FROM t"))
+
+	// checkpoint 之前的日志仍在保留期内，重启后仍可恢复到更早的版本
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t TO VERSION AS OF %d", created))
+	assert.Empty(t, sortedRows(t, exec, sess, "SELECT * FROM t"))
+}
@@ -0,0 +1,537 @@
+package test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/config"
+	"github.com/yyun543/minidb/internal/delta"
+	"github.com/yyun543/minidb/internal/engine"
+	"github.com/yyun543/minidb/internal/objectstore"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// fakeS3Object 内存中的对象
+type fakeS3Object struct {
+	data     []byte
+	etag     string
+	modified time.Time
+}
+
+// fakeS3Server 内存中的 S3 兼容服务 (path-style)，支持条件写入、Range 读取和 ListObjectsV2 分页
+type fakeS3Server struct {
+	mu       sync.Mutex
+	bucket   string
+	objects  map[string]*fakeS3Object
+	pageSize int
+	requests map[string]int
+	unsigned int
+}
+
+func newFakeS3Server(t *testing.T, bucket string) (*fakeS3Server, *httptest.Server) {
+	fake := &fakeS3Server{
+		bucket:   bucket,
+		objects:  make(map[string]*fakeS3Object),
+		pageSize: 3,
+		requests: make(map[string]int),
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests[r.Method]++
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") ||
+		r.Header.Get("X-Amz-Date") == "" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		f.unsigned++
+	}
+
+	bucketPrefix := "/" + f.bucket
+	if r.URL.Path != bucketPrefix && !strings.HasPrefix(r.URL.Path, bucketPrefix+"/") {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, bucketPrefix), "/")
+
+	if key == "" && r.Method == http.MethodGet {
+		f.list(w, r)
+		return
+	}
+
+	obj := f.objects[key]
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("If-None-Match") == "*" && obj != nil {
+			http.Error(w, "PreconditionFailed", http.StatusPreconditionFailed)
+			return
+		}
+		if match := r.Header.Get("If-Match"); match != "" && (obj == nil || obj.etag != match) {
+			http.Error(w, "PreconditionFailed", http.StatusPreconditionFailed)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		sum := md5.Sum(data)
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		f.objects[key] = &fakeS3Object{data: data, etag: etag, modified: time.Now()}
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet, http.MethodHead:
+		if obj == nil {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", obj.etag)
+		w.Header().Set("Last-Modified", obj.modified.UTC().Format(http.TimeFormat))
+
+		data := obj.data
+		status := http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil || start > end || start >= len(data) {
+				http.Error(w, "InvalidRange", http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data = data[start : end+1]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// list 实现 ListObjectsV2 (continuation-token 为下一页起始下标)
+func (f *fakeS3Server) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("list-type") != "2" {
+		http.Error(w, "only ListObjectsV2 is supported", http.StatusBadRequest)
+		return
+	}
+
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, query.Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	start, _ := strconv.Atoi(query.Get("continuation-token"))
+	end := start + f.pageSize
+	if end > len(keys) {
+		end = len(keys)
+	}
+
+	type content struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	}
+	result := struct {
+		XMLName               xml.Name  `xml:"ListBucketResult"`
+		Contents              []content `xml:"Contents"`
+		IsTruncated           bool      `xml:"IsTruncated"`
+		NextContinuationToken string    `xml:"NextContinuationToken,omitempty"`
+	}{}
+	for _, key := range keys[start:end] {
+		result.Contents = append(result.Contents, content{Key: key, Size: len(f.objects[key].data)})
+	}
+	if end < len(keys) {
+		result.IsTruncated = true
+		result.NextContinuationToken = strconv.Itoa(end)
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result)
+}
+
+// keys 返回当前所有对象键
+func (f *fakeS3Server) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newTestS3Store(t *testing.T, endpoint, prefix string) *objectstore.S3Store {
+	store, err := objectstore.NewS3Store(objectstore.S3Config{
+		Endpoint:        endpoint,
+		Bucket:          "minidb-test",
+		Prefix:          prefix,
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestS3StoreObjectOperations(t *testing.T) {
+	fake, server := newFakeS3Server(t, "minidb-test")
+	store := newTestS3Store(t, server.URL, "warehouse")
+
+	require.NoError(t, store.Put("db/t/data/a.parquet", []byte("hello world")))
+	require.NoError(t, store.Put("db/t/data/b.parquet", []byte("b")))
+	require.NoError(t, store.Put("db/t/deltas/c.parquet", []byte("c")))
+	require.NoError(t, store.Put("db/t2/data/d.parquet", []byte("d")))
+	require.NoError(t, store.Put("db/t/data/e with space.parquet", []byte("e")))
+
+	data, err := store.Get("db/t/data/a.parquet")
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	_, err = store.Get("db/t/data/missing.parquet")
+	assert.ErrorContains(t, err, "object not found")
+
+	exists, err := store.Exists("db/t/data/a.parquet")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = store.Exists("db/t/data/missing.parquet")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	info, err := store.Stat("db/t/data/a.parquet")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), info.Size)
+	assert.NotEmpty(t, info.ETag)
+
+	// 前缀按目录匹配，且跨越多个分页
+	keys, err := store.List("db/t")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"db/t/data/a.parquet",
+		"db/t/data/b.parquet",
+		"db/t/data/e with space.parquet",
+		"db/t/deltas/c.parquet",
+	}, keys)
+
+	// 对象键带有配置的前缀
+	assert.Contains(t, fake.keys(), "warehouse/db/t/data/a.parquet")
+
+	// 随机读取
+	reader, err := store.GetReaderAt("db/t/data/a.parquet")
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	n, err := reader.ReadAt(buf, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(buf[:n]))
+	n, err = reader.ReadAt(buf, 9)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "ld", string(buf[:n]))
+	size, err := reader.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), size)
+	require.NoError(t, reader.Close())
+
+	// 写入器在 Close 时上传
+	writer, err := store.GetWriter("db/t/data/f.parquet")
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("streamed"))
+	require.NoError(t, err)
+	exists, _ = store.Exists("db/t/data/f.parquet")
+	assert.False(t, exists, "object must not be visible before Close")
+	require.NoError(t, writer.Close())
+	data, err = store.Get("db/t/data/f.parquet")
+	require.NoError(t, err)
+	assert.Equal(t, "streamed", string(data))
+
+	require.NoError(t, store.Delete("db/t/data/f.parquet"))
+	require.NoError(t, store.Delete("db/t/data/f.parquet"), "deleting a missing object is not an error")
+	exists, _ = store.Exists("db/t/data/f.parquet")
+	assert.False(t, exists)
+
+	assert.Zero(t, fake.unsigned, "all requests must carry a SigV4 signature")
+}
+
+func TestS3StoreConditionalWrites(t *testing.T) {
+	_, server := newFakeS3Server(t, "minidb-test")
+	store := newTestS3Store(t, server.URL, "")
+
+	require.NoError(t, store.PutIfNotExists("sys/_delta_log/00000000000000000001.json", []byte("v1")))
+	err := store.PutIfNotExists("sys/_delta_log/00000000000000000001.json", []byte("v1-other"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PreconditionFailed")
+
+	data, err := store.Get("sys/_delta_log/00000000000000000001.json")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(data), "losing writer must not overwrite the committed version")
+
+	info, err := store.Stat("sys/_delta_log/00000000000000000001.json")
+	require.NoError(t, err)
+
+	err = store.PutIfMatch("sys/_delta_log/00000000000000000001.json", []byte("stale"), "0123456789abcdef")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PreconditionFailed")
+
+	require.NoError(t, store.PutIfMatch("sys/_delta_log/00000000000000000001.json", []byte("v1-updated"), info.ETag))
+	err = store.PutIfMatch("sys/_delta_log/00000000000000000001.json", []byte("again"), info.ETag)
+	assert.ErrorContains(t, err, "PreconditionFailed", "ETag changed after the previous write")
+
+	require.NoError(t, store.PutIfMatch("sys/_delta_log/00000000000000000002.json", []byte("v2"), ""))
+	err = store.PutIfMatch("sys/_delta_log/00000000000000000002.json", []byte("v2"), "")
+	assert.ErrorContains(t, err, "PreconditionFailed")
+
+	// 两个乐观 Delta Log 实例竞争同一个版本
+	logA := delta.NewOptimisticDeltaLog(store, "shared")
+	logB := delta.NewOptimisticDeltaLog(store, "shared")
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+
+	require.NoError(t, logA.AppendMetadata("db.t", schema))
+	err = logB.AppendMetadata("db.t", schema)
+	var conflict *delta.ConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int64(1), conflict.Version)
+
+	// Bootstrap 后从最新版本继续提交
+	require.NoError(t, logB.Bootstrap())
+	assert.Equal(t, int64(1), logB.GetLatestVersion())
+	require.NoError(t, logB.AppendMetadata("db.t", schema))
+	assert.Equal(t, int64(2), logB.GetLatestVersion())
+}
+
+// writeS3TestRows 写入 [start, start+n) 的行
+func writeS3TestRows(t *testing.T, engine *storage.ParquetEngine, schema *arrow.Schema, start, n int) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	for i := start; i < start+n; i++ {
+		builder.Field(0).(*array.Int64Builder).Append(int64(i))
+		builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("name_%d", i))
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	require.NoError(t, engine.Write(context.Background(), "default", "items", record))
+}
+
+// scanS3TestRows 扫描 items 表，返回 id -> name
+func scanS3TestRows(t *testing.T, ctx context.Context, engine *storage.ParquetEngine) map[int64]string {
+	iter, err := engine.Scan(ctx, "default", "items", nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	rows := make(map[int64]string)
+	for iter.Next() {
+		record := iter.Record()
+		ids := record.Column(0).(*array.Int64)
+		names := record.Column(1).(*array.String)
+		for i := 0; i < int(record.NumRows()); i++ {
+			rows[ids.Value(i)] = names.Value(i)
+		}
+	}
+	require.NoError(t, iter.Err())
+	return rows
+}
+
+func TestParquetEngineOnS3Store(t *testing.T) {
+	fake, server := newFakeS3Server(t, "minidb-test")
+	// basePath 只是逻辑前缀，不应在本地创建任何文件
+	basePath := fmt.Sprintf("s3_engine_%d", time.Now().UnixNano())
+
+	engine, err := storage.NewParquetEngine(basePath, storage.WithObjectStore(newTestS3Store(t, server.URL, "warehouse")))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+
+	require.NoError(t, engine.CreateDatabase("default"))
+	exists, err := engine.DatabaseExists("default")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+	require.NoError(t, engine.CreateTable("default", "items", schema))
+
+	writeS3TestRows(t, engine, schema, 0, 100)
+	writeS3TestRows(t, engine, schema, 100, 50)
+	writeS3TestRows(t, engine, schema, 150, 25)
+
+	rows := scanS3TestRows(t, context.Background(), engine)
+	require.Len(t, rows, 175)
+	assert.Equal(t, "name_42", rows[42])
+
+	// 并行扫描同样从对象存储读取
+	parallelRows := scanS3TestRows(t, storage.WithScanParallelism(context.Background(), 4), engine)
+	assert.Equal(t, rows, parallelRows)
+
+	// Merge-on-Read 的 delta 文件也写入对象存储
+	updated, err := engine.Update(context.Background(), "default", "items",
+		[]storage.Filter{{Column: "id", Operator: "=", Value: int64(7)}},
+		map[string]interface{}{"name": "seven"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+	rows = scanS3TestRows(t, context.Background(), engine)
+	assert.Equal(t, "seven", rows[7])
+
+	var dataFiles, deltaFiles, logFiles int
+	for _, key := range fake.keys() {
+		require.True(t, strings.HasPrefix(key, "warehouse/"), key)
+		switch {
+		case strings.HasPrefix(key, "warehouse/default/items/data/"):
+			dataFiles++
+		case strings.HasPrefix(key, "warehouse/default/items/deltas/"):
+			deltaFiles++
+		case strings.HasPrefix(key, "warehouse/sys/delta_log/data/"):
+			logFiles++
+		}
+	}
+	assert.Equal(t, 3, dataFiles)
+	assert.Equal(t, 1, deltaFiles)
+	assert.Greater(t, logFiles, 0)
+	assert.Contains(t, fake.keys(), "warehouse/default/.db")
+	assert.Contains(t, fake.keys(), "warehouse/sys/.db")
+	assert.Greater(t, fake.requests[http.MethodGet], 0)
+
+	_, err = os.Stat(basePath)
+	assert.True(t, os.IsNotExist(err), "engine backed by S3 must not write to the local filesystem")
+	require.NoError(t, engine.Close())
+
+	// 新引擎从对象存储中的 sys.delta_log 恢复
+	reopened, err := storage.NewParquetEngine(basePath, storage.WithObjectStore(newTestS3Store(t, server.URL, "warehouse")))
+	require.NoError(t, err)
+	require.NoError(t, reopened.Open())
+	defer reopened.Close()
+
+	recoveredSchema, err := reopened.GetTableSchema("default", "items")
+	require.NoError(t, err)
+	assert.True(t, schema.Equal(recoveredSchema))
+	assert.Equal(t, rows, scanS3TestRows(t, context.Background(), reopened))
+}
+
+func TestParquetEngineOnS3OptimisticLog(t *testing.T) {
+	fake, server := newFakeS3Server(t, "minidb-test")
+	basePath := fmt.Sprintf("s3_optimistic_%d", time.Now().UnixNano())
+
+	newEngine := func() *storage.ParquetEngine {
+		engine, err := storage.NewParquetEngine(basePath,
+			storage.WithObjectStore(newTestS3Store(t, server.URL, "")),
+			storage.WithOptimisticLock(true))
+		require.NoError(t, err)
+		require.NoError(t, engine.Open())
+		return engine
+	}
+
+	writer1 := newEngine()
+	defer writer1.Close()
+	require.NoError(t, writer1.CreateDatabase("default"))
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+	require.NoError(t, writer1.CreateTable("default", "items", schema))
+	writeS3TestRows(t, writer1, schema, 0, 10)
+
+	// 第二个引擎启动时从版本文件恢复最新版本，提交不会与已有版本冲突
+	writer2 := newEngine()
+	defer writer2.Close()
+	assert.Equal(t, writer1.GetDeltaLog().GetLatestVersion(), writer2.GetDeltaLog().GetLatestVersion())
+	writeS3TestRows(t, writer2, schema, 10, 5)
+
+	versionFiles := 0
+	for _, key := range fake.keys() {
+		if strings.HasPrefix(key, "sys/_delta_log/") && strings.HasSuffix(key, ".json") {
+			versionFiles++
+		}
+	}
+	assert.Equal(t, 3, versionFiles, "metadata + two adds, each committed with a conditional PUT")
+
+	snapshot, err := writer2.GetDeltaLog().GetSnapshot("default.items", -1)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Files, 2)
+}
+
+// TestEngineWithS3Config 配置 storage.s3 后引擎把数据文件和 Delta Log 写入存储桶，凭据可以来自环境变量
+func TestEngineWithS3Config(t *testing.T) {
+	fake, server := newFakeS3Server(t, "minidb-test")
+	t.Setenv(config.S3AccessKeyIDEnv, "env-key")
+	t.Setenv(config.S3SecretAccessKeyEnv, "env-secret")
+
+	cfg, err := config.Parse([]byte(fmt.Sprintf(`
+storage:
+  s3:
+    endpoint: %s
+    bucket: minidb-test
+    prefix: warehouse
+    use_path_style: true
+maintenance:
+  enabled: false
+`, server.URL)))
+	require.NoError(t, err)
+	cfg.DataDir = SetupTestDir(t, "s3_engine_config")
+	accessKeyID, secretAccessKey := cfg.Storage.S3.Credentials()
+	assert.Equal(t, "env-key", accessKeyID)
+	assert.Equal(t, "env-secret", secretAccessKey)
+
+	eng, err := engine.New(cfg)
+	require.NoError(t, err)
+	sess := eng.Sessions().CreateSession()
+	sess.CurrentDB = "default"
+	for _, sql := range []string{"CREATE TABLE items (id INT)", "INSERT INTO items VALUES (1), (2), (3)"} {
+		_, err := eng.Execute(context.Background(), sess, sql)
+		require.NoError(t, err, sql)
+	}
+	require.NoError(t, eng.Close())
+
+	var dataFiles int
+	for _, key := range fake.keys() {
+		require.True(t, strings.HasPrefix(key, "warehouse/"), key)
+		if strings.HasPrefix(key, "warehouse/default/items/data/") {
+			dataFiles++
+		}
+	}
+	assert.Equal(t, 1, dataFiles, "buffered rows are flushed to the bucket on close")
+	assert.Contains(t, fake.keys(), "warehouse/sys/.db")
+
+	// 重新打开时从存储桶恢复
+	eng, err = engine.New(cfg)
+	require.NoError(t, err)
+	defer eng.Close()
+	sess = eng.Sessions().CreateSession()
+	sess.CurrentDB = "default"
+	result, err := eng.Execute(context.Background(), sess, "SELECT id FROM items")
+	require.NoError(t, err)
+	defer result.Release()
+	var rows int64
+	for _, record := range result.Records {
+		rows += record.NumRows()
+	}
+	assert.Equal(t, int64(3), rows)
+}
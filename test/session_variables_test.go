@@ -0,0 +1,252 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/apache/arrow/go/v18/parquet/compress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+)
+
+// showVariable 执行 SHOW name 并返回显示的值
+func showVariable(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, name string) string {
+	result, err := execSQL(t, exec, sess, "SHOW "+name)
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	return strings.TrimSuffix(rows[0], "|")
+}
+
+// TestSessionVariableParse SET / SHOW / RESET 的解析，SHOW TABLES 等不受影响
+func TestSessionVariableParse(t *testing.T) {
+	node, err := parser.Parse("SET TIME ZONE 'Asia/Shanghai'")
+	require.NoError(t, err)
+	set := node.(*parser.SetStmt)
+	assert.Equal(t, "timezone", set.Variable)
+	assert.Equal(t, "Asia/Shanghai", set.Value)
+
+	node, err = parser.Parse("SET work_mem TO DEFAULT")
+	require.NoError(t, err)
+	assert.Equal(t, "work_mem", node.(*parser.ResetStmt).Variable)
+
+	node, err = parser.Parse("SHOW Work_Mem")
+	require.NoError(t, err)
+	assert.Equal(t, "work_mem", node.(*parser.ShowVariableStmt).Variable)
+	node, err = parser.Parse("SHOW ALL;")
+	require.NoError(t, err)
+	assert.True(t, node.(*parser.ShowVariableStmt).All)
+	node, err = parser.Parse("SHOW TIME ZONE")
+	require.NoError(t, err)
+	assert.Equal(t, "timezone", node.(*parser.ShowVariableStmt).Variable)
+
+	node, err = parser.Parse("RESET ALL")
+	require.NoError(t, err)
+	assert.True(t, node.(*parser.ResetStmt).All)
+	node, err = parser.Parse("RESET search_path")
+	require.NoError(t, err)
+	assert.Equal(t, "search_path", node.(*parser.ResetStmt).Variable)
+
+	for sql, want := range map[string]interface{}{
+		"SHOW TABLES":              &parser.ShowTablesStmt{},
+		"SHOW DATABASES":           &parser.ShowDatabasesStmt{},
+		"SHOW INDEXES ON users":    &parser.ShowIndexesStmt{},
+		"SHOW CREATE TABLE users":  &parser.ShowCreateTableStmt{},
+		"SET max_parallelism TO 4": &parser.SetStmt{},
+	} {
+		node, err := parser.Parse(sql)
+		require.NoError(t, err, sql)
+		assert.IsType(t, want, node, sql)
+	}
+}
+
+// TestSessionVariableSetShowReset 设置、显示和恢复会话变量
+func TestSessionVariableSetShowReset(t *testing.T) {
+	_, exec, sess := newTestEngine(t, SetupTestDir(t, "session_variables"))
+
+	// 未设置时显示默认值
+	assert.Equal(t, "auto", showVariable(t, exec, sess, "vectorized_execution"))
+	assert.Equal(t, "table", showVariable(t, exec, sess, "result_format"))
+	assert.Equal(t, "snappy", showVariable(t, exec, sess, "default_compression"))
+	assert.Equal(t, "64MB", showVariable(t, exec, sess, "work_mem"))
+	assert.Equal(t, "0", showVariable(t, exec, sess, "statement_timeout"))
+	assert.Equal(t, "default", showVariable(t, exec, sess, "search_path"))
+
+	for _, sql := range []string{
+		"SET vectorized_execution = off",
+		"SET result_format = 'JSON'",
+		"SET work_mem = '256MB'",
+		"SET statement_timeout = '1m'",
+		"SET TIME ZONE '+05:30'",
+		"SET default_compression = ZSTD",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	assert.Equal(t, "off", showVariable(t, exec, sess, "vectorized_execution"))
+	assert.Equal(t, executor.VectorizedOff, executor.VectorizedExecution(sess))
+	assert.Equal(t, executor.ResultFormatJSON, executor.ResultFormat(sess))
+	assert.Equal(t, "256MB", showVariable(t, exec, sess, "work_mem"))
+	assert.Equal(t, "1m0s", showVariable(t, exec, sess, "statement_timeout"))
+	assert.Equal(t, "+05:30", showVariable(t, exec, sess, "TIME ZONE"))
+	assert.Equal(t, "zstd", showVariable(t, exec, sess, "default_compression"))
+
+	result, err := execSQL(t, exec, sess, "SHOW ALL")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "setting", "description"}, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 8)
+	assert.True(t, strings.HasPrefix(rows[0], "default_compression|zstd|"), rows[0])
+
+	for sql, want := range map[string]string{
+		"SET vectorized_execution = 'sometimes'": "must be on, off or auto",
+		"SET result_format = 'xml'":              "must be table, csv, json or arrow",
+		"SET work_mem = '1KB'":                   "at least 64KB",
+		"SET work_mem = 'lots'":                  "must be a size",
+		"SET timezone = 'Mars/Olympus_Mons'":     "invalid time zone",
+		"SET default_compression = 'zip'":        "unsupported compression codec",
+		"SET search_path = missing":              "does not exist",
+		"SET no_such_setting = 1":                "unrecognized configuration parameter",
+		"SHOW no_such_setting":                   "unrecognized configuration parameter",
+		"RESET no_such_setting":                  "unrecognized configuration parameter",
+		"SET work_mem = NULL":                    "cannot be NULL",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.Error(t, err, sql)
+		assert.Contains(t, err.Error(), want, sql)
+	}
+	assert.Equal(t, "256MB", showVariable(t, exec, sess, "work_mem"), "invalid values must not change the setting")
+
+	_, err = execSQL(t, exec, sess, "RESET work_mem")
+	require.NoError(t, err)
+	assert.Equal(t, "64MB", showVariable(t, exec, sess, "work_mem"))
+	_, err = execSQL(t, exec, sess, "SET vectorized_execution TO DEFAULT")
+	require.NoError(t, err)
+	assert.Equal(t, executor.VectorizedAuto, executor.VectorizedExecution(sess))
+
+	_, err = execSQL(t, exec, sess, "RESET ALL")
+	require.NoError(t, err)
+	assert.Equal(t, executor.ResultFormatTable, executor.ResultFormat(sess))
+	assert.Zero(t, executor.StatementTimeout(sess))
+	assert.Equal(t, time.Local, executor.Timezone(sess))
+	assert.Equal(t, "snappy", showVariable(t, exec, sess, "default_compression"))
+}
+
+// TestSearchPathSwitchesDatabase search_path 决定未限定的表名所在的数据库
+func TestSearchPathSwitchesDatabase(t *testing.T) {
+	_, exec, sess := newTestEngine(t, SetupTestDir(t, "session_search_path"))
+
+	for _, sql := range []string{
+		"CREATE DATABASE analytics",
+		"SET search_path = analytics",
+		"CREATE TABLE events (id INT, kind VARCHAR)",
+		"INSERT INTO events VALUES (1, 'click')",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	assert.Equal(t, "analytics", sess.CurrentDB)
+	assert.Equal(t, "analytics", showVariable(t, exec, sess, "search_path"))
+
+	_, err := execSQL(t, exec, sess, "RESET search_path")
+	require.NoError(t, err)
+	assert.Equal(t, "default", sess.CurrentDB)
+	_, err = execSQL(t, exec, sess, "SELECT * FROM events")
+	assert.Error(t, err, "events only exists in analytics")
+
+	result, err := execSQL(t, exec, sess, "SELECT kind FROM analytics.events")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"click|"}, resultRows(result))
+}
+
+// TestDefaultCompressionAppliesToNewTables default_compression 用于未指定 compression 的新表
+func TestDefaultCompressionAppliesToNewTables(t *testing.T) {
+	engine, exec, sess := newTestEngine(t, SetupTestDir(t, "session_default_compression"))
+	defer engine.Close()
+
+	for _, sql := range []string{
+		"SET default_compression = 'zstd'",
+		"CREATE TABLE zipped (id INT)",
+		"CREATE TABLE explicit (id INT) WITH (compression = 'gzip')",
+		"INSERT INTO zipped VALUES (1)",
+		"INSERT INTO explicit VALUES (1)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+
+	for table, want := range map[string]compress.Compression{
+		"zipped":   compress.Codecs.Zstd,
+		"explicit": compress.Codecs.Gzip,
+	} {
+		snapshot, err := engine.GetDeltaLog().GetSnapshot("default."+table, -1)
+		require.NoError(t, err)
+		require.Len(t, snapshot.Files, 1)
+		chunk, err := openParquetFooter(t, engine, snapshot.Files[0].Path).MetaData().RowGroup(0).ColumnChunk(0)
+		require.NoError(t, err)
+		assert.Equal(t, want, chunk.Compression(), table)
+	}
+}
+
+// TestTimezoneRendering 系统表和带时区的 TIMESTAMP 按会话时区显示
+func TestTimezoneRendering(t *testing.T) {
+	_, exec, _, admin := setupAccessControlTest(t)
+
+	createdAt := func() time.Time {
+		result, err := execSQL(t, exec, admin, "SELECT created_at FROM sys.users WHERE user_name = 'admin'")
+		require.NoError(t, err)
+		rows := resultRows(result)
+		require.Len(t, rows, 1)
+		ts, err := time.Parse("2006-01-02 15:04:05", strings.TrimSuffix(rows[0], "|"))
+		require.NoError(t, err)
+		return ts
+	}
+	_, err := execSQL(t, exec, admin, "SET timezone = 'UTC'")
+	require.NoError(t, err)
+	utc := createdAt()
+	_, err = execSQL(t, exec, admin, "SET timezone = '+05:00'")
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Hour, createdAt().Sub(utc))
+
+	// Arrow TIMESTAMP 列：带时区的转换到会话时区，不带时区的原样显示
+	instant := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	zoned := array.NewTimestampBuilder(memory.NewGoAllocator(), &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"})
+	zoned.Append(arrow.Timestamp(instant.UnixMilli()))
+	naive := array.NewTimestampBuilder(memory.NewGoAllocator(), &arrow.TimestampType{Unit: arrow.Millisecond})
+	naive.Append(arrow.Timestamp(instant.UnixMilli()))
+
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01 20:30:00+08:00", executor.FormatValue(zoned.NewArray(), 0, shanghai))
+	assert.Equal(t, "2024-03-01 12:30:00", executor.FormatValue(naive.NewArray(), 0, shanghai))
+}
+
+// TestResultFormats result_format 的 table / csv / json 输出
+func TestResultFormats(t *testing.T) {
+	exec, sess := setupPreparedTest(t)
+	result, err := execSQL(t, exec, sess, "SELECT id, name FROM users WHERE id <= 2 ORDER BY id")
+	require.NoError(t, err)
+	var records []arrow.Record
+	for _, batch := range result.Batches() {
+		records = append(records, batch.Record())
+	}
+	headers := result.Headers
+
+	table := executor.FormatResult(headers, records, executor.ResultFormatTable, time.UTC)
+	assert.Contains(t, table, "| alice           |")
+	assert.True(t, strings.HasSuffix(table, "2 rows in set\n"))
+	assert.Equal(t, "Empty set", executor.FormatResult(headers, nil, executor.ResultFormatTable, time.UTC))
+
+	assert.Equal(t, "id,name\n1,alice\n2,bob\n",
+		executor.FormatResult(headers, records, executor.ResultFormatCSV, time.UTC))
+	assert.Equal(t, "[\n  {\"id\": 1, \"name\": \"alice\"},\n  {\"id\": 2, \"name\": \"bob\"}\n]\n",
+		executor.FormatResult(headers, records, executor.ResultFormatJSON, time.UTC))
+	assert.Equal(t, "[]\n", executor.FormatResult(headers, nil, executor.ResultFormatJSON, time.UTC))
+}
@@ -0,0 +1,176 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/parser"
+)
+
+// TestShallowCloneParse SHALLOW CLONE 和 VACUUM 语句解析
+func TestShallowCloneParse(t *testing.T) {
+	node, err := parser.Parse("CREATE TABLE qa.t2 SHALLOW CLONE prod.t1 VERSION AS OF 7")
+	require.NoError(t, err)
+	stmt, ok := node.(*parser.CloneTableStmt)
+	require.True(t, ok)
+	assert.Equal(t, "qa.t2", stmt.Table)
+	assert.Equal(t, "prod.t1", stmt.Source)
+	assert.Equal(t, int64(7), stmt.Version)
+
+	node, err = parser.Parse("create table t2 shallow clone t1;")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), node.(*parser.CloneTableStmt).Version)
+
+	// 普通 CREATE TABLE 不受影响
+	node, err = parser.Parse("CREATE TABLE t3 (id INT)")
+	require.NoError(t, err)
+	_, ok = node.(*parser.CreateTableStmt)
+	assert.True(t, ok)
+
+	node, err = parser.Parse("VACUUM t RETAIN 0 HOURS DRY RUN")
+	require.NoError(t, err)
+	vacuum, ok := node.(*parser.VacuumStmt)
+	require.True(t, ok)
+	assert.Equal(t, int64(0), vacuum.RetainHours)
+	assert.True(t, vacuum.DryRun)
+
+	node, err = parser.Parse("VACUUM db.t")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), node.(*parser.VacuumStmt).RetainHours)
+
+	for _, sql := range []string{
+		"CREATE TABLE t2 SHALLOW CLONE",
+		"CREATE TABLE t2 SHALLOW CLONE t1 VERSION AS OF 'x'",
+		"VACUUM t RETAIN 5",
+		"VACUUM t RETAIN -1 HOURS",
+	} {
+		_, err := parser.Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+// TestShallowClone 克隆表引用源表文件，之后两张表的写入互不影响
+func TestShallowClone(t *testing.T) {
+	dir := SetupTestDir(t, "shallow_clone")
+	engine, exec, sess := newTestEngine(t, dir)
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t1 (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO t1 VALUES (1, 'a'), (2, 'b')")
+	require.NoError(t, err)
+	v1 := deltaLog.GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "UPDATE t1 SET name = 'bb' WHERE id = 2")
+	require.NoError(t, err)
+
+	result, err := execSQL(t, exec, sess, "CREATE TABLE t2 SHALLOW CLONE t1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source_version", "version", "files_cloned", "bytes_cloned"}, result.Headers)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	assert.Contains(t, rows[0], "|3|", "two data files and one delta file")
+	assert.Equal(t, []string{"1|a|", "2|bb|"}, sortedRows(t, exec, sess, "SELECT * FROM t2"))
+
+	// 零拷贝：新表目录下没有数据文件
+	_, err = os.Stat(filepath.Join(dir, "default", "t2", "data"))
+	assert.True(t, os.IsNotExist(err), "clone should not copy data files")
+
+	// 克隆历史版本
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("CREATE TABLE t3 SHALLOW CLONE default.t1 VERSION AS OF %d", v1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1|a|", "2|b|"}, sortedRows(t, exec, sess, "SELECT * FROM t3"))
+
+	// 写入互不影响
+	_, err = execSQL(t, exec, sess, "INSERT INTO t2 VALUES (3, 'c')")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "DELETE FROM t1 WHERE id = 1")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "UPDATE t3 SET name = 'x' WHERE id = 1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|bb|"}, sortedRows(t, exec, sess, "SELECT * FROM t1"))
+	assert.Equal(t, []string{"1|a|", "2|bb|", "3|c|"}, sortedRows(t, exec, sess, "SELECT * FROM t2"))
+	assert.Equal(t, []string{"1|x|", "2|b|"}, sortedRows(t, exec, sess, "SELECT * FROM t3"))
+
+	// 错误
+	for _, sql := range []string{
+		"CREATE TABLE t2 SHALLOW CLONE t1",
+		"CREATE TABLE t4 SHALLOW CLONE missing",
+		"CREATE TABLE t4 SHALLOW CLONE t1 VERSION AS OF 100000",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		assert.Error(t, err, sql)
+	}
+	_, err = execSQL(t, exec, sess, "SELECT * FROM t4")
+	assert.Error(t, err, "failed clone should not leave a table behind")
+
+	// 重启后克隆表仍然可用
+	require.NoError(t, engine.Close())
+	engine, exec, sess = newTestEngine(t, dir)
+	defer engine.Close()
+	assert.Equal(t, []string{"1|a|", "2|bb|", "3|c|"}, sortedRows(t, exec, sess, "SELECT * FROM t2"))
+}
+
+// TestVacuumSharedFiles VACUUM 不删除其他表仍引用的文件
+func TestVacuumSharedFiles(t *testing.T) {
+	dir := SetupTestDir(t, "vacuum_shared_files")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+	deltaLog := engine.GetDeltaLog()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE t1 (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	created := deltaLog.GetLatestVersion()
+	_, err = execSQL(t, exec, sess, "INSERT INTO t1 VALUES (1, 'a'), (2, 'b')")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "CREATE TABLE t2 SHALLOW CLONE t1")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "INSERT INTO t1 VALUES (9, 'only_t1')")
+	require.NoError(t, err)
+
+	// 源表移除全部文件
+	restoreTable(t, exec, sess, fmt.Sprintf("RESTORE TABLE t1 TO VERSION AS OF %d", created))
+	time.Sleep(10 * time.Millisecond)
+
+	// 默认保留期内不删除任何文件
+	result, err := execSQL(t, exec, sess, "VACUUM t1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"path"}, result.Headers)
+	assert.Empty(t, resultRows(result))
+
+	// 只有 t1 独有的文件可以删除，DRY RUN 不删除文件
+	result, err = execSQL(t, exec, sess, "VACUUM t1 RETAIN 0 HOURS DRY RUN")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	onlyT1 := rows[0][:len(rows[0])-1]
+	_, err = os.Stat(onlyT1)
+	require.NoError(t, err)
+
+	result, err = execSQL(t, exec, sess, "VACUUM t1 RETAIN 0 HOURS")
+	require.NoError(t, err)
+	assert.Equal(t, rows, resultRows(result))
+	_, err = os.Stat(onlyT1)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, []string{"1|a|", "2|b|"}, sortedRows(t, exec, sess, "SELECT * FROM t2"))
+
+	// 克隆表仍在保留期内引用的文件同样受保护
+	_, err = execSQL(t, exec, sess, "DROP TABLE t2")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec, sess, "VACUUM t1 RETAIN 1 HOURS")
+	require.NoError(t, err)
+	assert.Empty(t, resultRows(result))
+
+	time.Sleep(10 * time.Millisecond)
+	result, err = execSQL(t, exec, sess, "VACUUM t1 RETAIN 0 HOURS")
+	require.NoError(t, err)
+	assert.Len(t, resultRows(result), 2)
+
+	// 已删除的文件不能再用于 RESTORE
+	_, err = execSQL(t, exec, sess, fmt.Sprintf("RESTORE TABLE t1 TO VERSION AS OF %d", created+1))
+	assert.ErrorContains(t, err, "no longer exists")
+}
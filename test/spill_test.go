@@ -0,0 +1,300 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/executor/operators"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+	"github.com/yyun543/minidb/internal/types"
+)
+
+// batchSource 测试用的子算子，依次返回预先准备的批次
+type batchSource struct {
+	batches []*types.Batch
+	pos     int
+}
+
+func (s *batchSource) Init(ctx interface{}) error { return nil }
+
+func (s *batchSource) Next() (*types.Batch, error) {
+	if s.pos >= len(s.batches) {
+		return nil, nil
+	}
+	s.pos++
+	return s.batches[s.pos-1], nil
+}
+
+func (s *batchSource) Close() error { return nil }
+
+// spillContext 测试用的执行上下文，只提供内存记账器
+type spillContext struct {
+	acct *operators.MemoryAccountant
+}
+
+func (c *spillContext) MemoryAccountant() *operators.MemoryAccountant { return c.acct }
+
+// makeSpillBatches 生成 numBatches 个批次，每批 rowsPerBatch 行 (id, region, amount)
+func makeSpillBatches(numBatches, rowsPerBatch int) []*types.Batch {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "region", Type: arrow.BinaryTypes.String},
+		{Name: "amount", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	var batches []*types.Batch
+	for b := 0; b < numBatches; b++ {
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+		for i := 0; i < rowsPerBatch; i++ {
+			// 打乱 id 顺序，保证需要真正排序
+			id := int64((b*rowsPerBatch+i)*7919) % int64(numBatches*rowsPerBatch)
+			builder.Field(0).(*array.Int64Builder).Append(id)
+			builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("region_%02d", id%40))
+			builder.Field(2).(*array.Float64Builder).Append(float64(id % 100))
+		}
+		batches = append(batches, types.NewBatch(builder.NewRecord()))
+		builder.Release()
+	}
+	return batches
+}
+
+func TestOrderBySpillsToDisk(t *testing.T) {
+	acct := operators.NewMemoryAccountant(32*1024, SetupTestDir(t, "spill_order_by"))
+	defer acct.Cleanup()
+	ctx := &spillContext{acct: acct}
+
+	source := &batchSource{batches: makeSpillBatches(10, 1000)}
+	orderBy := operators.NewOrderBy([]optimizer.OrderKey{{Column: "id", Direction: "DESC"}}, source, ctx)
+	require.NoError(t, orderBy.Init(ctx))
+
+	var ids []int64
+	for {
+		batch, err := orderBy.Next()
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		col := batch.Record().Column(0).(*array.Int64)
+		for i := 0; i < col.Len(); i++ {
+			ids = append(ids, col.Value(i))
+		}
+	}
+	require.NoError(t, orderBy.Close())
+
+	assert.Greater(t, acct.SpillCount(), int64(1), "sort should produce multiple spilled runs")
+	require.Len(t, ids, 10000)
+	for i := 1; i < len(ids); i++ {
+		require.GreaterOrEqual(t, ids[i-1], ids[i], "rows must be sorted descending")
+	}
+}
+
+func TestGroupBySpillsToDisk(t *testing.T) {
+	acct := operators.NewMemoryAccountant(32*1024, SetupTestDir(t, "spill_group_by"))
+	defer acct.Cleanup()
+	ctx := &spillContext{acct: acct}
+
+	source := &batchSource{batches: makeSpillBatches(10, 1000)}
+	groupBy := operators.NewGroupBy(
+		[]optimizer.ColumnRef{{Column: "region"}},
+		[]optimizer.AggregateExpr{{Function: "COUNT", Column: "*", Alias: "cnt"}, {Function: "SUM", Column: "amount", Alias: "total"}},
+		[]optimizer.ColumnRef{
+			{Column: "region", Type: optimizer.ColumnRefTypeColumn},
+			{Column: "*", Alias: "cnt", Type: optimizer.ColumnRefTypeFunction, FunctionName: "COUNT"},
+			{Column: "amount", Alias: "total", Type: optimizer.ColumnRefTypeFunction, FunctionName: "SUM"},
+		},
+		source, ctx)
+	require.NoError(t, groupBy.Init(ctx))
+
+	counts := make(map[string]int64)
+	var total float64
+	for {
+		batch, err := groupBy.Next()
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		record := batch.Record()
+		regions := record.Column(0).(*array.String)
+		cnts := record.Column(1).(*array.Int64)
+		sums := record.Column(2).(*array.Float64)
+		for i := 0; i < int(record.NumRows()); i++ {
+			_, dup := counts[regions.Value(i)]
+			require.False(t, dup, "group %s returned twice", regions.Value(i))
+			counts[regions.Value(i)] = cnts.Value(i)
+			total += sums.Value(i)
+		}
+	}
+	require.NoError(t, groupBy.Close())
+
+	assert.Greater(t, acct.SpillCount(), int64(0), "aggregation should spill partitions")
+	assert.Len(t, counts, 40)
+	for region, cnt := range counts {
+		assert.Equal(t, int64(250), cnt, "region %s", region)
+	}
+	// sum(id % 100) for id in [0, 10000)
+	assert.Equal(t, float64(100*4950), total)
+}
+
+func TestSpillQueriesMatchInMemoryResults(t *testing.T) {
+	testDir := SetupTestDir(t, "spill_sql")
+	storageEngine, err := storage.NewParquetEngine(testDir)
+	require.NoError(t, err)
+	require.NoError(t, storageEngine.Open())
+	defer storageEngine.Close()
+
+	cat := catalog.NewCatalog()
+	cat.SetStorageEngine(storageEngine)
+	require.NoError(t, cat.Init())
+
+	sessMgr, err := session.NewSessionManager()
+	require.NoError(t, err)
+	sess := sessMgr.CreateSession()
+	sess.CurrentDB = "default"
+
+	exec := executor.NewExecutor(cat)
+	_, err = execSQL(t, exec, sess, "CREATE TABLE users (id INT, name VARCHAR)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "CREATE TABLE orders (order_id INT, user_id INT, amount INT)")
+	require.NoError(t, err)
+
+	// 直接批量写入，避免逐行 INSERT 产生大量小文件
+	usersSchema, err := storageEngine.GetTableSchema("default", "users")
+	require.NoError(t, err)
+	ub := array.NewRecordBuilder(memory.NewGoAllocator(), usersSchema)
+	for i := 0; i < 500; i++ {
+		ub.Field(0).(*array.Int64Builder).Append(int64(i))
+		ub.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("user_%03d", i))
+	}
+	usersRecord := ub.NewRecord()
+	require.NoError(t, storageEngine.Write(context.Background(), "default", "users", usersRecord))
+
+	ordersSchema, err := storageEngine.GetTableSchema("default", "orders")
+	require.NoError(t, err)
+	ob := array.NewRecordBuilder(memory.NewGoAllocator(), ordersSchema)
+	for i := 0; i < 3000; i++ {
+		ob.Field(0).(*array.Int64Builder).Append(int64((i * 7919) % 3000))
+		ob.Field(1).(*array.Int64Builder).Append(int64(i % 600)) // 500-599 没有匹配的用户
+		ob.Field(2).(*array.Int64Builder).Append(int64(i % 97))
+	}
+	ordersRecord := ob.NewRecord()
+	require.NoError(t, storageEngine.Write(context.Background(), "default", "orders", ordersRecord))
+
+	spillDir := filepath.Join(testDir, "spill")
+	smallExec := executor.NewExecutor(cat)
+	config := executor.DefaultOptimizerConfig()
+	config.WorkMemSize = 16 * 1024
+	config.SpillDir = spillDir
+	smallExec.SetConfig(config)
+
+	queries := []string{
+		"SELECT order_id, amount FROM orders ORDER BY order_id",
+		"SELECT user_id, COUNT(*) AS cnt, SUM(amount) AS total FROM orders GROUP BY user_id",
+		"SELECT u.id, u.name, o.order_id FROM users u JOIN orders o ON u.id = o.user_id",
+	}
+	for _, sql := range queries {
+		t.Run(sql, func(t *testing.T) {
+			expected, err := execSQL(t, exec, sess, sql)
+			require.NoError(t, err)
+			actual, err := execSQL(t, smallExec, sess, sql)
+			require.NoError(t, err)
+
+			expectedRows := resultRows(expected)
+			actualRows := resultRows(actual)
+			assert.Equal(t, expected.Headers, actual.Headers)
+			assert.Equal(t, len(expectedRows), len(actualRows))
+			if sql == queries[0] {
+				// ORDER BY 必须保持相同顺序
+				assert.Equal(t, expectedRows, actualRows)
+			} else {
+				assert.ElementsMatch(t, expectedRows, actualRows)
+			}
+		})
+	}
+
+	// 查询结束后溢写目录应被清理
+	entries, err := os.ReadDir(spillDir)
+	if err == nil {
+		assert.Empty(t, entries, "spill files must be removed after the query")
+	}
+}
+
+// TestOrderByNullOrderingWithAndWithoutSpill 内存排序和溢写归并对 NULL 排序键的处理一致
+func TestOrderByNullOrderingWithAndWithoutSpill(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "score", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	}, nil)
+	makeBatches := func() []*types.Batch {
+		var batches []*types.Batch
+		for b := 0; b < 10; b++ {
+			builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+			for i := 0; i < 500; i++ {
+				id := int64(b*500 + i)
+				builder.Field(0).(*array.Int64Builder).Append(id)
+				if id%5 == 0 {
+					builder.Field(1).(*array.Int64Builder).AppendNull()
+				} else {
+					// 包含 0 和负数，NULL 被当作 0 时顺序会不同
+					builder.Field(1).(*array.Int64Builder).Append(id%7 - 3)
+				}
+			}
+			batches = append(batches, types.NewBatch(builder.NewRecord()))
+			builder.Release()
+		}
+		return batches
+	}
+
+	sortRows := func(acct *operators.MemoryAccountant, direction string) []string {
+		ctx := &spillContext{acct: acct}
+		keys := []optimizer.OrderKey{{Column: "score", Direction: direction}, {Column: "id", Direction: "ASC"}}
+		orderBy := operators.NewOrderBy(keys, &batchSource{batches: makeBatches()}, ctx)
+		require.NoError(t, orderBy.Init(ctx))
+		var rows []string
+		for {
+			batch, err := orderBy.Next()
+			require.NoError(t, err)
+			if batch == nil {
+				break
+			}
+			record := batch.Record()
+			for i := 0; i < int(record.NumRows()); i++ {
+				rows = append(rows, record.Column(0).ValueStr(i)+"|"+record.Column(1).ValueStr(i))
+			}
+		}
+		require.NoError(t, orderBy.Close())
+		return rows
+	}
+
+	for _, direction := range []string{"ASC", "DESC"} {
+		inMemory := sortRows(nil, direction)
+		acct := operators.NewMemoryAccountant(16*1024, SetupTestDir(t, "spill_order_by_nulls_"+direction))
+		spilled := sortRows(acct, direction)
+		assert.Greater(t, acct.SpillCount(), int64(1), "sort should spill")
+		acct.Cleanup()
+
+		require.Len(t, inMemory, 5000)
+		assert.Equal(t, inMemory, spilled, direction)
+
+		// NULL 小于任何值：ASC 时排在最前，DESC 时排在最后
+		nullRows := inMemory[:1000]
+		if direction == "DESC" {
+			nullRows = inMemory[4000:]
+		}
+		for _, row := range nullRows {
+			require.True(t, strings.HasSuffix(row, "|(null)"), "%s: %s", direction, row)
+		}
+	}
+}
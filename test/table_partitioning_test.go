@@ -0,0 +1,303 @@
+package test
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+// partitionDirsOf 返回快照中数据文件所在的分区目录 (相对于表的 data 目录)
+func partitionDirsOf(t *testing.T, engine *storage.ParquetEngine, dir, tableID string) []string {
+	snapshot, err := engine.GetDeltaLog().GetSnapshot(tableID, -1)
+	require.NoError(t, err)
+	db, table := tableID[:strings.Index(tableID, ".")], tableID[strings.Index(tableID, ".")+1:]
+	dataDir := filepath.Join(dir, db, table, "data")
+
+	seen := map[string]bool{}
+	for _, f := range snapshot.Files {
+		rel, err := filepath.Rel(dataDir, filepath.Dir(f.Path))
+		require.NoError(t, err)
+		seen[filepath.ToSlash(rel)] = true
+	}
+	dirs := make([]string, 0, len(seen))
+	for d := range seen {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// scanPartitionIDs 只使用分区裁剪条件扫描表 (不做行级过滤)，返回 id 列
+func scanPartitionIDs(t *testing.T, engine *storage.ParquetEngine, table string, filters ...storage.Filter) []string {
+	ctx := storage.WithPartitionFilters(context.Background(), filters)
+	iter, err := engine.Scan(ctx, "default", table, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var ids []string
+	for iter.Next() {
+		record := iter.Record()
+		for i := 0; i < int(record.NumRows()); i++ {
+			ids = append(ids, record.Column(0).ValueStr(i))
+		}
+	}
+	require.NoError(t, iter.Err())
+	sort.Strings(ids)
+	return ids
+}
+
+// TestPartitionClauseParse PARTITION BY 子句与 ALTER TABLE DROP PARTITION 解析
+func TestPartitionClauseParse(t *testing.T) {
+	node, err := parser.Parse(`CREATE TABLE events (id INT, ts INT) PARTITION BY RANGE (ts) (
+		PARTITION p0 VALUES LESS THAN (100),
+		PARTITION p1 VALUES LESS THAN (200),
+		PARTITION pmax VALUES LESS THAN MAXVALUE)`)
+	require.NoError(t, err)
+	stmt := node.(*parser.CreateTableStmt)
+	require.NotNil(t, stmt.Partition)
+	assert.Equal(t, "RANGE", stmt.Partition.Type)
+	assert.Equal(t, []string{"ts"}, stmt.Partition.Columns)
+	require.Len(t, stmt.Partition.Partitions, 3)
+	assert.Equal(t, int64(100), stmt.Partition.Partitions[0].LessThan)
+	assert.True(t, stmt.Partition.Partitions[2].MaxValue)
+
+	node, err = parser.Parse(`CREATE TABLE sales (id INT, region VARCHAR) PARTITION BY LIST (region) (
+		PARTITION americas VALUES IN ('us', 'ca'), PARTITION europe VALUES IN ('de'))`)
+	require.NoError(t, err)
+	stmt = node.(*parser.CreateTableStmt)
+	assert.Equal(t, "LIST", stmt.Partition.Type)
+	assert.Equal(t, []interface{}{"us", "ca"}, stmt.Partition.Partitions[0].Values)
+
+	node, err = parser.Parse("CREATE TABLE h (id INT, v INT) PARTITION BY HASH (id) PARTITIONS 8 WITH (compression = 'zstd')")
+	require.NoError(t, err)
+	stmt = node.(*parser.CreateTableStmt)
+	assert.Equal(t, "HASH", stmt.Partition.Type)
+	assert.Equal(t, 8, stmt.Partition.PartitionNum)
+	assert.Empty(t, stmt.Partition.Partitions)
+	assert.Equal(t, "zstd", stmt.Options["compression"])
+
+	for _, bad := range []string{
+		"CREATE TABLE t (ts INT) PARTITION BY RANGE (ts)",
+		"CREATE TABLE t (ts INT) PARTITION BY RANGE (ts) (PARTITION p0 VALUES LESS THAN MAXVALUE, PARTITION p1 VALUES LESS THAN (10))",
+		"CREATE TABLE t (ts INT) PARTITION BY RANGE (ts) (PARTITION p0 VALUES LESS THAN (5), PARTITION p0 VALUES LESS THAN (10))",
+		"CREATE TABLE t (a INT, b INT) PARTITION BY LIST (a, b) (PARTITION p0 VALUES IN (1))",
+	} {
+		_, err := parser.Parse(bad)
+		assert.Error(t, err, bad)
+	}
+
+	node, err = parser.Parse("ALTER TABLE events DROP PARTITION p0")
+	require.NoError(t, err)
+	alter := node.(*parser.AlterTableStmt)
+	assert.Equal(t, parser.AlterTableDropPartition, alter.Action)
+	assert.Equal(t, "p0", alter.PartitionName)
+
+	node, err = parser.Parse("ALTER TABLE sales DROP PARTITION (region = 'us')")
+	require.NoError(t, err)
+	alter = node.(*parser.AlterTableStmt)
+	assert.Equal(t, map[string]interface{}{"region": "us"}, alter.PartitionValues)
+
+	_, err = parser.Parse("ALTER TABLE sales ADD COLUMN x INT")
+	assert.Error(t, err)
+}
+
+// TestListPartitionedTableLayoutAndPruning 按列值分区: 分区目录、ADD 条目中的分区值、分区裁剪
+func TestListPartitionedTableLayoutAndPruning(t *testing.T) {
+	dir := SetupTestDir(t, "partition_list")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE sales (id INT, region VARCHAR, amount INT) PARTITION BY LIST (region)")
+	require.NoError(t, err)
+	for _, sql := range []string{
+		"INSERT INTO sales VALUES (1, 'us', 10)",
+		"INSERT INTO sales VALUES (2, 'eu', 20)",
+		"INSERT INTO sales VALUES (3, 'us', 30)",
+		"INSERT INTO sales VALUES (4, 'apac', 40)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+
+	assert.Equal(t, []string{"region=apac", "region=eu", "region=us"}, partitionDirsOf(t, engine, dir, "default.sales"))
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.sales", -1)
+	require.NoError(t, err)
+	for _, f := range snapshot.Files {
+		assert.Equal(t, filepath.Base(filepath.Dir(f.Path)), "region="+f.PartitionValues["region"])
+	}
+
+	// 只读取匹配的分区目录
+	assert.Equal(t, []string{"1", "3"}, scanPartitionIDs(t, engine, "sales", storage.Filter{Column: "region", Operator: "=", Value: "us"}))
+	assert.Equal(t, []string{"2", "4"}, scanPartitionIDs(t, engine, "sales",
+		storage.Filter{Column: "region", Operator: "IN", Values: []interface{}{"eu", "apac"}}))
+	assert.Len(t, scanPartitionIDs(t, engine, "sales", storage.Filter{Column: "amount", Operator: ">", Value: int64(100)}), 4,
+		"filters on non-partition columns must not prune partitions")
+
+	// SQL 查询结果不受裁剪影响
+	result, err := execSQL(t, exec, sess, "SELECT id FROM sales WHERE region = 'us' AND amount > 15")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3|"}, resultRows(result))
+	result, err = execSQL(t, exec, sess, "SELECT id FROM sales WHERE region IN ('eu', 'apac') ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|", "4|"}, resultRows(result))
+
+	// 分区值随 sys.delta_log 持久化
+	require.NoError(t, engine.Close())
+	engine2, exec2, sess2 := newTestEngine(t, dir)
+	defer engine2.Close()
+	snapshot, err = engine2.GetDeltaLog().GetSnapshot("default.sales", -1)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Files, 4)
+	for _, f := range snapshot.Files {
+		assert.NotEmpty(t, f.PartitionValues["region"], f.Path)
+	}
+	assert.Equal(t, []string{"1", "3"}, scanPartitionIDs(t, engine2, "sales", storage.Filter{Column: "region", Operator: "=", Value: "us"}))
+
+	// 按列值删除分区
+	_, err = execSQL(t, exec2, sess2, "ALTER TABLE sales DROP PARTITION (region = 'us')")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec2, sess2, "SELECT id FROM sales ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|", "4|"}, resultRows(result))
+
+	_, err = execSQL(t, exec2, sess2, "ALTER TABLE sales DROP PARTITION (region = 'us')")
+	assert.Error(t, err, "dropping a partition without data should fail")
+	_, err = execSQL(t, exec2, sess2, "ALTER TABLE sales DROP PARTITION p0")
+	assert.Error(t, err, "value-partitioned tables have no named partitions")
+}
+
+// TestRangePartitionedTable 显式 RANGE 分区: 范围裁剪、越界写入报错、删除命名分区
+func TestRangePartitionedTable(t *testing.T) {
+	dir := SetupTestDir(t, "partition_range")
+	engine, exec, sess := newTestEngine(t, dir)
+
+	_, err := execSQL(t, exec, sess, `CREATE TABLE events (id INT, ts INT) PARTITION BY RANGE (ts) (
+		PARTITION p0 VALUES LESS THAN (100),
+		PARTITION p1 VALUES LESS THAN (200))`)
+	require.NoError(t, err)
+	for _, sql := range []string{
+		"INSERT INTO events VALUES (1, 5)",
+		"INSERT INTO events VALUES (2, 99)",
+		"INSERT INTO events VALUES (3, 100)",
+		"INSERT INTO events VALUES (4, 150)",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+	_, err = execSQL(t, exec, sess, "INSERT INTO events VALUES (5, 250)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no partition for value 250")
+
+	assert.Equal(t, []string{"ts_range=p0", "ts_range=p1"}, partitionDirsOf(t, engine, dir, "default.events"))
+	assert.Equal(t, []string{"3", "4"}, scanPartitionIDs(t, engine, "events", storage.Filter{Column: "ts", Operator: ">=", Value: int64(100)}))
+	assert.Equal(t, []string{"1", "2"}, scanPartitionIDs(t, engine, "events", storage.Filter{Column: "ts", Operator: "<", Value: int64(100)}))
+	assert.Equal(t, []string{"1", "2"}, scanPartitionIDs(t, engine, "events", storage.Filter{Column: "ts", Operator: "=", Value: int64(42)}))
+
+	result, err := execSQL(t, exec, sess, "SELECT id FROM events WHERE ts >= 99 ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2|", "3|", "4|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "ALTER TABLE events DROP PARTITION missing")
+	assert.Error(t, err)
+	_, err = execSQL(t, exec, sess, "ALTER TABLE events DROP PARTITION p0")
+	require.NoError(t, err)
+	result, err = execSQL(t, exec, sess, "SELECT id FROM events ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3|", "4|"}, resultRows(result))
+
+	// 被删除的分区从分区定义中移除，重启后仍然生效
+	require.NoError(t, engine.Close())
+	engine2, exec2, sess2 := newTestEngine(t, dir)
+	defer engine2.Close()
+	schema, err := engine2.GetTableSchema("default", "events")
+	require.NoError(t, err)
+	spec := storage.PartitionSpecFromSchema(schema)
+	require.NotNil(t, spec)
+	require.Len(t, spec.Partitions, 1)
+	assert.Equal(t, "p1", spec.Partitions[0].Name)
+
+	// 与 MySQL 一致，下一个分区接管被删除分区的取值范围
+	_, err = execSQL(t, exec2, sess2, "INSERT INTO events VALUES (6, 50)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ts_range=p1"}, partitionDirsOf(t, engine2, dir, "default.events"))
+	_, err = execSQL(t, exec2, sess2, "ALTER TABLE events DROP PARTITION p1")
+	assert.Error(t, err, "the last partition cannot be dropped")
+}
+
+// TestHashPartitionedTable HASH 分区: 分桶目录、等值条件裁剪、不支持删除分区
+func TestHashPartitionedTable(t *testing.T) {
+	dir := SetupTestDir(t, "partition_hash")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE users (id INT, name VARCHAR) PARTITION BY HASH (id) PARTITIONS 4")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, `INSERT INTO users VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e'), (6, 'f'), (7, 'g'), (8, 'h')`)
+	require.NoError(t, err)
+
+	dirs := partitionDirsOf(t, engine, dir, "default.users")
+	require.NotEmpty(t, dirs)
+	for _, d := range dirs {
+		assert.True(t, strings.HasPrefix(d, "id_bucket="), d)
+	}
+	assert.Greater(t, len(dirs), 1, "rows should be spread across buckets")
+
+	ids := scanPartitionIDs(t, engine, "users", storage.Filter{Column: "id", Operator: "=", Value: int64(3)})
+	assert.Contains(t, ids, "3")
+	assert.Less(t, len(ids), 8, "an equality filter should only read one bucket")
+
+	result, err := execSQL(t, exec, sess, "SELECT name FROM users WHERE id = 3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c|"}, resultRows(result))
+
+	_, err = execSQL(t, exec, sess, "ALTER TABLE users DROP PARTITION (id = 3)")
+	assert.Error(t, err)
+
+	// 非分区表不支持 DROP PARTITION
+	_, err = execSQL(t, exec, sess, "CREATE TABLE plain (id INT)")
+	require.NoError(t, err)
+	_, err = execSQL(t, exec, sess, "ALTER TABLE plain DROP PARTITION p0")
+	assert.Error(t, err)
+}
+
+// TestCompactionKeepsPartitions 小文件合并按分区进行，合并后的文件仍位于分区目录
+func TestCompactionKeepsPartitions(t *testing.T) {
+	dir := SetupTestDir(t, "partition_compaction")
+	engine, exec, sess := newTestEngine(t, dir)
+	defer engine.Close()
+
+	_, err := execSQL(t, exec, sess, "CREATE TABLE sales (id INT, region VARCHAR) PARTITION BY LIST (region)")
+	require.NoError(t, err)
+	for _, sql := range []string{
+		"INSERT INTO sales VALUES (1, 'us')",
+		"INSERT INTO sales VALUES (2, 'us')",
+		"INSERT INTO sales VALUES (3, 'eu')",
+		"INSERT INTO sales VALUES (4, 'eu')",
+	} {
+		_, err := execSQL(t, exec, sess, sql)
+		require.NoError(t, err, sql)
+	}
+
+	compactor := optimizer.NewCompactor(&optimizer.CompactionConfig{
+		TargetFileSize:    1024 * 1024,
+		MinFileSize:       1024 * 1024,
+		MaxFilesToCompact: 10,
+	})
+	require.NoError(t, compactor.CompactTable("default.sales", engine))
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.sales", -1)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Files, 2, "one merged file per partition")
+	for _, f := range snapshot.Files {
+		assert.Equal(t, int64(2), f.RowCount)
+		assert.Equal(t, "region="+f.PartitionValues["region"], filepath.Base(filepath.Dir(f.Path)))
+	}
+	assert.Equal(t, []string{"1", "2"}, scanPartitionIDs(t, engine, "sales", storage.Filter{Column: "region", Operator: "=", Value: "us"}))
+}
@@ -5,10 +5,18 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/apache/arrow/go/v18/arrow"
 	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/optimizer"
+	"github.com/yyun543/minidb/internal/parser"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
 )
 
 // TestDataDir returns the base directory for all test data
@@ -258,3 +266,60 @@ func abs(x float64) float64 {
 	}
 	return x
 }
+
+// newTestEngine 在 dir 下打开引擎并创建执行器和一个当前库为 default 的会话 (不启用写缓冲，便于直接检查数据文件)
+func newTestEngine(t *testing.T, dir string) (*storage.ParquetEngine, *executor.ExecutorImpl, *session.Session) {
+	engine, err := storage.NewParquetEngine(dir)
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+
+	cat := catalog.NewCatalog()
+	cat.SetStorageEngine(engine)
+	require.NoError(t, cat.Init())
+
+	sessMgr, err := session.NewSessionManager()
+	require.NoError(t, err)
+	sess := sessMgr.CreateSession()
+	sess.CurrentDB = "default"
+	return engine, executor.NewExecutor(cat), sess
+}
+
+// resultRows 将结果集展开为字符串行 (每个值后跟 "|")，便于比较
+func resultRows(result *executor.ResultSet) []string {
+	var rows []string
+	for _, batch := range result.Batches() {
+		record := batch.Record()
+		for i := 0; i < int(record.NumRows()); i++ {
+			row := ""
+			for j := 0; j < int(record.NumCols()); j++ {
+				row += record.Column(j).ValueStr(i) + "|"
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// execSQL 执行SQL语句的辅助函数
+func execSQL(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string) (*executor.ResultSet, error) {
+	t.Helper()
+	stmt, err := parser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	opt := optimizer.NewOptimizer()
+	plan, err := opt.Optimize(stmt)
+	if err != nil {
+		return nil, err
+	}
+	return exec.Execute(plan, sess)
+}
+
+// sortedRows 查询并返回排序后的结果行
+func sortedRows(t *testing.T, exec *executor.ExecutorImpl, sess *session.Session, sql string) []string {
+	result, err := execSQL(t, exec, sess, sql)
+	require.NoError(t, err)
+	rows := resultRows(result)
+	sort.Strings(rows)
+	return rows
+}
@@ -0,0 +1,362 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v18/arrow"
+	"github.com/apache/arrow/go/v18/arrow/array"
+	"github.com/apache/arrow/go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yyun543/minidb/internal/catalog"
+	"github.com/yyun543/minidb/internal/executor"
+	"github.com/yyun543/minidb/internal/session"
+	"github.com/yyun543/minidb/internal/storage"
+)
+
+var writeBufferSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "name", Type: arrow.BinaryTypes.String},
+}, nil)
+
+// openWriteBufferEngine 打开启用写缓冲的引擎，并确保 default.events 表存在
+func openWriteBufferEngine(t *testing.T, dir string, cfg storage.WriteBufferConfig) *storage.ParquetEngine {
+	engine, err := storage.NewParquetEngine(dir, storage.WithWriteBuffer(cfg))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+
+	if exists, _ := engine.TableExists("default", "events"); !exists {
+		require.NoError(t, engine.CreateDatabase("default"))
+		require.NoError(t, engine.CreateTable("default", "events", writeBufferSchema))
+	}
+	return engine
+}
+
+// writeEventRow 单行写入
+func writeEventRow(t *testing.T, engine *storage.ParquetEngine, id int) {
+	writeBufferedRow(t, engine, "events", id)
+}
+
+// writeBufferedRow 向 default 库中与 events 结构相同的表单行写入
+func writeBufferedRow(t *testing.T, engine *storage.ParquetEngine, table string, id int) {
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), writeBufferSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).Append(int64(id))
+	builder.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("event_%d", id))
+	record := builder.NewRecord()
+	defer record.Release()
+	require.NoError(t, engine.Write(context.Background(), "default", table, record))
+}
+
+// scanEventIDs 扫描 events 表的 id (排序后返回)
+func scanEventIDs(t *testing.T, engine *storage.ParquetEngine, filters []storage.Filter) []int64 {
+	return scanBufferedIDs(t, engine, "events", filters)
+}
+
+// scanBufferedIDs 扫描 default 库中与 events 结构相同的表的 id (排序后返回)
+func scanBufferedIDs(t *testing.T, engine *storage.ParquetEngine, table string, filters []storage.Filter) []int64 {
+	iter, err := engine.Scan(context.Background(), "default", table, filters)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var ids []int64
+	for iter.Next() {
+		col := iter.Record().Column(0).(*array.Int64)
+		for i := 0; i < col.Len(); i++ {
+			ids = append(ids, col.Value(i))
+		}
+	}
+	require.NoError(t, iter.Err())
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// eventFileCount 当前快照中的数据文件数
+func eventFileCount(t *testing.T, engine *storage.ParquetEngine) int {
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.events", -1)
+	require.NoError(t, err)
+	return len(snapshot.Files)
+}
+
+// walSegments 列出 events 表的 WAL 段文件
+func walSegments(t *testing.T, dir string) []string {
+	matches, err := filepath.Glob(filepath.Join(dir, "_wal", "default", "events", "*.wal"))
+	require.NoError(t, err)
+	return matches
+}
+
+func expectedIDs(n int) []int64 {
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = int64(i)
+	}
+	return ids
+}
+
+func TestWriteBufferGroupCommit(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_group_commit")
+	engine := openWriteBufferEngine(t, dir, storage.WriteBufferConfig{MaxRows: 10000, FlushInterval: time.Hour})
+	defer engine.Close()
+
+	// 并发单行写入共享 WAL fsync
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < 200; i += 8 {
+				writeEventRow(t, engine, i)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// 刷写前：没有新的 Parquet 文件，但数据对扫描可见
+	assert.Equal(t, 0, eventFileCount(t, engine))
+	assert.Equal(t, expectedIDs(200), scanEventIDs(t, engine, nil))
+	assert.Equal(t, []int64{5, 6, 7}, scanEventIDs(t, engine,
+		[]storage.Filter{{Column: "id", Operator: ">=", Value: int64(5)}, {Column: "id", Operator: "<", Value: int64(8)}}))
+	assert.Len(t, walSegments(t, dir), 1)
+
+	// 刷写：200 次写入合并为一个文件和一条 ADD 日志
+	require.NoError(t, engine.FlushWriteBuffer("default", "events"))
+	assert.Equal(t, 1, eventFileCount(t, engine))
+	assert.Equal(t, expectedIDs(200), scanEventIDs(t, engine, nil))
+	assert.Empty(t, walSegments(t, dir))
+}
+
+func TestWriteBufferFlushThresholds(t *testing.T) {
+	t.Run("rows", func(t *testing.T) {
+		engine := openWriteBufferEngine(t, SetupTestDir(t, "write_buffer_rows"), storage.WriteBufferConfig{MaxRows: 50, FlushInterval: time.Hour})
+		defer engine.Close()
+
+		for i := 0; i < 120; i++ {
+			writeEventRow(t, engine, i)
+		}
+		assert.Equal(t, 2, eventFileCount(t, engine))
+		assert.Equal(t, expectedIDs(120), scanEventIDs(t, engine, nil))
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		engine := openWriteBufferEngine(t, SetupTestDir(t, "write_buffer_bytes"), storage.WriteBufferConfig{MaxBytes: 1, FlushInterval: time.Hour})
+		defer engine.Close()
+
+		for i := 0; i < 3; i++ {
+			writeEventRow(t, engine, i)
+		}
+		assert.Equal(t, 3, eventFileCount(t, engine))
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		engine := openWriteBufferEngine(t, SetupTestDir(t, "write_buffer_interval"), storage.WriteBufferConfig{FlushInterval: 50 * time.Millisecond})
+		defer engine.Close()
+
+		for i := 0; i < 5; i++ {
+			writeEventRow(t, engine, i)
+		}
+		require.Eventually(t, func() bool { return eventFileCount(t, engine) == 1 }, 5*time.Second, 20*time.Millisecond)
+		assert.Equal(t, expectedIDs(5), scanEventIDs(t, engine, nil))
+	})
+
+	t.Run("large batches bypass the buffer", func(t *testing.T) {
+		dir := SetupTestDir(t, "write_buffer_bulk")
+		engine := openWriteBufferEngine(t, dir, storage.WriteBufferConfig{MaxRows: 10, FlushInterval: time.Hour})
+		defer engine.Close()
+
+		builder := array.NewRecordBuilder(memory.NewGoAllocator(), writeBufferSchema)
+		for i := 0; i < 100; i++ {
+			builder.Field(0).(*array.Int64Builder).Append(int64(i))
+			builder.Field(1).(*array.StringBuilder).Append("bulk")
+		}
+		record := builder.NewRecord()
+		require.NoError(t, engine.Write(context.Background(), "default", "events", record))
+		record.Release()
+		builder.Release()
+
+		assert.Equal(t, 1, eventFileCount(t, engine))
+		assert.Empty(t, walSegments(t, dir))
+	})
+}
+
+func TestWriteBufferCrashRecovery(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_recovery")
+	cfg := storage.WriteBufferConfig{FlushInterval: time.Hour}
+
+	// 写入后不关闭引擎，模拟进程崩溃
+	crashed := openWriteBufferEngine(t, dir, cfg)
+	for i := 0; i < 30; i++ {
+		writeEventRow(t, crashed, i)
+	}
+	segments := walSegments(t, dir)
+	require.Len(t, segments, 1)
+
+	// 崩溃时最后一帧只写了一半
+	f, err := os.OpenFile(segments[0], os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0xff, 0x01, 0x00, 0x00, 0xde, 0xad})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// 重启后重放 WAL：数据可见但仍在缓冲中
+	recovered := openWriteBufferEngine(t, dir, cfg)
+	assert.Equal(t, 0, eventFileCount(t, recovered))
+	assert.Equal(t, expectedIDs(30), scanEventIDs(t, recovered, nil))
+
+	// 新写入进入新的 WAL 段
+	writeEventRow(t, recovered, 30)
+	assert.Len(t, walSegments(t, dir), 2)
+	require.NoError(t, recovered.Close())
+
+	// 正常关闭会刷写所有缓冲数据
+	reopened := openWriteBufferEngine(t, dir, cfg)
+	defer reopened.Close()
+	assert.Equal(t, 1, eventFileCount(t, reopened))
+	assert.Equal(t, expectedIDs(31), scanEventIDs(t, reopened, nil))
+	assert.Empty(t, walSegments(t, dir))
+}
+
+func TestWriteBufferReplayIsIdempotent(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_idempotent")
+	cfg := storage.WriteBufferConfig{FlushInterval: time.Hour}
+
+	engine := openWriteBufferEngine(t, dir, cfg)
+	for i := 0; i < 10; i++ {
+		writeEventRow(t, engine, i)
+	}
+	segments := walSegments(t, dir)
+	require.Len(t, segments, 1)
+	saved, err := os.ReadFile(segments[0])
+	require.NoError(t, err)
+
+	// 模拟崩溃发生在 ADD 提交之后、删除 WAL 段之前
+	require.NoError(t, engine.FlushWriteBuffer("default", "events"))
+	require.NoError(t, os.WriteFile(segments[0], saved, 0644))
+
+	recovered := openWriteBufferEngine(t, dir, cfg)
+	defer recovered.Close()
+	assert.Equal(t, expectedIDs(10), scanEventIDs(t, recovered, nil), "flushed segment must not be replayed twice")
+	assert.Empty(t, walSegments(t, dir))
+}
+
+// TestWriteBufferCloneCrashRecovery 克隆表引用源表刷写的文件，但不继承源表的 WAL 水位：
+// 克隆表自己已 fsync 的 WAL 段在崩溃后重放，而不是被当作已刷写删除
+func TestWriteBufferCloneCrashRecovery(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_clone_recovery")
+	cfg := storage.WriteBufferConfig{FlushInterval: time.Hour}
+	ctx := context.Background()
+
+	// 源表刷写三次，水位为 3
+	engine := openWriteBufferEngine(t, dir, cfg)
+	for round := 0; round < 3; round++ {
+		for i := round * 10; i < (round+1)*10; i++ {
+			writeEventRow(t, engine, i)
+		}
+		require.NoError(t, engine.FlushWriteBuffer("default", "events"))
+	}
+
+	_, version, err := engine.CloneSource("default", "events", -1)
+	require.NoError(t, err)
+	require.NoError(t, engine.CreateTable("default", "events_clone", writeBufferSchema))
+	_, err = engine.CloneTable(ctx, "default", "events", version, "default", "events_clone")
+	require.NoError(t, err)
+
+	// 写入克隆表和源表后不关闭引擎，模拟进程崩溃
+	for _, id := range []int{100, 101, 102} {
+		writeBufferedRow(t, engine, "events_clone", id)
+	}
+	writeEventRow(t, engine, 30)
+
+	recovered := openWriteBufferEngine(t, dir, cfg)
+	assert.Equal(t, append(expectedIDs(30), 100, 101, 102), scanBufferedIDs(t, recovered, "events_clone", nil))
+	assert.Equal(t, expectedIDs(31), scanEventIDs(t, recovered, nil))
+
+	// 重启后新建的缓冲从水位之后编号，再次崩溃时新段不会被误删
+	writeBufferedRow(t, recovered, "events_clone", 103)
+	require.NoError(t, recovered.FlushWriteBuffer("default", "events_clone"))
+	writeBufferedRow(t, recovered, "events_clone", 104)
+
+	reopened := openWriteBufferEngine(t, dir, cfg)
+	defer reopened.Close()
+	assert.Equal(t, append(expectedIDs(30), 100, 101, 102, 103, 104), scanBufferedIDs(t, reopened, "events_clone", nil))
+	assert.Equal(t, expectedIDs(31), scanEventIDs(t, reopened, nil))
+}
+
+func TestWriteBufferUpdateDeleteAndDrop(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_mutations")
+	engine := openWriteBufferEngine(t, dir, storage.WriteBufferConfig{FlushInterval: time.Hour})
+	defer engine.Close()
+
+	for i := 0; i < 10; i++ {
+		writeEventRow(t, engine, i)
+	}
+
+	// UPDATE / DELETE 作用于尚未刷写的数据
+	_, err := engine.Update(context.Background(), "default", "events",
+		[]storage.Filter{{Column: "id", Operator: "=", Value: int64(3)}},
+		map[string]interface{}{"name": "updated"})
+	require.NoError(t, err)
+	_, err = engine.Delete(context.Background(), "default", "events",
+		[]storage.Filter{{Column: "id", Operator: "=", Value: int64(4)}})
+	require.NoError(t, err)
+
+	iter, err := engine.Scan(context.Background(), "default", "events",
+		[]storage.Filter{{Column: "id", Operator: "=", Value: int64(3)}})
+	require.NoError(t, err)
+	require.True(t, iter.Next())
+	assert.Equal(t, "updated", iter.Record().Column(1).(*array.String).Value(0))
+	iter.Close()
+	assert.Equal(t, []int64{0, 1, 2, 3, 5, 6, 7, 8, 9}, scanEventIDs(t, engine, nil))
+
+	// DROP TABLE 丢弃缓冲数据和 WAL
+	writeEventRow(t, engine, 100)
+	require.Len(t, walSegments(t, dir), 1)
+	require.NoError(t, engine.DropTable("default", "events"))
+	assert.Empty(t, walSegments(t, dir))
+}
+
+func TestWriteBufferSQLInserts(t *testing.T) {
+	dir := SetupTestDir(t, "write_buffer_sql")
+	engine, err := storage.NewParquetEngine(dir, storage.WithWriteBuffer(storage.WriteBufferConfig{FlushInterval: time.Hour}))
+	require.NoError(t, err)
+	require.NoError(t, engine.Open())
+	defer engine.Close()
+
+	cat := catalog.NewCatalog()
+	cat.SetStorageEngine(engine)
+	require.NoError(t, cat.Init())
+
+	sessMgr, err := session.NewSessionManager()
+	require.NoError(t, err)
+	sess := sessMgr.CreateSession()
+	sess.CurrentDB = "default"
+	exec := executor.NewExecutor(cat)
+
+	_, err = execSQL(t, exec, sess, "CREATE TABLE orders (id INT, amount INT)")
+	require.NoError(t, err)
+	for i := 0; i < 50; i++ {
+		_, err := execSQL(t, exec, sess, fmt.Sprintf("INSERT INTO orders VALUES (%d, %d)", i, i*10))
+		require.NoError(t, err)
+	}
+
+	snapshot, err := engine.GetDeltaLog().GetSnapshot("default.orders", -1)
+	require.NoError(t, err)
+	assert.Empty(t, snapshot.Files, "single-row INSERTs must not create one Parquet file each")
+
+	result, err := execSQL(t, exec, sess, "SELECT COUNT(*) AS cnt, SUM(amount) AS total FROM orders")
+	require.NoError(t, err)
+	rows := resultRows(result)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "50|12250|", rows[0])
+
+	require.NoError(t, engine.FlushWriteBuffer("default", "orders"))
+	snapshot, err = engine.GetDeltaLog().GetSnapshot("default.orders", -1)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Files, 1)
+}